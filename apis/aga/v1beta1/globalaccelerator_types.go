@@ -47,6 +47,45 @@ const (
 	IPAddressTypeDualStack IPAddressType = "DUAL_STACK"
 )
 
+// +kubebuilder:validation:Enum=IPv4;IPv6
+// IPAddressFamily defines the IP address family of Global Accelerator static IP addresses.
+type IPAddressFamily string
+
+const (
+	IPAddressFamilyIPv4 IPAddressFamily = "IPv4"
+	IPAddressFamilyIPv6 IPAddressFamily = "IPv6"
+)
+
+// ByoipCIDR selects a BYOIP address range that one of the accelerator's static IP addresses is allocated from.
+type ByoipCIDR struct {
+	// IPAddressFamily is the IP address family of the address range.
+	IPAddressFamily IPAddressFamily `json:"ipAddressFamily"`
+
+	// Cidr is the address range, in CIDR notation, that you provisioned to Global Accelerator.
+	// The address range must be provisioned or advertised. The controller allocates the lowest address of the range
+	// that isn't used by another accelerator in the account.
+	// +kubebuilder:validation:MaxLength=43
+	Cidr string `json:"cidr"`
+}
+
+// FlowLogsConfig defines the flow logs of the Global Accelerator.
+type FlowLogsConfig struct {
+	// Enabled indicates whether flow logs are enabled.
+	Enabled bool `json:"enabled"`
+
+	// S3Bucket is the name of the Amazon S3 bucket for the flow logs. Required when flow logs are enabled.
+	// The bucket must have a bucket policy that grants Global Accelerator permission to write to the bucket.
+	// +kubebuilder:validation:MaxLength=63
+	// +optional
+	S3Bucket *string `json:"s3Bucket,omitempty"`
+
+	// S3Prefix is the prefix for the location in the Amazon S3 bucket for the flow logs.
+	// If you don't specify a prefix, the flow logs are stored in the root of the bucket.
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	S3Prefix *string `json:"s3Prefix,omitempty"`
+}
+
 // PortRange defines the port range for Global Accelerator listeners.
 // +kubebuilder:validation:XValidation:rule="self.fromPort <= self.toPort",message="FromPort must be less than or equal to ToPort"
 type PortRange struct {
@@ -140,7 +179,7 @@ type PortOverride struct {
 	EndpointPort int32 `json:"endpointPort"`
 }
 
-// +kubebuilder:validation:Enum=EndpointID;Service;Ingress;Gateway
+// +kubebuilder:validation:Enum=EndpointID;Service;Ingress;Gateway;Federated
 // GlobalAcceleratorEndpointType defines the type of endpoint for Global Accelerator.
 type GlobalAcceleratorEndpointType string
 
@@ -149,11 +188,29 @@ const (
 	GlobalAcceleratorEndpointTypeService    GlobalAcceleratorEndpointType = "Service"
 	GlobalAcceleratorEndpointTypeIngress    GlobalAcceleratorEndpointType = "Ingress"
 	GlobalAcceleratorEndpointTypeGateway    GlobalAcceleratorEndpointType = "Gateway"
+	GlobalAcceleratorEndpointTypeFederated  GlobalAcceleratorEndpointType = "Federated"
 )
 
+// GlobalAcceleratorEndpointSelector selects the Kubernetes resources of an endpoint type by label.
+type GlobalAcceleratorEndpointSelector struct {
+	// LabelSelector selects the resources by their labels.
+	LabelSelector metav1.LabelSelector `json:"labelSelector"`
+
+	// Namespaces is the allow-list of namespaces to select resources from.
+	// If not specified, defaults to the same namespace as the GlobalAccelerator resource.
+	// Resources selected from other namespaces must be allowed by a ReferenceGrant, like endpoints referenced by name.
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
 // GlobalAcceleratorEndpoint defines an endpoint for a Global Accelerator endpoint group.
-// +kubebuilder:validation:XValidation:rule="self.type != 'EndpointID' || (has(self.endpointID) && !has(self.name))",message="endpointID is required and name must not be set when type is EndpointID"
-// +kubebuilder:validation:XValidation:rule="self.type == 'EndpointID' || (has(self.name) && !has(self.endpointID))",message="name is required and endpointID must not be set when type is Service/Ingress/Gateway"
+// +kubebuilder:validation:XValidation:rule="self.type != 'EndpointID' || (has(self.endpointID) && !has(self.name) && !has(self.selector))",message="endpointID is required and name/selector must not be set when type is EndpointID"
+// +kubebuilder:validation:XValidation:rule="self.type == 'EndpointID' || self.type == 'Federated' || (has(self.name) != has(self.selector) && !has(self.endpointID))",message="exactly one of name or selector is required and endpointID must not be set when type is Service/Ingress/Gateway"
+// +kubebuilder:validation:XValidation:rule="!has(self.selector) || !has(self.namespace)",message="namespace must not be set when selector is set, use selector.namespaces instead"
+// +kubebuilder:validation:XValidation:rule="(self.type == 'Federated') == has(self.federationGroup)",message="federationGroup is required when type is Federated and must not be set otherwise"
+// +kubebuilder:validation:XValidation:rule="self.type != 'Federated' || (!has(self.endpointID) && !has(self.name) && !has(self.namespace) && !has(self.selector))",message="endpointID, name, namespace and selector must not be set when type is Federated"
+// +kubebuilder:validation:XValidation:rule="self.type == 'Federated' || !has(self.allowedClusters)",message="allowedClusters must not be set when type is not Federated"
 type GlobalAcceleratorEndpoint struct {
 	// Type specifies the type of endpoint reference.
 	Type GlobalAcceleratorEndpointType `json:"type"`
@@ -175,6 +232,30 @@ type GlobalAcceleratorEndpoint struct {
 	// +optional
 	Namespace *string `json:"namespace,omitempty"`
 
+	// Selector selects the Kubernetes resources by label when type is Service, Ingress, or Gateway, as an alternative to Name.
+	// Every selected resource becomes an endpoint of the endpoint group, and resources that start or stop matching
+	// are added to or removed from the endpoint group automatically.
+	// The weight of a selected resource can be overridden by the aga.k8s.aws/endpoint-weight annotation on the resource.
+	// +optional
+	Selector *GlobalAcceleratorEndpointSelector `json:"selector,omitempty"`
+
+	// FederationGroup is the name of the federation group when type is Federated.
+	// Every load balancer in the region of the endpoint group that is tagged with aga.k8s.aws/federation-group=<FederationGroup>
+	// becomes an endpoint of the endpoint group. Controllers in other clusters publish their load balancers to the federation group
+	// when the owning Service, Ingress or Gateway has the aga.k8s.aws/federation-group annotation, while weights and traffic dials stay declared here.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=128
+	// +optional
+	FederationGroup *string `json:"federationGroup,omitempty"`
+
+	// AllowedClusters restricts the load balancers of a Federated endpoint to the ones published by the listed clusters,
+	// as identified by their elbv2.k8s.aws/cluster tag.
+	// If not specified, load balancers published to the federation group by any cluster are added.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=20
+	// +optional
+	AllowedClusters []string `json:"allowedClusters,omitempty"`
+
 	// Weight is the weight associated with the endpoint. When you add weights to endpoints, you configure Global Accelerator to route traffic based on proportions that you specify.
 	// For example, you might specify endpoint weights of 4, 5, 5, and 6 (sum=20). The result is that 4/20 of your traffic, on average, is routed to the first endpoint,
 	// 5/20 is routed both to the second and third endpoints, and 6/20 is routed to the last endpoint.
@@ -194,6 +275,14 @@ type GlobalAcceleratorEndpoint struct {
 	// +kubebuilder:default=true
 	// +optional
 	ClientIPPreservationEnabled *bool `json:"clientIPPreservationEnabled,omitempty"`
+
+	// CrossAccountAttachmentARN is the ARN of the cross-account attachment that grants the accelerator permission to use the endpoint
+	// when it is owned by another AWS account. Only applies to endpoints of type EndpointID.
+	// For more information, see Cross-account attachments in the AWS Global Accelerator Developer Guide:
+	// https://docs.aws.amazon.com/global-accelerator/latest/dg/cross-account-resources.html
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	CrossAccountAttachmentARN *string `json:"crossAccountAttachmentARN,omitempty"`
 }
 
 // GlobalAcceleratorSpec defines the desired state of GlobalAccelerator
@@ -219,6 +308,15 @@ type GlobalAcceleratorSpec struct {
 	// +optional
 	IpAddresses *[]string `json:"ipAddresses,omitempty"`
 
+	// ByoipCIDRs optionally selects the BYOIP address ranges that the accelerator's static IP addresses are allocated from,
+	// as an alternative to IpAddresses. You can select up to two address ranges of each IP address family,
+	// and IPv6 address ranges require the DUAL_STACK IP address type.
+	// Like IpAddresses, the address ranges only apply when the accelerator is created.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=4
+	// +optional
+	ByoipCIDRs *[]ByoipCIDR `json:"byoipCIDRs,omitempty"`
+
 	// IPAddressType is the value for the address type.
 	// +kubebuilder:default="IPV4"
 	// +optional
@@ -228,6 +326,11 @@ type GlobalAcceleratorSpec struct {
 	// +optional
 	Tags *map[string]string `json:"tags,omitempty"`
 
+	// FlowLogs defines the flow logs of the Global Accelerator.
+	// When not specified, the flow logs settings of the accelerator are left unchanged.
+	// +optional
+	FlowLogs *FlowLogsConfig `json:"flowLogs,omitempty"`
+
 	// Listeners defines the listeners for the Global Accelerator.
 	// +optional
 	Listeners *[]GlobalAcceleratorListener `json:"listeners,omitempty"`
@@ -259,11 +362,58 @@ type GlobalAcceleratorStatus struct {
 	// +optional
 	Status *string `json:"status,omitempty"`
 
+	// CrossAccountAttachments is the state of the cross-account attachments of endpoints owned by other AWS accounts.
+	// +optional
+	CrossAccountAttachments []CrossAccountAttachmentStatus `json:"crossAccountAttachments,omitempty"`
+
+	// EndpointGroups is the endpoint groups of the accelerator and the endpoints added to them.
+	// +optional
+	EndpointGroups []GlobalAcceleratorEndpointGroupStatus `json:"endpointGroups,omitempty"`
+
 	// Conditions represent the current conditions of the GlobalAccelerator.
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
+// GlobalAcceleratorEndpointGroupStatus is an endpoint group of the accelerator and the endpoints added to it.
+type GlobalAcceleratorEndpointGroupStatus struct {
+	// EndpointGroupARN is the Amazon Resource Name (ARN) of the endpoint group.
+	EndpointGroupARN string `json:"endpointGroupARN"`
+
+	// Region is the AWS Region where the endpoint group is located.
+	Region string `json:"region"`
+
+	// EndpointIDs is the IDs of the endpoints added to the endpoint group.
+	// +optional
+	EndpointIDs []string `json:"endpointIDs,omitempty"`
+}
+
+// CrossAccountAttachmentState defines whether a cross-account attachment grants the accelerator permission to use an endpoint.
+type CrossAccountAttachmentState string
+
+const (
+	// CrossAccountAttachmentStateAttached indicates the attachment grants the endpoint, and the endpoint is added to its endpoint group.
+	CrossAccountAttachmentStateAttached CrossAccountAttachmentState = "Attached"
+	// CrossAccountAttachmentStateNotAttached indicates the attachment doesn't grant the endpoint (yet), and the endpoint is not added to its endpoint group.
+	CrossAccountAttachmentStateNotAttached CrossAccountAttachmentState = "NotAttached"
+)
+
+// CrossAccountAttachmentStatus is the state of the cross-account attachment of an endpoint owned by another AWS account.
+type CrossAccountAttachmentStatus struct {
+	// EndpointID is the ID of the endpoint.
+	EndpointID string `json:"endpointID"`
+
+	// AttachmentARN is the ARN of the cross-account attachment.
+	AttachmentARN string `json:"attachmentARN"`
+
+	// State is whether the attachment grants the accelerator permission to use the endpoint.
+	State CrossAccountAttachmentState `json:"state"`
+
+	// Message explains the state.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
 // IPSet is the static IP addresses that Global Accelerator associates with the accelerator.
 type IPSet struct {
 
@@ -25,6 +25,61 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ByoipCIDR) DeepCopyInto(out *ByoipCIDR) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ByoipCIDR.
+func (in *ByoipCIDR) DeepCopy() *ByoipCIDR {
+	if in == nil {
+		return nil
+	}
+	out := new(ByoipCIDR)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CrossAccountAttachmentStatus) DeepCopyInto(out *CrossAccountAttachmentStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CrossAccountAttachmentStatus.
+func (in *CrossAccountAttachmentStatus) DeepCopy() *CrossAccountAttachmentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CrossAccountAttachmentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlowLogsConfig) DeepCopyInto(out *FlowLogsConfig) {
+	*out = *in
+	if in.S3Bucket != nil {
+		in, out := &in.S3Bucket, &out.S3Bucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3Prefix != nil {
+		in, out := &in.S3Prefix, &out.S3Prefix
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlowLogsConfig.
+func (in *FlowLogsConfig) DeepCopy() *FlowLogsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(FlowLogsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GlobalAccelerator) DeepCopyInto(out *GlobalAccelerator) {
 	*out = *in
@@ -70,6 +125,21 @@ func (in *GlobalAcceleratorEndpoint) DeepCopyInto(out *GlobalAcceleratorEndpoint
 		*out = new(string)
 		**out = **in
 	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(GlobalAcceleratorEndpointSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FederationGroup != nil {
+		in, out := &in.FederationGroup, &out.FederationGroup
+		*out = new(string)
+		**out = **in
+	}
+	if in.AllowedClusters != nil {
+		in, out := &in.AllowedClusters, &out.AllowedClusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Weight != nil {
 		in, out := &in.Weight, &out.Weight
 		*out = new(int32)
@@ -80,6 +150,11 @@ func (in *GlobalAcceleratorEndpoint) DeepCopyInto(out *GlobalAcceleratorEndpoint
 		*out = new(bool)
 		**out = **in
 	}
+	if in.CrossAccountAttachmentARN != nil {
+		in, out := &in.CrossAccountAttachmentARN, &out.CrossAccountAttachmentARN
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalAcceleratorEndpoint.
@@ -137,6 +212,47 @@ func (in *GlobalAcceleratorEndpointGroup) DeepCopy() *GlobalAcceleratorEndpointG
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalAcceleratorEndpointGroupStatus) DeepCopyInto(out *GlobalAcceleratorEndpointGroupStatus) {
+	*out = *in
+	if in.EndpointIDs != nil {
+		in, out := &in.EndpointIDs, &out.EndpointIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalAcceleratorEndpointGroupStatus.
+func (in *GlobalAcceleratorEndpointGroupStatus) DeepCopy() *GlobalAcceleratorEndpointGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalAcceleratorEndpointGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalAcceleratorEndpointSelector) DeepCopyInto(out *GlobalAcceleratorEndpointSelector) {
+	*out = *in
+	in.LabelSelector.DeepCopyInto(&out.LabelSelector)
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalAcceleratorEndpointSelector.
+func (in *GlobalAcceleratorEndpointSelector) DeepCopy() *GlobalAcceleratorEndpointSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalAcceleratorEndpointSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GlobalAcceleratorList) DeepCopyInto(out *GlobalAcceleratorList) {
 	*out = *in
@@ -226,6 +342,15 @@ func (in *GlobalAcceleratorSpec) DeepCopyInto(out *GlobalAcceleratorSpec) {
 			copy(*out, *in)
 		}
 	}
+	if in.ByoipCIDRs != nil {
+		in, out := &in.ByoipCIDRs, &out.ByoipCIDRs
+		*out = new([]ByoipCIDR)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]ByoipCIDR, len(*in))
+			copy(*out, *in)
+		}
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = new(map[string]string)
@@ -237,6 +362,11 @@ func (in *GlobalAcceleratorSpec) DeepCopyInto(out *GlobalAcceleratorSpec) {
 			}
 		}
 	}
+	if in.FlowLogs != nil {
+		in, out := &in.FlowLogs, &out.FlowLogs
+		*out = new(FlowLogsConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Listeners != nil {
 		in, out := &in.Listeners, &out.Listeners
 		*out = new([]GlobalAcceleratorListener)
@@ -295,6 +425,18 @@ func (in *GlobalAcceleratorStatus) DeepCopyInto(out *GlobalAcceleratorStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.CrossAccountAttachments != nil {
+		in, out := &in.CrossAccountAttachments, &out.CrossAccountAttachments
+		*out = make([]CrossAccountAttachmentStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.EndpointGroups != nil {
+		in, out := &in.EndpointGroups, &out.EndpointGroups
+		*out = make([]GlobalAcceleratorEndpointGroupStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -18,6 +18,7 @@ package v1beta1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // +kubebuilder:validation:Enum=ipv4;dualstack;dualstack-without-public-ipv4
@@ -114,8 +115,280 @@ type IPAMConfiguration struct {
 	IPv4IPAMPoolId *string `json:"ipv4IPAMPoolId,omitempty"`
 }
 
+// +kubebuilder:validation:Enum=off;passthrough;verify
+// MutualAuthenticationMode is the mutual authentication mode of listener.
+type MutualAuthenticationMode string
+
+const (
+	MutualAuthenticationModeOff         MutualAuthenticationMode = "off"
+	MutualAuthenticationModePassthrough MutualAuthenticationMode = "passthrough"
+	MutualAuthenticationModeVerify      MutualAuthenticationMode = "verify"
+)
+
+// +kubebuilder:validation:Enum=on;off
+// AdvertiseTrustStoreCaNames indicates whether trust store CA names are advertised.
+type AdvertiseTrustStoreCaNames string
+
+const (
+	AdvertiseTrustStoreCaNamesOn  AdvertiseTrustStoreCaNames = "on"
+	AdvertiseTrustStoreCaNamesOff AdvertiseTrustStoreCaNames = "off"
+)
+
+// +kubebuilder:validation:Enum=Secret;ConfigMap
+// TrustStoreCABundleKind is the kind of object holding a trust store CA bundle.
+type TrustStoreCABundleKind string
+
+const (
+	TrustStoreCABundleKindSecret    TrustStoreCABundleKind = "Secret"
+	TrustStoreCABundleKindConfigMap TrustStoreCABundleKind = "ConfigMap"
+)
+
+// TrustStoreCABundleSource references a CA bundle in a Secret or ConfigMap, the controller manages a trust store from it.
+type TrustStoreCABundleSource struct {
+	// The kind of object holding the CA bundle. Defaults to Secret.
+	// +optional
+	Kind *TrustStoreCABundleKind `json:"kind,omitempty"`
+
+	// The namespace of the object holding the CA bundle. Defaults to the Ingress namespace.
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+
+	// The name of the object holding the CA bundle.
+	Name string `json:"name"`
+
+	// The key of the PEM encoded CA bundle within the object. Defaults to ca.crt.
+	// +optional
+	Key *string `json:"key,omitempty"`
+
+	// The keys of PEM encoded certificate revocation lists within the object.
+	// +optional
+	RevocationListKeys []string `json:"revocationListKeys,omitempty"`
+}
+
+// MutualAuthenticationConfig defines the mutual authentication configuration for a listener port.
+// +kubebuilder:validation:XValidation:rule="self.mode == 'verify' ? (has(self.trustStore) != has(self.trustStoreCABundle)) : (!has(self.trustStore) && !has(self.trustStoreCABundle))",message="exactly one of trustStore or trustStoreCABundle must be specified when mode is 'verify', and neither otherwise"
+// +kubebuilder:validation:XValidation:rule="self.mode == 'verify' || (!has(self.ignoreClientCertificateExpiry) && !has(self.advertiseTrustStoreCaNames))",message="ignoreClientCertificateExpiry and advertiseTrustStoreCaNames are only supported when mode is 'verify'"
+type MutualAuthenticationConfig struct {
+	// The port of the listener
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+
+	// The mutual authentication mode of the listener
+	Mode MutualAuthenticationMode `json:"mode"`
+
+	// The name or ARN of the trust store
+	// +optional
+	TrustStore *string `json:"trustStore,omitempty"`
+
+	// The CA bundle of a trust store managed by the controller
+	// +optional
+	TrustStoreCABundle *TrustStoreCABundleSource `json:"trustStoreCABundle,omitempty"`
+
+	// Indicates whether expired client certificates are ignored
+	// +optional
+	IgnoreClientCertificateExpiry *bool `json:"ignoreClientCertificateExpiry,omitempty"`
+
+	// Indicates whether trust store CA names are advertised
+	// +optional
+	AdvertiseTrustStoreCaNames *AdvertiseTrustStoreCaNames `json:"advertiseTrustStoreCaNames,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=none;cognito;oidc
+// AuthType is the authentication type for Ingress backends.
+type AuthType string
+
+const (
+	AuthTypeNone    AuthType = "none"
+	AuthTypeCognito AuthType = "cognito"
+	AuthTypeOIDC    AuthType = "oidc"
+)
+
+// +kubebuilder:validation:Enum=authenticate;allow;deny
+// AuthOnUnauthenticatedRequest is the behavior if the user is not authenticated.
+type AuthOnUnauthenticatedRequest string
+
+const (
+	AuthOnUnauthenticatedRequestAuthenticate AuthOnUnauthenticatedRequest = "authenticate"
+	AuthOnUnauthenticatedRequestAllow        AuthOnUnauthenticatedRequest = "allow"
+	AuthOnUnauthenticatedRequestDeny         AuthOnUnauthenticatedRequest = "deny"
+)
+
+// AuthIDPConfigCognito defines the configuration for Amazon Cognito identity provider.
+type AuthIDPConfigCognito struct {
+	// The Amazon Resource Name (ARN) of the Amazon Cognito user pool.
+	UserPoolARN string `json:"userPoolARN"`
+
+	// The ID of the Amazon Cognito user pool client.
+	UserPoolClientID string `json:"userPoolClientID"`
+
+	// The domain prefix or fully-qualified domain name of the Amazon Cognito user pool.
+	UserPoolDomain string `json:"userPoolDomain"`
+
+	// The query parameters (up to 10) to include in the redirect request to the authorization endpoint.
+	// +optional
+	// +kubebuilder:validation:MaxProperties=10
+	AuthenticationRequestExtraParams map[string]string `json:"authenticationRequestExtraParams,omitempty"`
+}
+
+// AuthIDPConfigOIDC defines the configuration for an OpenID Connect identity provider.
+type AuthIDPConfigOIDC struct {
+	// The OIDC issuer identifier of the IdP.
+	Issuer string `json:"issuer"`
+
+	// The authorization endpoint of the IdP.
+	AuthorizationEndpoint string `json:"authorizationEndpoint"`
+
+	// The token endpoint of the IdP.
+	TokenEndpoint string `json:"tokenEndpoint"`
+
+	// The user info endpoint of the IdP.
+	UserInfoEndpoint string `json:"userInfoEndpoint"`
+
+	// The name of the secret holding the OAuth 2.0 clientID and clientSecret.
+	SecretName string `json:"secretName"`
+
+	// The namespace of the secret holding the OAuth 2.0 clientID and clientSecret.
+	// The same secret is used for the Ingresses of every namespace.
+	// +kubebuilder:validation:MinLength=1
+	SecretNamespace string `json:"secretNamespace"`
+
+	// The query parameters (up to 10) to include in the redirect request to the authorization endpoint.
+	// +optional
+	// +kubebuilder:validation:MaxProperties=10
+	AuthenticationRequestExtraParams map[string]string `json:"authenticationRequestExtraParams,omitempty"`
+}
+
+// AuthenticationConfig defines the authentication configuration for Ingress backends.
+// +kubebuilder:validation:XValidation:rule="self.type == 'cognito' ? has(self.idpCognito) : !has(self.idpCognito)",message="idpCognito must be specified only when type is 'cognito'"
+// +kubebuilder:validation:XValidation:rule="self.type == 'oidc' ? has(self.idpOIDC) : !has(self.idpOIDC)",message="idpOIDC must be specified only when type is 'oidc'"
+type AuthenticationConfig struct {
+	// The authentication type
+	Type AuthType `json:"type"`
+
+	// The configuration for Amazon Cognito identity provider
+	// +optional
+	IDPConfigCognito *AuthIDPConfigCognito `json:"idpCognito,omitempty"`
+
+	// The configuration for OpenID Connect identity provider
+	// +optional
+	IDPConfigOIDC *AuthIDPConfigOIDC `json:"idpOIDC,omitempty"`
+
+	// The behavior if the user is not authenticated. The default is authenticate.
+	// +optional
+	OnUnauthenticatedRequest *AuthOnUnauthenticatedRequest `json:"onUnauthenticatedRequest,omitempty"`
+
+	// The set of user claims to be requested from the IdP. The default is openid.
+	// +optional
+	Scope *string `json:"scope,omitempty"`
+
+	// The name of the cookie used to maintain session information. The default is AWSELBAuthSessionCookie.
+	// +optional
+	SessionCookieName *string `json:"sessionCookieName,omitempty"`
+
+	// The maximum duration of the authentication session, in seconds. The default is 604800 seconds (7 days).
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=604800
+	SessionTimeout *int64 `json:"sessionTimeout,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=single-string;string-array;space-separated-values
+// JwtAdditionalClaimFormat is the format of an additional claim's value(s) used in JWT validation.
+type JwtAdditionalClaimFormat string
+
+const (
+	JwtAdditionalClaimFormatSingleString         JwtAdditionalClaimFormat = "single-string"
+	JwtAdditionalClaimFormatStringArray          JwtAdditionalClaimFormat = "string-array"
+	JwtAdditionalClaimFormatSpaceSeparatedValues JwtAdditionalClaimFormat = "space-separated-values"
+)
+
+// JwtAdditionalClaim defines an additional claim to validate during JWT validation.
+type JwtAdditionalClaim struct {
+	// The format of the claim value(s).
+	Format JwtAdditionalClaimFormat `json:"format"`
+
+	// The claim name.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// The claim values.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=10
+	Values []string `json:"values"`
+}
+
+// JwtValidationConfig defines the JSON Web Token (JWT) validation performed prior to the routing action.
+type JwtValidationConfig struct {
+	// The JSON Web Key Set (JWKS) endpoint containing the public keys used to verify the JWT.
+	// +kubebuilder:validation:MinLength=1
+	JwksEndpoint string `json:"jwksEndpoint"`
+
+	// The issuer of the JWT.
+	// +kubebuilder:validation:MinLength=1
+	Issuer string `json:"issuer"`
+
+	// Any additional claims in the JWT that should be validated.
+	// +optional
+	AdditionalClaims []JwtAdditionalClaim `json:"additionalClaims,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=HTTP;HTTPS
+// HealthCheckProtocol is the protocol used for target group health checks.
+type HealthCheckProtocol string
+
+const (
+	HealthCheckProtocolHTTP  HealthCheckProtocol = "HTTP"
+	HealthCheckProtocolHTTPS HealthCheckProtocol = "HTTPS"
+)
+
+// TargetGroupHealthCheckConfig defines the health check configuration for target groups.
+type TargetGroupHealthCheckConfig struct {
+	// The port used when performing health checks on targets.
+	// It can be "traffic-port", a port number, or the name of a port on the backend Service.
+	// +optional
+	Port *intstr.IntOrString `json:"port,omitempty"`
+
+	// The protocol used when performing health checks on targets.
+	// +optional
+	Protocol *HealthCheckProtocol `json:"protocol,omitempty"`
+
+	// The destination for health checks on targets.
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// The HTTP or gRPC codes to use when checking for a successful response from a target.
+	// +optional
+	SuccessCodes *string `json:"successCodes,omitempty"`
+
+	// The approximate amount of time, in seconds, between health checks of an individual target.
+	// +optional
+	// +kubebuilder:validation:Minimum=5
+	// +kubebuilder:validation:Maximum=300
+	IntervalSeconds *int32 `json:"intervalSeconds,omitempty"`
+
+	// The amount of time, in seconds, during which no response means a failed health check.
+	// +optional
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=120
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+
+	// The number of consecutive health checks successes required before considering an unhealthy target healthy.
+	// +optional
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=10
+	HealthyThresholdCount *int32 `json:"healthyThresholdCount,omitempty"`
+
+	// The number of consecutive health check failures required before considering a target unhealthy.
+	// +optional
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=10
+	UnhealthyThresholdCount *int32 `json:"unhealthyThresholdCount,omitempty"`
+}
+
 // IngressClassParamsSpec defines the desired state of IngressClassParams
 // +kubebuilder:validation:XValidation:rule="!(has(self.prefixListsIDs) && has(self.PrefixListsIDs))", message="cannot specify both 'prefixListsIDs' and 'PrefixListsIDs' fields"
+// +kubebuilder:validation:XValidation:rule="!(has(self.authentication) && self.authentication.type != 'none' && has(self.jwtValidation))", message="cannot specify both 'authentication' and 'jwtValidation' fields"
 type IngressClassParamsSpec struct {
 	// LoadBalancerName defines the name of the load balancer that will be created with this IngressClassParams.
 	// +optional
@@ -169,6 +442,10 @@ type IngressClassParamsSpec struct {
 	// +optional
 	LoadBalancerAttributes []Attribute `json:"loadBalancerAttributes,omitempty"`
 
+	// LoadBalancerLogs define the delivery of LoadBalancer logs to S3 buckets for all Ingresses that belong to IngressClass with this IngressClassParams.
+	// +optional
+	LoadBalancerLogs *LoadBalancerLogsConfig `json:"loadBalancerLogs,omitempty"`
+
 	// Listeners define a list of listeners with their protocol, port and attributes.
 	// +optional
 	Listeners []Listener `json:"listeners,omitempty"`
@@ -197,6 +474,89 @@ type IngressClassParamsSpec struct {
 	// WAFv2ACLName specifies name of the Amazon WAFv2 web ACL.
 	// +optional
 	WAFv2ACLName string `json:"wafv2AclName"`
+
+	// WAFACLID specifies the identifier of the Amazon WAF Classic web ACL.
+	// +optional
+	WAFACLID string `json:"wafAclId,omitempty"`
+
+	// ShieldAdvancedProtection turns on / off the AWS Shield Advanced protection for all Ingresses that belong to IngressClass with this IngressClassParams.
+	// +optional
+	ShieldAdvancedProtection *bool `json:"shieldAdvancedProtection,omitempty"`
+
+	// MutualAuthentication defines the mutual authentication configuration of listeners for all Ingresses that belong to IngressClass with this IngressClassParams.
+	// +optional
+	// +listType=map
+	// +listMapKey=port
+	MutualAuthentication []MutualAuthenticationConfig `json:"mutualAuthentication,omitempty"`
+
+	// Authentication defines the authentication configuration for all Ingresses that belong to IngressClass with this IngressClassParams.
+	// +optional
+	Authentication *AuthenticationConfig `json:"authentication,omitempty"`
+
+	// JwtValidation defines the JWT validation configuration for all Ingresses that belong to IngressClass with this IngressClassParams.
+	// +optional
+	JwtValidation *JwtValidationConfig `json:"jwtValidation,omitempty"`
+
+	// TargetGroupHealthCheck defines the health check configuration of target groups for all Ingresses that belong to IngressClass with this IngressClassParams.
+	// +optional
+	TargetGroupHealthCheck *TargetGroupHealthCheckConfig `json:"targetGroupHealthCheck,omitempty"`
+
+	// TargetGroupAttributes define the custom attributes of target groups for all Ingresses that belong to IngressClass with this IngressClassParams.
+	// +optional
+	TargetGroupAttributes []Attribute `json:"targetGroupAttributes,omitempty"`
+}
+
+// LoadBalancerLogsConfig defines the delivery of LoadBalancer logs to S3 buckets
+type LoadBalancerLogsConfig struct {
+	// AccessLogs configures the delivery of access logs.
+	// +optional
+	AccessLogs *S3LogDeliveryConfig `json:"accessLogs,omitempty"`
+
+	// ConnectionLogs configures the delivery of connection logs.
+	// +optional
+	ConnectionLogs *S3LogDeliveryConfig `json:"connectionLogs,omitempty"`
+
+	// HealthCheckLogs configures the delivery of health check logs.
+	// +optional
+	HealthCheckLogs *S3LogDeliveryConfig `json:"healthCheckLogs,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Patch;Validate
+// LogBucketPolicyMode defines how the controller makes sure a bucket policy grants log delivery.
+type LogBucketPolicyMode string
+
+const (
+	// LogBucketPolicyModePatch adds the statements granting log delivery that are missing from the bucket policy.
+	LogBucketPolicyModePatch LogBucketPolicyMode = "Patch"
+	// LogBucketPolicyModeValidate reports an error when the bucket policy doesn't grant log delivery.
+	LogBucketPolicyModeValidate LogBucketPolicyMode = "Validate"
+)
+
+// S3LogDeliveryConfig defines the delivery of one type of LoadBalancer logs to a S3 bucket
+type S3LogDeliveryConfig struct {
+	// Bucket is the name of the S3 bucket that receives the logs. The bucket must be in the region of the LoadBalancer.
+	// +kubebuilder:validation:MinLength=3
+	// +kubebuilder:validation:MaxLength=63
+	Bucket string `json:"bucket"`
+
+	// Prefix is the prefix that logs are delivered under.
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+
+	// BucketPolicy defines whether the controller patches the bucket policy to grant log delivery, or only validates it.
+	// Defaults to Patch.
+	// +optional
+	BucketPolicy *LogBucketPolicyMode `json:"bucketPolicy,omitempty"`
+
+	// CreateBucket defines whether the controller creates the bucket if it doesn't exist.
+	// The controller never deletes buckets.
+	// +optional
+	CreateBucket *bool `json:"createBucket,omitempty"`
+
+	// ExpirationDays is the number of days after which log objects expire, applied by a lifecycle rule when the controller creates the bucket.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ExpirationDays *int32 `json:"expirationDays,omitempty"`
 }
 
 // +kubebuilder:object:root=true
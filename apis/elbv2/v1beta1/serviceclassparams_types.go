@@ -0,0 +1,156 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// +kubebuilder:validation:Enum=TCP;HTTP;HTTPS
+// ServiceHealthCheckProtocol is the protocol used for Network Load Balancer target group health checks.
+type ServiceHealthCheckProtocol string
+
+const (
+	ServiceHealthCheckProtocolTCP   ServiceHealthCheckProtocol = "TCP"
+	ServiceHealthCheckProtocolHTTP  ServiceHealthCheckProtocol = "HTTP"
+	ServiceHealthCheckProtocolHTTPS ServiceHealthCheckProtocol = "HTTPS"
+)
+
+// ServiceHealthCheckConfig defines the health check configuration for Network Load Balancer target groups.
+type ServiceHealthCheckConfig struct {
+	// The port used when performing health checks on targets.
+	// It can be "traffic-port", a port number, or the name of a port on the Service.
+	// +optional
+	Port *intstr.IntOrString `json:"port,omitempty"`
+
+	// The protocol used when performing health checks on targets.
+	// +optional
+	Protocol *ServiceHealthCheckProtocol `json:"protocol,omitempty"`
+
+	// The destination for HTTP and HTTPS health checks on targets.
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// The HTTP codes to use when checking for a successful response from a target.
+	// +optional
+	SuccessCodes *string `json:"successCodes,omitempty"`
+
+	// The approximate amount of time, in seconds, between health checks of an individual target.
+	// +optional
+	// +kubebuilder:validation:Minimum=5
+	// +kubebuilder:validation:Maximum=300
+	IntervalSeconds *int32 `json:"intervalSeconds,omitempty"`
+
+	// The amount of time, in seconds, during which no response means a failed health check.
+	// +optional
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=120
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+
+	// The number of consecutive health checks successes required before considering an unhealthy target healthy.
+	// +optional
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=10
+	HealthyThresholdCount *int32 `json:"healthyThresholdCount,omitempty"`
+
+	// The number of consecutive health check failures required before considering a target unhealthy.
+	// +optional
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=10
+	UnhealthyThresholdCount *int32 `json:"unhealthyThresholdCount,omitempty"`
+}
+
+// ServiceLoadBalancerSettings defines settings of the Network Load Balancers provisioned for Services.
+type ServiceLoadBalancerSettings struct {
+	// Scheme defines the scheme of the load balancer.
+	// +optional
+	Scheme *LoadBalancerScheme `json:"scheme,omitempty"`
+
+	// Subnets defines the subnets of the load balancer.
+	// +kubebuilder:validation:XValidation:rule="has(self.ids) != has(self.tags)",message="exactly one of ids or tags must be specified"
+	// +optional
+	Subnets *SubnetSelector `json:"subnets,omitempty"`
+
+	// SecurityGroups defines the names or IDs of the frontend security groups of the load balancer.
+	// +kubebuilder:validation:MinItems=1
+	// +optional
+	SecurityGroups []string `json:"securityGroups,omitempty"`
+
+	// Tags defines list of Tags on AWS resources provisioned for the Service.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+
+	// LoadBalancerAttributes define the custom attributes of the load balancer.
+	// +optional
+	LoadBalancerAttributes []Attribute `json:"loadBalancerAttributes,omitempty"`
+
+	// TargetGroupAttributes define the custom attributes of the target groups.
+	// +optional
+	TargetGroupAttributes []Attribute `json:"targetGroupAttributes,omitempty"`
+
+	// HealthCheck defines the health check configuration of the target groups.
+	// +optional
+	HealthCheck *ServiceHealthCheckConfig `json:"healthCheck,omitempty"`
+}
+
+// ServiceClassParamsSpec defines the desired state of ServiceClassParams
+type ServiceClassParamsSpec struct {
+	// LoadBalancerClass is the spec.loadBalancerClass of the Services that these parameters apply to.
+	// +kubebuilder:validation:MinLength=1
+	LoadBalancerClass string `json:"loadBalancerClass"`
+
+	// NamespaceSelector restrict the namespaces of Services that are allowed to specify the LoadBalancerClass.
+	// * if absent or present but empty, it selects all namespaces.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// Enforced defines the settings for all Services of the LoadBalancerClass.
+	// They take precedence over the annotations of the Services.
+	// +optional
+	Enforced *ServiceLoadBalancerSettings `json:"enforced,omitempty"`
+
+	// Defaults defines the settings for Services of the LoadBalancerClass that don't specify them with annotations.
+	// +optional
+	Defaults *ServiceLoadBalancerSettings `json:"defaults,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,singular=serviceclassparam
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="LOAD-BALANCER-CLASS",type="string",JSONPath=".spec.loadBalancerClass",description="The Service loadBalancerClass"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// ServiceClassParams is the Schema for the ServiceClassParams API
+type ServiceClassParams struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ServiceClassParamsSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceClassParamsList contains a list of ServiceClassParams
+type ServiceClassParamsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceClassParams `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ServiceClassParams{}, &ServiceClassParamsList{})
+}
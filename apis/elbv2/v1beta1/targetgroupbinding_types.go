@@ -122,6 +122,19 @@ type TargetGroupBindingNetworking struct {
 	Ingress []NetworkingIngressRule `json:"ingress,omitempty"`
 }
 
+// TargetZonePolicy defines how targets in availability zones that are not enabled on the LoadBalancer are handled.
+// +kubebuilder:validation:Enum=Register;Skip;Defer
+type TargetZonePolicy string
+
+const (
+	// TargetZonePolicyRegister registers targets regardless of their availability zone, uncovered zones are only reported.
+	TargetZonePolicyRegister TargetZonePolicy = "Register"
+	// TargetZonePolicySkip skips the registration of targets in uncovered availability zones.
+	TargetZonePolicySkip TargetZonePolicy = "Skip"
+	// TargetZonePolicyDefer defers the registration of targets in uncovered availability zones until the zone is enabled.
+	TargetZonePolicyDefer TargetZonePolicy = "Defer"
+)
+
 // TargetGroupBindingSpec defines the desired state of TargetGroupBinding
 type TargetGroupBindingSpec struct {
 	// targetGroupARN is the Amazon Resource Name (ARN) for the TargetGroup.
@@ -171,6 +184,16 @@ type TargetGroupBindingSpec struct {
 	// IAM Role ARN to assume when calling AWS APIs. Needed to assume a role in another account and prevent the confused deputy problem. https://docs.aws.amazon.com/IAM/latest/UserGuide/confused-deputy.html
 	// +optional
 	AssumeRoleExternalId string `json:"assumeRoleExternalId,omitempty"`
+
+	// targetZonePolicy defines how targets in availability zones that are not enabled on the LoadBalancer are handled.
+	// If unspecified, the availability zone of targets is not checked.
+	// +optional
+	TargetZonePolicy *TargetZonePolicy `json:"targetZonePolicy,omitempty"`
+
+	// coordinatedPodDrain denotes whether terminating pods are held by a finalizer until their targets finish draining.
+	// It only applies to TargetGroupBindings with the ip targetType.
+	// +optional
+	CoordinatedPodDrain bool `json:"coordinatedPodDrain,omitempty"`
 }
 
 // TargetGroupBindingStatus defines the observed state of TargetGroupBinding
@@ -182,6 +205,10 @@ type TargetGroupBindingStatus struct {
 	// Conditions describe the current conditions of the TargetGroupBinding.
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// OwnedTargetCount is the number of targets owned by this cluster, only populated for multi-cluster TargetGroupBindings.
+	// +optional
+	OwnedTargetCount *int32 `json:"ownedTargetCount,omitempty"`
 }
 
 // +kubebuilder:object:root=true
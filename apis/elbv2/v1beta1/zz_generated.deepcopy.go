@@ -146,6 +146,95 @@ func (in *Attribute) DeepCopy() *Attribute {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthIDPConfigCognito) DeepCopyInto(out *AuthIDPConfigCognito) {
+	*out = *in
+	if in.AuthenticationRequestExtraParams != nil {
+		in, out := &in.AuthenticationRequestExtraParams, &out.AuthenticationRequestExtraParams
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthIDPConfigCognito.
+func (in *AuthIDPConfigCognito) DeepCopy() *AuthIDPConfigCognito {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthIDPConfigCognito)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthIDPConfigOIDC) DeepCopyInto(out *AuthIDPConfigOIDC) {
+	*out = *in
+	if in.AuthenticationRequestExtraParams != nil {
+		in, out := &in.AuthenticationRequestExtraParams, &out.AuthenticationRequestExtraParams
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthIDPConfigOIDC.
+func (in *AuthIDPConfigOIDC) DeepCopy() *AuthIDPConfigOIDC {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthIDPConfigOIDC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthenticationConfig) DeepCopyInto(out *AuthenticationConfig) {
+	*out = *in
+	if in.IDPConfigCognito != nil {
+		in, out := &in.IDPConfigCognito, &out.IDPConfigCognito
+		*out = new(AuthIDPConfigCognito)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IDPConfigOIDC != nil {
+		in, out := &in.IDPConfigOIDC, &out.IDPConfigOIDC
+		*out = new(AuthIDPConfigOIDC)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OnUnauthenticatedRequest != nil {
+		in, out := &in.OnUnauthenticatedRequest, &out.OnUnauthenticatedRequest
+		*out = new(AuthOnUnauthenticatedRequest)
+		**out = **in
+	}
+	if in.Scope != nil {
+		in, out := &in.Scope, &out.Scope
+		*out = new(string)
+		**out = **in
+	}
+	if in.SessionCookieName != nil {
+		in, out := &in.SessionCookieName, &out.SessionCookieName
+		*out = new(string)
+		**out = **in
+	}
+	if in.SessionTimeout != nil {
+		in, out := &in.SessionTimeout, &out.SessionTimeout
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationConfig.
+func (in *AuthenticationConfig) DeepCopy() *AuthenticationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPAMConfiguration) DeepCopyInto(out *IPAMConfiguration) {
 	*out = *in
@@ -287,6 +376,11 @@ func (in *IngressClassParamsSpec) DeepCopyInto(out *IngressClassParamsSpec) {
 		*out = make([]Attribute, len(*in))
 		copy(*out, *in)
 	}
+	if in.LoadBalancerLogs != nil {
+		in, out := &in.LoadBalancerLogs, &out.LoadBalancerLogs
+		*out = new(LoadBalancerLogsConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Listeners != nil {
 		in, out := &in.Listeners, &out.Listeners
 		*out = make([]Listener, len(*in))
@@ -314,6 +408,38 @@ func (in *IngressClassParamsSpec) DeepCopyInto(out *IngressClassParamsSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ShieldAdvancedProtection != nil {
+		in, out := &in.ShieldAdvancedProtection, &out.ShieldAdvancedProtection
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MutualAuthentication != nil {
+		in, out := &in.MutualAuthentication, &out.MutualAuthentication
+		*out = make([]MutualAuthenticationConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(AuthenticationConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.JwtValidation != nil {
+		in, out := &in.JwtValidation, &out.JwtValidation
+		*out = new(JwtValidationConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TargetGroupHealthCheck != nil {
+		in, out := &in.TargetGroupHealthCheck, &out.TargetGroupHealthCheck
+		*out = new(TargetGroupHealthCheckConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TargetGroupAttributes != nil {
+		in, out := &in.TargetGroupAttributes, &out.TargetGroupAttributes
+		*out = make([]Attribute, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressClassParamsSpec.
@@ -341,6 +467,48 @@ func (in *IngressGroup) DeepCopy() *IngressGroup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JwtAdditionalClaim) DeepCopyInto(out *JwtAdditionalClaim) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JwtAdditionalClaim.
+func (in *JwtAdditionalClaim) DeepCopy() *JwtAdditionalClaim {
+	if in == nil {
+		return nil
+	}
+	out := new(JwtAdditionalClaim)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JwtValidationConfig) DeepCopyInto(out *JwtValidationConfig) {
+	*out = *in
+	if in.AdditionalClaims != nil {
+		in, out := &in.AdditionalClaims, &out.AdditionalClaims
+		*out = make([]JwtAdditionalClaim, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JwtValidationConfig.
+func (in *JwtValidationConfig) DeepCopy() *JwtValidationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(JwtValidationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Listener) DeepCopyInto(out *Listener) {
 	*out = *in
@@ -361,6 +529,36 @@ func (in *Listener) DeepCopy() *Listener {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerLogsConfig) DeepCopyInto(out *LoadBalancerLogsConfig) {
+	*out = *in
+	if in.AccessLogs != nil {
+		in, out := &in.AccessLogs, &out.AccessLogs
+		*out = new(S3LogDeliveryConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConnectionLogs != nil {
+		in, out := &in.ConnectionLogs, &out.ConnectionLogs
+		*out = new(S3LogDeliveryConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HealthCheckLogs != nil {
+		in, out := &in.HealthCheckLogs, &out.HealthCheckLogs
+		*out = new(S3LogDeliveryConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerLogsConfig.
+func (in *LoadBalancerLogsConfig) DeepCopy() *LoadBalancerLogsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerLogsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MinimumLoadBalancerCapacity) DeepCopyInto(out *MinimumLoadBalancerCapacity) {
 	*out = *in
@@ -376,6 +574,41 @@ func (in *MinimumLoadBalancerCapacity) DeepCopy() *MinimumLoadBalancerCapacity {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MutualAuthenticationConfig) DeepCopyInto(out *MutualAuthenticationConfig) {
+	*out = *in
+	if in.TrustStore != nil {
+		in, out := &in.TrustStore, &out.TrustStore
+		*out = new(string)
+		**out = **in
+	}
+	if in.TrustStoreCABundle != nil {
+		in, out := &in.TrustStoreCABundle, &out.TrustStoreCABundle
+		*out = new(TrustStoreCABundleSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IgnoreClientCertificateExpiry != nil {
+		in, out := &in.IgnoreClientCertificateExpiry, &out.IgnoreClientCertificateExpiry
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AdvertiseTrustStoreCaNames != nil {
+		in, out := &in.AdvertiseTrustStoreCaNames, &out.AdvertiseTrustStoreCaNames
+		*out = new(AdvertiseTrustStoreCaNames)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MutualAuthenticationConfig.
+func (in *MutualAuthenticationConfig) DeepCopy() *MutualAuthenticationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MutualAuthenticationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkingIngressRule) DeepCopyInto(out *NetworkingIngressRule) {
 	*out = *in
@@ -455,6 +688,41 @@ func (in *NetworkingPort) DeepCopy() *NetworkingPort {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3LogDeliveryConfig) DeepCopyInto(out *S3LogDeliveryConfig) {
+	*out = *in
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.BucketPolicy != nil {
+		in, out := &in.BucketPolicy, &out.BucketPolicy
+		*out = new(LogBucketPolicyMode)
+		**out = **in
+	}
+	if in.CreateBucket != nil {
+		in, out := &in.CreateBucket, &out.CreateBucket
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExpirationDays != nil {
+		in, out := &in.ExpirationDays, &out.ExpirationDays
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3LogDeliveryConfig.
+func (in *S3LogDeliveryConfig) DeepCopy() *S3LogDeliveryConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(S3LogDeliveryConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecurityGroup) DeepCopyInto(out *SecurityGroup) {
 	*out = *in
@@ -470,6 +738,199 @@ func (in *SecurityGroup) DeepCopy() *SecurityGroup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceClassParams) DeepCopyInto(out *ServiceClassParams) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceClassParams.
+func (in *ServiceClassParams) DeepCopy() *ServiceClassParams {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceClassParams)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceClassParams) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceClassParamsList) DeepCopyInto(out *ServiceClassParamsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ServiceClassParams, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceClassParamsList.
+func (in *ServiceClassParamsList) DeepCopy() *ServiceClassParamsList {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceClassParamsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceClassParamsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceClassParamsSpec) DeepCopyInto(out *ServiceClassParamsSpec) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Enforced != nil {
+		in, out := &in.Enforced, &out.Enforced
+		*out = new(ServiceLoadBalancerSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Defaults != nil {
+		in, out := &in.Defaults, &out.Defaults
+		*out = new(ServiceLoadBalancerSettings)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceClassParamsSpec.
+func (in *ServiceClassParamsSpec) DeepCopy() *ServiceClassParamsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceClassParamsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceHealthCheckConfig) DeepCopyInto(out *ServiceHealthCheckConfig) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(ServiceHealthCheckProtocol)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.SuccessCodes != nil {
+		in, out := &in.SuccessCodes, &out.SuccessCodes
+		*out = new(string)
+		**out = **in
+	}
+	if in.IntervalSeconds != nil {
+		in, out := &in.IntervalSeconds, &out.IntervalSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.HealthyThresholdCount != nil {
+		in, out := &in.HealthyThresholdCount, &out.HealthyThresholdCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.UnhealthyThresholdCount != nil {
+		in, out := &in.UnhealthyThresholdCount, &out.UnhealthyThresholdCount
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceHealthCheckConfig.
+func (in *ServiceHealthCheckConfig) DeepCopy() *ServiceHealthCheckConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceHealthCheckConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceLoadBalancerSettings) DeepCopyInto(out *ServiceLoadBalancerSettings) {
+	*out = *in
+	if in.Scheme != nil {
+		in, out := &in.Scheme, &out.Scheme
+		*out = new(LoadBalancerScheme)
+		**out = **in
+	}
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = new(SubnetSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityGroups != nil {
+		in, out := &in.SecurityGroups, &out.SecurityGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+	if in.LoadBalancerAttributes != nil {
+		in, out := &in.LoadBalancerAttributes, &out.LoadBalancerAttributes
+		*out = make([]Attribute, len(*in))
+		copy(*out, *in)
+	}
+	if in.TargetGroupAttributes != nil {
+		in, out := &in.TargetGroupAttributes, &out.TargetGroupAttributes
+		*out = make([]Attribute, len(*in))
+		copy(*out, *in)
+	}
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(ServiceHealthCheckConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceLoadBalancerSettings.
+func (in *ServiceLoadBalancerSettings) DeepCopy() *ServiceLoadBalancerSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceLoadBalancerSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceReference) DeepCopyInto(out *ServiceReference) {
 	*out = *in
@@ -647,6 +1108,11 @@ func (in *TargetGroupBindingSpec) DeepCopyInto(out *TargetGroupBindingSpec) {
 		*out = new(TargetGroupIPAddressType)
 		**out = **in
 	}
+	if in.TargetZonePolicy != nil {
+		in, out := &in.TargetZonePolicy, &out.TargetZonePolicy
+		*out = new(TargetZonePolicy)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupBindingSpec.
@@ -674,6 +1140,11 @@ func (in *TargetGroupBindingStatus) DeepCopyInto(out *TargetGroupBindingStatus)
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.OwnedTargetCount != nil {
+		in, out := &in.OwnedTargetCount, &out.OwnedTargetCount
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupBindingStatus.
@@ -685,3 +1156,93 @@ func (in *TargetGroupBindingStatus) DeepCopy() *TargetGroupBindingStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetGroupHealthCheckConfig) DeepCopyInto(out *TargetGroupHealthCheckConfig) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(HealthCheckProtocol)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.SuccessCodes != nil {
+		in, out := &in.SuccessCodes, &out.SuccessCodes
+		*out = new(string)
+		**out = **in
+	}
+	if in.IntervalSeconds != nil {
+		in, out := &in.IntervalSeconds, &out.IntervalSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.HealthyThresholdCount != nil {
+		in, out := &in.HealthyThresholdCount, &out.HealthyThresholdCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.UnhealthyThresholdCount != nil {
+		in, out := &in.UnhealthyThresholdCount, &out.UnhealthyThresholdCount
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupHealthCheckConfig.
+func (in *TargetGroupHealthCheckConfig) DeepCopy() *TargetGroupHealthCheckConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetGroupHealthCheckConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustStoreCABundleSource) DeepCopyInto(out *TrustStoreCABundleSource) {
+	*out = *in
+	if in.Kind != nil {
+		in, out := &in.Kind, &out.Kind
+		*out = new(TrustStoreCABundleKind)
+		**out = **in
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.RevocationListKeys != nil {
+		in, out := &in.RevocationListKeys, &out.RevocationListKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustStoreCABundleSource.
+func (in *TrustStoreCABundleSource) DeepCopy() *TrustStoreCABundleSource {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustStoreCABundleSource)
+	in.DeepCopyInto(out)
+	return out
+}
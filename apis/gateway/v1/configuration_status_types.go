@@ -0,0 +1,72 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConfigurationConditionType is a type of condition reported for a LoadBalancerConfiguration or TargetGroupConfiguration ancestor.
+type ConfigurationConditionType string
+
+// ConfigurationConditionReason is a reason for a LoadBalancerConfiguration or TargetGroupConfiguration ancestor condition.
+type ConfigurationConditionReason string
+
+const (
+	// ConfigurationConditionAccepted indicates whether the configuration has been accepted by the ancestor.
+	ConfigurationConditionAccepted ConfigurationConditionType = "Accepted"
+	// ConfigurationConditionResolvedRefs indicates whether the references of the configuration could be resolved.
+	ConfigurationConditionResolvedRefs ConfigurationConditionType = "ResolvedRefs"
+	// ConfigurationConditionConflicted indicates whether another configuration overrides some or all of this configuration for the ancestor.
+	ConfigurationConditionConflicted ConfigurationConditionType = "Conflicted"
+)
+
+const (
+	// ConfigurationReasonAccepted is used with the Accepted condition when the configuration is used by the ancestor.
+	ConfigurationReasonAccepted ConfigurationConditionReason = "Accepted"
+	// ConfigurationReasonInvalid is used with the Accepted condition when the configuration can't be used by the ancestor.
+	ConfigurationReasonInvalid ConfigurationConditionReason = "Invalid"
+	// ConfigurationReasonPending is used with the Accepted condition when the ancestor hasn't processed the latest configuration yet.
+	ConfigurationReasonPending ConfigurationConditionReason = "Pending"
+	// ConfigurationReasonResolvedRefs is used with the ResolvedRefs condition when all references are resolved.
+	ConfigurationReasonResolvedRefs ConfigurationConditionReason = "ResolvedRefs"
+	// ConfigurationReasonInvalidRef is used with the ResolvedRefs condition when a referenced object is missing or can't be used.
+	ConfigurationReasonInvalidRef ConfigurationConditionReason = "InvalidRef"
+	// ConfigurationReasonTargetNotFound is used with the ResolvedRefs condition when the target of a TargetGroupConfiguration doesn't exist.
+	ConfigurationReasonTargetNotFound ConfigurationConditionReason = "TargetNotFound"
+	// ConfigurationReasonConflicted is used with the Accepted and Conflicted conditions when another configuration takes precedence.
+	ConfigurationReasonConflicted ConfigurationConditionReason = "Conflicted"
+	// ConfigurationReasonNoConflicts is used with the Conflicted condition when no other configuration takes precedence.
+	ConfigurationReasonNoConflicts ConfigurationConditionReason = "NoConflicts"
+)
+
+// ConfigurationAncestorReference identifies a Gateway or GatewayClass that consumes a configuration.
+type ConfigurationAncestorReference struct {
+	// group is the API group of the ancestor.
+	// +optional
+	Group string `json:"group,omitempty"`
+
+	// kind is the kind of the ancestor, Gateway or GatewayClass.
+	Kind string `json:"kind"`
+
+	// namespace is the namespace of the ancestor, empty for cluster scoped ancestors.
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+
+	// name is the name of the ancestor.
+	Name string `json:"name"`
+}
+
+// ConfigurationAncestorStatus describes the status of a configuration with respect to one of its ancestors.
+type ConfigurationAncestorStatus struct {
+	// ancestorRef identifies the Gateway or GatewayClass this status applies to.
+	AncestorRef ConfigurationAncestorReference `json:"ancestorRef"`
+
+	// controllerName is the name of the controller that manages the ancestor.
+	ControllerName string `json:"controllerName"`
+
+	// conditions describe the status of the configuration with respect to the ancestor.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=8
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
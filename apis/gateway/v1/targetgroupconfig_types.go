@@ -227,6 +227,11 @@ type TargetGroupProps struct {
 	// +optional
 	EnableMultiCluster *bool `json:"enableMultiCluster,omitempty"`
 
+	// cloudWatchAlarmThresholds [Application / Network LoadBalancer] overrides the CloudWatch alarm thresholds
+	// of the LoadBalancerConfiguration for this target group, keyed by metric name.
+	// +optional
+	CloudWatchAlarmThresholds map[string]string `json:"cloudWatchAlarmThresholds,omitempty"`
+
 	// Tags the Tags to add on the target group.
 	// +optional
 	Tags *map[string]string `json:"tags,omitempty"`
@@ -251,6 +256,12 @@ type TargetGroupConfigurationStatus struct {
 	// The generation of the Gateway Configuration attached to the GatewayClass object.
 	// +optional
 	ObservedGatewayClassConfigurationGeneration *int64 `json:"observedGatewayClassConfigurationGeneration,omitempty"`
+
+	// ancestors is the status of the TargetGroupConfiguration with respect to each Gateway or GatewayClass that consumes it.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=16
+	Ancestors []ConfigurationAncestorStatus `json:"ancestors,omitempty"`
 }
 
 // +kubebuilder:object:root=true
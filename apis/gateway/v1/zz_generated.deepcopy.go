@@ -172,6 +172,76 @@ func (in *AuthenticateOidcActionConfig) DeepCopy() *AuthenticateOidcActionConfig
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudWatchAlarmsConfiguration) DeepCopyInto(out *CloudWatchAlarmsConfiguration) {
+	*out = *in
+	if in.Thresholds != nil {
+		in, out := &in.Thresholds, &out.Thresholds
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.AlarmActions != nil {
+		in, out := &in.AlarmActions, &out.AlarmActions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudWatchAlarmsConfiguration.
+func (in *CloudWatchAlarmsConfiguration) DeepCopy() *CloudWatchAlarmsConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudWatchAlarmsConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurationAncestorReference) DeepCopyInto(out *ConfigurationAncestorReference) {
+	*out = *in
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationAncestorReference.
+func (in *ConfigurationAncestorReference) DeepCopy() *ConfigurationAncestorReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurationAncestorReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurationAncestorStatus) DeepCopyInto(out *ConfigurationAncestorStatus) {
+	*out = *in
+	in.AncestorRef.DeepCopyInto(&out.AncestorRef)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationAncestorStatus.
+func (in *ConfigurationAncestorStatus) DeepCopy() *ConfigurationAncestorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurationAncestorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DefaultTargetGroupConfigurationReference) DeepCopyInto(out *DefaultTargetGroupConfigurationReference) {
 	*out = *in
@@ -418,6 +488,11 @@ func (in *ListenerConfiguration) DeepCopyInto(out *ListenerConfiguration) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.DefaultAction != nil {
+		in, out := &in.DefaultAction, &out.DefaultAction
+		*out = new(ListenerDefaultAction)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerConfiguration.
@@ -430,6 +505,96 @@ func (in *ListenerConfiguration) DeepCopy() *ListenerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerDefaultAction) DeepCopyInto(out *ListenerDefaultAction) {
+	*out = *in
+	if in.ForwardConfig != nil {
+		in, out := &in.ForwardConfig, &out.ForwardConfig
+		*out = new(ListenerDefaultForwardConfig)
+		**out = **in
+	}
+	if in.FixedResponseConfig != nil {
+		in, out := &in.FixedResponseConfig, &out.FixedResponseConfig
+		*out = new(FixedResponseActionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RedirectConfig != nil {
+		in, out := &in.RedirectConfig, &out.RedirectConfig
+		*out = new(ListenerDefaultRedirectConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerDefaultAction.
+func (in *ListenerDefaultAction) DeepCopy() *ListenerDefaultAction {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerDefaultAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerDefaultForwardConfig) DeepCopyInto(out *ListenerDefaultForwardConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerDefaultForwardConfig.
+func (in *ListenerDefaultForwardConfig) DeepCopy() *ListenerDefaultForwardConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerDefaultForwardConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerDefaultRedirectConfig) DeepCopyInto(out *ListenerDefaultRedirectConfig) {
+	*out = *in
+	if in.Scheme != nil {
+		in, out := &in.Scheme, &out.Scheme
+		*out = new(string)
+		**out = **in
+	}
+	if in.Hostname != nil {
+		in, out := &in.Hostname, &out.Hostname
+		*out = new(string)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.Query != nil {
+		in, out := &in.Query, &out.Query
+		*out = new(string)
+		**out = **in
+	}
+	if in.StatusCode != nil {
+		in, out := &in.StatusCode, &out.StatusCode
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerDefaultRedirectConfig.
+func (in *ListenerDefaultRedirectConfig) DeepCopy() *ListenerDefaultRedirectConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerDefaultRedirectConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ListenerRuleCondition) DeepCopyInto(out *ListenerRuleCondition) {
 	*out = *in
@@ -670,6 +835,11 @@ func (in *LoadBalancerConfigurationSpec) DeepCopyInto(out *LoadBalancerConfigura
 		*out = new(LoadBalancerConfigMergeMode)
 		**out = **in
 	}
+	if in.ShareLoadBalancer != nil {
+		in, out := &in.ShareLoadBalancer, &out.ShareLoadBalancer
+		*out = new(bool)
+		**out = **in
+	}
 	if in.LoadBalancerName != nil {
 		in, out := &in.LoadBalancerName, &out.LoadBalancerName
 		*out = new(string)
@@ -813,7 +983,22 @@ func (in *LoadBalancerConfigurationSpec) DeepCopyInto(out *LoadBalancerConfigura
 	if in.ShieldAdvanced != nil {
 		in, out := &in.ShieldAdvanced, &out.ShieldAdvanced
 		*out = new(ShieldConfiguration)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LoadBalancerLogs != nil {
+		in, out := &in.LoadBalancerLogs, &out.LoadBalancerLogs
+		*out = new(LoadBalancerLogsConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VPCEndpointService != nil {
+		in, out := &in.VPCEndpointService, &out.VPCEndpointService
+		*out = new(VPCEndpointServiceConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CloudWatchAlarms != nil {
+		in, out := &in.CloudWatchAlarms, &out.CloudWatchAlarms
+		*out = new(CloudWatchAlarmsConfiguration)
+		(*in).DeepCopyInto(*out)
 	}
 	if in.DefaultTargetGroupConfiguration != nil {
 		in, out := &in.DefaultTargetGroupConfiguration, &out.DefaultTargetGroupConfiguration
@@ -845,6 +1030,13 @@ func (in *LoadBalancerConfigurationStatus) DeepCopyInto(out *LoadBalancerConfigu
 		*out = new(int64)
 		**out = **in
 	}
+	if in.Ancestors != nil {
+		in, out := &in.Ancestors, &out.Ancestors
+		*out = make([]ConfigurationAncestorStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerConfigurationStatus.
@@ -857,6 +1049,36 @@ func (in *LoadBalancerConfigurationStatus) DeepCopy() *LoadBalancerConfiguration
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerLogsConfiguration) DeepCopyInto(out *LoadBalancerLogsConfiguration) {
+	*out = *in
+	if in.AccessLogs != nil {
+		in, out := &in.AccessLogs, &out.AccessLogs
+		*out = new(S3LogDeliveryConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConnectionLogs != nil {
+		in, out := &in.ConnectionLogs, &out.ConnectionLogs
+		*out = new(S3LogDeliveryConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HealthCheckLogs != nil {
+		in, out := &in.HealthCheckLogs, &out.HealthCheckLogs
+		*out = new(S3LogDeliveryConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerLogsConfiguration.
+func (in *LoadBalancerLogsConfiguration) DeepCopy() *LoadBalancerLogsConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerLogsConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MinimumLoadBalancerCapacity) DeepCopyInto(out *MinimumLoadBalancerCapacity) {
 	*out = *in
@@ -890,6 +1112,11 @@ func (in *MutualAuthenticationAttributes) DeepCopyInto(out *MutualAuthentication
 		*out = new(string)
 		**out = **in
 	}
+	if in.TrustStoreCABundle != nil {
+		in, out := &in.TrustStoreCABundle, &out.TrustStoreCABundle
+		*out = new(TrustStoreCABundleSource)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MutualAuthenticationAttributes.
@@ -979,6 +1206,41 @@ func (in *RouteIdentifier) DeepCopy() *RouteIdentifier {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3LogDeliveryConfiguration) DeepCopyInto(out *S3LogDeliveryConfiguration) {
+	*out = *in
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.BucketPolicy != nil {
+		in, out := &in.BucketPolicy, &out.BucketPolicy
+		*out = new(LogBucketPolicyMode)
+		**out = **in
+	}
+	if in.CreateBucket != nil {
+		in, out := &in.CreateBucket, &out.CreateBucket
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExpirationDays != nil {
+		in, out := &in.ExpirationDays, &out.ExpirationDays
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3LogDeliveryConfiguration.
+func (in *S3LogDeliveryConfiguration) DeepCopy() *S3LogDeliveryConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(S3LogDeliveryConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Secret) DeepCopyInto(out *Secret) {
 	*out = *in
@@ -994,9 +1256,39 @@ func (in *Secret) DeepCopy() *Secret {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShieldApplicationLayerAutomaticResponse) DeepCopyInto(out *ShieldApplicationLayerAutomaticResponse) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShieldApplicationLayerAutomaticResponse.
+func (in *ShieldApplicationLayerAutomaticResponse) DeepCopy() *ShieldApplicationLayerAutomaticResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(ShieldApplicationLayerAutomaticResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShieldConfiguration) DeepCopyInto(out *ShieldConfiguration) {
 	*out = *in
+	if in.HealthCheckIDs != nil {
+		in, out := &in.HealthCheckIDs, &out.HealthCheckIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ApplicationLayerAutomaticResponse != nil {
+		in, out := &in.ApplicationLayerAutomaticResponse, &out.ApplicationLayerAutomaticResponse
+		*out = new(ShieldApplicationLayerAutomaticResponse)
+		**out = **in
+	}
+	if in.ProtectionGroups != nil {
+		in, out := &in.ProtectionGroups, &out.ProtectionGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShieldConfiguration.
@@ -1179,6 +1471,13 @@ func (in *TargetGroupConfigurationStatus) DeepCopyInto(out *TargetGroupConfigura
 		*out = new(int64)
 		**out = **in
 	}
+	if in.Ancestors != nil {
+		in, out := &in.Ancestors, &out.Ancestors
+		*out = make([]ConfigurationAncestorStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupConfigurationStatus.
@@ -1244,6 +1543,13 @@ func (in *TargetGroupProps) DeepCopyInto(out *TargetGroupProps) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.CloudWatchAlarmThresholds != nil {
+		in, out := &in.CloudWatchAlarmThresholds, &out.CloudWatchAlarmThresholds
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = new(map[string]string)
@@ -1292,6 +1598,66 @@ func (in *TargetGroupStickinessConfig) DeepCopy() *TargetGroupStickinessConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustStoreCABundleSource) DeepCopyInto(out *TrustStoreCABundleSource) {
+	*out = *in
+	if in.Kind != nil {
+		in, out := &in.Kind, &out.Kind
+		*out = new(TrustStoreCABundleKind)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.RevocationListKeys != nil {
+		in, out := &in.RevocationListKeys, &out.RevocationListKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustStoreCABundleSource.
+func (in *TrustStoreCABundleSource) DeepCopy() *TrustStoreCABundleSource {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustStoreCABundleSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpointServiceConfiguration) DeepCopyInto(out *VPCEndpointServiceConfiguration) {
+	*out = *in
+	if in.AllowedPrincipals != nil {
+		in, out := &in.AllowedPrincipals, &out.AllowedPrincipals
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AcceptanceRequired != nil {
+		in, out := &in.AcceptanceRequired, &out.AcceptanceRequired
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PrivateDNSName != nil {
+		in, out := &in.PrivateDNSName, &out.PrivateDNSName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpointServiceConfiguration.
+func (in *VPCEndpointServiceConfiguration) DeepCopy() *VPCEndpointServiceConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEndpointServiceConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WAFv2Configuration) DeepCopyInto(out *WAFv2Configuration) {
 	*out = *in
@@ -117,9 +117,39 @@ const (
 	MutualAuthenticationVerifyMode      MutualAuthenticationMode = "verify"
 )
 
+// +kubebuilder:validation:Enum=Secret;ConfigMap
+// TrustStoreCABundleKind is the kind of object holding a trust store CA bundle.
+type TrustStoreCABundleKind string
+
+// Supported kinds of objects holding a trust store CA bundle
+const (
+	TrustStoreCABundleKindSecret    TrustStoreCABundleKind = "Secret"
+	TrustStoreCABundleKindConfigMap TrustStoreCABundleKind = "ConfigMap"
+)
+
+// TrustStoreCABundleSource references a CA bundle in a Secret or ConfigMap in the Gateway namespace, the controller manages a trust store from it.
+type TrustStoreCABundleSource struct {
+	// The kind of object holding the CA bundle. Defaults to Secret.
+	// +optional
+	Kind *TrustStoreCABundleKind `json:"kind,omitempty"`
+
+	// The name of the object holding the CA bundle.
+	Name string `json:"name"`
+
+	// The key of the PEM encoded CA bundle within the object. Defaults to ca.crt.
+	// +optional
+	Key *string `json:"key,omitempty"`
+
+	// The keys of PEM encoded certificate revocation lists within the object.
+	// +optional
+	RevocationListKeys []string `json:"revocationListKeys,omitempty"`
+}
+
 // Information about the mutual authentication attributes of a listener.
-// +kubebuilder:validation:XValidation:rule="!(self.mode == 'verify' && !has(self.trustStore))",message="trustStore is required when mutualAuthentication mode is 'verify'"
+// +kubebuilder:validation:XValidation:rule="!(self.mode == 'verify' && !has(self.trustStore) && !has(self.trustStoreCABundle))",message="trustStore or trustStoreCABundle is required when mutualAuthentication mode is 'verify'"
+// +kubebuilder:validation:XValidation:rule="!(has(self.trustStore) && has(self.trustStoreCABundle))",message="trustStore and trustStoreCABundle are mutually exclusive"
 // +kubebuilder:validation:XValidation:rule="!(self.mode != 'verify' && has(self.trustStore))",message="Mutual Authentication mode 'off' or 'passthrough' does not support 'trustStore'"
+// +kubebuilder:validation:XValidation:rule="!(self.mode != 'verify' && has(self.trustStoreCABundle))",message="Mutual Authentication mode 'off' or 'passthrough' does not support 'trustStoreCABundle'"
 // +kubebuilder:validation:XValidation:rule="!(self.mode != 'verify' && has(self.ignoreClientCertificateExpiry))",message="Mutual Authentication mode 'off' or 'passthrough' does not support 'ignoreClientCertificateExpiry'"
 // +kubebuilder:validation:XValidation:rule="!(self.mode != 'verify' && has(self.advertiseTrustStoreCaNames))",message="Mutual Authentication mode 'off' or 'passthrough' does not support 'advertiseTrustStoreCaNames'"
 type MutualAuthenticationAttributes struct {
@@ -138,12 +168,129 @@ type MutualAuthenticationAttributes struct {
 	// The Name or ARN of the trust store.
 	// +optional
 	TrustStore *string `json:"trustStore,omitempty"`
+
+	// The CA bundle of a trust store managed by the controller.
+	// +optional
+	TrustStoreCABundle *TrustStoreCABundleSource `json:"trustStoreCABundle,omitempty"`
 }
 
 // ShieldConfiguration configuration parameters used to configure Shield
 type ShieldConfiguration struct {
 	// Enabled whether Shield Advanced should be configured with the Gateway
 	Enabled bool `json:"enabled,omitempty"`
+
+	// healthCheckIds are the Route53 health check IDs associated with the Shield protection for health-based detection.
+	// When specified, the associated health checks are reconciled to match this list.
+	// +optional
+	HealthCheckIDs []string `json:"healthCheckIds,omitempty"`
+
+	// applicationLayerAutomaticResponse configures Shield Advanced application layer automatic mitigation.
+	// +optional
+	ApplicationLayerAutomaticResponse *ShieldApplicationLayerAutomaticResponse `json:"applicationLayerAutomaticResponse,omitempty"`
+
+	// protectionGroups are the IDs of the Shield protection groups the load balancer is a member of.
+	// Missing protection groups are created with the load balancer as their only member.
+	// When specified, the load balancer is removed from any other protection group with an arbitrary pattern.
+	// +optional
+	ProtectionGroups []string `json:"protectionGroups,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Block;Count;Disabled
+// ShieldApplicationLayerAutomaticResponseAction is the WAF rule action used by Shield Advanced automatic mitigation.
+type ShieldApplicationLayerAutomaticResponseAction string
+
+const (
+	ShieldApplicationLayerAutomaticResponseActionBlock    ShieldApplicationLayerAutomaticResponseAction = "Block"
+	ShieldApplicationLayerAutomaticResponseActionCount    ShieldApplicationLayerAutomaticResponseAction = "Count"
+	ShieldApplicationLayerAutomaticResponseActionDisabled ShieldApplicationLayerAutomaticResponseAction = "Disabled"
+)
+
+// ShieldApplicationLayerAutomaticResponse configuration parameters used to configure Shield Advanced automatic mitigation
+type ShieldApplicationLayerAutomaticResponse struct {
+	// action is the WAF rule action used for automatic mitigation, or Disabled to turn automatic mitigation off.
+	// Automatic mitigation requires a WAF web ACL associated with the load balancer.
+	Action ShieldApplicationLayerAutomaticResponseAction `json:"action"`
+}
+
+// LoadBalancerLogsConfiguration configuration parameters used to deliver LoadBalancer logs to S3 buckets
+type LoadBalancerLogsConfiguration struct {
+	// accessLogs configures the delivery of access logs.
+	// +optional
+	AccessLogs *S3LogDeliveryConfiguration `json:"accessLogs,omitempty"`
+
+	// connectionLogs configures the delivery of connection logs. Only applies to Application LoadBalancer Gateways.
+	// +optional
+	ConnectionLogs *S3LogDeliveryConfiguration `json:"connectionLogs,omitempty"`
+
+	// healthCheckLogs configures the delivery of health check logs. Only applies to Application LoadBalancer Gateways.
+	// +optional
+	HealthCheckLogs *S3LogDeliveryConfiguration `json:"healthCheckLogs,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Patch;Validate
+// LogBucketPolicyMode defines how the controller makes sure a bucket policy grants log delivery.
+type LogBucketPolicyMode string
+
+const (
+	// LogBucketPolicyModePatch adds the statements granting log delivery that are missing from the bucket policy.
+	LogBucketPolicyModePatch LogBucketPolicyMode = "Patch"
+	// LogBucketPolicyModeValidate reports an error when the bucket policy doesn't grant log delivery.
+	LogBucketPolicyModeValidate LogBucketPolicyMode = "Validate"
+)
+
+// S3LogDeliveryConfiguration configuration parameters used to deliver one type of LoadBalancer logs to a S3 bucket
+type S3LogDeliveryConfiguration struct {
+	// bucket is the name of the S3 bucket that receives the logs. The bucket must be in the region of the LoadBalancer.
+	// +kubebuilder:validation:MinLength=3
+	// +kubebuilder:validation:MaxLength=63
+	Bucket string `json:"bucket"`
+
+	// prefix is the prefix that logs are delivered under.
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+
+	// bucketPolicy defines whether the controller patches the bucket policy to grant log delivery, or only validates it.
+	// Defaults to Patch.
+	// +optional
+	BucketPolicy *LogBucketPolicyMode `json:"bucketPolicy,omitempty"`
+
+	// createBucket defines whether the controller creates the bucket if it doesn't exist.
+	// The controller never deletes buckets.
+	// +optional
+	CreateBucket *bool `json:"createBucket,omitempty"`
+
+	// expirationDays is the number of days after which log objects expire, applied by a lifecycle rule when the controller creates the bucket.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ExpirationDays *int32 `json:"expirationDays,omitempty"`
+}
+
+// VPCEndpointServiceConfiguration configuration parameters used to expose the Gateway through a VPC endpoint service (AWS PrivateLink)
+type VPCEndpointServiceConfiguration struct {
+	// allowedPrincipals is the list of ARNs of the principals allowed to discover and connect to the endpoint service.
+	// connection requests from the accounts of these principals are accepted automatically.
+	// +optional
+	AllowedPrincipals []string `json:"allowedPrincipals,omitempty"`
+
+	// acceptanceRequired specifies whether connection requests to the endpoint service must be accepted.
+	// +optional
+	AcceptanceRequired *bool `json:"acceptanceRequired,omitempty"`
+
+	// privateDNSName is the private DNS name to assign to the endpoint service.
+	// +optional
+	PrivateDNSName *string `json:"privateDNSName,omitempty"`
+}
+
+// CloudWatchAlarmsConfiguration configuration parameters used to provision CloudWatch alarms for the load balancer and its target groups
+type CloudWatchAlarmsConfiguration struct {
+	// thresholds is the map of metric name to alarm threshold, an alarm is provisioned for each metric with a threshold.
+	// Supported metrics are UnHealthyHostCount, HTTPCode_ELB_5XX_Count and TargetResponseTime.
+	// +optional
+	Thresholds map[string]string `json:"thresholds,omitempty"`
+
+	// alarmActions is the list of ARNs of the actions (e.g. SNS topics) to execute when an alarm transitions into ALARM state.
+	// +optional
+	AlarmActions []string `json:"alarmActions,omitempty"`
 }
 
 // WAFv2Configuration configuration parameters used to configure WAFv2
@@ -290,6 +437,18 @@ type LoadBalancerConfigurationSpec struct {
 	// +optional
 	ShieldAdvanced *ShieldConfiguration `json:"shieldConfiguration,omitempty"`
 
+	// loadBalancerLogs define the delivery of LoadBalancer logs to S3 buckets.
+	// +optional
+	LoadBalancerLogs *LoadBalancerLogsConfiguration `json:"loadBalancerLogs,omitempty"`
+
+	// vpcEndpointService define the VPC endpoint service (AWS PrivateLink) settings for a Gateway [Network Load Balancer]
+	// +optional
+	VPCEndpointService *VPCEndpointServiceConfiguration `json:"vpcEndpointService,omitempty"`
+
+	// cloudWatchAlarms define the CloudWatch alarms provisioned for the Gateway's load balancer and target groups
+	// +optional
+	CloudWatchAlarms *CloudWatchAlarmsConfiguration `json:"cloudWatchAlarms,omitempty"`
+
 	// defaultTargetGroupConfiguration references a TargetGroupConfiguration by name in the same namespace as this LoadBalancerConfiguration.
 	// The referenced TGC provides default target group properties for all Service backends attached to the Gateway.
 	// Service-level TGCs override these defaults on a per-field basis.
@@ -227,6 +227,11 @@ type TargetGroupProps struct {
 	// +optional
 	EnableMultiCluster *bool `json:"enableMultiCluster,omitempty"`
 
+	// cloudWatchAlarmThresholds [Application / Network LoadBalancer] overrides the CloudWatch alarm thresholds
+	// of the LoadBalancerConfiguration for this target group, keyed by metric name.
+	// +optional
+	CloudWatchAlarmThresholds map[string]string `json:"cloudWatchAlarmThresholds,omitempty"`
+
 	// Tags the Tags to add on the target group.
 	// +optional
 	Tags *map[string]string `json:"tags,omitempty"`
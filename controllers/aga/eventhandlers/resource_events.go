@@ -6,6 +6,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	networking "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/util/workqueue"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aga"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -29,7 +30,7 @@ func NewEnqueueRequestsForResourceEvent(
 }
 
 // enqueueRequestsForResourceEvent handles resource events and enqueues reconcile requests for GlobalAccelerators
-// that reference the resource
+// that reference the resource by name or select it with a label-selector endpoint
 type enqueueRequestsForResourceEvent struct {
 	resourceType     aga.ResourceType
 	referenceTracker *aga.ReferenceTracker
@@ -61,18 +62,22 @@ func (h *enqueueRequestsForResourceEvent) Generic(ctx context.Context, evt event
 // handleTypedResource handles resource events for the typed interface
 func (h *enqueueRequestsForResourceEvent) handleResource(_ context.Context, obj interface{}, eventType string, queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
 	var namespace, name string
+	var resourceLabels map[string]string
 
 	// Extract namespace and name based on the object type
 	switch res := obj.(type) {
 	case *corev1.Service:
 		namespace = res.Namespace
 		name = res.Name
+		resourceLabels = res.Labels
 	case *networking.Ingress:
 		namespace = res.Namespace
 		name = res.Name
+		resourceLabels = res.Labels
 	case *gwv1.Gateway:
 		namespace = res.Namespace
 		name = res.Name
+		resourceLabels = res.Labels
 	default:
 		h.logger.Error(nil, "Unknown resource type", "type", h.resourceType)
 		return
@@ -86,16 +91,15 @@ func (h *enqueueRequestsForResourceEvent) handleResource(_ context.Context, obj
 		},
 	}
 
-	// If this resource is not referenced by any GA, no need to queue reconciles
-	if !h.referenceTracker.IsResourceReferenced(resourceKey) {
-		return
+	// Get all GAs that reference this resource by name or select it by labels
+	gaRefs := sets.New[types.NamespacedName]()
+	if h.referenceTracker.IsResourceReferenced(resourceKey) {
+		gaRefs.Insert(h.referenceTracker.GetGAsForResource(resourceKey)...)
 	}
-
-	// Get all GAs that reference this resource
-	gaRefs := h.referenceTracker.GetGAsForResource(resourceKey)
+	gaRefs.Insert(h.referenceTracker.GetGAsSelectingResource(h.resourceType, namespace, resourceLabels)...)
 
 	// Queue reconcile for affected GAs
-	for _, gaRef := range gaRefs {
+	for gaRef := range gaRefs {
 		h.logger.V(1).Info("Enqueueing GA for reconcile due to resource event",
 			"resourceType", h.resourceType,
 			"resourceName", resourceKey.Name,
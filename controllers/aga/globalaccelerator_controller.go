@@ -71,6 +71,11 @@ const (
 	requeueReasonEndpointsInWarningState = "Retrying endpoints for Global Accelerator %s  which did load successfully - will check availability again soon"
 	statusUpdateRequeueTime              = 1 * time.Minute
 
+	// requeueReasonFederatedEndpointsResync indicates that the reconciliation is being requeued because
+	// load balancers published to Federated endpoints by other clusters are not watched and need to be periodically rediscovered
+	requeueReasonFederatedEndpointsResync = "Rediscovering federated endpoints for Global Accelerator %s"
+	federatedEndpointsResyncTime          = 5 * time.Minute
+
 	// Metric stage constants
 	MetricStageFetchGlobalAccelerator     = "fetch_globalAccelerator"
 	MetricStageAddFinalizers              = "add_finalizers"
@@ -129,6 +134,8 @@ func NewGlobalAcceleratorReconciler(k8sClient client.Client, eventRecorder recor
 	endpointLoader := aga.NewEndpointLoader(k8sClient, dnsToLoadBalancerResolver, logger.WithName("endpoint-loader"))
 	return &globalAcceleratorReconciler{
 		k8sClient:        k8sClient,
+		rgt:              cloud.RGT(),
+		clusterRegion:    cloud.Region(),
 		eventRecorder:    eventRecorder,
 		finalizerManager: finalizerManager,
 		logger:           logger,
@@ -154,6 +161,8 @@ func NewGlobalAcceleratorReconciler(k8sClient client.Client, eventRecorder recor
 // globalAcceleratorReconciler reconciles a GlobalAccelerator object
 type globalAcceleratorReconciler struct {
 	k8sClient        client.Client
+	rgt              services.RGT
+	clusterRegion    string
 	eventRecorder    record.EventRecorder
 	finalizerManager k8s.FinalizerManager
 	modelBuilder     aga.ModelBuilder
@@ -275,14 +284,38 @@ func (r *globalAcceleratorReconciler) buildModel(ctx context.Context, ga *agaapi
 func (r *globalAcceleratorReconciler) reconcileGlobalAcceleratorResources(ctx context.Context, ga *agaapi.GlobalAccelerator) error {
 	r.logger.Info("Reconciling GlobalAccelerator resources", "globalAccelerator", k8s.NamespacedName(ga))
 
+	// Resolve label-selector endpoints into endpoints referencing the selected resources by name
+	resolvedGA, selectorRefs, err := aga.ResolveSelectorEndpoints(ctx, r.k8sClient, ga, r.logger)
+	if err != nil {
+		r.eventRecorder.Event(ga, corev1.EventTypeWarning, k8s.GlobalAcceleratorEventReasonFailedEndpointLoad, fmt.Sprintf("Failed to reconcile due to %v", err))
+		if statusErr := r.statusUpdater.UpdateStatusFailure(ctx, ga, agadeploy.EndpointLoadFailed, err.Error()); statusErr != nil {
+			r.logger.Error(statusErr, "Failed to update GlobalAccelerator status after endpoint selector resolution failure")
+		}
+		return err
+	}
+
+	// Track label-selector endpoints and watch the resources they select
+	r.referenceTracker.UpdateSelectorReferencesForGA(ga, selectorRefs)
+	r.endpointResourcesManager.MonitorSelectorResources(ga, selectorRefs)
+
+	// Resolve Federated endpoints into the load balancers published to their federation group
+	resolvedGA, hasFederatedEndpoints, err := aga.ResolveFederatedEndpoints(ctx, r.rgt, resolvedGA, r.clusterRegion, r.logger)
+	if err != nil {
+		r.eventRecorder.Event(ga, corev1.EventTypeWarning, k8s.GlobalAcceleratorEventReasonFailedEndpointLoad, fmt.Sprintf("Failed to reconcile due to %v", err))
+		if statusErr := r.statusUpdater.UpdateStatusFailure(ctx, ga, agadeploy.EndpointLoadFailed, err.Error()); statusErr != nil {
+			r.logger.Error(statusErr, "Failed to update GlobalAccelerator status after federated endpoint resolution failure")
+		}
+		return err
+	}
+
 	// Get all desired endpoints from GA
-	endpoints := aga.GetAllDesiredEndpointsFromGA(ga)
+	endpoints := aga.GetAllDesiredEndpointsFromGA(resolvedGA)
 
 	// Track referenced endpoints
 	r.referenceTracker.UpdateDesiredEndpointReferencesForGA(ga, endpoints)
 
 	// Validate and load endpoint status using the endpoint loader
-	loadedEndpoints, fatalErrors := r.endpointLoader.LoadEndpoints(ctx, ga, endpoints)
+	loadedEndpoints, fatalErrors := r.endpointLoader.LoadEndpoints(ctx, resolvedGA, endpoints)
 
 	if len(fatalErrors) > 0 {
 		err := fmt.Errorf("failed to load endpoints: %v", fatalErrors[0])
@@ -301,9 +334,8 @@ func (r *globalAcceleratorReconciler) reconcileGlobalAcceleratorResources(ctx co
 
 	var stack core.Stack
 	var accelerator *agamodel.Accelerator
-	var err error
 	buildModelFn := func() {
-		stack, accelerator, err = r.buildModel(ctx, ga, loadedEndpoints)
+		stack, accelerator, err = r.buildModel(ctx, resolvedGA, loadedEndpoints)
 	}
 	r.metricsCollector.ObserveControllerReconcileLatency(controllerName, MetricStageBuildModel, buildModelFn)
 	if err != nil {
@@ -369,6 +401,11 @@ func (r *globalAcceleratorReconciler) reconcileGlobalAcceleratorResources(ctx co
 
 	r.eventRecorder.Event(ga, corev1.EventTypeNormal, k8s.GlobalAcceleratorEventReasonSuccessfullyReconciled, "Successfully reconciled")
 
+	// Load balancers published by other clusters are not watched, so rediscover them periodically
+	if hasFederatedEndpoints {
+		return ctrlerrors.NewRequeueNeededAfter(fmt.Sprintf(requeueReasonFederatedEndpointsResync, k8s.NamespacedName(ga)), federatedEndpointsResyncTime)
+	}
+
 	return nil
 }
 
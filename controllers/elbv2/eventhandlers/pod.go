@@ -76,6 +76,21 @@ func (h *enqueueRequestsForPodEvent) enqueueImpactedTargetGroupBindings(_ contex
 			}
 		}
 	}
+	for _, finalizer := range pod.Finalizers {
+		tgbName := parseTargetGroupBindingName(finalizer, targetgroupbinding.PodDrainFinalizerPrefix)
+		if tgbName == "" {
+			continue
+		}
+		tgb := types.NamespacedName{
+			Namespace: pod.Key.Namespace,
+			Name:      tgbName,
+		}
+
+		h.logger.V(1).Info("enqueue targetGroupBinding for pod event", "pod", pod.Key.Name, "targetGroupBinding", tgb)
+		queue.Add(reconcile.Request{
+			NamespacedName: tgb,
+		})
+	}
 }
 
 // parseTargetGroupBindingName extracts the TargetGroupBinding name from a
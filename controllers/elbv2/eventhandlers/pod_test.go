@@ -56,6 +56,26 @@ func Test_enqueueRequestsForPodEvent_enqueueImpactedTargetGroupBindings(t *testi
 				},
 			},
 		},
+		{
+			name: "pod event should enqueue TGBs used as pod drain finalizers",
+			args: args{
+				pod: &k8s.PodInfo{
+					Key: types.NamespacedName{
+						Namespace: "awesome-ns",
+						Name:      "awesome-pod",
+					},
+					Finalizers: []string{
+						"pod-drain.elbv2.k8s.aws/tgb-1",
+						"ignored-prefix/tgb-2",
+					},
+				},
+			},
+			wantRequests: []reconcile.Request{
+				{
+					NamespacedName: types.NamespacedName{Namespace: "awesome-ns", Name: "tgb-1"},
+				},
+			},
+		},
 		{
 			name: "pod event without matching readiness gates are ignored",
 			args: args{
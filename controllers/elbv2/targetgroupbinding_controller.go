@@ -29,7 +29,10 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/controllers/elbv2/eventhandlers"
@@ -37,6 +40,7 @@ import (
 	ctrlerrors "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/error"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/k8s"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/runtime"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/shard"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/targetgroupbinding"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 
@@ -56,15 +60,17 @@ const (
 
 // NewTargetGroupBindingReconciler constructs new targetGroupBindingReconciler
 func NewTargetGroupBindingReconciler(k8sClient client.Client, eventRecorder record.EventRecorder, finalizerManager k8s.FinalizerManager,
-	tgbResourceManager targetgroupbinding.ResourceManager, config config.ControllerConfig, deferredTargetGroupBindingReconciler DeferredTargetGroupBindingReconciler,
-	logger logr.Logger, metricsCollector lbcmetrics.MetricCollector, reconcileCounters *metricsutil.ReconcileCounters, podInformer cache.Informer) *targetGroupBindingReconciler {
+	tgbResourceManager targetgroupbinding.ResourceManager, multiClusterManager targetgroupbinding.MultiClusterManager, config config.ControllerConfig, deferredTargetGroupBindingReconciler DeferredTargetGroupBindingReconciler,
+	shardManager shard.Manager, logger logr.Logger, metricsCollector lbcmetrics.MetricCollector, reconcileCounters *metricsutil.ReconcileCounters, podInformer cache.Informer) *targetGroupBindingReconciler {
 
 	return &targetGroupBindingReconciler{
 		k8sClient:                            k8sClient,
 		eventRecorder:                        eventRecorder,
 		finalizerManager:                     finalizerManager,
 		tgbResourceManager:                   tgbResourceManager,
+		multiClusterManager:                  multiClusterManager,
 		deferredTargetGroupBindingReconciler: deferredTargetGroupBindingReconciler,
+		shardManager:                         shardManager,
 		logger:                               logger,
 		metricsCollector:                     metricsCollector,
 		reconcileCounters:                    reconcileCounters,
@@ -82,7 +88,9 @@ type targetGroupBindingReconciler struct {
 	eventRecorder                        record.EventRecorder
 	finalizerManager                     k8s.FinalizerManager
 	tgbResourceManager                   targetgroupbinding.ResourceManager
+	multiClusterManager                  targetgroupbinding.MultiClusterManager
 	deferredTargetGroupBindingReconciler DeferredTargetGroupBindingReconciler
+	shardManager                         shard.Manager
 	logger                               logr.Logger
 	metricsCollector                     lbcmetrics.MetricCollector
 	reconcileCounters                    *metricsutil.ReconcileCounters
@@ -95,7 +103,7 @@ type targetGroupBindingReconciler struct {
 
 // +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=targetgroupbindings,verbs=get;list;watch;update;patch;create;delete
 // +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=targetgroupbindings/status,verbs=update;patch
-// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;patch
 // +kubebuilder:rbac:groups="",resources=pods/status,verbs=update;patch
 // +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=endpoints,verbs=get;list;watch
@@ -105,6 +113,18 @@ type targetGroupBindingReconciler struct {
 // +kubebuilder:rbac:groups="discovery.k8s.io",resources=endpointslices,verbs=get;list;watch
 
 func (r *targetGroupBindingReconciler) Reconcile(ctx context.Context, req reconcile.Request) (ctrl.Result, error) {
+	release, owned, err := r.shardManager.Acquire(ctx, controllerName, req.NamespacedName)
+	if err != nil {
+		return runtime.HandleReconcileError(err, r.logger)
+	}
+	if !owned {
+		// securityGroup rules are shared across TargetGroupBindings, so the global shard reconciles them for all shards.
+		if r.shardManager.IsGlobalShard() {
+			return runtime.HandleReconcileError(r.reconcileNetworking(ctx, req), r.logger)
+		}
+		return ctrl.Result{}, nil
+	}
+	defer release()
 	r.reconcileCounters.IncrementTGB(req.NamespacedName)
 	r.logger.V(1).Info("Reconcile request", "name", req.Name)
 	return runtime.HandleReconcileError(r.reconcile(ctx, req), r.logger)
@@ -118,7 +138,14 @@ func (r *targetGroupBindingReconciler) reconcile(ctx context.Context, req reconc
 	}
 	r.metricsCollector.ObserveControllerReconcileLatency(controllerName, "fetch_targetGroupBinding", fetchTargetGroupBindingFn)
 	if err != nil {
-		return client.IgnoreNotFound(err)
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		// pods can still be held by the pod drain finalizer of a TargetGroupBinding that was deleted without cleanup,
+		// e.g. when its finalizer was removed while the controller was down.
+		tgb.Namespace = req.Namespace
+		tgb.Name = req.Name
+		return r.tgbResourceManager.ReleasePods(ctx, tgb)
 	}
 
 	if !tgb.DeletionTimestamp.IsZero() {
@@ -127,6 +154,23 @@ func (r *targetGroupBindingReconciler) reconcile(ctx context.Context, req reconc
 	return r.reconcileTargetGroupBinding(ctx, tgb)
 }
 
+// reconcileNetworking reconciles the networking of a TargetGroupBinding owned by another shard.
+func (r *targetGroupBindingReconciler) reconcileNetworking(ctx context.Context, req reconcile.Request) error {
+	tgb := &elbv2api.TargetGroupBinding{}
+	if err := r.k8sClient.Get(ctx, req.NamespacedName, tgb); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		tgb.Namespace = req.Namespace
+		tgb.Name = req.Name
+		return r.tgbResourceManager.CleanupNetworking(ctx, tgb)
+	}
+	if !tgb.DeletionTimestamp.IsZero() {
+		return r.tgbResourceManager.CleanupNetworking(ctx, tgb)
+	}
+	return r.tgbResourceManager.ReconcileNetworking(ctx, tgb)
+}
+
 func (r *targetGroupBindingReconciler) reconcileTargetGroupBinding(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
 	var err error
 	finalizerFn := func() {
@@ -188,13 +232,16 @@ func (r *targetGroupBindingReconciler) cleanupTargetGroupBinding(ctx context.Con
 }
 
 func (r *targetGroupBindingReconciler) updateTargetGroupBindingStatus(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
-	if aws.ToInt64(tgb.Status.ObservedGeneration) == tgb.Generation {
+	ownedTargetCount := r.multiClusterManager.GetOwnedTargetCount(tgb)
+	if aws.ToInt64(tgb.Status.ObservedGeneration) == tgb.Generation &&
+		equality.Semantic.DeepEqual(tgb.Status.OwnedTargetCount, ownedTargetCount) {
 		return nil
 	}
 
 	tgbOld := tgb.DeepCopy()
 
 	tgb.Status.ObservedGeneration = aws.Int64(tgb.Generation)
+	tgb.Status.OwnedTargetCount = ownedTargetCount
 	if err := r.k8sClient.Status().Patch(ctx, tgb, client.MergeFrom(tgbOld)); err != nil {
 		return errors.Wrapf(err, "failed to update targetGroupBinding status: %v", k8s.NamespacedName(tgb))
 	}
@@ -272,19 +319,42 @@ func (r *targetGroupBindingReconciler) SetupWithManager(ctx context.Context, mgr
 			r.logger.WithName("eventHandlers").WithName("endpoints"))
 	}
 
-	return ctrl.NewControllerManagedBy(mgr).
+	builder := ctrl.NewControllerManagedBy(mgr).
 		For(&elbv2api.TargetGroupBinding{}).
 		Named(controllerName).
 		Watches(&corev1.Service{}, svcEventHandler).
 		Watches(clientObj, eventHandler).
 		Watches(&corev1.Node{}, nodeEventsHandler).
-		WatchesRawSource(&k8s.TypedInformer[*k8s.PodInfo]{Informer: r.podInformer, Handler: podEventHandler}).
+		WatchesRawSource(&k8s.TypedInformer[*k8s.PodInfo]{Informer: r.podInformer, Handler: podEventHandler})
+	if r.shardManager.Enabled() {
+		builder = builder.WatchesRawSource(shard.NewResyncSource(r.shardManager, r.listTargetGroupBindingRequests,
+			r.handlesTargetGroupBinding, r.logger.WithName("shardResync")))
+	}
+	return builder.
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: r.maxConcurrentReconciles,
+			NeedLeaderElection:      aws.Bool(!r.shardManager.Enabled()),
 			RateLimiter:             workqueue.NewTypedItemExponentialFailureRateLimiter[reconcile.Request](5*time.Millisecond, r.maxExponentialBackoffDelay)}).
 		Complete(r)
 }
 
+// handlesTargetGroupBinding returns whether this replica reconciles the TargetGroupBinding, either fully or only its networking.
+func (r *targetGroupBindingReconciler) handlesTargetGroupBinding(key types.NamespacedName) bool {
+	return r.shardManager.Owns(key) || r.shardManager.IsGlobalShard()
+}
+
+func (r *targetGroupBindingReconciler) listTargetGroupBindingRequests(ctx context.Context) ([]reconcile.Request, error) {
+	tgbList := &elbv2api.TargetGroupBindingList{}
+	if err := r.k8sClient.List(ctx, tgbList); err != nil {
+		return nil, err
+	}
+	requests := make([]reconcile.Request, 0, len(tgbList.Items))
+	for _, tgb := range tgbList.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: k8s.NamespacedName(&tgb)})
+	}
+	return requests, nil
+}
+
 func (r *targetGroupBindingReconciler) setupIndexes(ctx context.Context, fieldIndexer client.FieldIndexer) error {
 	if err := fieldIndexer.IndexField(ctx, &elbv2api.TargetGroupBinding{},
 		targetgroupbinding.IndexKeyServiceRefName, targetgroupbinding.IndexFuncServiceRefName); err != nil {
@@ -34,6 +34,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/shard"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/targetgroupbinding"
 )
 
@@ -50,6 +51,11 @@ type mockMetricCollector struct{}
 func (m *mockMetricCollector) ObservePodReadinessGateReady(namespace string, tgbName string, duration time.Duration) {
 }
 func (m *mockMetricCollector) ObserveQUICTargetMissingServerId(namespace string, tgbName string) {}
+func (m *mockMetricCollector) ObserveTargetsInUncoveredZones(namespace string, tgbName string, count int) {
+}
+func (m *mockMetricCollector) ResetTargetsInUncoveredZones(namespace string, tgbName string) {}
+func (m *mockMetricCollector) ObservePodDrainDuration(namespace string, tgbName string, duration time.Duration) {
+}
 func (m *mockMetricCollector) ObserveControllerReconcileError(controller string, errorType string) {
 }
 func (m *mockMetricCollector) ObserveControllerReconcileLatency(controller string, stage string, fn func()) {
@@ -98,7 +104,9 @@ func TestTargetGroupBindingReconciler_Delete_Stuck(t *testing.T) {
 		eventRecorder:                        record.NewFakeRecorder(10),
 		finalizerManager:                     mockFinalizerManager,
 		tgbResourceManager:                   mockResMgr,
+		multiClusterManager:                  targetgroupbinding.NewMultiClusterManager(k8sClient, k8sClient, log.Log),
 		deferredTargetGroupBindingReconciler: &mockDeferredReconciler{},
+		shardManager:                         shard.NewNoopManager(),
 		logger:                               log.Log.WithName("controllers").WithName("TargetGroupBinding"),
 		metricsCollector:                     &mockMetricCollector{},
 		reconcileCounters:                    metricsutil.NewReconcileCounters(),
@@ -167,7 +175,9 @@ func TestTargetGroupBindingReconciler_Reconcile_FailedReconcile(t *testing.T) {
 		eventRecorder:                        fakeRecorder,
 		finalizerManager:                     mockFinalizerManager,
 		tgbResourceManager:                   mockResMgr,
+		multiClusterManager:                  targetgroupbinding.NewMultiClusterManager(k8sClient, k8sClient, log.Log),
 		deferredTargetGroupBindingReconciler: &mockDeferredReconciler{},
+		shardManager:                         shard.NewNoopManager(),
 		logger:                               log.Log.WithName("controllers").WithName("TargetGroupBinding"),
 		metricsCollector:                     &mockMetricCollector{},
 		reconcileCounters:                    metricsutil.NewReconcileCounters(),
@@ -231,7 +241,9 @@ func TestTargetGroupBindingReconciler_Reconcile_Success(t *testing.T) {
 		eventRecorder:                        fakeRecorder,
 		finalizerManager:                     mockFinalizerManager,
 		tgbResourceManager:                   mockResMgr,
+		multiClusterManager:                  targetgroupbinding.NewMultiClusterManager(k8sClient, k8sClient, log.Log),
 		deferredTargetGroupBindingReconciler: &mockDeferredReconciler{},
+		shardManager:                         shard.NewNoopManager(),
 		logger:                               log.Log.WithName("controllers").WithName("TargetGroupBinding"),
 		metricsCollector:                     &mockMetricCollector{},
 		reconcileCounters:                    metricsutil.NewReconcileCounters(),
@@ -259,3 +271,49 @@ func TestTargetGroupBindingReconciler_Reconcile_Success(t *testing.T) {
 		t.Fatal("expected SuccessfullyReconciled event but none was emitted")
 	}
 }
+
+func TestTargetGroupBindingReconciler_Reconcile_NotFound(t *testing.T) {
+	scheme := runtime.NewScheme()
+	clientgoscheme.AddToScheme(scheme)
+	elbv2api.AddToScheme(scheme)
+
+	k8sClient := testclient.NewClientBuilder().WithScheme(scheme).Build()
+
+	// Setup Mocks
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockResMgr := targetgroupbinding.NewMockResourceManager(ctrl)
+	mockResMgr.EXPECT().ReleasePods(gomock.Any(), &elbv2api.TargetGroupBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-tgb",
+			Namespace: "default",
+		},
+	}).Return(nil)
+
+	reconciler := &targetGroupBindingReconciler{
+		k8sClient:                            k8sClient,
+		eventRecorder:                        record.NewFakeRecorder(10),
+		finalizerManager:                     k8s.NewMockFinalizerManager(ctrl),
+		tgbResourceManager:                   mockResMgr,
+		multiClusterManager:                  targetgroupbinding.NewMultiClusterManager(k8sClient, k8sClient, log.Log),
+		deferredTargetGroupBindingReconciler: &mockDeferredReconciler{},
+		shardManager:                         shard.NewNoopManager(),
+		logger:                               log.Log.WithName("controllers").WithName("TargetGroupBinding"),
+		metricsCollector:                     &mockMetricCollector{},
+		reconcileCounters:                    metricsutil.NewReconcileCounters(),
+	}
+
+	req := reconcile.Request{
+		NamespacedName: client.ObjectKey{
+			Namespace: "default",
+			Name:      "test-tgb",
+		},
+	}
+
+	// EXECUTE
+	_, err := reconciler.Reconcile(context.Background(), req)
+
+	// VERIFY
+	assert.NoError(t, err)
+}
@@ -48,11 +48,44 @@ func getStoredAddonConfig(gateway *gwv1.Gateway, logger logr.Logger) []addon.Add
 	return res
 }
 
+// getEnabledAddOns returns the addons stored as enabled in the Gateway annotations.
+func getEnabledAddOns(gateway *gwv1.Gateway, logger logr.Logger) []addon.Addon {
+	enabledAddOns := make([]addon.Addon, 0)
+	for _, ao := range getStoredAddonConfig(gateway, logger) {
+		if ao.Enabled {
+			enabledAddOns = append(enabledAddOns, ao.Name)
+		}
+	}
+	return enabledAddOns
+}
+
 // generateAddOnKey translates an addon into the respective annotation key value.
 func generateAddOnKey(a addon.Addon) string {
 	return fmt.Sprintf("%s%s", constants.GatewayLBPrefixEnabledAddon, strings.ToLower(string(a)))
 }
 
+// joinAddOns serializes addons into an annotation value.
+func joinAddOns(addOns []addon.Addon) string {
+	names := make([]string, 0, len(addOns))
+	for _, ao := range addOns {
+		names = append(names, string(ao))
+	}
+	return strings.Join(names, ",")
+}
+
+// splitAddOns parses an annotation value serialized by joinAddOns, ignoring unknown addons.
+func splitAddOns(value string) []addon.Addon {
+	addOns := make([]addon.Addon, 0)
+	for _, name := range strings.Split(value, ",") {
+		for _, ao := range addon.AllAddons {
+			if name == string(ao) {
+				addOns = append(addOns, ao)
+			}
+		}
+	}
+	return addOns
+}
+
 // parseAddOnEnabledValue parses an annotation key value into a boolean, assuming false if the value is malformed.
 func parseAddOnEnabledValue(e string, logger logr.Logger) bool {
 	b, err := strconv.ParseBool(e)
@@ -374,3 +374,38 @@ func Test_persistAddOns(t *testing.T) {
 		})
 	}
 }
+
+func Test_joinAddOns_splitAddOns(t *testing.T) {
+	testCases := []struct {
+		name   string
+		addOns []addon.Addon
+		value  string
+	}{
+		{
+			name:   "no addons",
+			addOns: []addon.Addon{},
+			value:  "",
+		},
+		{
+			name:   "single addon",
+			addOns: []addon.Addon{addon.WAFv2},
+			value:  "WAFv2",
+		},
+		{
+			name:   "multiple addons",
+			addOns: []addon.Addon{addon.Shield, addon.WAFv2},
+			value:  "Shield,WAFv2",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.value, joinAddOns(tc.addOns))
+			assert.Equal(t, tc.addOns, splitAddOns(tc.value))
+		})
+	}
+}
+
+func Test_splitAddOns_unknownAddOn(t *testing.T) {
+	assert.Equal(t, []addon.Addon{addon.Shield}, splitAddOns("Shield,Unknown"))
+}
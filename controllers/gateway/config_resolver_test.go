@@ -122,7 +122,7 @@ func Test_getLoadBalancerConfigForGateway(t *testing.T) {
 					return nil, errors.New("bad thing")
 				}
 				return &elbv2gw.LoadBalancerConfiguration{
-					ObjectMeta: metav1.ObjectMeta{ResourceVersion: "1"},
+					ObjectMeta: metav1.ObjectMeta{Generation: 1},
 				}, nil
 			},
 			setupMocks: func() {
@@ -176,7 +176,7 @@ func Test_getLoadBalancerConfigForGateway(t *testing.T) {
 					return nil, errors.New("bad thing")
 				}
 				return &elbv2gw.LoadBalancerConfiguration{
-					ObjectMeta: metav1.ObjectMeta{ResourceVersion: "1"},
+					ObjectMeta: metav1.ObjectMeta{Generation: 1},
 				}, nil
 			},
 			setupMocks: func() {
@@ -257,11 +257,11 @@ func Test_getLoadBalancerConfigForGateway(t *testing.T) {
 					return nil, errors.New("bad thing")
 				}
 				return &elbv2gw.LoadBalancerConfiguration{
-					ObjectMeta: metav1.ObjectMeta{Name: "gwclass", ResourceVersion: "1"},
+					ObjectMeta: metav1.ObjectMeta{Name: "gwclass", Generation: 1},
 				}, nil
 			},
 			expected: elbv2gw.LoadBalancerConfiguration{
-				ObjectMeta: metav1.ObjectMeta{Name: "gwclass", ResourceVersion: "1"},
+				ObjectMeta: metav1.ObjectMeta{Name: "gwclass", Generation: 1},
 			},
 			setupMocks: func() {
 				k8sFinalizerManager.EXPECT().
@@ -306,11 +306,11 @@ func Test_getLoadBalancerConfigForGateway(t *testing.T) {
 					return nil, errors.New("bad thing")
 				}
 				return &elbv2gw.LoadBalancerConfiguration{
-					ObjectMeta: metav1.ObjectMeta{Name: "gw", ResourceVersion: "1"},
+					ObjectMeta: metav1.ObjectMeta{Name: "gw", Generation: 1},
 				}, nil
 			},
 			expected: elbv2gw.LoadBalancerConfiguration{
-				ObjectMeta: metav1.ObjectMeta{Name: "gw", ResourceVersion: "1"},
+				ObjectMeta: metav1.ObjectMeta{Name: "gw", Generation: 1},
 			},
 			setupMocks: func() {
 				k8sFinalizerManager.EXPECT().
@@ -360,7 +360,7 @@ func Test_getLoadBalancerConfigForGateway(t *testing.T) {
 				}
 
 				return &elbv2gw.LoadBalancerConfiguration{
-					ObjectMeta: metav1.ObjectMeta{Name: reference.Name, ResourceVersion: "1"},
+					ObjectMeta: metav1.ObjectMeta{Name: reference.Name, Generation: 1},
 				}, nil
 			},
 			expected: elbv2gw.LoadBalancerConfiguration{
@@ -410,7 +410,7 @@ func Test_getLoadBalancerConfigForGateway(t *testing.T) {
 					return nil, errors.New("bad thing")
 				}
 				return &elbv2gw.LoadBalancerConfiguration{
-					ObjectMeta: metav1.ObjectMeta{Name: "gwclass", ResourceVersion: "1"},
+					ObjectMeta: metav1.ObjectMeta{Name: "gwclass", Generation: 1},
 				}, nil
 			},
 			setupMocks: func() {
@@ -0,0 +1,114 @@
+package gateway
+
+import (
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/v3/apis/gateway/v1"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+const (
+	// The max number of ancestors that can be stored in the status of a LoadBalancerConfiguration or TargetGroupConfiguration
+	maxConfigAncestors = 16
+
+	gatewayKind      = "Gateway"
+	gatewayClassKind = "GatewayClass"
+)
+
+// gatewayAncestorRef generates the ancestor reference of a Gateway.
+func gatewayAncestorRef(gw types.NamespacedName) elbv2gw.ConfigurationAncestorReference {
+	namespace := gw.Namespace
+	return elbv2gw.ConfigurationAncestorReference{
+		Group:     gwv1.GroupName,
+		Kind:      gatewayKind,
+		Namespace: &namespace,
+		Name:      gw.Name,
+	}
+}
+
+// gatewayClassAncestorRef generates the ancestor reference of a GatewayClass.
+func gatewayClassAncestorRef(gwClass *gwv1.GatewayClass) elbv2gw.ConfigurationAncestorReference {
+	return elbv2gw.ConfigurationAncestorReference{
+		Group: gwv1.GroupName,
+		Kind:  gatewayClassKind,
+		Name:  gwClass.Name,
+	}
+}
+
+// newConfigCondition generates an ancestor condition for the given generation of a configuration.
+func newConfigCondition(conditionType elbv2gw.ConfigurationConditionType, status metav1.ConditionStatus, reason elbv2gw.ConfigurationConditionReason, message string, generation int64) metav1.Condition {
+	return metav1.Condition{
+		Type:               string(conditionType),
+		Status:             status,
+		Reason:             string(reason),
+		Message:            truncateMessage(message),
+		ObservedGeneration: generation,
+	}
+}
+
+// ancestorKey generates a key to compare ancestor statuses.
+func ancestorKey(ancestor elbv2gw.ConfigurationAncestorStatus) string {
+	ref := ancestor.AncestorRef
+	namespace := ""
+	if ref.Namespace != nil {
+		namespace = *ref.Namespace
+	}
+	return ref.Kind + "/" + namespace + "/" + ref.Name + "/" + ancestor.ControllerName
+}
+
+// mergeConfigAncestorStatuses generates the ancestor statuses to store, from the current and desired statuses.
+// The last transition time of a condition is preserved when its status doesn't change.
+// Ancestors are sorted by kind, namespace and name, and capped at maxConfigAncestors.
+func mergeConfigAncestorStatuses(current []elbv2gw.ConfigurationAncestorStatus, desired []elbv2gw.ConfigurationAncestorStatus) []elbv2gw.ConfigurationAncestorStatus {
+	currentByKey := make(map[string]elbv2gw.ConfigurationAncestorStatus, len(current))
+	for _, ancestor := range current {
+		currentByKey[ancestorKey(ancestor)] = ancestor
+	}
+
+	now := metav1.Now()
+	merged := make([]elbv2gw.ConfigurationAncestorStatus, 0, len(desired))
+	seen := make(map[string]bool, len(desired))
+	for _, ancestor := range desired {
+		key := ancestorKey(ancestor)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		existing := currentByKey[key]
+		conditions := make([]metav1.Condition, 0, len(ancestor.Conditions))
+		for _, cond := range ancestor.Conditions {
+			cond.LastTransitionTime = now
+			for _, existingCond := range existing.Conditions {
+				if existingCond.Type == cond.Type && existingCond.Status == cond.Status {
+					cond.LastTransitionTime = existingCond.LastTransitionTime
+				}
+			}
+			conditions = append(conditions, cond)
+		}
+		ancestor.Conditions = conditions
+		merged = append(merged, ancestor)
+	}
+
+	sort.Slice(merged, func(i, j int) bool {
+		return ancestorKey(merged[i]) < ancestorKey(merged[j])
+	})
+	if len(merged) > maxConfigAncestors {
+		merged = merged[:maxConfigAncestors]
+	}
+	if len(merged) == 0 {
+		return nil
+	}
+	return merged
+}
+
+// isConfigAncestorStatusesEqual determines if two sets of ancestor statuses are equivalent.
+func isConfigAncestorStatusesEqual(a []elbv2gw.ConfigurationAncestorStatus, b []elbv2gw.ConfigurationAncestorStatus) bool {
+	if len(a) == 0 && len(b) == 0 {
+		return true
+	}
+	return equality.Semantic.DeepEqual(a, b)
+}
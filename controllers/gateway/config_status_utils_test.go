@@ -0,0 +1,83 @@
+package gateway
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/v3/apis/gateway/v1"
+)
+
+func Test_mergeConfigAncestorStatuses(t *testing.T) {
+	earlier := metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	gwA := gatewayAncestorRef(types.NamespacedName{Namespace: "ns", Name: "a"})
+	gwB := gatewayAncestorRef(types.NamespacedName{Namespace: "ns", Name: "b"})
+	acceptedTrue := newConfigCondition(elbv2gw.ConfigurationConditionAccepted, metav1.ConditionTrue, elbv2gw.ConfigurationReasonAccepted, "Accepted", 2)
+	acceptedFalse := newConfigCondition(elbv2gw.ConfigurationConditionAccepted, metav1.ConditionFalse, elbv2gw.ConfigurationReasonInvalid, "invalid", 2)
+
+	withTime := func(cond metav1.Condition, ts metav1.Time) metav1.Condition {
+		cond.LastTransitionTime = ts
+		return cond
+	}
+
+	t.Run("no ancestors", func(t *testing.T) {
+		assert.Nil(t, mergeConfigAncestorStatuses(nil, nil))
+	})
+
+	t.Run("preserves transition time of unchanged conditions and sorts ancestors", func(t *testing.T) {
+		current := []elbv2gw.ConfigurationAncestorStatus{
+			{AncestorRef: gwA, ControllerName: "gateway.k8s.aws/alb", Conditions: []metav1.Condition{withTime(acceptedTrue, earlier)}},
+			{AncestorRef: gwB, ControllerName: "gateway.k8s.aws/alb", Conditions: []metav1.Condition{withTime(acceptedTrue, earlier)}},
+		}
+		desired := []elbv2gw.ConfigurationAncestorStatus{
+			{AncestorRef: gwB, ControllerName: "gateway.k8s.aws/alb", Conditions: []metav1.Condition{acceptedFalse}},
+			{AncestorRef: gwA, ControllerName: "gateway.k8s.aws/alb", Conditions: []metav1.Condition{acceptedTrue}},
+		}
+		merged := mergeConfigAncestorStatuses(current, desired)
+		assert.Len(t, merged, 2)
+		assert.Equal(t, gwA, merged[0].AncestorRef)
+		assert.Equal(t, earlier, merged[0].Conditions[0].LastTransitionTime)
+		assert.Equal(t, gwB, merged[1].AncestorRef)
+		assert.Equal(t, metav1.ConditionFalse, merged[1].Conditions[0].Status)
+		assert.NotEqual(t, earlier, merged[1].Conditions[0].LastTransitionTime)
+		assert.False(t, isConfigAncestorStatusesEqual(current, merged))
+	})
+
+	t.Run("unchanged ancestors are equal", func(t *testing.T) {
+		current := []elbv2gw.ConfigurationAncestorStatus{
+			{AncestorRef: gwA, ControllerName: "gateway.k8s.aws/alb", Conditions: []metav1.Condition{withTime(acceptedTrue, earlier)}},
+		}
+		desired := []elbv2gw.ConfigurationAncestorStatus{
+			{AncestorRef: gwA, ControllerName: "gateway.k8s.aws/alb", Conditions: []metav1.Condition{acceptedTrue}},
+		}
+		assert.True(t, isConfigAncestorStatusesEqual(current, mergeConfigAncestorStatuses(current, desired)))
+	})
+
+	t.Run("removed ancestors are dropped and duplicates are ignored", func(t *testing.T) {
+		current := []elbv2gw.ConfigurationAncestorStatus{
+			{AncestorRef: gwA, ControllerName: "gateway.k8s.aws/alb", Conditions: []metav1.Condition{withTime(acceptedTrue, earlier)}},
+		}
+		desired := []elbv2gw.ConfigurationAncestorStatus{
+			{AncestorRef: gwB, ControllerName: "gateway.k8s.aws/nlb", Conditions: []metav1.Condition{acceptedTrue}},
+			{AncestorRef: gwB, ControllerName: "gateway.k8s.aws/nlb", Conditions: []metav1.Condition{acceptedFalse}},
+		}
+		merged := mergeConfigAncestorStatuses(current, desired)
+		assert.Len(t, merged, 1)
+		assert.Equal(t, gwB, merged[0].AncestorRef)
+		assert.Equal(t, metav1.ConditionTrue, merged[0].Conditions[0].Status)
+	})
+
+	t.Run("ancestors are capped", func(t *testing.T) {
+		var desired []elbv2gw.ConfigurationAncestorStatus
+		for i := 0; i < maxConfigAncestors+4; i++ {
+			desired = append(desired, elbv2gw.ConfigurationAncestorStatus{
+				AncestorRef:    gatewayAncestorRef(types.NamespacedName{Namespace: "ns", Name: string(rune('a' + i))}),
+				ControllerName: "gateway.k8s.aws/alb",
+				Conditions:     []metav1.Condition{acceptedTrue},
+			})
+		}
+		assert.Len(t, mergeConfigAncestorStatuses(nil, desired), maxConfigAncestors)
+	})
+}
@@ -0,0 +1,87 @@
+package eventhandlers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/v3/apis/gateway/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway/gatewayutils"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// NewEnqueueRequestsForConfigMapEvent constructs new enqueueRequestsForConfigMapEvent.
+// ConfigMaps are watched by metadata only, since only the Gateways referencing them as CA bundles are impacted.
+func NewEnqueueRequestsForConfigMapEvent(k8sClient client.Client, gwController string, logger logr.Logger) handler.TypedEventHandler[*metav1.PartialObjectMetadata, reconcile.Request] {
+	return &enqueueRequestsForConfigMapEvent{
+		k8sClient:    k8sClient,
+		gwController: gwController,
+		logger:       logger,
+	}
+}
+
+var _ handler.TypedEventHandler[*metav1.PartialObjectMetadata, reconcile.Request] = (*enqueueRequestsForConfigMapEvent)(nil)
+
+type enqueueRequestsForConfigMapEvent struct {
+	k8sClient    client.Client
+	gwController string
+	logger       logr.Logger
+}
+
+func (h *enqueueRequestsForConfigMapEvent) Create(ctx context.Context, e event.TypedCreateEvent[*metav1.PartialObjectMetadata], queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	cmNew := e.Object
+	h.enqueueImpactedGateways(ctx, cmNew, queue)
+}
+
+func (h *enqueueRequestsForConfigMapEvent) Update(ctx context.Context, e event.TypedUpdateEvent[*metav1.PartialObjectMetadata], queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	// the data of ConfigMaps isn't watched, any change to the ConfigMap is assumed to change its CA bundle.
+	// periodic resyncs don't change the resourceVersion.
+	if e.ObjectOld.ResourceVersion == e.ObjectNew.ResourceVersion {
+		return
+	}
+	h.enqueueImpactedGateways(ctx, e.ObjectNew, queue)
+}
+
+func (h *enqueueRequestsForConfigMapEvent) Delete(ctx context.Context, e event.TypedDeleteEvent[*metav1.PartialObjectMetadata], queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	cmOld := e.Object
+	h.enqueueImpactedGateways(ctx, cmOld, queue)
+}
+
+func (h *enqueueRequestsForConfigMapEvent) Generic(ctx context.Context, e event.TypedGenericEvent[*metav1.PartialObjectMetadata], queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	cmObj := e.Object
+	h.enqueueImpactedGateways(ctx, cmObj, queue)
+}
+
+func (h *enqueueRequestsForConfigMapEvent) enqueueImpactedGateways(ctx context.Context, cm *metav1.PartialObjectMetadata, queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	enqueueTrustStoreCABundleConsumers(ctx, h.k8sClient, h.gwController, h.logger, elbv2gw.TrustStoreCABundleKindConfigMap, k8s.NamespacedName(cm), queue)
+}
+
+// enqueueTrustStoreCABundleConsumers enqueues the Gateways managing a trust store from the CA bundle held by the object,
+// either through their LoadBalancerConfiguration or their client certificate validation, so that the trust store is updated once the CA bundle is rotated.
+func enqueueTrustStoreCABundleConsumers(ctx context.Context, k8sClient client.Client, gwController string, logger logr.Logger,
+	kind elbv2gw.TrustStoreCABundleKind, objKey types.NamespacedName, queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	gateways, err := gatewayutils.GetImpactedGatewaysFromTrustStoreCABundle(ctx, k8sClient, kind, objKey, gwController)
+	if err != nil {
+		logger.Error(err, "failed to get impacted gateways from CA bundle", "kind", kind, "object", objKey)
+		return
+	}
+	frontendGateways, err := gatewayutils.GetImpactedGatewaysFromFrontendCACertificate(ctx, k8sClient, string(kind), objKey, gwController)
+	if err != nil {
+		logger.Error(err, "failed to get impacted gateways from CA certificate", "kind", kind, "object", objKey)
+		return
+	}
+	gateways = append(gateways, frontendGateways...)
+	for _, gw := range gateways {
+		logger.V(1).Info("enqueue gateway for CA bundle event",
+			"kind", kind,
+			"object", objKey,
+			"gateway", k8s.NamespacedName(gw))
+		queue.Add(reconcile.Request{NamespacedName: k8s.NamespacedName(gw)})
+	}
+}
@@ -0,0 +1,83 @@
+package configurationeventhandlers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
+	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/v3/apis/gateway/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway/constants"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// LoadBalancerConfigurationsForGateway maps a Gateway to the LoadBalancerConfiguration referenced by its infrastructure parameters.
+func LoadBalancerConfigurationsForGateway(_ context.Context, gw *gwv1.Gateway) []reconcile.Request {
+	if gw.Spec.Infrastructure == nil || gw.Spec.Infrastructure.ParametersRef == nil {
+		return nil
+	}
+	paramRef := gw.Spec.Infrastructure.ParametersRef
+	if string(paramRef.Kind) != constants.LoadBalancerConfiguration {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: gw.Namespace, Name: paramRef.Name}}}
+}
+
+// LoadBalancerConfigurationsForGatewayClass maps a GatewayClass to the LoadBalancerConfiguration referenced by its parameters.
+func LoadBalancerConfigurationsForGatewayClass(_ context.Context, gwClass *gwv1.GatewayClass) []reconcile.Request {
+	paramRef := gwClass.Spec.ParametersRef
+	if paramRef == nil || paramRef.Namespace == nil || string(paramRef.Kind) != constants.LoadBalancerConfiguration {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: string(*paramRef.Namespace), Name: paramRef.Name}}}
+}
+
+// TargetGroupConfigurationsForLoadBalancerConfiguration maps a LoadBalancerConfiguration to its default TargetGroupConfiguration.
+func TargetGroupConfigurationsForLoadBalancerConfiguration(_ context.Context, lbConf *elbv2gw.LoadBalancerConfiguration) []reconcile.Request {
+	if lbConf.Spec.DefaultTargetGroupConfiguration == nil {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: lbConf.Namespace, Name: lbConf.Spec.DefaultTargetGroupConfiguration.Name}}}
+}
+
+// NewLoadBalancerConfigurationsForTargetGroupConfiguration creates a mapper from a default TargetGroupConfiguration
+// to the LoadBalancerConfigurations that reference it.
+func NewLoadBalancerConfigurationsForTargetGroupConfiguration(k8sClient client.Client, logger logr.Logger) ConfigurationMapFn[*elbv2gw.TargetGroupConfiguration] {
+	return func(ctx context.Context, tgConf *elbv2gw.TargetGroupConfiguration) []reconcile.Request {
+		if tgConf.Spec.TargetReference != nil {
+			return nil
+		}
+		lbConfigList := &elbv2gw.LoadBalancerConfigurationList{}
+		if err := k8sClient.List(ctx, lbConfigList, client.InNamespace(tgConf.Namespace)); err != nil {
+			logger.Error(err, "failed to list loadbalancerconfigurations for targetgroupconfiguration event", "targetgroupconfiguration", k8s.NamespacedName(tgConf))
+			return nil
+		}
+		var requests []reconcile.Request
+		for i := range lbConfigList.Items {
+			lbConf := &lbConfigList.Items[i]
+			if lbConf.Spec.DefaultTargetGroupConfiguration != nil && lbConf.Spec.DefaultTargetGroupConfiguration.Name == tgConf.Name {
+				requests = append(requests, reconcile.Request{NamespacedName: k8s.NamespacedName(lbConf)})
+			}
+		}
+		return requests
+	}
+}
+
+// NewAllTargetGroupConfigurations creates a mapper to every TargetGroupConfiguration in the cluster.
+// Gateways consume TargetGroupConfigurations through the Services of their routes, which can live in any namespace.
+func NewAllTargetGroupConfigurations[T client.Object](k8sClient client.Client, logger logr.Logger) ConfigurationMapFn[T] {
+	return func(ctx context.Context, obj T) []reconcile.Request {
+		tgConfigList := &elbv2gw.TargetGroupConfigurationList{}
+		if err := k8sClient.List(ctx, tgConfigList); err != nil {
+			logger.Error(err, "failed to list targetgroupconfigurations", "object", k8s.NamespacedName(obj))
+			return nil
+		}
+		requests := make([]reconcile.Request, 0, len(tgConfigList.Items))
+		for i := range tgConfigList.Items {
+			requests = append(requests, reconcile.Request{NamespacedName: k8s.NamespacedName(&tgConfigList.Items[i])})
+		}
+		return requests
+	}
+}
@@ -0,0 +1,130 @@
+package configurationeventhandlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/v3/apis/gateway/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway/constants"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/testutils"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func TestLoadBalancerConfigurationsForGateway(t *testing.T) {
+	tests := []struct {
+		name string
+		gw   *gwv1.Gateway
+		want []reconcile.Request
+	}{
+		{
+			name: "no infrastructure",
+			gw:   &gwv1.Gateway{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "gw"}},
+		},
+		{
+			name: "references a loadbalancer configuration",
+			gw: &gwv1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "gw"},
+				Spec: gwv1.GatewaySpec{
+					Infrastructure: &gwv1.GatewayInfrastructure{
+						ParametersRef: &gwv1.LocalParametersReference{Kind: constants.LoadBalancerConfiguration, Name: "lbc"},
+					},
+				},
+			},
+			want: []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "lbc"}}},
+		},
+		{
+			name: "references another kind",
+			gw: &gwv1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "gw"},
+				Spec: gwv1.GatewaySpec{
+					Infrastructure: &gwv1.GatewayInfrastructure{
+						ParametersRef: &gwv1.LocalParametersReference{Kind: "ConfigMap", Name: "cm"},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, LoadBalancerConfigurationsForGateway(context.Background(), tt.gw))
+		})
+	}
+}
+
+func TestLoadBalancerConfigurationsForGatewayClass(t *testing.T) {
+	ns := gwv1.Namespace("ns")
+	tests := []struct {
+		name    string
+		gwClass *gwv1.GatewayClass
+		want    []reconcile.Request
+	}{
+		{
+			name:    "no parameters",
+			gwClass: &gwv1.GatewayClass{ObjectMeta: metav1.ObjectMeta{Name: "class"}},
+		},
+		{
+			name: "references a loadbalancer configuration",
+			gwClass: &gwv1.GatewayClass{
+				ObjectMeta: metav1.ObjectMeta{Name: "class"},
+				Spec: gwv1.GatewayClassSpec{
+					ParametersRef: &gwv1.ParametersReference{Kind: constants.LoadBalancerConfiguration, Name: "lbc", Namespace: &ns},
+				},
+			},
+			want: []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "lbc"}}},
+		},
+		{
+			name: "reference without namespace",
+			gwClass: &gwv1.GatewayClass{
+				ObjectMeta: metav1.ObjectMeta{Name: "class"},
+				Spec: gwv1.GatewayClassSpec{
+					ParametersRef: &gwv1.ParametersReference{Kind: constants.LoadBalancerConfiguration, Name: "lbc"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, LoadBalancerConfigurationsForGatewayClass(context.Background(), tt.gwClass))
+		})
+	}
+}
+
+func TestNewLoadBalancerConfigurationsForTargetGroupConfiguration(t *testing.T) {
+	ctx := context.Background()
+	k8sClient := testutils.GenerateTestClient()
+	for _, lbConf := range []*elbv2gw.LoadBalancerConfiguration{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "referencing"},
+			Spec: elbv2gw.LoadBalancerConfigurationSpec{
+				DefaultTargetGroupConfiguration: &elbv2gw.DefaultTargetGroupConfigurationReference{Name: "default-tgc"},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "other"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "other-ns", Name: "referencing"},
+			Spec: elbv2gw.LoadBalancerConfigurationSpec{
+				DefaultTargetGroupConfiguration: &elbv2gw.DefaultTargetGroupConfigurationReference{Name: "default-tgc"},
+			},
+		},
+	} {
+		assert.NoError(t, k8sClient.Create(ctx, lbConf))
+	}
+	mapFn := NewLoadBalancerConfigurationsForTargetGroupConfiguration(k8sClient, logr.Discard())
+
+	assert.Equal(t, []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "referencing"}}},
+		mapFn(ctx, &elbv2gw.TargetGroupConfiguration{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "default-tgc"}}))
+
+	assert.Empty(t, mapFn(ctx, &elbv2gw.TargetGroupConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "default-tgc"},
+		Spec: elbv2gw.TargetGroupConfigurationSpec{
+			TargetReference: &elbv2gw.Reference{Name: "svc"},
+		},
+	}))
+}
@@ -0,0 +1,60 @@
+package configurationeventhandlers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// ConfigurationMapFn maps an object to the configurations whose status depends on it.
+type ConfigurationMapFn[T client.Object] func(ctx context.Context, obj T) []reconcile.Request
+
+// NewEnqueueRequestsForObjectEvent creates a handler that enqueues the configurations whose status depends on an object.
+// On updates, configurations mapped from both the old and the new object are enqueued, so that a configuration
+// that is no longer referenced drops the ancestor status of the object.
+func NewEnqueueRequestsForObjectEvent[T client.Object](mapFn ConfigurationMapFn[T], logger logr.Logger) handler.TypedEventHandler[T, reconcile.Request] {
+	return &enqueueRequestsForObjectEvent[T]{
+		mapFn:  mapFn,
+		logger: logger,
+	}
+}
+
+// enqueueRequestsForObjectEvent handles events of objects that are ancestors or references of configurations
+type enqueueRequestsForObjectEvent[T client.Object] struct {
+	mapFn  ConfigurationMapFn[T]
+	logger logr.Logger
+}
+
+func (h *enqueueRequestsForObjectEvent[T]) Create(ctx context.Context, e event.TypedCreateEvent[T], queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	h.enqueueImpactedConfigurations(ctx, queue, e.Object)
+}
+
+func (h *enqueueRequestsForObjectEvent[T]) Update(ctx context.Context, e event.TypedUpdateEvent[T], queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	h.enqueueImpactedConfigurations(ctx, queue, e.ObjectOld, e.ObjectNew)
+}
+
+func (h *enqueueRequestsForObjectEvent[T]) Delete(ctx context.Context, e event.TypedDeleteEvent[T], queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	h.enqueueImpactedConfigurations(ctx, queue, e.Object)
+}
+
+func (h *enqueueRequestsForObjectEvent[T]) Generic(ctx context.Context, e event.TypedGenericEvent[T], queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	h.enqueueImpactedConfigurations(ctx, queue, e.Object)
+}
+
+func (h *enqueueRequestsForObjectEvent[T]) enqueueImpactedConfigurations(ctx context.Context, queue workqueue.TypedRateLimitingInterface[reconcile.Request], objs ...T) {
+	requests := sets.New[reconcile.Request]()
+	for _, obj := range objs {
+		requests.Insert(h.mapFn(ctx, obj)...)
+	}
+	for req := range requests {
+		h.logger.V(1).Info("enqueue configuration for ancestor status", "object", k8s.NamespacedName(objs[len(objs)-1]), "configuration", req.NamespacedName)
+		queue.Add(req)
+	}
+}
@@ -49,6 +49,10 @@ func (h *enqueueRequestsForLoadBalancerConfigurationEvent) Create(ctx context.Co
 
 func (h *enqueueRequestsForLoadBalancerConfigurationEvent) Update(ctx context.Context, e event.TypedUpdateEvent[*elbv2gw.LoadBalancerConfiguration], queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
 	lbconfigNew := e.ObjectNew
+	// status only updates, such as the ancestor conditions, don't change the configuration of the Gateways.
+	if e.ObjectOld.Generation == lbconfigNew.Generation {
+		return
+	}
 	h.logger.V(1).Info("enqueue loadbalancerconfiguration update event", "loadbalancerconfiguration", lbconfigNew.Name)
 	h.enqueueImpactedGateways(ctx, lbconfigNew, queue)
 }
@@ -19,11 +19,12 @@ import (
 
 // NewEnqueueRequestsForSecretEvent constructs new enqueueRequestsForSecretEvent.
 func NewEnqueueRequestsForSecretEvent(listenerRuleConfigEventChan chan<- event.TypedGenericEvent[*elbv2gw.ListenerRuleConfiguration],
-	k8sClient client.Client, eventRecorder record.EventRecorder, logger logr.Logger) handler.TypedEventHandler[*corev1.Secret, reconcile.Request] {
+	k8sClient client.Client, eventRecorder record.EventRecorder, gwController string, logger logr.Logger) handler.TypedEventHandler[*corev1.Secret, reconcile.Request] {
 	return &enqueueRequestsForSecretEvent{
 		listenerRuleConfigEventChan: listenerRuleConfigEventChan,
 		k8sClient:                   k8sClient,
 		eventRecorder:               eventRecorder,
+		gwController:                gwController,
 		logger:                      logger,
 	}
 }
@@ -34,6 +35,7 @@ type enqueueRequestsForSecretEvent struct {
 	listenerRuleConfigEventChan chan<- event.TypedGenericEvent[*elbv2gw.ListenerRuleConfiguration]
 	k8sClient                   client.Client
 	eventRecorder               record.EventRecorder
+	gwController                string
 	logger                      logr.Logger
 }
 
@@ -41,7 +43,7 @@ func (h *enqueueRequestsForSecretEvent) Create(ctx context.Context, e event.Type
 	//No-Op : We will only start monitoring secret events after they have been created and associated with gateway specific resources. We don't watch cluster-wide secret events.
 }
 
-func (h *enqueueRequestsForSecretEvent) Update(ctx context.Context, e event.TypedUpdateEvent[*corev1.Secret], _ workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+func (h *enqueueRequestsForSecretEvent) Update(ctx context.Context, e event.TypedUpdateEvent[*corev1.Secret], queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
 	secretOld := e.ObjectOld
 	secretNew := e.ObjectNew
 
@@ -54,18 +56,21 @@ func (h *enqueueRequestsForSecretEvent) Update(ctx context.Context, e event.Type
 	}
 	h.logger.V(1).Info("enqueue secret update event", "secret", secretNew.Name)
 	h.enqueueImpactedListenerRulesConfigs(ctx, secretNew)
+	enqueueTrustStoreCABundleConsumers(ctx, h.k8sClient, h.gwController, h.logger, elbv2gw.TrustStoreCABundleKindSecret, k8s.NamespacedName(secretNew), queue)
 }
 
-func (h *enqueueRequestsForSecretEvent) Delete(ctx context.Context, e event.TypedDeleteEvent[*corev1.Secret], _ workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+func (h *enqueueRequestsForSecretEvent) Delete(ctx context.Context, e event.TypedDeleteEvent[*corev1.Secret], queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
 	secretOld := e.Object
 	h.logger.V(1).Info("enqueue secret delete event", "secret", secretOld.Name)
 	h.enqueueImpactedListenerRulesConfigs(ctx, secretOld)
+	enqueueTrustStoreCABundleConsumers(ctx, h.k8sClient, h.gwController, h.logger, elbv2gw.TrustStoreCABundleKindSecret, k8s.NamespacedName(secretOld), queue)
 }
 
-func (h *enqueueRequestsForSecretEvent) Generic(ctx context.Context, e event.TypedGenericEvent[*corev1.Secret], _ workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+func (h *enqueueRequestsForSecretEvent) Generic(ctx context.Context, e event.TypedGenericEvent[*corev1.Secret], queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
 	secretObj := e.Object
 	h.logger.V(1).Info("enqueue secret generic event", "secret", secretObj.Name)
 	h.enqueueImpactedListenerRulesConfigs(ctx, secretObj)
+	enqueueTrustStoreCABundleConsumers(ctx, h.k8sClient, h.gwController, h.logger, elbv2gw.TrustStoreCABundleKindSecret, k8s.NamespacedName(secretObj), queue)
 }
 
 func (h *enqueueRequestsForSecretEvent) enqueueImpactedListenerRulesConfigs(ctx context.Context, secret *corev1.Secret) {
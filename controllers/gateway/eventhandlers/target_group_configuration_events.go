@@ -10,6 +10,7 @@ import (
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/v3/apis/gateway/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway/routeutils"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/k8s"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -19,17 +20,20 @@ import (
 )
 
 // NewEnqueueRequestsForTargetGroupConfigurationEvent creates handler for TargetGroupConfiguration resources
-func NewEnqueueRequestsForTargetGroupConfigurationEvent(svcEventChan chan<- event.TypedGenericEvent[*corev1.Service], tcpRouteEventChan chan<- event.TypedGenericEvent[*gwv1.TCPRoute],
+func NewEnqueueRequestsForTargetGroupConfigurationEvent(svcEventChan chan<- event.TypedGenericEvent[*corev1.Service], httpRouteEventChan chan<- event.TypedGenericEvent[*gwv1.HTTPRoute],
+	grpcRouteEventChan chan<- event.TypedGenericEvent[*gwv1.GRPCRoute], tcpRouteEventChan chan<- event.TypedGenericEvent[*gwv1.TCPRoute],
 	lbcEventChan chan<- event.TypedGenericEvent[*elbv2gw.LoadBalancerConfiguration],
 	k8sClient client.Client, eventRecorder record.EventRecorder, logger logr.Logger, gwController string) handler.TypedEventHandler[*elbv2gw.TargetGroupConfiguration, reconcile.Request] {
 	return &enqueueRequestsForTargetGroupConfigurationEvent{
-		svcEventChan:      svcEventChan,
-		tcpRouteEventChan: tcpRouteEventChan,
-		lbcEventChan:      lbcEventChan,
-		k8sClient:         k8sClient,
-		eventRecorder:     eventRecorder,
-		logger:            logger,
-		gwController:      gwController,
+		svcEventChan:       svcEventChan,
+		httpRouteEventChan: httpRouteEventChan,
+		grpcRouteEventChan: grpcRouteEventChan,
+		tcpRouteEventChan:  tcpRouteEventChan,
+		lbcEventChan:       lbcEventChan,
+		k8sClient:          k8sClient,
+		eventRecorder:      eventRecorder,
+		logger:             logger,
+		gwController:       gwController,
 	}
 }
 
@@ -37,13 +41,15 @@ var _ handler.TypedEventHandler[*elbv2gw.TargetGroupConfiguration, reconcile.Req
 
 // enqueueRequestsForTargetGroupConfigurationEvent handles TargetGroupConfiguration events
 type enqueueRequestsForTargetGroupConfigurationEvent struct {
-	svcEventChan      chan<- event.TypedGenericEvent[*corev1.Service]
-	tcpRouteEventChan chan<- event.TypedGenericEvent[*gwv1.TCPRoute]
-	lbcEventChan      chan<- event.TypedGenericEvent[*elbv2gw.LoadBalancerConfiguration]
-	k8sClient         client.Client
-	eventRecorder     record.EventRecorder
-	logger            logr.Logger
-	gwController      string
+	svcEventChan       chan<- event.TypedGenericEvent[*corev1.Service]
+	httpRouteEventChan chan<- event.TypedGenericEvent[*gwv1.HTTPRoute]
+	grpcRouteEventChan chan<- event.TypedGenericEvent[*gwv1.GRPCRoute]
+	tcpRouteEventChan  chan<- event.TypedGenericEvent[*gwv1.TCPRoute]
+	lbcEventChan       chan<- event.TypedGenericEvent[*elbv2gw.LoadBalancerConfiguration]
+	k8sClient          client.Client
+	eventRecorder      record.EventRecorder
+	logger             logr.Logger
+	gwController       string
 }
 
 func (h *enqueueRequestsForTargetGroupConfigurationEvent) Create(ctx context.Context, e event.TypedCreateEvent[*elbv2gw.TargetGroupConfiguration], queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
@@ -54,6 +60,10 @@ func (h *enqueueRequestsForTargetGroupConfigurationEvent) Create(ctx context.Con
 
 func (h *enqueueRequestsForTargetGroupConfigurationEvent) Update(ctx context.Context, e event.TypedUpdateEvent[*elbv2gw.TargetGroupConfiguration], queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
 	tgconfigNew := e.ObjectNew
+	// status only updates, such as the ancestor conditions, don't change the configuration of the Gateways.
+	if e.ObjectOld.Generation == tgconfigNew.Generation {
+		return
+	}
 	h.logger.V(1).Info("enqueue targetgroupconfiguration update event", "targetgroupconfiguration", tgconfigNew.Name)
 	h.enqueueImpactedObject(ctx, tgconfigNew, queue)
 }
@@ -73,6 +83,7 @@ func (h *enqueueRequestsForTargetGroupConfigurationEvent) Generic(ctx context.Co
 func (h *enqueueRequestsForTargetGroupConfigurationEvent) enqueueImpactedObject(ctx context.Context, tgconfig *elbv2gw.TargetGroupConfiguration, queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
 	if tgconfig.Spec.TargetReference == nil {
 		h.enqueueGatewaysReferencingDefaultTGC(ctx, tgconfig, queue)
+		h.enqueueRoutesReferencingTGC(ctx, tgconfig)
 		return
 	}
 	objName := types.NamespacedName{Namespace: tgconfig.Namespace, Name: tgconfig.Spec.TargetReference.Name}
@@ -140,6 +151,42 @@ func (h *enqueueRequestsForTargetGroupConfigurationEvent) enqueueGatewaysReferen
 	}
 }
 
+// enqueueRoutesReferencingTGC finds the L7 routes that attach this TGC to one of their backends
+// through an ExtensionRef filter, and emits synthetic route events for them.
+func (h *enqueueRequestsForTargetGroupConfigurationEvent) enqueueRoutesReferencingTGC(ctx context.Context, tgconfig *elbv2gw.TargetGroupConfiguration) {
+	if h.httpRouteEventChan == nil && h.grpcRouteEventChan == nil {
+		return
+	}
+	l7Routes, err := routeutils.ListL7Routes(ctx, h.k8sClient)
+	if err != nil {
+		h.logger.V(1).Info("ignoring to enqueue impacted L7 routes ", "error: ", err)
+	}
+	for _, route := range routeutils.FilterRoutesByTargetGroupCfg(l7Routes, tgconfig) {
+		switch route.GetRouteKind() {
+		case routeutils.HTTPRouteKind:
+			if h.httpRouteEventChan == nil {
+				continue
+			}
+			h.logger.V(1).Info("enqueue httproute for targetgroupconfiguration event",
+				"targetgroupconfiguration", k8s.NamespacedName(tgconfig),
+				"httproute", route.GetRouteNamespacedName())
+			h.httpRouteEventChan <- event.TypedGenericEvent[*gwv1.HTTPRoute]{
+				Object: route.GetRawRoute().(*gwv1.HTTPRoute),
+			}
+		case routeutils.GRPCRouteKind:
+			if h.grpcRouteEventChan == nil {
+				continue
+			}
+			h.logger.V(1).Info("enqueue grpcroute for targetgroupconfiguration event",
+				"targetgroupconfiguration", k8s.NamespacedName(tgconfig),
+				"grpcroute", route.GetRouteNamespacedName())
+			h.grpcRouteEventChan <- event.TypedGenericEvent[*gwv1.GRPCRoute]{
+				Object: route.GetRawRoute().(*gwv1.GRPCRoute),
+			}
+		}
+	}
+}
+
 func getImpactedTCPRoutes(list *gwv1.TCPRouteList, tgconfig *elbv2gw.TargetGroupConfiguration) []*gwv1.TCPRoute {
 	seen := sets.Set[types.NamespacedName]{}
 	res := make([]*gwv1.TCPRoute, 0)
@@ -391,3 +391,85 @@ func TestEnqueueGatewaysReferencingDefaultTGC(t *testing.T) {
 		})
 	}
 }
+
+func TestEnqueueRoutesReferencingTGC(t *testing.T) {
+	tgcFilter := func(name string) gwv1.HTTPRouteFilter {
+		return gwv1.HTTPRouteFilter{
+			Type: gwv1.HTTPRouteFilterExtensionRef,
+			ExtensionRef: &gwv1.LocalObjectReference{
+				Group: constants.ControllerCRDGroupVersion,
+				Kind:  constants.TargetGroupConfiguration,
+				Name:  gwv1.ObjectName(name),
+			},
+		}
+	}
+	ctx := context.Background()
+	k8sClient := testutils.GenerateTestClient()
+	assert.NoError(t, k8sClient.Create(ctx, &gwv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "referencing", Namespace: "test-ns"},
+		Spec: gwv1.HTTPRouteSpec{
+			Rules: []gwv1.HTTPRouteRule{
+				{BackendRefs: []gwv1.HTTPBackendRef{{Filters: []gwv1.HTTPRouteFilter{tgcFilter("route-tgc")}}}},
+			},
+		},
+	}))
+	assert.NoError(t, k8sClient.Create(ctx, &gwv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "test-ns"},
+		Spec: gwv1.HTTPRouteSpec{
+			Rules: []gwv1.HTTPRouteRule{
+				{BackendRefs: []gwv1.HTTPBackendRef{{Filters: []gwv1.HTTPRouteFilter{tgcFilter("other-tgc")}}}},
+			},
+		},
+	}))
+	assert.NoError(t, k8sClient.Create(ctx, &gwv1.GRPCRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "referencing-grpc", Namespace: "test-ns"},
+		Spec: gwv1.GRPCRouteSpec{
+			Rules: []gwv1.GRPCRouteRule{
+				{
+					BackendRefs: []gwv1.GRPCBackendRef{
+						{
+							Filters: []gwv1.GRPCRouteFilter{
+								{
+									Type: gwv1.GRPCRouteFilterExtensionRef,
+									ExtensionRef: &gwv1.LocalObjectReference{
+										Group: constants.ControllerCRDGroupVersion,
+										Kind:  constants.TargetGroupConfiguration,
+										Name:  "route-tgc",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}))
+
+	httpRouteEventChan := make(chan event.TypedGenericEvent[*gwv1.HTTPRoute], 10)
+	grpcRouteEventChan := make(chan event.TypedGenericEvent[*gwv1.GRPCRoute], 10)
+	h := &enqueueRequestsForTargetGroupConfigurationEvent{
+		httpRouteEventChan: httpRouteEventChan,
+		grpcRouteEventChan: grpcRouteEventChan,
+		k8sClient:          k8sClient,
+		logger:             logr.New(&log.NullLogSink{}),
+		gwController:       constants.ALBGatewayController,
+	}
+
+	h.enqueueRoutesReferencingTGC(ctx, &elbv2gw.TargetGroupConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: "route-tgc", Namespace: "test-ns"},
+	})
+
+	close(httpRouteEventChan)
+	close(grpcRouteEventChan)
+	gotEnqueued := make([]types.NamespacedName, 0)
+	for evt := range httpRouteEventChan {
+		gotEnqueued = append(gotEnqueued, k8s.NamespacedName(evt.Object))
+	}
+	for evt := range grpcRouteEventChan {
+		gotEnqueued = append(gotEnqueued, k8s.NamespacedName(evt.Object))
+	}
+	assert.ElementsMatch(t, []types.NamespacedName{
+		{Namespace: "test-ns", Name: "referencing"},
+		{Namespace: "test-ns", Name: "referencing-grpc"},
+	}, gotEnqueued)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/certs"
@@ -14,6 +15,8 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -26,11 +29,14 @@ import (
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/config"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/deploy"
 	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/deploy/elbv2"
+	s3deploy "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/deploy/s3"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/deploy/shield"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/deploy/tracking"
 	ctrlerrors "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/error"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway/constants"
 	gateway_constants "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway/constants"
 	gatewaymodel "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway/model"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway/model/subnet"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway/referencecounter"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway/routeutils"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/k8s"
@@ -38,7 +44,9 @@ import (
 	lbcmetrics "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/metrics/lbc"
 	metricsutil "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/metrics/util"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/ec2"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2"
+	s3model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/s3"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/networking"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/runtime"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/shared_constants"
@@ -60,13 +68,13 @@ const (
 var _ Reconciler = &gatewayReconciler{}
 
 // NewNLBGatewayReconciler constructs a gateway reconciler to handle specifically for NLB gateways
-func NewNLBGatewayReconciler(routeLoader routeutils.Loader, referenceCounter referencecounter.ServiceReferenceCounter, cloud services.Cloud, k8sClient client.Client, certDiscovery certs.CertDiscovery, eventRecorder record.EventRecorder, controllerConfig config.ControllerConfig, finalizerManager k8s.FinalizerManager, networkingManager networking.NetworkingManager, networkingSGReconciler networking.SecurityGroupReconciler, networkingSGManager networking.SecurityGroupManager, elbv2TaggingManager elbv2deploy.TaggingManager, subnetResolver networking.SubnetsResolver, vpcInfoProvider networking.VPCInfoProvider, backendSGProvider networking.BackendSGProvider, sgResolver networking.SecurityGroupResolver, logger logr.Logger, metricsCollector lbcmetrics.MetricCollector, reconcileCounters *metricsutil.ReconcileCounters, targetGroupCollector awsmetrics.TargetGroupCollector, targetGroupNameToArnMapper shared_utils.TargetGroupARNMapper, listenerSetStatusSubmitter ListenerSetStatusSubmitter) Reconciler {
-	return newGatewayReconciler(constants.NLBGatewayController, elbv2model.LoadBalancerTypeNetwork, controllerConfig.NLBGatewayMaxConcurrentReconciles, constants.NLBGatewayTagPrefix, shared_constants.NLBGatewayFinalizer, certDiscovery, routeLoader, referenceCounter, routeutils.L4RouteFilter, cloud, k8sClient, eventRecorder, controllerConfig, finalizerManager, networkingSGReconciler, networkingManager, networkingSGManager, elbv2TaggingManager, subnetResolver, vpcInfoProvider, backendSGProvider, sgResolver, nlbAddons, targetGroupNameToArnMapper, logger, metricsCollector, reconcileCounters.IncrementNLBGateway, targetGroupCollector, listenerSetStatusSubmitter)
+func NewNLBGatewayReconciler(routeLoader routeutils.Loader, referenceCounter referencecounter.ServiceReferenceCounter, cloud services.Cloud, k8sClient client.Client, certDiscovery certs.CertDiscovery, eventRecorder record.EventRecorder, controllerConfig config.ControllerConfig, finalizerManager k8s.FinalizerManager, networkingManager networking.NetworkingManager, networkingSGReconciler networking.SecurityGroupReconciler, networkingSGManager networking.SecurityGroupManager, elbv2TaggingManager elbv2deploy.TaggingManager, shieldProtectionManager shield.ProtectionManager, subnetResolver networking.SubnetsResolver, vpcInfoProvider networking.VPCInfoProvider, backendSGProvider networking.BackendSGProvider, sgResolver networking.SecurityGroupResolver, logger logr.Logger, metricsCollector lbcmetrics.MetricCollector, reconcileCounters *metricsutil.ReconcileCounters, targetGroupCollector awsmetrics.TargetGroupCollector, targetGroupNameToArnMapper shared_utils.TargetGroupARNMapper, listenerSetStatusSubmitter ListenerSetStatusSubmitter) Reconciler {
+	return newGatewayReconciler(constants.NLBGatewayController, elbv2model.LoadBalancerTypeNetwork, controllerConfig.NLBGatewayMaxConcurrentReconciles, constants.NLBGatewayTagPrefix, shared_constants.NLBGatewayFinalizer, certDiscovery, routeLoader, referenceCounter, routeutils.L4RouteFilter, cloud, k8sClient, eventRecorder, controllerConfig, finalizerManager, networkingSGReconciler, networkingManager, networkingSGManager, elbv2TaggingManager, shieldProtectionManager, subnetResolver, vpcInfoProvider, backendSGProvider, sgResolver, nlbAddons, targetGroupNameToArnMapper, logger, metricsCollector, reconcileCounters.IncrementNLBGateway, targetGroupCollector, listenerSetStatusSubmitter)
 }
 
 // NewALBGatewayReconciler constructs a gateway reconciler to handle specifically for ALB gateways
-func NewALBGatewayReconciler(routeLoader routeutils.Loader, cloud services.Cloud, k8sClient client.Client, certDiscovery certs.CertDiscovery, referenceCounter referencecounter.ServiceReferenceCounter, eventRecorder record.EventRecorder, controllerConfig config.ControllerConfig, finalizerManager k8s.FinalizerManager, networkingManager networking.NetworkingManager, networkingSGReconciler networking.SecurityGroupReconciler, networkingSGManager networking.SecurityGroupManager, elbv2TaggingManager elbv2deploy.TaggingManager, subnetResolver networking.SubnetsResolver, vpcInfoProvider networking.VPCInfoProvider, backendSGProvider networking.BackendSGProvider, sgResolver networking.SecurityGroupResolver, logger logr.Logger, metricsCollector lbcmetrics.MetricCollector, reconcileCounters *metricsutil.ReconcileCounters, targetGroupCollector awsmetrics.TargetGroupCollector, targetGroupNameToArnMapper shared_utils.TargetGroupARNMapper, listenerSetStatusSubmitter ListenerSetStatusSubmitter) Reconciler {
-	return newGatewayReconciler(constants.ALBGatewayController, elbv2model.LoadBalancerTypeApplication, controllerConfig.ALBGatewayMaxConcurrentReconciles, constants.ALBGatewayTagPrefix, shared_constants.ALBGatewayFinalizer, certDiscovery, routeLoader, referenceCounter, routeutils.L7RouteFilter, cloud, k8sClient, eventRecorder, controllerConfig, finalizerManager, networkingSGReconciler, networkingManager, networkingSGManager, elbv2TaggingManager, subnetResolver, vpcInfoProvider, backendSGProvider, sgResolver, albAddons, targetGroupNameToArnMapper, logger, metricsCollector, reconcileCounters.IncrementALBGateway, targetGroupCollector, listenerSetStatusSubmitter)
+func NewALBGatewayReconciler(routeLoader routeutils.Loader, cloud services.Cloud, k8sClient client.Client, certDiscovery certs.CertDiscovery, referenceCounter referencecounter.ServiceReferenceCounter, eventRecorder record.EventRecorder, controllerConfig config.ControllerConfig, finalizerManager k8s.FinalizerManager, networkingManager networking.NetworkingManager, networkingSGReconciler networking.SecurityGroupReconciler, networkingSGManager networking.SecurityGroupManager, elbv2TaggingManager elbv2deploy.TaggingManager, shieldProtectionManager shield.ProtectionManager, subnetResolver networking.SubnetsResolver, vpcInfoProvider networking.VPCInfoProvider, backendSGProvider networking.BackendSGProvider, sgResolver networking.SecurityGroupResolver, logger logr.Logger, metricsCollector lbcmetrics.MetricCollector, reconcileCounters *metricsutil.ReconcileCounters, targetGroupCollector awsmetrics.TargetGroupCollector, targetGroupNameToArnMapper shared_utils.TargetGroupARNMapper, listenerSetStatusSubmitter ListenerSetStatusSubmitter) Reconciler {
+	return newGatewayReconciler(constants.ALBGatewayController, elbv2model.LoadBalancerTypeApplication, controllerConfig.ALBGatewayMaxConcurrentReconciles, constants.ALBGatewayTagPrefix, shared_constants.ALBGatewayFinalizer, certDiscovery, routeLoader, referenceCounter, routeutils.L7RouteFilter, cloud, k8sClient, eventRecorder, controllerConfig, finalizerManager, networkingSGReconciler, networkingManager, networkingSGManager, elbv2TaggingManager, shieldProtectionManager, subnetResolver, vpcInfoProvider, backendSGProvider, sgResolver, albAddons, targetGroupNameToArnMapper, logger, metricsCollector, reconcileCounters.IncrementALBGateway, targetGroupCollector, listenerSetStatusSubmitter)
 }
 
 // newGatewayReconciler constructs a reconciler that responds to gateway object changes
@@ -74,7 +82,7 @@ func newGatewayReconciler(controllerName string, lbType elbv2model.LoadBalancerT
 	gatewayTagPrefix string, finalizer string, certDiscovery certs.CertDiscovery, routeLoader routeutils.Loader, serviceReferenceCounter referencecounter.ServiceReferenceCounter, routeFilter routeutils.LoadRouteFilter,
 	cloud services.Cloud, k8sClient client.Client, eventRecorder record.EventRecorder, controllerConfig config.ControllerConfig,
 	finalizerManager k8s.FinalizerManager, networkingSGReconciler networking.SecurityGroupReconciler,
-	networkingManager networking.NetworkingManager, networkingSGManager networking.SecurityGroupManager, elbv2TaggingManager elbv2deploy.TaggingManager,
+	networkingManager networking.NetworkingManager, networkingSGManager networking.SecurityGroupManager, elbv2TaggingManager elbv2deploy.TaggingManager, shieldProtectionManager shield.ProtectionManager,
 	subnetResolver networking.SubnetsResolver, vpcInfoProvider networking.VPCInfoProvider, backendSGProvider networking.BackendSGProvider,
 	sgResolver networking.SecurityGroupResolver, supportedAddons []addon.Addon, targetGroupNameToArnMapper shared_utils.TargetGroupARNMapper, logger logr.Logger, metricsCollector lbcmetrics.MetricCollector,
 	reconcileTracker func(namespaceName types.NamespacedName), targetGroupCollector awsmetrics.TargetGroupCollector, listenerSetStatusSubmitter ListenerSetStatusSubmitter) Reconciler {
@@ -83,7 +91,7 @@ func newGatewayReconciler(controllerName string, lbType elbv2model.LoadBalancerT
 	modelBuilder := gatewaymodel.NewModelBuilder(subnetResolver, vpcInfoProvider, cloud.VpcID(), lbType, trackingProvider, elbv2TaggingManager, controllerConfig, cloud.EC2(), cloud.ELBV2(), certDiscovery, k8sClient, controllerConfig.FeatureGates, controllerConfig.ClusterName, controllerConfig.DefaultTags, sets.New(controllerConfig.ExternalManagedTags...), controllerConfig.DefaultSSLPolicy, controllerConfig.DefaultTargetType, controllerConfig.DefaultLoadBalancerScheme, backendSGProvider, sgResolver, controllerConfig.EnableBackendSecurityGroup, controllerConfig.DisableRestrictedSGRules, supportedAddons, logger)
 
 	stackMarshaller := deploy.NewDefaultStackMarshaller()
-	stackDeployer := deploy.NewDefaultStackDeployer(cloud, k8sClient, networkingManager, networkingSGManager, networkingSGReconciler, elbv2TaggingManager, controllerConfig, gatewayTagPrefix, logger, metricsCollector, controllerName, true, targetGroupCollector, lbType == elbv2model.LoadBalancerTypeNetwork)
+	stackDeployer := deploy.NewDefaultStackDeployer(cloud, k8sClient, networkingManager, networkingSGManager, networkingSGReconciler, elbv2TaggingManager, shieldProtectionManager, controllerConfig, gatewayTagPrefix, logger, metricsCollector, controllerName, true, targetGroupCollector, lbType == elbv2model.LoadBalancerTypeNetwork)
 
 	cfgResolver := newGatewayConfigResolver(logger.WithName("config-resolver"))
 
@@ -140,6 +148,9 @@ type gatewayReconciler struct {
 	lbcEventChan               chan event.TypedGenericEvent[*elbv2gw.LoadBalancerConfiguration]
 	listenerSetStatusSubmitter ListenerSetStatusSubmitter
 	listenerSetEnabled         bool
+
+	// sharedLoadBalancerLocks serializes the reconciliation of each shared load balancer stack.
+	sharedLoadBalancerLocks sync.Map
 }
 
 //+kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=referencegrants,verbs=get;list;watch;patch
@@ -188,6 +199,8 @@ type gatewayReconciler struct {
 // +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=listenersets/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=listenersets/finalizers,verbs=update
 
+// +kubebuilder:rbac:groups=multicluster.x-k8s.io,resources=serviceimports,verbs=get;list;watch
+
 func (r *gatewayReconciler) Reconcile(ctx context.Context, req reconcile.Request) (ctrl.Result, error) {
 	r.reconcileTracker(req.NamespacedName)
 	err := r.reconcileHelper(ctx, req)
@@ -232,38 +245,25 @@ func (r *gatewayReconciler) reconcileHelper(ctx context.Context, req reconcile.R
 
 	isDeleting := isGatewayDeleting(gw)
 
+	// A Gateway that joined a shared load balancer is reconciled together with the other Gateways sharing it,
+	// this also takes care of Gateways leaving the shared load balancer.
+	if joinedStackName := getJoinedSharedLoadBalancer(gw); joinedStackName != "" {
+		return r.reconcileJoinedSharedLoadBalancer(ctx, gw, mergedLbConfig, joinedStackName)
+	}
+	if sharedStackName := getSharedLoadBalancerStackName(gw, gwClass, mergedLbConfig, r.lbType); sharedStackName != "" && !isDeleting {
+		return r.joinSharedLoadBalancer(ctx, gw, sharedStackName)
+	}
+
 	loaderResults, err := r.gatewayLoader.LoadRoutesForGateway(ctx, *gw, r.routeFilter, r.controllerName, resolvedDefaultTGC)
 
 	if err != nil {
-		var loaderErr routeutils.LoaderError
-		if errors.As(err, &loaderErr) {
-			var gatewayReason gwv1.GatewayConditionReason
-			var gatewayMessage string
-			if loaderErr == nil {
-				gatewayReason = gwv1.GatewayReasonAccepted
-				gatewayMessage = gateway_constants.GatewayAcceptedFalseMessage
-			} else {
-				gatewayReason = loaderErr.GetGatewayReason()
-				gatewayMessage = loaderErr.GetGatewayMessage()
-			}
-			statusErr := r.updateGatewayStatusFailure(ctx, gw, gatewayReason, gatewayMessage, loaderResults)
-			if statusErr != nil {
-				r.logger.Error(statusErr, "Unable to update gateway status on failure to build routes")
-			}
-		}
+		r.handleLoadRoutesError(ctx, gw, loaderResults, err)
 		return err
 	}
 	allRoutes := loaderResults.Routes
 
 	// To handle Addons, we need to build the set that has been previously enabled. This is stored within the Gateway annotations.
-	allAddOns := getStoredAddonConfig(gw, r.logger)
-
-	currentAddOns := make([]addon.Addon, 0)
-	for _, ao := range allAddOns {
-		if ao.Enabled {
-			currentAddOns = append(currentAddOns, ao.Name)
-		}
-	}
+	currentAddOns := getEnabledAddOns(gw, r.logger)
 
 	stack, lb, newAddOnConfig, backendSGRequired, secrets, err := r.buildModel(ctx, gw, mergedLbConfig, loaderResults.Listeners, allRoutes, currentAddOns, isDeleting)
 
@@ -371,7 +371,12 @@ func (r *gatewayReconciler) reconcileUpdate(ctx context.Context, gw *gwv1.Gatewa
 		}
 	}
 
-	if err = r.updateGatewayStatusSuccess(ctx, lb.Status, gw, loaderResults); err != nil {
+	endpointServiceName, logDeliveryReady, err := getStackStatusDetails(ctx, stack)
+	if err != nil {
+		return err
+	}
+
+	if err = r.updateGatewayStatusSuccess(ctx, lb.Status, endpointServiceName, logDeliveryReady, gw, loaderResults); err != nil {
 		r.eventRecorder.Event(gw, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
 		return err
 	}
@@ -379,6 +384,46 @@ func (r *gatewayReconciler) reconcileUpdate(ctx context.Context, gw *gwv1.Gatewa
 	return nil
 }
 
+// getStackStatusDetails returns the VPC endpoint service name and whether the LoadBalancer logs are delivered,
+// from the deployed stack, to be reported in the Gateway status.
+func getStackStatusDetails(ctx context.Context, stack core.Stack) (string, bool, error) {
+	var endpointServiceName string
+	var resESs []*ec2model.VPCEndpointService
+	stack.ListResources(&resESs)
+	if len(resESs) != 0 {
+		var err error
+		endpointServiceName, err = resESs[0].ServiceName().Resolve(ctx)
+		if err != nil {
+			return "", false, err
+		}
+	}
+
+	var resLogBuckets []*s3model.LogBucket
+	stack.ListResources(&resLogBuckets)
+	return endpointServiceName, len(resLogBuckets) != 0, nil
+}
+
+// handleLoadRoutesError updates the Gateway status when the routes of the Gateway can't be loaded.
+func (r *gatewayReconciler) handleLoadRoutesError(ctx context.Context, gw *gwv1.Gateway, loaderResults *routeutils.LoaderResult, err error) {
+	var loaderErr routeutils.LoaderError
+	if !errors.As(err, &loaderErr) {
+		return
+	}
+	var gatewayReason gwv1.GatewayConditionReason
+	var gatewayMessage string
+	if loaderErr == nil {
+		gatewayReason = gwv1.GatewayReasonAccepted
+		gatewayMessage = gateway_constants.GatewayAcceptedFalseMessage
+	} else {
+		gatewayReason = loaderErr.GetGatewayReason()
+		gatewayMessage = loaderErr.GetGatewayMessage()
+	}
+	statusErr := r.updateGatewayStatusFailure(ctx, gw, gatewayReason, gatewayMessage, loaderResults)
+	if statusErr != nil {
+		r.logger.Error(statusErr, "Unable to update gateway status on failure to build routes")
+	}
+}
+
 // handleReconcileError updates the Gateway status when reconciliation fails.
 // It skips status updates for requeue errors since those are expected and will retry.
 // Uses a static message to avoid fast reconcile loops from dynamic error content like request IDs.
@@ -389,10 +434,70 @@ func (r *gatewayReconciler) handleReconcileError(ctx context.Context, gw *gwv1.G
 		return
 	}
 
+	var addressErr *subnet.AddressError
+	if errors.As(err, &addressErr) {
+		if statusErr := r.updateGatewayAddressFailure(ctx, gw, addressErr); statusErr != nil {
+			r.logger.Error(statusErr, "Unable to update gateway address status on reconcile failure")
+		}
+		return
+	}
+
 	statusErr := r.updateGatewayStatusFailure(ctx, gw, gwv1.GatewayReasonInvalid, gateway_constants.GatewayReconcileErrorMessage, nil)
 	if statusErr != nil {
 		r.logger.Error(statusErr, "Unable to update gateway status on reconcile failure")
 	}
+
+	var logDeliveryErr *s3deploy.LogDeliveryError
+	if errors.As(err, &logDeliveryErr) {
+		if statusErr := r.updateGatewayLogDeliveryFailure(ctx, gw, logDeliveryErr); statusErr != nil {
+			r.logger.Error(statusErr, "Unable to update gateway log delivery status on reconcile failure")
+		}
+	}
+}
+
+// updateGatewayAddressFailure reports why the addresses requested by the Gateway cannot be assigned.
+// The requested addresses are removed from the status addresses, since they aren't bound to the Gateway.
+func (r *gatewayReconciler) updateGatewayAddressFailure(ctx context.Context, gw *gwv1.Gateway, addressErr *subnet.AddressError) error {
+	gwOld := gw.DeepCopy()
+	var needPatch bool
+	if addressErr.Reason == gwv1.GatewayReasonUnsupportedAddress {
+		needPatch = r.gatewayConditionUpdater(gw, string(gwv1.GatewayConditionAccepted), metav1.ConditionFalse, string(addressErr.Reason), addressErr.Message)
+		needPatch = r.gatewayConditionUpdater(gw, string(gwv1.GatewayConditionProgrammed), metav1.ConditionFalse, string(gwv1.GatewayReasonInvalid), addressErr.Message) || needPatch
+	} else {
+		needPatch = r.gatewayConditionUpdater(gw, string(gwv1.GatewayConditionProgrammed), metav1.ConditionFalse, string(addressErr.Reason), addressErr.Message)
+	}
+
+	var hostnameAddresses []gwv1.GatewayStatusAddress
+	for _, address := range gw.Status.Addresses {
+		if address.Type != nil && *address.Type == gwv1.HostnameAddressType {
+			hostnameAddresses = append(hostnameAddresses, address)
+		}
+	}
+	if len(hostnameAddresses) != len(gw.Status.Addresses) {
+		gw.Status.Addresses = hostnameAddresses
+		needPatch = true
+	}
+
+	if !needPatch {
+		return nil
+	}
+	if err := r.k8sClient.Status().Patch(ctx, gw, client.MergeFrom(gwOld)); err != nil {
+		return errors.Wrapf(err, "failed to update gw status: %v", k8s.NamespacedName(gw))
+	}
+	return nil
+}
+
+// updateGatewayLogDeliveryFailure reports why LoadBalancer logs cannot be delivered to their bucket.
+func (r *gatewayReconciler) updateGatewayLogDeliveryFailure(ctx context.Context, gw *gwv1.Gateway, logDeliveryErr *s3deploy.LogDeliveryError) error {
+	gwOld := gw.DeepCopy()
+	message := fmt.Sprintf("bucket %v: %v", logDeliveryErr.BucketName, logDeliveryErr.Message)
+	if !r.gatewayConditionUpdater(gw, shared_constants.LogDeliveryConditionType, metav1.ConditionFalse, logDeliveryErr.Reason, message) {
+		return nil
+	}
+	if err := r.k8sClient.Status().Patch(ctx, gw, client.MergeFrom(gwOld)); err != nil {
+		return errors.Wrapf(err, "failed to update gw status: %v", k8s.NamespacedName(gw))
+	}
+	return nil
 }
 
 func (r *gatewayReconciler) deployModel(ctx context.Context, gw *gwv1.Gateway, stack core.Stack, secrets []types.NamespacedName) error {
@@ -426,7 +531,7 @@ func (r *gatewayReconciler) buildModel(ctx context.Context, gw *gwv1.Gateway, cf
 	return stack, lb, newAddOnConfig, backendSGRequired, secrets, nil
 }
 
-func (r *gatewayReconciler) updateGatewayStatusSuccess(ctx context.Context, lbStatus *elbv2model.LoadBalancerStatus, gw *gwv1.Gateway, loaderResults routeutils.LoaderResult) error {
+func (r *gatewayReconciler) updateGatewayStatusSuccess(ctx context.Context, lbStatus *elbv2model.LoadBalancerStatus, endpointServiceName string, logDeliveryReady bool, gw *gwv1.Gateway, loaderResults routeutils.LoaderResult) error {
 	// LB Status should always be set, if it's not, we need to prevent NPE
 	if lbStatus == nil {
 		r.logger.Info("Unable to update Gateway Status due to null LB status")
@@ -476,15 +581,21 @@ func (r *gatewayReconciler) updateGatewayStatusSuccess(ctx context.Context, lbSt
 	}
 
 	needPatch = r.gatewayConditionUpdater(gw, string(gwv1.GatewayConditionAccepted), isAccepted, string(acceptedConditioned), "") || needPatch
-	normalizedDNSName := strings.ToLower(lbStatus.DNSName)
-	if len(gw.Status.Addresses) != 1 ||
-		gw.Status.Addresses[0].Value != normalizedDNSName {
-		gw.Status.Addresses = []gwv1.GatewayStatusAddress{
-			{
-				Type:  new(gwv1.HostnameAddressType),
-				Value: normalizedDNSName,
-			},
-		}
+	statusAddresses := buildGatewayStatusAddresses(lbStatus.DNSName, gw)
+	if !equality.Semantic.DeepEqual(gw.Status.Addresses, statusAddresses) {
+		gw.Status.Addresses = statusAddresses
+		needPatch = true
+	}
+
+	if endpointServiceName != "" {
+		needPatch = r.gatewayConditionUpdater(gw, shared_constants.VPCEndpointServiceConditionType, metav1.ConditionTrue, shared_constants.VPCEndpointServiceConditionReasonAvailable, endpointServiceName) || needPatch
+	} else if meta.RemoveStatusCondition(&gw.Status.Conditions, shared_constants.VPCEndpointServiceConditionType) {
+		needPatch = true
+	}
+
+	if logDeliveryReady {
+		needPatch = r.gatewayConditionUpdater(gw, shared_constants.LogDeliveryConditionType, metav1.ConditionTrue, shared_constants.LogDeliveryConditionReasonReady, "") || needPatch
+	} else if meta.RemoveStatusCondition(&gw.Status.Conditions, shared_constants.LogDeliveryConditionType) {
 		needPatch = true
 	}
 
@@ -627,7 +738,7 @@ func (r *gatewayReconciler) setupALBGatewayControllerWatches(ctrl controller.Con
 	grpcRouteEventChan := make(chan event.TypedGenericEvent[*gwv1.GRPCRoute])
 	svcEventChan := make(chan event.TypedGenericEvent[*corev1.Service])
 	secretEventsChan := make(chan event.TypedGenericEvent[*corev1.Secret])
-	tgConfigEventHandler := eventhandlers.NewEnqueueRequestsForTargetGroupConfigurationEvent(svcEventChan, nil, r.lbcEventChan, r.k8sClient, r.eventRecorder,
+	tgConfigEventHandler := eventhandlers.NewEnqueueRequestsForTargetGroupConfigurationEvent(svcEventChan, httpRouteEventChan, grpcRouteEventChan, nil, r.lbcEventChan, r.k8sClient, r.eventRecorder,
 		loggerPrefix.WithName("TargetGroupConfiguration"), constants.ALBGatewayController)
 	listenerRuleConfigEventHandler := eventhandlers.NewEnqueueRequestsForListenerRuleConfigurationEvent(httpRouteEventChan, grpcRouteEventChan, r.k8sClient, loggerPrefix.WithName("ListenerRuleConfiguration"))
 	grpcRouteEventHandler := eventhandlers.NewEnqueueRequestsForGRPCRouteEvent(r.k8sClient, r.eventRecorder,
@@ -638,8 +749,10 @@ func (r *gatewayReconciler) setupALBGatewayControllerWatches(ctrl controller.Con
 		loggerPrefix.WithName("Service"), constants.ALBGatewayController)
 	refGrantHandler := eventhandlers.NewEnqueueRequestsForReferenceGrantEvent(httpRouteEventChan, grpcRouteEventChan, nil, nil, nil, r.k8sClient, r.eventRecorder,
 		loggerPrefix.WithName("ReferenceGrant"))
-	secretEventHandler := eventhandlers.NewEnqueueRequestsForSecretEvent(listenerRuleConfigEventChan, r.k8sClient, r.eventRecorder,
+	secretEventHandler := eventhandlers.NewEnqueueRequestsForSecretEvent(listenerRuleConfigEventChan, r.k8sClient, r.eventRecorder, r.controllerName,
 		r.logger.WithName("eventHandlers").WithName("secret"))
+	configMapEventHandler := eventhandlers.NewEnqueueRequestsForConfigMapEvent(r.k8sClient, r.controllerName,
+		loggerPrefix.WithName("ConfigMap"))
 	if err := ctrl.Watch(source.Channel(tbConfigEventChan, tgConfigEventHandler)); err != nil {
 		return err
 	}
@@ -676,6 +789,9 @@ func (r *gatewayReconciler) setupALBGatewayControllerWatches(ctrl controller.Con
 	if err := ctrl.Watch(source.Kind(mgr.GetCache(), &gwv1.GRPCRoute{}, grpcRouteEventHandler)); err != nil {
 		return err
 	}
+	if err := ctrl.Watch(source.Kind(mgr.GetCache(), k8s.NewConfigMapMetadata(), configMapEventHandler)); err != nil {
+		return err
+	}
 
 	if r.listenerSetEnabled {
 		listenerSetEventHandler := eventhandlers.NewEnqueueRequestsForListenerSetEvent(
@@ -698,7 +814,7 @@ func (r *gatewayReconciler) setupNLBGatewayControllerWatches(ctrl controller.Con
 	udpRouteEventChan := make(chan event.TypedGenericEvent[*gwv1.UDPRoute])
 	tlsRouteEventChan := make(chan event.TypedGenericEvent[*gwv1.TLSRoute])
 	svcEventChan := make(chan event.TypedGenericEvent[*corev1.Service])
-	tgConfigEventHandler := eventhandlers.NewEnqueueRequestsForTargetGroupConfigurationEvent(svcEventChan, tcpRouteEventChan, r.lbcEventChan, r.k8sClient, r.eventRecorder,
+	tgConfigEventHandler := eventhandlers.NewEnqueueRequestsForTargetGroupConfigurationEvent(svcEventChan, nil, nil, tcpRouteEventChan, r.lbcEventChan, r.k8sClient, r.eventRecorder,
 		loggerPrefix.WithName("TargetGroupConfiguration"), constants.NLBGatewayController)
 	tcpRouteEventHandler := eventhandlers.NewEnqueueRequestsForTCPRouteEvent(r.k8sClient, r.eventRecorder,
 		loggerPrefix.WithName("TCPRoute"))
@@ -756,6 +872,28 @@ func (r *gatewayReconciler) setupNLBGatewayControllerWatches(ctrl controller.Con
 
 }
 
+// buildGatewayStatusAddresses builds the addresses bound to the Gateway, which are the DNS name of the load balancer
+// and the addresses requested by the Gateway that are assigned to the load balancer.
+func buildGatewayStatusAddresses(dnsName string, gw *gwv1.Gateway) []gwv1.GatewayStatusAddress {
+	statusAddresses := []gwv1.GatewayStatusAddress{
+		{
+			Type:  new(gwv1.HostnameAddressType),
+			Value: strings.ToLower(dnsName),
+		},
+	}
+	for _, address := range gw.Spec.Addresses {
+		addressType := gwv1.IPAddressType
+		if address.Type != nil {
+			addressType = *address.Type
+		}
+		statusAddresses = append(statusAddresses, gwv1.GatewayStatusAddress{
+			Type:  &addressType,
+			Value: address.Value,
+		})
+	}
+	return statusAddresses
+}
+
 func isGatewayProgrammed(lbStatus elbv2model.LoadBalancerStatus) bool {
 	if lbStatus.ProvisioningState == nil {
 		return false
@@ -8,14 +8,19 @@ import (
 
 	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/go-logr/logr"
+	pkgerrors "github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/record"
+	s3deploy "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/deploy/s3"
 	ctrlerrors "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/error"
 	gateway_constants "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway/constants"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway/model/subnet"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway/routeutils"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/k8s"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/shared_constants"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/testutils"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
@@ -171,7 +176,7 @@ func Test_updateGatewayStatusSuccess_normalizesDNSNameToLowercase(t *testing.T)
 		},
 	}
 
-	err = reconciler.updateGatewayStatusSuccess(context.Background(), lbStatus, gw, routeutils.LoaderResult{})
+	err = reconciler.updateGatewayStatusSuccess(context.Background(), lbStatus, "", false, gw, routeutils.LoaderResult{})
 	assert.NoError(t, err)
 
 	updatedGW := &gwv1.Gateway{}
@@ -182,3 +187,214 @@ func Test_updateGatewayStatusSuccess_normalizesDNSNameToLowercase(t *testing.T)
 	assert.NotNil(t, updatedGW.Status.Addresses[0].Type)
 	assert.Equal(t, gwv1.HostnameAddressType, *updatedGW.Status.Addresses[0].Type)
 }
+
+func Test_handleReconcileError_logDelivery(t *testing.T) {
+	k8sClient := testutils.GenerateTestClient()
+	gw := &gwv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-gw",
+			Namespace: "test-ns",
+		},
+	}
+	err := k8sClient.Create(context.Background(), gw)
+	assert.NoError(t, err)
+
+	reconciler := &gatewayReconciler{
+		k8sClient:               k8sClient,
+		logger:                  logr.Discard(),
+		eventRecorder:           record.NewFakeRecorder(10),
+		gatewayConditionUpdater: prepareGatewayConditionUpdate,
+	}
+	logDeliveryErr := &s3deploy.LogDeliveryError{
+		BucketName: "my-logs",
+		Reason:     shared_constants.LogDeliveryConditionReasonBucketNotFound,
+		Message:    "bucket doesn't exist",
+	}
+	reconciler.handleReconcileError(context.Background(), gw.DeepCopy(), pkgerrors.Wrap(logDeliveryErr, "failed to deploy"))
+
+	storedGw := &gwv1.Gateway{}
+	err = k8sClient.Get(context.Background(), k8s.NamespacedName(gw), storedGw)
+	assert.NoError(t, err)
+	logDeliveryCondition := meta.FindStatusCondition(storedGw.Status.Conditions, shared_constants.LogDeliveryConditionType)
+	assert.NotNil(t, logDeliveryCondition)
+	assert.Equal(t, metav1.ConditionFalse, logDeliveryCondition.Status)
+	assert.Equal(t, shared_constants.LogDeliveryConditionReasonBucketNotFound, logDeliveryCondition.Reason)
+	assert.Equal(t, "bucket my-logs: bucket doesn't exist", logDeliveryCondition.Message)
+}
+
+func Test_updateGatewayStatusSuccess_logDelivery(t *testing.T) {
+	k8sClient := testutils.GenerateTestClient()
+	gw := &gwv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-gw",
+			Namespace: "test-ns",
+		},
+	}
+	err := k8sClient.Create(context.Background(), gw)
+	assert.NoError(t, err)
+
+	reconciler := &gatewayReconciler{
+		k8sClient:                  k8sClient,
+		logger:                     logr.Discard(),
+		eventRecorder:              record.NewFakeRecorder(10),
+		gatewayConditionUpdater:    prepareGatewayConditionUpdate,
+		listenerSetStatusSubmitter: &NoopListenerSetStatusSubmitter{},
+	}
+	lbStatus := &elbv2model.LoadBalancerStatus{
+		LoadBalancerARN: "arn:aws:elasticloadbalancing:region:account-id:loadbalancer/app/my-alb/123456789",
+		DNSName:         "my-alb-1234567890.eu-west-1.elb.amazonaws.com",
+		ProvisioningState: &elbv2types.LoadBalancerState{
+			Code: elbv2types.LoadBalancerStateEnumActive,
+		},
+	}
+
+	err = reconciler.updateGatewayStatusSuccess(context.Background(), lbStatus, "", true, gw, routeutils.LoaderResult{})
+	assert.NoError(t, err)
+	storedGw := &gwv1.Gateway{}
+	err = k8sClient.Get(context.Background(), k8s.NamespacedName(gw), storedGw)
+	assert.NoError(t, err)
+	logDeliveryCondition := meta.FindStatusCondition(storedGw.Status.Conditions, shared_constants.LogDeliveryConditionType)
+	assert.NotNil(t, logDeliveryCondition)
+	assert.Equal(t, metav1.ConditionTrue, logDeliveryCondition.Status)
+	assert.Equal(t, shared_constants.LogDeliveryConditionReasonReady, logDeliveryCondition.Reason)
+
+	err = reconciler.updateGatewayStatusSuccess(context.Background(), lbStatus, "", false, storedGw, routeutils.LoaderResult{})
+	assert.NoError(t, err)
+	err = k8sClient.Get(context.Background(), k8s.NamespacedName(gw), storedGw)
+	assert.NoError(t, err)
+	assert.Nil(t, meta.FindStatusCondition(storedGw.Status.Conditions, shared_constants.LogDeliveryConditionType))
+}
+
+func Test_handleReconcileError_address(t *testing.T) {
+	testCases := []struct {
+		name               string
+		addressErr         *subnet.AddressError
+		expectedAccepted   *metav1.Condition
+		expectedProgrammed metav1.Condition
+	}{
+		{
+			name: "address not usable",
+			addressErr: &subnet.AddressError{
+				Reason:  gwv1.GatewayReasonAddressNotUsable,
+				Message: "address 10.0.0.5 doesn't belong to any subnet of the load balancer",
+			},
+			expectedProgrammed: metav1.Condition{
+				Status:  metav1.ConditionFalse,
+				Reason:  string(gwv1.GatewayReasonAddressNotUsable),
+				Message: "address 10.0.0.5 doesn't belong to any subnet of the load balancer",
+			},
+		},
+		{
+			name: "unsupported address type",
+			addressErr: &subnet.AddressError{
+				Reason:  gwv1.GatewayReasonUnsupportedAddress,
+				Message: "address type Hostname is not supported",
+			},
+			expectedAccepted: &metav1.Condition{
+				Status:  metav1.ConditionFalse,
+				Reason:  string(gwv1.GatewayReasonUnsupportedAddress),
+				Message: "address type Hostname is not supported",
+			},
+			expectedProgrammed: metav1.Condition{
+				Status:  metav1.ConditionFalse,
+				Reason:  string(gwv1.GatewayReasonInvalid),
+				Message: "address type Hostname is not supported",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k8sClient := testutils.GenerateTestClient()
+			gw := &gwv1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-gw",
+					Namespace: "test-ns",
+				},
+				Status: gwv1.GatewayStatus{
+					Addresses: []gwv1.GatewayStatusAddress{
+						{Type: new(gwv1.HostnameAddressType), Value: "my-nlb-1234567890.elb.eu-west-1.amazonaws.com"},
+						{Type: new(gwv1.IPAddressType), Value: "10.0.0.4"},
+					},
+				},
+			}
+			err := k8sClient.Create(context.Background(), gw)
+			assert.NoError(t, err)
+
+			reconciler := &gatewayReconciler{
+				k8sClient:               k8sClient,
+				logger:                  logr.Discard(),
+				eventRecorder:           record.NewFakeRecorder(10),
+				gatewayConditionUpdater: prepareGatewayConditionUpdate,
+			}
+			reconciler.handleReconcileError(context.Background(), gw.DeepCopy(), pkgerrors.Wrap(tc.addressErr, "failed to build subnets"))
+
+			storedGw := &gwv1.Gateway{}
+			err = k8sClient.Get(context.Background(), k8s.NamespacedName(gw), storedGw)
+			assert.NoError(t, err)
+
+			acceptedCondition := meta.FindStatusCondition(storedGw.Status.Conditions, string(gwv1.GatewayConditionAccepted))
+			if tc.expectedAccepted == nil {
+				assert.Nil(t, acceptedCondition)
+			} else {
+				assert.NotNil(t, acceptedCondition)
+				assert.Equal(t, tc.expectedAccepted.Status, acceptedCondition.Status)
+				assert.Equal(t, tc.expectedAccepted.Reason, acceptedCondition.Reason)
+				assert.Equal(t, tc.expectedAccepted.Message, acceptedCondition.Message)
+			}
+			programmedCondition := meta.FindStatusCondition(storedGw.Status.Conditions, string(gwv1.GatewayConditionProgrammed))
+			assert.NotNil(t, programmedCondition)
+			assert.Equal(t, tc.expectedProgrammed.Status, programmedCondition.Status)
+			assert.Equal(t, tc.expectedProgrammed.Reason, programmedCondition.Reason)
+			assert.Equal(t, tc.expectedProgrammed.Message, programmedCondition.Message)
+
+			assert.Equal(t, []gwv1.GatewayStatusAddress{
+				{Type: new(gwv1.HostnameAddressType), Value: "my-nlb-1234567890.elb.eu-west-1.amazonaws.com"},
+			}, storedGw.Status.Addresses)
+		})
+	}
+}
+
+func Test_updateGatewayStatusSuccess_addresses(t *testing.T) {
+	k8sClient := testutils.GenerateTestClient()
+	gw := &gwv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-gw",
+			Namespace: "test-ns",
+		},
+		Spec: gwv1.GatewaySpec{
+			Addresses: []gwv1.GatewaySpecAddress{
+				{Value: "10.0.0.4"},
+				{Type: new(gwv1.IPAddressType), Value: "2600:1f14::1"},
+			},
+		},
+	}
+	err := k8sClient.Create(context.Background(), gw)
+	assert.NoError(t, err)
+
+	reconciler := &gatewayReconciler{
+		k8sClient:                  k8sClient,
+		logger:                     logr.Discard(),
+		eventRecorder:              record.NewFakeRecorder(10),
+		gatewayConditionUpdater:    prepareGatewayConditionUpdate,
+		listenerSetStatusSubmitter: &NoopListenerSetStatusSubmitter{},
+	}
+	lbStatus := &elbv2model.LoadBalancerStatus{
+		LoadBalancerARN: "arn:aws:elasticloadbalancing:region:account-id:loadbalancer/net/my-nlb/123456789",
+		DNSName:         "my-nlb-1234567890.elb.eu-west-1.amazonaws.com",
+		ProvisioningState: &elbv2types.LoadBalancerState{
+			Code: elbv2types.LoadBalancerStateEnumActive,
+		},
+	}
+
+	err = reconciler.updateGatewayStatusSuccess(context.Background(), lbStatus, "", false, gw, routeutils.LoaderResult{})
+	assert.NoError(t, err)
+	storedGw := &gwv1.Gateway{}
+	err = k8sClient.Get(context.Background(), k8s.NamespacedName(gw), storedGw)
+	assert.NoError(t, err)
+	assert.Equal(t, []gwv1.GatewayStatusAddress{
+		{Type: new(gwv1.HostnameAddressType), Value: "my-nlb-1234567890.elb.eu-west-1.amazonaws.com"},
+		{Type: new(gwv1.IPAddressType), Value: "10.0.0.4"},
+		{Type: new(gwv1.IPAddressType), Value: "2600:1f14::1"},
+	}, storedGw.Status.Addresses)
+}
@@ -121,16 +121,19 @@ func getListenerConditions(generation int64, listenerValidationResult routeutils
 
 	// Build Accepted Conditions
 	switch listenerReason {
-	case gwv1.ListenerReasonPortUnavailable, gwv1.ListenerReasonUnsupportedProtocol, gwv1.ListenerReasonHostnameConflict, gwv1.ListenerReasonProtocolConflict:
+	case gwv1.ListenerReasonPortUnavailable, gwv1.ListenerReasonUnsupportedProtocol, gwv1.ListenerReasonHostnameConflict, gwv1.ListenerReasonProtocolConflict,
+		gwv1.ListenerReasonNoValidCACertificate, gwv1.ListenerReasonUnsupportedValue:
 		conditions = append(conditions, buildAcceptedCondition(generation, listenerReason, listenerErrMessage))
 	default:
 		conditions = append(conditions, buildAcceptedCondition(generation, gwv1.ListenerReasonAccepted, gateway_constants.ListenerAcceptedMessage))
 	}
 
 	// Build ResolvedRefs Conditions
-	switch listenerReason {
-	case gwv1.ListenerReasonInvalidRouteKinds, gwv1.ListenerReasonRefNotPermitted:
+	switch {
+	case listenerReason == gwv1.ListenerReasonInvalidRouteKinds || listenerReason == gwv1.ListenerReasonRefNotPermitted:
 		conditions = append(conditions, buildResolvedRefsCondition(generation, listenerReason, listenerErrMessage))
+	case listenerValidationResult.ResolvedRefsReason != "":
+		conditions = append(conditions, buildResolvedRefsCondition(generation, listenerValidationResult.ResolvedRefsReason, listenerValidationResult.ResolvedRefsMessage))
 	default:
 		conditions = append(conditions, buildResolvedRefsCondition(generation, gwv1.ListenerReasonResolvedRefs, gateway_constants.ListenerResolvedRefMessage))
 	}
@@ -157,6 +157,53 @@ func Test_getListenerConditions(t *testing.T) {
 			expectedProgrammedReason: string(gwv1.ListenerReasonRefNotPermitted),
 			generation:               8,
 		},
+		{
+			name: "validation result with no valid CA certificate",
+			listenerValidationResult: routeutils.ListenerValidationResult{
+				Reason:              gwv1.ListenerReasonNoValidCACertificate,
+				Message:             "No valid CA certificate",
+				ResolvedRefsReason:  gwv1.ListenerReasonInvalidCACertificateRef,
+				ResolvedRefsMessage: "CA certificate ref not found",
+			},
+			isProgrammed:             false,
+			expectedConditionCount:   4,
+			expectedConflictReason:   string(gwv1.ListenerReasonNoConflicts),
+			expectedAcceptedReason:   string(gwv1.ListenerReasonNoValidCACertificate),
+			expectedResolvedReason:   string(gwv1.ListenerReasonInvalidCACertificateRef),
+			expectedProgrammedReason: string(gwv1.ListenerReasonNoValidCACertificate),
+			generation:               9,
+		},
+		{
+			name: "accepted validation result with invalid CA certificate kind",
+			listenerValidationResult: routeutils.ListenerValidationResult{
+				IsValid:             true,
+				Reason:              gwv1.ListenerReasonAccepted,
+				Message:             "Listener is accepted",
+				ResolvedRefsReason:  gwv1.ListenerReasonInvalidCACertificateKind,
+				ResolvedRefsMessage: "Unsupported kind",
+			},
+			isProgrammed:             true,
+			expectedConditionCount:   4,
+			expectedConflictReason:   string(gwv1.ListenerReasonNoConflicts),
+			expectedAcceptedReason:   string(gwv1.ListenerReasonAccepted),
+			expectedResolvedReason:   string(gwv1.ListenerReasonInvalidCACertificateKind),
+			expectedProgrammedReason: string(gwv1.ListenerReasonProgrammed),
+			generation:               10,
+		},
+		{
+			name: "validation result with unsupported value",
+			listenerValidationResult: routeutils.ListenerValidationResult{
+				Reason:  gwv1.ListenerReasonUnsupportedValue,
+				Message: "Unsupported client certificate validation mode",
+			},
+			isProgrammed:             false,
+			expectedConditionCount:   4,
+			expectedConflictReason:   string(gwv1.ListenerReasonNoConflicts),
+			expectedAcceptedReason:   string(gwv1.ListenerReasonUnsupportedValue),
+			expectedResolvedReason:   string(gwv1.ListenerReasonResolvedRefs),
+			expectedProgrammedReason: string(gwv1.ListenerReasonUnsupportedValue),
+			generation:               11,
+		},
 	}
 
 	for _, tt := range tests {
@@ -3,11 +3,19 @@ package gateway
 import (
 	"context"
 	"fmt"
+	"strings"
+
 	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/record"
 	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/v3/apis/gateway/v1"
+	configurationeventhandlers "sigs.k8s.io/aws-load-balancer-controller/v3/controllers/gateway/eventhandlers/configuration"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway/constants"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway/gatewayutils"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/k8s"
@@ -17,8 +25,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
 // NewLoadbalancerConfigurationReconciler constructs a reconciler that responds to loadbalancer configuration changes
@@ -29,6 +39,7 @@ func NewLoadbalancerConfigurationReconciler(k8sClient client.Client, eventRecord
 		eventRecorder:    eventRecorder,
 		logger:           logger,
 		finalizerManager: finalizerManager,
+		configMergeFn:    gateway.NewLoadBalancerConfigMerger().Merge,
 		workers:          controllerConfig.GatewayClassMaxConcurrentReconciles,
 	}
 }
@@ -39,6 +50,7 @@ type loadbalancerConfigurationReconciler struct {
 	logger           logr.Logger
 	eventRecorder    record.EventRecorder
 	finalizerManager k8s.FinalizerManager
+	configMergeFn    func(gwClassLbConfig elbv2gw.LoadBalancerConfiguration, gwLbConfig elbv2gw.LoadBalancerConfiguration) elbv2gw.LoadBalancerConfiguration
 	workers          int
 }
 
@@ -48,6 +60,21 @@ func (r *loadbalancerConfigurationReconciler) SetupWatches(_ context.Context, ct
 		return err
 	}
 
+	// Gateways and GatewayClasses are the ancestors reported in the status of a loadbalancer configuration.
+	gwEventHandler := configurationeventhandlers.NewEnqueueRequestsForObjectEvent(configurationeventhandlers.LoadBalancerConfigurationsForGateway, r.logger)
+	if err := ctrl.Watch(source.Kind(mgr.GetCache(), &gwv1.Gateway{}, gwEventHandler)); err != nil {
+		return err
+	}
+	gwClassEventHandler := configurationeventhandlers.NewEnqueueRequestsForObjectEvent(configurationeventhandlers.LoadBalancerConfigurationsForGatewayClass, r.logger)
+	if err := ctrl.Watch(source.Kind(mgr.GetCache(), &gwv1.GatewayClass{}, gwClassEventHandler)); err != nil {
+		return err
+	}
+	tgcEventHandler := configurationeventhandlers.NewEnqueueRequestsForObjectEvent(configurationeventhandlers.NewLoadBalancerConfigurationsForTargetGroupConfiguration(r.k8sClient, r.logger), r.logger)
+	if err := ctrl.Watch(source.Kind(mgr.GetCache(), &elbv2gw.TargetGroupConfiguration{}, tgcEventHandler,
+		predicate.TypedGenerationChangedPredicate[*elbv2gw.TargetGroupConfiguration]{})); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -64,17 +91,23 @@ func (r *loadbalancerConfigurationReconciler) reconcile(ctx context.Context, req
 	r.logger.V(1).Info("Found loadbalancer configuration", "cfg", lbConf)
 
 	if lbConf.DeletionTimestamp == nil || lbConf.DeletionTimestamp.IsZero() {
-		return r.handleUpdate(lbConf)
+		return r.handleUpdate(ctx, lbConf)
 	}
 
 	return r.handleDelete(lbConf)
 }
 
-func (r *loadbalancerConfigurationReconciler) handleUpdate(lbConf *elbv2gw.LoadBalancerConfiguration) error {
-	if k8s.HasFinalizer(lbConf, shared_constants.LoadBalancerConfigurationFinalizer) {
-		return nil
+func (r *loadbalancerConfigurationReconciler) handleUpdate(ctx context.Context, lbConf *elbv2gw.LoadBalancerConfiguration) error {
+	if !k8s.HasFinalizer(lbConf, shared_constants.LoadBalancerConfigurationFinalizer) {
+		if err := r.finalizerManager.AddFinalizers(ctx, lbConf, shared_constants.LoadBalancerConfigurationFinalizer); err != nil {
+			return err
+		}
 	}
-	return r.finalizerManager.AddFinalizers(context.Background(), lbConf, shared_constants.LoadBalancerConfigurationFinalizer)
+	ancestors, err := r.buildAncestorStatuses(ctx, lbConf)
+	if err != nil {
+		return err
+	}
+	return r.updateStatus(ctx, lbConf, ancestors)
 }
 
 func (r *loadbalancerConfigurationReconciler) handleDelete(lbConf *elbv2gw.LoadBalancerConfiguration) error {
@@ -94,6 +127,161 @@ func (r *loadbalancerConfigurationReconciler) handleDelete(lbConf *elbv2gw.LoadB
 	return r.finalizerManager.RemoveFinalizers(context.Background(), lbConf, shared_constants.LoadBalancerConfigurationFinalizer)
 }
 
+// buildAncestorStatuses computes the status of the loadbalancer configuration for each Gateway and GatewayClass that references it.
+func (r *loadbalancerConfigurationReconciler) buildAncestorStatuses(ctx context.Context, lbConf *elbv2gw.LoadBalancerConfiguration) ([]elbv2gw.ConfigurationAncestorStatus, error) {
+	defaultTGC, invalidRefMessage, err := r.resolveDefaultTargetGroupConfiguration(ctx, lbConf)
+	if err != nil {
+		return nil, err
+	}
+	resolvedRefsCondition := newConfigCondition(elbv2gw.ConfigurationConditionResolvedRefs, metav1.ConditionTrue, elbv2gw.ConfigurationReasonResolvedRefs, "All references are resolved", lbConf.Generation)
+	if invalidRefMessage != "" {
+		resolvedRefsCondition = newConfigCondition(elbv2gw.ConfigurationConditionResolvedRefs, metav1.ConditionFalse, elbv2gw.ConfigurationReasonInvalidRef, invalidRefMessage, lbConf.Generation)
+	}
+	noConflictsCondition := newConfigCondition(elbv2gw.ConfigurationConditionConflicted, metav1.ConditionFalse, elbv2gw.ConfigurationReasonNoConflicts, "No conflicts", lbConf.Generation)
+
+	var ancestors []elbv2gw.ConfigurationAncestorStatus
+
+	gwClasses, err := gatewayutils.GetImpactedGatewayClassesFromLbConfig(ctx, r.k8sClient, lbConf, constants.FullGatewayControllerSet)
+	if err != nil {
+		return nil, err
+	}
+	for _, gwClass := range gwClasses {
+		ancestors = append(ancestors, elbv2gw.ConfigurationAncestorStatus{
+			AncestorRef:    gatewayClassAncestorRef(gwClass),
+			ControllerName: string(gwClass.Spec.ControllerName),
+			Conditions: []metav1.Condition{
+				r.buildGatewayClassAcceptedCondition(gwClass, lbConf, defaultTGC, invalidRefMessage),
+				resolvedRefsCondition,
+				noConflictsCondition,
+			},
+		})
+	}
+
+	for _, controllerName := range sets.List(constants.FullGatewayControllerSet) {
+		gws, err := gatewayutils.GetImpactedGatewaysFromLbConfig(ctx, r.k8sClient, lbConf, controllerName)
+		if err != nil {
+			return nil, err
+		}
+		for _, gw := range gws {
+			acceptedCondition, conflictedCondition, err := r.buildGatewayConditions(ctx, gw, lbConf, invalidRefMessage)
+			if err != nil {
+				return nil, err
+			}
+			ancestors = append(ancestors, elbv2gw.ConfigurationAncestorStatus{
+				AncestorRef:    gatewayAncestorRef(k8s.NamespacedName(gw)),
+				ControllerName: controllerName,
+				Conditions:     []metav1.Condition{acceptedCondition, resolvedRefsCondition, conflictedCondition},
+			})
+		}
+	}
+	return ancestors, nil
+}
+
+// resolveDefaultTargetGroupConfiguration resolves the default target group configuration of the loadbalancer configuration.
+// A message is returned instead of an error when the reference is invalid, so that it can be reported in the status.
+func (r *loadbalancerConfigurationReconciler) resolveDefaultTargetGroupConfiguration(ctx context.Context, lbConf *elbv2gw.LoadBalancerConfiguration) (*elbv2gw.TargetGroupConfiguration, string, error) {
+	if lbConf.Spec.DefaultTargetGroupConfiguration == nil {
+		return nil, "", nil
+	}
+	tgcKey := types.NamespacedName{Namespace: lbConf.Namespace, Name: lbConf.Spec.DefaultTargetGroupConfiguration.Name}
+	tgConf := &elbv2gw.TargetGroupConfiguration{}
+	if err := r.k8sClient.Get(ctx, tgcKey, tgConf); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Sprintf("default TargetGroupConfiguration %s not found", tgcKey), nil
+		}
+		return nil, "", err
+	}
+	if tgConf.Spec.TargetReference != nil {
+		return nil, fmt.Sprintf("default TargetGroupConfiguration %s has targetReference set", tgcKey), nil
+	}
+	return tgConf, "", nil
+}
+
+// buildGatewayClassAcceptedCondition computes whether a GatewayClass accepted the latest loadbalancer configuration.
+func (r *loadbalancerConfigurationReconciler) buildGatewayClassAcceptedCondition(gwClass *gwv1.GatewayClass, lbConf *elbv2gw.LoadBalancerConfiguration, defaultTGC *elbv2gw.TargetGroupConfiguration, invalidRefMessage string) metav1.Condition {
+	if invalidRefMessage != "" {
+		return newConfigCondition(elbv2gw.ConfigurationConditionAccepted, metav1.ConditionFalse, elbv2gw.ConfigurationReasonInvalid, invalidRefMessage, lbConf.Generation)
+	}
+	if indx, ok := deriveAcceptedConditionIndex(gwClass); ok && gwClass.Status.Conditions[indx].Status == metav1.ConditionFalse {
+		message := fmt.Sprintf("GatewayClass %s is not accepted: %s", gwClass.Name, gwClass.Status.Conditions[indx].Message)
+		return newConfigCondition(elbv2gw.ConfigurationConditionAccepted, metav1.ConditionFalse, elbv2gw.ConfigurationReasonInvalid, message, lbConf.Generation)
+	}
+	storedVersion := getStoredProcessedConfig(gwClass)
+	if storedVersion == nil || *storedVersion != computeProcessedConfigVersion(lbConf, defaultTGC) {
+		message := fmt.Sprintf("GatewayClass %s hasn't processed the latest configuration", gwClass.Name)
+		return newConfigCondition(elbv2gw.ConfigurationConditionAccepted, metav1.ConditionFalse, elbv2gw.ConfigurationReasonPending, message, lbConf.Generation)
+	}
+	return newConfigCondition(elbv2gw.ConfigurationConditionAccepted, metav1.ConditionTrue, elbv2gw.ConfigurationReasonAccepted, fmt.Sprintf("Accepted by GatewayClass %s", gwClass.Name), lbConf.Generation)
+}
+
+// buildGatewayConditions computes whether a Gateway accepted the loadbalancer configuration,
+// and whether the loadbalancer configuration of the GatewayClass overrides any of its fields.
+func (r *loadbalancerConfigurationReconciler) buildGatewayConditions(ctx context.Context, gw *gwv1.Gateway, lbConf *elbv2gw.LoadBalancerConfiguration, invalidRefMessage string) (metav1.Condition, metav1.Condition, error) {
+	conflictedCondition := newConfigCondition(elbv2gw.ConfigurationConditionConflicted, metav1.ConditionFalse, elbv2gw.ConfigurationReasonNoConflicts, "No conflicts", lbConf.Generation)
+
+	gwClass := &gwv1.GatewayClass{}
+	if err := r.k8sClient.Get(ctx, types.NamespacedName{Name: string(gw.Spec.GatewayClassName)}, gwClass); err != nil {
+		if apierrors.IsNotFound(err) {
+			message := fmt.Sprintf("GatewayClass %s of Gateway %s not found", gw.Spec.GatewayClassName, k8s.NamespacedName(gw))
+			return newConfigCondition(elbv2gw.ConfigurationConditionAccepted, metav1.ConditionFalse, elbv2gw.ConfigurationReasonPending, message, lbConf.Generation), conflictedCondition, nil
+		}
+		return metav1.Condition{}, metav1.Condition{}, err
+	}
+
+	gwClassLBConf, err := r.resolveGatewayClassLoadBalancerConfiguration(ctx, gwClass)
+	if err != nil {
+		return metav1.Condition{}, metav1.Condition{}, err
+	}
+	if gwClassLBConf != nil {
+		overridden := gateway.GetOverriddenFields(*lbConf, r.configMergeFn(*gwClassLBConf, *lbConf))
+		if len(overridden) != 0 {
+			message := fmt.Sprintf("LoadBalancerConfiguration %s of GatewayClass %s takes precedence for %s", k8s.NamespacedName(gwClassLBConf), gwClass.Name, strings.Join(overridden, ", "))
+			conflictedCondition = newConfigCondition(elbv2gw.ConfigurationConditionConflicted, metav1.ConditionTrue, elbv2gw.ConfigurationReasonConflicted, message, lbConf.Generation)
+		}
+	}
+
+	if invalidRefMessage != "" {
+		return newConfigCondition(elbv2gw.ConfigurationConditionAccepted, metav1.ConditionFalse, elbv2gw.ConfigurationReasonInvalid, invalidRefMessage, lbConf.Generation), conflictedCondition, nil
+	}
+	if indx, ok := deriveAcceptedConditionIndex(gwClass); !ok || gwClass.Status.Conditions[indx].Status != metav1.ConditionTrue {
+		message := fmt.Sprintf("GatewayClass %s of Gateway %s is not accepted", gwClass.Name, k8s.NamespacedName(gw))
+		return newConfigCondition(elbv2gw.ConfigurationConditionAccepted, metav1.ConditionFalse, elbv2gw.ConfigurationReasonPending, message, lbConf.Generation), conflictedCondition, nil
+	}
+	message := fmt.Sprintf("Accepted by Gateway %s", k8s.NamespacedName(gw))
+	return newConfigCondition(elbv2gw.ConfigurationConditionAccepted, metav1.ConditionTrue, elbv2gw.ConfigurationReasonAccepted, message, lbConf.Generation), conflictedCondition, nil
+}
+
+// resolveGatewayClassLoadBalancerConfiguration returns the loadbalancer configuration of a GatewayClass, or nil when it has none.
+func (r *loadbalancerConfigurationReconciler) resolveGatewayClassLoadBalancerConfiguration(ctx context.Context, gwClass *gwv1.GatewayClass) (*elbv2gw.LoadBalancerConfiguration, error) {
+	paramRef := gwClass.Spec.ParametersRef
+	if paramRef == nil || paramRef.Namespace == nil || string(paramRef.Kind) != constants.LoadBalancerConfiguration {
+		return nil, nil
+	}
+	gwClassLBConf, err := gatewayutils.ResolveLoadBalancerConfig(ctx, r.k8sClient, paramRef)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return gwClassLBConf, nil
+}
+
+// updateStatus updates the LoadBalancerConfiguration status with the ancestor statuses
+func (r *loadbalancerConfigurationReconciler) updateStatus(ctx context.Context, lbConf *elbv2gw.LoadBalancerConfiguration, ancestors []elbv2gw.ConfigurationAncestorStatus) error {
+	mergedAncestors := mergeConfigAncestorStatuses(lbConf.Status.Ancestors, ancestors)
+	if isConfigAncestorStatusesEqual(lbConf.Status.Ancestors, mergedAncestors) {
+		return nil
+	}
+
+	lbConfOld := lbConf.DeepCopy()
+	lbConf.Status.Ancestors = mergedAncestors
+	if err := r.k8sClient.Status().Patch(ctx, lbConf, client.MergeFrom(lbConfOld)); err != nil {
+		return fmt.Errorf("failed to update LoadBalancerConfiguration status: %w", err)
+	}
+	return nil
+}
+
 func (r *loadbalancerConfigurationReconciler) SetupWithManager(_ context.Context, mgr ctrl.Manager) (controller.Controller, error) {
 	return controller.New(constants.LoadBalancerConfigurationController, mgr, controller.Options{
 		MaxConcurrentReconciles: r.workers,
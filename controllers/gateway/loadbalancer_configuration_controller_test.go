@@ -0,0 +1,156 @@
+package gateway
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/v3/apis/gateway/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway/constants"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/testutils"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func Test_loadbalancerConfigurationReconciler_buildAncestorStatuses(t *testing.T) {
+	internal := elbv2gw.LoadBalancerScheme("internal")
+	internetFacing := elbv2gw.LoadBalancerScheme("internet-facing")
+	ns := gwv1.Namespace("ns")
+
+	gwClassLBConf := &elbv2gw.LoadBalancerConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "class-lbc", Generation: 1},
+		Spec: elbv2gw.LoadBalancerConfigurationSpec{
+			Scheme: &internal,
+		},
+	}
+	gwClass := &gwv1.GatewayClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "alb-class"},
+		Spec: gwv1.GatewayClassSpec{
+			ControllerName: gwv1.GatewayController(constants.ALBGatewayController),
+			ParametersRef: &gwv1.ParametersReference{
+				Group:     constants.ControllerCRDGroupVersion,
+				Kind:      constants.LoadBalancerConfiguration,
+				Name:      "class-lbc",
+				Namespace: &ns,
+			},
+		},
+	}
+	acceptedCondition := metav1.Condition{
+		Type:               string(gwv1.GatewayClassConditionStatusAccepted),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(gwv1.GatewayClassReasonAccepted),
+		LastTransitionTime: metav1.Now(),
+	}
+	gw := &gwv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "gw"},
+		Spec: gwv1.GatewaySpec{
+			GatewayClassName: "alb-class",
+			Infrastructure: &gwv1.GatewayInfrastructure{
+				ParametersRef: &gwv1.LocalParametersReference{
+					Group: constants.ControllerCRDGroupVersion,
+					Kind:  constants.LoadBalancerConfiguration,
+					Name:  "gw-lbc",
+				},
+			},
+		},
+	}
+
+	conditionsByType := func(ancestor elbv2gw.ConfigurationAncestorStatus) map[elbv2gw.ConfigurationConditionType]metav1.Condition {
+		res := make(map[elbv2gw.ConfigurationConditionType]metav1.Condition)
+		for _, cond := range ancestor.Conditions {
+			res[elbv2gw.ConfigurationConditionType(cond.Type)] = cond
+		}
+		return res
+	}
+
+	setup := func(t *testing.T, processedVersion string) *loadbalancerConfigurationReconciler {
+		k8sClient := testutils.GenerateTestClient()
+		ctx := context.Background()
+		gwClassWithAnnotation := gwClass.DeepCopy()
+		gwClassWithAnnotation.Annotations = map[string]string{gatewayClassAnnotationLastProcessedConfig: processedVersion}
+		assert.NoError(t, k8sClient.Create(ctx, gwClassWithAnnotation))
+		gwClassWithAnnotation.Status.Conditions = []metav1.Condition{acceptedCondition}
+		assert.NoError(t, k8sClient.Status().Update(ctx, gwClassWithAnnotation))
+		assert.NoError(t, k8sClient.Create(ctx, gwClassLBConf.DeepCopy()))
+		assert.NoError(t, k8sClient.Create(ctx, gw.DeepCopy()))
+		return &loadbalancerConfigurationReconciler{
+			k8sClient:     k8sClient,
+			logger:        logr.Discard(),
+			configMergeFn: gateway.NewLoadBalancerConfigMerger().Merge,
+		}
+	}
+
+	t.Run("gateway class accepted the latest configuration", func(t *testing.T) {
+		r := setup(t, "1")
+		ancestors, err := r.buildAncestorStatuses(context.Background(), gwClassLBConf)
+		assert.NoError(t, err)
+		assert.Len(t, ancestors, 1)
+		assert.Equal(t, gatewayClassAncestorRef(gwClass), ancestors[0].AncestorRef)
+		assert.Equal(t, constants.ALBGatewayController, ancestors[0].ControllerName)
+		conditions := conditionsByType(ancestors[0])
+		assert.Equal(t, metav1.ConditionTrue, conditions[elbv2gw.ConfigurationConditionAccepted].Status)
+		assert.Equal(t, metav1.ConditionTrue, conditions[elbv2gw.ConfigurationConditionResolvedRefs].Status)
+		assert.Equal(t, metav1.ConditionFalse, conditions[elbv2gw.ConfigurationConditionConflicted].Status)
+	})
+
+	t.Run("gateway class hasn't processed the latest configuration", func(t *testing.T) {
+		r := setup(t, "0")
+		ancestors, err := r.buildAncestorStatuses(context.Background(), gwClassLBConf)
+		assert.NoError(t, err)
+		assert.Len(t, ancestors, 1)
+		accepted := conditionsByType(ancestors[0])[elbv2gw.ConfigurationConditionAccepted]
+		assert.Equal(t, metav1.ConditionFalse, accepted.Status)
+		assert.Equal(t, string(elbv2gw.ConfigurationReasonPending), accepted.Reason)
+	})
+
+	t.Run("gateway configuration overridden by gateway class configuration", func(t *testing.T) {
+		r := setup(t, "1")
+		gwLBConf := &elbv2gw.LoadBalancerConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "gw-lbc", Generation: 3},
+			Spec: elbv2gw.LoadBalancerConfigurationSpec{
+				Scheme: &internetFacing,
+			},
+		}
+		ancestors, err := r.buildAncestorStatuses(context.Background(), gwLBConf)
+		assert.NoError(t, err)
+		assert.Len(t, ancestors, 1)
+		assert.Equal(t, gatewayAncestorRef(k8s.NamespacedName(gw)), ancestors[0].AncestorRef)
+		conditions := conditionsByType(ancestors[0])
+		assert.Equal(t, metav1.ConditionTrue, conditions[elbv2gw.ConfigurationConditionAccepted].Status)
+		conflicted := conditions[elbv2gw.ConfigurationConditionConflicted]
+		assert.Equal(t, metav1.ConditionTrue, conflicted.Status)
+		assert.Equal(t, "LoadBalancerConfiguration ns/class-lbc of GatewayClass alb-class takes precedence for scheme", conflicted.Message)
+		assert.Equal(t, int64(3), conflicted.ObservedGeneration)
+	})
+
+	t.Run("default target group configuration not found", func(t *testing.T) {
+		r := setup(t, "1")
+		gwLBConf := &elbv2gw.LoadBalancerConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "gw-lbc"},
+			Spec: elbv2gw.LoadBalancerConfigurationSpec{
+				DefaultTargetGroupConfiguration: &elbv2gw.DefaultTargetGroupConfigurationReference{Name: "missing"},
+			},
+		}
+		ancestors, err := r.buildAncestorStatuses(context.Background(), gwLBConf)
+		assert.NoError(t, err)
+		assert.Len(t, ancestors, 1)
+		conditions := conditionsByType(ancestors[0])
+		assert.Equal(t, metav1.ConditionFalse, conditions[elbv2gw.ConfigurationConditionResolvedRefs].Status)
+		assert.Equal(t, string(elbv2gw.ConfigurationReasonInvalidRef), conditions[elbv2gw.ConfigurationConditionResolvedRefs].Reason)
+		assert.Equal(t, "default TargetGroupConfiguration ns/missing not found", conditions[elbv2gw.ConfigurationConditionResolvedRefs].Message)
+		assert.Equal(t, metav1.ConditionFalse, conditions[elbv2gw.ConfigurationConditionAccepted].Status)
+		assert.Equal(t, metav1.ConditionFalse, conditions[elbv2gw.ConfigurationConditionConflicted].Status)
+	})
+
+	t.Run("unreferenced configuration has no ancestors", func(t *testing.T) {
+		r := setup(t, "1")
+		ancestors, err := r.buildAncestorStatuses(context.Background(), &elbv2gw.LoadBalancerConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "unused"},
+		})
+		assert.NoError(t, err)
+		assert.Empty(t, ancestors)
+	})
+}
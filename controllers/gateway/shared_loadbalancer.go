@@ -0,0 +1,538 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/v3/apis/gateway/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/addon"
+	ctrlerrors "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/error"
+	gateway_constants "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway/constants"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway/routeutils"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/networking"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// sharedLoadBalancerMember is a Gateway that joined a shared load balancer.
+type sharedLoadBalancerMember struct {
+	gw            *gwv1.Gateway
+	lbConf        elbv2gw.LoadBalancerConfiguration
+	defaultTGC    *elbv2gw.TargetGroupConfiguration
+	loaderResults *routeutils.LoaderResult
+}
+
+// getSharedLoadBalancerStackName returns the name of the shared load balancer stack the Gateway is configured to join,
+// or "" when the Gateway gets a load balancer of its own.
+// Gateways of the same GatewayClass referencing a LoadBalancerConfiguration with the same name share the load balancer.
+func getSharedLoadBalancerStackName(gw *gwv1.Gateway, gwClass *gwv1.GatewayClass, lbConf elbv2gw.LoadBalancerConfiguration, lbType elbv2model.LoadBalancerType) string {
+	if lbType != elbv2model.LoadBalancerTypeApplication || lbConf.Spec.ShareLoadBalancer == nil || !*lbConf.Spec.ShareLoadBalancer {
+		return ""
+	}
+	var lbConfName string
+	if gw.Spec.Infrastructure != nil && gw.Spec.Infrastructure.ParametersRef != nil &&
+		string(gw.Spec.Infrastructure.ParametersRef.Kind) == gateway_constants.LoadBalancerConfiguration {
+		lbConfName = gw.Spec.Infrastructure.ParametersRef.Name
+	} else if gwClass.Spec.ParametersRef != nil && string(gwClass.Spec.ParametersRef.Kind) == gateway_constants.LoadBalancerConfiguration {
+		lbConfName = gwClass.Spec.ParametersRef.Name
+	}
+	if lbConfName == "" {
+		return ""
+	}
+	// Underscores aren't allowed in Kubernetes names, so the stack can't collide with the stack of a single Gateway.
+	return fmt.Sprintf("%s_%s", gwClass.Name, lbConfName)
+}
+
+// getJoinedSharedLoadBalancer returns the name of the shared load balancer stack the Gateway has joined, or "" if none.
+func getJoinedSharedLoadBalancer(gw *gwv1.Gateway) string {
+	return gw.Annotations[gateway_constants.AnnotationSharedLoadBalancer]
+}
+
+// buildSharedLoadBalancerGateway builds the Gateway the shared load balancer is modeled after.
+// It isn't namespaced, so that the stack and the names of its AWS resources don't depend on the Gateways sharing it.
+func buildSharedLoadBalancerGateway(stackName string, representative *gwv1.Gateway) *gwv1.Gateway {
+	return &gwv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: stackName,
+			UID:  types.UID(stackName),
+		},
+		Spec: gwv1.GatewaySpec{
+			GatewayClassName: representative.Spec.GatewayClassName,
+			Infrastructure:   representative.Spec.Infrastructure,
+			Addresses:        representative.Spec.Addresses,
+		},
+	}
+}
+
+// joinSharedLoadBalancer records the shared load balancer stack joined by the Gateway. The Gateway update triggers the reconciliation
+// of the shared load balancer. The load balancer previously provisioned for the Gateway alone is only deleted once the Gateway
+// is served by the shared load balancer, the addons enabled on it are moved aside so that they aren't merged into the shared load balancer.
+func (r *gatewayReconciler) joinSharedLoadBalancer(ctx context.Context, gw *gwv1.Gateway, stackName string) error {
+	gwOld := gw.DeepCopy()
+	annotations := make(map[string]string, len(gw.Annotations)+2)
+	for k, v := range gw.Annotations {
+		annotations[k] = v
+	}
+	annotations[gateway_constants.AnnotationSharedLoadBalancer] = stackName
+	if k8s.HasFinalizer(gw, r.finalizer) {
+		dedicatedAddOns := getEnabledAddOns(gw, r.logger)
+		for _, ao := range dedicatedAddOns {
+			annotations[generateAddOnKey(ao)] = falseString
+		}
+		annotations[gateway_constants.AnnotationDedicatedLoadBalancerAddons] = joinAddOns(dedicatedAddOns)
+	}
+	gw.Annotations = annotations
+	if err := r.k8sClient.Patch(ctx, gw, client.MergeFrom(gwOld)); err != nil {
+		return errors.Wrapf(err, "failed to join shared load balancer %s for gateway %s", stackName, k8s.NamespacedName(gw))
+	}
+	r.logger.Info("Gateway joined shared load balancer", "gateway", k8s.NamespacedName(gw), "sharedLoadBalancer", stackName)
+	return nil
+}
+
+// reconcileJoinedSharedLoadBalancer reconciles the shared load balancer joined by the Gateway, then deletes the load balancer
+// previously provisioned for the Gateway alone. A Gateway being deleted deletes that load balancer before leaving the shared one,
+// as its finalizer is removed once it left.
+func (r *gatewayReconciler) reconcileJoinedSharedLoadBalancer(ctx context.Context, gw *gwv1.Gateway, lbConf elbv2gw.LoadBalancerConfiguration, stackName string) error {
+	_, hasDedicatedLoadBalancer := gw.Annotations[gateway_constants.AnnotationDedicatedLoadBalancerAddons]
+	if !isGatewayDeleting(gw) || !hasDedicatedLoadBalancer {
+		if err := r.reconcileSharedLoadBalancer(ctx, stackName); err != nil {
+			return err
+		}
+	}
+	if !hasDedicatedLoadBalancer {
+		return nil
+	}
+	deleted, err := r.deleteDedicatedLoadBalancer(ctx, gw, lbConf)
+	if err != nil || !deleted {
+		return err
+	}
+	if isGatewayDeleting(gw) {
+		return r.reconcileSharedLoadBalancer(ctx, stackName)
+	}
+	return nil
+}
+
+// deleteDedicatedLoadBalancer deletes the load balancer provisioned for the Gateway alone, once the addons recorded
+// on the Gateway for it are disabled. It returns false while the addons are being disabled.
+func (r *gatewayReconciler) deleteDedicatedLoadBalancer(ctx context.Context, gw *gwv1.Gateway, lbConf elbv2gw.LoadBalancerConfiguration) (bool, error) {
+	currentAddOns := splitAddOns(gw.Annotations[gateway_constants.AnnotationDedicatedLoadBalancerAddons])
+	stack, lb, newAddOnConfig, _, _, err := r.buildModel(ctx, gw, lbConf, nil, nil, currentAddOns, true)
+	if err != nil {
+		return false, err
+	}
+	if err := r.deployModel(ctx, gw, stack, nil); err != nil {
+		return false, err
+	}
+
+	gwOld := gw.DeepCopy()
+	annotations := make(map[string]string, len(gw.Annotations))
+	for k, v := range gw.Annotations {
+		annotations[k] = v
+	}
+	if lb != nil {
+		_, addOnRemovals := diffAddOns(currentAddOns, newAddOnConfig)
+		remainingAddOns := sets.New(currentAddOns...).Difference(addOnRemovals)
+		annotations[gateway_constants.AnnotationDedicatedLoadBalancerAddons] = joinAddOns(sets.List(remainingAddOns))
+	} else {
+		if err := r.backendSGProvider.Release(ctx, networking.ResourceTypeGateway, []types.NamespacedName{k8s.NamespacedName(gw)}); err != nil {
+			return false, err
+		}
+		delete(annotations, gateway_constants.AnnotationDedicatedLoadBalancerAddons)
+	}
+	gw.Annotations = annotations
+	if err := r.k8sClient.Patch(ctx, gw, client.MergeFrom(gwOld)); err != nil {
+		return false, errors.Wrapf(err, "failed to delete dedicated load balancer of gateway %s", k8s.NamespacedName(gw))
+	}
+	if lb != nil {
+		return false, ctrlerrors.NewRequeueNeeded("disabling addons of dedicated load balancer")
+	}
+	r.logger.Info("Deleted dedicated load balancer of gateway", "gateway", k8s.NamespacedName(gw))
+	return true, nil
+}
+
+// reconcileSharedLoadBalancer reconciles the load balancer shared by the Gateways that joined the stack.
+// The load balancer is deleted once the last Gateway leaves it.
+func (r *gatewayReconciler) reconcileSharedLoadBalancer(ctx context.Context, stackName string) error {
+	lock, _ := r.sharedLoadBalancerLocks.LoadOrStore(stackName, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	defer lock.(*sync.Mutex).Unlock()
+
+	members, departingMembers, err := r.loadSharedLoadBalancerMembers(ctx, stackName)
+	if err != nil {
+		return err
+	}
+
+	// Every Gateway records the addons enabled on the shared load balancer, so that they are disabled by whichever remains.
+	currentAddOns := sets.New[addon.Addon]()
+	for _, member := range append(append([]*sharedLoadBalancerMember{}, members...), departingMembers...) {
+		currentAddOns.Insert(getEnabledAddOns(member.gw, r.logger)...)
+	}
+
+	if len(members) == 0 {
+		return r.reconcileSharedLoadBalancerDelete(ctx, stackName, departingMembers, sets.List(currentAddOns))
+	}
+	return r.reconcileSharedLoadBalancerUpdate(ctx, stackName, members, departingMembers, sets.List(currentAddOns))
+}
+
+// loadSharedLoadBalancerMembers loads the Gateways that joined the shared load balancer stack, oldest first.
+// Gateways being deleted, or no longer configured to share the load balancer, are returned as departing.
+func (r *gatewayReconciler) loadSharedLoadBalancerMembers(ctx context.Context, stackName string) ([]*sharedLoadBalancerMember, []*sharedLoadBalancerMember, error) {
+	gwList := &gwv1.GatewayList{}
+	if err := r.k8sClient.List(ctx, gwList); err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to list gateways of shared load balancer %s", stackName)
+	}
+
+	var members, departingMembers []*sharedLoadBalancerMember
+	for i := range gwList.Items {
+		gw := &gwList.Items[i]
+		if getJoinedSharedLoadBalancer(gw) != stackName {
+			continue
+		}
+
+		gwClass := &gwv1.GatewayClass{}
+		if err := r.k8sClient.Get(ctx, types.NamespacedName{Name: string(gw.Spec.GatewayClassName)}, gwClass); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return nil, nil, err
+			}
+			departingMembers = append(departingMembers, &sharedLoadBalancerMember{gw: gw})
+			continue
+		}
+		if string(gwClass.Spec.ControllerName) != r.controllerName {
+			departingMembers = append(departingMembers, &sharedLoadBalancerMember{gw: gw})
+			continue
+		}
+
+		lbConf, defaultTGC, err := r.cfgResolver.getLoadBalancerConfigForGateway(ctx, r.k8sClient, r.finalizerManager, gw, gwClass)
+		if err != nil {
+			if statusErr := r.updateGatewayStatusFailure(ctx, gw, gwv1.GatewayReasonInvalid, err.Error(), nil); statusErr != nil {
+				r.logger.Error(statusErr, "Unable to update gateway status on failure to retrieve attached config")
+			}
+			return nil, nil, err
+		}
+
+		member := &sharedLoadBalancerMember{gw: gw, lbConf: lbConf, defaultTGC: defaultTGC}
+		if isGatewayDeleting(gw) || getSharedLoadBalancerStackName(gw, gwClass, lbConf, r.lbType) != stackName {
+			departingMembers = append(departingMembers, member)
+		} else {
+			members = append(members, member)
+		}
+	}
+
+	sortSharedLoadBalancerMembers(members)
+	sortSharedLoadBalancerMembers(departingMembers)
+	return members, departingMembers, nil
+}
+
+// sortSharedLoadBalancerMembers orders the Gateways by creation time, then by namespaced name.
+func sortSharedLoadBalancerMembers(members []*sharedLoadBalancerMember) {
+	sort.SliceStable(members, func(i, j int) bool {
+		ti := members[i].gw.CreationTimestamp
+		tj := members[j].gw.CreationTimestamp
+		if !ti.Equal(&tj) {
+			return ti.Before(&tj)
+		}
+		return k8s.NamespacedName(members[i].gw).String() < k8s.NamespacedName(members[j].gw).String()
+	})
+}
+
+// reconcileSharedLoadBalancerUpdate deploys the shared load balancer with the listeners and routes of all its Gateways,
+// and reports its status on each of them. The load balancer configuration of the oldest Gateway is used.
+func (r *gatewayReconciler) reconcileSharedLoadBalancerUpdate(ctx context.Context, stackName string, members []*sharedLoadBalancerMember, departingMembers []*sharedLoadBalancerMember, currentAddOns []addon.Addon) error {
+	for _, member := range members {
+		loaderResults, err := r.gatewayLoader.LoadRoutesForGateway(ctx, *member.gw, r.routeFilter, r.controllerName, member.defaultTGC)
+		if err != nil {
+			r.handleLoadRoutesError(ctx, member.gw, loaderResults, err)
+			return err
+		}
+		member.loaderResults = loaderResults
+	}
+
+	listeners, routes := mergeSharedLoadBalancerListeners(members)
+	sharedGw := buildSharedLoadBalancerGateway(stackName, members[0].gw)
+	stack, lb, newAddOnConfig, backendSGRequired, secrets, err := r.modelBuilder.Build(ctx, sharedGw, members[0].lbConf, listeners, routes, currentAddOns, r.secretsManager, r.targetGroupNameToArnMapper, false)
+	if err != nil {
+		r.recordSharedLoadBalancerEvent(members, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		for _, member := range members {
+			r.handleReconcileError(ctx, member.gw, err)
+		}
+		return err
+	}
+
+	// The addons are recorded before being materialized, so that they can't be orphaned.
+	addOnAdditions, addOnRemovals := diffAddOns(currentAddOns, newAddOnConfig)
+	for _, member := range members {
+		if len(addOnAdditions) > 0 {
+			if err := persistAddOns(ctx, r.k8sClient, member.gw, addOnAdditions.UnsortedList(), false); err != nil {
+				return err
+			}
+		}
+		if err := r.finalizerManager.AddFinalizers(ctx, member.gw, r.finalizer); err != nil {
+			r.eventRecorder.Event(member.gw, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedAddFinalizer, fmt.Sprintf("Failed add gateway finalizer due to %v", err))
+			return err
+		}
+	}
+
+	if err := r.deploySharedModel(ctx, stackName, members, stack, secrets); err != nil {
+		for _, member := range members {
+			r.handleReconcileError(ctx, member.gw, err)
+		}
+		return err
+	}
+
+	if !backendSGRequired {
+		if err := r.backendSGProvider.Release(ctx, networking.ResourceTypeGateway, []types.NamespacedName{k8s.NamespacedName(sharedGw)}); err != nil {
+			return err
+		}
+	}
+
+	endpointServiceName, logDeliveryReady, err := getStackStatusDetails(ctx, stack)
+	if err != nil {
+		return err
+	}
+
+	var statusErr error
+	for _, member := range members {
+		r.serviceReferenceCounter.UpdateRelations(getServicesFromRoutes(member.loaderResults.Routes), k8s.NamespacedName(member.gw), false)
+		if err := r.updateGatewayStatusSuccess(ctx, lb.Status, endpointServiceName, logDeliveryReady, member.gw, *member.loaderResults); err != nil {
+			var requeueNeededAfter *ctrlerrors.RequeueNeededAfter
+			if !errors.As(err, &requeueNeededAfter) {
+				r.eventRecorder.Event(member.gw, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
+				return err
+			}
+			statusErr = err
+			continue
+		}
+		r.eventRecorder.Event(member.gw, corev1.EventTypeNormal, k8s.GatewayEventReasonSuccessfullyReconciled, "Successfully reconciled")
+	}
+
+	if err := r.releaseDepartingSharedLoadBalancerMembers(ctx, departingMembers); err != nil {
+		return err
+	}
+
+	// The addons are only forgotten once they are removed from the load balancer.
+	if len(addOnRemovals) > 0 {
+		for _, member := range members {
+			if err := persistAddOns(ctx, r.k8sClient, member.gw, addOnRemovals.UnsortedList(), true); err != nil {
+				return err
+			}
+		}
+	}
+	return statusErr
+}
+
+// reconcileSharedLoadBalancerDelete deletes the shared load balancer once all its Gateways are departing,
+// then releases them.
+func (r *gatewayReconciler) reconcileSharedLoadBalancerDelete(ctx context.Context, stackName string, departingMembers []*sharedLoadBalancerMember, currentAddOns []addon.Addon) error {
+	if len(departingMembers) == 0 {
+		return nil
+	}
+	sharedGw := buildSharedLoadBalancerGateway(stackName, departingMembers[0].gw)
+	stack, lb, newAddOnConfig, _, _, err := r.modelBuilder.Build(ctx, sharedGw, departingMembers[0].lbConf, nil, nil, currentAddOns, r.secretsManager, r.targetGroupNameToArnMapper, true)
+	if err != nil {
+		r.recordSharedLoadBalancerEvent(departingMembers, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		return err
+	}
+	if err := r.deploySharedModel(ctx, stackName, departingMembers, stack, nil); err != nil {
+		return err
+	}
+
+	// The load balancer is only deleted once its addons are disabled, the Gateway updates trigger the next reconciliation.
+	if _, addOnRemovals := diffAddOns(currentAddOns, newAddOnConfig); len(addOnRemovals) > 0 {
+		for _, member := range departingMembers {
+			if err := persistAddOns(ctx, r.k8sClient, member.gw, addOnRemovals.UnsortedList(), true); err != nil {
+				return err
+			}
+		}
+	}
+	if lb != nil {
+		return nil
+	}
+
+	if err := r.backendSGProvider.Release(ctx, networking.ResourceTypeGateway, []types.NamespacedName{k8s.NamespacedName(sharedGw)}); err != nil {
+		return err
+	}
+	r.logger.Info("Deleted shared load balancer", "sharedLoadBalancer", stackName)
+	return r.releaseDepartingSharedLoadBalancerMembers(ctx, departingMembers)
+}
+
+// releaseDepartingSharedLoadBalancerMembers removes the finalizer of the Gateways being deleted, and the shared load balancer
+// annotations of the Gateways that are no longer configured to share it, so that they are reconciled on their own.
+func (r *gatewayReconciler) releaseDepartingSharedLoadBalancerMembers(ctx context.Context, departingMembers []*sharedLoadBalancerMember) error {
+	for _, member := range departingMembers {
+		r.serviceReferenceCounter.UpdateRelations([]types.NamespacedName{}, k8s.NamespacedName(member.gw), true)
+		if isGatewayDeleting(member.gw) {
+			// The finalizer is kept until the load balancer of the Gateway alone is deleted by its own reconciliation.
+			if _, exists := member.gw.Annotations[gateway_constants.AnnotationDedicatedLoadBalancerAddons]; exists {
+				continue
+			}
+			if err := r.finalizerManager.RemoveFinalizers(ctx, member.gw, r.finalizer); err != nil {
+				r.eventRecorder.Event(member.gw, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedRemoveFinalizer, fmt.Sprintf("Failed remove gateway finalizer due to %v", err))
+				return err
+			}
+			continue
+		}
+
+		// The addons belong to the shared load balancer, they mustn't be disabled again by the Gateway.
+		gwOld := member.gw.DeepCopy()
+		annotations := make(map[string]string, len(member.gw.Annotations))
+		for k, v := range member.gw.Annotations {
+			annotations[k] = v
+		}
+		delete(annotations, gateway_constants.AnnotationSharedLoadBalancer)
+		for _, ao := range getEnabledAddOns(member.gw, r.logger) {
+			annotations[generateAddOnKey(ao)] = falseString
+		}
+		// The load balancer of the Gateway alone wasn't deleted yet, it's reconciled again together with its addons.
+		if dedicatedAddOns, exists := annotations[gateway_constants.AnnotationDedicatedLoadBalancerAddons]; exists {
+			for _, ao := range splitAddOns(dedicatedAddOns) {
+				annotations[generateAddOnKey(ao)] = trueString
+			}
+			delete(annotations, gateway_constants.AnnotationDedicatedLoadBalancerAddons)
+		}
+		member.gw.Annotations = annotations
+		if err := r.k8sClient.Patch(ctx, member.gw, client.MergeFrom(gwOld)); err != nil {
+			return errors.Wrapf(err, "failed to leave shared load balancer for gateway %s", k8s.NamespacedName(member.gw))
+		}
+		r.logger.Info("Gateway left shared load balancer", "gateway", k8s.NamespacedName(member.gw), "sharedLoadBalancer", getJoinedSharedLoadBalancer(gwOld))
+	}
+	return nil
+}
+
+// deploySharedModel deploys the stack of the shared load balancer, failures are recorded on each of its Gateways.
+func (r *gatewayReconciler) deploySharedModel(ctx context.Context, stackName string, members []*sharedLoadBalancerMember, stack core.Stack, secrets []types.NamespacedName) error {
+	if err := r.stackDeployer.Deploy(ctx, stack, r.metricsCollector, r.controllerName); err != nil {
+		var requeueNeededAfter *ctrlerrors.RequeueNeededAfter
+		if errors.As(err, &requeueNeededAfter) {
+			return err
+		}
+		r.recordSharedLoadBalancerEvent(members, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedDeployModel, fmt.Sprintf("Failed deploy model due to %v", err))
+		return err
+	}
+	r.logger.Info("successfully deployed model", "sharedLoadBalancer", stackName)
+	if r.lbType == elbv2model.LoadBalancerTypeApplication {
+		r.secretsManager.MonitorSecrets(stackName, secrets)
+	}
+	return nil
+}
+
+func (r *gatewayReconciler) recordSharedLoadBalancerEvent(members []*sharedLoadBalancerMember, eventType string, reason string, message string) {
+	for _, member := range members {
+		r.eventRecorder.Event(member.gw, eventType, reason, message)
+	}
+}
+
+// mergeSharedLoadBalancerListeners merges the listeners and routes of the Gateways sharing a load balancer.
+// Gateways are processed oldest first, a listener conflicting with the listener of an older Gateway on the same port
+// (different protocol, or same hostname) is marked as conflicted in the listener status of its Gateway, and isn't merged.
+// Client certificate validation isn't supported on a shared load balancer, as the Gateway TLS config isn't merged,
+// a HTTPS listener with client certificate validation is marked as unsupported and isn't merged either.
+// Routes are merged for the ports where the Gateway has a listener left.
+func mergeSharedLoadBalancerListeners(members []*sharedLoadBalancerMember) ([]gwv1.Listener, map[int32][]routeutils.RouteDescriptor) {
+	portProtocols := make(map[gwv1.PortNumber]gwv1.ProtocolType)
+	portHostnames := sets.New[string]()
+	listeners := make([]gwv1.Listener, 0)
+	routes := make(map[int32][]routeutils.RouteDescriptor)
+	routeKeysByPort := make(map[int32]sets.Set[string])
+
+	for _, member := range members {
+		memberPortProtocols := make(map[gwv1.PortNumber]gwv1.ProtocolType)
+		memberPortHostnames := sets.New[string]()
+		memberPorts := sets.New[int32]()
+		for _, listener := range member.loaderResults.Listeners {
+			if listener.Protocol == gwv1.HTTPSProtocolType && routeutils.GetFrontendTLSValidation(*member.gw, listener.Port) != nil {
+				markSharedListenerInvalid(member, listener, gwv1.ListenerReasonUnsupportedValue,
+					fmt.Sprintf("Client certificate validation for port %d isn't supported on a shared load balancer", listener.Port))
+				continue
+			}
+			if protocol, exists := portProtocols[listener.Port]; exists && protocol != listener.Protocol {
+				markSharedListenerInvalid(member, listener, gwv1.ListenerReasonProtocolConflict,
+					fmt.Sprintf("Protocol conflict for port %d with another Gateway sharing the load balancer", listener.Port))
+				continue
+			}
+			// A listener without hostname matches every hostname, so it conflicts with another Gateway's listener without hostname.
+			var hostname gwv1.Hostname
+			if listener.Hostname != nil {
+				hostname = *listener.Hostname
+			}
+			hostnameKey := fmt.Sprintf("%d-%s", listener.Port, hostname)
+			if portHostnames.Has(hostnameKey) {
+				message := fmt.Sprintf("Hostname conflict for port %d with hostname %s with another Gateway sharing the load balancer", listener.Port, hostname)
+				if listener.Hostname == nil {
+					message = fmt.Sprintf("Hostname conflict for port %d without hostname with another Gateway sharing the load balancer", listener.Port)
+				}
+				markSharedListenerInvalid(member, listener, gwv1.ListenerReasonHostnameConflict, message)
+				continue
+			}
+			memberPortHostnames.Insert(hostnameKey)
+			memberPortProtocols[listener.Port] = listener.Protocol
+			memberPorts.Insert(int32(listener.Port))
+			listeners = append(listeners, listener)
+		}
+		for port, protocol := range memberPortProtocols {
+			portProtocols[port] = protocol
+		}
+		portHostnames = portHostnames.Union(memberPortHostnames)
+
+		for port, portRoutes := range member.loaderResults.Routes {
+			if !memberPorts.Has(port) {
+				delete(member.loaderResults.Routes, port)
+				continue
+			}
+			if _, exists := routes[port]; !exists {
+				routes[port] = make([]routeutils.RouteDescriptor, 0)
+				routeKeysByPort[port] = sets.New[string]()
+			}
+			// A route attached to several Gateways sharing the load balancer is only programmed once.
+			for _, route := range portRoutes {
+				routeKey := route.GetRouteIdentifier()
+				if routeKeysByPort[port].Has(routeKey) {
+					continue
+				}
+				routeKeysByPort[port].Insert(routeKey)
+				routes[port] = append(routes[port], route)
+			}
+		}
+	}
+	return listeners, routes
+}
+
+// markSharedListenerInvalid reports the listener as invalid in the listener validation results of its Gateway or ListenerSet.
+func markSharedListenerInvalid(member *sharedLoadBalancerMember, listener gwv1.Listener, reason gwv1.ListenerConditionReason, message string) {
+	markInvalid := func(validationResults *routeutils.ListenerValidationResults) bool {
+		result, exists := validationResults.Results[listener.Name]
+		if !exists {
+			return false
+		}
+		result.IsValid = false
+		result.Reason = reason
+		result.Message = message
+		validationResults.Results[listener.Name] = result
+		validationResults.HasErrors = true
+		return true
+	}
+
+	validation := &member.loaderResults.ValidationResults
+	for _, gwListener := range member.gw.Spec.Listeners {
+		if gwListener.Name == listener.Name && gwListener.Port == listener.Port {
+			markInvalid(&validation.GatewayListenerValidation)
+			return
+		}
+	}
+	for nsn, lsValidationResults := range validation.ListenerSetListenerValidation {
+		if markInvalid(&lsValidationResults) {
+			validation.ListenerSetListenerValidation[nsn] = lsValidationResults
+			return
+		}
+	}
+}
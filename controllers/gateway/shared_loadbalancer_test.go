@@ -0,0 +1,402 @@
+package gateway
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/v3/apis/gateway/v1"
+	gateway_constants "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway/constants"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway/routeutils"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/k8s"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/testutils"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func Test_getSharedLoadBalancerStackName(t *testing.T) {
+	sharedLbConf := elbv2gw.LoadBalancerConfiguration{
+		Spec: elbv2gw.LoadBalancerConfigurationSpec{
+			ShareLoadBalancer: ptr.To(true),
+		},
+	}
+	gwClass := &gwv1.GatewayClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "alb"},
+		Spec: gwv1.GatewayClassSpec{
+			ParametersRef: &gwv1.ParametersReference{
+				Kind: "LoadBalancerConfiguration",
+				Name: "class-config",
+			},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		gw       *gwv1.Gateway
+		gwClass  *gwv1.GatewayClass
+		lbConf   elbv2gw.LoadBalancerConfiguration
+		lbType   elbv2model.LoadBalancerType
+		expected string
+	}{
+		{
+			name:     "sharing not configured",
+			gw:       &gwv1.Gateway{},
+			gwClass:  gwClass,
+			lbConf:   elbv2gw.LoadBalancerConfiguration{},
+			lbType:   elbv2model.LoadBalancerTypeApplication,
+			expected: "",
+		},
+		{
+			name:    "sharing disabled",
+			gw:      &gwv1.Gateway{},
+			gwClass: gwClass,
+			lbConf: elbv2gw.LoadBalancerConfiguration{
+				Spec: elbv2gw.LoadBalancerConfigurationSpec{
+					ShareLoadBalancer: ptr.To(false),
+				},
+			},
+			lbType:   elbv2model.LoadBalancerTypeApplication,
+			expected: "",
+		},
+		{
+			name:     "sharing not supported for nlb",
+			gw:       &gwv1.Gateway{},
+			gwClass:  gwClass,
+			lbConf:   sharedLbConf,
+			lbType:   elbv2model.LoadBalancerTypeNetwork,
+			expected: "",
+		},
+		{
+			name:     "shared through the class config",
+			gw:       &gwv1.Gateway{},
+			gwClass:  gwClass,
+			lbConf:   sharedLbConf,
+			lbType:   elbv2model.LoadBalancerTypeApplication,
+			expected: "alb_class-config",
+		},
+		{
+			name: "shared through the gateway config",
+			gw: &gwv1.Gateway{
+				Spec: gwv1.GatewaySpec{
+					Infrastructure: &gwv1.GatewayInfrastructure{
+						ParametersRef: &gwv1.LocalParametersReference{
+							Kind: "LoadBalancerConfiguration",
+							Name: "gw-config",
+						},
+					},
+				},
+			},
+			gwClass:  gwClass,
+			lbConf:   sharedLbConf,
+			lbType:   elbv2model.LoadBalancerTypeApplication,
+			expected: "alb_gw-config",
+		},
+		{
+			name: "no config referenced",
+			gw:   &gwv1.Gateway{},
+			gwClass: &gwv1.GatewayClass{
+				ObjectMeta: metav1.ObjectMeta{Name: "alb"},
+			},
+			lbConf:   sharedLbConf,
+			lbType:   elbv2model.LoadBalancerTypeApplication,
+			expected: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, getSharedLoadBalancerStackName(tc.gw, tc.gwClass, tc.lbConf, tc.lbType))
+		})
+	}
+}
+
+func Test_sortSharedLoadBalancerMembers(t *testing.T) {
+	now := time.Now()
+	newMember := func(namespace, name string, creationTime time.Time) *sharedLoadBalancerMember {
+		return &sharedLoadBalancerMember{
+			gw: &gwv1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:         namespace,
+					Name:              name,
+					CreationTimestamp: metav1.NewTime(creationTime),
+				},
+			},
+		}
+	}
+	members := []*sharedLoadBalancerMember{
+		newMember("ns2", "gw", now),
+		newMember("ns1", "gw", now),
+		newMember("ns3", "gw", now.Add(-time.Hour)),
+	}
+
+	sortSharedLoadBalancerMembers(members)
+
+	var names []types.NamespacedName
+	for _, member := range members {
+		names = append(names, types.NamespacedName{Namespace: member.gw.Namespace, Name: member.gw.Name})
+	}
+	assert.Equal(t, []types.NamespacedName{
+		{Namespace: "ns3", Name: "gw"},
+		{Namespace: "ns1", Name: "gw"},
+		{Namespace: "ns2", Name: "gw"},
+	}, names)
+}
+
+func Test_mergeSharedLoadBalancerListeners(t *testing.T) {
+	routeA := &routeutils.MockRoute{Kind: routeutils.HTTPRouteKind, Namespace: "ns", Name: "route-a"}
+	routeB := &routeutils.MockRoute{Kind: routeutils.HTTPRouteKind, Namespace: "ns", Name: "route-b"}
+	routeC := &routeutils.MockRoute{Kind: routeutils.HTTPRouteKind, Namespace: "ns", Name: "route-c"}
+
+	newMember := func(name string, listeners []gwv1.Listener, routes map[int32][]routeutils.RouteDescriptor) *sharedLoadBalancerMember {
+		results := make(map[gwv1.SectionName]routeutils.ListenerValidationResult)
+		for _, l := range listeners {
+			results[l.Name] = routeutils.ListenerValidationResult{ListenerName: l.Name, IsValid: true}
+		}
+		return &sharedLoadBalancerMember{
+			gw: &gwv1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: name},
+				Spec:       gwv1.GatewaySpec{Listeners: listeners},
+			},
+			loaderResults: &routeutils.LoaderResult{
+				Listeners: listeners,
+				Routes:    routes,
+				ValidationResults: routeutils.ValidatedGatewayListeners{
+					GatewayListenerValidation: routeutils.ListenerValidationResults{Results: results},
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name                  string
+		members               []*sharedLoadBalancerMember
+		expectedListeners     []gwv1.SectionName
+		expectedRoutes        map[int32][]routeutils.RouteDescriptor
+		expectedConflicts     map[string]map[gwv1.SectionName]gwv1.ListenerConditionReason
+		expectedMemberRoutes  map[string]map[int32][]routeutils.RouteDescriptor
+		expectedMemberHasErrs map[string]bool
+	}{
+		{
+			name: "listeners with distinct hostnames on the same port",
+			members: []*sharedLoadBalancerMember{
+				newMember("gw1", []gwv1.Listener{
+					{Name: "http", Port: 80, Protocol: gwv1.HTTPProtocolType, Hostname: ptr.To(gwv1.Hostname("a.example.com"))},
+				}, map[int32][]routeutils.RouteDescriptor{80: {routeA}}),
+				newMember("gw2", []gwv1.Listener{
+					{Name: "http", Port: 80, Protocol: gwv1.HTTPProtocolType, Hostname: ptr.To(gwv1.Hostname("b.example.com"))},
+				}, map[int32][]routeutils.RouteDescriptor{80: {routeB}}),
+			},
+			expectedListeners: []gwv1.SectionName{"http", "http"},
+			expectedRoutes:    map[int32][]routeutils.RouteDescriptor{80: {routeA, routeB}},
+			expectedMemberHasErrs: map[string]bool{
+				"gw1": false,
+				"gw2": false,
+			},
+		},
+		{
+			name: "protocol conflict with an older gateway",
+			members: []*sharedLoadBalancerMember{
+				newMember("gw1", []gwv1.Listener{
+					{Name: "http", Port: 80, Protocol: gwv1.HTTPProtocolType},
+				}, map[int32][]routeutils.RouteDescriptor{80: {routeA}}),
+				newMember("gw2", []gwv1.Listener{
+					{Name: "https", Port: 80, Protocol: gwv1.HTTPSProtocolType},
+					{Name: "other", Port: 8080, Protocol: gwv1.HTTPProtocolType},
+				}, map[int32][]routeutils.RouteDescriptor{80: {routeB}, 8080: {routeC}}),
+			},
+			expectedListeners: []gwv1.SectionName{"http", "other"},
+			expectedRoutes:    map[int32][]routeutils.RouteDescriptor{80: {routeA}, 8080: {routeC}},
+			expectedConflicts: map[string]map[gwv1.SectionName]gwv1.ListenerConditionReason{
+				"gw2": {"https": gwv1.ListenerReasonProtocolConflict},
+			},
+			expectedMemberRoutes: map[string]map[int32][]routeutils.RouteDescriptor{
+				"gw2": {8080: {routeC}},
+			},
+			expectedMemberHasErrs: map[string]bool{
+				"gw1": false,
+				"gw2": true,
+			},
+		},
+		{
+			name: "hostname conflict with an older gateway",
+			members: []*sharedLoadBalancerMember{
+				newMember("gw1", []gwv1.Listener{
+					{Name: "http", Port: 80, Protocol: gwv1.HTTPProtocolType, Hostname: ptr.To(gwv1.Hostname("a.example.com"))},
+				}, map[int32][]routeutils.RouteDescriptor{80: {routeA}}),
+				newMember("gw2", []gwv1.Listener{
+					{Name: "http", Port: 80, Protocol: gwv1.HTTPProtocolType, Hostname: ptr.To(gwv1.Hostname("a.example.com"))},
+				}, map[int32][]routeutils.RouteDescriptor{80: {routeB}}),
+			},
+			expectedListeners: []gwv1.SectionName{"http"},
+			expectedRoutes:    map[int32][]routeutils.RouteDescriptor{80: {routeA}},
+			expectedConflicts: map[string]map[gwv1.SectionName]gwv1.ListenerConditionReason{
+				"gw2": {"http": gwv1.ListenerReasonHostnameConflict},
+			},
+			expectedMemberRoutes: map[string]map[int32][]routeutils.RouteDescriptor{
+				"gw2": {},
+			},
+			expectedMemberHasErrs: map[string]bool{
+				"gw1": false,
+				"gw2": true,
+			},
+		},
+		{
+			name: "listener with client certificate validation is unsupported",
+			members: func() []*sharedLoadBalancerMember {
+				gw1 := newMember("gw1", []gwv1.Listener{
+					{Name: "https", Port: 443, Protocol: gwv1.HTTPSProtocolType},
+					{Name: "http", Port: 80, Protocol: gwv1.HTTPProtocolType},
+				}, map[int32][]routeutils.RouteDescriptor{443: {routeA}, 80: {routeB}})
+				gw1.gw.Spec.TLS = &gwv1.GatewayTLSConfig{
+					Frontend: &gwv1.FrontendTLSConfig{
+						Default: gwv1.TLSConfig{
+							Validation: &gwv1.FrontendTLSValidation{
+								CACertificateRefs: []gwv1.ObjectReference{{Kind: "ConfigMap", Name: "ca"}},
+							},
+						},
+					},
+				}
+				return []*sharedLoadBalancerMember{gw1}
+			}(),
+			expectedListeners: []gwv1.SectionName{"http"},
+			expectedRoutes:    map[int32][]routeutils.RouteDescriptor{80: {routeB}},
+			expectedConflicts: map[string]map[gwv1.SectionName]gwv1.ListenerConditionReason{
+				"gw1": {"https": gwv1.ListenerReasonUnsupportedValue},
+			},
+			expectedMemberRoutes: map[string]map[int32][]routeutils.RouteDescriptor{
+				"gw1": {80: {routeB}},
+			},
+			expectedMemberHasErrs: map[string]bool{
+				"gw1": true,
+			},
+		},
+		{
+			name: "listener without hostname conflicts with an older gateway's listener without hostname",
+			members: []*sharedLoadBalancerMember{
+				newMember("gw1", []gwv1.Listener{
+					{Name: "http", Port: 80, Protocol: gwv1.HTTPProtocolType},
+				}, map[int32][]routeutils.RouteDescriptor{80: {routeA}}),
+				newMember("gw2", []gwv1.Listener{
+					{Name: "http", Port: 80, Protocol: gwv1.HTTPProtocolType},
+					{Name: "named", Port: 80, Protocol: gwv1.HTTPProtocolType, Hostname: ptr.To(gwv1.Hostname("b.example.com"))},
+				}, map[int32][]routeutils.RouteDescriptor{80: {routeB}}),
+			},
+			expectedListeners: []gwv1.SectionName{"http", "named"},
+			expectedRoutes:    map[int32][]routeutils.RouteDescriptor{80: {routeA, routeB}},
+			expectedConflicts: map[string]map[gwv1.SectionName]gwv1.ListenerConditionReason{
+				"gw2": {"http": gwv1.ListenerReasonHostnameConflict},
+			},
+			expectedMemberHasErrs: map[string]bool{
+				"gw1": false,
+				"gw2": true,
+			},
+		},
+		{
+			name: "route attached to several gateways is merged once",
+			members: []*sharedLoadBalancerMember{
+				newMember("gw1", []gwv1.Listener{
+					{Name: "http", Port: 80, Protocol: gwv1.HTTPProtocolType, Hostname: ptr.To(gwv1.Hostname("a.example.com"))},
+				}, map[int32][]routeutils.RouteDescriptor{80: {routeA}}),
+				newMember("gw2", []gwv1.Listener{
+					{Name: "http", Port: 80, Protocol: gwv1.HTTPProtocolType, Hostname: ptr.To(gwv1.Hostname("b.example.com"))},
+				}, map[int32][]routeutils.RouteDescriptor{80: {routeA, routeB}}),
+			},
+			expectedListeners: []gwv1.SectionName{"http", "http"},
+			expectedRoutes:    map[int32][]routeutils.RouteDescriptor{80: {routeA, routeB}},
+			expectedMemberHasErrs: map[string]bool{
+				"gw1": false,
+				"gw2": false,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			listeners, routes := mergeSharedLoadBalancerListeners(tc.members)
+
+			var listenerNames []gwv1.SectionName
+			for _, l := range listeners {
+				listenerNames = append(listenerNames, l.Name)
+			}
+			assert.Equal(t, tc.expectedListeners, listenerNames)
+			assert.Equal(t, tc.expectedRoutes, routes)
+
+			for _, member := range tc.members {
+				validation := member.loaderResults.ValidationResults.GatewayListenerValidation
+				assert.Equal(t, tc.expectedMemberHasErrs[member.gw.Name], validation.HasErrors)
+				for listenerName, result := range validation.Results {
+					expectedReason, conflicted := tc.expectedConflicts[member.gw.Name][listenerName]
+					assert.Equal(t, !conflicted, result.IsValid)
+					if conflicted {
+						assert.Equal(t, expectedReason, result.Reason)
+					}
+				}
+				if expectedMemberRoutes, ok := tc.expectedMemberRoutes[member.gw.Name]; ok {
+					assert.Equal(t, expectedMemberRoutes, member.loaderResults.Routes)
+				}
+			}
+		})
+	}
+}
+
+func Test_joinSharedLoadBalancer(t *testing.T) {
+	const finalizer = "gateway.k8s.aws/alb"
+	testCases := []struct {
+		name                string
+		finalizers          []string
+		annotations         map[string]string
+		expectedAnnotations map[string]string
+	}{
+		{
+			name: "gateway without load balancer of its own",
+			expectedAnnotations: map[string]string{
+				gateway_constants.AnnotationSharedLoadBalancer: "shared-stack",
+			},
+		},
+		{
+			name:       "gateway with load balancer of its own keeps it until served by the shared load balancer",
+			finalizers: []string{finalizer},
+			annotations: map[string]string{
+				"gateway.k8s.aws.addon.wafv2":  "true",
+				"gateway.k8s.aws.addon.shield": "false",
+			},
+			expectedAnnotations: map[string]string{
+				gateway_constants.AnnotationSharedLoadBalancer:          "shared-stack",
+				gateway_constants.AnnotationDedicatedLoadBalancerAddons: "WAFv2",
+				"gateway.k8s.aws.addon.wafv2":                           "false",
+				"gateway.k8s.aws.addon.shield":                          "false",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k8sClient := testutils.GenerateTestClient()
+			gw := &gwv1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "ns",
+					Name:        "gw",
+					Finalizers:  tc.finalizers,
+					Annotations: tc.annotations,
+				},
+			}
+			assert.NoError(t, k8sClient.Create(context.Background(), gw))
+
+			r := &gatewayReconciler{
+				k8sClient: k8sClient,
+				finalizer: finalizer,
+				logger:    logr.Discard(),
+			}
+			assert.NoError(t, r.joinSharedLoadBalancer(context.Background(), gw, "shared-stack"))
+
+			updatedGw := &gwv1.Gateway{}
+			assert.NoError(t, k8sClient.Get(context.Background(), k8s.NamespacedName(gw), updatedGw))
+			assert.Equal(t, tc.expectedAnnotations, updatedGw.Annotations)
+		})
+	}
+}
@@ -4,18 +4,24 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/record"
 	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/v3/apis/gateway/v1"
+	configurationeventhandlers "sigs.k8s.io/aws-load-balancer-controller/v3/controllers/gateway/eventhandlers/configuration"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/config"
+	ctrlerrors "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/error"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway/constants"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway/gatewayutils"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway/referencecounter"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway/routeutils"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/k8s"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/runtime"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/shared_constants"
@@ -23,11 +29,18 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
+const (
+	// Gateways pick up the Services of their routes on reconcile, so the ancestors of a target group configuration are refreshed periodically.
+	targetGroupConfigurationAncestorRefreshInterval = 5 * time.Minute
+	serviceKind                                     = "Service"
+)
+
 // NewTargetGroupConfigurationReconciler constructs a reconciler that responds to targetgroup configuration changes
 func NewTargetGroupConfigurationReconciler(k8sClient client.Client, eventRecorder record.EventRecorder, controllerConfig config.ControllerConfig, serviceReferenceCounter referencecounter.ServiceReferenceCounter, finalizerManager k8s.FinalizerManager, logger logr.Logger) Reconciler {
 
@@ -60,6 +73,18 @@ func (r *targetgroupConfigurationReconciler) SetupWatches(_ context.Context, ctr
 		return err
 	}
 
+	// Gateways are the ancestors reported in the status of a targetgroup configuration,
+	// either through the Services of their routes or through the default targetgroup configuration of their loadbalancer configuration.
+	gwEventHandler := configurationeventhandlers.NewEnqueueRequestsForObjectEvent(configurationeventhandlers.NewAllTargetGroupConfigurations[*gwv1.Gateway](r.k8sClient, r.logger), r.logger)
+	if err := ctrl.Watch(source.Kind(mgr.GetCache(), &gwv1.Gateway{}, gwEventHandler)); err != nil {
+		return err
+	}
+	lbcEventHandler := configurationeventhandlers.NewEnqueueRequestsForObjectEvent(configurationeventhandlers.TargetGroupConfigurationsForLoadBalancerConfiguration, r.logger)
+	if err := ctrl.Watch(source.Kind(mgr.GetCache(), &elbv2gw.LoadBalancerConfiguration{}, lbcEventHandler,
+		predicate.TypedGenerationChangedPredicate[*elbv2gw.LoadBalancerConfiguration]{})); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -76,16 +101,34 @@ func (r *targetgroupConfigurationReconciler) reconcile(ctx context.Context, req
 	r.logger.V(1).Info("Found tg configuration", "cfg", tgConf)
 
 	if tgConf.DeletionTimestamp == nil || tgConf.DeletionTimestamp.IsZero() {
-		return r.handleUpdate(tgConf)
+		return r.handleUpdate(ctx, tgConf)
 	}
 	return r.handleDelete(tgConf)
 }
 
-func (r *targetgroupConfigurationReconciler) handleUpdate(tgConf *elbv2gw.TargetGroupConfiguration) error {
-	if k8s.HasFinalizer(tgConf, shared_constants.TargetGroupConfigurationFinalizer) {
+func (r *targetgroupConfigurationReconciler) handleUpdate(ctx context.Context, tgConf *elbv2gw.TargetGroupConfiguration) error {
+	if !k8s.HasFinalizer(tgConf, shared_constants.TargetGroupConfigurationFinalizer) {
+		if err := r.finalizerManager.AddFinalizers(ctx, tgConf, shared_constants.TargetGroupConfigurationFinalizer); err != nil {
+			return err
+		}
+	}
+	var ancestors []elbv2gw.ConfigurationAncestorStatus
+	var err error
+	if tgConf.Spec.TargetReference == nil {
+		ancestors, err = r.buildDefaultAncestorStatuses(ctx, tgConf)
+	} else {
+		ancestors, err = r.buildTargetAncestorStatuses(ctx, tgConf)
+	}
+	if err != nil {
+		return err
+	}
+	if err := r.updateStatus(ctx, tgConf, ancestors); err != nil {
+		return err
+	}
+	if tgConf.Spec.TargetReference == nil {
 		return nil
 	}
-	return r.finalizerManager.AddFinalizers(context.Background(), tgConf, shared_constants.TargetGroupConfigurationFinalizer)
+	return ctrlerrors.NewRequeueNeededAfter("refresh targetgroup configuration ancestors", targetGroupConfigurationAncestorRefreshInterval)
 }
 
 func (r *targetgroupConfigurationReconciler) handleDelete(tgConf *elbv2gw.TargetGroupConfiguration) error {
@@ -123,6 +166,14 @@ func (r *targetgroupConfigurationReconciler) handleDelete(tgConf *elbv2gw.Target
 		if inUseLBC != "" {
 			return fmt.Errorf("default targetgroup configuration [%+v] is still in use by LoadBalancerConfiguration [%s]", k8s.NamespacedName(tgConf), inUseLBC)
 		}
+		// TGC without targetReference can also be attached to route backends through ExtensionRef filters.
+		inUseByRoutes, err := routeutils.IsTargetGroupConfigInUseByRoutes(context.Background(), tgConf, r.k8sClient)
+		if err != nil {
+			return err
+		}
+		if inUseByRoutes {
+			return fmt.Errorf("targetgroup configuration [%+v] is still in use by routes", k8s.NamespacedName(tgConf))
+		}
 		return r.finalizerManager.RemoveFinalizers(context.Background(), tgConf, shared_constants.TargetGroupConfigurationFinalizer)
 	}
 
@@ -184,6 +235,133 @@ func (r *targetgroupConfigurationReconciler) isDefaultTGCInUse(ctx context.Conte
 	return "", nil
 }
 
+// buildTargetAncestorStatuses computes the status of a targetgroup configuration with targetReference for each Gateway that routes to its Service.
+func (r *targetgroupConfigurationReconciler) buildTargetAncestorStatuses(ctx context.Context, tgConf *elbv2gw.TargetGroupConfiguration) ([]elbv2gw.ConfigurationAncestorStatus, error) {
+	targetKind := serviceKind
+	if tgConf.Spec.TargetReference.Kind != nil {
+		targetKind = *tgConf.Spec.TargetReference.Kind
+	}
+	// Gateways are only tracked for Service targets.
+	if targetKind != serviceKind {
+		return nil, nil
+	}
+	svcKey := types.NamespacedName{Namespace: tgConf.Namespace, Name: tgConf.Spec.TargetReference.Name}
+
+	resolvedRefsCondition := newConfigCondition(elbv2gw.ConfigurationConditionResolvedRefs, metav1.ConditionTrue, elbv2gw.ConfigurationReasonResolvedRefs, "All references are resolved", tgConf.Generation)
+	if err := r.k8sClient.Get(ctx, svcKey, &corev1.Service{}); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		resolvedRefsCondition = newConfigCondition(elbv2gw.ConfigurationConditionResolvedRefs, metav1.ConditionFalse, elbv2gw.ConfigurationReasonTargetNotFound, fmt.Sprintf("Service %s not found", svcKey), tgConf.Generation)
+	}
+
+	acceptedCondition := newConfigCondition(elbv2gw.ConfigurationConditionAccepted, metav1.ConditionTrue, elbv2gw.ConfigurationReasonAccepted, "Accepted", tgConf.Generation)
+	conflictedCondition := newConfigCondition(elbv2gw.ConfigurationConditionConflicted, metav1.ConditionFalse, elbv2gw.ConfigurationReasonNoConflicts, "No conflicts", tgConf.Generation)
+	selected, err := routeutils.LookUpTargetGroupConfiguration(ctx, r.k8sClient, targetKind, svcKey)
+	if err != nil {
+		return nil, err
+	}
+	if selected != nil && selected.UID != tgConf.UID {
+		message := fmt.Sprintf("TargetGroupConfiguration %s takes precedence for Service %s", k8s.NamespacedName(selected), svcKey)
+		acceptedCondition = newConfigCondition(elbv2gw.ConfigurationConditionAccepted, metav1.ConditionFalse, elbv2gw.ConfigurationReasonConflicted, message, tgConf.Generation)
+		conflictedCondition = newConfigCondition(elbv2gw.ConfigurationConditionConflicted, metav1.ConditionTrue, elbv2gw.ConfigurationReasonConflicted, message, tgConf.Generation)
+	}
+
+	var ancestors []elbv2gw.ConfigurationAncestorStatus
+	for _, gwKey := range r.serviceReferenceCounter.GetGatewaysForService(svcKey) {
+		controllerName, err := r.getGatewayControllerName(ctx, gwKey)
+		if err != nil {
+			return nil, err
+		}
+		if !constants.FullGatewayControllerSet.Has(controllerName) {
+			continue
+		}
+		ancestors = append(ancestors, elbv2gw.ConfigurationAncestorStatus{
+			AncestorRef:    gatewayAncestorRef(gwKey),
+			ControllerName: controllerName,
+			Conditions:     []metav1.Condition{acceptedCondition, resolvedRefsCondition, conflictedCondition},
+		})
+	}
+	return ancestors, nil
+}
+
+// buildDefaultAncestorStatuses computes the status of a default targetgroup configuration for each Gateway and GatewayClass
+// whose loadbalancer configuration references it.
+func (r *targetgroupConfigurationReconciler) buildDefaultAncestorStatuses(ctx context.Context, tgConf *elbv2gw.TargetGroupConfiguration) ([]elbv2gw.ConfigurationAncestorStatus, error) {
+	lbConfigList := &elbv2gw.LoadBalancerConfigurationList{}
+	if err := r.k8sClient.List(ctx, lbConfigList, client.InNamespace(tgConf.Namespace)); err != nil {
+		return nil, err
+	}
+
+	conditions := []metav1.Condition{
+		newConfigCondition(elbv2gw.ConfigurationConditionAccepted, metav1.ConditionTrue, elbv2gw.ConfigurationReasonAccepted, "Accepted", tgConf.Generation),
+		newConfigCondition(elbv2gw.ConfigurationConditionResolvedRefs, metav1.ConditionTrue, elbv2gw.ConfigurationReasonResolvedRefs, "All references are resolved", tgConf.Generation),
+		newConfigCondition(elbv2gw.ConfigurationConditionConflicted, metav1.ConditionFalse, elbv2gw.ConfigurationReasonNoConflicts, "No conflicts", tgConf.Generation),
+	}
+	var ancestors []elbv2gw.ConfigurationAncestorStatus
+	for i := range lbConfigList.Items {
+		lbConfig := &lbConfigList.Items[i]
+		if lbConfig.Spec.DefaultTargetGroupConfiguration == nil || lbConfig.Spec.DefaultTargetGroupConfiguration.Name != tgConf.Name {
+			continue
+		}
+
+		gwClasses, err := gatewayutils.GetImpactedGatewayClassesFromLbConfig(ctx, r.k8sClient, lbConfig, constants.FullGatewayControllerSet)
+		if err != nil {
+			return nil, err
+		}
+		for _, gwClass := range gwClasses {
+			ancestors = append(ancestors, elbv2gw.ConfigurationAncestorStatus{
+				AncestorRef:    gatewayClassAncestorRef(gwClass),
+				ControllerName: string(gwClass.Spec.ControllerName),
+				Conditions:     conditions,
+			})
+		}
+
+		for _, controllerName := range sets.List(constants.FullGatewayControllerSet) {
+			gws, err := gatewayutils.GetImpactedGatewaysFromLbConfig(ctx, r.k8sClient, lbConfig, controllerName)
+			if err != nil {
+				return nil, err
+			}
+			for _, gw := range gws {
+				ancestors = append(ancestors, elbv2gw.ConfigurationAncestorStatus{
+					AncestorRef:    gatewayAncestorRef(k8s.NamespacedName(gw)),
+					ControllerName: controllerName,
+					Conditions:     conditions,
+				})
+			}
+		}
+	}
+	return ancestors, nil
+}
+
+// getGatewayControllerName returns the controller of a Gateway, or empty string if the Gateway no longer exists.
+func (r *targetgroupConfigurationReconciler) getGatewayControllerName(ctx context.Context, gwKey types.NamespacedName) (string, error) {
+	gw := &gwv1.Gateway{}
+	if err := r.k8sClient.Get(ctx, gwKey, gw); err != nil {
+		return "", client.IgnoreNotFound(err)
+	}
+	gwClass := &gwv1.GatewayClass{}
+	if err := r.k8sClient.Get(ctx, types.NamespacedName{Name: string(gw.Spec.GatewayClassName)}, gwClass); err != nil {
+		return "", client.IgnoreNotFound(err)
+	}
+	return string(gwClass.Spec.ControllerName), nil
+}
+
+// updateStatus updates the TargetGroupConfiguration status with the ancestor statuses
+func (r *targetgroupConfigurationReconciler) updateStatus(ctx context.Context, tgConf *elbv2gw.TargetGroupConfiguration, ancestors []elbv2gw.ConfigurationAncestorStatus) error {
+	mergedAncestors := mergeConfigAncestorStatuses(tgConf.Status.Ancestors, ancestors)
+	if isConfigAncestorStatusesEqual(tgConf.Status.Ancestors, mergedAncestors) {
+		return nil
+	}
+
+	tgConfOld := tgConf.DeepCopy()
+	tgConf.Status.Ancestors = mergedAncestors
+	if err := r.k8sClient.Status().Patch(ctx, tgConf, client.MergeFrom(tgConfOld)); err != nil {
+		return fmt.Errorf("failed to update TargetGroupConfiguration status: %w", err)
+	}
+	return nil
+}
+
 func (r *targetgroupConfigurationReconciler) SetupWithManager(_ context.Context, mgr ctrl.Manager) (controller.Controller, error) {
 	return controller.New(constants.TargetGroupConfigurationController, mgr, controller.Options{
 		MaxConcurrentReconciles: r.workers,
@@ -0,0 +1,195 @@
+package gateway
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/v3/apis/gateway/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway/constants"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway/referencecounter"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/testutils"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func Test_targetgroupConfigurationReconciler_buildTargetAncestorStatuses(t *testing.T) {
+	older := metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local))
+	newer := metav1.NewTime(time.Date(2024, 6, 1, 0, 0, 0, 0, time.Local))
+	gwKey := types.NamespacedName{Namespace: "ns", Name: "gw"}
+	svcKey := types.NamespacedName{Namespace: "ns", Name: "svc"}
+
+	newTGC := func(name string, uid types.UID, created metav1.Time) *elbv2gw.TargetGroupConfiguration {
+		return &elbv2gw.TargetGroupConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: name, UID: uid, CreationTimestamp: created, Generation: 2},
+			Spec: elbv2gw.TargetGroupConfigurationSpec{
+				TargetReference: &elbv2gw.Reference{Name: "svc"},
+			},
+		}
+	}
+
+	tests := []struct {
+		name               string
+		tgConf             *elbv2gw.TargetGroupConfiguration
+		existingTGCs       []*elbv2gw.TargetGroupConfiguration
+		createService      bool
+		referenceGateway   bool
+		wantAncestors      int
+		wantAccepted       metav1.ConditionStatus
+		wantAcceptedReason elbv2gw.ConfigurationConditionReason
+		wantResolvedRefs   metav1.ConditionStatus
+		wantResolvedReason elbv2gw.ConfigurationConditionReason
+		wantConflicted     metav1.ConditionStatus
+	}{
+		{
+			name:               "accepted by the gateway routing to the service",
+			tgConf:             newTGC("tgc", "uid-1", older),
+			existingTGCs:       []*elbv2gw.TargetGroupConfiguration{newTGC("tgc", "uid-1", older)},
+			createService:      true,
+			referenceGateway:   true,
+			wantAncestors:      1,
+			wantAccepted:       metav1.ConditionTrue,
+			wantAcceptedReason: elbv2gw.ConfigurationReasonAccepted,
+			wantResolvedRefs:   metav1.ConditionTrue,
+			wantResolvedReason: elbv2gw.ConfigurationReasonResolvedRefs,
+			wantConflicted:     metav1.ConditionFalse,
+		},
+		{
+			name:   "older configuration for the same service takes precedence",
+			tgConf: newTGC("tgc-new", "uid-2", newer),
+			existingTGCs: []*elbv2gw.TargetGroupConfiguration{
+				newTGC("tgc-old", "uid-1", older),
+				newTGC("tgc-new", "uid-2", newer),
+			},
+			createService:      true,
+			referenceGateway:   true,
+			wantAncestors:      1,
+			wantAccepted:       metav1.ConditionFalse,
+			wantAcceptedReason: elbv2gw.ConfigurationReasonConflicted,
+			wantResolvedRefs:   metav1.ConditionTrue,
+			wantResolvedReason: elbv2gw.ConfigurationReasonResolvedRefs,
+			wantConflicted:     metav1.ConditionTrue,
+		},
+		{
+			name:               "service not found",
+			tgConf:             newTGC("tgc", "uid-1", older),
+			existingTGCs:       []*elbv2gw.TargetGroupConfiguration{newTGC("tgc", "uid-1", older)},
+			referenceGateway:   true,
+			wantAncestors:      1,
+			wantAccepted:       metav1.ConditionTrue,
+			wantAcceptedReason: elbv2gw.ConfigurationReasonAccepted,
+			wantResolvedRefs:   metav1.ConditionFalse,
+			wantResolvedReason: elbv2gw.ConfigurationReasonTargetNotFound,
+			wantConflicted:     metav1.ConditionFalse,
+		},
+		{
+			name:          "service not routed by any gateway",
+			tgConf:        newTGC("tgc", "uid-1", older),
+			existingTGCs:  []*elbv2gw.TargetGroupConfiguration{newTGC("tgc", "uid-1", older)},
+			createService: true,
+			wantAncestors: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sClient := testutils.GenerateTestClient()
+			assert.NoError(t, k8sClient.Create(ctx, &gwv1.GatewayClass{
+				ObjectMeta: metav1.ObjectMeta{Name: "alb-class"},
+				Spec:       gwv1.GatewayClassSpec{ControllerName: gwv1.GatewayController(constants.ALBGatewayController)},
+			}))
+			assert.NoError(t, k8sClient.Create(ctx, &gwv1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{Namespace: gwKey.Namespace, Name: gwKey.Name},
+				Spec:       gwv1.GatewaySpec{GatewayClassName: "alb-class"},
+			}))
+			if tc.createService {
+				assert.NoError(t, k8sClient.Create(ctx, &corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{Namespace: svcKey.Namespace, Name: svcKey.Name},
+				}))
+			}
+			for _, tgc := range tc.existingTGCs {
+				assert.NoError(t, k8sClient.Create(ctx, tgc))
+			}
+			refCounter := referencecounter.NewServiceReferenceCounter()
+			if tc.referenceGateway {
+				refCounter.UpdateRelations([]types.NamespacedName{svcKey}, gwKey, false)
+			}
+			r := &targetgroupConfigurationReconciler{
+				k8sClient:               k8sClient,
+				logger:                  logr.Discard(),
+				serviceReferenceCounter: refCounter,
+			}
+
+			ancestors, err := r.buildTargetAncestorStatuses(ctx, tc.tgConf)
+			assert.NoError(t, err)
+			assert.Len(t, ancestors, tc.wantAncestors)
+			if tc.wantAncestors == 0 {
+				return
+			}
+			assert.Equal(t, gatewayAncestorRef(gwKey), ancestors[0].AncestorRef)
+			assert.Equal(t, constants.ALBGatewayController, ancestors[0].ControllerName)
+			conditions := make(map[string]metav1.Condition)
+			for _, cond := range ancestors[0].Conditions {
+				assert.Equal(t, int64(2), cond.ObservedGeneration)
+				conditions[cond.Type] = cond
+			}
+			assert.Equal(t, tc.wantAccepted, conditions[string(elbv2gw.ConfigurationConditionAccepted)].Status)
+			assert.Equal(t, string(tc.wantAcceptedReason), conditions[string(elbv2gw.ConfigurationConditionAccepted)].Reason)
+			assert.Equal(t, tc.wantResolvedRefs, conditions[string(elbv2gw.ConfigurationConditionResolvedRefs)].Status)
+			assert.Equal(t, string(tc.wantResolvedReason), conditions[string(elbv2gw.ConfigurationConditionResolvedRefs)].Reason)
+			assert.Equal(t, tc.wantConflicted, conditions[string(elbv2gw.ConfigurationConditionConflicted)].Status)
+		})
+	}
+}
+
+func Test_targetgroupConfigurationReconciler_buildDefaultAncestorStatuses(t *testing.T) {
+	ctx := context.Background()
+	k8sClient := testutils.GenerateTestClient()
+	assert.NoError(t, k8sClient.Create(ctx, &elbv2gw.LoadBalancerConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "lbc"},
+		Spec: elbv2gw.LoadBalancerConfigurationSpec{
+			DefaultTargetGroupConfiguration: &elbv2gw.DefaultTargetGroupConfigurationReference{Name: "default-tgc"},
+		},
+	}))
+	assert.NoError(t, k8sClient.Create(ctx, &gwv1.GatewayClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "alb-class"},
+		Spec:       gwv1.GatewayClassSpec{ControllerName: gwv1.GatewayController(constants.ALBGatewayController)},
+	}))
+	assert.NoError(t, k8sClient.Create(ctx, &gwv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "gw"},
+		Spec: gwv1.GatewaySpec{
+			GatewayClassName: "alb-class",
+			Infrastructure: &gwv1.GatewayInfrastructure{
+				ParametersRef: &gwv1.LocalParametersReference{
+					Group: constants.ControllerCRDGroupVersion,
+					Kind:  constants.LoadBalancerConfiguration,
+					Name:  "lbc",
+				},
+			},
+		},
+	}))
+	r := &targetgroupConfigurationReconciler{
+		k8sClient: k8sClient,
+		logger:    logr.Discard(),
+	}
+
+	ancestors, err := r.buildDefaultAncestorStatuses(ctx, &elbv2gw.TargetGroupConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "default-tgc"},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, ancestors, 1)
+	assert.Equal(t, gatewayAncestorRef(types.NamespacedName{Namespace: "ns", Name: "gw"}), ancestors[0].AncestorRef)
+	assert.Equal(t, constants.ALBGatewayController, ancestors[0].ControllerName)
+	assert.Len(t, ancestors[0].Conditions, 3)
+
+	ancestors, err = r.buildDefaultAncestorStatuses(ctx, &elbv2gw.TargetGroupConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "unused-tgc"},
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, ancestors)
+}
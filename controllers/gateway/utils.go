@@ -27,20 +27,21 @@ const (
 	maxMessageLength = 32700
 )
 
-// computeProcessedConfigVersion computes a composite version string from the LBC and optional TGC resource versions.
+// computeProcessedConfigVersion computes a composite version string from the LBC and optional TGC generations.
+// Generations are used rather than resource versions, so that status updates of the configurations don't invalidate the processed version.
 func computeProcessedConfigVersion(lbConf *elbv2gw.LoadBalancerConfiguration, tgConf *elbv2gw.TargetGroupConfiguration) string {
 	if lbConf == nil {
 		return ""
 	}
-	version := lbConf.ResourceVersion
+	version := strconv.FormatInt(lbConf.Generation, 10)
 	if tgConf != nil {
-		version = version + "-" + tgConf.ResourceVersion
+		version = version + "-" + strconv.FormatInt(tgConf.Generation, 10)
 	}
 	return version
 }
 
-// updateGatewayClassLastProcessedConfig updates the gateway class annotations with the last processed lb config resource version or "" if no lb config is attached to the gatewayclass.
-// When a default TargetGroupConfiguration is referenced by the LBC, its resource version is included in the calculated version
+// updateGatewayClassLastProcessedConfig updates the gateway class annotations with the last processed lb config generation or "" if no lb config is attached to the gatewayclass.
+// When a default TargetGroupConfiguration is referenced by the LBC, its generation is included in the calculated version
 // so that TGC changes also trigger downstream Gateway reconciliation.
 func updateGatewayClassLastProcessedConfig(ctx context.Context, k8sClient client.Client, gwClass *gwv1.GatewayClass, lbConf *elbv2gw.LoadBalancerConfiguration, tgConf *elbv2gw.TargetGroupConfiguration) error {
 
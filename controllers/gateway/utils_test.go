@@ -41,7 +41,7 @@ func Test_updateGatewayClassLastProcessedConfig(t *testing.T) {
 				ObjectMeta: v1.ObjectMeta{
 					Name: "gwclass",
 					Annotations: map[string]string{
-						gatewayClassAnnotationLastProcessedConfig:          "foo",
+						gatewayClassAnnotationLastProcessedConfig:          "1",
 						gatewayClassAnnotationLastProcessedConfigTimestamp: "0",
 					},
 				},
@@ -52,7 +52,7 @@ func Test_updateGatewayClassLastProcessedConfig(t *testing.T) {
 			name: "with lb conf, no prior annotation",
 			lbConf: &elbv2gw.LoadBalancerConfiguration{
 				ObjectMeta: v1.ObjectMeta{
-					ResourceVersion: "bar",
+					Generation: 2,
 				},
 			},
 			gwClass: gwv1.GatewayClass{
@@ -60,55 +60,55 @@ func Test_updateGatewayClassLastProcessedConfig(t *testing.T) {
 					Name: "gwclass",
 				},
 			},
-			expectedVersion: "bar",
+			expectedVersion: "2",
 		},
 		{
 			name: "with lb conf, with prior annotation",
 			lbConf: &elbv2gw.LoadBalancerConfiguration{
 				ObjectMeta: v1.ObjectMeta{
-					ResourceVersion: "bar",
+					Generation: 2,
 				},
 			},
 			gwClass: gwv1.GatewayClass{
 				ObjectMeta: v1.ObjectMeta{
 					Name: "gwclass",
 					Annotations: map[string]string{
-						gatewayClassAnnotationLastProcessedConfig:          "foo",
+						gatewayClassAnnotationLastProcessedConfig:          "1",
 						gatewayClassAnnotationLastProcessedConfigTimestamp: "0",
 					},
 				},
 			},
-			expectedVersion: "bar",
+			expectedVersion: "2",
 		},
 		{
 			name: "no change in stored version should not trigger patch",
 			lbConf: &elbv2gw.LoadBalancerConfiguration{
 				ObjectMeta: v1.ObjectMeta{
-					ResourceVersion: "foo",
+					Generation: 1,
 				},
 			},
 			gwClass: gwv1.GatewayClass{
 				ObjectMeta: v1.ObjectMeta{
 					Name: "gwclass",
 					Annotations: map[string]string{
-						gatewayClassAnnotationLastProcessedConfig:          "foo",
+						gatewayClassAnnotationLastProcessedConfig:          "1",
 						gatewayClassAnnotationLastProcessedConfigTimestamp: "10",
 					},
 				},
 			},
-			expectedVersion: "foo",
+			expectedVersion: "1",
 			noPatch:         true,
 		},
 		{
 			name: "with lb conf and tg conf, composite version",
 			lbConf: &elbv2gw.LoadBalancerConfiguration{
 				ObjectMeta: v1.ObjectMeta{
-					ResourceVersion: "lbc-v1",
+					Generation: 1,
 				},
 			},
 			tgConf: &elbv2gw.TargetGroupConfiguration{
 				ObjectMeta: v1.ObjectMeta{
-					ResourceVersion: "tgc-v1",
+					Generation: 1,
 				},
 			},
 			gwClass: gwv1.GatewayClass{
@@ -116,60 +116,60 @@ func Test_updateGatewayClassLastProcessedConfig(t *testing.T) {
 					Name: "gwclass",
 				},
 			},
-			expectedVersion: "lbc-v1-tgc-v1",
+			expectedVersion: "1-1",
 		},
 		{
 			name: "tg conf change triggers patch even when lb conf unchanged",
 			lbConf: &elbv2gw.LoadBalancerConfiguration{
 				ObjectMeta: v1.ObjectMeta{
-					ResourceVersion: "lbc-v1",
+					Generation: 1,
 				},
 			},
 			tgConf: &elbv2gw.TargetGroupConfiguration{
 				ObjectMeta: v1.ObjectMeta{
-					ResourceVersion: "tgc-v2",
+					Generation: 2,
 				},
 			},
 			gwClass: gwv1.GatewayClass{
 				ObjectMeta: v1.ObjectMeta{
 					Name: "gwclass",
 					Annotations: map[string]string{
-						gatewayClassAnnotationLastProcessedConfig:          "lbc-v1-tgc-v1",
+						gatewayClassAnnotationLastProcessedConfig:          "1-1",
 						gatewayClassAnnotationLastProcessedConfigTimestamp: "10",
 					},
 				},
 			},
-			expectedVersion: "lbc-v1-tgc-v2",
+			expectedVersion: "1-2",
 		},
 		{
 			name: "no change in composite version should not trigger patch",
 			lbConf: &elbv2gw.LoadBalancerConfiguration{
 				ObjectMeta: v1.ObjectMeta{
-					ResourceVersion: "lbc-v1",
+					Generation: 1,
 				},
 			},
 			tgConf: &elbv2gw.TargetGroupConfiguration{
 				ObjectMeta: v1.ObjectMeta{
-					ResourceVersion: "tgc-v1",
+					Generation: 1,
 				},
 			},
 			gwClass: gwv1.GatewayClass{
 				ObjectMeta: v1.ObjectMeta{
 					Name: "gwclass",
 					Annotations: map[string]string{
-						gatewayClassAnnotationLastProcessedConfig:          "lbc-v1-tgc-v1",
+						gatewayClassAnnotationLastProcessedConfig:          "1-1",
 						gatewayClassAnnotationLastProcessedConfigTimestamp: "10",
 					},
 				},
 			},
-			expectedVersion: "lbc-v1-tgc-v1",
+			expectedVersion: "1-1",
 			noPatch:         true,
 		},
 		{
 			name: "lb conf with nil tg conf uses only lb version",
 			lbConf: &elbv2gw.LoadBalancerConfiguration{
 				ObjectMeta: v1.ObjectMeta{
-					ResourceVersion: "lbc-v1",
+					Generation: 1,
 				},
 			},
 			tgConf: nil,
@@ -178,7 +178,7 @@ func Test_updateGatewayClassLastProcessedConfig(t *testing.T) {
 					Name: "gwclass",
 				},
 			},
-			expectedVersion: "lbc-v1",
+			expectedVersion: "1",
 		},
 	}
 
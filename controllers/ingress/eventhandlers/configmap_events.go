@@ -0,0 +1,138 @@
+package eventhandlers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/v3/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/ingress"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/shared_utils"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// NewEnqueueRequestsForConfigMapEvent constructs new enqueueRequestsForConfigMapEvent.
+// ConfigMaps are watched by metadata only, since only the Ingresses referencing them as trustStore CA bundles are impacted.
+// ingClassEventChan is nil when the IngressClass resource isn't available.
+func NewEnqueueRequestsForConfigMapEvent(ingEventChan chan<- event.TypedGenericEvent[*networking.Ingress], ingClassEventChan chan<- event.TypedGenericEvent[*networking.IngressClass],
+	k8sClient client.Client, eventRecorder record.EventRecorder, logger logr.Logger) handler.TypedEventHandler[*metav1.PartialObjectMetadata, reconcile.Request] {
+	return &enqueueRequestsForConfigMapEvent{
+		ingEventChan:      ingEventChan,
+		ingClassEventChan: ingClassEventChan,
+		k8sClient:         k8sClient,
+		eventRecorder:     eventRecorder,
+		logger:            logger,
+	}
+}
+
+var _ handler.TypedEventHandler[*metav1.PartialObjectMetadata, reconcile.Request] = (*enqueueRequestsForConfigMapEvent)(nil)
+
+type enqueueRequestsForConfigMapEvent struct {
+	ingEventChan      chan<- event.TypedGenericEvent[*networking.Ingress]
+	ingClassEventChan chan<- event.TypedGenericEvent[*networking.IngressClass]
+	k8sClient         client.Client
+	eventRecorder     record.EventRecorder
+	logger            logr.Logger
+}
+
+func (h *enqueueRequestsForConfigMapEvent) Create(ctx context.Context, e event.TypedCreateEvent[*metav1.PartialObjectMetadata], _ workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	cmNew := e.Object
+	h.enqueueImpactedObjects(ctx, cmNew)
+}
+
+func (h *enqueueRequestsForConfigMapEvent) Update(ctx context.Context, e event.TypedUpdateEvent[*metav1.PartialObjectMetadata], _ workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	cmOld := e.ObjectOld
+	cmNew := e.ObjectNew
+
+	// the data of ConfigMaps isn't watched, any change to the ConfigMap is assumed to change its CA bundle.
+	// periodic resyncs don't change the resourceVersion.
+	if cmOld.ResourceVersion == cmNew.ResourceVersion {
+		return
+	}
+	h.enqueueImpactedObjects(ctx, cmNew)
+}
+
+func (h *enqueueRequestsForConfigMapEvent) Delete(ctx context.Context, e event.TypedDeleteEvent[*metav1.PartialObjectMetadata], _ workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	cmOld := e.Object
+	h.enqueueImpactedObjects(ctx, cmOld)
+}
+
+func (h *enqueueRequestsForConfigMapEvent) Generic(ctx context.Context, e event.TypedGenericEvent[*metav1.PartialObjectMetadata], _ workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	cmObj := e.Object
+	h.enqueueImpactedObjects(ctx, cmObj)
+}
+
+func (h *enqueueRequestsForConfigMapEvent) enqueueImpactedObjects(ctx context.Context, cm *metav1.PartialObjectMetadata) {
+	enqueueTrustStoreCABundleConsumers(ctx, h.k8sClient, h.ingEventChan, h.ingClassEventChan, h.logger,
+		shared_utils.TrustStoreCABundleKindConfigMap, k8s.NamespacedName(cm))
+}
+
+// enqueueTrustStoreCABundleConsumers enqueues the Ingresses and IngressClasses whose trustStore CA bundle is held by the object,
+// so that the trustStore is updated once the CA bundle is rotated.
+func enqueueTrustStoreCABundleConsumers(ctx context.Context, k8sClient client.Client,
+	ingEventChan chan<- event.TypedGenericEvent[*networking.Ingress], ingClassEventChan chan<- event.TypedGenericEvent[*networking.IngressClass],
+	logger logr.Logger, kind string, objKey types.NamespacedName) {
+	indexKey := ingress.BuildTrustStoreCABundleRefIndexKey(kind, objKey.Name)
+
+	ingList := &networking.IngressList{}
+	if err := k8sClient.List(ctx, ingList,
+		client.InNamespace(objKey.Namespace),
+		client.MatchingFields{ingress.IndexKeyTrustStoreCABundleRefName: indexKey}); err != nil {
+		logger.Error(err, "failed to fetch ingresses")
+		return
+	}
+	for index := range ingList.Items {
+		ing := &ingList.Items[index]
+
+		logger.V(1).Info("enqueue ingress for trustStore CA bundle event",
+			"kind", kind,
+			"object", objKey,
+			"ingress", k8s.NamespacedName(ing))
+		ingEventChan <- event.TypedGenericEvent[*networking.Ingress]{
+			Object: ing,
+		}
+	}
+
+	if ingClassEventChan == nil {
+		return
+	}
+	ingClassParamsList := &elbv2api.IngressClassParamsList{}
+	if err := k8sClient.List(ctx, ingClassParamsList,
+		client.MatchingFields{ingress.IndexKeyTrustStoreCABundleRefName: indexKey}); err != nil {
+		logger.Error(err, "failed to fetch ingressClassParams")
+		return
+	}
+	for index := range ingClassParamsList.Items {
+		enqueueIngressClassesOfIngressClassParams(ctx, k8sClient, ingClassEventChan,
+			logger.WithValues("kind", kind, "object", objKey), &ingClassParamsList.Items[index])
+	}
+}
+
+// enqueueIngressClassesOfIngressClassParams enqueues the IngressClasses that reference ingClassParams.
+func enqueueIngressClassesOfIngressClassParams(ctx context.Context, k8sClient client.Client,
+	ingClassEventChan chan<- event.TypedGenericEvent[*networking.IngressClass], logger logr.Logger, ingClassParams *elbv2api.IngressClassParams) {
+	ingClassList := &networking.IngressClassList{}
+	if err := k8sClient.List(ctx, ingClassList,
+		client.MatchingFields{ingress.IndexKeyIngressClassParamsRefName: ingClassParams.GetName()}); err != nil {
+		logger.Error(err, "failed to fetch ingressClasses")
+		return
+	}
+	for index := range ingClassList.Items {
+		ingClass := &ingClassList.Items[index]
+
+		logger.V(1).Info("enqueue ingressClass for ingressClassParams reference event",
+			"ingressClassParams", ingClassParams.GetName(),
+			"ingressClass", ingClass.GetName())
+		ingClassEventChan <- event.TypedGenericEvent[*networking.IngressClass]{
+			Object: ingClass,
+		}
+	}
+}
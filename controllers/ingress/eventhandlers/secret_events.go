@@ -10,33 +10,39 @@ import (
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/v3/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/ingress"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/shared_utils"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 )
 
 // NewEnqueueRequestsForSecretEvent constructs new enqueueRequestsForSecretEvent.
+// ingClassEventChan is nil when the IngressClass resource isn't available.
 func NewEnqueueRequestsForSecretEvent(ingEventChan chan<- event.TypedGenericEvent[*networking.Ingress], svcEventChan chan<- event.TypedGenericEvent[*corev1.Service],
+	ingClassEventChan chan<- event.TypedGenericEvent[*networking.IngressClass],
 	k8sClient client.Client, eventRecorder record.EventRecorder, logger logr.Logger) handler.TypedEventHandler[*corev1.Secret, reconcile.Request] {
 	return &enqueueRequestsForSecretEvent{
-		ingEventChan:  ingEventChan,
-		svcEventChan:  svcEventChan,
-		k8sClient:     k8sClient,
-		eventRecorder: eventRecorder,
-		logger:        logger,
+		ingEventChan:      ingEventChan,
+		svcEventChan:      svcEventChan,
+		ingClassEventChan: ingClassEventChan,
+		k8sClient:         k8sClient,
+		eventRecorder:     eventRecorder,
+		logger:            logger,
 	}
 }
 
 var _ handler.TypedEventHandler[*corev1.Secret, reconcile.Request] = (*enqueueRequestsForSecretEvent)(nil)
 
 type enqueueRequestsForSecretEvent struct {
-	ingEventChan  chan<- event.TypedGenericEvent[*networking.Ingress]
-	svcEventChan  chan<- event.TypedGenericEvent[*corev1.Service]
-	k8sClient     client.Client
-	eventRecorder record.EventRecorder
-	logger        logr.Logger
+	ingEventChan      chan<- event.TypedGenericEvent[*networking.Ingress]
+	svcEventChan      chan<- event.TypedGenericEvent[*corev1.Service]
+	ingClassEventChan chan<- event.TypedGenericEvent[*networking.IngressClass]
+	k8sClient         client.Client
+	eventRecorder     record.EventRecorder
+	logger            logr.Logger
 }
 
 func (h *enqueueRequestsForSecretEvent) Create(ctx context.Context, e event.TypedCreateEvent[*corev1.Secret], _ workqueue.TypedRateLimitingInterface[reconcile.Request]) {
@@ -107,4 +113,21 @@ func (h *enqueueRequestsForSecretEvent) enqueueImpactedObjects(ctx context.Conte
 			Object: svc,
 		}
 	}
+
+	enqueueTrustStoreCABundleConsumers(ctx, h.k8sClient, h.ingEventChan, h.ingClassEventChan, h.logger,
+		shared_utils.TrustStoreCABundleKindSecret, secretKey)
+
+	if h.ingClassEventChan == nil {
+		return
+	}
+	ingClassParamsList := &elbv2api.IngressClassParamsList{}
+	if err := h.k8sClient.List(ctx, ingClassParamsList,
+		client.MatchingFields{ingress.IndexKeyIngressClassParamsSecretRefName: secretKey.String()}); err != nil {
+		h.logger.Error(err, "failed to fetch ingressClassParams")
+		return
+	}
+	for index := range ingClassParamsList.Items {
+		enqueueIngressClassesOfIngressClassParams(ctx, h.k8sClient, h.ingClassEventChan,
+			h.logger.WithValues("secret", secretKey), &ingClassParamsList.Items[index])
+	}
 }
@@ -11,11 +11,13 @@ import (
 
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	networking "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/record"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/v3/apis/elbv2/v1beta1"
@@ -25,6 +27,7 @@ import (
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/config"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/deploy"
 	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/deploy/shield"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/deploy/tracking"
 	ctrlerrors "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/error"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/ingress"
@@ -35,6 +38,7 @@ import (
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2"
 	networkingpkg "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/networking"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/runtime"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/shard"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -55,14 +59,14 @@ const (
 func NewGroupReconciler(cloud services.Cloud, k8sClient client.Client, eventRecorder record.EventRecorder,
 	finalizerManager k8s.FinalizerManager, networkingSGManager networkingpkg.SecurityGroupManager,
 	networkingManager networkingpkg.NetworkingManager, networkingSGReconciler networkingpkg.SecurityGroupReconciler, subnetsResolver networkingpkg.SubnetsResolver,
-	elbv2TaggingManager elbv2deploy.TaggingManager, controllerConfig config.ControllerConfig, backendSGProvider networkingpkg.BackendSGProvider,
+	elbv2TaggingManager elbv2deploy.TaggingManager, shieldProtectionManager shield.ProtectionManager, controllerConfig config.ControllerConfig, backendSGProvider networkingpkg.BackendSGProvider,
 	sgResolver networkingpkg.SecurityGroupResolver, secretsManager k8s.SecretsManager, logger logr.Logger, metricsCollector lbcmetrics.MetricCollector, reconcileCounters *metricsutil.ReconcileCounters,
-	targetGroupCollector awsmetrics.TargetGroupCollector, targetGroupNameToArnMapper shared_utils.TargetGroupARNMapper,
+	targetGroupCollector awsmetrics.TargetGroupCollector, targetGroupNameToArnMapper shared_utils.TargetGroupARNMapper, shardManager shard.Manager,
 ) *groupReconciler {
 	annotationParser := annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixIngress)
 	authConfigBuilder := ingress.NewDefaultAuthConfigBuilder(annotationParser)
 	enhancedBackendBuilder := ingress.NewDefaultEnhancedBackendBuilder(k8sClient, annotationParser, authConfigBuilder, controllerConfig.IngressConfig.TolerateNonExistentBackendService, controllerConfig.IngressConfig.TolerateNonExistentBackendAction)
-	referenceIndexer := ingress.NewDefaultReferenceIndexer(enhancedBackendBuilder, authConfigBuilder, logger)
+	referenceIndexer := ingress.NewDefaultReferenceIndexer(enhancedBackendBuilder, authConfigBuilder, annotationParser, logger)
 	trackingProvider := tracking.NewDefaultProvider(ingressTagPrefix, controllerConfig.ClusterName)
 	certDiscovery := certs.NewACMCertDiscovery(cloud.ACM(), controllerConfig.IngressConfig.AllowedCertificateAuthorityARNs, controllerConfig.FeatureGates.Enabled(config.EnableCertificateManagement), logger)
 	modelBuilder := ingress.NewDefaultModelBuilder(k8sClient, eventRecorder,
@@ -71,10 +75,10 @@ func NewGroupReconciler(cloud services.Cloud, k8sClient client.Client, eventReco
 		authConfigBuilder, enhancedBackendBuilder, trackingProvider, elbv2TaggingManager, controllerConfig.FeatureGates,
 		cloud.VpcID(), controllerConfig.ClusterName, controllerConfig.DefaultTags, controllerConfig.ExternalManagedTags,
 		controllerConfig.DefaultSSLPolicy, controllerConfig.DefaultTargetType, controllerConfig.DefaultLoadBalancerScheme, backendSGProvider, sgResolver,
-		controllerConfig.EnableBackendSecurityGroup, controllerConfig.EnableManageBackendSecurityGroupRules, controllerConfig.DisableRestrictedSGRules, controllerConfig.IngressConfig.AllowedCertificateAuthorityARNs, controllerConfig.FeatureGates.Enabled(config.EnableIPTargetType), controllerConfig.FeatureGates.Enabled(config.EnableCertificateManagement), controllerConfig.IngressConfig.DefaultPCAArn, targetGroupNameToArnMapper, secretsManager, logger, metricsCollector,
+		controllerConfig.EnableBackendSecurityGroup, controllerConfig.EnableManageBackendSecurityGroupRules, controllerConfig.DisableRestrictedSGRules, controllerConfig.RestrictSGEgress, controllerConfig.IngressConfig.AllowedCertificateAuthorityARNs, controllerConfig.FeatureGates.Enabled(config.EnableIPTargetType), controllerConfig.FeatureGates.Enabled(config.EnableCertificateManagement), controllerConfig.IngressConfig.DefaultPCAArn, targetGroupNameToArnMapper, secretsManager, logger, metricsCollector,
 		certDiscovery)
 	stackMarshaller := deploy.NewDefaultStackMarshaller()
-	stackDeployer := deploy.NewDefaultStackDeployer(cloud, k8sClient, networkingManager, networkingSGManager, networkingSGReconciler, elbv2TaggingManager,
+	stackDeployer := deploy.NewDefaultStackDeployer(cloud, k8sClient, networkingManager, networkingSGManager, networkingSGReconciler, elbv2TaggingManager, shieldProtectionManager,
 		controllerConfig, ingressTagPrefix, logger, metricsCollector, controllerName, controllerConfig.FeatureGates.Enabled(config.EnhancedDefaultBehavior), targetGroupCollector, true)
 	classLoader := ingress.NewDefaultClassLoader(k8sClient, true)
 	classAnnotationMatcher := ingress.NewDefaultClassAnnotationMatcher(controllerConfig.IngressConfig.IngressClass)
@@ -91,6 +95,7 @@ func NewGroupReconciler(cloud services.Cloud, k8sClient client.Client, eventReco
 		stackDeployer:     stackDeployer,
 		backendSGProvider: backendSGProvider,
 		secretsManager:    secretsManager,
+		shardManager:      shardManager,
 
 		groupLoader:           groupLoader,
 		groupFinalizerManager: groupFinalizerManager,
@@ -114,6 +119,7 @@ type groupReconciler struct {
 	stackDeployer     deploy.StackDeployer
 	backendSGProvider networkingpkg.BackendSGProvider
 	secretsManager    k8s.SecretsManager
+	shardManager      shard.Manager
 
 	groupLoader           ingress.GroupLoader
 	groupFinalizerManager ingress.FinalizerManager
@@ -133,9 +139,15 @@ type groupReconciler struct {
 // +kubebuilder:rbac:groups=extensions,resources=ingresses,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups=extensions,resources=ingresses/status,verbs=update;patch
 // +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 func (r *groupReconciler) Reconcile(ctx context.Context, req reconcile.Request) (ctrl.Result, error) {
+	release, owned, err := r.shardManager.Acquire(ctx, controllerName, req.NamespacedName)
+	if err != nil || !owned {
+		return runtime.HandleReconcileError(err, r.logger)
+	}
+	defer release()
 	r.reconcileCounters.IncrementIngress(req.NamespacedName)
 	return runtime.HandleReconcileError(r.reconcile(ctx, req), r.logger)
 }
@@ -339,6 +351,7 @@ func (r *groupReconciler) updateIngressStatus(ctx context.Context, lbDNS string,
 func (r *groupReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, clientSet *kubernetes.Clientset) error {
 	c, err := controller.New(controllerName, mgr, controller.Options{
 		MaxConcurrentReconciles: r.maxConcurrentReconciles,
+		NeedLeaderElection:      awssdk.Bool(!r.shardManager.Enabled()),
 		Reconciler:              r,
 	})
 	if err != nil {
@@ -381,6 +394,13 @@ func (r *groupReconciler) setupIndexes(ctx context.Context, fieldIndexer client.
 	); err != nil {
 		return err
 	}
+	if err := fieldIndexer.IndexField(ctx, &networking.Ingress{}, ingress.IndexKeyTrustStoreCABundleRefName,
+		func(obj client.Object) []string {
+			return r.referenceIndexer.BuildTrustStoreCABundleRefIndexes(context.Background(), obj.(*networking.Ingress))
+		},
+	); err != nil {
+		return err
+	}
 	if ingressClassResourceAvailable {
 		if err := fieldIndexer.IndexField(ctx, &networking.IngressClass{}, ingress.IndexKeyIngressClassParamsRefName,
 			func(obj client.Object) []string {
@@ -396,6 +416,20 @@ func (r *groupReconciler) setupIndexes(ctx context.Context, fieldIndexer client.
 		); err != nil {
 			return err
 		}
+		if err := fieldIndexer.IndexField(ctx, &elbv2api.IngressClassParams{}, ingress.IndexKeyIngressClassParamsSecretRefName,
+			func(obj client.Object) []string {
+				return r.referenceIndexer.BuildIngressClassParamsSecretRefIndexes(ctx, obj.(*elbv2api.IngressClassParams))
+			},
+		); err != nil {
+			return err
+		}
+		if err := fieldIndexer.IndexField(ctx, &elbv2api.IngressClassParams{}, ingress.IndexKeyTrustStoreCABundleRefName,
+			func(obj client.Object) []string {
+				return r.referenceIndexer.BuildIngressClassParamsTrustStoreCABundleRefIndexes(ctx, obj.(*elbv2api.IngressClassParams))
+			},
+		); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -409,8 +443,14 @@ func (r *groupReconciler) setupWatches(_ context.Context, c controller.Controlle
 		r.logger.WithName("eventHandlers").WithName("ingress"))
 	svcEventHandler := eventhandlers.NewEnqueueRequestsForServiceEvent(ingEventChan, r.k8sClient, r.eventRecorder,
 		r.logger.WithName("eventHandlers").WithName("service"))
-	secretEventHandler := eventhandlers.NewEnqueueRequestsForSecretEvent(ingEventChan, svcEventChan, r.k8sClient, r.eventRecorder,
+	var ingClassEventChan chan event.TypedGenericEvent[*networking.IngressClass]
+	if ingressClassResourceAvailable {
+		ingClassEventChan = make(chan event.TypedGenericEvent[*networking.IngressClass])
+	}
+	secretEventHandler := eventhandlers.NewEnqueueRequestsForSecretEvent(ingEventChan, svcEventChan, ingClassEventChan, r.k8sClient, r.eventRecorder,
 		r.logger.WithName("eventHandlers").WithName("secret"))
+	configMapEventHandler := eventhandlers.NewEnqueueRequestsForConfigMapEvent(ingEventChan, ingClassEventChan, r.k8sClient, r.eventRecorder,
+		r.logger.WithName("eventHandlers").WithName("configMap"))
 	if err := c.Watch(source.Channel(ingEventChan, ingEventHandler)); err != nil {
 		return err
 	}
@@ -426,8 +466,15 @@ func (r *groupReconciler) setupWatches(_ context.Context, c controller.Controlle
 	if err := c.Watch(source.Channel(secretEventsChan, secretEventHandler)); err != nil {
 		return err
 	}
+	if err := c.Watch(source.Kind(mgr.GetCache(), k8s.NewConfigMapMetadata(), configMapEventHandler)); err != nil {
+		return err
+	}
+	if r.shardManager.Enabled() {
+		if err := c.Watch(shard.NewResyncSource(r.shardManager, r.listIngressGroupRequests, r.shardManager.Owns, r.logger.WithName("shardResync"))); err != nil {
+			return err
+		}
+	}
 	if ingressClassResourceAvailable {
-		ingClassEventChan := make(chan event.TypedGenericEvent[*networking.IngressClass])
 		ingClassParamsEventHandler := eventhandlers.NewEnqueueRequestsForIngressClassParamsEvent(ingClassEventChan, r.k8sClient, r.eventRecorder,
 			r.logger.WithName("eventHandlers").WithName("ingressClassParams"))
 		ingClassEventHandler := eventhandlers.NewEnqueueRequestsForIngressClassEvent(ingEventChan, r.k8sClient, r.eventRecorder,
@@ -445,6 +492,29 @@ func (r *groupReconciler) setupWatches(_ context.Context, c controller.Controlle
 	return nil
 }
 
+func (r *groupReconciler) listIngressGroupRequests(ctx context.Context) ([]reconcile.Request, error) {
+	ingList := &networking.IngressList{}
+	if err := r.k8sClient.List(ctx, ingList); err != nil {
+		return nil, err
+	}
+	groupIDs := sets.New[ingress.GroupID]()
+	for i := range ingList.Items {
+		ing := &ingList.Items[i]
+		groupID, err := r.groupLoader.LoadGroupIDIfAny(ctx, ing)
+		if err != nil {
+			r.logger.V(1).Info("failed to load groupID for ingress", "ingress", k8s.NamespacedName(ing), "error", err)
+		} else if groupID != nil {
+			groupIDs.Insert(*groupID)
+		}
+		groupIDs.Insert(r.groupLoader.LoadGroupIDsPendingFinalization(ctx, ing)...)
+	}
+	requests := make([]reconcile.Request, 0, groupIDs.Len())
+	for groupID := range groupIDs {
+		requests = append(requests, ingress.EncodeGroupIDToReconcileRequest(groupID))
+	}
+	return requests, nil
+}
+
 func isIngressStatusEqual(a, b []networking.IngressLoadBalancerIngress) bool {
 	if len(a) != len(b) {
 		return false
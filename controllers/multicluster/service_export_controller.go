@@ -0,0 +1,93 @@
+package multicluster
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/v3/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/services"
+	ctrlerrors "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/error"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway/multicluster"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	controllerName = "serviceExport"
+
+	// requeueReasonTargetGroupsResync indicates that the reconciliation is being requeued because
+	// multi-cluster target groups created by other clusters are not watched and need to be periodically rediscovered
+	requeueReasonTargetGroupsResync = "Rediscovering multi-cluster target groups for ServiceExport %s"
+	targetGroupsResyncTime          = 5 * time.Minute
+)
+
+// NewServiceExportReconciler constructs new serviceExportReconciler
+func NewServiceExportReconciler(k8sClient client.Client, cloud services.Cloud, allowedImportingClusters []string, maxConcurrentReconciles int, logger logr.Logger) *serviceExportReconciler {
+	return &serviceExportReconciler{
+		k8sClient:               k8sClient,
+		binder:                  multicluster.NewDefaultServiceExportBinder(k8sClient, cloud.RGT(), cloud.ELBV2(), allowedImportingClusters, logger.WithName("binder")),
+		maxConcurrentReconciles: maxConcurrentReconciles,
+		logger:                  logger,
+	}
+}
+
+// serviceExportReconciler binds the endpoints of exported Services to the multi-cluster target groups
+// that Gateways in any cluster of the clusterset created for their ServiceImport.
+type serviceExportReconciler struct {
+	k8sClient               client.Client
+	binder                  multicluster.ServiceExportBinder
+	maxConcurrentReconciles int
+	logger                  logr.Logger
+}
+
+// +kubebuilder:rbac:groups=multicluster.x-k8s.io,resources=serviceexports,verbs=get;list;watch
+
+func (r *serviceExportReconciler) Reconcile(ctx context.Context, req reconcile.Request) (ctrl.Result, error) {
+	return runtime.HandleReconcileError(r.reconcile(ctx, req), r.logger)
+}
+
+func (r *serviceExportReconciler) reconcile(ctx context.Context, req reconcile.Request) error {
+	serviceExport := multicluster.NewServiceExport()
+	if err := r.k8sClient.Get(ctx, req.NamespacedName, serviceExport); err != nil {
+		// the TargetGroupBindings are garbage collected along with their ServiceExport.
+		return client.IgnoreNotFound(err)
+	}
+	if !serviceExport.GetDeletionTimestamp().IsZero() {
+		return nil
+	}
+	if err := r.binder.Bind(ctx, serviceExport); err != nil {
+		return err
+	}
+	return ctrlerrors.NewRequeueNeededAfter(fmt.Sprintf(requeueReasonTargetGroupsResync, req.NamespacedName), targetGroupsResyncTime)
+}
+
+func (r *serviceExportReconciler) SetupWithManager(_ context.Context, mgr ctrl.Manager, clientSet *kubernetes.Clientset) error {
+	resList, err := clientSet.ServerResourcesForGroupVersion(multicluster.ServiceExportGVK.GroupVersion().String())
+	if err != nil || !k8s.IsResourceKindAvailable(resList, multicluster.ServiceExportKind) {
+		r.logger.Info("ServiceExport CRD is not available, skipping controller setup")
+		return nil
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(multicluster.NewServiceExport()).
+		Named(controllerName).
+		Owns(&elbv2api.TargetGroupBinding{}).
+		// the ServiceExport has the same name as the Service it exports.
+		Watches(&corev1.Service{}, handler.EnqueueRequestsFromMapFunc(func(_ context.Context, svc client.Object) []reconcile.Request {
+			return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: svc.GetNamespace(), Name: svc.GetName()}}}
+		})).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: r.maxConcurrentReconciles,
+		}).
+		Complete(r)
+}
@@ -0,0 +1,89 @@
+package eventhandlers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/workqueue"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/v3/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/k8s"
+	svcpkg "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/service"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// NewEnqueueRequestsForServiceClassParamsEvent constructs new enqueueRequestsForServiceClassParamsEvent.
+func NewEnqueueRequestsForServiceClassParamsEvent(k8sClient client.Client, serviceUtils svcpkg.ServiceUtils, loadBalancerClass string,
+	logger logr.Logger) *enqueueRequestsForServiceClassParamsEvent {
+	return &enqueueRequestsForServiceClassParamsEvent{
+		k8sClient:         k8sClient,
+		serviceUtils:      serviceUtils,
+		loadBalancerClass: loadBalancerClass,
+		logger:            logger,
+	}
+}
+
+var _ handler.EventHandler = (*enqueueRequestsForServiceClassParamsEvent)(nil)
+
+type enqueueRequestsForServiceClassParamsEvent struct {
+	k8sClient         client.Client
+	serviceUtils      svcpkg.ServiceUtils
+	loadBalancerClass string
+	logger            logr.Logger
+}
+
+func (h *enqueueRequestsForServiceClassParamsEvent) Create(ctx context.Context, e event.CreateEvent, queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	classParamsNew := e.Object.(*elbv2api.ServiceClassParams)
+	h.enqueueImpactedServices(ctx, queue, classParamsNew.Spec.LoadBalancerClass)
+}
+
+func (h *enqueueRequestsForServiceClassParamsEvent) Update(ctx context.Context, e event.UpdateEvent, queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	classParamsOld := e.ObjectOld.(*elbv2api.ServiceClassParams)
+	classParamsNew := e.ObjectNew.(*elbv2api.ServiceClassParams)
+
+	if equality.Semantic.DeepEqual(classParamsOld.Spec, classParamsNew.Spec) {
+		return
+	}
+
+	h.enqueueImpactedServices(ctx, queue, classParamsOld.Spec.LoadBalancerClass, classParamsNew.Spec.LoadBalancerClass)
+}
+
+func (h *enqueueRequestsForServiceClassParamsEvent) Delete(ctx context.Context, e event.DeleteEvent, queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	classParamsOld := e.Object.(*elbv2api.ServiceClassParams)
+	h.enqueueImpactedServices(ctx, queue, classParamsOld.Spec.LoadBalancerClass)
+}
+
+func (h *enqueueRequestsForServiceClassParamsEvent) Generic(ctx context.Context, e event.GenericEvent, queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	// we don't have any generic event for ServiceClassParams.
+}
+
+// enqueueImpactedServices enqueues the Services managed by the controller of any of the loadBalancerClasses.
+// Services without loadBalancerClass are of the loadBalancerClass of the controller.
+func (h *enqueueRequestsForServiceClassParamsEvent) enqueueImpactedServices(ctx context.Context, queue workqueue.TypedRateLimitingInterface[reconcile.Request],
+	loadBalancerClasses ...string) {
+	classes := sets.New(loadBalancerClasses...)
+	svcList := &corev1.ServiceList{}
+	if err := h.k8sClient.List(ctx, svcList); err != nil {
+		h.logger.Error(err, "failed to fetch services")
+		return
+	}
+	for i := range svcList.Items {
+		svc := &svcList.Items[i]
+		svcLoadBalancerClass := svcpkg.ServiceLoadBalancerClass(svc, h.loadBalancerClass)
+		if !classes.Has(svcLoadBalancerClass) {
+			continue
+		}
+		if !h.serviceUtils.IsServiceSupported(svc) {
+			continue
+		}
+		h.logger.V(1).Info("enqueue service for serviceClassParams event",
+			"loadBalancerClass", svcLoadBalancerClass,
+			"service", k8s.NamespacedName(svc))
+		queue.Add(reconcile.Request{NamespacedName: k8s.NamespacedName(svc)})
+	}
+}
@@ -10,27 +10,35 @@ import (
 
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/record"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/v3/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/controllers/service/eventhandlers"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/config"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/deploy"
 	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/deploy/shield"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/deploy/tracking"
 	ctrlerrors "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/error"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/k8s"
 	lbcmetrics "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/metrics/lbc"
 	metricsutil "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/metrics/util"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/ec2"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/networking"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/runtime"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/service"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/shard"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -40,25 +48,28 @@ const (
 	serviceTagPrefix        = "service.k8s.aws"
 	serviceAnnotationPrefix = "service.beta.kubernetes.io"
 	controllerName          = "service"
+	serviceClassParamsKind  = "ServiceClassParams"
 )
 
 func NewServiceReconciler(cloud services.Cloud, k8sClient client.Client, eventRecorder record.EventRecorder,
 	finalizerManager k8s.FinalizerManager, networkingManager networking.NetworkingManager, networkingSGManager networking.SecurityGroupManager,
 	networkingSGReconciler networking.SecurityGroupReconciler, subnetsResolver networking.SubnetsResolver,
-	vpcInfoProvider networking.VPCInfoProvider, elbv2TaggingManager elbv2deploy.TaggingManager, controllerConfig config.ControllerConfig,
+	vpcInfoProvider networking.VPCInfoProvider, elbv2TaggingManager elbv2deploy.TaggingManager, shieldProtectionManager shield.ProtectionManager, controllerConfig config.ControllerConfig,
 	backendSGProvider networking.BackendSGProvider, sgResolver networking.SecurityGroupResolver, logger logr.Logger, metricsCollector lbcmetrics.MetricCollector, reconcileCounters *metricsutil.ReconcileCounters,
-	targetGroupCollector awsmetrics.TargetGroupCollector) *serviceReconciler {
+	targetGroupCollector awsmetrics.TargetGroupCollector, shardManager shard.Manager) *serviceReconciler {
 
 	annotationParser := annotations.NewSuffixAnnotationParser(serviceAnnotationPrefix)
 	trackingProvider := tracking.NewDefaultProvider(serviceTagPrefix, controllerConfig.ClusterName)
 	serviceUtils := service.NewServiceUtils(annotationParser, shared_constants.ServiceFinalizer, controllerConfig.ServiceConfig.LoadBalancerClass, controllerConfig.FeatureGates)
 	enhancedBackendBuilder := service.NewDefaultEnhancedBackendBuilder(k8sClient, annotationParser, logger)
+	classParamsLoader := service.NewDefaultClassParamsLoader(k8sClient, controllerConfig.ServiceConfig.LoadBalancerClass)
 	modelBuilder := service.NewDefaultModelBuilder(annotationParser, subnetsResolver, vpcInfoProvider, cloud.VpcID(), trackingProvider,
 		elbv2TaggingManager, cloud.EC2(), controllerConfig.FeatureGates, controllerConfig.ClusterName, controllerConfig.DefaultTags, controllerConfig.ExternalManagedTags,
 		controllerConfig.DefaultSSLPolicy, controllerConfig.DefaultTargetType, controllerConfig.DefaultLoadBalancerScheme, controllerConfig.FeatureGates.Enabled(config.EnableIPTargetType), serviceUtils,
-		backendSGProvider, sgResolver, controllerConfig.EnableBackendSecurityGroup, controllerConfig.EnableManageBackendSecurityGroupRules, controllerConfig.DisableRestrictedSGRules, logger, metricsCollector, controllerConfig.FeatureGates.Enabled(config.EnableTCPUDPListenerType), enhancedBackendBuilder)
+		backendSGProvider, sgResolver, controllerConfig.EnableBackendSecurityGroup, controllerConfig.EnableManageBackendSecurityGroupRules, controllerConfig.DisableRestrictedSGRules, controllerConfig.RestrictSGEgress, logger, metricsCollector, controllerConfig.FeatureGates.Enabled(config.EnableTCPUDPListenerType), enhancedBackendBuilder,
+		classParamsLoader)
 	stackMarshaller := deploy.NewDefaultStackMarshaller()
-	stackDeployer := deploy.NewDefaultStackDeployer(cloud, k8sClient, networkingManager, networkingSGManager, networkingSGReconciler, elbv2TaggingManager, controllerConfig, serviceTagPrefix, logger, metricsCollector, controllerName, controllerConfig.FeatureGates.Enabled(config.EnhancedDefaultBehavior), targetGroupCollector, false)
+	stackDeployer := deploy.NewDefaultStackDeployer(cloud, k8sClient, networkingManager, networkingSGManager, networkingSGReconciler, elbv2TaggingManager, shieldProtectionManager, controllerConfig, serviceTagPrefix, logger, metricsCollector, controllerName, controllerConfig.FeatureGates.Enabled(config.EnhancedDefaultBehavior), targetGroupCollector, false)
 	return &serviceReconciler{
 		k8sClient:         k8sClient,
 		eventRecorder:     eventRecorder,
@@ -67,6 +78,7 @@ func NewServiceReconciler(cloud services.Cloud, k8sClient client.Client, eventRe
 		loadBalancerClass: controllerConfig.ServiceConfig.LoadBalancerClass,
 		serviceUtils:      serviceUtils,
 		backendSGProvider: backendSGProvider,
+		shardManager:      shardManager,
 
 		modelBuilder:    modelBuilder,
 		stackMarshaller: stackMarshaller,
@@ -87,6 +99,7 @@ type serviceReconciler struct {
 	loadBalancerClass string
 	serviceUtils      service.ServiceUtils
 	backendSGProvider networking.BackendSGProvider
+	shardManager      shard.Manager
 
 	modelBuilder      service.ModelBuilder
 	stackMarshaller   deploy.StackMarshaller
@@ -101,8 +114,14 @@ type serviceReconciler struct {
 // +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups="",resources=services/status,verbs=update;patch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=serviceclassparams,verbs=get;list;watch
 
 func (r *serviceReconciler) Reconcile(ctx context.Context, req reconcile.Request) (ctrl.Result, error) {
+	release, owned, err := r.shardManager.Acquire(ctx, controllerName, req.NamespacedName)
+	if err != nil || !owned {
+		return runtime.HandleReconcileError(err, r.logger)
+	}
+	defer release()
 	r.reconcileCounters.IncrementService(req.NamespacedName)
 	return runtime.HandleReconcileError(r.reconcile(ctx, req), r.logger)
 }
@@ -209,8 +228,18 @@ func (r *serviceReconciler) reconcileLoadBalancerResources(ctx context.Context,
 		}
 	}
 
+	var endpointServiceName string
+	var resESs []*ec2model.VPCEndpointService
+	stack.ListResources(&resESs)
+	if len(resESs) != 0 {
+		endpointServiceName, err = resESs[0].ServiceName().Resolve(ctx)
+		if err != nil {
+			return ctrlerrors.NewErrorWithMetrics(controllerName, "endpoint_service_name_resolve_error", err, r.metricsCollector)
+		}
+	}
+
 	updateStatusFn := func() {
-		err = r.updateServiceStatus(ctx, normalizedLbDNS, svc)
+		err = r.updateServiceStatus(ctx, normalizedLbDNS, endpointServiceName, svc)
 	}
 	r.metricsCollector.ObserveControllerReconcileLatency(controllerName, "update_status", updateStatusFn)
 	if err != nil {
@@ -242,14 +271,14 @@ func (r *serviceReconciler) cleanupLoadBalancerResources(ctx context.Context, sv
 	return nil
 }
 
-func (r *serviceReconciler) updateServiceStatus(ctx context.Context, lbDNS string, svc *corev1.Service) error {
+func (r *serviceReconciler) updateServiceStatus(ctx context.Context, lbDNS string, endpointServiceName string, svc *corev1.Service) error {
+	svcOld := svc.DeepCopy()
+	needsUpdate := false
 	if len(svc.Status.LoadBalancer.Ingress) != 1 ||
 		svc.Status.LoadBalancer.Ingress[0].IP != "" ||
 		svc.Status.LoadBalancer.Ingress[0].Hostname != lbDNS ||
 		r.shouldUpdatePorts(svc) {
 
-		svcOld := svc.DeepCopy()
-
 		ports := r.buildPortsForStatus(svc)
 
 		svc.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{
@@ -258,6 +287,12 @@ func (r *serviceReconciler) updateServiceStatus(ctx context.Context, lbDNS strin
 				Ports:    ports,
 			},
 		}
+		needsUpdate = true
+	}
+	if updateVPCEndpointServiceCondition(svc, endpointServiceName) {
+		needsUpdate = true
+	}
+	if needsUpdate {
 		if err := r.k8sClient.Status().Patch(ctx, svc, client.MergeFrom(svcOld)); err != nil {
 			return errors.Wrapf(err, "failed to update service status: %v", k8s.NamespacedName(svc))
 		}
@@ -265,6 +300,21 @@ func (r *serviceReconciler) updateServiceStatus(ctx context.Context, lbDNS strin
 	return nil
 }
 
+// updateVPCEndpointServiceCondition reports the VPC endpoint service name as service condition, or removes the condition
+// when there is no VPC endpoint service. It returns whether the conditions changed.
+func updateVPCEndpointServiceCondition(svc *corev1.Service, endpointServiceName string) bool {
+	if endpointServiceName == "" {
+		return meta.RemoveStatusCondition(&svc.Status.Conditions, shared_constants.VPCEndpointServiceConditionType)
+	}
+	return meta.SetStatusCondition(&svc.Status.Conditions, metav1.Condition{
+		Type:               shared_constants.VPCEndpointServiceConditionType,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: svc.Generation,
+		Reason:             shared_constants.VPCEndpointServiceConditionReasonAvailable,
+		Message:            endpointServiceName,
+	})
+}
+
 // shouldUpdatePorts checks if we need to update the port information in the status
 func (r *serviceReconciler) shouldUpdatePorts(svc *corev1.Service) bool {
 	if len(svc.Status.LoadBalancer.Ingress) != 1 {
@@ -311,21 +361,55 @@ func (r *serviceReconciler) buildPortsForStatus(svc *corev1.Service) []corev1.Po
 func (r *serviceReconciler) cleanupServiceStatus(ctx context.Context, svc *corev1.Service) error {
 	svcOld := svc.DeepCopy()
 	svc.Status.LoadBalancer = corev1.LoadBalancerStatus{}
+	meta.RemoveStatusCondition(&svc.Status.Conditions, shared_constants.VPCEndpointServiceConditionType)
 	if err := r.k8sClient.Status().Patch(ctx, svc, client.MergeFrom(svcOld)); err != nil {
 		return errors.Wrapf(err, "failed to cleanup service status: %v", k8s.NamespacedName(svc))
 	}
 	return nil
 }
 
-func (r *serviceReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
+func (r *serviceReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, clientSet *kubernetes.Clientset) error {
 	svcEventHandler := eventhandlers.NewEnqueueRequestForServiceEvent(r.eventRecorder,
 		r.serviceUtils, r.logger.WithName("eventHandlers").WithName("service"))
 
-	return ctrl.NewControllerManagedBy(mgr).
+	builder := ctrl.NewControllerManagedBy(mgr).
 		Named(controllerName).
-		Watches(&corev1.Service{}, svcEventHandler).
+		Watches(&corev1.Service{}, svcEventHandler)
+
+	resList, err := clientSet.ServerResourcesForGroupVersion(elbv2api.GroupVersion.String())
+	if err != nil {
+		return err
+	}
+	// ServiceClassParams CRD is not installed when CRDs are not upgraded along with the controller.
+	if k8s.IsResourceKindAvailable(resList, serviceClassParamsKind) {
+		classParamsEventHandler := eventhandlers.NewEnqueueRequestsForServiceClassParamsEvent(r.k8sClient,
+			r.serviceUtils, r.loadBalancerClass, r.logger.WithName("eventHandlers").WithName("serviceClassParams"))
+		builder = builder.Watches(&elbv2api.ServiceClassParams{}, classParamsEventHandler)
+	}
+	if r.shardManager.Enabled() {
+		builder = builder.WatchesRawSource(shard.NewResyncSource(r.shardManager, r.listServiceRequests, r.shardManager.Owns,
+			r.logger.WithName("shardResync")))
+	}
+	return builder.
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: r.maxConcurrentReconciles,
+			NeedLeaderElection:      awssdk.Bool(!r.shardManager.Enabled()),
 		}).
 		Complete(r)
 }
+
+func (r *serviceReconciler) listServiceRequests(ctx context.Context) ([]reconcile.Request, error) {
+	svcList := &corev1.ServiceList{}
+	if err := r.k8sClient.List(ctx, svcList); err != nil {
+		return nil, err
+	}
+	var requests []reconcile.Request
+	for i := range svcList.Items {
+		svc := &svcList.Items[i]
+		if !r.serviceUtils.IsServicePendingFinalization(svc) && !r.serviceUtils.IsServiceSupported(svc) {
+			continue
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: k8s.NamespacedName(svc)})
+	}
+	return requests, nil
+}
@@ -64,6 +64,9 @@ type mockMetricsCollector struct{}
 
 func (m *mockMetricsCollector) ObservePodReadinessGateReady(_ string, _ string, _ time.Duration) {}
 func (m *mockMetricsCollector) ObserveQUICTargetMissingServerId(_ string, _ string)              {}
+func (m *mockMetricsCollector) ObserveTargetsInUncoveredZones(_ string, _ string, _ int)         {}
+func (m *mockMetricsCollector) ResetTargetsInUncoveredZones(_ string, _ string)                  {}
+func (m *mockMetricsCollector) ObservePodDrainDuration(_ string, _ string, _ time.Duration)      {}
 func (m *mockMetricsCollector) ObserveControllerReconcileError(_ string, _ string)               {}
 func (m *mockMetricsCollector) ObserveControllerReconcileLatency(_ string, _ string, fn func())  { fn() }
 func (m *mockMetricsCollector) ObserveWebhookValidationError(_ string, _ string)                 {}
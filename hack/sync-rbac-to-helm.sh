@@ -168,6 +168,18 @@ rules:
   - get
   - update
   - patch
+{{- if .Values.enableSharding }}
+- apiGroups:
+  - "coordination.k8s.io"
+  resources:
+  - leases
+  verbs:
+  - get
+  - list
+  - update
+  - patch
+  - delete
+{{- end }}
 ---
 apiVersion: rbac.authorization.k8s.io/v1
 kind: RoleBinding
@@ -29,6 +29,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/v3/apis/gateway/v1"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/controllers/gateway"
+	multiclustercontroller "sigs.k8s.io/aws-load-balancer-controller/v3/controllers/multicluster"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/services"
 	gateway_constants "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway/constants"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway/crddetect"
@@ -43,6 +44,7 @@ import (
 	"k8s.io/client-go/util/workqueue"
 
 	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/deploy/shield"
 
 	"github.com/go-logr/logr"
 	"github.com/spf13/pflag"
@@ -68,6 +70,7 @@ import (
 	metricsutil "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/metrics/util"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/networking"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/runtime"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/shard"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/targetgroupbinding"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/version"
 	agawebhook "sigs.k8s.io/aws-load-balancer-controller/v3/webhooks/aga"
@@ -107,6 +110,7 @@ type gatewayControllerConfig struct {
 	sgReconciler             networking.SecurityGroupReconciler
 	sgManager                networking.SecurityGroupManager
 	elbv2TaggingManager      elbv2deploy.TaggingManager
+	shieldProtectionManager  shield.ProtectionManager
 	subnetResolver           networking.SubnetsResolver
 	vpcInfoProvider          networking.VPCInfoProvider
 	backendSGProvider        networking.BackendSGProvider
@@ -185,6 +189,15 @@ func main() {
 
 	nlbGatewayEnabled := controllerCFG.FeatureGates.Enabled(config.NLBGatewayAPI)
 	albGatewayEnabled := controllerCFG.FeatureGates.Enabled(config.ALBGatewayAPI)
+	var shardManager shard.Manager = shard.NewNoopManager()
+	if controllerCFG.ShardConfig.EnableSharding {
+		shardManager, err = setupShardManager(mgr, controllerCFG)
+		if err != nil {
+			setupLog.Error(err, "unable to setup shard manager")
+			os.Exit(1)
+		}
+	}
+
 	podInfoRepo := k8s.NewDefaultPodInfoRepo(clientSet.CoreV1().RESTClient(), controllerCFG.RuntimeConfig.WatchNamespace, controllerCFG.ServerIDInjectionConfig.EnvironmentVariableName, ctrl.Log)
 	finalizerManager := k8s.NewDefaultFinalizerManager(mgr.GetClient(), ctrl.Log)
 	sgManager := networking.NewDefaultSecurityGroupManager(cloud.EC2(), ctrl.Log)
@@ -202,28 +215,33 @@ func main() {
 	podENIResolver := networking.NewDefaultPodENIInfoResolver(mgr.GetClient(), cloud.EC2(), nodeInfoProvider, cloud.VpcID(), ctrl.Log)
 	nodeENIResolver := networking.NewDefaultNodeENIInfoResolver(nodeInfoProvider, ctrl.Log)
 
-	networkingManager := networking.NewDefaultNetworkingManager(mgr.GetClient(), podENIResolver, nodeENIResolver, sgManager, sgReconciler, cloud.VpcID(), controllerCFG.ClusterName, controllerCFG.ServiceTargetENISGTags, ctrl.Log, controllerCFG.DisableRestrictedSGRules)
+	networkingManager := networking.NewDefaultNetworkingManager(mgr.GetClient(), podENIResolver, nodeENIResolver, sgManager, sgReconciler, cloud.VpcID(), controllerCFG.ClusterName, controllerCFG.ServiceTargetENISGTags, shardManager, ctrl.Log, controllerCFG.DisableRestrictedSGRules, controllerCFG.RestrictSGEgress)
 
 	tgArnMapper := shared_utils.NewTargetGroupNameToArnMapper(cloud.ELBV2())
 
-	tgbResManager := targetgroupbinding.NewDefaultResourceManager(mgr.GetClient(), cloud.ELBV2(),
+	tgbResManager := targetgroupbinding.NewDefaultResourceManager(mgr.GetClient(), cloud.ELBV2(), cloud.GlobalAccelerator(),
 		podInfoRepo, networkingManager, vpcInfoProvider, multiClusterManager, lbcMetricsCollector,
 		cloud.VpcID(), controllerCFG.FeatureGates.Enabled(config.EndpointsFailOpen), controllerCFG.EnableEndpointSlices,
 		mgr.GetEventRecorderFor("targetGroupBinding"), ctrl.Log, controllerCFG.MaxTargetsPerTargetGroup, controllerCFG.TargetGroupBindingRequeueDuration)
 	backendSGProvider := networking.NewBackendSGProvider(controllerCFG.ClusterName, controllerCFG.BackendSecurityGroup,
-		cloud.VpcID(), cloud.EC2(), mgr.GetClient(), controllerCFG.DefaultTags, nlbGatewayEnabled || albGatewayEnabled, ctrl.Log.WithName("backend-sg-provider"))
+		cloud.VpcID(), cloud.EC2(), mgr.GetClient(), controllerCFG.DefaultTags, nlbGatewayEnabled || albGatewayEnabled, shardManager, ctrl.Log.WithName("backend-sg-provider"))
+	if err := mgr.Add(backendSGProvider); err != nil {
+		setupLog.Error(err, "unable to add backend SG provider")
+		os.Exit(1)
+	}
 	sgResolver := networking.NewDefaultSecurityGroupResolver(cloud.EC2(), cloud.VpcID())
 	elbv2TaggingManager := elbv2deploy.NewDefaultTaggingManager(cloud.ELBV2(), cloud.VpcID(), controllerCFG.FeatureGates, cloud.RGT(), ctrl.Log)
+	shieldProtectionManager := shield.NewDefaultProtectionManager(cloud.Shield(), controllerCFG.ClusterName, ctrl.Log)
 	requiredLabelKey, requiredLabelValue := config.ParseRequiredSecretsLabel(controllerCFG.RequiredSecretsLabel)
 	secretsManager := k8s.NewSecretsManager(clientSet, nil, ctrl.Log.WithName("secrets-manager"), requiredLabelKey, requiredLabelValue)
 	ingGroupReconciler := ingress.NewGroupReconciler(cloud, mgr.GetClient(), mgr.GetEventRecorderFor("ingress"),
-		finalizerManager, sgManager, networkingManager, sgReconciler, subnetResolver, elbv2TaggingManager,
+		finalizerManager, sgManager, networkingManager, sgReconciler, subnetResolver, elbv2TaggingManager, shieldProtectionManager,
 		controllerCFG, backendSGProvider, sgResolver, secretsManager, ctrl.Log.WithName("controllers").WithName("ingress"), lbcMetricsCollector, reconcileCounters,
-		targetGroupCollector, tgArnMapper)
+		targetGroupCollector, tgArnMapper, shardManager)
 	svcReconciler := service.NewServiceReconciler(cloud, mgr.GetClient(), mgr.GetEventRecorderFor("service"),
-		finalizerManager, networkingManager, sgManager, sgReconciler, subnetResolver, vpcInfoProvider, elbv2TaggingManager,
+		finalizerManager, networkingManager, sgManager, sgReconciler, subnetResolver, vpcInfoProvider, elbv2TaggingManager, shieldProtectionManager,
 		controllerCFG, backendSGProvider, sgResolver, ctrl.Log.WithName("controllers").WithName("service"), lbcMetricsCollector, reconcileCounters,
-		targetGroupCollector)
+		targetGroupCollector, shardManager)
 
 	delayingQueue := workqueue.NewDelayingQueueWithConfig(workqueue.DelayingQueueConfig{
 		Name: "delayed-target-group-binding",
@@ -231,7 +249,7 @@ func main() {
 
 	deferredTGBQueue := elbv2controller.NewDeferredTargetGroupBindingReconciler(delayingQueue, controllerCFG.RuntimeConfig.SyncPeriod, mgr.GetClient(), ctrl.Log.WithName("deferredTGBQueue"))
 	tgbReconciler := elbv2controller.NewTargetGroupBindingReconciler(mgr.GetClient(), mgr.GetEventRecorderFor("targetGroupBinding"),
-		finalizerManager, tgbResManager, controllerCFG, deferredTGBQueue, ctrl.Log.WithName("controllers").WithName("targetGroupBinding"), lbcMetricsCollector, reconcileCounters, podInfoRepo.GetInformer())
+		finalizerManager, tgbResManager, multiClusterManager, controllerCFG, deferredTGBQueue, shardManager, ctrl.Log.WithName("controllers").WithName("targetGroupBinding"), lbcMetricsCollector, reconcileCounters, podInfoRepo.GetInformer())
 
 	ctx := ctrl.SetupSignalHandler()
 	if err = ingGroupReconciler.SetupWithManager(ctx, mgr, clientSet); err != nil {
@@ -241,7 +259,7 @@ func main() {
 
 	// Setup service reconciler only if AllowServiceType is set to true.
 	if controllerCFG.FeatureGates.Enabled(config.EnableServiceController) {
-		if err = svcReconciler.SetupWithManager(ctx, mgr); err != nil {
+		if err = svcReconciler.SetupWithManager(ctx, mgr, clientSet); err != nil {
 			setupLog.Error(err, "Unable to create controller", "controller", "Service")
 			os.Exit(1)
 		}
@@ -262,6 +280,16 @@ func main() {
 		}
 	}
 
+	// Setup ServiceExport controller only if enabled
+	if controllerCFG.FeatureGates.Enabled(config.MultiClusterServiceExport) {
+		serviceExportReconciler := multiclustercontroller.NewServiceExportReconciler(mgr.GetClient(), cloud,
+			controllerCFG.ServiceExportAllowedImportingClusters, controllerCFG.ServiceMaxConcurrentReconciles, ctrl.Log.WithName("controllers").WithName("serviceExport"))
+		if err := serviceExportReconciler.SetupWithManager(ctx, mgr, clientSet); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "ServiceExport")
+			os.Exit(1)
+		}
+	}
+
 	// Initialize common gateway configuration
 	if controllerCFG.FeatureGates.Enabled(config.NLBGatewayAPI) || controllerCFG.FeatureGates.Enabled(config.ALBGatewayAPI) {
 
@@ -297,6 +325,7 @@ func main() {
 			sgReconciler:             sgReconciler,
 			sgManager:                sgManager,
 			elbv2TaggingManager:      elbv2TaggingManager,
+			shieldProtectionManager:  shieldProtectionManager,
 			subnetResolver:           subnetResolver,
 			vpcInfoProvider:          vpcInfoProvider,
 			backendSGProvider:        backendSGProvider,
@@ -450,7 +479,7 @@ func main() {
 	}
 
 	podReadinessGateInjector := pod_readiness.NewPodReadinessGate(controllerCFG.PodWebhookConfig,
-		mgr.GetClient(), ctrl.Log.WithName("pod-readiness-gate-injector"))
+		mgr.GetClient(), targetgroupbinding.NewCachedDeregistrationDelayResolver(cloud.ELBV2(), ctrl.Log), ctrl.Log.WithName("pod-readiness-gate-injector"))
 
 	quicServerIDInjector := quic.NewQUICServerIDInjector(controllerCFG.ServerIDInjectionConfig, mgr.GetClient(), mgr.GetAPIReader(), ctrl.Log.WithName("quic-server-id-injector"))
 
@@ -518,6 +547,25 @@ func main() {
 	}
 }
 
+// setupShardManager sets up the lease based shard manager that distributes TargetGroupBindings, Services and Ingress groups
+// across controller replicas, the elected leader remains the global shard.
+func setupShardManager(mgr ctrl.Manager, controllerCFG config.ControllerConfig) (shard.Manager, error) {
+	leaseNamespace, err := shard.ResolveLeaseNamespace(controllerCFG.RuntimeConfig.LeaderElectionNamespace)
+	if err != nil {
+		return nil, err
+	}
+	identity, err := shard.ResolveIdentity()
+	if err != nil {
+		return nil, err
+	}
+	shardManager := shard.NewDefaultManager(mgr.GetClient(), mgr.GetAPIReader(), controllerCFG.ShardConfig,
+		controllerCFG.RuntimeConfig.LeaderElectionID, leaseNamespace, identity, mgr.Elected(), ctrl.Log.WithName("shard-manager"))
+	if err := mgr.Add(shardManager); err != nil {
+		return nil, err
+	}
+	return shardManager, nil
+}
+
 // setupGatewayController handles the setup of both NLB and ALB gateway controllers
 func setupGatewayController(ctx context.Context, mgr ctrl.Manager, cfg *gatewayControllerConfig, controllerType string, clientSet *kubernetes.Clientset) error {
 	logger := ctrl.Log.WithName("controllers").WithName(controllerType)
@@ -538,6 +586,7 @@ func setupGatewayController(ctx context.Context, mgr ctrl.Manager, cfg *gatewayC
 			cfg.sgReconciler,
 			cfg.sgManager,
 			cfg.elbv2TaggingManager,
+			cfg.shieldProtectionManager,
 			cfg.subnetResolver,
 			cfg.vpcInfoProvider,
 			cfg.backendSGProvider,
@@ -563,6 +612,7 @@ func setupGatewayController(ctx context.Context, mgr ctrl.Manager, cfg *gatewayC
 			cfg.sgReconciler,
 			cfg.sgManager,
 			cfg.elbv2TaggingManager,
+			cfg.shieldProtectionManager,
 			cfg.subnetResolver,
 			cfg.vpcInfoProvider,
 			cfg.backendSGProvider,
@@ -3,6 +3,9 @@ package aga
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
+
 	awssdk "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
@@ -12,6 +15,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	agaapi "sigs.k8s.io/aws-load-balancer-controller/v3/apis/aga/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/annotations"
@@ -287,3 +291,175 @@ func createProtocolPortsInfo(tcpPorts, udpPorts []int32) []ProtocolPortInfo {
 
 	return protocolPortsInfo
 }
+
+// ResolveSelectorEndpoints resolves the label-selector endpoints of a GlobalAccelerator.
+// It returns a copy of the GlobalAccelerator in which every label-selector endpoint is replaced by endpoints that reference
+// the selected resources by name, so that they are loaded and built like any other endpoint, together with the selector
+// references that must be watched to pick up resources that start or stop matching.
+// Resources that are already referenced in the same endpoint group are not added again.
+func ResolveSelectorEndpoints(ctx context.Context, k8sClient client.Client, ga *agaapi.GlobalAccelerator, logger logr.Logger) (*agaapi.GlobalAccelerator, []SelectorReference, error) {
+	if !hasSelectorEndpoints(ga) {
+		return ga, nil, nil
+	}
+
+	resolvedGA := ga.DeepCopy()
+	selectorRefsByKey := make(map[string]SelectorReference)
+	for listenerIdx := range *resolvedGA.Spec.Listeners {
+		listener := &(*resolvedGA.Spec.Listeners)[listenerIdx]
+		if listener.EndpointGroups == nil {
+			continue
+		}
+		for groupIdx := range *listener.EndpointGroups {
+			endpointGroup := &(*listener.EndpointGroups)[groupIdx]
+			if endpointGroup.Endpoints == nil {
+				continue
+			}
+
+			seen := make(map[string]bool)
+			for _, endpoint := range *endpointGroup.Endpoints {
+				if endpoint.Selector == nil {
+					seen[generateEndpointKey(endpoint, ga.Namespace)] = true
+				}
+			}
+
+			var resolvedEndpoints []agaapi.GlobalAcceleratorEndpoint
+			for _, endpoint := range *endpointGroup.Endpoints {
+				if endpoint.Selector == nil {
+					resolvedEndpoints = append(resolvedEndpoints, endpoint)
+					continue
+				}
+				selectorRefs, err := buildSelectorReferences(endpoint, ga.Namespace)
+				if err != nil {
+					return nil, nil, err
+				}
+				for _, selectorRef := range selectorRefs {
+					selectorRefsByKey[selectorRef.Key()] = selectorRef
+					selectedObjs, err := listSelectedResources(ctx, k8sClient, selectorRef)
+					if err != nil {
+						return nil, nil, err
+					}
+					for _, obj := range selectedObjs {
+						selectedEndpoint := buildSelectedEndpoint(endpoint, obj, logger)
+						key := generateEndpointKey(selectedEndpoint, ga.Namespace)
+						if seen[key] {
+							continue
+						}
+						seen[key] = true
+						resolvedEndpoints = append(resolvedEndpoints, selectedEndpoint)
+					}
+				}
+			}
+			endpointGroup.Endpoints = &resolvedEndpoints
+		}
+	}
+
+	selectorRefs := make([]SelectorReference, 0, len(selectorRefsByKey))
+	for _, selectorRef := range selectorRefsByKey {
+		selectorRefs = append(selectorRefs, selectorRef)
+	}
+	sort.Slice(selectorRefs, func(i, j int) bool {
+		return selectorRefs[i].Key() < selectorRefs[j].Key()
+	})
+	return resolvedGA, selectorRefs, nil
+}
+
+// hasSelectorEndpoints checks whether any endpoint of the GlobalAccelerator is a label-selector endpoint
+func hasSelectorEndpoints(ga *agaapi.GlobalAccelerator) bool {
+	for _, endpoint := range GetAllDesiredEndpointsFromGA(ga) {
+		if endpoint.Endpoint.Selector != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// buildSelectorReferences builds the selector references of a label-selector endpoint, one per namespace
+func buildSelectorReferences(endpoint agaapi.GlobalAcceleratorEndpoint, gaNamespace string) ([]SelectorReference, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&endpoint.Selector.LabelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid label selector for %s endpoint: %w", endpoint.Type, err)
+	}
+	namespaces := endpoint.Selector.Namespaces
+	if len(namespaces) == 0 {
+		namespaces = []string{gaNamespace}
+	}
+	selectorRefs := make([]SelectorReference, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		selectorRefs = append(selectorRefs, SelectorReference{
+			Type:      ResourceType(endpoint.Type),
+			Namespace: namespace,
+			Selector:  selector,
+		})
+	}
+	return selectorRefs, nil
+}
+
+// listSelectedResources lists the resources selected by a selector reference, sorted by name
+func listSelectedResources(ctx context.Context, k8sClient client.Client, selectorRef SelectorReference) ([]client.Object, error) {
+	listOpts := []client.ListOption{
+		client.InNamespace(selectorRef.Namespace),
+		client.MatchingLabelsSelector{Selector: selectorRef.Selector},
+	}
+
+	var objs []client.Object
+	switch selectorRef.Type {
+	case ServiceResourceType:
+		svcList := &corev1.ServiceList{}
+		if err := k8sClient.List(ctx, svcList, listOpts...); err != nil {
+			return nil, fmt.Errorf("failed to list services in namespace %s: %w", selectorRef.Namespace, err)
+		}
+		for i := range svcList.Items {
+			objs = append(objs, &svcList.Items[i])
+		}
+	case IngressResourceType:
+		ingList := &networkingv1.IngressList{}
+		if err := k8sClient.List(ctx, ingList, listOpts...); err != nil {
+			return nil, fmt.Errorf("failed to list ingresses in namespace %s: %w", selectorRef.Namespace, err)
+		}
+		for i := range ingList.Items {
+			objs = append(objs, &ingList.Items[i])
+		}
+	case GatewayResourceType:
+		gwList := &gwv1.GatewayList{}
+		if err := k8sClient.List(ctx, gwList, listOpts...); err != nil {
+			return nil, fmt.Errorf("failed to list gateways in namespace %s: %w", selectorRef.Namespace, err)
+		}
+		for i := range gwList.Items {
+			objs = append(objs, &gwList.Items[i])
+		}
+	default:
+		return nil, fmt.Errorf("label selector is not supported for endpoint type %s", selectorRef.Type)
+	}
+
+	sort.Slice(objs, func(i, j int) bool {
+		return objs[i].GetName() < objs[j].GetName()
+	})
+	return objs, nil
+}
+
+// buildSelectedEndpoint builds an endpoint that references a resource selected by a label-selector endpoint by name
+// The weight is taken from the EndpointWeightAnnotation on the resource if valid, otherwise from the label-selector endpoint
+func buildSelectedEndpoint(selectorEndpoint agaapi.GlobalAcceleratorEndpoint, obj client.Object, logger logr.Logger) agaapi.GlobalAcceleratorEndpoint {
+	name := obj.GetName()
+	namespace := obj.GetNamespace()
+	selectedEndpoint := agaapi.GlobalAcceleratorEndpoint{
+		Type:                        selectorEndpoint.Type,
+		Name:                        &name,
+		Namespace:                   &namespace,
+		Weight:                      selectorEndpoint.Weight,
+		ClientIPPreservationEnabled: selectorEndpoint.ClientIPPreservationEnabled,
+	}
+
+	if rawWeight, exists := obj.GetAnnotations()[EndpointWeightAnnotation]; exists {
+		weight, err := strconv.ParseInt(rawWeight, 10, 32)
+		if err != nil || weight < 0 || weight > 255 {
+			logger.Info("Ignoring invalid endpoint weight annotation, weight must be an integer between 0 and 255",
+				"resource", k8s.NamespacedName(obj),
+				"annotation", EndpointWeightAnnotation,
+				"value", rawWeight)
+		} else {
+			selectedEndpoint.Weight = awssdk.Int32(int32(weight))
+		}
+	}
+	return selectedEndpoint
+}
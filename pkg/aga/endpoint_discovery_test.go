@@ -6,6 +6,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	mock_client "sigs.k8s.io/aws-load-balancer-controller/v3/mocks/controller-runtime/client"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/testutils"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -14,6 +15,7 @@ import (
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	agaapi "sigs.k8s.io/aws-load-balancer-controller/v3/apis/aga/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
@@ -1042,3 +1044,158 @@ func TestFetchLoadBalancerProtocolPortInfo(t *testing.T) {
 		})
 	}
 }
+
+func TestResolveSelectorEndpoints(t *testing.T) {
+	newService := func(namespace, name string, labels, annotations map[string]string) *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   namespace,
+				Name:        name,
+				Labels:      labels,
+				Annotations: annotations,
+			},
+		}
+	}
+	newGA := func(endpoints ...agaapi.GlobalAcceleratorEndpoint) *agaapi.GlobalAccelerator {
+		return &agaapi.GlobalAccelerator{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-ga", Namespace: "default"},
+			Spec: agaapi.GlobalAcceleratorSpec{
+				Listeners: &[]agaapi.GlobalAcceleratorListener{{
+					EndpointGroups: &[]agaapi.GlobalAcceleratorEndpointGroup{{
+						Endpoints: &endpoints,
+					}},
+				}},
+			},
+		}
+	}
+	webSelector := metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}
+
+	tests := []struct {
+		name              string
+		objects           []client.Object
+		ga                *agaapi.GlobalAccelerator
+		wantEndpoints     []agaapi.GlobalAcceleratorEndpoint
+		wantSelectorCount int
+		wantErr           string
+	}{
+		{
+			name:    "no selector endpoints - GA returned unchanged",
+			objects: []client.Object{newService("default", "svc-a", map[string]string{"app": "web"}, nil)},
+			ga: newGA(agaapi.GlobalAcceleratorEndpoint{
+				Type: agaapi.GlobalAcceleratorEndpointTypeService,
+				Name: awssdk.String("svc-a"),
+			}),
+			wantEndpoints: []agaapi.GlobalAcceleratorEndpoint{
+				{Type: agaapi.GlobalAcceleratorEndpointTypeService, Name: awssdk.String("svc-a")},
+			},
+		},
+		{
+			name: "selector in GA namespace - matching services sorted by name with annotation weight",
+			objects: []client.Object{
+				newService("default", "svc-b", map[string]string{"app": "web"}, map[string]string{EndpointWeightAnnotation: "200"}),
+				newService("default", "svc-a", map[string]string{"app": "web"}, nil),
+				newService("default", "svc-c", map[string]string{"app": "api"}, nil),
+				newService("other", "svc-d", map[string]string{"app": "web"}, nil),
+			},
+			ga: newGA(agaapi.GlobalAcceleratorEndpoint{
+				Type:     agaapi.GlobalAcceleratorEndpointTypeService,
+				Selector: &agaapi.GlobalAcceleratorEndpointSelector{LabelSelector: webSelector},
+				Weight:   awssdk.Int32(50),
+			}),
+			wantEndpoints: []agaapi.GlobalAcceleratorEndpoint{
+				{Type: agaapi.GlobalAcceleratorEndpointTypeService, Name: awssdk.String("svc-a"), Namespace: awssdk.String("default"), Weight: awssdk.Int32(50)},
+				{Type: agaapi.GlobalAcceleratorEndpointTypeService, Name: awssdk.String("svc-b"), Namespace: awssdk.String("default"), Weight: awssdk.Int32(200)},
+			},
+			wantSelectorCount: 1,
+		},
+		{
+			name: "selector across allowed namespaces - invalid weight annotation ignored",
+			objects: []client.Object{
+				newService("team-a", "svc-a", map[string]string{"app": "web"}, map[string]string{EndpointWeightAnnotation: "300"}),
+				newService("team-b", "svc-b", map[string]string{"app": "web"}, map[string]string{EndpointWeightAnnotation: "abc"}),
+				newService("team-c", "svc-c", map[string]string{"app": "web"}, nil),
+			},
+			ga: newGA(agaapi.GlobalAcceleratorEndpoint{
+				Type: agaapi.GlobalAcceleratorEndpointTypeService,
+				Selector: &agaapi.GlobalAcceleratorEndpointSelector{
+					LabelSelector: webSelector,
+					Namespaces:    []string{"team-a", "team-b"},
+				},
+			}),
+			wantEndpoints: []agaapi.GlobalAcceleratorEndpoint{
+				{Type: agaapi.GlobalAcceleratorEndpointTypeService, Name: awssdk.String("svc-a"), Namespace: awssdk.String("team-a")},
+				{Type: agaapi.GlobalAcceleratorEndpointTypeService, Name: awssdk.String("svc-b"), Namespace: awssdk.String("team-b")},
+			},
+			wantSelectorCount: 2,
+		},
+		{
+			name: "selected resource already referenced by name - not duplicated",
+			objects: []client.Object{
+				newService("default", "svc-a", map[string]string{"app": "web"}, nil),
+				newService("default", "svc-b", map[string]string{"app": "web"}, nil),
+			},
+			ga: newGA(
+				agaapi.GlobalAcceleratorEndpoint{
+					Type:   agaapi.GlobalAcceleratorEndpointTypeService,
+					Name:   awssdk.String("svc-a"),
+					Weight: awssdk.Int32(10),
+				},
+				agaapi.GlobalAcceleratorEndpoint{
+					Type:     agaapi.GlobalAcceleratorEndpointTypeService,
+					Selector: &agaapi.GlobalAcceleratorEndpointSelector{LabelSelector: webSelector},
+				},
+			),
+			wantEndpoints: []agaapi.GlobalAcceleratorEndpoint{
+				{Type: agaapi.GlobalAcceleratorEndpointTypeService, Name: awssdk.String("svc-a"), Weight: awssdk.Int32(10)},
+				{Type: agaapi.GlobalAcceleratorEndpointTypeService, Name: awssdk.String("svc-b"), Namespace: awssdk.String("default")},
+			},
+			wantSelectorCount: 1,
+		},
+		{
+			name: "selector on ingresses",
+			objects: []client.Object{
+				&networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ing-a", Labels: map[string]string{"app": "web"}}},
+			},
+			ga: newGA(agaapi.GlobalAcceleratorEndpoint{
+				Type:     agaapi.GlobalAcceleratorEndpointTypeIngress,
+				Selector: &agaapi.GlobalAcceleratorEndpointSelector{LabelSelector: webSelector},
+			}),
+			wantEndpoints: []agaapi.GlobalAcceleratorEndpoint{
+				{Type: agaapi.GlobalAcceleratorEndpointTypeIngress, Name: awssdk.String("ing-a"), Namespace: awssdk.String("default")},
+			},
+			wantSelectorCount: 1,
+		},
+		{
+			name: "invalid label selector",
+			ga: newGA(agaapi.GlobalAcceleratorEndpoint{
+				Type: agaapi.GlobalAcceleratorEndpointTypeService,
+				Selector: &agaapi.GlobalAcceleratorEndpointSelector{
+					LabelSelector: metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: "app", Operator: metav1.LabelSelectorOpIn},
+					}},
+				},
+			}),
+			wantErr: "invalid label selector",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sClient := testutils.GenerateTestClient()
+			for _, obj := range tt.objects {
+				assert.NoError(t, k8sClient.Create(context.Background(), obj))
+			}
+			original := tt.ga.DeepCopy()
+
+			resolvedGA, selectorRefs, err := ResolveSelectorEndpoints(context.Background(), k8sClient, tt.ga, zap.New())
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantEndpoints, *(*(*resolvedGA.Spec.Listeners)[0].EndpointGroups)[0].Endpoints)
+			assert.Len(t, selectorRefs, tt.wantSelectorCount)
+			assert.Equal(t, original, tt.ga, "the original GA must not be modified")
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package aga
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	rgttypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/go-logr/logr"
+	agaapi "sigs.k8s.io/aws-load-balancer-controller/v3/apis/aga/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/shared_constants"
+)
+
+// FederationGroupTagKey is the tag on a load balancer that publishes it to the Federated endpoints of a federation group
+const FederationGroupTagKey = shared_constants.TagKeyAGAFederationGroup
+
+// federationKey identifies the load balancers published to a federation group in a region by the allowed clusters
+type federationKey struct {
+	region          string
+	group           string
+	allowedClusters string
+}
+
+// ResolveFederatedEndpoints resolves the Federated endpoints of a GlobalAccelerator.
+// It returns a copy of the GlobalAccelerator in which every Federated endpoint is replaced by EndpointID endpoints for the
+// load balancers tagged with its federation group in the region of the endpoint group and published by its allowed clusters,
+// and whether the GlobalAccelerator has any Federated endpoints. Load balancers that are already referenced by ID in the same endpoint group are not added again.
+func ResolveFederatedEndpoints(ctx context.Context, rgt services.RGT, ga *agaapi.GlobalAccelerator, clusterRegion string, logger logr.Logger) (*agaapi.GlobalAccelerator, bool, error) {
+	if !hasFederatedEndpoints(ga) {
+		return ga, false, nil
+	}
+
+	resolvedGA := ga.DeepCopy()
+	publishedLBsCache := make(map[federationKey][]string)
+	for listenerIdx := range *resolvedGA.Spec.Listeners {
+		listener := &(*resolvedGA.Spec.Listeners)[listenerIdx]
+		if listener.EndpointGroups == nil {
+			continue
+		}
+		for groupIdx := range *listener.EndpointGroups {
+			endpointGroup := &(*listener.EndpointGroups)[groupIdx]
+			if endpointGroup.Endpoints == nil {
+				continue
+			}
+			region := clusterRegion
+			if endpointGroup.Region != nil && awssdk.ToString(endpointGroup.Region) != "" {
+				region = awssdk.ToString(endpointGroup.Region)
+			}
+
+			seen := make(map[string]bool)
+			for _, endpoint := range *endpointGroup.Endpoints {
+				if endpoint.Type == agaapi.GlobalAcceleratorEndpointTypeEndpointID {
+					seen[awssdk.ToString(endpoint.EndpointID)] = true
+				}
+			}
+
+			var resolvedEndpoints []agaapi.GlobalAcceleratorEndpoint
+			for _, endpoint := range *endpointGroup.Endpoints {
+				if endpoint.Type != agaapi.GlobalAcceleratorEndpointTypeFederated {
+					resolvedEndpoints = append(resolvedEndpoints, endpoint)
+					continue
+				}
+				key := federationKey{
+					region:          region,
+					group:           awssdk.ToString(endpoint.FederationGroup),
+					allowedClusters: buildAllowedClustersKey(endpoint.AllowedClusters),
+				}
+				lbARNs, cached := publishedLBsCache[key]
+				if !cached {
+					var err error
+					lbARNs, err = listPublishedLoadBalancers(ctx, rgt, key)
+					if err != nil {
+						return nil, false, err
+					}
+					publishedLBsCache[key] = lbARNs
+					logger.V(1).Info("Resolved federated endpoints",
+						"region", key.region,
+						"federationGroup", key.group,
+						"allowedClusters", endpoint.AllowedClusters,
+						"loadBalancers", lbARNs)
+				}
+				for _, lbARN := range lbARNs {
+					if seen[lbARN] {
+						continue
+					}
+					seen[lbARN] = true
+					resolvedEndpoints = append(resolvedEndpoints, agaapi.GlobalAcceleratorEndpoint{
+						Type:                        agaapi.GlobalAcceleratorEndpointTypeEndpointID,
+						EndpointID:                  awssdk.String(lbARN),
+						Weight:                      endpoint.Weight,
+						ClientIPPreservationEnabled: endpoint.ClientIPPreservationEnabled,
+					})
+				}
+			}
+			endpointGroup.Endpoints = &resolvedEndpoints
+		}
+	}
+	return resolvedGA, true, nil
+}
+
+// hasFederatedEndpoints checks whether any endpoint of the GlobalAccelerator is a Federated endpoint
+func hasFederatedEndpoints(ga *agaapi.GlobalAccelerator) bool {
+	for _, endpoint := range GetAllDesiredEndpointsFromGA(ga) {
+		if endpoint.Type == agaapi.GlobalAcceleratorEndpointTypeFederated {
+			return true
+		}
+	}
+	return false
+}
+
+// buildAllowedClustersKey builds a stable key for the allowed clusters of a Federated endpoint, empty if any cluster is allowed
+func buildAllowedClustersKey(allowedClusters []string) string {
+	clusters := append([]string(nil), allowedClusters...)
+	sort.Strings(clusters)
+	return strings.Join(clusters, ",")
+}
+
+// listPublishedLoadBalancers lists the ARNs of the load balancers published to a federation group in a region, sorted by ARN.
+// When allowed clusters are set, only the load balancers whose cluster tag matches one of them are listed.
+func listPublishedLoadBalancers(ctx context.Context, rgt services.RGT, key federationKey) ([]string, error) {
+	tagFilters := []rgttypes.TagFilter{
+		{
+			Key:    awssdk.String(FederationGroupTagKey),
+			Values: []string{key.group},
+		},
+	}
+	if key.allowedClusters != "" {
+		tagFilters = append(tagFilters, rgttypes.TagFilter{
+			Key:    awssdk.String(shared_constants.TagKeyK8sCluster),
+			Values: strings.Split(key.allowedClusters, ","),
+		})
+	}
+	req := &resourcegroupstaggingapi.GetResourcesInput{
+		TagFilters:          tagFilters,
+		ResourceTypeFilters: []string{services.ResourceTypeELBLoadBalancer},
+	}
+	resources, err := rgt.GetResourcesInRegionAsList(ctx, key.region, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list load balancers of federation group %s in region %s: %w", key.group, key.region, err)
+	}
+	lbARNs := make([]string, 0, len(resources))
+	for _, resource := range resources {
+		lbARNs = append(lbARNs, awssdk.ToString(resource.ResourceARN))
+	}
+	sort.Strings(lbARNs)
+	return lbARNs, nil
+}
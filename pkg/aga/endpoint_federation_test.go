@@ -0,0 +1,204 @@
+package aga
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	rgttypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	agaapi "sigs.k8s.io/aws-load-balancer-controller/v3/apis/aga/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/services"
+)
+
+func TestResolveFederatedEndpoints(t *testing.T) {
+	lbARN1 := "arn:aws:elasticloadbalancing:eu-west-1:123456789012:loadbalancer/net/lb-1/1111111111111111"
+	lbARN2 := "arn:aws:elasticloadbalancing:eu-west-1:210987654321:loadbalancer/net/lb-2/2222222222222222"
+	localLBARN := "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/net/lb-3/3333333333333333"
+
+	type getResourcesCall struct {
+		region   string
+		group    string
+		clusters []string
+		arns     []string
+		err      error
+	}
+
+	newGA := func(groups ...agaapi.GlobalAcceleratorEndpointGroup) *agaapi.GlobalAccelerator {
+		return &agaapi.GlobalAccelerator{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-ga", Namespace: "default"},
+			Spec: agaapi.GlobalAcceleratorSpec{
+				Listeners: &[]agaapi.GlobalAcceleratorListener{{
+					EndpointGroups: &groups,
+				}},
+			},
+		}
+	}
+	federated := func(group string, weight int32) agaapi.GlobalAcceleratorEndpoint {
+		return agaapi.GlobalAcceleratorEndpoint{
+			Type:            agaapi.GlobalAcceleratorEndpointTypeFederated,
+			FederationGroup: awssdk.String(group),
+			Weight:          awssdk.Int32(weight),
+		}
+	}
+	federatedForClusters := func(group string, weight int32, clusters ...string) agaapi.GlobalAcceleratorEndpoint {
+		endpoint := federated(group, weight)
+		endpoint.AllowedClusters = clusters
+		return endpoint
+	}
+	endpointID := func(arn string, weight int32) agaapi.GlobalAcceleratorEndpoint {
+		return agaapi.GlobalAcceleratorEndpoint{
+			Type:       agaapi.GlobalAcceleratorEndpointTypeEndpointID,
+			EndpointID: awssdk.String(arn),
+			Weight:     awssdk.Int32(weight),
+		}
+	}
+
+	tests := []struct {
+		name              string
+		ga                *agaapi.GlobalAccelerator
+		getResourcesCalls []getResourcesCall
+		wantGroups        [][]agaapi.GlobalAcceleratorEndpoint
+		wantFederated     bool
+		wantErr           string
+	}{
+		{
+			name: "no federated endpoints - GA returned unchanged",
+			ga: newGA(agaapi.GlobalAcceleratorEndpointGroup{
+				Endpoints: &[]agaapi.GlobalAcceleratorEndpoint{endpointID(lbARN1, 128)},
+			}),
+			wantGroups:    [][]agaapi.GlobalAcceleratorEndpoint{{endpointID(lbARN1, 128)}},
+			wantFederated: false,
+		},
+		{
+			name: "federated endpoints in remote and local regions",
+			ga: newGA(
+				agaapi.GlobalAcceleratorEndpointGroup{
+					Region:    awssdk.String("eu-west-1"),
+					Endpoints: &[]agaapi.GlobalAcceleratorEndpoint{federated("web", 100)},
+				},
+				agaapi.GlobalAcceleratorEndpointGroup{
+					Endpoints: &[]agaapi.GlobalAcceleratorEndpoint{federated("web", 50)},
+				},
+			),
+			getResourcesCalls: []getResourcesCall{
+				{region: "eu-west-1", group: "web", arns: []string{lbARN2, lbARN1}},
+				{region: "us-west-2", group: "web", arns: []string{localLBARN}},
+			},
+			wantGroups: [][]agaapi.GlobalAcceleratorEndpoint{
+				{endpointID(lbARN1, 100), endpointID(lbARN2, 100)},
+				{endpointID(localLBARN, 50)},
+			},
+			wantFederated: true,
+		},
+		{
+			name: "load balancer already referenced by ID - not duplicated",
+			ga: newGA(agaapi.GlobalAcceleratorEndpointGroup{
+				Region:    awssdk.String("eu-west-1"),
+				Endpoints: &[]agaapi.GlobalAcceleratorEndpoint{endpointID(lbARN1, 10), federated("web", 100)},
+			}),
+			getResourcesCalls: []getResourcesCall{
+				{region: "eu-west-1", group: "web", arns: []string{lbARN1, lbARN2}},
+			},
+			wantGroups: [][]agaapi.GlobalAcceleratorEndpoint{
+				{endpointID(lbARN1, 10), endpointID(lbARN2, 100)},
+			},
+			wantFederated: true,
+		},
+		{
+			name: "no published load balancers - empty endpoint group",
+			ga: newGA(agaapi.GlobalAcceleratorEndpointGroup{
+				Region:    awssdk.String("eu-west-1"),
+				Endpoints: &[]agaapi.GlobalAcceleratorEndpoint{federated("web", 100)},
+			}),
+			getResourcesCalls: []getResourcesCall{
+				{region: "eu-west-1", group: "web"},
+			},
+			wantGroups:    [][]agaapi.GlobalAcceleratorEndpoint{nil},
+			wantFederated: true,
+		},
+		{
+			name: "federated endpoints with allowed clusters - cluster tag filtered and listed once per cluster set",
+			ga: newGA(
+				agaapi.GlobalAcceleratorEndpointGroup{
+					Region:    awssdk.String("eu-west-1"),
+					Endpoints: &[]agaapi.GlobalAcceleratorEndpoint{federatedForClusters("web", 100, "cluster-b", "cluster-a")},
+				},
+				agaapi.GlobalAcceleratorEndpointGroup{
+					Region:    awssdk.String("eu-west-1"),
+					Endpoints: &[]agaapi.GlobalAcceleratorEndpoint{federatedForClusters("web", 50, "cluster-a", "cluster-b")},
+				},
+				agaapi.GlobalAcceleratorEndpointGroup{
+					Region:    awssdk.String("eu-west-1"),
+					Endpoints: &[]agaapi.GlobalAcceleratorEndpoint{federated("web", 10)},
+				},
+			),
+			getResourcesCalls: []getResourcesCall{
+				{region: "eu-west-1", group: "web", clusters: []string{"cluster-a", "cluster-b"}, arns: []string{lbARN1}},
+				{region: "eu-west-1", group: "web", arns: []string{lbARN1, lbARN2}},
+			},
+			wantGroups: [][]agaapi.GlobalAcceleratorEndpoint{
+				{endpointID(lbARN1, 100)},
+				{endpointID(lbARN1, 50)},
+				{endpointID(lbARN1, 10), endpointID(lbARN2, 10)},
+			},
+			wantFederated: true,
+		},
+		{
+			name: "tagging API failure",
+			ga: newGA(agaapi.GlobalAcceleratorEndpointGroup{
+				Region:    awssdk.String("eu-west-1"),
+				Endpoints: &[]agaapi.GlobalAcceleratorEndpoint{federated("web", 100)},
+			}),
+			getResourcesCalls: []getResourcesCall{
+				{region: "eu-west-1", group: "web", err: errors.New("access denied")},
+			},
+			wantErr: "failed to list load balancers of federation group web in region eu-west-1: access denied",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRGT := services.NewMockRGT(ctrl)
+			for _, call := range tt.getResourcesCalls {
+				var resources []rgttypes.ResourceTagMapping
+				for _, arn := range call.arns {
+					resources = append(resources, rgttypes.ResourceTagMapping{ResourceARN: awssdk.String(arn)})
+				}
+				tagFilters := []rgttypes.TagFilter{
+					{Key: awssdk.String(FederationGroupTagKey), Values: []string{call.group}},
+				}
+				if len(call.clusters) > 0 {
+					tagFilters = append(tagFilters, rgttypes.TagFilter{Key: awssdk.String("elbv2.k8s.aws/cluster"), Values: call.clusters})
+				}
+				mockRGT.EXPECT().GetResourcesInRegionAsList(gomock.Any(), call.region, &resourcegroupstaggingapi.GetResourcesInput{
+					TagFilters:          tagFilters,
+					ResourceTypeFilters: []string{services.ResourceTypeELBLoadBalancer},
+				}).Return(resources, call.err)
+			}
+			original := tt.ga.DeepCopy()
+
+			resolvedGA, hasFederated, err := ResolveFederatedEndpoints(context.Background(), mockRGT, tt.ga, "us-west-2", logr.Discard())
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantFederated, hasFederated)
+			groups := *(*resolvedGA.Spec.Listeners)[0].EndpointGroups
+			assert.Len(t, groups, len(tt.wantGroups))
+			for i, wantEndpoints := range tt.wantGroups {
+				assert.Equal(t, wantEndpoints, *groups[i].Endpoints)
+			}
+			assert.Equal(t, original, tt.ga, "the original GA must not be modified")
+		})
+	}
+}
@@ -26,6 +26,9 @@ type EndpointResourcesManager interface {
 	// MonitorEndpointResources updates the watches based on resources referenced by a GA
 	MonitorEndpointResources(ga *agaapi.GlobalAccelerator, endpoints []*LoadedEndpoint)
 
+	// MonitorSelectorResources updates the watches based on label-selector endpoints of a GA
+	MonitorSelectorResources(ga *agaapi.GlobalAccelerator, selectorRefs []SelectorReference)
+
 	// RemoveGA removes all watches for resources referenced by a GA being deleted
 	RemoveGA(gaKey ktypes.NamespacedName)
 
@@ -39,6 +42,7 @@ type defaultEndpointResourcesManager struct {
 	serviceWatches   map[ktypes.NamespacedName]*ResourceWatcher
 	ingressWatches   map[ktypes.NamespacedName]*ResourceWatcher
 	gatewayWatches   map[ktypes.NamespacedName]*ResourceWatcher
+	selectorWatches  map[string]*ResourceWatcher // SelectorReference key -> watch
 	serviceEventChan chan<- event.GenericEvent
 	ingressEventChan chan<- event.GenericEvent
 	gatewayEventChan chan<- event.GenericEvent
@@ -60,6 +64,7 @@ func NewEndpointResourcesManager(
 		serviceWatches:   make(map[ktypes.NamespacedName]*ResourceWatcher),
 		ingressWatches:   make(map[ktypes.NamespacedName]*ResourceWatcher),
 		gatewayWatches:   make(map[ktypes.NamespacedName]*ResourceWatcher),
+		selectorWatches:  make(map[string]*ResourceWatcher),
 		serviceEventChan: serviceEventChan,
 		ingressEventChan: ingressEventChan,
 		gatewayEventChan: gatewayEventChan,
@@ -136,6 +141,42 @@ func (m *defaultEndpointResourcesManager) MonitorEndpointResources(ga *agaapi.Gl
 	m.cleanupWatches(m.gatewayWatches, gatewayRefs, gaID, string(GatewayResourceType))
 }
 
+// MonitorSelectorResources updates the watches based on label-selector endpoints of a GA
+// Resources matching a selector are watched so that a GA is reconciled when resources start or stop matching
+func (m *defaultEndpointResourcesManager) MonitorSelectorResources(ga *agaapi.GlobalAccelerator, selectorRefs []SelectorReference) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	gaID := k8s.NamespacedName(ga).String()
+
+	currentRefs := sets.NewString()
+	for _, selectorRef := range selectorRefs {
+		key := selectorRef.Key()
+		currentRefs.Insert(key)
+
+		// Start watching resources matching this selector if not already watched
+		if _, exists := m.selectorWatches[key]; !exists {
+			m.logger.V(1).Info("Starting watch for label selector", "type", selectorRef.Type, "selector", key)
+			m.selectorWatches[key] = m.newSelectorResourceWatcher(selectorRef)
+		}
+		m.selectorWatches[key].AddConsumer(gaID)
+	}
+
+	// Perform cleanup for selectors no longer used by this GA
+	for key, watch := range m.selectorWatches {
+		if !currentRefs.Has(key) && watch.HasConsumer(gaID) {
+			watch.RemoveConsumer(gaID)
+
+			// If no GAs use this selector anymore, stop watching it
+			if !watch.HasConsumers() {
+				m.logger.V(1).Info("Stopping watch for label selector", "selector", key)
+				watch.Stop()
+				delete(m.selectorWatches, key)
+			}
+		}
+	}
+}
+
 // cleanupWatches removes watches for resources no longer referenced
 func (m *defaultEndpointResourcesManager) cleanupWatches(
 	watches map[ktypes.NamespacedName]*ResourceWatcher,
@@ -170,6 +211,17 @@ func (m *defaultEndpointResourcesManager) RemoveGA(gaKey ktypes.NamespacedName)
 	m.removeGAFromWatches(m.serviceWatches, gaID, string(ServiceResourceType))
 	m.removeGAFromWatches(m.ingressWatches, gaID, string(IngressResourceType))
 	m.removeGAFromWatches(m.gatewayWatches, gaID, string(GatewayResourceType))
+
+	for key, watch := range m.selectorWatches {
+		if watch.HasConsumer(gaID) {
+			watch.RemoveConsumer(gaID)
+			if !watch.HasConsumers() {
+				m.logger.V(1).Info("Stopping watch for label selector", "selector", key)
+				watch.Stop()
+				delete(m.selectorWatches, key)
+			}
+		}
+	}
 }
 
 // removeGAFromWatches removes a GA from the consumers of all watches
@@ -195,6 +247,18 @@ func (m *defaultEndpointResourcesManager) removeGAFromWatches(
 
 // newResourceWatcher creates a new ResourceWatcher for a specific resource type
 func (m *defaultEndpointResourcesManager) newResourceWatcher(namespace, name string, resourceType ResourceType) *ResourceWatcher {
+	store, resourceClient, exampleObject := m.newWatcherDependencies(namespace, resourceType)
+	return NewResourceWatcher(namespace, name, resourceClient, store, exampleObject)
+}
+
+// newSelectorResourceWatcher creates a new ResourceWatcher for resources matching a label selector
+func (m *defaultEndpointResourcesManager) newSelectorResourceWatcher(selectorRef SelectorReference) *ResourceWatcher {
+	store, resourceClient, exampleObject := m.newWatcherDependencies(selectorRef.Namespace, selectorRef.Type)
+	return NewLabelSelectorResourceWatcher(selectorRef.Namespace, selectorRef.Selector, resourceClient, store, exampleObject)
+}
+
+// newWatcherDependencies creates the store, client and example object needed to watch a resource type in a namespace
+func (m *defaultEndpointResourcesManager) newWatcherDependencies(namespace string, resourceType ResourceType) (cache.Store, ResourceClient, client.Object) {
 	var store cache.Store
 	var resourceClient ResourceClient
 	var exampleObject client.Object
@@ -216,7 +280,7 @@ func (m *defaultEndpointResourcesManager) newResourceWatcher(namespace, name str
 		panic(fmt.Sprintf("Unknown resource type: %s", resourceType))
 	}
 
-	return NewResourceWatcher(namespace, name, resourceClient, store, exampleObject)
+	return store, resourceClient, exampleObject
 }
 
 // newServiceStore creates a new store for services
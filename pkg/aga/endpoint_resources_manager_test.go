@@ -7,6 +7,7 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	ktypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/fake"
 	agaapi "sigs.k8s.io/aws-load-balancer-controller/v3/apis/aga/v1beta1"
@@ -320,3 +321,43 @@ func TestCrossNamespaceReferences(t *testing.T) {
 		assert.NotContains(t, defaultManager.serviceWatches, resourceKey, "Cross-namespace service watch should be removed when GA is removed")
 	})
 }
+
+func TestMonitorSelectorResources(t *testing.T) {
+	clientSet := fake.NewSimpleClientset()
+	gwClient := fakegwclientset.NewSimpleClientset()
+
+	manager := NewEndpointResourcesManager(
+		clientSet,
+		gwClient,
+		NewMockEventChannel().Channel(),
+		NewMockEventChannel().Channel(),
+		NewMockEventChannel().Channel(),
+		logr.Discard(),
+	)
+	defaultManager, _ := manager.(*defaultEndpointResourcesManager)
+
+	ga1 := &agaapi.GlobalAccelerator{ObjectMeta: metav1.ObjectMeta{Name: "test-ga-1", Namespace: "default"}}
+	ga2 := &agaapi.GlobalAccelerator{ObjectMeta: metav1.ObjectMeta{Name: "test-ga-2", Namespace: "default"}}
+
+	serviceSelector := SelectorReference{Type: ServiceResourceType, Namespace: "default", Selector: labels.SelectorFromSet(labels.Set{"app": "web"})}
+	ingressSelector := SelectorReference{Type: IngressResourceType, Namespace: "team-a", Selector: labels.SelectorFromSet(labels.Set{"app": "web"})}
+
+	manager.MonitorSelectorResources(ga1, []SelectorReference{serviceSelector, ingressSelector})
+	manager.MonitorSelectorResources(ga2, []SelectorReference{serviceSelector})
+
+	assert.Contains(t, defaultManager.selectorWatches, serviceSelector.Key(), "Service selector watch should be created")
+	assert.Contains(t, defaultManager.selectorWatches, ingressSelector.Key(), "Ingress selector watch should be created")
+	assert.True(t, defaultManager.selectorWatches[serviceSelector.Key()].HasConsumer("default/test-ga-1"))
+	assert.True(t, defaultManager.selectorWatches[serviceSelector.Key()].HasConsumer("default/test-ga-2"))
+
+	// Dropping a selector from GA1 stops the watch that no GA uses anymore
+	manager.MonitorSelectorResources(ga1, []SelectorReference{serviceSelector})
+	assert.NotContains(t, defaultManager.selectorWatches, ingressSelector.Key(), "Ingress selector watch should be removed")
+	assert.Contains(t, defaultManager.selectorWatches, serviceSelector.Key())
+
+	// The shared watch is kept until all GAs are removed
+	manager.RemoveGA(ktypes.NamespacedName{Namespace: "default", Name: "test-ga-1"})
+	assert.Contains(t, defaultManager.selectorWatches, serviceSelector.Key(), "Service selector watch should still exist")
+	manager.RemoveGA(ktypes.NamespacedName{Namespace: "default", Name: "test-ga-2"})
+	assert.Empty(t, defaultManager.selectorWatches, "All selector watches should be removed")
+}
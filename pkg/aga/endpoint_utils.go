@@ -3,6 +3,7 @@ package aga
 import (
 	awssdk "github.com/aws/aws-sdk-go-v2/aws"
 
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	agaapi "sigs.k8s.io/aws-load-balancer-controller/v3/apis/aga/v1beta1"
 )
@@ -19,6 +20,26 @@ const (
 	GatewayResourceType ResourceType = "Gateway"
 )
 
+// EndpointWeightAnnotation is the annotation on a resource selected by a label-selector endpoint that overrides its weight
+const EndpointWeightAnnotation = "aga.k8s.aws/endpoint-weight"
+
+// SelectorReference contains information about a label-selector endpoint in one of its namespaces
+type SelectorReference struct {
+	Type      ResourceType
+	Namespace string
+	Selector  labels.Selector
+}
+
+// Key generates a unique key for the selector reference
+func (s SelectorReference) Key() string {
+	return string(s.Type) + "/" + s.Namespace + "/" + s.Selector.String()
+}
+
+// Matches checks whether a resource of the given type, namespace and labels is selected
+func (s SelectorReference) Matches(resourceType ResourceType, namespace string, resourceLabels map[string]string) bool {
+	return s.Type == resourceType && s.Namespace == namespace && s.Selector.Matches(labels.Set(resourceLabels))
+}
+
 // EndpointReference contains information about a referenced endpoint
 type EndpointReference struct {
 	Type       agaapi.GlobalAcceleratorEndpointType
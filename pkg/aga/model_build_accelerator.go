@@ -63,6 +63,8 @@ func (b *defaultAcceleratorBuilder) buildAcceleratorSpec(ctx context.Context, st
 	}
 
 	ipAddresses := b.buildAcceleratorIPAddresses(ctx, ga)
+	byoipCIDRs := b.buildAcceleratorByoipCIDRs(ctx, ga)
+	flowLogs := b.buildAcceleratorFlowLogs(ctx, ga)
 
 	tags, err := b.buildAcceleratorTags(ctx, stack, ga)
 	if err != nil {
@@ -73,8 +75,10 @@ func (b *defaultAcceleratorBuilder) buildAcceleratorSpec(ctx context.Context, st
 		Name:          name,
 		Enabled:       awssdk.Bool(true), // Controller always creates enabled accelerator
 		IpAddresses:   ipAddresses,
+		ByoipCIDRs:    byoipCIDRs,
 		IPAddressType: ipAddressType,
 		Tags:          tags,
+		FlowLogs:      flowLogs,
 	}, nil
 }
 
@@ -110,6 +114,32 @@ func (b *defaultAcceleratorBuilder) buildAcceleratorIPAddresses(_ context.Contex
 	return nil
 }
 
+func (b *defaultAcceleratorBuilder) buildAcceleratorByoipCIDRs(_ context.Context, ga *agaapi.GlobalAccelerator) []agamodel.ByoipCIDR {
+	if ga.Spec.ByoipCIDRs == nil {
+		return nil
+	}
+	byoipCIDRs := make([]agamodel.ByoipCIDR, 0, len(*ga.Spec.ByoipCIDRs))
+	for _, byoipCIDR := range *ga.Spec.ByoipCIDRs {
+		byoipCIDRs = append(byoipCIDRs, agamodel.ByoipCIDR{
+			IPAddressFamily: string(byoipCIDR.IPAddressFamily),
+			Cidr:            byoipCIDR.Cidr,
+		})
+	}
+	return byoipCIDRs
+}
+
+func (b *defaultAcceleratorBuilder) buildAcceleratorFlowLogs(_ context.Context, ga *agaapi.GlobalAccelerator) *agamodel.FlowLogs {
+	if ga.Spec.FlowLogs == nil {
+		// Leave flow logs unchanged if not specified
+		return nil
+	}
+	return &agamodel.FlowLogs{
+		Enabled:  ga.Spec.FlowLogs.Enabled,
+		S3Bucket: awssdk.ToString(ga.Spec.FlowLogs.S3Bucket),
+		S3Prefix: awssdk.ToString(ga.Spec.FlowLogs.S3Prefix),
+	}
+}
+
 func (b *defaultAcceleratorBuilder) buildAcceleratorIPAddressType(_ context.Context, ga *agaapi.GlobalAccelerator) agamodel.IPAddressType {
 	switch ga.Spec.IPAddressType {
 	case agaapi.IPAddressTypeIPV4:
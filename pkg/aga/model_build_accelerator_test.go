@@ -204,6 +204,93 @@ func Test_defaultAcceleratorBuilder_buildAcceleratorIPAddresses(t *testing.T) {
 	}
 }
 
+func Test_defaultAcceleratorBuilder_buildAcceleratorByoipCIDRs(t *testing.T) {
+	tests := []struct {
+		name string
+		ga   *agaapi.GlobalAccelerator
+		want []agamodel.ByoipCIDR
+	}{
+		{
+			name: "no BYOIP address ranges specified",
+			ga: &agaapi.GlobalAccelerator{
+				Spec: agaapi.GlobalAcceleratorSpec{},
+			},
+			want: nil,
+		},
+		{
+			name: "BYOIP address ranges specified",
+			ga: &agaapi.GlobalAccelerator{
+				Spec: agaapi.GlobalAcceleratorSpec{
+					ByoipCIDRs: &[]agaapi.ByoipCIDR{
+						{IPAddressFamily: agaapi.IPAddressFamilyIPv4, Cidr: "198.51.100.0/24"},
+						{IPAddressFamily: agaapi.IPAddressFamilyIPv6, Cidr: "2001:db8::/48"},
+					},
+				},
+			},
+			want: []agamodel.ByoipCIDR{
+				{IPAddressFamily: "IPv4", Cidr: "198.51.100.0/24"},
+				{IPAddressFamily: "IPv6", Cidr: "2001:db8::/48"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &defaultAcceleratorBuilder{}
+
+			got := b.buildAcceleratorByoipCIDRs(context.Background(), tt.ga)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_defaultAcceleratorBuilder_buildAcceleratorFlowLogs(t *testing.T) {
+	tests := []struct {
+		name string
+		ga   *agaapi.GlobalAccelerator
+		want *agamodel.FlowLogs
+	}{
+		{
+			name: "flow logs not specified",
+			ga: &agaapi.GlobalAccelerator{
+				Spec: agaapi.GlobalAcceleratorSpec{},
+			},
+			want: nil,
+		},
+		{
+			name: "flow logs enabled",
+			ga: &agaapi.GlobalAccelerator{
+				Spec: agaapi.GlobalAcceleratorSpec{
+					FlowLogs: &agaapi.FlowLogsConfig{
+						Enabled:  true,
+						S3Bucket: aws.String("flow-logs"),
+						S3Prefix: aws.String("prod"),
+					},
+				},
+			},
+			want: &agamodel.FlowLogs{Enabled: true, S3Bucket: "flow-logs", S3Prefix: "prod"},
+		},
+		{
+			name: "flow logs disabled",
+			ga: &agaapi.GlobalAccelerator{
+				Spec: agaapi.GlobalAcceleratorSpec{
+					FlowLogs: &agaapi.FlowLogsConfig{Enabled: false},
+				},
+			},
+			want: &agamodel.FlowLogs{Enabled: false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &defaultAcceleratorBuilder{}
+
+			got := b.buildAcceleratorFlowLogs(context.Background(), tt.ga)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func Test_defaultAcceleratorBuilder_buildAcceleratorTags(t *testing.T) {
 	trackingProvider := tracking.NewDefaultProvider("aga.k8s.aws", "test-cluster")
 
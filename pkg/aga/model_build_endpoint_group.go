@@ -223,6 +223,7 @@ func (b *defaultEndpointGroupBuilder) buildEndpointConfigurations(_ context.Cont
 				}
 				endpointConfig.Weight = awssdk.Int32(loadedEndpoint.Weight)
 				endpointConfig.ClientIPPreservationEnabled = ep.ClientIPPreservationEnabled
+				endpointConfig.AttachmentARN = ep.CrossAccountAttachmentARN
 				endpointConfigurations = append(endpointConfigurations, endpointConfig)
 			} else {
 				// Log warning for endpoints which are not loaded successfully during loading and has Warning status
@@ -22,6 +22,7 @@ type ReferenceTracker struct {
 	mutex       sync.RWMutex
 	resourceMap map[ResourceKey]sets.String                    // Resource -> Set of GA names
 	gaRefMap    map[types.NamespacedName]sets.Set[ResourceKey] // GA -> Set of resources
+	selectorMap map[types.NamespacedName][]SelectorReference   // GA -> label-selector endpoints
 	logger      logr.Logger
 }
 
@@ -30,6 +31,7 @@ func NewReferenceTracker(logger logr.Logger) *ReferenceTracker {
 	return &ReferenceTracker{
 		resourceMap: make(map[ResourceKey]sets.String),
 		gaRefMap:    make(map[types.NamespacedName]sets.Set[ResourceKey]),
+		selectorMap: make(map[types.NamespacedName][]SelectorReference),
 		logger:      logger,
 	}
 }
@@ -84,11 +86,26 @@ func (t *ReferenceTracker) UpdateDesiredEndpointReferencesForGA(ga *agaapi.Globa
 	t.gaRefMap[gaKey] = currentResources
 }
 
+// UpdateSelectorReferencesForGA updates the label-selector endpoints tracked for a GlobalAccelerator
+func (t *ReferenceTracker) UpdateSelectorReferencesForGA(ga *agaapi.GlobalAccelerator, selectorRefs []SelectorReference) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	gaKey := k8s.NamespacedName(ga)
+	if len(selectorRefs) == 0 {
+		delete(t.selectorMap, gaKey)
+		return
+	}
+	t.selectorMap[gaKey] = selectorRefs
+}
+
 // RemoveGA removes all tracking information for a GlobalAccelerator
 func (t *ReferenceTracker) RemoveGA(gaKey types.NamespacedName) {
 	t.mutex.Lock()
 	defer t.mutex.Unlock()
 
+	delete(t.selectorMap, gaKey)
+
 	if resources, exists := t.gaRefMap[gaKey]; exists {
 		for resourceKey := range resources {
 			if gaSet, exists := t.resourceMap[resourceKey]; exists {
@@ -136,3 +153,21 @@ func (t *ReferenceTracker) GetGAsForResource(resourceKey ResourceKey) []types.Na
 
 	return result
 }
+
+// GetGAsSelectingResource returns all GlobalAccelerators with a label-selector endpoint that selects a resource
+func (t *ReferenceTracker) GetGAsSelectingResource(resourceType ResourceType, namespace string, resourceLabels map[string]string) []types.NamespacedName {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	var result []types.NamespacedName
+	for gaKey, selectorRefs := range t.selectorMap {
+		for _, selectorRef := range selectorRefs {
+			if selectorRef.Matches(resourceType, namespace, resourceLabels) {
+				result = append(result, gaKey)
+				break
+			}
+		}
+	}
+
+	return result
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	agaapi "sigs.k8s.io/aws-load-balancer-controller/v3/apis/aga/v1beta1"
 )
@@ -521,3 +522,39 @@ func TestReferenceTracker_GetGAsForResource(t *testing.T) {
 	gasForNonExistingService := tracker.GetGAsForResource(nonExistingServiceKey)
 	assert.Equal(t, 0, len(gasForNonExistingService))
 }
+
+func TestReferenceTracker_GetGAsSelectingResource(t *testing.T) {
+	ga1 := &agaapi.GlobalAccelerator{ObjectMeta: metav1.ObjectMeta{Name: "ga1", Namespace: "test-ns"}}
+	ga2 := &agaapi.GlobalAccelerator{ObjectMeta: metav1.ObjectMeta{Name: "ga2", Namespace: "test-ns"}}
+	webSelector := labels.SelectorFromSet(labels.Set{"app": "web"})
+	apiSelector := labels.SelectorFromSet(labels.Set{"app": "api"})
+
+	tracker := NewReferenceTracker(logr.Discard())
+	tracker.UpdateSelectorReferencesForGA(ga1, []SelectorReference{
+		{Type: ServiceResourceType, Namespace: "test-ns", Selector: webSelector},
+	})
+	tracker.UpdateSelectorReferencesForGA(ga2, []SelectorReference{
+		{Type: ServiceResourceType, Namespace: "test-ns", Selector: webSelector},
+		{Type: IngressResourceType, Namespace: "other-ns", Selector: apiSelector},
+	})
+
+	ga1Key := types.NamespacedName{Namespace: "test-ns", Name: "ga1"}
+	ga2Key := types.NamespacedName{Namespace: "test-ns", Name: "ga2"}
+
+	assert.ElementsMatch(t, []types.NamespacedName{ga1Key, ga2Key},
+		tracker.GetGAsSelectingResource(ServiceResourceType, "test-ns", map[string]string{"app": "web", "tier": "frontend"}))
+	assert.ElementsMatch(t, []types.NamespacedName{ga2Key},
+		tracker.GetGAsSelectingResource(IngressResourceType, "other-ns", map[string]string{"app": "api"}))
+	assert.Empty(t, tracker.GetGAsSelectingResource(ServiceResourceType, "other-ns", map[string]string{"app": "web"}))
+	assert.Empty(t, tracker.GetGAsSelectingResource(IngressResourceType, "test-ns", map[string]string{"app": "web"}))
+	assert.Empty(t, tracker.GetGAsSelectingResource(ServiceResourceType, "test-ns", nil))
+
+	// Clearing selectors for a GA stops matching it
+	tracker.UpdateSelectorReferencesForGA(ga1, nil)
+	assert.ElementsMatch(t, []types.NamespacedName{ga2Key},
+		tracker.GetGAsSelectingResource(ServiceResourceType, "test-ns", map[string]string{"app": "web"}))
+
+	// Removing a GA removes its selectors
+	tracker.RemoveGA(ga2Key)
+	assert.Empty(t, tracker.GetGAsSelectingResource(ServiceResourceType, "test-ns", map[string]string{"app": "web"}))
+}
@@ -7,6 +7,7 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/watch"
@@ -37,19 +38,47 @@ func NewResourceWatcher(
 	exampleObject client.Object,
 ) *ResourceWatcher {
 	fieldSelector := fields.Set{"metadata.name": name}.AsSelector().String()
+	tweakListOptions := func(options *metav1.ListOptions) {
+		options.FieldSelector = fieldSelector
+	}
+	return newResourceWatcher(fmt.Sprintf("%T-%s/%s", exampleObject, namespace, name), tweakListOptions, resourceClient, store, exampleObject)
+}
+
+// NewLabelSelectorResourceWatcher creates a new ResourceWatcher for all resources in a namespace matching a label selector
+func NewLabelSelectorResourceWatcher(
+	namespace string,
+	selector labels.Selector,
+	resourceClient ResourceClient,
+	store cache.Store,
+	exampleObject client.Object,
+) *ResourceWatcher {
+	labelSelector := selector.String()
+	tweakListOptions := func(options *metav1.ListOptions) {
+		options.LabelSelector = labelSelector
+	}
+	return newResourceWatcher(fmt.Sprintf("%T-%s?%s", exampleObject, namespace, labelSelector), tweakListOptions, resourceClient, store, exampleObject)
+}
 
+// newResourceWatcher creates a ResourceWatcher whose list and watch calls are restricted by tweakListOptions
+func newResourceWatcher(
+	reflectorName string,
+	tweakListOptions func(options *metav1.ListOptions),
+	resourceClient ResourceClient,
+	store cache.Store,
+	exampleObject client.Object,
+) *ResourceWatcher {
 	listFunc := func(options metav1.ListOptions) (runtime.Object, error) {
-		options.FieldSelector = fieldSelector
+		tweakListOptions(&options)
 		return resourceClient.List(context.Background(), options)
 	}
 
 	watchFunc := func(options metav1.ListOptions) (watch.Interface, error) {
-		options.FieldSelector = fieldSelector
+		tweakListOptions(&options)
 		return resourceClient.Watch(context.Background(), options)
 	}
 
 	rt := cache.NewNamedReflector(
-		fmt.Sprintf("%T-%s/%s", exampleObject, namespace, name),
+		reflectorName,
 		&cache.ListWatch{ListFunc: listFunc, WatchFunc: watchFunc},
 		exampleObject,
 		store,
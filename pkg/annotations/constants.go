@@ -27,6 +27,9 @@ const (
 	IngressSuffixWAFACLID                                      = "waf-acl-id"
 	IngressSuffixWebACLID                                      = "web-acl-id" // deprecated, use "waf-acl-id" instead.
 	IngressSuffixShieldAdvancedProtection                      = "shield-advanced-protection"
+	IngressSuffixShieldAdvancedHealthCheckIDs                  = "shield-advanced-health-check-ids"
+	IngressSuffixShieldAdvancedAutomaticResponse               = "shield-advanced-automatic-response"
+	IngressSuffixShieldAdvancedProtectionGroups                = "shield-advanced-protection-groups"
 	IngressSuffixSecurityGroups                                = "security-groups"
 	IngressSuffixListenPorts                                   = "listen-ports"
 	IngressSuffixSSLRedirect                                   = "ssl-redirect"
@@ -59,6 +62,7 @@ const (
 	IngressSuffixSecurityGroupPrefixLists                      = "security-group-prefix-lists"
 	IngressSuffixlsAttsAnnotationPrefix                        = "listener-attributes"
 	IngressLBSuffixMultiClusterTargetGroup                     = "multi-cluster-target-group"
+	IngressSuffixTargetZonePolicy                              = "target-zone-policy"
 	IngressSuffixLoadBalancerCapacityReservation               = "minimum-load-balancer-capacity"
 	IngressSuffixIPAMIPv4PoolId                                = "ipam-ipv4-pool-id"
 	IngressSuffixEnableFrontendNlb                             = "enable-frontend-nlb"
@@ -82,6 +86,8 @@ const (
 	IngressSuffixCreateCertificate                             = "create-acm-cert"
 	IngressSuffixACMCaARN                                      = "acm-pca-arn"
 	IngressSuffixDryRunPlan                                    = "dry-run-plan"
+	IngressSuffixCloudWatchAlarmThresholds                     = "cloudwatch-alarm-thresholds"
+	IngressSuffixCloudWatchAlarmActions                        = "cloudwatch-alarm-actions"
 
 	// NLB annotation suffixes
 	// prefixes service.beta.kubernetes.io, service.kubernetes.io
@@ -125,6 +131,7 @@ const (
 	SvcLBSuffixSecurityGroupPrefixLists                  = "aws-load-balancer-security-group-prefix-lists"
 	SvcLBSuffixlsAttsAnnotationPrefix                    = "aws-load-balancer-listener-attributes"
 	SvcLBSuffixMultiClusterTargetGroup                   = "aws-load-balancer-multi-cluster-target-group"
+	SvcLBSuffixTargetZonePolicy                          = "aws-load-balancer-target-zone-policy"
 	SvcLBSuffixEnablePrefixForIpv6SourceNat              = "aws-load-balancer-enable-prefix-for-ipv6-source-nat"
 	SvcLBSuffixSourceNatIpv6Prefixes                     = "aws-load-balancer-source-nat-ipv6-prefixes"
 	SvcLBSuffixLoadBalancerCapacityReservation           = "aws-load-balancer-minimum-load-balancer-capacity"
@@ -132,6 +139,12 @@ const (
 	SvcLBSuffixEnableTCPUDPListener                      = "aws-load-balancer-enable-tcp-udp-listener"
 	SvcLBSuffixDisableNLBSG                              = "aws-load-balancer-disable-nlb-sg"
 	SvcLBSuffixQUICEnabledPorts                          = "aws-load-balancer-quic-enabled-ports"
+	SvcLBSuffixEndpointServiceEnabled                    = "aws-load-balancer-endpoint-service-enabled"
+	SvcLBSuffixEndpointServiceAllowedPrincipals          = "aws-load-balancer-endpoint-service-allowed-principals"
+	SvcLBSuffixEndpointServiceAcceptanceRequired         = "aws-load-balancer-endpoint-service-acceptance-required"
+	SvcLBSuffixEndpointServicePrivateDNSName             = "aws-load-balancer-endpoint-service-private-dns-name"
+	SvcLBSuffixCloudWatchAlarmThresholds                 = "aws-load-balancer-cloudwatch-alarm-thresholds"
+	SvcLBSuffixCloudWatchAlarmActions                    = "aws-load-balancer-cloudwatch-alarm-actions"
 )
 
 const (
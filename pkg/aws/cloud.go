@@ -109,6 +109,9 @@ func NewCloud(cfg CloudConfig, clusterName string, metricsCollector *aws_metrics
 		shield:            services.NewShield(awsClientsProvider),
 		rgt:               services.NewRGT(awsClientsProvider),
 		globalAccelerator: services.NewGlobalAccelerator(awsClientsProvider),
+		s3:                services.NewS3(awsClientsProvider),
+		cloudWatch:        services.NewCloudWatch(awsClientsProvider),
+		sts:               services.NewSTS(awsClientsProvider),
 
 		awsConfigGenerator: awsConfigGenerator,
 
@@ -214,6 +217,9 @@ type defaultCloud struct {
 	shield            services.Shield
 	rgt               services.RGT
 	globalAccelerator services.GlobalAccelerator
+	s3                services.S3
+	cloudWatch        services.CloudWatch
+	sts               services.STS
 
 	clusterName string
 
@@ -326,6 +332,18 @@ func (c *defaultCloud) GlobalAccelerator() services.GlobalAccelerator {
 	return c.globalAccelerator
 }
 
+func (c *defaultCloud) S3() services.S3 {
+	return c.s3
+}
+
+func (c *defaultCloud) CloudWatch() services.CloudWatch {
+	return c.cloudWatch
+}
+
+func (c *defaultCloud) STS() services.STS {
+	return c.sts
+}
+
 func (c *defaultCloud) Region() string {
 	return c.cfg.Region
 }
@@ -5,11 +5,13 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/acm"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
 	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
 	"github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/shield"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/aws/aws-sdk-go-v2/service/wafregional"
@@ -28,6 +30,8 @@ type defaultAWSClientsProvider struct {
 	stsClient               *sts.Client
 	route53Client           *route53.Client
 	globalAcceleratorClient *globalaccelerator.Client
+	s3Client                *s3.Client
+	cloudWatchClient        *cloudwatch.Client
 
 	// used for dynamic creation of ELBv2 client
 	elbv2CustomEndpoint *string
@@ -44,6 +48,8 @@ func NewDefaultAWSClientsProvider(cfg aws.Config, endpointsResolver *endpoints.R
 	stsCustomEndpoint := endpointsResolver.EndpointFor(sts.ServiceID)
 	globalAcceleratorCustomEndpoint := endpointsResolver.EndpointFor(globalaccelerator.ServiceID)
 	route53CustomEndpoint := endpointsResolver.EndpointFor(route53.ServiceID)
+	s3CustomEndpoint := endpointsResolver.EndpointFor(s3.ServiceID)
+	cloudWatchCustomEndpoint := endpointsResolver.EndpointFor(cloudwatch.ServiceID)
 
 	ec2Client := ec2.NewFromConfig(cfg, func(o *ec2.Options) {
 		if ec2CustomEndpoint != nil {
@@ -100,6 +106,18 @@ func NewDefaultAWSClientsProvider(cfg aws.Config, endpointsResolver *endpoints.R
 		}
 	})
 
+	s3Client := s3.NewFromConfig(cfg, func(o *s3.Options) {
+		if s3CustomEndpoint != nil {
+			o.BaseEndpoint = s3CustomEndpoint
+		}
+	})
+
+	cloudWatchClient := cloudwatch.NewFromConfig(cfg, func(o *cloudwatch.Options) {
+		if cloudWatchCustomEndpoint != nil {
+			o.BaseEndpoint = cloudWatchCustomEndpoint
+		}
+	})
+
 	return &defaultAWSClientsProvider{
 		ec2Client:               ec2Client,
 		elbv2Client:             elbv2Client,
@@ -111,6 +129,8 @@ func NewDefaultAWSClientsProvider(cfg aws.Config, endpointsResolver *endpoints.R
 		stsClient:               stsClient,
 		route53Client:           route53Client,
 		globalAcceleratorClient: globalAcceleratorClient,
+		s3Client:                s3Client,
+		cloudWatchClient:        cloudWatchClient,
 
 		elbv2CustomEndpoint: elbv2CustomEndpoint,
 	}, nil
@@ -159,6 +179,14 @@ func (p *defaultAWSClientsProvider) GetGlobalAcceleratorClient(ctx context.Conte
 	return p.globalAcceleratorClient, nil
 }
 
+func (p *defaultAWSClientsProvider) GetS3Client(ctx context.Context, operationName string) (*s3.Client, error) {
+	return p.s3Client, nil
+}
+
+func (p *defaultAWSClientsProvider) GetCloudWatchClient(ctx context.Context, operationName string) (*cloudwatch.Client, error) {
+	return p.cloudWatchClient, nil
+}
+
 func (p *defaultAWSClientsProvider) GenerateNewELBv2Client(cfg aws.Config) *elasticloadbalancingv2.Client {
 	return generateNewELBv2ClientHelper(cfg, p.elbv2CustomEndpoint)
 }
@@ -5,11 +5,13 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/acm"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
 	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
 	"github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/shield"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/aws/aws-sdk-go-v2/service/wafregional"
@@ -27,5 +29,7 @@ type AWSClientsProvider interface {
 	GetRGTClient(ctx context.Context, operationName string) (*resourcegroupstaggingapi.Client, error)
 	GetSTSClient(ctx context.Context, operationName string) (*sts.Client, error)
 	GetGlobalAcceleratorClient(ctx context.Context, operationName string) (*globalaccelerator.Client, error)
+	GetS3Client(ctx context.Context, operationName string) (*s3.Client, error)
+	GetCloudWatchClient(ctx context.Context, operationName string) (*cloudwatch.Client, error)
 	GenerateNewELBv2Client(cfg aws.Config) *elasticloadbalancingv2.Client
 }
@@ -30,6 +30,15 @@ type Cloud interface {
 	// GlobalAccelerator provides API to AWS GlobalAccelerator
 	GlobalAccelerator() GlobalAccelerator
 
+	// S3 provides API to AWS S3
+	S3() S3
+
+	// CloudWatch provides API to AWS CloudWatch
+	CloudWatch() CloudWatch
+
+	// STS provides API to AWS STS
+	STS() STS
+
 	// Region for the kubernetes cluster
 	Region() string
 
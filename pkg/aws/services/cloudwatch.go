@@ -0,0 +1,79 @@
+package services
+
+import (
+	"context"
+
+	cloudwatchsdk "github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cloudwatchtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/provider"
+)
+
+type CloudWatch interface {
+	// wrapper to DescribeAlarms API, which aggregates paged results into list of metric alarms.
+	DescribeMetricAlarmsAsList(ctx context.Context, input *cloudwatchsdk.DescribeAlarmsInput) ([]cloudwatchtypes.MetricAlarm, error)
+	PutMetricAlarmWithContext(ctx context.Context, input *cloudwatchsdk.PutMetricAlarmInput) (*cloudwatchsdk.PutMetricAlarmOutput, error)
+	DeleteAlarmsWithContext(ctx context.Context, input *cloudwatchsdk.DeleteAlarmsInput) (*cloudwatchsdk.DeleteAlarmsOutput, error)
+	TagResourceWithContext(ctx context.Context, input *cloudwatchsdk.TagResourceInput) (*cloudwatchsdk.TagResourceOutput, error)
+	UntagResourceWithContext(ctx context.Context, input *cloudwatchsdk.UntagResourceInput) (*cloudwatchsdk.UntagResourceOutput, error)
+}
+
+// NewCloudWatch constructs new CloudWatch implementation.
+func NewCloudWatch(awsClientsProvider provider.AWSClientsProvider) CloudWatch {
+	return &cloudWatchClient{
+		awsClientsProvider: awsClientsProvider,
+	}
+}
+
+// default implementation for CloudWatch.
+type cloudWatchClient struct {
+	awsClientsProvider provider.AWSClientsProvider
+}
+
+func (c *cloudWatchClient) DescribeMetricAlarmsAsList(ctx context.Context, input *cloudwatchsdk.DescribeAlarmsInput) ([]cloudwatchtypes.MetricAlarm, error) {
+	client, err := c.awsClientsProvider.GetCloudWatchClient(ctx, "DescribeAlarms")
+	if err != nil {
+		return nil, err
+	}
+	var result []cloudwatchtypes.MetricAlarm
+	paginator := cloudwatchsdk.NewDescribeAlarmsPaginator(client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, output.MetricAlarms...)
+	}
+	return result, nil
+}
+
+func (c *cloudWatchClient) PutMetricAlarmWithContext(ctx context.Context, input *cloudwatchsdk.PutMetricAlarmInput) (*cloudwatchsdk.PutMetricAlarmOutput, error) {
+	client, err := c.awsClientsProvider.GetCloudWatchClient(ctx, "PutMetricAlarm")
+	if err != nil {
+		return nil, err
+	}
+	return client.PutMetricAlarm(ctx, input)
+}
+
+func (c *cloudWatchClient) DeleteAlarmsWithContext(ctx context.Context, input *cloudwatchsdk.DeleteAlarmsInput) (*cloudwatchsdk.DeleteAlarmsOutput, error) {
+	client, err := c.awsClientsProvider.GetCloudWatchClient(ctx, "DeleteAlarms")
+	if err != nil {
+		return nil, err
+	}
+	return client.DeleteAlarms(ctx, input)
+}
+
+func (c *cloudWatchClient) TagResourceWithContext(ctx context.Context, input *cloudwatchsdk.TagResourceInput) (*cloudwatchsdk.TagResourceOutput, error) {
+	client, err := c.awsClientsProvider.GetCloudWatchClient(ctx, "TagResource")
+	if err != nil {
+		return nil, err
+	}
+	return client.TagResource(ctx, input)
+}
+
+func (c *cloudWatchClient) UntagResourceWithContext(ctx context.Context, input *cloudwatchsdk.UntagResourceInput) (*cloudwatchsdk.UntagResourceOutput, error) {
+	client, err := c.awsClientsProvider.GetCloudWatchClient(ctx, "UntagResource")
+	if err != nil {
+		return nil, err
+	}
+	return client.UntagResource(ctx, input)
+}
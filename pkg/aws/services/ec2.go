@@ -28,15 +28,33 @@ type EC2 interface {
 	// DescribeRouteTablesAsList wraps the DescribeRouteTablesWithContext API, which aggregates paged results into list.
 	DescribeRouteTablesAsList(ctx context.Context, input *ec2.DescribeRouteTablesInput) ([]types.RouteTable, error)
 
+	// DescribeVpcEndpointServiceConfigurationsAsList wraps the DescribeVpcEndpointServiceConfigurations API, which aggregates paged results into list.
+	DescribeVpcEndpointServiceConfigurationsAsList(ctx context.Context, input *ec2.DescribeVpcEndpointServiceConfigurationsInput) ([]types.ServiceConfiguration, error)
+
+	// DescribeVpcEndpointServicePermissionsAsList wraps the DescribeVpcEndpointServicePermissions API, which aggregates paged results into list.
+	DescribeVpcEndpointServicePermissionsAsList(ctx context.Context, input *ec2.DescribeVpcEndpointServicePermissionsInput) ([]types.AllowedPrincipal, error)
+
+	// DescribeVpcEndpointConnectionsAsList wraps the DescribeVpcEndpointConnections API, which aggregates paged results into list.
+	DescribeVpcEndpointConnectionsAsList(ctx context.Context, input *ec2.DescribeVpcEndpointConnectionsInput) ([]types.VpcEndpointConnection, error)
+
 	CreateTagsWithContext(ctx context.Context, input *ec2.CreateTagsInput) (*ec2.CreateTagsOutput, error)
 	DeleteTagsWithContext(ctx context.Context, input *ec2.DeleteTagsInput) (*ec2.DeleteTagsOutput, error)
 	CreateSecurityGroupWithContext(ctx context.Context, input *ec2.CreateSecurityGroupInput) (*ec2.CreateSecurityGroupOutput, error)
 	DeleteSecurityGroupWithContext(ctx context.Context, input *ec2.DeleteSecurityGroupInput) (*ec2.DeleteSecurityGroupOutput, error)
 	AuthorizeSecurityGroupIngressWithContext(ctx context.Context, input *ec2.AuthorizeSecurityGroupIngressInput) (*ec2.AuthorizeSecurityGroupIngressOutput, error)
 	RevokeSecurityGroupIngressWithContext(ctx context.Context, input *ec2.RevokeSecurityGroupIngressInput) (*ec2.RevokeSecurityGroupIngressOutput, error)
+	AuthorizeSecurityGroupEgressWithContext(ctx context.Context, input *ec2.AuthorizeSecurityGroupEgressInput) (*ec2.AuthorizeSecurityGroupEgressOutput, error)
+	RevokeSecurityGroupEgressWithContext(ctx context.Context, input *ec2.RevokeSecurityGroupEgressInput) (*ec2.RevokeSecurityGroupEgressOutput, error)
 	DescribeAvailabilityZonesWithContext(ctx context.Context, input *ec2.DescribeAvailabilityZonesInput) (*ec2.DescribeAvailabilityZonesOutput, error)
 	DescribeVpcsWithContext(ctx context.Context, input *ec2.DescribeVpcsInput) (*ec2.DescribeVpcsOutput, error)
 	DescribeInstancesWithContext(ctx context.Context, input *ec2.DescribeInstancesInput) (*ec2.DescribeInstancesOutput, error)
+	CreateVpcEndpointServiceConfigurationWithContext(ctx context.Context, input *ec2.CreateVpcEndpointServiceConfigurationInput) (*ec2.CreateVpcEndpointServiceConfigurationOutput, error)
+	ModifyVpcEndpointServiceConfigurationWithContext(ctx context.Context, input *ec2.ModifyVpcEndpointServiceConfigurationInput) (*ec2.ModifyVpcEndpointServiceConfigurationOutput, error)
+	DeleteVpcEndpointServiceConfigurationsWithContext(ctx context.Context, input *ec2.DeleteVpcEndpointServiceConfigurationsInput) (*ec2.DeleteVpcEndpointServiceConfigurationsOutput, error)
+	ModifyVpcEndpointServicePermissionsWithContext(ctx context.Context, input *ec2.ModifyVpcEndpointServicePermissionsInput) (*ec2.ModifyVpcEndpointServicePermissionsOutput, error)
+	AcceptVpcEndpointConnectionsWithContext(ctx context.Context, input *ec2.AcceptVpcEndpointConnectionsInput) (*ec2.AcceptVpcEndpointConnectionsOutput, error)
+	RejectVpcEndpointConnectionsWithContext(ctx context.Context, input *ec2.RejectVpcEndpointConnectionsInput) (*ec2.RejectVpcEndpointConnectionsOutput, error)
+	DescribeAddressesWithContext(ctx context.Context, input *ec2.DescribeAddressesInput) (*ec2.DescribeAddressesOutput, error)
 }
 
 // NewEC2 constructs new EC2 implementation.
@@ -210,6 +228,22 @@ func (c *ec2Client) RevokeSecurityGroupIngressWithContext(ctx context.Context, i
 	return client.RevokeSecurityGroupIngress(ctx, input)
 }
 
+func (c *ec2Client) AuthorizeSecurityGroupEgressWithContext(ctx context.Context, input *ec2.AuthorizeSecurityGroupEgressInput) (*ec2.AuthorizeSecurityGroupEgressOutput, error) {
+	client, err := c.awsClientsProvider.GetEC2Client(ctx, "AuthorizeSecurityGroupEgress")
+	if err != nil {
+		return nil, err
+	}
+	return client.AuthorizeSecurityGroupEgress(ctx, input)
+}
+
+func (c *ec2Client) RevokeSecurityGroupEgressWithContext(ctx context.Context, input *ec2.RevokeSecurityGroupEgressInput) (*ec2.RevokeSecurityGroupEgressOutput, error) {
+	client, err := c.awsClientsProvider.GetEC2Client(ctx, "RevokeSecurityGroupEgress")
+	if err != nil {
+		return nil, err
+	}
+	return client.RevokeSecurityGroupEgress(ctx, input)
+}
+
 func (c *ec2Client) DescribeAvailabilityZonesWithContext(ctx context.Context, input *ec2.DescribeAvailabilityZonesInput) (*ec2.DescribeAvailabilityZonesOutput, error) {
 	client, err := c.awsClientsProvider.GetEC2Client(ctx, "DescribeAvailabilityZones")
 	if err != nil {
@@ -225,3 +259,110 @@ func (c *ec2Client) DescribeVpcsWithContext(ctx context.Context, input *ec2.Desc
 	}
 	return client.DescribeVpcs(ctx, input)
 }
+
+func (c *ec2Client) DescribeVpcEndpointServiceConfigurationsAsList(ctx context.Context, input *ec2.DescribeVpcEndpointServiceConfigurationsInput) ([]types.ServiceConfiguration, error) {
+	var result []types.ServiceConfiguration
+	client, err := c.awsClientsProvider.GetEC2Client(ctx, "DescribeVpcEndpointServiceConfigurations")
+	if err != nil {
+		return nil, err
+	}
+	paginator := ec2.NewDescribeVpcEndpointServiceConfigurationsPaginator(client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, output.ServiceConfigurations...)
+	}
+	return result, nil
+}
+
+func (c *ec2Client) DescribeVpcEndpointServicePermissionsAsList(ctx context.Context, input *ec2.DescribeVpcEndpointServicePermissionsInput) ([]types.AllowedPrincipal, error) {
+	var result []types.AllowedPrincipal
+	client, err := c.awsClientsProvider.GetEC2Client(ctx, "DescribeVpcEndpointServicePermissions")
+	if err != nil {
+		return nil, err
+	}
+	paginator := ec2.NewDescribeVpcEndpointServicePermissionsPaginator(client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, output.AllowedPrincipals...)
+	}
+	return result, nil
+}
+
+func (c *ec2Client) DescribeVpcEndpointConnectionsAsList(ctx context.Context, input *ec2.DescribeVpcEndpointConnectionsInput) ([]types.VpcEndpointConnection, error) {
+	var result []types.VpcEndpointConnection
+	client, err := c.awsClientsProvider.GetEC2Client(ctx, "DescribeVpcEndpointConnections")
+	if err != nil {
+		return nil, err
+	}
+	paginator := ec2.NewDescribeVpcEndpointConnectionsPaginator(client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, output.VpcEndpointConnections...)
+	}
+	return result, nil
+}
+
+func (c *ec2Client) CreateVpcEndpointServiceConfigurationWithContext(ctx context.Context, input *ec2.CreateVpcEndpointServiceConfigurationInput) (*ec2.CreateVpcEndpointServiceConfigurationOutput, error) {
+	client, err := c.awsClientsProvider.GetEC2Client(ctx, "CreateVpcEndpointServiceConfiguration")
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateVpcEndpointServiceConfiguration(ctx, input)
+}
+
+func (c *ec2Client) ModifyVpcEndpointServiceConfigurationWithContext(ctx context.Context, input *ec2.ModifyVpcEndpointServiceConfigurationInput) (*ec2.ModifyVpcEndpointServiceConfigurationOutput, error) {
+	client, err := c.awsClientsProvider.GetEC2Client(ctx, "ModifyVpcEndpointServiceConfiguration")
+	if err != nil {
+		return nil, err
+	}
+	return client.ModifyVpcEndpointServiceConfiguration(ctx, input)
+}
+
+func (c *ec2Client) DeleteVpcEndpointServiceConfigurationsWithContext(ctx context.Context, input *ec2.DeleteVpcEndpointServiceConfigurationsInput) (*ec2.DeleteVpcEndpointServiceConfigurationsOutput, error) {
+	client, err := c.awsClientsProvider.GetEC2Client(ctx, "DeleteVpcEndpointServiceConfigurations")
+	if err != nil {
+		return nil, err
+	}
+	return client.DeleteVpcEndpointServiceConfigurations(ctx, input)
+}
+
+func (c *ec2Client) ModifyVpcEndpointServicePermissionsWithContext(ctx context.Context, input *ec2.ModifyVpcEndpointServicePermissionsInput) (*ec2.ModifyVpcEndpointServicePermissionsOutput, error) {
+	client, err := c.awsClientsProvider.GetEC2Client(ctx, "ModifyVpcEndpointServicePermissions")
+	if err != nil {
+		return nil, err
+	}
+	return client.ModifyVpcEndpointServicePermissions(ctx, input)
+}
+
+func (c *ec2Client) AcceptVpcEndpointConnectionsWithContext(ctx context.Context, input *ec2.AcceptVpcEndpointConnectionsInput) (*ec2.AcceptVpcEndpointConnectionsOutput, error) {
+	client, err := c.awsClientsProvider.GetEC2Client(ctx, "AcceptVpcEndpointConnections")
+	if err != nil {
+		return nil, err
+	}
+	return client.AcceptVpcEndpointConnections(ctx, input)
+}
+
+func (c *ec2Client) RejectVpcEndpointConnectionsWithContext(ctx context.Context, input *ec2.RejectVpcEndpointConnectionsInput) (*ec2.RejectVpcEndpointConnectionsOutput, error) {
+	client, err := c.awsClientsProvider.GetEC2Client(ctx, "RejectVpcEndpointConnections")
+	if err != nil {
+		return nil, err
+	}
+	return client.RejectVpcEndpointConnections(ctx, input)
+}
+
+func (c *ec2Client) DescribeAddressesWithContext(ctx context.Context, input *ec2.DescribeAddressesInput) (*ec2.DescribeAddressesOutput, error) {
+	client, err := c.awsClientsProvider.GetEC2Client(ctx, "DescribeAddresses")
+	if err != nil {
+		return nil, err
+	}
+	return client.DescribeAddresses(ctx, input)
+}
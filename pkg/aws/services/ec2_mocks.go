@@ -36,6 +36,36 @@ func (m *MockEC2) EXPECT() *MockEC2MockRecorder {
 	return m.recorder
 }
 
+// AcceptVpcEndpointConnectionsWithContext mocks base method.
+func (m *MockEC2) AcceptVpcEndpointConnectionsWithContext(arg0 context.Context, arg1 *ec2.AcceptVpcEndpointConnectionsInput) (*ec2.AcceptVpcEndpointConnectionsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptVpcEndpointConnectionsWithContext", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.AcceptVpcEndpointConnectionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptVpcEndpointConnectionsWithContext indicates an expected call of AcceptVpcEndpointConnectionsWithContext.
+func (mr *MockEC2MockRecorder) AcceptVpcEndpointConnectionsWithContext(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptVpcEndpointConnectionsWithContext", reflect.TypeOf((*MockEC2)(nil).AcceptVpcEndpointConnectionsWithContext), arg0, arg1)
+}
+
+// AuthorizeSecurityGroupEgressWithContext mocks base method.
+func (m *MockEC2) AuthorizeSecurityGroupEgressWithContext(arg0 context.Context, arg1 *ec2.AuthorizeSecurityGroupEgressInput) (*ec2.AuthorizeSecurityGroupEgressOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthorizeSecurityGroupEgressWithContext", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.AuthorizeSecurityGroupEgressOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthorizeSecurityGroupEgressWithContext indicates an expected call of AuthorizeSecurityGroupEgressWithContext.
+func (mr *MockEC2MockRecorder) AuthorizeSecurityGroupEgressWithContext(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeSecurityGroupEgressWithContext", reflect.TypeOf((*MockEC2)(nil).AuthorizeSecurityGroupEgressWithContext), arg0, arg1)
+}
+
 // AuthorizeSecurityGroupIngressWithContext mocks base method.
 func (m *MockEC2) AuthorizeSecurityGroupIngressWithContext(arg0 context.Context, arg1 *ec2.AuthorizeSecurityGroupIngressInput) (*ec2.AuthorizeSecurityGroupIngressOutput, error) {
 	m.ctrl.T.Helper()
@@ -81,6 +111,21 @@ func (mr *MockEC2MockRecorder) CreateTagsWithContext(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTagsWithContext", reflect.TypeOf((*MockEC2)(nil).CreateTagsWithContext), arg0, arg1)
 }
 
+// CreateVpcEndpointServiceConfigurationWithContext mocks base method.
+func (m *MockEC2) CreateVpcEndpointServiceConfigurationWithContext(arg0 context.Context, arg1 *ec2.CreateVpcEndpointServiceConfigurationInput) (*ec2.CreateVpcEndpointServiceConfigurationOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVpcEndpointServiceConfigurationWithContext", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.CreateVpcEndpointServiceConfigurationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVpcEndpointServiceConfigurationWithContext indicates an expected call of CreateVpcEndpointServiceConfigurationWithContext.
+func (mr *MockEC2MockRecorder) CreateVpcEndpointServiceConfigurationWithContext(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVpcEndpointServiceConfigurationWithContext", reflect.TypeOf((*MockEC2)(nil).CreateVpcEndpointServiceConfigurationWithContext), arg0, arg1)
+}
+
 // DeleteSecurityGroupWithContext mocks base method.
 func (m *MockEC2) DeleteSecurityGroupWithContext(arg0 context.Context, arg1 *ec2.DeleteSecurityGroupInput) (*ec2.DeleteSecurityGroupOutput, error) {
 	m.ctrl.T.Helper()
@@ -111,6 +156,36 @@ func (mr *MockEC2MockRecorder) DeleteTagsWithContext(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTagsWithContext", reflect.TypeOf((*MockEC2)(nil).DeleteTagsWithContext), arg0, arg1)
 }
 
+// DeleteVpcEndpointServiceConfigurationsWithContext mocks base method.
+func (m *MockEC2) DeleteVpcEndpointServiceConfigurationsWithContext(arg0 context.Context, arg1 *ec2.DeleteVpcEndpointServiceConfigurationsInput) (*ec2.DeleteVpcEndpointServiceConfigurationsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVpcEndpointServiceConfigurationsWithContext", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.DeleteVpcEndpointServiceConfigurationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVpcEndpointServiceConfigurationsWithContext indicates an expected call of DeleteVpcEndpointServiceConfigurationsWithContext.
+func (mr *MockEC2MockRecorder) DeleteVpcEndpointServiceConfigurationsWithContext(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVpcEndpointServiceConfigurationsWithContext", reflect.TypeOf((*MockEC2)(nil).DeleteVpcEndpointServiceConfigurationsWithContext), arg0, arg1)
+}
+
+// DescribeAddressesWithContext mocks base method.
+func (m *MockEC2) DescribeAddressesWithContext(arg0 context.Context, arg1 *ec2.DescribeAddressesInput) (*ec2.DescribeAddressesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAddressesWithContext", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.DescribeAddressesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeAddressesWithContext indicates an expected call of DescribeAddressesWithContext.
+func (mr *MockEC2MockRecorder) DescribeAddressesWithContext(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAddressesWithContext", reflect.TypeOf((*MockEC2)(nil).DescribeAddressesWithContext), arg0, arg1)
+}
+
 // DescribeAvailabilityZonesWithContext mocks base method.
 func (m *MockEC2) DescribeAvailabilityZonesWithContext(arg0 context.Context, arg1 *ec2.DescribeAvailabilityZonesInput) (*ec2.DescribeAvailabilityZonesOutput, error) {
 	m.ctrl.T.Helper()
@@ -231,6 +306,51 @@ func (mr *MockEC2MockRecorder) DescribeVPCsAsList(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVPCsAsList", reflect.TypeOf((*MockEC2)(nil).DescribeVPCsAsList), arg0, arg1)
 }
 
+// DescribeVpcEndpointConnectionsAsList mocks base method.
+func (m *MockEC2) DescribeVpcEndpointConnectionsAsList(arg0 context.Context, arg1 *ec2.DescribeVpcEndpointConnectionsInput) ([]types.VpcEndpointConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeVpcEndpointConnectionsAsList", arg0, arg1)
+	ret0, _ := ret[0].([]types.VpcEndpointConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeVpcEndpointConnectionsAsList indicates an expected call of DescribeVpcEndpointConnectionsAsList.
+func (mr *MockEC2MockRecorder) DescribeVpcEndpointConnectionsAsList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVpcEndpointConnectionsAsList", reflect.TypeOf((*MockEC2)(nil).DescribeVpcEndpointConnectionsAsList), arg0, arg1)
+}
+
+// DescribeVpcEndpointServiceConfigurationsAsList mocks base method.
+func (m *MockEC2) DescribeVpcEndpointServiceConfigurationsAsList(arg0 context.Context, arg1 *ec2.DescribeVpcEndpointServiceConfigurationsInput) ([]types.ServiceConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeVpcEndpointServiceConfigurationsAsList", arg0, arg1)
+	ret0, _ := ret[0].([]types.ServiceConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeVpcEndpointServiceConfigurationsAsList indicates an expected call of DescribeVpcEndpointServiceConfigurationsAsList.
+func (mr *MockEC2MockRecorder) DescribeVpcEndpointServiceConfigurationsAsList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVpcEndpointServiceConfigurationsAsList", reflect.TypeOf((*MockEC2)(nil).DescribeVpcEndpointServiceConfigurationsAsList), arg0, arg1)
+}
+
+// DescribeVpcEndpointServicePermissionsAsList mocks base method.
+func (m *MockEC2) DescribeVpcEndpointServicePermissionsAsList(arg0 context.Context, arg1 *ec2.DescribeVpcEndpointServicePermissionsInput) ([]types.AllowedPrincipal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeVpcEndpointServicePermissionsAsList", arg0, arg1)
+	ret0, _ := ret[0].([]types.AllowedPrincipal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeVpcEndpointServicePermissionsAsList indicates an expected call of DescribeVpcEndpointServicePermissionsAsList.
+func (mr *MockEC2MockRecorder) DescribeVpcEndpointServicePermissionsAsList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVpcEndpointServicePermissionsAsList", reflect.TypeOf((*MockEC2)(nil).DescribeVpcEndpointServicePermissionsAsList), arg0, arg1)
+}
+
 // DescribeVpcsWithContext mocks base method.
 func (m *MockEC2) DescribeVpcsWithContext(arg0 context.Context, arg1 *ec2.DescribeVpcsInput) (*ec2.DescribeVpcsOutput, error) {
 	m.ctrl.T.Helper()
@@ -246,6 +366,66 @@ func (mr *MockEC2MockRecorder) DescribeVpcsWithContext(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVpcsWithContext", reflect.TypeOf((*MockEC2)(nil).DescribeVpcsWithContext), arg0, arg1)
 }
 
+// ModifyVpcEndpointServiceConfigurationWithContext mocks base method.
+func (m *MockEC2) ModifyVpcEndpointServiceConfigurationWithContext(arg0 context.Context, arg1 *ec2.ModifyVpcEndpointServiceConfigurationInput) (*ec2.ModifyVpcEndpointServiceConfigurationOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyVpcEndpointServiceConfigurationWithContext", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.ModifyVpcEndpointServiceConfigurationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyVpcEndpointServiceConfigurationWithContext indicates an expected call of ModifyVpcEndpointServiceConfigurationWithContext.
+func (mr *MockEC2MockRecorder) ModifyVpcEndpointServiceConfigurationWithContext(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyVpcEndpointServiceConfigurationWithContext", reflect.TypeOf((*MockEC2)(nil).ModifyVpcEndpointServiceConfigurationWithContext), arg0, arg1)
+}
+
+// ModifyVpcEndpointServicePermissionsWithContext mocks base method.
+func (m *MockEC2) ModifyVpcEndpointServicePermissionsWithContext(arg0 context.Context, arg1 *ec2.ModifyVpcEndpointServicePermissionsInput) (*ec2.ModifyVpcEndpointServicePermissionsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyVpcEndpointServicePermissionsWithContext", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.ModifyVpcEndpointServicePermissionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyVpcEndpointServicePermissionsWithContext indicates an expected call of ModifyVpcEndpointServicePermissionsWithContext.
+func (mr *MockEC2MockRecorder) ModifyVpcEndpointServicePermissionsWithContext(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyVpcEndpointServicePermissionsWithContext", reflect.TypeOf((*MockEC2)(nil).ModifyVpcEndpointServicePermissionsWithContext), arg0, arg1)
+}
+
+// RejectVpcEndpointConnectionsWithContext mocks base method.
+func (m *MockEC2) RejectVpcEndpointConnectionsWithContext(arg0 context.Context, arg1 *ec2.RejectVpcEndpointConnectionsInput) (*ec2.RejectVpcEndpointConnectionsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectVpcEndpointConnectionsWithContext", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.RejectVpcEndpointConnectionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RejectVpcEndpointConnectionsWithContext indicates an expected call of RejectVpcEndpointConnectionsWithContext.
+func (mr *MockEC2MockRecorder) RejectVpcEndpointConnectionsWithContext(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectVpcEndpointConnectionsWithContext", reflect.TypeOf((*MockEC2)(nil).RejectVpcEndpointConnectionsWithContext), arg0, arg1)
+}
+
+// RevokeSecurityGroupEgressWithContext mocks base method.
+func (m *MockEC2) RevokeSecurityGroupEgressWithContext(arg0 context.Context, arg1 *ec2.RevokeSecurityGroupEgressInput) (*ec2.RevokeSecurityGroupEgressOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSecurityGroupEgressWithContext", arg0, arg1)
+	ret0, _ := ret[0].(*ec2.RevokeSecurityGroupEgressOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeSecurityGroupEgressWithContext indicates an expected call of RevokeSecurityGroupEgressWithContext.
+func (mr *MockEC2MockRecorder) RevokeSecurityGroupEgressWithContext(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSecurityGroupEgressWithContext", reflect.TypeOf((*MockEC2)(nil).RevokeSecurityGroupEgressWithContext), arg0, arg1)
+}
+
 // RevokeSecurityGroupIngressWithContext mocks base method.
 func (m *MockEC2) RevokeSecurityGroupIngressWithContext(arg0 context.Context, arg1 *ec2.RevokeSecurityGroupIngressInput) (*ec2.RevokeSecurityGroupIngressOutput, error) {
 	m.ctrl.T.Helper()
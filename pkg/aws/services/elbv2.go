@@ -55,6 +55,17 @@ type ELBV2 interface {
 	RegisterTargetsWithContext(ctx context.Context, input *elasticloadbalancingv2.RegisterTargetsInput) (*elasticloadbalancingv2.RegisterTargetsOutput, error)
 	DeregisterTargetsWithContext(ctx context.Context, input *elasticloadbalancingv2.DeregisterTargetsInput) (*elasticloadbalancingv2.DeregisterTargetsOutput, error)
 	DescribeTrustStoresWithContext(ctx context.Context, input *elasticloadbalancingv2.DescribeTrustStoresInput) (*elasticloadbalancingv2.DescribeTrustStoresOutput, error)
+
+	// wrapper to DescribeTrustStoresPagesWithContext API, which aggregates paged results into list.
+	DescribeTrustStoresAsList(ctx context.Context, input *elasticloadbalancingv2.DescribeTrustStoresInput) ([]types.TrustStore, error)
+	CreateTrustStoreWithContext(ctx context.Context, input *elasticloadbalancingv2.CreateTrustStoreInput) (*elasticloadbalancingv2.CreateTrustStoreOutput, error)
+	ModifyTrustStoreWithContext(ctx context.Context, input *elasticloadbalancingv2.ModifyTrustStoreInput) (*elasticloadbalancingv2.ModifyTrustStoreOutput, error)
+	DeleteTrustStoreWithContext(ctx context.Context, input *elasticloadbalancingv2.DeleteTrustStoreInput) (*elasticloadbalancingv2.DeleteTrustStoreOutput, error)
+
+	// wrapper to DescribeTrustStoreRevocationsPagesWithContext API, which aggregates paged results into list.
+	DescribeTrustStoreRevocationsAsList(ctx context.Context, input *elasticloadbalancingv2.DescribeTrustStoreRevocationsInput) ([]types.DescribeTrustStoreRevocation, error)
+	AddTrustStoreRevocationsWithContext(ctx context.Context, input *elasticloadbalancingv2.AddTrustStoreRevocationsInput) (*elasticloadbalancingv2.AddTrustStoreRevocationsOutput, error)
+	RemoveTrustStoreRevocationsWithContext(ctx context.Context, input *elasticloadbalancingv2.RemoveTrustStoreRevocationsInput) (*elasticloadbalancingv2.RemoveTrustStoreRevocationsOutput, error)
 	RemoveListenerCertificatesWithContext(ctx context.Context, input *elasticloadbalancingv2.RemoveListenerCertificatesInput) (*elasticloadbalancingv2.RemoveListenerCertificatesOutput, error)
 	AddListenerCertificatesWithContext(ctx context.Context, input *elasticloadbalancingv2.AddListenerCertificatesInput) (*elasticloadbalancingv2.AddListenerCertificatesOutput, error)
 	DescribeListenerAttributesWithContext(ctx context.Context, input *elasticloadbalancingv2.DescribeListenerAttributesInput) (*elasticloadbalancingv2.DescribeListenerAttributesOutput, error)
@@ -152,6 +163,80 @@ func (c *elbv2Client) DescribeTrustStoresWithContext(ctx context.Context, input
 	return client.DescribeTrustStores(ctx, input)
 }
 
+func (c *elbv2Client) DescribeTrustStoresAsList(ctx context.Context, input *elasticloadbalancingv2.DescribeTrustStoresInput) ([]types.TrustStore, error) {
+	var result []types.TrustStore
+	client, err := c.getClient(ctx, "DescribeTrustStores")
+	if err != nil {
+		return nil, err
+	}
+	paginator := elasticloadbalancingv2.NewDescribeTrustStoresPaginator(client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, output.TrustStores...)
+	}
+	return result, nil
+}
+
+func (c *elbv2Client) CreateTrustStoreWithContext(ctx context.Context, input *elasticloadbalancingv2.CreateTrustStoreInput) (*elasticloadbalancingv2.CreateTrustStoreOutput, error) {
+	client, err := c.getClient(ctx, "CreateTrustStore")
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateTrustStore(ctx, input)
+}
+
+func (c *elbv2Client) ModifyTrustStoreWithContext(ctx context.Context, input *elasticloadbalancingv2.ModifyTrustStoreInput) (*elasticloadbalancingv2.ModifyTrustStoreOutput, error) {
+	client, err := c.getClient(ctx, "ModifyTrustStore")
+	if err != nil {
+		return nil, err
+	}
+	return client.ModifyTrustStore(ctx, input)
+}
+
+func (c *elbv2Client) DeleteTrustStoreWithContext(ctx context.Context, input *elasticloadbalancingv2.DeleteTrustStoreInput) (*elasticloadbalancingv2.DeleteTrustStoreOutput, error) {
+	client, err := c.getClient(ctx, "DeleteTrustStore")
+	if err != nil {
+		return nil, err
+	}
+	return client.DeleteTrustStore(ctx, input)
+}
+
+func (c *elbv2Client) DescribeTrustStoreRevocationsAsList(ctx context.Context, input *elasticloadbalancingv2.DescribeTrustStoreRevocationsInput) ([]types.DescribeTrustStoreRevocation, error) {
+	var result []types.DescribeTrustStoreRevocation
+	client, err := c.getClient(ctx, "DescribeTrustStoreRevocations")
+	if err != nil {
+		return nil, err
+	}
+	paginator := elasticloadbalancingv2.NewDescribeTrustStoreRevocationsPaginator(client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, output.TrustStoreRevocations...)
+	}
+	return result, nil
+}
+
+func (c *elbv2Client) AddTrustStoreRevocationsWithContext(ctx context.Context, input *elasticloadbalancingv2.AddTrustStoreRevocationsInput) (*elasticloadbalancingv2.AddTrustStoreRevocationsOutput, error) {
+	client, err := c.getClient(ctx, "AddTrustStoreRevocations")
+	if err != nil {
+		return nil, err
+	}
+	return client.AddTrustStoreRevocations(ctx, input)
+}
+
+func (c *elbv2Client) RemoveTrustStoreRevocationsWithContext(ctx context.Context, input *elasticloadbalancingv2.RemoveTrustStoreRevocationsInput) (*elasticloadbalancingv2.RemoveTrustStoreRevocationsOutput, error) {
+	client, err := c.getClient(ctx, "RemoveTrustStoreRevocations")
+	if err != nil {
+		return nil, err
+	}
+	return client.RemoveTrustStoreRevocations(ctx, input)
+}
+
 func (c *elbv2Client) ModifyRuleWithContext(ctx context.Context, input *elasticloadbalancingv2.ModifyRuleInput) (*elasticloadbalancingv2.ModifyRuleOutput, error) {
 	client, err := c.getClient(ctx, "ModifyRule")
 	if err != nil {
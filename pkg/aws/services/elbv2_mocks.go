@@ -66,6 +66,21 @@ func (mr *MockELBV2MockRecorder) AddTagsWithContext(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTagsWithContext", reflect.TypeOf((*MockELBV2)(nil).AddTagsWithContext), arg0, arg1)
 }
 
+// AddTrustStoreRevocationsWithContext mocks base method.
+func (m *MockELBV2) AddTrustStoreRevocationsWithContext(arg0 context.Context, arg1 *elasticloadbalancingv2.AddTrustStoreRevocationsInput) (*elasticloadbalancingv2.AddTrustStoreRevocationsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTrustStoreRevocationsWithContext", arg0, arg1)
+	ret0, _ := ret[0].(*elasticloadbalancingv2.AddTrustStoreRevocationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTrustStoreRevocationsWithContext indicates an expected call of AddTrustStoreRevocationsWithContext.
+func (mr *MockELBV2MockRecorder) AddTrustStoreRevocationsWithContext(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTrustStoreRevocationsWithContext", reflect.TypeOf((*MockELBV2)(nil).AddTrustStoreRevocationsWithContext), arg0, arg1)
+}
+
 // AssumeRole mocks base method.
 func (m *MockELBV2) AssumeRole(arg0 context.Context, arg1, arg2 string) (ELBV2, error) {
 	m.ctrl.T.Helper()
@@ -141,6 +156,21 @@ func (mr *MockELBV2MockRecorder) CreateTargetGroupWithContext(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTargetGroupWithContext", reflect.TypeOf((*MockELBV2)(nil).CreateTargetGroupWithContext), arg0, arg1)
 }
 
+// CreateTrustStoreWithContext mocks base method.
+func (m *MockELBV2) CreateTrustStoreWithContext(arg0 context.Context, arg1 *elasticloadbalancingv2.CreateTrustStoreInput) (*elasticloadbalancingv2.CreateTrustStoreOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTrustStoreWithContext", arg0, arg1)
+	ret0, _ := ret[0].(*elasticloadbalancingv2.CreateTrustStoreOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTrustStoreWithContext indicates an expected call of CreateTrustStoreWithContext.
+func (mr *MockELBV2MockRecorder) CreateTrustStoreWithContext(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTrustStoreWithContext", reflect.TypeOf((*MockELBV2)(nil).CreateTrustStoreWithContext), arg0, arg1)
+}
+
 // DeleteListenerWithContext mocks base method.
 func (m *MockELBV2) DeleteListenerWithContext(arg0 context.Context, arg1 *elasticloadbalancingv2.DeleteListenerInput) (*elasticloadbalancingv2.DeleteListenerOutput, error) {
 	m.ctrl.T.Helper()
@@ -201,6 +231,21 @@ func (mr *MockELBV2MockRecorder) DeleteTargetGroupWithContext(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTargetGroupWithContext", reflect.TypeOf((*MockELBV2)(nil).DeleteTargetGroupWithContext), arg0, arg1)
 }
 
+// DeleteTrustStoreWithContext mocks base method.
+func (m *MockELBV2) DeleteTrustStoreWithContext(arg0 context.Context, arg1 *elasticloadbalancingv2.DeleteTrustStoreInput) (*elasticloadbalancingv2.DeleteTrustStoreOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTrustStoreWithContext", arg0, arg1)
+	ret0, _ := ret[0].(*elasticloadbalancingv2.DeleteTrustStoreOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTrustStoreWithContext indicates an expected call of DeleteTrustStoreWithContext.
+func (mr *MockELBV2MockRecorder) DeleteTrustStoreWithContext(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrustStoreWithContext", reflect.TypeOf((*MockELBV2)(nil).DeleteTrustStoreWithContext), arg0, arg1)
+}
+
 // DeregisterTargetsWithContext mocks base method.
 func (m *MockELBV2) DeregisterTargetsWithContext(arg0 context.Context, arg1 *elasticloadbalancingv2.DeregisterTargetsInput) (*elasticloadbalancingv2.DeregisterTargetsOutput, error) {
 	m.ctrl.T.Helper()
@@ -441,6 +486,36 @@ func (mr *MockELBV2MockRecorder) DescribeTargetHealthWithContext(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTargetHealthWithContext", reflect.TypeOf((*MockELBV2)(nil).DescribeTargetHealthWithContext), arg0, arg1)
 }
 
+// DescribeTrustStoreRevocationsAsList mocks base method.
+func (m *MockELBV2) DescribeTrustStoreRevocationsAsList(arg0 context.Context, arg1 *elasticloadbalancingv2.DescribeTrustStoreRevocationsInput) ([]types.DescribeTrustStoreRevocation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTrustStoreRevocationsAsList", arg0, arg1)
+	ret0, _ := ret[0].([]types.DescribeTrustStoreRevocation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTrustStoreRevocationsAsList indicates an expected call of DescribeTrustStoreRevocationsAsList.
+func (mr *MockELBV2MockRecorder) DescribeTrustStoreRevocationsAsList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTrustStoreRevocationsAsList", reflect.TypeOf((*MockELBV2)(nil).DescribeTrustStoreRevocationsAsList), arg0, arg1)
+}
+
+// DescribeTrustStoresAsList mocks base method.
+func (m *MockELBV2) DescribeTrustStoresAsList(arg0 context.Context, arg1 *elasticloadbalancingv2.DescribeTrustStoresInput) ([]types.TrustStore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTrustStoresAsList", arg0, arg1)
+	ret0, _ := ret[0].([]types.TrustStore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTrustStoresAsList indicates an expected call of DescribeTrustStoresAsList.
+func (mr *MockELBV2MockRecorder) DescribeTrustStoresAsList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTrustStoresAsList", reflect.TypeOf((*MockELBV2)(nil).DescribeTrustStoresAsList), arg0, arg1)
+}
+
 // DescribeTrustStoresWithContext mocks base method.
 func (m *MockELBV2) DescribeTrustStoresWithContext(arg0 context.Context, arg1 *elasticloadbalancingv2.DescribeTrustStoresInput) (*elasticloadbalancingv2.DescribeTrustStoresOutput, error) {
 	m.ctrl.T.Helper()
@@ -576,6 +651,21 @@ func (mr *MockELBV2MockRecorder) ModifyTargetGroupWithContext(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyTargetGroupWithContext", reflect.TypeOf((*MockELBV2)(nil).ModifyTargetGroupWithContext), arg0, arg1)
 }
 
+// ModifyTrustStoreWithContext mocks base method.
+func (m *MockELBV2) ModifyTrustStoreWithContext(arg0 context.Context, arg1 *elasticloadbalancingv2.ModifyTrustStoreInput) (*elasticloadbalancingv2.ModifyTrustStoreOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyTrustStoreWithContext", arg0, arg1)
+	ret0, _ := ret[0].(*elasticloadbalancingv2.ModifyTrustStoreOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyTrustStoreWithContext indicates an expected call of ModifyTrustStoreWithContext.
+func (mr *MockELBV2MockRecorder) ModifyTrustStoreWithContext(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyTrustStoreWithContext", reflect.TypeOf((*MockELBV2)(nil).ModifyTrustStoreWithContext), arg0, arg1)
+}
+
 // RegisterTargetsWithContext mocks base method.
 func (m *MockELBV2) RegisterTargetsWithContext(arg0 context.Context, arg1 *elasticloadbalancingv2.RegisterTargetsInput) (*elasticloadbalancingv2.RegisterTargetsOutput, error) {
 	m.ctrl.T.Helper()
@@ -621,6 +711,21 @@ func (mr *MockELBV2MockRecorder) RemoveTagsWithContext(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTagsWithContext", reflect.TypeOf((*MockELBV2)(nil).RemoveTagsWithContext), arg0, arg1)
 }
 
+// RemoveTrustStoreRevocationsWithContext mocks base method.
+func (m *MockELBV2) RemoveTrustStoreRevocationsWithContext(arg0 context.Context, arg1 *elasticloadbalancingv2.RemoveTrustStoreRevocationsInput) (*elasticloadbalancingv2.RemoveTrustStoreRevocationsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTrustStoreRevocationsWithContext", arg0, arg1)
+	ret0, _ := ret[0].(*elasticloadbalancingv2.RemoveTrustStoreRevocationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveTrustStoreRevocationsWithContext indicates an expected call of RemoveTrustStoreRevocationsWithContext.
+func (mr *MockELBV2MockRecorder) RemoveTrustStoreRevocationsWithContext(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTrustStoreRevocationsWithContext", reflect.TypeOf((*MockELBV2)(nil).RemoveTrustStoreRevocationsWithContext), arg0, arg1)
+}
+
 // SetIpAddressTypeWithContext mocks base method.
 func (m *MockELBV2) SetIpAddressTypeWithContext(arg0 context.Context, arg1 *elasticloadbalancingv2.SetIpAddressTypeInput) (*elasticloadbalancingv2.SetIpAddressTypeOutput, error) {
 	m.ctrl.T.Helper()
@@ -70,6 +70,18 @@ type GlobalAccelerator interface {
 
 	// RemoveEndpoints removes endpoints from an endpoint group.
 	RemoveEndpointsWithContext(ctx context.Context, input *globalaccelerator.RemoveEndpointsInput) (*globalaccelerator.RemoveEndpointsOutput, error)
+
+	// DescribeAcceleratorAttributes describes the attributes of an accelerator.
+	DescribeAcceleratorAttributesWithContext(ctx context.Context, input *globalaccelerator.DescribeAcceleratorAttributesInput) (*globalaccelerator.DescribeAcceleratorAttributesOutput, error)
+
+	// UpdateAcceleratorAttributes updates the attributes of an accelerator.
+	UpdateAcceleratorAttributesWithContext(ctx context.Context, input *globalaccelerator.UpdateAcceleratorAttributesInput) (*globalaccelerator.UpdateAcceleratorAttributesOutput, error)
+
+	// wrapper to ListByoipCidrs API, which aggregates paged results into list.
+	ListByoipCidrsAsList(ctx context.Context, input *globalaccelerator.ListByoipCidrsInput) ([]types.ByoipCidr, error)
+
+	// wrapper to ListCrossAccountResources API, which aggregates paged results into list.
+	ListCrossAccountResourcesAsList(ctx context.Context, input *globalaccelerator.ListCrossAccountResourcesInput) ([]types.CrossAccountResource, error)
 }
 
 // NewGlobalAccelerator constructs new GlobalAccelerator implementation.
@@ -278,3 +290,53 @@ func (c *defaultGlobalAccelerator) RemoveEndpointsWithContext(ctx context.Contex
 	}
 	return client.RemoveEndpoints(ctx, input)
 }
+
+func (c *defaultGlobalAccelerator) DescribeAcceleratorAttributesWithContext(ctx context.Context, input *globalaccelerator.DescribeAcceleratorAttributesInput) (*globalaccelerator.DescribeAcceleratorAttributesOutput, error) {
+	client, err := c.awsClientsProvider.GetGlobalAcceleratorClient(ctx, "DescribeAcceleratorAttributes")
+	if err != nil {
+		return nil, err
+	}
+	return client.DescribeAcceleratorAttributes(ctx, input)
+}
+
+func (c *defaultGlobalAccelerator) UpdateAcceleratorAttributesWithContext(ctx context.Context, input *globalaccelerator.UpdateAcceleratorAttributesInput) (*globalaccelerator.UpdateAcceleratorAttributesOutput, error) {
+	client, err := c.awsClientsProvider.GetGlobalAcceleratorClient(ctx, "UpdateAcceleratorAttributes")
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateAcceleratorAttributes(ctx, input)
+}
+
+func (c *defaultGlobalAccelerator) ListByoipCidrsAsList(ctx context.Context, input *globalaccelerator.ListByoipCidrsInput) ([]types.ByoipCidr, error) {
+	var result []types.ByoipCidr
+	client, err := c.awsClientsProvider.GetGlobalAcceleratorClient(ctx, "ListByoipCidrs")
+	if err != nil {
+		return nil, err
+	}
+	paginator := globalaccelerator.NewListByoipCidrsPaginator(client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, output.ByoipCidrs...)
+	}
+	return result, nil
+}
+
+func (c *defaultGlobalAccelerator) ListCrossAccountResourcesAsList(ctx context.Context, input *globalaccelerator.ListCrossAccountResourcesInput) ([]types.CrossAccountResource, error) {
+	var result []types.CrossAccountResource
+	client, err := c.awsClientsProvider.GetGlobalAcceleratorClient(ctx, "ListCrossAccountResources")
+	if err != nil {
+		return nil, err
+	}
+	paginator := globalaccelerator.NewListCrossAccountResourcesPaginator(client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, output.CrossAccountResources...)
+	}
+	return result, nil
+}
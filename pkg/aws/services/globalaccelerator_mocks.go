@@ -141,6 +141,21 @@ func (mr *MockGlobalAcceleratorMockRecorder) DeleteListenerWithContext(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteListenerWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).DeleteListenerWithContext), arg0, arg1)
 }
 
+// DescribeAcceleratorAttributesWithContext mocks base method.
+func (m *MockGlobalAccelerator) DescribeAcceleratorAttributesWithContext(arg0 context.Context, arg1 *globalaccelerator.DescribeAcceleratorAttributesInput) (*globalaccelerator.DescribeAcceleratorAttributesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAcceleratorAttributesWithContext", arg0, arg1)
+	ret0, _ := ret[0].(*globalaccelerator.DescribeAcceleratorAttributesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeAcceleratorAttributesWithContext indicates an expected call of DescribeAcceleratorAttributesWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) DescribeAcceleratorAttributesWithContext(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAcceleratorAttributesWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).DescribeAcceleratorAttributesWithContext), arg0, arg1)
+}
+
 // DescribeAcceleratorWithContext mocks base method.
 func (m *MockGlobalAccelerator) DescribeAcceleratorWithContext(arg0 context.Context, arg1 *globalaccelerator.DescribeAcceleratorInput) (*globalaccelerator.DescribeAcceleratorOutput, error) {
 	m.ctrl.T.Helper()
@@ -201,6 +216,36 @@ func (mr *MockGlobalAcceleratorMockRecorder) ListAcceleratorsAsList(arg0, arg1 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAcceleratorsAsList", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListAcceleratorsAsList), arg0, arg1)
 }
 
+// ListByoipCidrsAsList mocks base method.
+func (m *MockGlobalAccelerator) ListByoipCidrsAsList(arg0 context.Context, arg1 *globalaccelerator.ListByoipCidrsInput) ([]types.ByoipCidr, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByoipCidrsAsList", arg0, arg1)
+	ret0, _ := ret[0].([]types.ByoipCidr)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByoipCidrsAsList indicates an expected call of ListByoipCidrsAsList.
+func (mr *MockGlobalAcceleratorMockRecorder) ListByoipCidrsAsList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByoipCidrsAsList", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListByoipCidrsAsList), arg0, arg1)
+}
+
+// ListCrossAccountResourcesAsList mocks base method.
+func (m *MockGlobalAccelerator) ListCrossAccountResourcesAsList(arg0 context.Context, arg1 *globalaccelerator.ListCrossAccountResourcesInput) ([]types.CrossAccountResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCrossAccountResourcesAsList", arg0, arg1)
+	ret0, _ := ret[0].([]types.CrossAccountResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCrossAccountResourcesAsList indicates an expected call of ListCrossAccountResourcesAsList.
+func (mr *MockGlobalAcceleratorMockRecorder) ListCrossAccountResourcesAsList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCrossAccountResourcesAsList", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListCrossAccountResourcesAsList), arg0, arg1)
+}
+
 // ListEndpointGroupsAsList mocks base method.
 func (m *MockGlobalAccelerator) ListEndpointGroupsAsList(arg0 context.Context, arg1 *globalaccelerator.ListEndpointGroupsInput) ([]types.EndpointGroup, error) {
 	m.ctrl.T.Helper()
@@ -306,6 +351,21 @@ func (mr *MockGlobalAcceleratorMockRecorder) UntagResourceWithContext(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagResourceWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).UntagResourceWithContext), arg0, arg1)
 }
 
+// UpdateAcceleratorAttributesWithContext mocks base method.
+func (m *MockGlobalAccelerator) UpdateAcceleratorAttributesWithContext(arg0 context.Context, arg1 *globalaccelerator.UpdateAcceleratorAttributesInput) (*globalaccelerator.UpdateAcceleratorAttributesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAcceleratorAttributesWithContext", arg0, arg1)
+	ret0, _ := ret[0].(*globalaccelerator.UpdateAcceleratorAttributesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAcceleratorAttributesWithContext indicates an expected call of UpdateAcceleratorAttributesWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) UpdateAcceleratorAttributesWithContext(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAcceleratorAttributesWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).UpdateAcceleratorAttributesWithContext), arg0, arg1)
+}
+
 // UpdateAcceleratorWithContext mocks base method.
 func (m *MockGlobalAccelerator) UpdateAcceleratorWithContext(arg0 context.Context, arg1 *globalaccelerator.UpdateAcceleratorInput) (*globalaccelerator.UpdateAcceleratorOutput, error) {
 	m.ctrl.T.Helper()
@@ -12,10 +12,14 @@ const (
 	ResourceTypeELBTargetGroup    = "elasticloadbalancing:targetgroup"
 	ResourceTypeELBLoadBalancer   = "elasticloadbalancing:loadbalancer"
 	ResourceTypeGlobalAccelerator = "globalaccelerator:accelerator"
+	ResourceTypeCloudWatchAlarm   = "cloudwatch:alarm"
 )
 
 type RGT interface {
 	GetResourcesAsList(ctx context.Context, input *resourcegroupstaggingapi.GetResourcesInput) ([]rgttypes.ResourceTagMapping, error)
+
+	// wrapper to GetResourcesAsList for resources in a region other than the controller's region.
+	GetResourcesInRegionAsList(ctx context.Context, region string, input *resourcegroupstaggingapi.GetResourcesInput) ([]rgttypes.ResourceTagMapping, error)
 }
 
 // NewRGT constructs new RGT implementation.
@@ -46,6 +50,26 @@ func (c *rgtClient) GetResourcesAsList(ctx context.Context, input *resourcegroup
 	return result, nil
 }
 
+func (c *rgtClient) GetResourcesInRegionAsList(ctx context.Context, region string, input *resourcegroupstaggingapi.GetResourcesInput) ([]rgttypes.ResourceTagMapping, error) {
+	client, err := c.awsClientsProvider.GetRGTClient(ctx, "GetResources")
+	if err != nil {
+		return nil, err
+	}
+	withRegion := func(o *resourcegroupstaggingapi.Options) {
+		o.Region = region
+	}
+	var result []rgttypes.ResourceTagMapping
+	paginator := resourcegroupstaggingapi.NewGetResourcesPaginator(client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx, withRegion)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, output.ResourceTagMappingList...)
+	}
+	return result, nil
+}
+
 func ParseRGTTags(tags []rgttypes.Tag) map[string]string {
 	result := make(map[string]string, len(tags))
 	for _, tag := range tags {
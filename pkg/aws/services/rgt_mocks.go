@@ -50,3 +50,18 @@ func (mr *MockRGTMockRecorder) GetResourcesAsList(arg0, arg1 interface{}) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourcesAsList", reflect.TypeOf((*MockRGT)(nil).GetResourcesAsList), arg0, arg1)
 }
+
+// GetResourcesInRegionAsList mocks base method.
+func (m *MockRGT) GetResourcesInRegionAsList(arg0 context.Context, arg1 string, arg2 *resourcegroupstaggingapi.GetResourcesInput) ([]types.ResourceTagMapping, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResourcesInRegionAsList", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]types.ResourceTagMapping)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResourcesInRegionAsList indicates an expected call of GetResourcesInRegionAsList.
+func (mr *MockRGTMockRecorder) GetResourcesInRegionAsList(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourcesInRegionAsList", reflect.TypeOf((*MockRGT)(nil).GetResourcesInRegionAsList), arg0, arg1, arg2)
+}
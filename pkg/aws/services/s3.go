@@ -0,0 +1,86 @@
+package services
+
+import (
+	"context"
+
+	s3sdk "github.com/aws/aws-sdk-go-v2/service/s3"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/provider"
+)
+
+type S3 interface {
+	PutObjectWithContext(ctx context.Context, input *s3sdk.PutObjectInput) (*s3sdk.PutObjectOutput, error)
+	DeleteObjectWithContext(ctx context.Context, input *s3sdk.DeleteObjectInput) (*s3sdk.DeleteObjectOutput, error)
+	HeadBucketWithContext(ctx context.Context, input *s3sdk.HeadBucketInput) (*s3sdk.HeadBucketOutput, error)
+	CreateBucketWithContext(ctx context.Context, input *s3sdk.CreateBucketInput) (*s3sdk.CreateBucketOutput, error)
+	GetBucketPolicyWithContext(ctx context.Context, input *s3sdk.GetBucketPolicyInput) (*s3sdk.GetBucketPolicyOutput, error)
+	PutBucketPolicyWithContext(ctx context.Context, input *s3sdk.PutBucketPolicyInput) (*s3sdk.PutBucketPolicyOutput, error)
+	PutBucketLifecycleConfigurationWithContext(ctx context.Context, input *s3sdk.PutBucketLifecycleConfigurationInput) (*s3sdk.PutBucketLifecycleConfigurationOutput, error)
+}
+
+// NewS3 constructs new S3 implementation.
+func NewS3(awsClientsProvider provider.AWSClientsProvider) S3 {
+	return &s3Client{
+		awsClientsProvider: awsClientsProvider,
+	}
+}
+
+// default implementation for S3.
+type s3Client struct {
+	awsClientsProvider provider.AWSClientsProvider
+}
+
+func (c *s3Client) PutObjectWithContext(ctx context.Context, input *s3sdk.PutObjectInput) (*s3sdk.PutObjectOutput, error) {
+	client, err := c.awsClientsProvider.GetS3Client(ctx, "PutObject")
+	if err != nil {
+		return nil, err
+	}
+	return client.PutObject(ctx, input)
+}
+
+func (c *s3Client) DeleteObjectWithContext(ctx context.Context, input *s3sdk.DeleteObjectInput) (*s3sdk.DeleteObjectOutput, error) {
+	client, err := c.awsClientsProvider.GetS3Client(ctx, "DeleteObject")
+	if err != nil {
+		return nil, err
+	}
+	return client.DeleteObject(ctx, input)
+}
+
+func (c *s3Client) HeadBucketWithContext(ctx context.Context, input *s3sdk.HeadBucketInput) (*s3sdk.HeadBucketOutput, error) {
+	client, err := c.awsClientsProvider.GetS3Client(ctx, "HeadBucket")
+	if err != nil {
+		return nil, err
+	}
+	return client.HeadBucket(ctx, input)
+}
+
+func (c *s3Client) CreateBucketWithContext(ctx context.Context, input *s3sdk.CreateBucketInput) (*s3sdk.CreateBucketOutput, error) {
+	client, err := c.awsClientsProvider.GetS3Client(ctx, "CreateBucket")
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateBucket(ctx, input)
+}
+
+func (c *s3Client) GetBucketPolicyWithContext(ctx context.Context, input *s3sdk.GetBucketPolicyInput) (*s3sdk.GetBucketPolicyOutput, error) {
+	client, err := c.awsClientsProvider.GetS3Client(ctx, "GetBucketPolicy")
+	if err != nil {
+		return nil, err
+	}
+	return client.GetBucketPolicy(ctx, input)
+}
+
+func (c *s3Client) PutBucketPolicyWithContext(ctx context.Context, input *s3sdk.PutBucketPolicyInput) (*s3sdk.PutBucketPolicyOutput, error) {
+	client, err := c.awsClientsProvider.GetS3Client(ctx, "PutBucketPolicy")
+	if err != nil {
+		return nil, err
+	}
+	return client.PutBucketPolicy(ctx, input)
+}
+
+func (c *s3Client) PutBucketLifecycleConfigurationWithContext(ctx context.Context, input *s3sdk.PutBucketLifecycleConfigurationInput) (*s3sdk.PutBucketLifecycleConfigurationOutput, error) {
+	client, err := c.awsClientsProvider.GetS3Client(ctx, "PutBucketLifecycleConfiguration")
+	if err != nil {
+		return nil, err
+	}
+	return client.PutBucketLifecycleConfiguration(ctx, input)
+}
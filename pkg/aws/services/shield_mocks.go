@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	shield "github.com/aws/aws-sdk-go-v2/service/shield"
+	types "github.com/aws/aws-sdk-go-v2/service/shield/types"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -35,6 +36,36 @@ func (m *MockShield) EXPECT() *MockShieldMockRecorder {
 	return m.recorder
 }
 
+// AssociateHealthCheckWithContext mocks base method.
+func (m *MockShield) AssociateHealthCheckWithContext(arg0 context.Context, arg1 *shield.AssociateHealthCheckInput) (*shield.AssociateHealthCheckOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssociateHealthCheckWithContext", arg0, arg1)
+	ret0, _ := ret[0].(*shield.AssociateHealthCheckOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssociateHealthCheckWithContext indicates an expected call of AssociateHealthCheckWithContext.
+func (mr *MockShieldMockRecorder) AssociateHealthCheckWithContext(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssociateHealthCheckWithContext", reflect.TypeOf((*MockShield)(nil).AssociateHealthCheckWithContext), arg0, arg1)
+}
+
+// CreateProtectionGroupWithContext mocks base method.
+func (m *MockShield) CreateProtectionGroupWithContext(arg0 context.Context, arg1 *shield.CreateProtectionGroupInput) (*shield.CreateProtectionGroupOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProtectionGroupWithContext", arg0, arg1)
+	ret0, _ := ret[0].(*shield.CreateProtectionGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProtectionGroupWithContext indicates an expected call of CreateProtectionGroupWithContext.
+func (mr *MockShieldMockRecorder) CreateProtectionGroupWithContext(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProtectionGroupWithContext", reflect.TypeOf((*MockShield)(nil).CreateProtectionGroupWithContext), arg0, arg1)
+}
+
 // CreateProtectionWithContext mocks base method.
 func (m *MockShield) CreateProtectionWithContext(arg0 context.Context, arg1 *shield.CreateProtectionInput) (*shield.CreateProtectionOutput, error) {
 	m.ctrl.T.Helper()
@@ -50,6 +81,21 @@ func (mr *MockShieldMockRecorder) CreateProtectionWithContext(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProtectionWithContext", reflect.TypeOf((*MockShield)(nil).CreateProtectionWithContext), arg0, arg1)
 }
 
+// DeleteProtectionGroupWithContext mocks base method.
+func (m *MockShield) DeleteProtectionGroupWithContext(arg0 context.Context, arg1 *shield.DeleteProtectionGroupInput) (*shield.DeleteProtectionGroupOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProtectionGroupWithContext", arg0, arg1)
+	ret0, _ := ret[0].(*shield.DeleteProtectionGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProtectionGroupWithContext indicates an expected call of DeleteProtectionGroupWithContext.
+func (mr *MockShieldMockRecorder) DeleteProtectionGroupWithContext(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProtectionGroupWithContext", reflect.TypeOf((*MockShield)(nil).DeleteProtectionGroupWithContext), arg0, arg1)
+}
+
 // DeleteProtectionWithContext mocks base method.
 func (m *MockShield) DeleteProtectionWithContext(arg0 context.Context, arg1 *shield.DeleteProtectionInput) (*shield.DeleteProtectionOutput, error) {
 	m.ctrl.T.Helper()
@@ -65,6 +111,21 @@ func (mr *MockShieldMockRecorder) DeleteProtectionWithContext(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProtectionWithContext", reflect.TypeOf((*MockShield)(nil).DeleteProtectionWithContext), arg0, arg1)
 }
 
+// DescribeProtectionGroupWithContext mocks base method.
+func (m *MockShield) DescribeProtectionGroupWithContext(arg0 context.Context, arg1 *shield.DescribeProtectionGroupInput) (*shield.DescribeProtectionGroupOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeProtectionGroupWithContext", arg0, arg1)
+	ret0, _ := ret[0].(*shield.DescribeProtectionGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeProtectionGroupWithContext indicates an expected call of DescribeProtectionGroupWithContext.
+func (mr *MockShieldMockRecorder) DescribeProtectionGroupWithContext(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeProtectionGroupWithContext", reflect.TypeOf((*MockShield)(nil).DescribeProtectionGroupWithContext), arg0, arg1)
+}
+
 // DescribeProtectionWithContext mocks base method.
 func (m *MockShield) DescribeProtectionWithContext(arg0 context.Context, arg1 *shield.DescribeProtectionInput) (*shield.DescribeProtectionOutput, error) {
 	m.ctrl.T.Helper()
@@ -80,6 +141,51 @@ func (mr *MockShieldMockRecorder) DescribeProtectionWithContext(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeProtectionWithContext", reflect.TypeOf((*MockShield)(nil).DescribeProtectionWithContext), arg0, arg1)
 }
 
+// DisableApplicationLayerAutomaticResponseWithContext mocks base method.
+func (m *MockShield) DisableApplicationLayerAutomaticResponseWithContext(arg0 context.Context, arg1 *shield.DisableApplicationLayerAutomaticResponseInput) (*shield.DisableApplicationLayerAutomaticResponseOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableApplicationLayerAutomaticResponseWithContext", arg0, arg1)
+	ret0, _ := ret[0].(*shield.DisableApplicationLayerAutomaticResponseOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableApplicationLayerAutomaticResponseWithContext indicates an expected call of DisableApplicationLayerAutomaticResponseWithContext.
+func (mr *MockShieldMockRecorder) DisableApplicationLayerAutomaticResponseWithContext(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableApplicationLayerAutomaticResponseWithContext", reflect.TypeOf((*MockShield)(nil).DisableApplicationLayerAutomaticResponseWithContext), arg0, arg1)
+}
+
+// DisassociateHealthCheckWithContext mocks base method.
+func (m *MockShield) DisassociateHealthCheckWithContext(arg0 context.Context, arg1 *shield.DisassociateHealthCheckInput) (*shield.DisassociateHealthCheckOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisassociateHealthCheckWithContext", arg0, arg1)
+	ret0, _ := ret[0].(*shield.DisassociateHealthCheckOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisassociateHealthCheckWithContext indicates an expected call of DisassociateHealthCheckWithContext.
+func (mr *MockShieldMockRecorder) DisassociateHealthCheckWithContext(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisassociateHealthCheckWithContext", reflect.TypeOf((*MockShield)(nil).DisassociateHealthCheckWithContext), arg0, arg1)
+}
+
+// EnableApplicationLayerAutomaticResponseWithContext mocks base method.
+func (m *MockShield) EnableApplicationLayerAutomaticResponseWithContext(arg0 context.Context, arg1 *shield.EnableApplicationLayerAutomaticResponseInput) (*shield.EnableApplicationLayerAutomaticResponseOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableApplicationLayerAutomaticResponseWithContext", arg0, arg1)
+	ret0, _ := ret[0].(*shield.EnableApplicationLayerAutomaticResponseOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableApplicationLayerAutomaticResponseWithContext indicates an expected call of EnableApplicationLayerAutomaticResponseWithContext.
+func (mr *MockShieldMockRecorder) EnableApplicationLayerAutomaticResponseWithContext(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableApplicationLayerAutomaticResponseWithContext", reflect.TypeOf((*MockShield)(nil).EnableApplicationLayerAutomaticResponseWithContext), arg0, arg1)
+}
+
 // GetSubscriptionStateWithContext mocks base method.
 func (m *MockShield) GetSubscriptionStateWithContext(arg0 context.Context, arg1 *shield.GetSubscriptionStateInput) (*shield.GetSubscriptionStateOutput, error) {
 	m.ctrl.T.Helper()
@@ -94,3 +200,63 @@ func (mr *MockShieldMockRecorder) GetSubscriptionStateWithContext(arg0, arg1 int
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptionStateWithContext", reflect.TypeOf((*MockShield)(nil).GetSubscriptionStateWithContext), arg0, arg1)
 }
+
+// ListProtectionGroupsAsList mocks base method.
+func (m *MockShield) ListProtectionGroupsAsList(arg0 context.Context, arg1 *shield.ListProtectionGroupsInput) ([]types.ProtectionGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProtectionGroupsAsList", arg0, arg1)
+	ret0, _ := ret[0].([]types.ProtectionGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProtectionGroupsAsList indicates an expected call of ListProtectionGroupsAsList.
+func (mr *MockShieldMockRecorder) ListProtectionGroupsAsList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProtectionGroupsAsList", reflect.TypeOf((*MockShield)(nil).ListProtectionGroupsAsList), arg0, arg1)
+}
+
+// ListTagsForResourceWithContext mocks base method.
+func (m *MockShield) ListTagsForResourceWithContext(arg0 context.Context, arg1 *shield.ListTagsForResourceInput) (*shield.ListTagsForResourceOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTagsForResourceWithContext", arg0, arg1)
+	ret0, _ := ret[0].(*shield.ListTagsForResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTagsForResourceWithContext indicates an expected call of ListTagsForResourceWithContext.
+func (mr *MockShieldMockRecorder) ListTagsForResourceWithContext(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsForResourceWithContext", reflect.TypeOf((*MockShield)(nil).ListTagsForResourceWithContext), arg0, arg1)
+}
+
+// UpdateApplicationLayerAutomaticResponseWithContext mocks base method.
+func (m *MockShield) UpdateApplicationLayerAutomaticResponseWithContext(arg0 context.Context, arg1 *shield.UpdateApplicationLayerAutomaticResponseInput) (*shield.UpdateApplicationLayerAutomaticResponseOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateApplicationLayerAutomaticResponseWithContext", arg0, arg1)
+	ret0, _ := ret[0].(*shield.UpdateApplicationLayerAutomaticResponseOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateApplicationLayerAutomaticResponseWithContext indicates an expected call of UpdateApplicationLayerAutomaticResponseWithContext.
+func (mr *MockShieldMockRecorder) UpdateApplicationLayerAutomaticResponseWithContext(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateApplicationLayerAutomaticResponseWithContext", reflect.TypeOf((*MockShield)(nil).UpdateApplicationLayerAutomaticResponseWithContext), arg0, arg1)
+}
+
+// UpdateProtectionGroupWithContext mocks base method.
+func (m *MockShield) UpdateProtectionGroupWithContext(arg0 context.Context, arg1 *shield.UpdateProtectionGroupInput) (*shield.UpdateProtectionGroupOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProtectionGroupWithContext", arg0, arg1)
+	ret0, _ := ret[0].(*shield.UpdateProtectionGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProtectionGroupWithContext indicates an expected call of UpdateProtectionGroupWithContext.
+func (mr *MockShieldMockRecorder) UpdateProtectionGroupWithContext(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProtectionGroupWithContext", reflect.TypeOf((*MockShield)(nil).UpdateProtectionGroupWithContext), arg0, arg1)
+}
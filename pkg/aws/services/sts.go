@@ -0,0 +1,32 @@
+package services
+
+import (
+	"context"
+
+	stssdk "github.com/aws/aws-sdk-go-v2/service/sts"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/provider"
+)
+
+type STS interface {
+	GetCallerIdentityWithContext(ctx context.Context, input *stssdk.GetCallerIdentityInput) (*stssdk.GetCallerIdentityOutput, error)
+}
+
+// NewSTS constructs new STS implementation.
+func NewSTS(awsClientsProvider provider.AWSClientsProvider) STS {
+	return &stsClient{
+		awsClientsProvider: awsClientsProvider,
+	}
+}
+
+// default implementation for STS.
+type stsClient struct {
+	awsClientsProvider provider.AWSClientsProvider
+}
+
+func (c *stsClient) GetCallerIdentityWithContext(ctx context.Context, input *stssdk.GetCallerIdentityInput) (*stssdk.GetCallerIdentityOutput, error) {
+	client, err := c.awsClientsProvider.GetSTSClient(ctx, "GetCallerIdentity")
+	if err != nil {
+		return nil, err
+	}
+	return client.GetCallerIdentity(ctx, input)
+}
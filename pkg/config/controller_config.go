@@ -7,6 +7,7 @@ import (
 
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/inject/pod_readiness"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/inject/quic"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/shard"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/shared_constants"
 
 	"github.com/pkg/errors"
@@ -39,9 +40,12 @@ const (
 	flagBackendSecurityGroup                         = "backend-security-group"
 	flagEnableEndpointSlices                         = "enable-endpoint-slices"
 	flagDisableRestrictedSGRules                     = "disable-restricted-sg-rules"
+	flagRestrictSGEgress                             = "restrict-security-group-egress"
 	flagMaxTargetsPerTargetGroup                     = "max-targets-per-target-group"
 	flagTargetGroupBindingRequeueDuration            = "targetgroupbinding-requeue-duration"
 	flagRequiredSecretsLabel                         = "required-secrets-label"
+	flagTrustStoreS3Bucket                           = "trust-store-s3-bucket"
+	flagServiceExportAllowedImportingClusters        = "service-export-allowed-importing-clusters"
 	defaultLogLevel                                  = "info"
 	defaultGlobalAcceleratorMaxConcurrentReconciles  = 1
 	defaultMaxConcurrentReconciles                   = 3
@@ -51,6 +55,7 @@ const (
 	defaultEnableManageBackendSGRules                = false
 	defaultEnableEndpointSlices                      = true
 	defaultDisableRestrictedSGRules                  = false
+	defaultRestrictSGEgress                          = false
 	defaultLbStabilizationMonitorInterval            = time.Second * 120
 	defaultMaxTargetsPerTargetGroup                  = 0
 	defaultTargetGroupBindingRequeuDuration          = time.Second * 15
@@ -91,6 +96,8 @@ type ControllerConfig struct {
 	AddonsConfig AddonsConfig
 	// Configurations for the Service controller
 	ServiceConfig ServiceConfig
+	// Configurations for sharded controller replicas
+	ShardConfig shard.Config
 
 	// Default AWS Tags that will be applied to all AWS resources managed by this controller.
 	DefaultTags map[string]string
@@ -149,6 +156,10 @@ type ControllerConfig struct {
 	// DisableRestrictedSGRules specifies whether to use restricted security group rules
 	DisableRestrictedSGRules bool
 
+	// RestrictSGEgress specifies whether to restrict egress rules of controller-managed load balancer security groups
+	// to the target security groups and ports
+	RestrictSGEgress bool
+
 	// LBStabilizationMonitorInterval specifies the duration of interval to monitor the load balancer state for stabilization
 	LBStabilizationMonitorInterval time.Duration
 
@@ -164,6 +175,14 @@ type ControllerConfig struct {
 	// By default, no label is required and the controller can read all Secrets.
 	RequiredSecretsLabel string
 
+	// TrustStoreS3Bucket specifies the S3 bucket used to stage CA bundles of trust stores managed by the controller.
+	// Trust stores can only be created from CA bundles in Kubernetes when it's specified.
+	TrustStoreS3Bucket string
+
+	// ServiceExportAllowedImportingClusters specifies the names of the clusters whose multi-cluster target groups
+	// the endpoints of exported Services are registered into.
+	ServiceExportAllowedImportingClusters []string
+
 	FeatureGates FeatureGates
 }
 
@@ -210,6 +229,8 @@ func (cfg *ControllerConfig) BindFlags(fs *pflag.FlagSet) {
 		"Enable EndpointSlices for IP targets instead of Endpoints")
 	fs.BoolVar(&cfg.DisableRestrictedSGRules, flagDisableRestrictedSGRules, defaultDisableRestrictedSGRules,
 		"Disable the usage of restricted security group rules")
+	fs.BoolVar(&cfg.RestrictSGEgress, flagRestrictSGEgress, defaultRestrictSGEgress,
+		"Restrict egress rules of controller-managed load balancer security groups to the target security groups and ports")
 	fs.StringToStringVar(&cfg.ServiceTargetENISGTags, flagServiceTargetENISGTags, nil,
 		"AWS Tags, in addition to cluster tags, for finding the target ENI security group to which to add inbound rules from NLBs")
 	fs.IntVar(&cfg.MaxTargetsPerTargetGroup, flagMaxTargetsPerTargetGroup, defaultMaxTargetsPerTargetGroup,
@@ -218,6 +239,10 @@ func (cfg *ControllerConfig) BindFlags(fs *pflag.FlagSet) {
 		"Duration after which TargetGroupBinding will be requeued for reconciliation when it's waiting for AWS resources to update.")
 	fs.StringVar(&cfg.RequiredSecretsLabel, flagRequiredSecretsLabel, "",
 		"Required label (key=value) that Secrets must have to be read by the controller")
+	fs.StringVar(&cfg.TrustStoreS3Bucket, flagTrustStoreS3Bucket, "",
+		"S3 bucket used to stage CA bundles when creating mTLS trust stores from Secrets or ConfigMaps")
+	fs.StringSliceVar(&cfg.ServiceExportAllowedImportingClusters, flagServiceExportAllowedImportingClusters, nil,
+		"Names of the clusters whose multi-cluster target groups the endpoints of exported Services are registered into")
 	cfg.FeatureGates.BindFlags(fs)
 	cfg.AWSConfig.BindFlags(fs)
 	cfg.RuntimeConfig.BindFlags(fs)
@@ -227,6 +252,7 @@ func (cfg *ControllerConfig) BindFlags(fs *pflag.FlagSet) {
 	cfg.IngressConfig.BindFlags(fs)
 	cfg.AddonsConfig.BindFlags(fs)
 	cfg.ServiceConfig.BindFlags(fs)
+	cfg.ShardConfig.BindFlags(fs)
 }
 
 // Validate the controller configuration
@@ -259,6 +285,9 @@ func (cfg *ControllerConfig) Validate() error {
 	if err := cfg.validateRequiredSecretsLabel(); err != nil {
 		return err
 	}
+	if err := cfg.validateShardConfiguration(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -325,6 +354,16 @@ func (cfg *ControllerConfig) validateManageBackendSecurityGroupRulesConfiguratio
 	return nil
 }
 
+func (cfg *ControllerConfig) validateShardConfiguration() error {
+	if err := cfg.ShardConfig.Validate(); err != nil {
+		return err
+	}
+	if cfg.ShardConfig.EnableSharding && !cfg.RuntimeConfig.EnableLeaderElection {
+		return errors.Errorf("leader election must be enabled when sharding is enabled")
+	}
+	return nil
+}
+
 func (cfg *ControllerConfig) validateRequiredSecretsLabel() error {
 	if cfg.RequiredSecretsLabel == "" {
 		return nil
@@ -3,8 +3,10 @@ package config
 import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/shard"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/shared_constants"
 	"testing"
+	"time"
 )
 
 func TestControllerConfig_validateDefaultTagsCollisionWithTrackingTags(t *testing.T) {
@@ -219,3 +221,53 @@ func TestControllerConfig_validateManageBackendSecurityGroupRulesConfiguration(t
 		})
 	}
 }
+
+func TestControllerConfig_validateShardConfiguration(t *testing.T) {
+	tests := []struct {
+		name                 string
+		shardConfig          shard.Config
+		enableLeaderElection bool
+		wantErr              bool
+		errMsg               string
+	}{
+		{
+			name:                 "with sharding disabled - should succeed",
+			shardConfig:          shard.Config{},
+			enableLeaderElection: false,
+			wantErr:              false,
+		},
+		{
+			name:                 "with sharding and leader election enabled - should succeed",
+			shardConfig:          shard.Config{EnableSharding: true, LeaseDuration: 30 * time.Second, LeaseRenewInterval: 10 * time.Second},
+			enableLeaderElection: true,
+			wantErr:              false,
+		},
+		{
+			name:                 "with sharding enabled and leader election disabled - expect error",
+			shardConfig:          shard.Config{EnableSharding: true, LeaseDuration: 30 * time.Second, LeaseRenewInterval: 10 * time.Second},
+			enableLeaderElection: false,
+			wantErr:              true,
+			errMsg:               "leader election must be enabled when sharding is enabled",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &ControllerConfig{
+				ShardConfig: tt.shardConfig,
+				RuntimeConfig: RuntimeConfig{
+					EnableLeaderElection: tt.enableLeaderElection,
+				},
+			}
+
+			err := cfg.validateShardConfiguration()
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -42,6 +42,9 @@ const (
 	GatewayListenerSet            Feature = "GatewayListenerSet"
 	EnableCertificateManagement   Feature = "EnableCertificateManagement"
 	IngressPlanAnnotation         Feature = "IngressPlanAnnotation"
+	VPCEndpointServiceManagement  Feature = "VPCEndpointServiceManagement"
+	CloudWatchAlarmManagement     Feature = "CloudWatchAlarmManagement"
+	MultiClusterServiceExport     Feature = "MultiClusterServiceExport"
 )
 
 type FeatureGates interface {
@@ -97,6 +100,9 @@ func NewFeatureGates() FeatureGates {
 			GatewayListenerSet:            generateDefaultFeatureStatus(true),
 			EnableCertificateManagement:   generateDefaultFeatureStatus(false),
 			IngressPlanAnnotation:         generateDefaultFeatureStatus(false),
+			VPCEndpointServiceManagement:  generateDefaultFeatureStatus(false),
+			CloudWatchAlarmManagement:     generateDefaultFeatureStatus(false),
+			MultiClusterServiceExport:     generateDefaultFeatureStatus(false),
 		},
 	}
 }
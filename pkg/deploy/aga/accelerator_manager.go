@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/netip"
 	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -78,6 +79,13 @@ func (m *defaultAcceleratorManager) Create(ctx context.Context, resAccelerator *
 
 	// Build create input
 	createInput := m.buildSDKCreateAcceleratorInput(ctx, resAccelerator)
+	if len(createInput.IpAddresses) == 0 && len(resAccelerator.Spec.ByoipCIDRs) > 0 {
+		ipAddresses, err := m.allocateByoipIPAddresses(ctx, resAccelerator.Spec.ByoipCIDRs)
+		if err != nil {
+			return agamodel.AcceleratorStatus{}, fmt.Errorf("failed to allocate BYOIP addresses: %w", err)
+		}
+		createInput.IpAddresses = ipAddresses
+	}
 
 	// Create accelerator
 	m.logger.Info("Creating accelerator",
@@ -94,6 +102,10 @@ func (m *defaultAcceleratorManager) Create(ctx context.Context, resAccelerator *
 		"resourceID", resAccelerator.ID(),
 		"acceleratorARN", *accelerator.AcceleratorArn)
 
+	if err := m.reconcileAcceleratorFlowLogs(ctx, resAccelerator, *accelerator.AcceleratorArn); err != nil {
+		return agamodel.AcceleratorStatus{}, fmt.Errorf("failed to update accelerator flow logs: %w", err)
+	}
+
 	return m.buildAcceleratorStatus(accelerator), nil
 }
 
@@ -116,6 +128,10 @@ func (m *defaultAcceleratorManager) Update(ctx context.Context, resAccelerator *
 		return agamodel.AcceleratorStatus{}, fmt.Errorf("failed to update accelerator tags: %w", err)
 	}
 
+	if err := m.reconcileAcceleratorFlowLogs(ctx, resAccelerator, *sdkAccelerator.Accelerator.AcceleratorArn); err != nil {
+		return agamodel.AcceleratorStatus{}, fmt.Errorf("failed to update accelerator flow logs: %w", err)
+	}
+
 	var updatedAccelerator *agatypes.Accelerator
 	if !m.isSDKAcceleratorSettingsDrifted(resAccelerator, sdkAccelerator) {
 		m.logger.V(1).Info("No drift detected in accelerator settings, skipping update",
@@ -255,6 +271,125 @@ func (m *defaultAcceleratorManager) updateAcceleratorTags(ctx context.Context, r
 
 }
 
+// reconcileAcceleratorFlowLogs updates the flow logs attributes of the accelerator when they differ from the desired ones.
+func (m *defaultAcceleratorManager) reconcileAcceleratorFlowLogs(ctx context.Context, resAccelerator *agamodel.Accelerator, acceleratorARN string) error {
+	desired := resAccelerator.Spec.FlowLogs
+	if desired == nil {
+		return nil
+	}
+	describeOutput, err := m.gaService.DescribeAcceleratorAttributesWithContext(ctx, &globalaccelerator.DescribeAcceleratorAttributesInput{
+		AcceleratorArn: aws.String(acceleratorARN),
+	})
+	if err != nil {
+		return err
+	}
+	if !isSDKFlowLogsAttributesDrifted(desired, describeOutput.AcceleratorAttributes) {
+		return nil
+	}
+
+	updateInput := &globalaccelerator.UpdateAcceleratorAttributesInput{
+		AcceleratorArn:  aws.String(acceleratorARN),
+		FlowLogsEnabled: aws.Bool(desired.Enabled),
+	}
+	if desired.Enabled {
+		updateInput.FlowLogsS3Bucket = aws.String(desired.S3Bucket)
+		updateInput.FlowLogsS3Prefix = aws.String(desired.S3Prefix)
+	}
+	m.logger.Info("Updating accelerator flow logs",
+		"stackID", resAccelerator.Stack().StackID(),
+		"resourceID", resAccelerator.ID(),
+		"acceleratorARN", acceleratorARN,
+		"enabled", desired.Enabled)
+	if _, err := m.gaService.UpdateAcceleratorAttributesWithContext(ctx, updateInput); err != nil {
+		return err
+	}
+	m.logger.Info("Successfully updated accelerator flow logs",
+		"stackID", resAccelerator.Stack().StackID(),
+		"resourceID", resAccelerator.ID(),
+		"acceleratorARN", acceleratorARN)
+	return nil
+}
+
+// isSDKFlowLogsAttributesDrifted checks whether the actual flow logs attributes differ from the desired ones.
+// The bucket and prefix are only compared when flow logs are enabled.
+func isSDKFlowLogsAttributesDrifted(desired *agamodel.FlowLogs, actual *agatypes.AcceleratorAttributes) bool {
+	if actual == nil {
+		return true
+	}
+	if desired.Enabled != awssdk.ToBool(actual.FlowLogsEnabled) {
+		return true
+	}
+	if !desired.Enabled {
+		return false
+	}
+	return desired.S3Bucket != awssdk.ToString(actual.FlowLogsS3Bucket) ||
+		desired.S3Prefix != awssdk.ToString(actual.FlowLogsS3Prefix)
+}
+
+// allocateByoipIPAddresses picks one free static IP address from each of the BYOIP address ranges.
+// The address ranges must be provisioned to Global Accelerator and either ready or advertising.
+func (m *defaultAcceleratorManager) allocateByoipIPAddresses(ctx context.Context, byoipCIDRs []agamodel.ByoipCIDR) ([]string, error) {
+	sdkByoipCIDRs, err := m.gaService.ListByoipCidrsAsList(ctx, &globalaccelerator.ListByoipCidrsInput{})
+	if err != nil {
+		return nil, err
+	}
+	sdkByoipCIDRStates := make(map[string]agatypes.ByoipCidrState, len(sdkByoipCIDRs))
+	for _, sdkByoipCIDR := range sdkByoipCIDRs {
+		sdkByoipCIDRStates[awssdk.ToString(sdkByoipCIDR.Cidr)] = sdkByoipCIDR.State
+	}
+	for _, byoipCIDR := range byoipCIDRs {
+		state, exists := sdkByoipCIDRStates[byoipCIDR.Cidr]
+		if !exists {
+			return nil, fmt.Errorf("BYOIP address range %s is not provisioned to Global Accelerator", byoipCIDR.Cidr)
+		}
+		if state != agatypes.ByoipCidrStateReady && state != agatypes.ByoipCidrStateAdvertising {
+			return nil, fmt.Errorf("BYOIP address range %s is in state %s, expected %s or %s",
+				byoipCIDR.Cidr, state, agatypes.ByoipCidrStateReady, agatypes.ByoipCidrStateAdvertising)
+		}
+	}
+
+	sdkAccelerators, err := m.gaService.ListAcceleratorsAsList(ctx, &globalaccelerator.ListAcceleratorsInput{})
+	if err != nil {
+		return nil, err
+	}
+	usedIPAddresses := make(map[netip.Addr]bool)
+	for _, sdkAccelerator := range sdkAccelerators {
+		for _, ipSet := range sdkAccelerator.IpSets {
+			for _, ipAddress := range ipSet.IpAddresses {
+				if addr, err := netip.ParseAddr(ipAddress); err == nil {
+					usedIPAddresses[addr] = true
+				}
+			}
+		}
+	}
+
+	ipAddresses := make([]string, 0, len(byoipCIDRs))
+	for _, byoipCIDR := range byoipCIDRs {
+		addr, err := pickFreeIPAddress(byoipCIDR.Cidr, usedIPAddresses)
+		if err != nil {
+			return nil, err
+		}
+		usedIPAddresses[addr] = true
+		ipAddresses = append(ipAddresses, addr.String())
+	}
+	return ipAddresses, nil
+}
+
+// pickFreeIPAddress returns the lowest address within cidr that isn't in use, skipping the network address.
+func pickFreeIPAddress(cidr string, usedIPAddresses map[netip.Addr]bool) (netip.Addr, error) {
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return netip.Addr{}, fmt.Errorf("invalid BYOIP address range %s: %w", cidr, err)
+	}
+	prefix = prefix.Masked()
+	for addr := prefix.Addr().Next(); addr.IsValid() && prefix.Contains(addr); addr = addr.Next() {
+		if !usedIPAddresses[addr] {
+			return addr, nil
+		}
+	}
+	return netip.Addr{}, fmt.Errorf("no free IP address left in BYOIP address range %s", cidr)
+}
+
 func (m *defaultAcceleratorManager) isSDKAcceleratorSettingsDrifted(resAccelerator *agamodel.Accelerator, sdkAccelerator AcceleratorWithTags) bool {
 	// Check if name differs
 	if resAccelerator.Spec.Name != *sdkAccelerator.Accelerator.Name {
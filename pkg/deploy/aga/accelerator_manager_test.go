@@ -1010,3 +1010,193 @@ func makeIPSets(ips []string) []agatypes.IpSet {
 		},
 	}
 }
+
+func Test_defaultAcceleratorManager_allocateByoipIPAddresses(t *testing.T) {
+	tests := []struct {
+		name              string
+		byoipCIDRs        []agamodel.ByoipCIDR
+		setupExpectations func(mockGA *services.MockGlobalAccelerator)
+		want              []string
+		wantErr           string
+	}{
+		{
+			name: "allocates lowest free address of each range",
+			byoipCIDRs: []agamodel.ByoipCIDR{
+				{IPAddressFamily: "IPv4", Cidr: "198.51.100.0/24"},
+				{IPAddressFamily: "IPv4", Cidr: "203.0.113.0/24"},
+			},
+			setupExpectations: func(mockGA *services.MockGlobalAccelerator) {
+				mockGA.EXPECT().ListByoipCidrsAsList(gomock.Any(), gomock.Any()).Return([]gatypes.ByoipCidr{
+					{Cidr: aws.String("198.51.100.0/24"), State: gatypes.ByoipCidrStateAdvertising},
+					{Cidr: aws.String("203.0.113.0/24"), State: gatypes.ByoipCidrStateReady},
+				}, nil)
+				mockGA.EXPECT().ListAcceleratorsAsList(gomock.Any(), gomock.Any()).Return([]gatypes.Accelerator{
+					{
+						IpSets: []gatypes.IpSet{
+							{IpAddressFamily: "IPv4", IpAddresses: []string{"198.51.100.1", "198.51.100.2"}},
+						},
+					},
+				}, nil)
+			},
+			want: []string{"198.51.100.3", "203.0.113.1"},
+		},
+		{
+			name: "range not provisioned",
+			byoipCIDRs: []agamodel.ByoipCIDR{
+				{IPAddressFamily: "IPv4", Cidr: "198.51.100.0/24"},
+			},
+			setupExpectations: func(mockGA *services.MockGlobalAccelerator) {
+				mockGA.EXPECT().ListByoipCidrsAsList(gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			wantErr: "BYOIP address range 198.51.100.0/24 is not provisioned to Global Accelerator",
+		},
+		{
+			name: "range not ready",
+			byoipCIDRs: []agamodel.ByoipCIDR{
+				{IPAddressFamily: "IPv4", Cidr: "198.51.100.0/24"},
+			},
+			setupExpectations: func(mockGA *services.MockGlobalAccelerator) {
+				mockGA.EXPECT().ListByoipCidrsAsList(gomock.Any(), gomock.Any()).Return([]gatypes.ByoipCidr{
+					{Cidr: aws.String("198.51.100.0/24"), State: gatypes.ByoipCidrStatePendingProvisioning},
+				}, nil)
+			},
+			wantErr: "BYOIP address range 198.51.100.0/24 is in state PENDING_PROVISIONING, expected READY or ADVERTISING",
+		},
+		{
+			name: "range exhausted",
+			byoipCIDRs: []agamodel.ByoipCIDR{
+				{IPAddressFamily: "IPv4", Cidr: "198.51.100.0/30"},
+			},
+			setupExpectations: func(mockGA *services.MockGlobalAccelerator) {
+				mockGA.EXPECT().ListByoipCidrsAsList(gomock.Any(), gomock.Any()).Return([]gatypes.ByoipCidr{
+					{Cidr: aws.String("198.51.100.0/30"), State: gatypes.ByoipCidrStateReady},
+				}, nil)
+				mockGA.EXPECT().ListAcceleratorsAsList(gomock.Any(), gomock.Any()).Return([]gatypes.Accelerator{
+					{
+						IpSets: []gatypes.IpSet{
+							{IpAddressFamily: "IPv4", IpAddresses: []string{"198.51.100.1", "198.51.100.2", "198.51.100.3"}},
+						},
+					},
+				}, nil)
+			},
+			wantErr: "no free IP address left in BYOIP address range 198.51.100.0/30",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockGA := services.NewMockGlobalAccelerator(ctrl)
+			tt.setupExpectations(mockGA)
+			manager := &defaultAcceleratorManager{
+				gaService: mockGA,
+				logger:    logr.New(&log.NullLogSink{}),
+			}
+			got, err := manager.allocateByoipIPAddresses(context.Background(), tt.byoipCIDRs)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_defaultAcceleratorManager_reconcileAcceleratorFlowLogs(t *testing.T) {
+	testARN := "arn:aws:globalaccelerator::123456789012:accelerator/1234abcd-abcd-1234-abcd-1234abcdefgh"
+	tests := []struct {
+		name              string
+		flowLogs          *agamodel.FlowLogs
+		setupExpectations func(mockGA *services.MockGlobalAccelerator)
+		wantErr           bool
+	}{
+		{
+			name:     "flow logs not specified",
+			flowLogs: nil,
+		},
+		{
+			name:     "flow logs already in desired state",
+			flowLogs: &agamodel.FlowLogs{Enabled: true, S3Bucket: "flow-logs", S3Prefix: "prod"},
+			setupExpectations: func(mockGA *services.MockGlobalAccelerator) {
+				mockGA.EXPECT().DescribeAcceleratorAttributesWithContext(gomock.Any(), &globalaccelerator.DescribeAcceleratorAttributesInput{
+					AcceleratorArn: aws.String(testARN),
+				}).Return(&globalaccelerator.DescribeAcceleratorAttributesOutput{
+					AcceleratorAttributes: &gatypes.AcceleratorAttributes{
+						FlowLogsEnabled:  aws.Bool(true),
+						FlowLogsS3Bucket: aws.String("flow-logs"),
+						FlowLogsS3Prefix: aws.String("prod"),
+					},
+				}, nil)
+			},
+		},
+		{
+			name:     "enable flow logs",
+			flowLogs: &agamodel.FlowLogs{Enabled: true, S3Bucket: "flow-logs", S3Prefix: "prod"},
+			setupExpectations: func(mockGA *services.MockGlobalAccelerator) {
+				mockGA.EXPECT().DescribeAcceleratorAttributesWithContext(gomock.Any(), gomock.Any()).Return(&globalaccelerator.DescribeAcceleratorAttributesOutput{
+					AcceleratorAttributes: &gatypes.AcceleratorAttributes{
+						FlowLogsEnabled: aws.Bool(false),
+					},
+				}, nil)
+				mockGA.EXPECT().UpdateAcceleratorAttributesWithContext(gomock.Any(), &globalaccelerator.UpdateAcceleratorAttributesInput{
+					AcceleratorArn:   aws.String(testARN),
+					FlowLogsEnabled:  aws.Bool(true),
+					FlowLogsS3Bucket: aws.String("flow-logs"),
+					FlowLogsS3Prefix: aws.String("prod"),
+				}).Return(&globalaccelerator.UpdateAcceleratorAttributesOutput{}, nil)
+			},
+		},
+		{
+			name:     "disable flow logs",
+			flowLogs: &agamodel.FlowLogs{Enabled: false},
+			setupExpectations: func(mockGA *services.MockGlobalAccelerator) {
+				mockGA.EXPECT().DescribeAcceleratorAttributesWithContext(gomock.Any(), gomock.Any()).Return(&globalaccelerator.DescribeAcceleratorAttributesOutput{
+					AcceleratorAttributes: &gatypes.AcceleratorAttributes{
+						FlowLogsEnabled:  aws.Bool(true),
+						FlowLogsS3Bucket: aws.String("flow-logs"),
+					},
+				}, nil)
+				mockGA.EXPECT().UpdateAcceleratorAttributesWithContext(gomock.Any(), &globalaccelerator.UpdateAcceleratorAttributesInput{
+					AcceleratorArn:  aws.String(testARN),
+					FlowLogsEnabled: aws.Bool(false),
+				}).Return(&globalaccelerator.UpdateAcceleratorAttributesOutput{}, nil)
+			},
+		},
+		{
+			name:     "describe attributes fails",
+			flowLogs: &agamodel.FlowLogs{Enabled: false},
+			setupExpectations: func(mockGA *services.MockGlobalAccelerator) {
+				mockGA.EXPECT().DescribeAcceleratorAttributesWithContext(gomock.Any(), gomock.Any()).Return(nil, errors.New("access denied"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockGA := services.NewMockGlobalAccelerator(ctrl)
+			if tt.setupExpectations != nil {
+				tt.setupExpectations(mockGA)
+			}
+			manager := &defaultAcceleratorManager{
+				gaService: mockGA,
+				logger:    logr.New(&log.NullLogSink{}),
+			}
+			stack := core.NewDefaultStack(core.StackID{Namespace: "test-namespace", Name: "test-name"})
+			resAccelerator := agamodel.NewAccelerator(stack, "test-accelerator", agamodel.AcceleratorSpec{
+				Name:     "test-accelerator",
+				FlowLogs: tt.flowLogs,
+			}, &agaapi.GlobalAccelerator{})
+			err := manager.reconcileAcceleratorFlowLogs(context.Background(), resAccelerator, testARN)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
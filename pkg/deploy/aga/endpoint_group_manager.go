@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
 	agatypes "github.com/aws/aws-sdk-go-v2/service/globalaccelerator/types"
 	"github.com/go-logr/logr"
@@ -93,6 +94,12 @@ func (m *defaultEndpointGroupManager) Create(ctx context.Context, resEndpointGro
 		return agamodel.EndpointGroupStatus{}, err
 	}
 
+	// Only endpoints owned by other accounts that are granted by their cross-account attachment can be added
+	endpointConfigs, attachmentStatuses, err := m.resolveCrossAccountAttachments(ctx, resEndpointGroup.Spec.EndpointConfigurations)
+	if err != nil {
+		return agamodel.EndpointGroupStatus{}, err
+	}
+
 	// Create endpoint group
 	m.logger.V(1).Info("Creating endpoint group",
 		"stackID", resEndpointGroup.Stack().StackID(),
@@ -112,15 +119,16 @@ func (m *defaultEndpointGroupManager) Create(ctx context.Context, resEndpointGro
 	// Manage endpoints for newly created endpoint group
 	// For new endpoint groups, there are no existing endpoints
 	var noEndpoints []agatypes.EndpointDescription
-	if err := m.ManageEndpoints(ctx, *endpointGroup.EndpointGroupArn, resEndpointGroup.Spec.EndpointConfigurations, noEndpoints); err != nil {
+	if err := m.ManageEndpoints(ctx, *endpointGroup.EndpointGroupArn, endpointConfigs, noEndpoints); err != nil {
 		m.logger.Error(err, "Failed to manage endpoints for newly created endpoint group",
 			"endpointGroupARN", *endpointGroup.EndpointGroupArn,
-			"endpointCount", len(resEndpointGroup.Spec.EndpointConfigurations))
+			"endpointCount", len(endpointConfigs))
 		return agamodel.EndpointGroupStatus{}, fmt.Errorf("failed to manage endpoints for endpoint group %s: %w", *endpointGroup.EndpointGroupArn, err)
 	}
 
 	return agamodel.EndpointGroupStatus{
-		EndpointGroupARN: *endpointGroup.EndpointGroupArn,
+		EndpointGroupARN:        *endpointGroup.EndpointGroupArn,
+		CrossAccountAttachments: attachmentStatuses,
 	}, nil
 }
 
@@ -144,6 +152,12 @@ func (m *defaultEndpointGroupManager) buildSDKUpdateEndpointGroupInput(_ context
 }
 
 func (m *defaultEndpointGroupManager) Update(ctx context.Context, resEndpointGroup *agamodel.EndpointGroup, sdkEndpointGroup *agatypes.EndpointGroup) (agamodel.EndpointGroupStatus, error) {
+	// Only endpoints owned by other accounts that are granted by their cross-account attachment can be added
+	endpointConfigs, attachmentStatuses, err := m.resolveCrossAccountAttachments(ctx, resEndpointGroup.Spec.EndpointConfigurations)
+	if err != nil {
+		return agamodel.EndpointGroupStatus{}, err
+	}
+
 	// Check if the endpoint group actually needs an update
 	if !m.isSDKEndpointGroupSettingsDrifted(resEndpointGroup, sdkEndpointGroup) {
 		m.logger.V(1).Info("No drift detected in endpoint group settings, skipping update",
@@ -152,16 +166,17 @@ func (m *defaultEndpointGroupManager) Update(ctx context.Context, resEndpointGro
 			"endpointGroupARN", *sdkEndpointGroup.EndpointGroupArn)
 
 		// Even if the endpoint group itself doesn't need an update, we still need to check endpoints
-		if err := m.ManageEndpoints(ctx, *sdkEndpointGroup.EndpointGroupArn, resEndpointGroup.Spec.EndpointConfigurations, sdkEndpointGroup.EndpointDescriptions); err != nil {
+		if err := m.ManageEndpoints(ctx, *sdkEndpointGroup.EndpointGroupArn, endpointConfigs, sdkEndpointGroup.EndpointDescriptions); err != nil {
 			m.logger.Error(err, "Failed to manage endpoints for endpoint group",
 				"endpointGroupARN", *sdkEndpointGroup.EndpointGroupArn,
-				"desiredEndpointCount", len(resEndpointGroup.Spec.EndpointConfigurations),
+				"desiredEndpointCount", len(endpointConfigs),
 				"currentEndpointCount", len(sdkEndpointGroup.EndpointDescriptions))
 			return agamodel.EndpointGroupStatus{}, fmt.Errorf("failed to manage endpoints for endpoint group %s: %w", *sdkEndpointGroup.EndpointGroupArn, err)
 		}
 
 		return agamodel.EndpointGroupStatus{
-			EndpointGroupARN: *sdkEndpointGroup.EndpointGroupArn,
+			EndpointGroupARN:        *sdkEndpointGroup.EndpointGroupArn,
+			CrossAccountAttachments: attachmentStatuses,
 		}, nil
 	}
 
@@ -189,16 +204,17 @@ func (m *defaultEndpointGroupManager) Update(ctx context.Context, resEndpointGro
 		"endpointGroupARN", *updatedEndpointGroup.EndpointGroupArn)
 
 	// After updating the endpoint group, manage endpoints
-	if err := m.ManageEndpoints(ctx, *updatedEndpointGroup.EndpointGroupArn, resEndpointGroup.Spec.EndpointConfigurations, updatedEndpointGroup.EndpointDescriptions); err != nil {
+	if err := m.ManageEndpoints(ctx, *updatedEndpointGroup.EndpointGroupArn, endpointConfigs, updatedEndpointGroup.EndpointDescriptions); err != nil {
 		m.logger.Error(err, "Failed to manage endpoints for updated endpoint group",
 			"endpointGroupARN", *updatedEndpointGroup.EndpointGroupArn,
-			"desiredEndpointCount", len(resEndpointGroup.Spec.EndpointConfigurations),
+			"desiredEndpointCount", len(endpointConfigs),
 			"currentEndpointCount", len(updatedEndpointGroup.EndpointDescriptions))
 		return agamodel.EndpointGroupStatus{}, fmt.Errorf("failed to manage endpoints for updated endpoint group %s: %w", *updatedEndpointGroup.EndpointGroupArn, err)
 	}
 
 	return agamodel.EndpointGroupStatus{
-		EndpointGroupARN: *updatedEndpointGroup.EndpointGroupArn,
+		EndpointGroupARN:        *updatedEndpointGroup.EndpointGroupArn,
+		CrossAccountAttachments: attachmentStatuses,
 	}, nil
 }
 
@@ -310,9 +326,73 @@ func (m *defaultEndpointGroupManager) buildSDKEndpointConfiguration(config agamo
 		endpointConfig.ClientIPPreservationEnabled = config.ClientIPPreservationEnabled
 	}
 
+	// Add cross-account attachment if specified
+	if config.AttachmentARN != nil {
+		endpointConfig.AttachmentArn = config.AttachmentARN
+	}
+
 	return endpointConfig
 }
 
+// resolveCrossAccountAttachments checks the cross-account attachments of endpoints owned by other accounts.
+// Endpoints whose attachment doesn't grant this account permission to use them are left out of the returned
+// endpoint configurations, and the state of every attachment is reported in the returned statuses.
+func (m *defaultEndpointGroupManager) resolveCrossAccountAttachments(
+	ctx context.Context,
+	resEndpointConfigs []agamodel.EndpointConfiguration) ([]agamodel.EndpointConfiguration, []agamodel.CrossAccountAttachmentStatus, error) {
+
+	var attachmentStatuses []agamodel.CrossAccountAttachmentStatus
+	endpointConfigs := make([]agamodel.EndpointConfiguration, 0, len(resEndpointConfigs))
+	resourcesByOwner := make(map[string][]agatypes.CrossAccountResource)
+	for _, config := range resEndpointConfigs {
+		if config.AttachmentARN == nil {
+			endpointConfigs = append(endpointConfigs, config)
+			continue
+		}
+		attachmentARN := awssdk.ToString(config.AttachmentARN)
+		endpointARN, err := arn.Parse(config.EndpointID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse owner account of endpoint %s: %w", config.EndpointID, err)
+		}
+		resources, exists := resourcesByOwner[endpointARN.AccountID]
+		if !exists {
+			resources, err = m.gaService.ListCrossAccountResourcesAsList(ctx, &globalaccelerator.ListCrossAccountResourcesInput{
+				ResourceOwnerAwsAccountId: awssdk.String(endpointARN.AccountID),
+			})
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to list cross-account resources of account %s: %w", endpointARN.AccountID, err)
+			}
+			resourcesByOwner[endpointARN.AccountID] = resources
+		}
+
+		status := agamodel.CrossAccountAttachmentStatus{
+			EndpointID:    config.EndpointID,
+			AttachmentARN: attachmentARN,
+		}
+		if isEndpointGrantedByAttachment(resources, config.EndpointID, attachmentARN) {
+			status.Attached = true
+			endpointConfigs = append(endpointConfigs, config)
+		} else {
+			status.Message = fmt.Sprintf("attachment %s of account %s doesn't grant access to endpoint %s", attachmentARN, endpointARN.AccountID, config.EndpointID)
+			m.logger.Info("Skipping cross-account endpoint that isn't granted by its attachment",
+				"endpointID", config.EndpointID,
+				"attachmentARN", attachmentARN)
+		}
+		attachmentStatuses = append(attachmentStatuses, status)
+	}
+	return endpointConfigs, attachmentStatuses, nil
+}
+
+// isEndpointGrantedByAttachment checks whether the cross-account resources contain the endpoint under the attachment.
+func isEndpointGrantedByAttachment(resources []agatypes.CrossAccountResource, endpointID string, attachmentARN string) bool {
+	for _, resource := range resources {
+		if awssdk.ToString(resource.EndpointId) == endpointID && awssdk.ToString(resource.AttachmentArn) == attachmentARN {
+			return true
+		}
+	}
+	return false
+}
+
 // detectEndpointDrift compares existing endpoints with desired endpoint configurations
 // It efficiently determines which endpoints need to be added, updated or removed using set operations.
 // Returns:
@@ -1318,3 +1318,104 @@ func Test_ManageEndpoints(t *testing.T) {
 		})
 	}
 }
+
+func Test_defaultEndpointGroupManager_resolveCrossAccountAttachments(t *testing.T) {
+	ownEndpointID := "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/own-alb/1234567890abcdef"
+	crossAccountEndpointID := "arn:aws:elasticloadbalancing:us-west-2:210987654321:loadbalancer/app/shared-alb/1234567890abcdef"
+	otherCrossAccountEndpointID := "arn:aws:elasticloadbalancing:us-west-2:210987654321:loadbalancer/app/other-alb/1234567890abcdef"
+	attachmentARN := "arn:aws:globalaccelerator::210987654321:attachment/1234abcd-abcd-1234-abcd-1234abcdefgh"
+
+	tests := []struct {
+		name               string
+		resEndpointConfigs []agamodel.EndpointConfiguration
+		setupExpectations  func(mockGA *services.MockGlobalAccelerator)
+		wantConfigs        []agamodel.EndpointConfiguration
+		wantStatuses       []agamodel.CrossAccountAttachmentStatus
+		wantErr            bool
+	}{
+		{
+			name: "endpoints without attachment are passed through",
+			resEndpointConfigs: []agamodel.EndpointConfiguration{
+				{EndpointID: ownEndpointID},
+			},
+			wantConfigs: []agamodel.EndpointConfiguration{
+				{EndpointID: ownEndpointID},
+			},
+			wantStatuses: nil,
+		},
+		{
+			name: "granted and not granted cross-account endpoints",
+			resEndpointConfigs: []agamodel.EndpointConfiguration{
+				{EndpointID: ownEndpointID},
+				{EndpointID: crossAccountEndpointID, AttachmentARN: awssdk.String(attachmentARN)},
+				{EndpointID: otherCrossAccountEndpointID, AttachmentARN: awssdk.String(attachmentARN)},
+			},
+			setupExpectations: func(mockGA *services.MockGlobalAccelerator) {
+				// resources of the same owner are only listed once
+				mockGA.EXPECT().
+					ListCrossAccountResourcesAsList(gomock.Any(), &globalaccelerator.ListCrossAccountResourcesInput{
+						ResourceOwnerAwsAccountId: awssdk.String("210987654321"),
+					}).
+					Return([]agatypes.CrossAccountResource{
+						{EndpointId: awssdk.String(crossAccountEndpointID), AttachmentArn: awssdk.String(attachmentARN)},
+					}, nil).
+					Times(1)
+			},
+			wantConfigs: []agamodel.EndpointConfiguration{
+				{EndpointID: ownEndpointID},
+				{EndpointID: crossAccountEndpointID, AttachmentARN: awssdk.String(attachmentARN)},
+			},
+			wantStatuses: []agamodel.CrossAccountAttachmentStatus{
+				{EndpointID: crossAccountEndpointID, AttachmentARN: attachmentARN, Attached: true},
+				{
+					EndpointID:    otherCrossAccountEndpointID,
+					AttachmentARN: attachmentARN,
+					Attached:      false,
+					Message:       "attachment " + attachmentARN + " of account 210987654321 doesn't grant access to endpoint " + otherCrossAccountEndpointID,
+				},
+			},
+		},
+		{
+			name: "endpoint ID is not an ARN",
+			resEndpointConfigs: []agamodel.EndpointConfiguration{
+				{EndpointID: "eipalloc-1234567890abcdef", AttachmentARN: awssdk.String(attachmentARN)},
+			},
+			wantErr: true,
+		},
+		{
+			name: "listing cross-account resources fails",
+			resEndpointConfigs: []agamodel.EndpointConfiguration{
+				{EndpointID: crossAccountEndpointID, AttachmentARN: awssdk.String(attachmentARN)},
+			},
+			setupExpectations: func(mockGA *services.MockGlobalAccelerator) {
+				mockGA.EXPECT().
+					ListCrossAccountResourcesAsList(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("access denied"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockGA := services.NewMockGlobalAccelerator(ctrl)
+			if tt.setupExpectations != nil {
+				tt.setupExpectations(mockGA)
+			}
+			m := &defaultEndpointGroupManager{
+				gaService: mockGA,
+				logger:    logr.Discard(),
+			}
+			gotConfigs, gotStatuses, err := m.resolveCrossAccountAttachments(context.Background(), tt.resEndpointConfigs)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantConfigs, gotConfigs)
+			assert.Equal(t, tt.wantStatuses, gotStatuses)
+		})
+	}
+}
@@ -0,0 +1,266 @@
+package cloudwatch
+
+import (
+	"context"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	cloudwatchsdk "github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cloudwatchtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/deploy/tracking"
+	cloudwatchmodel "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/cloudwatch"
+)
+
+const (
+	dimensionLoadBalancer = "LoadBalancer"
+	dimensionTargetGroup  = "TargetGroup"
+)
+
+// MetricAlarmManager is responsible for create/update/delete MetricAlarm resources.
+type MetricAlarmManager interface {
+	Create(ctx context.Context, resAlarm *cloudwatchmodel.MetricAlarm) (cloudwatchmodel.MetricAlarmStatus, error)
+
+	Update(ctx context.Context, resAlarm *cloudwatchmodel.MetricAlarm, sdkAlarm MetricAlarmWithTags) (cloudwatchmodel.MetricAlarmStatus, error)
+
+	Delete(ctx context.Context, sdkAlarm MetricAlarmWithTags) error
+}
+
+// NewDefaultMetricAlarmManager constructs new defaultMetricAlarmManager.
+func NewDefaultMetricAlarmManager(cloudWatchClient services.CloudWatch, trackingProvider tracking.Provider, taggingManager TaggingManager,
+	externalManagedTags []string, logger logr.Logger) *defaultMetricAlarmManager {
+	return &defaultMetricAlarmManager{
+		cloudWatchClient:    cloudWatchClient,
+		trackingProvider:    trackingProvider,
+		taggingManager:      taggingManager,
+		externalManagedTags: externalManagedTags,
+		logger:              logger,
+	}
+}
+
+var _ MetricAlarmManager = &defaultMetricAlarmManager{}
+
+// default implementation for MetricAlarmManager.
+type defaultMetricAlarmManager struct {
+	cloudWatchClient    services.CloudWatch
+	trackingProvider    tracking.Provider
+	taggingManager      TaggingManager
+	externalManagedTags []string
+	logger              logr.Logger
+}
+
+func (m *defaultMetricAlarmManager) Create(ctx context.Context, resAlarm *cloudwatchmodel.MetricAlarm) (cloudwatchmodel.MetricAlarmStatus, error) {
+	req, err := buildSDKPutMetricAlarmInput(ctx, resAlarm.Spec)
+	if err != nil {
+		return cloudwatchmodel.MetricAlarmStatus{}, err
+	}
+	alarmTags := m.trackingProvider.ResourceTags(resAlarm.Stack(), resAlarm, resAlarm.Spec.Tags)
+	req.Tags = convertTagsToSDKTags(alarmTags)
+
+	m.logger.Info("creating metricAlarm",
+		"resourceID", resAlarm.ID(),
+		"alarmName", resAlarm.Spec.AlarmName)
+	if _, err := m.cloudWatchClient.PutMetricAlarmWithContext(ctx, req); err != nil {
+		return cloudwatchmodel.MetricAlarmStatus{}, err
+	}
+	sdkAlarm, err := m.describeMetricAlarm(ctx, resAlarm.Spec.AlarmName)
+	if err != nil {
+		return cloudwatchmodel.MetricAlarmStatus{}, err
+	}
+	m.logger.Info("created metricAlarm",
+		"resourceID", resAlarm.ID(),
+		"arn", awssdk.ToString(sdkAlarm.AlarmArn))
+	return buildResMetricAlarmStatus(sdkAlarm), nil
+}
+
+func (m *defaultMetricAlarmManager) Update(ctx context.Context, resAlarm *cloudwatchmodel.MetricAlarm, sdkAlarm MetricAlarmWithTags) (cloudwatchmodel.MetricAlarmStatus, error) {
+	// alarms cannot be renamed, so we replace the alarm if its name changed.
+	if awssdk.ToString(sdkAlarm.MetricAlarm.AlarmName) != resAlarm.Spec.AlarmName {
+		alarmStatus, err := m.Create(ctx, resAlarm)
+		if err != nil {
+			return cloudwatchmodel.MetricAlarmStatus{}, err
+		}
+		if err := m.Delete(ctx, sdkAlarm); err != nil {
+			return cloudwatchmodel.MetricAlarmStatus{}, err
+		}
+		return alarmStatus, nil
+	}
+
+	if err := m.updateSDKMetricAlarmWithTags(ctx, resAlarm, sdkAlarm); err != nil {
+		return cloudwatchmodel.MetricAlarmStatus{}, err
+	}
+	if err := m.updateSDKMetricAlarmWithSettings(ctx, resAlarm, sdkAlarm); err != nil {
+		return cloudwatchmodel.MetricAlarmStatus{}, err
+	}
+	return buildResMetricAlarmStatus(*sdkAlarm.MetricAlarm), nil
+}
+
+func (m *defaultMetricAlarmManager) Delete(ctx context.Context, sdkAlarm MetricAlarmWithTags) error {
+	alarmName := awssdk.ToString(sdkAlarm.MetricAlarm.AlarmName)
+	req := &cloudwatchsdk.DeleteAlarmsInput{
+		AlarmNames: []string{alarmName},
+	}
+	m.logger.Info("deleting metricAlarm",
+		"arn", awssdk.ToString(sdkAlarm.MetricAlarm.AlarmArn))
+	if _, err := m.cloudWatchClient.DeleteAlarmsWithContext(ctx, req); err != nil {
+		return errors.Wrap(err, "failed to delete metricAlarm")
+	}
+	m.logger.Info("deleted metricAlarm",
+		"arn", awssdk.ToString(sdkAlarm.MetricAlarm.AlarmArn))
+	return nil
+}
+
+func (m *defaultMetricAlarmManager) updateSDKMetricAlarmWithTags(ctx context.Context, resAlarm *cloudwatchmodel.MetricAlarm, sdkAlarm MetricAlarmWithTags) error {
+	desiredAlarmTags := m.trackingProvider.ResourceTags(resAlarm.Stack(), resAlarm, resAlarm.Spec.Tags)
+	return m.taggingManager.ReconcileTags(ctx, awssdk.ToString(sdkAlarm.MetricAlarm.AlarmArn), desiredAlarmTags,
+		WithCurrentTags(sdkAlarm.Tags),
+		WithIgnoredTagKeys(m.trackingProvider.LegacyTagKeys()),
+		WithIgnoredTagKeys(m.externalManagedTags))
+}
+
+func (m *defaultMetricAlarmManager) updateSDKMetricAlarmWithSettings(ctx context.Context, resAlarm *cloudwatchmodel.MetricAlarm, sdkAlarm MetricAlarmWithTags) error {
+	req, err := buildSDKPutMetricAlarmInput(ctx, resAlarm.Spec)
+	if err != nil {
+		return err
+	}
+	if !isSDKMetricAlarmSettingsDrifted(req, *sdkAlarm.MetricAlarm) {
+		return nil
+	}
+	m.logger.Info("modifying metricAlarm",
+		"resourceID", resAlarm.ID(),
+		"arn", awssdk.ToString(sdkAlarm.MetricAlarm.AlarmArn))
+	if _, err := m.cloudWatchClient.PutMetricAlarmWithContext(ctx, req); err != nil {
+		return err
+	}
+	m.logger.Info("modified metricAlarm",
+		"resourceID", resAlarm.ID(),
+		"arn", awssdk.ToString(sdkAlarm.MetricAlarm.AlarmArn))
+	return nil
+}
+
+func (m *defaultMetricAlarmManager) describeMetricAlarm(ctx context.Context, alarmName string) (cloudwatchtypes.MetricAlarm, error) {
+	alarms, err := m.cloudWatchClient.DescribeMetricAlarmsAsList(ctx, &cloudwatchsdk.DescribeAlarmsInput{
+		AlarmNames: []string{alarmName},
+		AlarmTypes: []cloudwatchtypes.AlarmType{cloudwatchtypes.AlarmTypeMetricAlarm},
+	})
+	if err != nil {
+		return cloudwatchtypes.MetricAlarm{}, err
+	}
+	if len(alarms) == 0 {
+		return cloudwatchtypes.MetricAlarm{}, errors.Errorf("metricAlarm not found: %v", alarmName)
+	}
+	return alarms[0], nil
+}
+
+func buildSDKPutMetricAlarmInput(ctx context.Context, alarmSpec cloudwatchmodel.MetricAlarmSpec) (*cloudwatchsdk.PutMetricAlarmInput, error) {
+	dimensions, err := buildSDKMetricAlarmDimensions(ctx, alarmSpec)
+	if err != nil {
+		return nil, err
+	}
+	return &cloudwatchsdk.PutMetricAlarmInput{
+		AlarmName:          awssdk.String(alarmSpec.AlarmName),
+		AlarmDescription:   alarmSpec.AlarmDescription,
+		Namespace:          awssdk.String(alarmSpec.Namespace),
+		MetricName:         awssdk.String(alarmSpec.MetricName),
+		Statistic:          cloudwatchtypes.Statistic(alarmSpec.Statistic),
+		ComparisonOperator: cloudwatchtypes.ComparisonOperator(alarmSpec.ComparisonOperator),
+		Threshold:          awssdk.Float64(alarmSpec.Threshold),
+		Period:             awssdk.Int32(alarmSpec.Period),
+		EvaluationPeriods:  awssdk.Int32(alarmSpec.EvaluationPeriods),
+		TreatMissingData:   awssdk.String(alarmSpec.TreatMissingData),
+		Dimensions:         dimensions,
+		ActionsEnabled:     awssdk.Bool(true),
+		AlarmActions:       alarmSpec.AlarmActions,
+	}, nil
+}
+
+// buildSDKMetricAlarmDimensions builds the LoadBalancer and TargetGroup dimensions from ARNs,
+// the dimension values are the resource part of the ARNs, e.g. app/my-lb/50dc6c495c0c9188 and targetgroup/my-tg/73e2d6bc24d8a067.
+func buildSDKMetricAlarmDimensions(ctx context.Context, alarmSpec cloudwatchmodel.MetricAlarmSpec) ([]cloudwatchtypes.Dimension, error) {
+	lbARN, err := alarmSpec.LoadBalancerARN.Resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lbDimensionValue, err := buildDimensionValueFromARN(lbARN, "loadbalancer/")
+	if err != nil {
+		return nil, err
+	}
+	dimensions := []cloudwatchtypes.Dimension{
+		{
+			Name:  awssdk.String(dimensionLoadBalancer),
+			Value: awssdk.String(lbDimensionValue),
+		},
+	}
+	if alarmSpec.TargetGroupARN != nil {
+		tgARN, err := alarmSpec.TargetGroupARN.Resolve(ctx)
+		if err != nil {
+			return nil, err
+		}
+		tgDimensionValue, err := buildDimensionValueFromARN(tgARN, "")
+		if err != nil {
+			return nil, err
+		}
+		dimensions = append(dimensions, cloudwatchtypes.Dimension{
+			Name:  awssdk.String(dimensionTargetGroup),
+			Value: awssdk.String(tgDimensionValue),
+		})
+	}
+	return dimensions, nil
+}
+
+func buildDimensionValueFromARN(rawARN string, resourcePrefix string) (string, error) {
+	parsedARN, err := arn.Parse(rawARN)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to parse ARN: %v", rawARN)
+	}
+	if !strings.HasPrefix(parsedARN.Resource, resourcePrefix) {
+		return "", errors.Errorf("unexpected ARN: %v", rawARN)
+	}
+	return strings.TrimPrefix(parsedARN.Resource, resourcePrefix), nil
+}
+
+func isSDKMetricAlarmSettingsDrifted(desired *cloudwatchsdk.PutMetricAlarmInput, sdkAlarm cloudwatchtypes.MetricAlarm) bool {
+	if awssdk.ToString(desired.AlarmDescription) != awssdk.ToString(sdkAlarm.AlarmDescription) ||
+		awssdk.ToString(desired.Namespace) != awssdk.ToString(sdkAlarm.Namespace) ||
+		awssdk.ToString(desired.MetricName) != awssdk.ToString(sdkAlarm.MetricName) ||
+		desired.Statistic != sdkAlarm.Statistic ||
+		desired.ComparisonOperator != sdkAlarm.ComparisonOperator ||
+		awssdk.ToFloat64(desired.Threshold) != awssdk.ToFloat64(sdkAlarm.Threshold) ||
+		awssdk.ToInt32(desired.Period) != awssdk.ToInt32(sdkAlarm.Period) ||
+		awssdk.ToInt32(desired.EvaluationPeriods) != awssdk.ToInt32(sdkAlarm.EvaluationPeriods) ||
+		awssdk.ToString(desired.TreatMissingData) != awssdk.ToString(sdkAlarm.TreatMissingData) ||
+		awssdk.ToBool(desired.ActionsEnabled) != awssdk.ToBool(sdkAlarm.ActionsEnabled) {
+		return true
+	}
+	if !sets.NewString(desired.AlarmActions...).Equal(sets.NewString(sdkAlarm.AlarmActions...)) {
+		return true
+	}
+	return !isSDKDimensionsEqual(desired.Dimensions, sdkAlarm.Dimensions)
+}
+
+func isSDKDimensionsEqual(lhs []cloudwatchtypes.Dimension, rhs []cloudwatchtypes.Dimension) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+	lhsValueByName := make(map[string]string, len(lhs))
+	for _, dimension := range lhs {
+		lhsValueByName[awssdk.ToString(dimension.Name)] = awssdk.ToString(dimension.Value)
+	}
+	for _, dimension := range rhs {
+		value, exists := lhsValueByName[awssdk.ToString(dimension.Name)]
+		if !exists || value != awssdk.ToString(dimension.Value) {
+			return false
+		}
+	}
+	return true
+}
+
+func buildResMetricAlarmStatus(sdkAlarm cloudwatchtypes.MetricAlarm) cloudwatchmodel.MetricAlarmStatus {
+	return cloudwatchmodel.MetricAlarmStatus{
+		AlarmARN: awssdk.ToString(sdkAlarm.AlarmArn),
+	}
+}
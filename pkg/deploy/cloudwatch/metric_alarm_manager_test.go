@@ -0,0 +1,158 @@
+package cloudwatch
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	cloudwatchsdk "github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cloudwatchtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	cloudwatchmodel "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/cloudwatch"
+	coremodel "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
+)
+
+func Test_buildSDKMetricAlarmDimensions(t *testing.T) {
+	tests := []struct {
+		name      string
+		alarmSpec cloudwatchmodel.MetricAlarmSpec
+		want      []cloudwatchtypes.Dimension
+		wantErr   error
+	}{
+		{
+			name: "LoadBalancer metric",
+			alarmSpec: cloudwatchmodel.MetricAlarmSpec{
+				LoadBalancerARN: coremodel.LiteralStringToken("arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188"),
+			},
+			want: []cloudwatchtypes.Dimension{
+				{
+					Name:  awssdk.String("LoadBalancer"),
+					Value: awssdk.String("app/my-lb/50dc6c495c0c9188"),
+				},
+			},
+		},
+		{
+			name: "TargetGroup metric",
+			alarmSpec: cloudwatchmodel.MetricAlarmSpec{
+				LoadBalancerARN: coremodel.LiteralStringToken("arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/net/my-lb/50dc6c495c0c9188"),
+				TargetGroupARN:  coremodel.LiteralStringToken("arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/my-tg/73e2d6bc24d8a067"),
+			},
+			want: []cloudwatchtypes.Dimension{
+				{
+					Name:  awssdk.String("LoadBalancer"),
+					Value: awssdk.String("net/my-lb/50dc6c495c0c9188"),
+				},
+				{
+					Name:  awssdk.String("TargetGroup"),
+					Value: awssdk.String("targetgroup/my-tg/73e2d6bc24d8a067"),
+				},
+			},
+		},
+		{
+			name: "unexpected LoadBalancer ARN",
+			alarmSpec: cloudwatchmodel.MetricAlarmSpec{
+				LoadBalancerARN: coremodel.LiteralStringToken("arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/my-tg/73e2d6bc24d8a067"),
+			},
+			wantErr: errors.New("unexpected ARN: arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/my-tg/73e2d6bc24d8a067"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildSDKMetricAlarmDimensions(context.Background(), tt.alarmSpec)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_isSDKMetricAlarmSettingsDrifted(t *testing.T) {
+	desired := &cloudwatchsdk.PutMetricAlarmInput{
+		AlarmName:          awssdk.String("k8s-ns-name-UnHealthyHostCount-0123456789"),
+		Namespace:          awssdk.String("AWS/ApplicationELB"),
+		MetricName:         awssdk.String("UnHealthyHostCount"),
+		Statistic:          cloudwatchtypes.StatisticMaximum,
+		ComparisonOperator: cloudwatchtypes.ComparisonOperatorGreaterThanOrEqualToThreshold,
+		Threshold:          awssdk.Float64(1),
+		Period:             awssdk.Int32(60),
+		EvaluationPeriods:  awssdk.Int32(3),
+		TreatMissingData:   awssdk.String("notBreaching"),
+		ActionsEnabled:     awssdk.Bool(true),
+		AlarmActions:       []string{"arn:aws:sns:us-west-2:123456789012:topic-1", "arn:aws:sns:us-west-2:123456789012:topic-2"},
+		Dimensions: []cloudwatchtypes.Dimension{
+			{Name: awssdk.String("LoadBalancer"), Value: awssdk.String("app/my-lb/50dc6c495c0c9188")},
+			{Name: awssdk.String("TargetGroup"), Value: awssdk.String("targetgroup/my-tg/73e2d6bc24d8a067")},
+		},
+	}
+	sdkAlarm := cloudwatchtypes.MetricAlarm{
+		AlarmName:          awssdk.String("k8s-ns-name-UnHealthyHostCount-0123456789"),
+		Namespace:          awssdk.String("AWS/ApplicationELB"),
+		MetricName:         awssdk.String("UnHealthyHostCount"),
+		Statistic:          cloudwatchtypes.StatisticMaximum,
+		ComparisonOperator: cloudwatchtypes.ComparisonOperatorGreaterThanOrEqualToThreshold,
+		Threshold:          awssdk.Float64(1),
+		Period:             awssdk.Int32(60),
+		EvaluationPeriods:  awssdk.Int32(3),
+		TreatMissingData:   awssdk.String("notBreaching"),
+		ActionsEnabled:     awssdk.Bool(true),
+		AlarmActions:       []string{"arn:aws:sns:us-west-2:123456789012:topic-2", "arn:aws:sns:us-west-2:123456789012:topic-1"},
+		Dimensions: []cloudwatchtypes.Dimension{
+			{Name: awssdk.String("TargetGroup"), Value: awssdk.String("targetgroup/my-tg/73e2d6bc24d8a067")},
+			{Name: awssdk.String("LoadBalancer"), Value: awssdk.String("app/my-lb/50dc6c495c0c9188")},
+		},
+	}
+	tests := []struct {
+		name     string
+		modifier func(alarm *cloudwatchtypes.MetricAlarm)
+		want     bool
+	}{
+		{
+			name:     "settings are in sync, regardless of actions and dimensions order",
+			modifier: func(alarm *cloudwatchtypes.MetricAlarm) {},
+			want:     false,
+		},
+		{
+			name: "threshold drifted",
+			modifier: func(alarm *cloudwatchtypes.MetricAlarm) {
+				alarm.Threshold = awssdk.Float64(2)
+			},
+			want: true,
+		},
+		{
+			name: "alarm actions drifted",
+			modifier: func(alarm *cloudwatchtypes.MetricAlarm) {
+				alarm.AlarmActions = []string{"arn:aws:sns:us-west-2:123456789012:topic-1"}
+			},
+			want: true,
+		},
+		{
+			name: "dimensions drifted",
+			modifier: func(alarm *cloudwatchtypes.MetricAlarm) {
+				alarm.Dimensions = []cloudwatchtypes.Dimension{
+					{Name: awssdk.String("LoadBalancer"), Value: awssdk.String("app/my-lb/50dc6c495c0c9188")},
+					{Name: awssdk.String("TargetGroup"), Value: awssdk.String("targetgroup/other-tg/73e2d6bc24d8a067")},
+				}
+			},
+			want: true,
+		},
+		{
+			name: "actions disabled",
+			modifier: func(alarm *cloudwatchtypes.MetricAlarm) {
+				alarm.ActionsEnabled = awssdk.Bool(false)
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			alarm := sdkAlarm
+			tt.modifier(&alarm)
+			got := isSDKMetricAlarmSettingsDrifted(desired, alarm)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
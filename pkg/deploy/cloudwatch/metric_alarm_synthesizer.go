@@ -0,0 +1,136 @@
+package cloudwatch
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/deploy/tracking"
+	cloudwatchmodel "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/cloudwatch"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
+)
+
+// NewMetricAlarmSynthesizer constructs new metricAlarmSynthesizer.
+func NewMetricAlarmSynthesizer(trackingProvider tracking.Provider, taggingManager TaggingManager,
+	alarmManager MetricAlarmManager, logger logr.Logger, stack core.Stack) *metricAlarmSynthesizer {
+	return &metricAlarmSynthesizer{
+		trackingProvider:   trackingProvider,
+		taggingManager:     taggingManager,
+		alarmManager:       alarmManager,
+		logger:             logger,
+		stack:              stack,
+		unmatchedSDKAlarms: nil,
+	}
+}
+
+type metricAlarmSynthesizer struct {
+	trackingProvider tracking.Provider
+	taggingManager   TaggingManager
+	alarmManager     MetricAlarmManager
+	logger           logr.Logger
+
+	stack              core.Stack
+	unmatchedSDKAlarms []MetricAlarmWithTags
+}
+
+func (s *metricAlarmSynthesizer) Synthesize(ctx context.Context) error {
+	var resAlarms []*cloudwatchmodel.MetricAlarm
+	s.stack.ListResources(&resAlarms)
+	sdkAlarms, err := s.findSDKMetricAlarms(ctx)
+	if err != nil {
+		return err
+	}
+	matchedResAndSDKAlarms, unmatchedResAlarms, unmatchedSDKAlarms, err := matchResAndSDKMetricAlarms(resAlarms, sdkAlarms, s.trackingProvider.ResourceIDTagKey())
+	if err != nil {
+		return err
+	}
+
+	// For MetricAlarm, we delete unmatched ones during post synthesize,
+	// so that alarms of deleted TargetGroups are removed along with them.
+	s.unmatchedSDKAlarms = unmatchedSDKAlarms
+
+	for _, resAlarm := range unmatchedResAlarms {
+		alarmStatus, err := s.alarmManager.Create(ctx, resAlarm)
+		if err != nil {
+			return err
+		}
+		resAlarm.SetStatus(alarmStatus)
+	}
+	for _, resAndSDKAlarm := range matchedResAndSDKAlarms {
+		alarmStatus, err := s.alarmManager.Update(ctx, resAndSDKAlarm.resAlarm, resAndSDKAlarm.sdkAlarm)
+		if err != nil {
+			return err
+		}
+		resAndSDKAlarm.resAlarm.SetStatus(alarmStatus)
+	}
+	return nil
+}
+
+func (s *metricAlarmSynthesizer) PostSynthesize(ctx context.Context) error {
+	for _, sdkAlarm := range s.unmatchedSDKAlarms {
+		if err := s.alarmManager.Delete(ctx, sdkAlarm); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// findSDKMetricAlarms will find all AWS CloudWatch metric alarms created for stack.
+func (s *metricAlarmSynthesizer) findSDKMetricAlarms(ctx context.Context) ([]MetricAlarmWithTags, error) {
+	stackTags := s.trackingProvider.StackTags(s.stack)
+	return s.taggingManager.ListMetricAlarms(ctx, tracking.TagsAsTagFilter(stackTags))
+}
+
+type resAndSDKMetricAlarmPair struct {
+	resAlarm *cloudwatchmodel.MetricAlarm
+	sdkAlarm MetricAlarmWithTags
+}
+
+func matchResAndSDKMetricAlarms(resAlarms []*cloudwatchmodel.MetricAlarm, sdkAlarms []MetricAlarmWithTags,
+	resourceIDTagKey string) ([]resAndSDKMetricAlarmPair, []*cloudwatchmodel.MetricAlarm, []MetricAlarmWithTags, error) {
+	var matchedResAndSDKAlarms []resAndSDKMetricAlarmPair
+	var unmatchedResAlarms []*cloudwatchmodel.MetricAlarm
+	var unmatchedSDKAlarms []MetricAlarmWithTags
+
+	resAlarmsByID := make(map[string]*cloudwatchmodel.MetricAlarm, len(resAlarms))
+	for _, resAlarm := range resAlarms {
+		resAlarmsByID[resAlarm.ID()] = resAlarm
+	}
+	sdkAlarmsByID, err := mapSDKMetricAlarmByResourceID(sdkAlarms, resourceIDTagKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	resAlarmIDs := sets.StringKeySet(resAlarmsByID)
+	sdkAlarmIDs := sets.StringKeySet(sdkAlarmsByID)
+	for _, resID := range resAlarmIDs.Intersection(sdkAlarmIDs).List() {
+		resAlarm := resAlarmsByID[resID]
+		sdkAlarms := sdkAlarmsByID[resID]
+		matchedResAndSDKAlarms = append(matchedResAndSDKAlarms, resAndSDKMetricAlarmPair{
+			resAlarm: resAlarm,
+			sdkAlarm: sdkAlarms[0],
+		})
+		unmatchedSDKAlarms = append(unmatchedSDKAlarms, sdkAlarms[1:]...)
+	}
+	for _, resID := range resAlarmIDs.Difference(sdkAlarmIDs).List() {
+		unmatchedResAlarms = append(unmatchedResAlarms, resAlarmsByID[resID])
+	}
+	for _, resID := range sdkAlarmIDs.Difference(resAlarmIDs).List() {
+		unmatchedSDKAlarms = append(unmatchedSDKAlarms, sdkAlarmsByID[resID]...)
+	}
+	return matchedResAndSDKAlarms, unmatchedResAlarms, unmatchedSDKAlarms, nil
+}
+
+func mapSDKMetricAlarmByResourceID(sdkAlarms []MetricAlarmWithTags, resourceIDTagKey string) (map[string][]MetricAlarmWithTags, error) {
+	sdkAlarmsByID := make(map[string][]MetricAlarmWithTags, len(sdkAlarms))
+	for _, sdkAlarm := range sdkAlarms {
+		resourceID, ok := sdkAlarm.Tags[resourceIDTagKey]
+		if !ok {
+			return nil, errors.Errorf("unexpected metricAlarm with no resourceID: %v", awssdk.ToString(sdkAlarm.MetricAlarm.AlarmArn))
+		}
+		sdkAlarmsByID[resourceID] = append(sdkAlarmsByID[resourceID], sdkAlarm)
+	}
+	return sdkAlarmsByID, nil
+}
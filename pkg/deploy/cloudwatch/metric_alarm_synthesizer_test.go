@@ -0,0 +1,127 @@
+package cloudwatch
+
+import (
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	cloudwatchtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	cloudwatchmodel "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/cloudwatch"
+	coremodel "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
+)
+
+func Test_matchResAndSDKMetricAlarms(t *testing.T) {
+	stack := coremodel.NewDefaultStack(coremodel.StackID{Namespace: "namespace", Name: "name"})
+	resAlarm := &cloudwatchmodel.MetricAlarm{
+		ResourceMeta: coremodel.NewResourceMeta(stack, "AWS::CloudWatch::Alarm", "HTTPCode_ELB_5XX_Count"),
+	}
+	sdkAlarm1 := MetricAlarmWithTags{
+		MetricAlarm: &cloudwatchtypes.MetricAlarm{
+			AlarmArn: awssdk.String("arn:aws:cloudwatch:us-west-2:123456789012:alarm:alarm-1"),
+		},
+		Tags: map[string]string{
+			"ingress.k8s.aws/resource": "HTTPCode_ELB_5XX_Count",
+		},
+	}
+	sdkAlarm2 := MetricAlarmWithTags{
+		MetricAlarm: &cloudwatchtypes.MetricAlarm{
+			AlarmArn: awssdk.String("arn:aws:cloudwatch:us-west-2:123456789012:alarm:alarm-2"),
+		},
+		Tags: map[string]string{
+			"ingress.k8s.aws/resource": "HTTPCode_ELB_5XX_Count",
+		},
+	}
+	sdkAlarmOther := MetricAlarmWithTags{
+		MetricAlarm: &cloudwatchtypes.MetricAlarm{
+			AlarmArn: awssdk.String("arn:aws:cloudwatch:us-west-2:123456789012:alarm:alarm-3"),
+		},
+		Tags: map[string]string{
+			"ingress.k8s.aws/resource": "namespace/ingress-svc:80/UnHealthyHostCount",
+		},
+	}
+	type args struct {
+		resAlarms        []*cloudwatchmodel.MetricAlarm
+		sdkAlarms        []MetricAlarmWithTags
+		resourceIDTagKey string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []resAndSDKMetricAlarmPair
+		want1   []*cloudwatchmodel.MetricAlarm
+		want2   []MetricAlarmWithTags
+		wantErr error
+	}{
+		{
+			name: "res MetricAlarm has match",
+			args: args{
+				resAlarms:        []*cloudwatchmodel.MetricAlarm{resAlarm},
+				sdkAlarms:        []MetricAlarmWithTags{sdkAlarm1},
+				resourceIDTagKey: "ingress.k8s.aws/resource",
+			},
+			want: []resAndSDKMetricAlarmPair{
+				{resAlarm: resAlarm, sdkAlarm: sdkAlarm1},
+			},
+		},
+		{
+			name: "res MetricAlarm has multiple matches",
+			args: args{
+				resAlarms:        []*cloudwatchmodel.MetricAlarm{resAlarm},
+				sdkAlarms:        []MetricAlarmWithTags{sdkAlarm1, sdkAlarm2},
+				resourceIDTagKey: "ingress.k8s.aws/resource",
+			},
+			want: []resAndSDKMetricAlarmPair{
+				{resAlarm: resAlarm, sdkAlarm: sdkAlarm1},
+			},
+			want2: []MetricAlarmWithTags{sdkAlarm2},
+		},
+		{
+			name: "res MetricAlarm don't have match",
+			args: args{
+				resAlarms:        []*cloudwatchmodel.MetricAlarm{resAlarm},
+				sdkAlarms:        []MetricAlarmWithTags{sdkAlarmOther},
+				resourceIDTagKey: "ingress.k8s.aws/resource",
+			},
+			want1: []*cloudwatchmodel.MetricAlarm{resAlarm},
+			want2: []MetricAlarmWithTags{sdkAlarmOther},
+		},
+		{
+			name: "no res MetricAlarm",
+			args: args{
+				sdkAlarms:        []MetricAlarmWithTags{sdkAlarm1},
+				resourceIDTagKey: "ingress.k8s.aws/resource",
+			},
+			want2: []MetricAlarmWithTags{sdkAlarm1},
+		},
+		{
+			name: "sdk MetricAlarm don't have resourceID tag",
+			args: args{
+				resAlarms: []*cloudwatchmodel.MetricAlarm{resAlarm},
+				sdkAlarms: []MetricAlarmWithTags{
+					{
+						MetricAlarm: &cloudwatchtypes.MetricAlarm{
+							AlarmArn: awssdk.String("arn:aws:cloudwatch:us-west-2:123456789012:alarm:alarm-1"),
+						},
+						Tags: map[string]string{},
+					},
+				},
+				resourceIDTagKey: "ingress.k8s.aws/resource",
+			},
+			wantErr: errors.New("unexpected metricAlarm with no resourceID: arn:aws:cloudwatch:us-west-2:123456789012:alarm:alarm-1"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1, got2, err := matchResAndSDKMetricAlarms(tt.args.resAlarms, tt.args.sdkAlarms, tt.args.resourceIDTagKey)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+				assert.Equal(t, tt.want1, got1)
+				assert.Equal(t, tt.want2, got2)
+			}
+		})
+	}
+}
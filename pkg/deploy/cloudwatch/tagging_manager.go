@@ -0,0 +1,223 @@
+package cloudwatch
+
+import (
+	"context"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	cloudwatchsdk "github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cloudwatchtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	rgtsdk "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	rgttypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/algorithm"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/deploy/tracking"
+)
+
+const (
+	// DescribeAlarms accepts at most 100 alarm names per request.
+	describeAlarmsMaxAlarmNames = 100
+)
+
+// options for ReconcileTags API.
+type ReconcileTagsOptions struct {
+	// CurrentTags on resources.
+	CurrentTags map[string]string
+
+	// IgnoredTagKeys defines the tag keys that should be ignored.
+	// these tags shouldn't be altered or deleted.
+	IgnoredTagKeys []string
+}
+
+func (opts *ReconcileTagsOptions) ApplyOptions(options []ReconcileTagsOption) {
+	for _, option := range options {
+		option(opts)
+	}
+}
+
+type ReconcileTagsOption func(opts *ReconcileTagsOptions)
+
+// WithCurrentTags is a reconcile option that supplies current tags.
+func WithCurrentTags(tags map[string]string) ReconcileTagsOption {
+	return func(opts *ReconcileTagsOptions) {
+		opts.CurrentTags = tags
+	}
+}
+
+// WithIgnoredTagKeys is a reconcile option that configures IgnoredTagKeys.
+func WithIgnoredTagKeys(ignoredTagKeys []string) ReconcileTagsOption {
+	return func(opts *ReconcileTagsOptions) {
+		opts.IgnoredTagKeys = append(opts.IgnoredTagKeys, ignoredTagKeys...)
+	}
+}
+
+// TaggingManager is an abstraction around tagging operations for CloudWatch.
+type TaggingManager interface {
+	// ReconcileTags will reconcile tags on alarm.
+	ReconcileTags(ctx context.Context, alarmARN string, desiredTags map[string]string, opts ...ReconcileTagsOption) error
+
+	// ListMetricAlarms returns metric alarms that matches any of the tagging requirements.
+	ListMetricAlarms(ctx context.Context, tagFilters ...tracking.TagFilter) ([]MetricAlarmWithTags, error)
+}
+
+// MetricAlarmWithTags represents an AWS CloudWatch metric alarm with its associated tags.
+type MetricAlarmWithTags struct {
+	MetricAlarm *cloudwatchtypes.MetricAlarm
+	Tags        map[string]string
+}
+
+// NewDefaultTaggingManager constructs new defaultTaggingManager.
+func NewDefaultTaggingManager(cloudWatchClient services.CloudWatch, rgt services.RGT, logger logr.Logger) *defaultTaggingManager {
+	return &defaultTaggingManager{
+		cloudWatchClient: cloudWatchClient,
+		rgt:              rgt,
+		logger:           logger,
+	}
+}
+
+var _ TaggingManager = &defaultTaggingManager{}
+
+// default implementation for TaggingManager.
+type defaultTaggingManager struct {
+	cloudWatchClient services.CloudWatch
+	rgt              services.RGT
+	logger           logr.Logger
+}
+
+func (m *defaultTaggingManager) ReconcileTags(ctx context.Context, alarmARN string, desiredTags map[string]string, opts ...ReconcileTagsOption) error {
+	reconcileOpts := ReconcileTagsOptions{
+		CurrentTags:    nil,
+		IgnoredTagKeys: nil,
+	}
+	reconcileOpts.ApplyOptions(opts)
+	currentTags := reconcileOpts.CurrentTags
+	if currentTags == nil {
+		return errors.New("currentTags must be specified")
+	}
+
+	tagsToUpdate, tagsToRemove := algorithm.DiffStringMapIgnoreAWSTags(desiredTags, currentTags)
+	for _, ignoredTagKey := range reconcileOpts.IgnoredTagKeys {
+		delete(tagsToUpdate, ignoredTagKey)
+		delete(tagsToRemove, ignoredTagKey)
+	}
+
+	if len(tagsToUpdate) > 0 {
+		req := &cloudwatchsdk.TagResourceInput{
+			ResourceARN: awssdk.String(alarmARN),
+			Tags:        convertTagsToSDKTags(tagsToUpdate),
+		}
+		m.logger.Info("adding resource tags",
+			"arn", alarmARN,
+			"change", tagsToUpdate)
+		if _, err := m.cloudWatchClient.TagResourceWithContext(ctx, req); err != nil {
+			return err
+		}
+		m.logger.Info("added resource tags",
+			"arn", alarmARN)
+	}
+
+	if len(tagsToRemove) > 0 {
+		req := &cloudwatchsdk.UntagResourceInput{
+			ResourceARN: awssdk.String(alarmARN),
+			TagKeys:     sets.StringKeySet(tagsToRemove).List(),
+		}
+		m.logger.Info("removing resource tags",
+			"arn", alarmARN,
+			"change", tagsToRemove)
+		if _, err := m.cloudWatchClient.UntagResourceWithContext(ctx, req); err != nil {
+			return err
+		}
+		m.logger.Info("removed resource tags",
+			"arn", alarmARN)
+	}
+	return nil
+}
+
+func (m *defaultTaggingManager) ListMetricAlarms(ctx context.Context, tagFilters ...tracking.TagFilter) ([]MetricAlarmWithTags, error) {
+	// use a map to avoid potential duplication in returned resources
+	resourceTagsByAlarmName := make(map[string][]rgttypes.Tag)
+	for _, tagFilter := range tagFilters {
+		req := &rgtsdk.GetResourcesInput{
+			TagFilters:          convertTagFiltersToRGTTagFilters(tagFilter),
+			ResourceTypeFilters: []string{services.ResourceTypeCloudWatchAlarm},
+		}
+		resources, err := m.rgt.GetResourcesAsList(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, resource := range resources {
+			alarmName, err := parseAlarmNameFromARN(awssdk.ToString(resource.ResourceARN))
+			if err != nil {
+				return nil, err
+			}
+			if _, exists := resourceTagsByAlarmName[alarmName]; !exists {
+				resourceTagsByAlarmName[alarmName] = resource.Tags
+			}
+		}
+	}
+
+	alarmNames := sets.StringKeySet(resourceTagsByAlarmName).List()
+	var matchedAlarms []MetricAlarmWithTags
+	for _, alarmNamesChunk := range algorithm.ChunkStrings(alarmNames, describeAlarmsMaxAlarmNames) {
+		req := &cloudwatchsdk.DescribeAlarmsInput{
+			AlarmNames: alarmNamesChunk,
+			AlarmTypes: []cloudwatchtypes.AlarmType{cloudwatchtypes.AlarmTypeMetricAlarm},
+		}
+		alarms, err := m.cloudWatchClient.DescribeMetricAlarmsAsList(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for i := range alarms {
+			alarm := alarms[i]
+			matchedAlarms = append(matchedAlarms, MetricAlarmWithTags{
+				MetricAlarm: &alarm,
+				Tags:        services.ParseRGTTags(resourceTagsByAlarmName[awssdk.ToString(alarm.AlarmName)]),
+			})
+		}
+	}
+	return matchedAlarms, nil
+}
+
+// parseAlarmNameFromARN parses the alarm name from alarm's ARN, which takes the form of arn:aws:cloudwatch:region:account-id:alarm:alarm-name.
+func parseAlarmNameFromARN(alarmARN string) (string, error) {
+	parsedARN, err := arn.Parse(alarmARN)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to parse alarm ARN: %v", alarmARN)
+	}
+	if !strings.HasPrefix(parsedARN.Resource, "alarm:") {
+		return "", errors.Errorf("unexpected alarm ARN: %v", alarmARN)
+	}
+	return strings.TrimPrefix(parsedARN.Resource, "alarm:"), nil
+}
+
+// convert tags into AWS SDK tag presentation.
+func convertTagsToSDKTags(tags map[string]string) []cloudwatchtypes.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	sdkTags := make([]cloudwatchtypes.Tag, 0, len(tags))
+
+	for _, key := range sets.StringKeySet(tags).List() {
+		sdkTags = append(sdkTags, cloudwatchtypes.Tag{
+			Key:   awssdk.String(key),
+			Value: awssdk.String(tags[key]),
+		})
+	}
+	return sdkTags
+}
+
+// convert tagFilters to RGTTagFilters
+func convertTagFiltersToRGTTagFilters(tagFilter tracking.TagFilter) []rgttypes.TagFilter {
+	var rgtTagFilters []rgttypes.TagFilter
+	for k, v := range tagFilter {
+		rgtTagFilters = append(rgtTagFilters, rgttypes.TagFilter{
+			Key:    awssdk.String(k),
+			Values: v,
+		})
+	}
+	return rgtTagFilters
+}
@@ -0,0 +1,44 @@
+package cloudwatch
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseAlarmNameFromARN(t *testing.T) {
+	tests := []struct {
+		name     string
+		alarmARN string
+		want     string
+		wantErr  error
+	}{
+		{
+			name:     "valid alarm ARN",
+			alarmARN: "arn:aws:cloudwatch:us-west-2:123456789012:alarm:k8s-ns-name-UnHealthyHostCount-0123456789",
+			want:     "k8s-ns-name-UnHealthyHostCount-0123456789",
+		},
+		{
+			name:     "not an alarm ARN",
+			alarmARN: "arn:aws:cloudwatch:us-west-2:123456789012:dashboard/my-dashboard",
+			wantErr:  errors.New("unexpected alarm ARN: arn:aws:cloudwatch:us-west-2:123456789012:dashboard/my-dashboard"),
+		},
+		{
+			name:     "invalid ARN",
+			alarmARN: "k8s-ns-name-UnHealthyHostCount-0123456789",
+			wantErr:  errors.New("failed to parse alarm ARN: k8s-ns-name-UnHealthyHostCount-0123456789: arn: invalid prefix"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseAlarmNameFromARN(tt.alarmARN)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -97,6 +97,9 @@ func (m *defaultSecurityGroupManager) Create(ctx context.Context, resSG *ec2mode
 	if err := m.networkingSGReconciler.ReconcileIngress(ctx, sgID, permissionInfos); err != nil {
 		return ec2model.SecurityGroupStatus{}, err
 	}
+	if err := m.reconcileSDKSecurityGroupEgress(ctx, resSG, sgID); err != nil {
+		return ec2model.SecurityGroupStatus{}, err
+	}
 
 	return ec2model.SecurityGroupStatus{
 		GroupID: sgID,
@@ -114,6 +117,9 @@ func (m *defaultSecurityGroupManager) Update(ctx context.Context, resSG *ec2mode
 	if err := m.networkingSGReconciler.ReconcileIngress(ctx, sdkSG.SecurityGroupID, permissionInfos); err != nil {
 		return ec2model.SecurityGroupStatus{}, err
 	}
+	if err := m.reconcileSDKSecurityGroupEgress(ctx, resSG, sdkSG.SecurityGroupID); err != nil {
+		return ec2model.SecurityGroupStatus{}, err
+	}
 	return ec2model.SecurityGroupStatus{
 		GroupID: sdkSG.SecurityGroupID,
 	}, nil
@@ -155,6 +161,20 @@ func (m *defaultSecurityGroupManager) updateSDKSecurityGroupGroupWithTags(ctx co
 		WithIgnoredTagKeys(m.externalManagedTags))
 }
 
+// reconcileSDKSecurityGroupEgress reconciles the egress permissions of securityGroup if they are modeled.
+// egress permissions managed for TargetGroupBinding networking are left untouched, they're reconciled by the networking manager.
+func (m *defaultSecurityGroupManager) reconcileSDKSecurityGroupEgress(ctx context.Context, resSG *ec2model.SecurityGroup, sgID string) error {
+	if resSG.Spec.Egress == nil {
+		return nil
+	}
+	permissionInfos, err := buildIPPermissionInfos(resSG.Spec.Egress)
+	if err != nil {
+		return err
+	}
+	return m.networkingSGReconciler.ReconcileEgress(ctx, sgID, permissionInfos,
+		networking.WithPermissionSelector(networking.NewNonTGBNetworkingPermissionSelector()))
+}
+
 func buildIPPermissionInfos(permissions []ec2model.IPPermission) ([]networking.IPPermissionInfo, error) {
 	permissionInfos := make([]networking.IPPermissionInfo, 0, len(permissions))
 	for _, permission := range permissions {
@@ -56,6 +56,15 @@ type TaggingManager interface {
 
 	// ListSecurityGroups returns SecurityGroups that matches any of the tagging requirements.
 	ListSecurityGroups(ctx context.Context, tagFilters ...tracking.TagFilter) ([]networking.SecurityGroupInfo, error)
+
+	// ListVPCEndpointServices returns VPC endpoint services that matches any of the tagging requirements.
+	ListVPCEndpointServices(ctx context.Context, tagFilters ...tracking.TagFilter) ([]VPCEndpointServiceWithTags, error)
+}
+
+// VPCEndpointServiceWithTags represents an AWS VPC endpoint service with its associated tags.
+type VPCEndpointServiceWithTags struct {
+	ServiceConfiguration *ec2types.ServiceConfiguration
+	Tags                 map[string]string
 }
 
 // NewDefaultTaggingManager constructs new defaultTaggingManager.
@@ -149,6 +158,32 @@ func (m *defaultTaggingManager) ListSecurityGroups(ctx context.Context, tagFilte
 	return sgInfos, nil
 }
 
+func (m *defaultTaggingManager) ListVPCEndpointServices(ctx context.Context, tagFilters ...tracking.TagFilter) ([]VPCEndpointServiceWithTags, error) {
+	esByID := make(map[string]VPCEndpointServiceWithTags)
+	for _, tagFilter := range tagFilters {
+		req := &ec2sdk.DescribeVpcEndpointServiceConfigurationsInput{
+			Filters: convertTagFilterToSDKFilters(tagFilter),
+		}
+		serviceConfigs, err := m.ec2Client.DescribeVpcEndpointServiceConfigurationsAsList(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for i := range serviceConfigs {
+			serviceConfig := serviceConfigs[i]
+			esByID[awssdk.ToString(serviceConfig.ServiceId)] = VPCEndpointServiceWithTags{
+				ServiceConfiguration: &serviceConfig,
+				Tags:                 convertSDKTagsToTags(serviceConfig.Tags),
+			}
+		}
+	}
+
+	esList := make([]VPCEndpointServiceWithTags, 0, len(esByID))
+	for _, es := range esByID {
+		esList = append(esList, es)
+	}
+	return esList, nil
+}
+
 func (m *defaultTaggingManager) listSecurityGroupsWithTagFilter(ctx context.Context, tagFilter tracking.TagFilter) (map[string]networking.SecurityGroupInfo, error) {
 	req := &ec2sdk.DescribeSecurityGroupsInput{
 		Filters: []ec2types.Filter{
@@ -159,6 +194,13 @@ func (m *defaultTaggingManager) listSecurityGroupsWithTagFilter(ctx context.Cont
 		},
 	}
 
+	req.Filters = append(req.Filters, convertTagFilterToSDKFilters(tagFilter)...)
+	return m.networkingSGManager.FetchSGInfosByRequest(ctx, req)
+}
+
+// convert tagFilter into AWS SDK filters.
+func convertTagFilterToSDKFilters(tagFilter tracking.TagFilter) []ec2types.Filter {
+	var filters []ec2types.Filter
 	for _, tagKey := range sets.StringKeySet(tagFilter).List() {
 		tagValues := tagFilter[tagKey]
 		var filter ec2types.Filter
@@ -171,10 +213,18 @@ func (m *defaultTaggingManager) listSecurityGroupsWithTagFilter(ctx context.Cont
 			filter.Name = awssdk.String(tagFilterName)
 			filter.Values = tagValues
 		}
-		req.Filters = append(req.Filters, filter)
+		filters = append(filters, filter)
 	}
+	return filters
+}
 
-	return m.networkingSGManager.FetchSGInfosByRequest(ctx, req)
+// convert AWS SDK tag presentation into tags.
+func convertSDKTagsToTags(sdkTags []ec2types.Tag) map[string]string {
+	tags := make(map[string]string, len(sdkTags))
+	for _, sdkTag := range sdkTags {
+		tags[awssdk.ToString(sdkTag.Key)] = awssdk.ToString(sdkTag.Value)
+	}
+	return tags
 }
 
 // convert tags into AWS SDK tag presentation.
@@ -0,0 +1,346 @@
+package ec2
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/deploy/tracking"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/ec2"
+)
+
+// values for the vpc-endpoint-state filter of DescribeVpcEndpointConnections.
+const (
+	vpcEndpointStatePendingAcceptance = "pendingAcceptance"
+	vpcEndpointStatePending           = "pending"
+	vpcEndpointStateAvailable         = "available"
+)
+
+// VPCEndpointServiceManager is responsible for create/update/delete VPCEndpointService resources.
+type VPCEndpointServiceManager interface {
+	Create(ctx context.Context, resES *ec2model.VPCEndpointService) (ec2model.VPCEndpointServiceStatus, error)
+
+	Update(ctx context.Context, resES *ec2model.VPCEndpointService, sdkES VPCEndpointServiceWithTags) (ec2model.VPCEndpointServiceStatus, error)
+
+	Delete(ctx context.Context, sdkES VPCEndpointServiceWithTags) error
+
+	// RemoveLoadBalancers removes the LoadBalancers from a VPCEndpointService, so that they can be deleted.
+	RemoveLoadBalancers(ctx context.Context, sdkES VPCEndpointServiceWithTags, lbARNs []string) error
+}
+
+// NewDefaultVPCEndpointServiceManager constructs new defaultVPCEndpointServiceManager.
+func NewDefaultVPCEndpointServiceManager(ec2Client services.EC2, trackingProvider tracking.Provider, taggingManager TaggingManager,
+	externalManagedTags []string, logger logr.Logger) *defaultVPCEndpointServiceManager {
+	return &defaultVPCEndpointServiceManager{
+		ec2Client:           ec2Client,
+		trackingProvider:    trackingProvider,
+		taggingManager:      taggingManager,
+		externalManagedTags: externalManagedTags,
+		logger:              logger,
+	}
+}
+
+// default implementation for VPCEndpointServiceManager.
+type defaultVPCEndpointServiceManager struct {
+	ec2Client           services.EC2
+	trackingProvider    tracking.Provider
+	taggingManager      TaggingManager
+	externalManagedTags []string
+	logger              logr.Logger
+}
+
+func (m *defaultVPCEndpointServiceManager) Create(ctx context.Context, resES *ec2model.VPCEndpointService) (ec2model.VPCEndpointServiceStatus, error) {
+	esTags := m.trackingProvider.ResourceTags(resES.Stack(), resES, resES.Spec.Tags)
+	lbARN, err := resES.Spec.NetworkLoadBalancerARN.Resolve(ctx)
+	if err != nil {
+		return ec2model.VPCEndpointServiceStatus{}, err
+	}
+	req := &ec2sdk.CreateVpcEndpointServiceConfigurationInput{
+		NetworkLoadBalancerArns: []string{lbARN},
+		AcceptanceRequired:      awssdk.Bool(resES.Spec.AcceptanceRequired),
+		PrivateDnsName:          resES.Spec.PrivateDNSName,
+		TagSpecifications: []ec2types.TagSpecification{
+			{
+				ResourceType: ec2types.ResourceTypeVpcEndpointService,
+				Tags:         convertTagsToSDKTags(esTags),
+			},
+		},
+	}
+	m.logger.Info("creating vpcEndpointService",
+		"resourceID", resES.ID())
+	resp, err := m.ec2Client.CreateVpcEndpointServiceConfigurationWithContext(ctx, req)
+	if err != nil {
+		return ec2model.VPCEndpointServiceStatus{}, err
+	}
+	serviceID := awssdk.ToString(resp.ServiceConfiguration.ServiceId)
+	m.logger.Info("created vpcEndpointService",
+		"resourceID", resES.ID(),
+		"serviceID", serviceID)
+
+	if err := m.reconcileAllowedPrincipals(ctx, resES, serviceID); err != nil {
+		return ec2model.VPCEndpointServiceStatus{}, err
+	}
+	return buildResVPCEndpointServiceStatus(*resp.ServiceConfiguration), nil
+}
+
+func (m *defaultVPCEndpointServiceManager) Update(ctx context.Context, resES *ec2model.VPCEndpointService, sdkES VPCEndpointServiceWithTags) (ec2model.VPCEndpointServiceStatus, error) {
+	serviceID := awssdk.ToString(sdkES.ServiceConfiguration.ServiceId)
+	if err := m.updateSDKVPCEndpointServiceWithTags(ctx, resES, sdkES); err != nil {
+		return ec2model.VPCEndpointServiceStatus{}, err
+	}
+	if err := m.updateSDKVPCEndpointServiceConfiguration(ctx, resES, sdkES); err != nil {
+		return ec2model.VPCEndpointServiceStatus{}, err
+	}
+	if err := m.reconcileAllowedPrincipals(ctx, resES, serviceID); err != nil {
+		return ec2model.VPCEndpointServiceStatus{}, err
+	}
+	if err := m.acceptPendingConnections(ctx, resES, serviceID); err != nil {
+		return ec2model.VPCEndpointServiceStatus{}, err
+	}
+	return buildResVPCEndpointServiceStatus(*sdkES.ServiceConfiguration), nil
+}
+
+func (m *defaultVPCEndpointServiceManager) Delete(ctx context.Context, sdkES VPCEndpointServiceWithTags) error {
+	serviceID := awssdk.ToString(sdkES.ServiceConfiguration.ServiceId)
+	if err := m.rejectActiveConnections(ctx, serviceID); err != nil {
+		return err
+	}
+
+	m.logger.Info("deleting vpcEndpointService",
+		"serviceID", serviceID)
+	resp, err := m.ec2Client.DeleteVpcEndpointServiceConfigurationsWithContext(ctx, &ec2sdk.DeleteVpcEndpointServiceConfigurationsInput{
+		ServiceIds: []string{serviceID},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to delete vpcEndpointService")
+	}
+	for _, item := range resp.Unsuccessful {
+		if item.Error != nil {
+			return errors.Errorf("failed to delete vpcEndpointService %v: %v", serviceID, awssdk.ToString(item.Error.Message))
+		}
+	}
+	m.logger.Info("deleted vpcEndpointService",
+		"serviceID", serviceID)
+	return nil
+}
+
+func (m *defaultVPCEndpointServiceManager) RemoveLoadBalancers(ctx context.Context, sdkES VPCEndpointServiceWithTags, lbARNs []string) error {
+	serviceID := awssdk.ToString(sdkES.ServiceConfiguration.ServiceId)
+	req := &ec2sdk.ModifyVpcEndpointServiceConfigurationInput{
+		ServiceId:                     awssdk.String(serviceID),
+		RemoveNetworkLoadBalancerArns: lbARNs,
+	}
+	m.logger.Info("removing loadBalancers from vpcEndpointService",
+		"serviceID", serviceID,
+		"loadBalancers", lbARNs)
+	if _, err := m.ec2Client.ModifyVpcEndpointServiceConfigurationWithContext(ctx, req); err != nil {
+		return errors.Wrap(err, "failed to remove loadBalancers from vpcEndpointService")
+	}
+	m.logger.Info("removed loadBalancers from vpcEndpointService",
+		"serviceID", serviceID)
+	return nil
+}
+
+func (m *defaultVPCEndpointServiceManager) updateSDKVPCEndpointServiceWithTags(ctx context.Context, resES *ec2model.VPCEndpointService, sdkES VPCEndpointServiceWithTags) error {
+	desiredESTags := m.trackingProvider.ResourceTags(resES.Stack(), resES, resES.Spec.Tags)
+	return m.taggingManager.ReconcileTags(ctx, awssdk.ToString(sdkES.ServiceConfiguration.ServiceId), desiredESTags,
+		WithCurrentTags(sdkES.Tags),
+		WithIgnoredTagKeys(m.trackingProvider.LegacyTagKeys()),
+		WithIgnoredTagKeys(m.externalManagedTags))
+}
+
+func (m *defaultVPCEndpointServiceManager) updateSDKVPCEndpointServiceConfiguration(ctx context.Context, resES *ec2model.VPCEndpointService, sdkES VPCEndpointServiceWithTags) error {
+	lbARN, err := resES.Spec.NetworkLoadBalancerARN.Resolve(ctx)
+	if err != nil {
+		return err
+	}
+	sdkConfig := sdkES.ServiceConfiguration
+	serviceID := awssdk.ToString(sdkConfig.ServiceId)
+	req := &ec2sdk.ModifyVpcEndpointServiceConfigurationInput{
+		ServiceId: awssdk.String(serviceID),
+	}
+	needsUpdate := false
+	if awssdk.ToBool(sdkConfig.AcceptanceRequired) != resES.Spec.AcceptanceRequired {
+		req.AcceptanceRequired = awssdk.Bool(resES.Spec.AcceptanceRequired)
+		needsUpdate = true
+	}
+	desiredPrivateDNSName := awssdk.ToString(resES.Spec.PrivateDNSName)
+	if awssdk.ToString(sdkConfig.PrivateDnsName) != desiredPrivateDNSName {
+		if desiredPrivateDNSName == "" {
+			req.RemovePrivateDnsName = awssdk.Bool(true)
+		} else {
+			req.PrivateDnsName = awssdk.String(desiredPrivateDNSName)
+		}
+		needsUpdate = true
+	}
+	currentLBARNs := sets.New(sdkConfig.NetworkLoadBalancerArns...)
+	desiredLBARNs := sets.New(lbARN)
+	if !currentLBARNs.Equal(desiredLBARNs) {
+		req.AddNetworkLoadBalancerArns = sets.List(desiredLBARNs.Difference(currentLBARNs))
+		req.RemoveNetworkLoadBalancerArns = sets.List(currentLBARNs.Difference(desiredLBARNs))
+		needsUpdate = true
+	}
+	if !needsUpdate {
+		return nil
+	}
+
+	m.logger.Info("modifying vpcEndpointService",
+		"resourceID", resES.ID(),
+		"serviceID", serviceID)
+	if _, err := m.ec2Client.ModifyVpcEndpointServiceConfigurationWithContext(ctx, req); err != nil {
+		return err
+	}
+	m.logger.Info("modified vpcEndpointService",
+		"resourceID", resES.ID(),
+		"serviceID", serviceID)
+	return nil
+}
+
+func (m *defaultVPCEndpointServiceManager) reconcileAllowedPrincipals(ctx context.Context, resES *ec2model.VPCEndpointService, serviceID string) error {
+	sdkPrincipals, err := m.ec2Client.DescribeVpcEndpointServicePermissionsAsList(ctx, &ec2sdk.DescribeVpcEndpointServicePermissionsInput{
+		ServiceId: awssdk.String(serviceID),
+	})
+	if err != nil {
+		return err
+	}
+	currentPrincipals := sets.New[string]()
+	for _, sdkPrincipal := range sdkPrincipals {
+		currentPrincipals.Insert(awssdk.ToString(sdkPrincipal.Principal))
+	}
+	desiredPrincipals := sets.New(resES.Spec.AllowedPrincipals...)
+	principalsToAdd := desiredPrincipals.Difference(currentPrincipals)
+	principalsToRemove := currentPrincipals.Difference(desiredPrincipals)
+	if len(principalsToAdd) == 0 && len(principalsToRemove) == 0 {
+		return nil
+	}
+
+	req := &ec2sdk.ModifyVpcEndpointServicePermissionsInput{
+		ServiceId:               awssdk.String(serviceID),
+		AddAllowedPrincipals:    sets.List(principalsToAdd),
+		RemoveAllowedPrincipals: sets.List(principalsToRemove),
+	}
+	m.logger.Info("modifying vpcEndpointService permissions",
+		"serviceID", serviceID,
+		"addAllowedPrincipals", req.AddAllowedPrincipals,
+		"removeAllowedPrincipals", req.RemoveAllowedPrincipals)
+	if _, err := m.ec2Client.ModifyVpcEndpointServicePermissionsWithContext(ctx, req); err != nil {
+		return err
+	}
+	m.logger.Info("modified vpcEndpointService permissions",
+		"serviceID", serviceID)
+	return nil
+}
+
+// acceptPendingConnections accepts pending endpoint connections owned by the accounts of allowed principals.
+func (m *defaultVPCEndpointServiceManager) acceptPendingConnections(ctx context.Context, resES *ec2model.VPCEndpointService, serviceID string) error {
+	if !resES.Spec.AcceptanceRequired {
+		return nil
+	}
+	allowedAccountIDs := extractPrincipalAccountIDs(resES.Spec.AllowedPrincipals)
+	if len(allowedAccountIDs) == 0 {
+		return nil
+	}
+	connections, err := m.describeVPCEndpointConnections(ctx, serviceID, vpcEndpointStatePendingAcceptance)
+	if err != nil {
+		return err
+	}
+	var endpointIDs []string
+	for _, connection := range connections {
+		if allowedAccountIDs.Has(awssdk.ToString(connection.VpcEndpointOwner)) {
+			endpointIDs = append(endpointIDs, awssdk.ToString(connection.VpcEndpointId))
+		}
+	}
+	if len(endpointIDs) == 0 {
+		return nil
+	}
+
+	m.logger.Info("accepting vpcEndpoint connections",
+		"serviceID", serviceID,
+		"vpcEndpointIDs", endpointIDs)
+	resp, err := m.ec2Client.AcceptVpcEndpointConnectionsWithContext(ctx, &ec2sdk.AcceptVpcEndpointConnectionsInput{
+		ServiceId:      awssdk.String(serviceID),
+		VpcEndpointIds: endpointIDs,
+	})
+	if err != nil {
+		return err
+	}
+	for _, item := range resp.Unsuccessful {
+		if item.Error != nil {
+			return errors.Errorf("failed to accept vpcEndpoint connection %v: %v", awssdk.ToString(item.ResourceId), awssdk.ToString(item.Error.Message))
+		}
+	}
+	m.logger.Info("accepted vpcEndpoint connections",
+		"serviceID", serviceID)
+	return nil
+}
+
+// rejectActiveConnections rejects all endpoint connections, which is required before the endpoint service can be deleted.
+func (m *defaultVPCEndpointServiceManager) rejectActiveConnections(ctx context.Context, serviceID string) error {
+	connections, err := m.describeVPCEndpointConnections(ctx, serviceID,
+		vpcEndpointStatePendingAcceptance, vpcEndpointStatePending, vpcEndpointStateAvailable)
+	if err != nil {
+		return err
+	}
+	if len(connections) == 0 {
+		return nil
+	}
+	endpointIDs := make([]string, 0, len(connections))
+	for _, connection := range connections {
+		endpointIDs = append(endpointIDs, awssdk.ToString(connection.VpcEndpointId))
+	}
+
+	m.logger.Info("rejecting vpcEndpoint connections",
+		"serviceID", serviceID,
+		"vpcEndpointIDs", endpointIDs)
+	if _, err := m.ec2Client.RejectVpcEndpointConnectionsWithContext(ctx, &ec2sdk.RejectVpcEndpointConnectionsInput{
+		ServiceId:      awssdk.String(serviceID),
+		VpcEndpointIds: endpointIDs,
+	}); err != nil {
+		return errors.Wrap(err, "failed to reject vpcEndpoint connections")
+	}
+	m.logger.Info("rejected vpcEndpoint connections",
+		"serviceID", serviceID)
+	return nil
+}
+
+func (m *defaultVPCEndpointServiceManager) describeVPCEndpointConnections(ctx context.Context, serviceID string, states ...string) ([]ec2types.VpcEndpointConnection, error) {
+	return m.ec2Client.DescribeVpcEndpointConnectionsAsList(ctx, &ec2sdk.DescribeVpcEndpointConnectionsInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   awssdk.String("service-id"),
+				Values: []string{serviceID},
+			},
+			{
+				Name:   awssdk.String("vpc-endpoint-state"),
+				Values: states,
+			},
+		},
+	})
+}
+
+// extractPrincipalAccountIDs returns the AWS account IDs of principal ARNs.
+// wildcard principals and principals without an account ID(e.g. organizations) are ignored.
+func extractPrincipalAccountIDs(principals []string) sets.Set[string] {
+	accountIDs := sets.New[string]()
+	for _, principal := range principals {
+		principalARN, err := arn.Parse(principal)
+		if err != nil || principalARN.AccountID == "" || principalARN.AccountID == "*" {
+			continue
+		}
+		accountIDs.Insert(principalARN.AccountID)
+	}
+	return accountIDs
+}
+
+func buildResVPCEndpointServiceStatus(sdkConfig ec2types.ServiceConfiguration) ec2model.VPCEndpointServiceStatus {
+	return ec2model.VPCEndpointServiceStatus{
+		ServiceID:   awssdk.ToString(sdkConfig.ServiceId),
+		ServiceName: awssdk.ToString(sdkConfig.ServiceName),
+	}
+}
@@ -0,0 +1,201 @@
+package ec2
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/services"
+	coremodel "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/ec2"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_defaultVPCEndpointServiceManager_reconcileAllowedPrincipals(t *testing.T) {
+	tests := []struct {
+		name              string
+		allowedPrincipals []string
+		sdkPrincipals     []ec2types.AllowedPrincipal
+		wantModifyReq     *ec2sdk.ModifyVpcEndpointServicePermissionsInput
+	}{
+		{
+			name:              "principals already up to date",
+			allowedPrincipals: []string{"arn:aws:iam::111122223333:root"},
+			sdkPrincipals: []ec2types.AllowedPrincipal{
+				{Principal: awssdk.String("arn:aws:iam::111122223333:root")},
+			},
+		},
+		{
+			name:              "principals added and removed",
+			allowedPrincipals: []string{"arn:aws:iam::111122223333:root", "arn:aws:iam::444455556666:role/consumer"},
+			sdkPrincipals: []ec2types.AllowedPrincipal{
+				{Principal: awssdk.String("arn:aws:iam::111122223333:root")},
+				{Principal: awssdk.String("arn:aws:iam::777788889999:root")},
+			},
+			wantModifyReq: &ec2sdk.ModifyVpcEndpointServicePermissionsInput{
+				ServiceId:               awssdk.String("vpce-svc-1"),
+				AddAllowedPrincipals:    []string{"arn:aws:iam::444455556666:role/consumer"},
+				RemoveAllowedPrincipals: []string{"arn:aws:iam::777788889999:root"},
+			},
+		},
+		{
+			name: "all principals removed",
+			sdkPrincipals: []ec2types.AllowedPrincipal{
+				{Principal: awssdk.String("*")},
+			},
+			wantModifyReq: &ec2sdk.ModifyVpcEndpointServicePermissionsInput{
+				ServiceId:               awssdk.String("vpce-svc-1"),
+				AddAllowedPrincipals:    []string{},
+				RemoveAllowedPrincipals: []string{"*"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ec2Client := services.NewMockEC2(ctrl)
+			ec2Client.EXPECT().DescribeVpcEndpointServicePermissionsAsList(gomock.Any(), &ec2sdk.DescribeVpcEndpointServicePermissionsInput{
+				ServiceId: awssdk.String("vpce-svc-1"),
+			}).Return(tt.sdkPrincipals, nil)
+			if tt.wantModifyReq != nil {
+				ec2Client.EXPECT().ModifyVpcEndpointServicePermissionsWithContext(gomock.Any(), tt.wantModifyReq).
+					Return(&ec2sdk.ModifyVpcEndpointServicePermissionsOutput{}, nil)
+			}
+			m := &defaultVPCEndpointServiceManager{
+				ec2Client: ec2Client,
+				logger:    logr.New(&log.NullLogSink{}),
+			}
+			stack := coremodel.NewDefaultStack(coremodel.StackID{Namespace: "namespace", Name: "name"})
+			resES := &ec2model.VPCEndpointService{
+				ResourceMeta: coremodel.NewResourceMeta(stack, "AWS::EC2::VPCEndpointService", "VPCEndpointService"),
+				Spec: ec2model.VPCEndpointServiceSpec{
+					AllowedPrincipals: tt.allowedPrincipals,
+				},
+			}
+			err := m.reconcileAllowedPrincipals(context.Background(), resES, "vpce-svc-1")
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_defaultVPCEndpointServiceManager_acceptPendingConnections(t *testing.T) {
+	tests := []struct {
+		name               string
+		spec               ec2model.VPCEndpointServiceSpec
+		pendingConnections []ec2types.VpcEndpointConnection
+		wantDescribe       bool
+		wantAcceptedIDs    []string
+	}{
+		{
+			name: "acceptance not required",
+			spec: ec2model.VPCEndpointServiceSpec{
+				AllowedPrincipals: []string{"arn:aws:iam::111122223333:root"},
+			},
+		},
+		{
+			name: "no principal account to accept",
+			spec: ec2model.VPCEndpointServiceSpec{
+				AcceptanceRequired: true,
+				AllowedPrincipals:  []string{"*"},
+			},
+		},
+		{
+			name: "connections from allowed accounts are accepted",
+			spec: ec2model.VPCEndpointServiceSpec{
+				AcceptanceRequired: true,
+				AllowedPrincipals:  []string{"arn:aws:iam::111122223333:root", "arn:aws:iam::444455556666:role/consumer"},
+			},
+			pendingConnections: []ec2types.VpcEndpointConnection{
+				{VpcEndpointId: awssdk.String("vpce-1"), VpcEndpointOwner: awssdk.String("111122223333")},
+				{VpcEndpointId: awssdk.String("vpce-2"), VpcEndpointOwner: awssdk.String("777788889999")},
+				{VpcEndpointId: awssdk.String("vpce-3"), VpcEndpointOwner: awssdk.String("444455556666")},
+			},
+			wantDescribe:    true,
+			wantAcceptedIDs: []string{"vpce-1", "vpce-3"},
+		},
+		{
+			name: "no connections from allowed accounts",
+			spec: ec2model.VPCEndpointServiceSpec{
+				AcceptanceRequired: true,
+				AllowedPrincipals:  []string{"arn:aws:iam::111122223333:root"},
+			},
+			pendingConnections: []ec2types.VpcEndpointConnection{
+				{VpcEndpointId: awssdk.String("vpce-2"), VpcEndpointOwner: awssdk.String("777788889999")},
+			},
+			wantDescribe: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ec2Client := services.NewMockEC2(ctrl)
+			if tt.wantDescribe {
+				ec2Client.EXPECT().DescribeVpcEndpointConnectionsAsList(gomock.Any(), &ec2sdk.DescribeVpcEndpointConnectionsInput{
+					Filters: []ec2types.Filter{
+						{
+							Name:   awssdk.String("service-id"),
+							Values: []string{"vpce-svc-1"},
+						},
+						{
+							Name:   awssdk.String("vpc-endpoint-state"),
+							Values: []string{"pendingAcceptance"},
+						},
+					},
+				}).Return(tt.pendingConnections, nil)
+			}
+			if len(tt.wantAcceptedIDs) != 0 {
+				ec2Client.EXPECT().AcceptVpcEndpointConnectionsWithContext(gomock.Any(), &ec2sdk.AcceptVpcEndpointConnectionsInput{
+					ServiceId:      awssdk.String("vpce-svc-1"),
+					VpcEndpointIds: tt.wantAcceptedIDs,
+				}).Return(&ec2sdk.AcceptVpcEndpointConnectionsOutput{}, nil)
+			}
+			m := &defaultVPCEndpointServiceManager{
+				ec2Client: ec2Client,
+				logger:    logr.New(&log.NullLogSink{}),
+			}
+			stack := coremodel.NewDefaultStack(coremodel.StackID{Namespace: "namespace", Name: "name"})
+			resES := &ec2model.VPCEndpointService{
+				ResourceMeta: coremodel.NewResourceMeta(stack, "AWS::EC2::VPCEndpointService", "VPCEndpointService"),
+				Spec:         tt.spec,
+			}
+			err := m.acceptPendingConnections(context.Background(), resES, "vpce-svc-1")
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_extractPrincipalAccountIDs(t *testing.T) {
+	tests := []struct {
+		name       string
+		principals []string
+		want       sets.Set[string]
+	}{
+		{
+			name:       "account, role and user principals",
+			principals: []string{"arn:aws:iam::111122223333:root", "arn:aws:iam::444455556666:role/consumer", "arn:aws:iam::111122223333:user/alice"},
+			want:       sets.New("111122223333", "444455556666"),
+		},
+		{
+			name:       "wildcard and organization principals are ignored",
+			principals: []string{"*", "arn:aws:organizations::111122223333:organization/o-abcdefghij", "arn:aws:iam::*:root"},
+			want:       sets.New("111122223333"),
+		},
+		{
+			name: "no principals",
+			want: sets.New[string](),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, extractPrincipalAccountIDs(tt.principals))
+		})
+	}
+}
@@ -0,0 +1,228 @@
+package ec2
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/ec2"
+)
+
+// NewVPCEndpointServiceSynthesizer constructs new vpcEndpointServiceSynthesizer.
+func NewVPCEndpointServiceSynthesizer(trackingProvider tracking.Provider, taggingManager TaggingManager,
+	esManager VPCEndpointServiceManager, logger logr.Logger, stack core.Stack) *vpcEndpointServiceSynthesizer {
+	return &vpcEndpointServiceSynthesizer{
+		trackingProvider: trackingProvider,
+		taggingManager:   taggingManager,
+		esManager:        esManager,
+		logger:           logger,
+		stack:            stack,
+	}
+}
+
+// vpcEndpointServiceSynthesizer creates and updates the VPCEndpointServices of a stack, once their LoadBalancers are synthesized.
+type vpcEndpointServiceSynthesizer struct {
+	trackingProvider tracking.Provider
+	taggingManager   TaggingManager
+	esManager        VPCEndpointServiceManager
+	logger           logr.Logger
+
+	stack core.Stack
+}
+
+func (s *vpcEndpointServiceSynthesizer) Synthesize(ctx context.Context) error {
+	var resESs []*ec2model.VPCEndpointService
+	s.stack.ListResources(&resESs)
+	sdkESs, err := findSDKVPCEndpointServices(ctx, s.trackingProvider, s.taggingManager, s.stack)
+	if err != nil {
+		return err
+	}
+	matchedResAndSDKESs, unmatchedResESs, unmatchedSDKESs, err := matchResAndSDKVPCEndpointServices(resESs, sdkESs, s.trackingProvider.ResourceIDTagKey())
+	if err != nil {
+		return err
+	}
+
+	// unmatched VPCEndpointServices are normally deleted by the vpcEndpointServiceCleanupSynthesizer already.
+	for _, sdkES := range unmatchedSDKESs {
+		if err := s.esManager.Delete(ctx, sdkES); err != nil {
+			return err
+		}
+	}
+	for _, resES := range unmatchedResESs {
+		esStatus, err := s.esManager.Create(ctx, resES)
+		if err != nil {
+			return err
+		}
+		resES.SetStatus(esStatus)
+	}
+	for _, resAndSDKES := range matchedResAndSDKESs {
+		esStatus, err := s.esManager.Update(ctx, resAndSDKES.resES, resAndSDKES.sdkES)
+		if err != nil {
+			return err
+		}
+		resAndSDKES.resES.SetStatus(esStatus)
+	}
+	return nil
+}
+
+func (s *vpcEndpointServiceSynthesizer) PostSynthesize(_ context.Context) error {
+	// nothing to do here.
+	return nil
+}
+
+// FindSDKLoadBalancerARNsToDeleteFunc returns the ARNs of the LoadBalancers of the stack that are deleted during synthesize.
+type FindSDKLoadBalancerARNsToDeleteFunc func(ctx context.Context) (sets.Set[string], error)
+
+// NewVPCEndpointServiceCleanupSynthesizer constructs new vpcEndpointServiceCleanupSynthesizer.
+func NewVPCEndpointServiceCleanupSynthesizer(trackingProvider tracking.Provider, taggingManager TaggingManager,
+	esManager VPCEndpointServiceManager, findSDKLoadBalancerARNsToDelete FindSDKLoadBalancerARNsToDeleteFunc,
+	logger logr.Logger, stack core.Stack) *vpcEndpointServiceCleanupSynthesizer {
+	return &vpcEndpointServiceCleanupSynthesizer{
+		trackingProvider:                trackingProvider,
+		taggingManager:                  taggingManager,
+		esManager:                       esManager,
+		findSDKLoadBalancerARNsToDelete: findSDKLoadBalancerARNsToDelete,
+		logger:                          logger,
+		stack:                           stack,
+	}
+}
+
+// vpcEndpointServiceCleanupSynthesizer releases the LoadBalancers that are about to be deleted from the VPCEndpointServices of a stack.
+// a LoadBalancer cannot be deleted while it's associated with a VPCEndpointService, so it must be synthesized before the LoadBalancers.
+type vpcEndpointServiceCleanupSynthesizer struct {
+	trackingProvider                tracking.Provider
+	taggingManager                  TaggingManager
+	esManager                       VPCEndpointServiceManager
+	findSDKLoadBalancerARNsToDelete FindSDKLoadBalancerARNsToDeleteFunc
+	logger                          logr.Logger
+
+	stack core.Stack
+}
+
+func (s *vpcEndpointServiceCleanupSynthesizer) Synthesize(ctx context.Context) error {
+	sdkESs, err := findSDKVPCEndpointServices(ctx, s.trackingProvider, s.taggingManager, s.stack)
+	if err != nil {
+		return err
+	}
+	if len(sdkESs) == 0 {
+		return nil
+	}
+	var resESs []*ec2model.VPCEndpointService
+	s.stack.ListResources(&resESs)
+	matchedResAndSDKESs, _, unmatchedSDKESs, err := matchResAndSDKVPCEndpointServices(resESs, sdkESs, s.trackingProvider.ResourceIDTagKey())
+	if err != nil {
+		return err
+	}
+	for _, sdkES := range unmatchedSDKESs {
+		if err := s.esManager.Delete(ctx, sdkES); err != nil {
+			return err
+		}
+	}
+	if len(matchedResAndSDKESs) == 0 {
+		return nil
+	}
+
+	lbARNsToDelete, err := s.findSDKLoadBalancerARNsToDelete(ctx)
+	if err != nil {
+		return err
+	}
+	for _, resAndSDKES := range matchedResAndSDKESs {
+		sdkES := resAndSDKES.sdkES
+		releasedLBARNs, deleteES := computeReleasedLoadBalancerARNs(sdkES, lbARNsToDelete)
+		if deleteES {
+			if err := s.esManager.Delete(ctx, sdkES); err != nil {
+				return err
+			}
+			continue
+		}
+		if len(releasedLBARNs) == 0 {
+			continue
+		}
+		if err := s.esManager.RemoveLoadBalancers(ctx, sdkES, releasedLBARNs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *vpcEndpointServiceCleanupSynthesizer) PostSynthesize(_ context.Context) error {
+	// nothing to do here.
+	return nil
+}
+
+// computeReleasedLoadBalancerARNs returns the LoadBalancers of sdkES that are about to be deleted,
+// and whether sdkES must be deleted because all its LoadBalancers are about to be deleted.
+// a VPCEndpointService needs at least one LoadBalancer, it's recreated once its LoadBalancer is replaced.
+func computeReleasedLoadBalancerARNs(sdkES VPCEndpointServiceWithTags, lbARNsToDelete sets.Set[string]) ([]string, bool) {
+	currentLBARNs := sets.New(sdkES.ServiceConfiguration.NetworkLoadBalancerArns...)
+	releasedLBARNs := currentLBARNs.Intersection(lbARNsToDelete)
+	if releasedLBARNs.Len() == 0 {
+		return nil, false
+	}
+	if releasedLBARNs.Equal(currentLBARNs) {
+		return nil, true
+	}
+	return sets.List(releasedLBARNs), false
+}
+
+// findSDKVPCEndpointServices will find all AWS VPCEndpointServices created for stack.
+func findSDKVPCEndpointServices(ctx context.Context, trackingProvider tracking.Provider, taggingManager TaggingManager, stack core.Stack) ([]VPCEndpointServiceWithTags, error) {
+	stackTags := trackingProvider.StackTags(stack)
+	return taggingManager.ListVPCEndpointServices(ctx, tracking.TagsAsTagFilter(stackTags))
+}
+
+type resAndSDKVPCEndpointServicePair struct {
+	resES *ec2model.VPCEndpointService
+	sdkES VPCEndpointServiceWithTags
+}
+
+func matchResAndSDKVPCEndpointServices(resESs []*ec2model.VPCEndpointService, sdkESs []VPCEndpointServiceWithTags,
+	resourceIDTagKey string) ([]resAndSDKVPCEndpointServicePair, []*ec2model.VPCEndpointService, []VPCEndpointServiceWithTags, error) {
+	var matchedResAndSDKESs []resAndSDKVPCEndpointServicePair
+	var unmatchedResESs []*ec2model.VPCEndpointService
+	var unmatchedSDKESs []VPCEndpointServiceWithTags
+
+	resESsByID := make(map[string]*ec2model.VPCEndpointService, len(resESs))
+	for _, resES := range resESs {
+		resESsByID[resES.ID()] = resES
+	}
+	sdkESsByID, err := mapSDKVPCEndpointServiceByResourceID(sdkESs, resourceIDTagKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	resESIDs := sets.StringKeySet(resESsByID)
+	sdkESIDs := sets.StringKeySet(sdkESsByID)
+	for _, resID := range resESIDs.Intersection(sdkESIDs).List() {
+		resES := resESsByID[resID]
+		sdkESs := sdkESsByID[resID]
+		matchedResAndSDKESs = append(matchedResAndSDKESs, resAndSDKVPCEndpointServicePair{
+			resES: resES,
+			sdkES: sdkESs[0],
+		})
+		unmatchedSDKESs = append(unmatchedSDKESs, sdkESs[1:]...)
+	}
+	for _, resID := range resESIDs.Difference(sdkESIDs).List() {
+		unmatchedResESs = append(unmatchedResESs, resESsByID[resID])
+	}
+	for _, resID := range sdkESIDs.Difference(resESIDs).List() {
+		unmatchedSDKESs = append(unmatchedSDKESs, sdkESsByID[resID]...)
+	}
+	return matchedResAndSDKESs, unmatchedResESs, unmatchedSDKESs, nil
+}
+
+func mapSDKVPCEndpointServiceByResourceID(sdkESs []VPCEndpointServiceWithTags, resourceIDTagKey string) (map[string][]VPCEndpointServiceWithTags, error) {
+	sdkESsByID := make(map[string][]VPCEndpointServiceWithTags, len(sdkESs))
+	for _, sdkES := range sdkESs {
+		resourceID, ok := sdkES.Tags[resourceIDTagKey]
+		if !ok {
+			return nil, errors.Errorf("unexpected vpcEndpointService with no resourceID: %v", awssdk.ToString(sdkES.ServiceConfiguration.ServiceId))
+		}
+		sdkESsByID[resourceID] = append(sdkESsByID[resourceID], sdkES)
+	}
+	return sdkESsByID, nil
+}
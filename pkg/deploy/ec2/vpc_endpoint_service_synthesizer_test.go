@@ -0,0 +1,166 @@
+package ec2
+
+import (
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/sets"
+	coremodel "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/ec2"
+)
+
+func Test_matchResAndSDKVPCEndpointServices(t *testing.T) {
+	stack := coremodel.NewDefaultStack(coremodel.StackID{Namespace: "namespace", Name: "name"})
+	resES := &ec2model.VPCEndpointService{
+		ResourceMeta: coremodel.NewResourceMeta(stack, "AWS::EC2::VPCEndpointService", "VPCEndpointService"),
+	}
+	sdkES1 := VPCEndpointServiceWithTags{
+		ServiceConfiguration: &ec2types.ServiceConfiguration{
+			ServiceId: awssdk.String("vpce-svc-1"),
+		},
+		Tags: map[string]string{
+			"service.k8s.aws/resource": "VPCEndpointService",
+		},
+	}
+	sdkES2 := VPCEndpointServiceWithTags{
+		ServiceConfiguration: &ec2types.ServiceConfiguration{
+			ServiceId: awssdk.String("vpce-svc-2"),
+		},
+		Tags: map[string]string{
+			"service.k8s.aws/resource": "VPCEndpointService",
+		},
+	}
+	sdkESOther := VPCEndpointServiceWithTags{
+		ServiceConfiguration: &ec2types.ServiceConfiguration{
+			ServiceId: awssdk.String("vpce-svc-3"),
+		},
+		Tags: map[string]string{
+			"service.k8s.aws/resource": "OtherVPCEndpointService",
+		},
+	}
+	type args struct {
+		resESs           []*ec2model.VPCEndpointService
+		sdkESs           []VPCEndpointServiceWithTags
+		resourceIDTagKey string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []resAndSDKVPCEndpointServicePair
+		want1   []*ec2model.VPCEndpointService
+		want2   []VPCEndpointServiceWithTags
+		wantErr error
+	}{
+		{
+			name: "res VPCEndpointService has match",
+			args: args{
+				resESs:           []*ec2model.VPCEndpointService{resES},
+				sdkESs:           []VPCEndpointServiceWithTags{sdkES1},
+				resourceIDTagKey: "service.k8s.aws/resource",
+			},
+			want: []resAndSDKVPCEndpointServicePair{
+				{resES: resES, sdkES: sdkES1},
+			},
+		},
+		{
+			name: "res VPCEndpointService has multiple matches",
+			args: args{
+				resESs:           []*ec2model.VPCEndpointService{resES},
+				sdkESs:           []VPCEndpointServiceWithTags{sdkES1, sdkES2},
+				resourceIDTagKey: "service.k8s.aws/resource",
+			},
+			want: []resAndSDKVPCEndpointServicePair{
+				{resES: resES, sdkES: sdkES1},
+			},
+			want2: []VPCEndpointServiceWithTags{sdkES2},
+		},
+		{
+			name: "res VPCEndpointService don't have match",
+			args: args{
+				resESs:           []*ec2model.VPCEndpointService{resES},
+				sdkESs:           []VPCEndpointServiceWithTags{sdkESOther},
+				resourceIDTagKey: "service.k8s.aws/resource",
+			},
+			want1: []*ec2model.VPCEndpointService{resES},
+			want2: []VPCEndpointServiceWithTags{sdkESOther},
+		},
+		{
+			name: "no res VPCEndpointService",
+			args: args{
+				sdkESs:           []VPCEndpointServiceWithTags{sdkES1},
+				resourceIDTagKey: "service.k8s.aws/resource",
+			},
+			want2: []VPCEndpointServiceWithTags{sdkES1},
+		},
+		{
+			name: "sdk VPCEndpointService don't have resourceID tag",
+			args: args{
+				resESs: []*ec2model.VPCEndpointService{resES},
+				sdkESs: []VPCEndpointServiceWithTags{
+					{
+						ServiceConfiguration: &ec2types.ServiceConfiguration{
+							ServiceId: awssdk.String("vpce-svc-1"),
+						},
+						Tags: map[string]string{},
+					},
+				},
+				resourceIDTagKey: "service.k8s.aws/resource",
+			},
+			wantErr: errors.New("unexpected vpcEndpointService with no resourceID: vpce-svc-1"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1, got2, err := matchResAndSDKVPCEndpointServices(tt.args.resESs, tt.args.sdkESs, tt.args.resourceIDTagKey)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+				assert.Equal(t, tt.want1, got1)
+				assert.Equal(t, tt.want2, got2)
+			}
+		})
+	}
+}
+
+func Test_computeReleasedLoadBalancerARNs(t *testing.T) {
+	sdkES := VPCEndpointServiceWithTags{
+		ServiceConfiguration: &ec2types.ServiceConfiguration{
+			ServiceId:               awssdk.String("vpce-svc-1"),
+			NetworkLoadBalancerArns: []string{"arn:aws:elasticloadbalancing:us-west-2:111122223333:loadbalancer/net/lb-1/1", "arn:aws:elasticloadbalancing:us-west-2:111122223333:loadbalancer/net/lb-2/2"},
+		},
+	}
+	tests := []struct {
+		name           string
+		lbARNsToDelete sets.Set[string]
+		want           []string
+		wantDeleteES   bool
+	}{
+		{
+			name:           "no loadBalancer is deleted",
+			lbARNsToDelete: sets.New("arn:aws:elasticloadbalancing:us-west-2:111122223333:loadbalancer/net/lb-3/3"),
+		},
+		{
+			name:           "some loadBalancers are deleted",
+			lbARNsToDelete: sets.New("arn:aws:elasticloadbalancing:us-west-2:111122223333:loadbalancer/net/lb-2/2"),
+			want:           []string{"arn:aws:elasticloadbalancing:us-west-2:111122223333:loadbalancer/net/lb-2/2"},
+		},
+		{
+			name: "all loadBalancers are deleted",
+			lbARNsToDelete: sets.New("arn:aws:elasticloadbalancing:us-west-2:111122223333:loadbalancer/net/lb-1/1",
+				"arn:aws:elasticloadbalancing:us-west-2:111122223333:loadbalancer/net/lb-2/2"),
+			wantDeleteES: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotDeleteES := computeReleasedLoadBalancerARNs(sdkES, tt.lbARNsToDelete)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantDeleteES, gotDeleteES)
+		})
+	}
+}
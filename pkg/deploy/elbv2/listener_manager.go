@@ -73,7 +73,7 @@ type defaultListenerManager struct {
 }
 
 func (m *defaultListenerManager) Create(ctx context.Context, resLS *elbv2model.Listener) (elbv2model.ListenerStatus, error) {
-	req, err := buildSDKCreateListenerInput(resLS.Spec, m.featureGates)
+	req, err := buildSDKCreateListenerInput(ctx, resLS.Spec, m.featureGates)
 	if err != nil {
 		return elbv2model.ListenerStatus{}, err
 	}
@@ -159,7 +159,10 @@ func (m *defaultListenerManager) updateSDKListenerWithSettings(ctx context.Conte
 		return err
 	}
 	desiredDefaultCerts, _ := buildSDKCertificates(resLS.Spec.Certificates)
-	desiredDefaultMutualAuthentication := buildSDKMutualAuthenticationConfig(resLS.Spec.MutualAuthentication)
+	desiredDefaultMutualAuthentication, err := buildSDKMutualAuthenticationConfig(ctx, resLS.Spec.MutualAuthentication)
+	if err != nil {
+		return err
+	}
 	if !m.isSDKListenerSettingsDrifted(resLS.Spec, sdkLS, desiredDefaultActions, desiredDefaultCerts, desiredDefaultMutualAuthentication) {
 		return nil
 	}
@@ -170,7 +173,7 @@ func (m *defaultListenerManager) updateSDKListenerWithSettings(ctx context.Conte
 		removeALPN = isRemoveALPN(sdkLS, resLS.Spec)
 	}
 
-	req := buildSDKModifyListenerInput(resLS.Spec, desiredDefaultActions, desiredDefaultCerts, removeMutualAuth, removeALPN)
+	req := buildSDKModifyListenerInput(resLS.Spec, desiredDefaultActions, desiredDefaultCerts, desiredDefaultMutualAuthentication, removeMutualAuth, removeALPN)
 	req.ListenerArn = sdkLS.Listener.ListenerArn
 	m.logger.Info("modifying listener",
 		"stackID", resLS.Stack().StackID(),
@@ -372,8 +375,7 @@ func (m *defaultListenerManager) isSDKListenerSettingsDrifted(lsSpec elbv2model.
 	return !cmp.Equal(desiredDefaultMutualAuthentication, sdkLS.Listener.MutualAuthentication, elbv2equality.CompareOptionsForMTLS())
 }
 
-func buildSDKCreateListenerInput(lsSpec elbv2model.ListenerSpec, featureGates config.FeatureGates) (*elbv2sdk.CreateListenerInput, error) {
-	ctx := context.Background()
+func buildSDKCreateListenerInput(ctx context.Context, lsSpec elbv2model.ListenerSpec, featureGates config.FeatureGates) (*elbv2sdk.CreateListenerInput, error) {
 	lbARN, err := lsSpec.LoadBalancerARN.Resolve(ctx)
 	if err != nil {
 		return nil, err
@@ -392,12 +394,16 @@ func buildSDKCreateListenerInput(lsSpec elbv2model.ListenerSpec, featureGates co
 	if len(lsSpec.ALPNPolicy) != 0 {
 		sdkObj.AlpnPolicy = lsSpec.ALPNPolicy
 	}
-	sdkObj.MutualAuthentication = buildSDKMutualAuthenticationConfig(lsSpec.MutualAuthentication)
+	sdkObj.MutualAuthentication, err = buildSDKMutualAuthenticationConfig(ctx, lsSpec.MutualAuthentication)
+	if err != nil {
+		return nil, err
+	}
 
 	return sdkObj, nil
 }
 
-func buildSDKModifyListenerInput(lsSpec elbv2model.ListenerSpec, desiredDefaultActions []elbv2types.Action, desiredDefaultCerts []elbv2types.Certificate, removeMTLS bool, removeALPN bool) *elbv2sdk.ModifyListenerInput {
+func buildSDKModifyListenerInput(lsSpec elbv2model.ListenerSpec, desiredDefaultActions []elbv2types.Action, desiredDefaultCerts []elbv2types.Certificate,
+	desiredDefaultMutualAuthentication *elbv2types.MutualAuthenticationAttributes, removeMTLS bool, removeALPN bool) *elbv2sdk.ModifyListenerInput {
 	sdkObj := &elbv2sdk.ModifyListenerInput{}
 	sdkObj.Port = awssdk.Int32(lsSpec.Port)
 	sdkObj.Protocol = elbv2types.ProtocolEnum(lsSpec.Protocol)
@@ -414,7 +420,7 @@ func buildSDKModifyListenerInput(lsSpec elbv2model.ListenerSpec, desiredDefaultA
 	if removeMTLS {
 		sdkObj.MutualAuthentication = mTLSOff
 	} else {
-		sdkObj.MutualAuthentication = buildSDKMutualAuthenticationConfig(lsSpec.MutualAuthentication)
+		sdkObj.MutualAuthentication = desiredDefaultMutualAuthentication
 	}
 
 	return sdkObj
@@ -446,21 +452,28 @@ func buildSDKCertificate(modelCert elbv2model.Certificate) elbv2types.Certificat
 }
 
 // buildSDKMutualAuthenticationConfig builds the mutual TLS authentication config for listener
-func buildSDKMutualAuthenticationConfig(modelMutualAuthenticationCfg *elbv2model.MutualAuthenticationAttributes) *elbv2types.MutualAuthenticationAttributes {
+func buildSDKMutualAuthenticationConfig(ctx context.Context, modelMutualAuthenticationCfg *elbv2model.MutualAuthenticationAttributes) (*elbv2types.MutualAuthenticationAttributes, error) {
 	if modelMutualAuthenticationCfg == nil {
-		return nil
+		return nil, nil
 	}
 	attributes := &elbv2types.MutualAuthenticationAttributes{
 		IgnoreClientCertificateExpiry: modelMutualAuthenticationCfg.IgnoreClientCertificateExpiry,
 		Mode:                          awssdk.String(modelMutualAuthenticationCfg.Mode),
 		TrustStoreArn:                 modelMutualAuthenticationCfg.TrustStoreArn,
 	}
+	if modelMutualAuthenticationCfg.TrustStore != nil {
+		trustStoreARN, err := modelMutualAuthenticationCfg.TrustStore.Resolve(ctx)
+		if err != nil {
+			return nil, err
+		}
+		attributes.TrustStoreArn = awssdk.String(trustStoreARN)
+	}
 
 	if modelMutualAuthenticationCfg.Mode == string(elbv2model.MutualAuthenticationVerifyMode) {
 		attributes.AdvertiseTrustStoreCaNames = translateAdvertiseCAToEnum(modelMutualAuthenticationCfg.AdvertiseTrustStoreCaNames)
 	}
 
-	return attributes
+	return attributes, nil
 }
 
 func buildResListenerStatus(sdkLS ListenerWithTags) elbv2model.ListenerStatus {
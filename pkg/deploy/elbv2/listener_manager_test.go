@@ -1,6 +1,7 @@
 package elbv2
 
 import (
+	"context"
 	"testing"
 
 	awssdk "github.com/aws/aws-sdk-go-v2/aws"
@@ -994,7 +995,9 @@ func Test_buildSDKModifyListenerInput(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			res := buildSDKModifyListenerInput(tc.lsSpec, tc.desiredDefaultActions, tc.desiredDefaultCerts, tc.removeMTLS, tc.removeALPN)
+			desiredDefaultMutualAuthentication, err := buildSDKMutualAuthenticationConfig(context.Background(), tc.lsSpec.MutualAuthentication)
+			assert.NoError(t, err)
+			res := buildSDKModifyListenerInput(tc.lsSpec, tc.desiredDefaultActions, tc.desiredDefaultCerts, desiredDefaultMutualAuthentication, tc.removeMTLS, tc.removeALPN)
 			assert.Equal(t, tc.expected, *res)
 		})
 	}
@@ -142,9 +142,29 @@ func (s *loadBalancerSynthesizer) PostSynthesize(ctx context.Context) error {
 
 // findSDKLoadBalancers will find all AWS LoadBalancer created for stack.
 func (s *loadBalancerSynthesizer) findSDKLoadBalancers(ctx context.Context) ([]LoadBalancerWithTags, error) {
-	stackTags := s.trackingProvider.StackTags(s.stack)
-	stackTagsLegacy := s.trackingProvider.StackTagsLegacy(s.stack)
-	return s.taggingManager.ListLoadBalancers(ctx,
+	return findSDKLoadBalancersForStack(ctx, s.trackingProvider, s.taggingManager, s.stack)
+}
+
+// FindSDKLoadBalancersToDelete returns the AWS LoadBalancers of the stack that the loadBalancerSynthesizer deletes,
+// either because they're no longer desired or because they require replacement.
+func FindSDKLoadBalancersToDelete(ctx context.Context, trackingProvider tracking.Provider, taggingManager TaggingManager, stack core.Stack) ([]LoadBalancerWithTags, error) {
+	var resLBs []*elbv2model.LoadBalancer
+	stack.ListResources(&resLBs)
+	sdkLBs, err := findSDKLoadBalancersForStack(ctx, trackingProvider, taggingManager, stack)
+	if err != nil {
+		return nil, err
+	}
+	_, _, unmatchedSDKLBs, err := matchResAndSDKLoadBalancers(resLBs, sdkLBs, trackingProvider.ResourceIDTagKey())
+	if err != nil {
+		return nil, err
+	}
+	return unmatchedSDKLBs, nil
+}
+
+func findSDKLoadBalancersForStack(ctx context.Context, trackingProvider tracking.Provider, taggingManager TaggingManager, stack core.Stack) ([]LoadBalancerWithTags, error) {
+	stackTags := trackingProvider.StackTags(stack)
+	stackTagsLegacy := trackingProvider.StackTagsLegacy(stack)
+	return taggingManager.ListLoadBalancers(ctx,
 		tracking.TagsAsTagFilter(stackTags),
 		tracking.TagsAsTagFilter(stackTagsLegacy))
 }
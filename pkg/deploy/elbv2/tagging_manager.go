@@ -53,6 +53,12 @@ type ListenerRuleWithTags struct {
 	Tags         map[string]string
 }
 
+// TrustStore with it's tags.
+type TrustStoreWithTags struct {
+	TrustStore *elbv2types.TrustStore
+	Tags       map[string]string
+}
+
 // options for ReconcileTags API.
 type ReconcileTagsOptions struct {
 	// CurrentTags on resources.
@@ -97,6 +103,9 @@ type TaggingManager interface {
 	// ListTargetGroups returns TargetGroups that matches any of the tagging requirements.
 	ListTargetGroups(ctx context.Context, tagFilters ...tracking.TagFilter) ([]TargetGroupWithTags, error)
 
+	// ListTrustStores returns TrustStores that matches any of the tagging requirements.
+	ListTrustStores(ctx context.Context, tagFilters ...tracking.TagFilter) ([]TrustStoreWithTags, error)
+
 	// ListListeners returns the LoadBalancer listeners along with tags
 	ListListeners(ctx context.Context, lbARN string) ([]ListenerWithTags, error)
 
@@ -435,6 +444,46 @@ func (m *defaultTaggingManager) listTargetGroupsNative(ctx context.Context, tagF
 	return matchedTGs, nil
 }
 
+// trust stores aren't scoped to a VPC, all trust stores in the account are matched against the tagFilters.
+func (m *defaultTaggingManager) ListTrustStores(ctx context.Context, tagFilters ...tracking.TagFilter) ([]TrustStoreWithTags, error) {
+	req := &elbv2sdk.DescribeTrustStoresInput{}
+	trustStores, err := m.elbv2Client.DescribeTrustStoresAsList(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	tsARNs := make([]string, 0, len(trustStores))
+	tsByARN := make(map[string]*elbv2types.TrustStore, len(trustStores))
+	for _, ts := range trustStores {
+		tsARN := awssdk.ToString(ts.TrustStoreArn)
+		tsARNs = append(tsARNs, tsARN)
+		tsByARN[tsARN] = &ts
+	}
+	tagsByARN, err := m.describeResourceTags(ctx, tsARNs)
+	if err != nil {
+		return nil, err
+	}
+
+	var matchedTrustStores []TrustStoreWithTags
+	for _, arn := range tsARNs {
+		tags := tagsByARN[arn]
+		matchedAnyTagFilter := false
+		for _, tagFilter := range tagFilters {
+			if tagFilter.Matches(tags) {
+				matchedAnyTagFilter = true
+				break
+			}
+		}
+		if matchedAnyTagFilter {
+			matchedTrustStores = append(matchedTrustStores, TrustStoreWithTags{
+				TrustStore: tsByARN[arn],
+				Tags:       tags,
+			})
+		}
+	}
+	return matchedTrustStores, nil
+}
+
 func (m *defaultTaggingManager) describeResourceTags(ctx context.Context, arns []string) (map[string]map[string]string, error) {
 	m.resourceTagsCacheMutex.Lock()
 	defer m.resourceTagsCacheMutex.Unlock()
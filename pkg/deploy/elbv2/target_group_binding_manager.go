@@ -221,6 +221,7 @@ func buildK8sTargetGroupBindingSpec(ctx context.Context, resTGB *elbv2modelk8s.T
 	k8sTGBSpec.IPAddressType = &resTGB.Spec.Template.Spec.IPAddressType
 	k8sTGBSpec.VpcID = resTGB.Spec.Template.Spec.VpcID
 	k8sTGBSpec.MultiClusterTargetGroup = resTGB.Spec.Template.Spec.MultiClusterTargetGroup
+	k8sTGBSpec.TargetZonePolicy = resTGB.Spec.Template.Spec.TargetZonePolicy
 	return k8sTGBSpec, nil
 }
 
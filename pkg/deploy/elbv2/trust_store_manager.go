@@ -0,0 +1,278 @@
+package elbv2
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	s3sdk "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/deploy/tracking"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/runtime"
+)
+
+const (
+	// TagKeyTrustStoreDigest is the tag on managed trust stores that records the digest of the deployed CA bundle and revocation lists.
+	TagKeyTrustStoreDigest = "elbv2.k8s.aws/trust-store-digest"
+
+	// trust store contents are staged under this prefix of the configured bucket, and removed once ELB has imported them.
+	trustStoreS3KeyPrefix = "aws-load-balancer-controller/trust-stores"
+
+	defaultWaitTrustStoreDeletionPollInterval = 2 * time.Second
+	defaultWaitTrustStoreDeletionTimeout      = 20 * time.Second
+)
+
+// TrustStoreManager is responsible for create/update/delete TrustStore resources.
+type TrustStoreManager interface {
+	Create(ctx context.Context, resTS *elbv2model.TrustStore) (elbv2model.TrustStoreStatus, error)
+
+	Update(ctx context.Context, resTS *elbv2model.TrustStore, sdkTS TrustStoreWithTags) (elbv2model.TrustStoreStatus, error)
+
+	Delete(ctx context.Context, sdkTS TrustStoreWithTags) error
+}
+
+// NewDefaultTrustStoreManager constructs new defaultTrustStoreManager.
+func NewDefaultTrustStoreManager(elbv2Client services.ELBV2, s3Client services.S3, s3Bucket string, trackingProvider tracking.Provider,
+	taggingManager TaggingManager, externalManagedTags []string, logger logr.Logger) *defaultTrustStoreManager {
+	return &defaultTrustStoreManager{
+		elbv2Client:         elbv2Client,
+		s3Client:            s3Client,
+		s3Bucket:            s3Bucket,
+		trackingProvider:    trackingProvider,
+		taggingManager:      taggingManager,
+		externalManagedTags: externalManagedTags,
+		logger:              logger,
+
+		waitTrustStoreDeletionPollInterval: defaultWaitTrustStoreDeletionPollInterval,
+		waitTrustStoreDeletionTimeout:      defaultWaitTrustStoreDeletionTimeout,
+	}
+}
+
+var _ TrustStoreManager = &defaultTrustStoreManager{}
+
+// default implementation for TrustStoreManager
+type defaultTrustStoreManager struct {
+	elbv2Client         services.ELBV2
+	s3Client            services.S3
+	s3Bucket            string
+	trackingProvider    tracking.Provider
+	taggingManager      TaggingManager
+	externalManagedTags []string
+
+	logger logr.Logger
+
+	waitTrustStoreDeletionPollInterval time.Duration
+	waitTrustStoreDeletionTimeout      time.Duration
+}
+
+func (m *defaultTrustStoreManager) Create(ctx context.Context, resTS *elbv2model.TrustStore) (elbv2model.TrustStoreStatus, error) {
+	if len(m.s3Bucket) == 0 {
+		return elbv2model.TrustStoreStatus{}, errors.Errorf("trust-store-s3-bucket must be specified to create trustStore from CA bundle: %v", resTS.ID())
+	}
+	caBundleKey, err := m.stageObject(ctx, resTS, "ca-bundle.pem", resTS.Spec.CACertificatesBundle)
+	if err != nil {
+		return elbv2model.TrustStoreStatus{}, err
+	}
+	defer m.cleanupObject(ctx, caBundleKey)
+
+	// the digest tag is only added once the revocations are imported, so that a failed creation is completed by a rotation on next reconcile.
+	tsTags := m.buildDesiredTags(resTS)
+	delete(tsTags, TagKeyTrustStoreDigest)
+	req := &elbv2sdk.CreateTrustStoreInput{
+		Name:                         awssdk.String(resTS.Spec.Name),
+		CaCertificatesBundleS3Bucket: awssdk.String(m.s3Bucket),
+		CaCertificatesBundleS3Key:    awssdk.String(caBundleKey),
+		Tags:                         convertTagsToSDKTags(tsTags),
+	}
+	m.logger.Info("creating trustStore",
+		"stackID", resTS.Stack().StackID(),
+		"resourceID", resTS.ID())
+	resp, err := m.elbv2Client.CreateTrustStoreWithContext(ctx, req)
+	if err != nil {
+		return elbv2model.TrustStoreStatus{}, err
+	}
+	sdkTS := TrustStoreWithTags{
+		TrustStore: &resp.TrustStores[0],
+		Tags:       tsTags,
+	}
+	m.logger.Info("created trustStore",
+		"stackID", resTS.Stack().StackID(),
+		"resourceID", resTS.ID(),
+		"arn", awssdk.ToString(sdkTS.TrustStore.TrustStoreArn))
+	if err := m.replaceRevocations(ctx, resTS, awssdk.ToString(sdkTS.TrustStore.TrustStoreArn), nil); err != nil {
+		return elbv2model.TrustStoreStatus{}, err
+	}
+	if err := m.updateSDKTrustStoreWithTags(ctx, resTS, sdkTS); err != nil {
+		return elbv2model.TrustStoreStatus{}, err
+	}
+	return buildResTrustStoreStatus(sdkTS), nil
+}
+
+func (m *defaultTrustStoreManager) Update(ctx context.Context, resTS *elbv2model.TrustStore, sdkTS TrustStoreWithTags) (elbv2model.TrustStoreStatus, error) {
+	if sdkTS.Tags[TagKeyTrustStoreDigest] != resTS.Spec.Digest {
+		if err := m.rotateSDKTrustStore(ctx, resTS, sdkTS); err != nil {
+			return elbv2model.TrustStoreStatus{}, err
+		}
+	}
+	// the digest tag is reconciled after the rotation, so that a failed rotation is retried on next reconcile.
+	if err := m.updateSDKTrustStoreWithTags(ctx, resTS, sdkTS); err != nil {
+		return elbv2model.TrustStoreStatus{}, err
+	}
+	return buildResTrustStoreStatus(sdkTS), nil
+}
+
+func (m *defaultTrustStoreManager) Delete(ctx context.Context, sdkTS TrustStoreWithTags) error {
+	req := &elbv2sdk.DeleteTrustStoreInput{
+		TrustStoreArn: sdkTS.TrustStore.TrustStoreArn,
+	}
+
+	m.logger.Info("deleting trustStore",
+		"arn", awssdk.ToString(req.TrustStoreArn))
+	if err := runtime.RetryImmediateOnError(m.waitTrustStoreDeletionPollInterval, m.waitTrustStoreDeletionTimeout, isTrustStoreInUseError, func() error {
+		_, err := m.elbv2Client.DeleteTrustStoreWithContext(ctx, req)
+		return err
+	}); err != nil {
+		return errors.Wrap(err, "failed to delete trustStore")
+	}
+	m.logger.Info("deleted trustStore",
+		"arn", awssdk.ToString(req.TrustStoreArn))
+	return nil
+}
+
+// rotateSDKTrustStore replaces the CA bundle and revocation lists of an existing trust store in place,
+// so that listeners referencing it pick up the new contents without being modified.
+func (m *defaultTrustStoreManager) rotateSDKTrustStore(ctx context.Context, resTS *elbv2model.TrustStore, sdkTS TrustStoreWithTags) error {
+	if len(m.s3Bucket) == 0 {
+		return errors.Errorf("trust-store-s3-bucket must be specified to update trustStore from CA bundle: %v", resTS.ID())
+	}
+	tsARN := awssdk.ToString(sdkTS.TrustStore.TrustStoreArn)
+	caBundleKey, err := m.stageObject(ctx, resTS, "ca-bundle.pem", resTS.Spec.CACertificatesBundle)
+	if err != nil {
+		return err
+	}
+	defer m.cleanupObject(ctx, caBundleKey)
+
+	req := &elbv2sdk.ModifyTrustStoreInput{
+		TrustStoreArn:                sdkTS.TrustStore.TrustStoreArn,
+		CaCertificatesBundleS3Bucket: awssdk.String(m.s3Bucket),
+		CaCertificatesBundleS3Key:    awssdk.String(caBundleKey),
+	}
+	m.logger.Info("modifying trustStore CA bundle",
+		"stackID", resTS.Stack().StackID(),
+		"resourceID", resTS.ID(),
+		"arn", tsARN)
+	if _, err := m.elbv2Client.ModifyTrustStoreWithContext(ctx, req); err != nil {
+		return err
+	}
+	m.logger.Info("modified trustStore CA bundle",
+		"stackID", resTS.Stack().StackID(),
+		"resourceID", resTS.ID(),
+		"arn", tsARN)
+
+	sdkRevocations, err := m.elbv2Client.DescribeTrustStoreRevocationsAsList(ctx, &elbv2sdk.DescribeTrustStoreRevocationsInput{
+		TrustStoreArn: sdkTS.TrustStore.TrustStoreArn,
+	})
+	if err != nil {
+		return err
+	}
+	return m.replaceRevocations(ctx, resTS, tsARN, sdkRevocations)
+}
+
+// replaceRevocations adds the desired revocation lists before removing the current ones, so that no revoked certificate is accepted in between.
+func (m *defaultTrustStoreManager) replaceRevocations(ctx context.Context, resTS *elbv2model.TrustStore, tsARN string, sdkRevocations []elbv2types.DescribeTrustStoreRevocation) error {
+	if len(resTS.Spec.RevocationLists) != 0 {
+		var revocationContents []elbv2types.RevocationContent
+		for i, crl := range resTS.Spec.RevocationLists {
+			crlKey, err := m.stageObject(ctx, resTS, fmt.Sprintf("crl-%d.pem", i), crl)
+			if err != nil {
+				return err
+			}
+			defer m.cleanupObject(ctx, crlKey)
+			revocationContents = append(revocationContents, elbv2types.RevocationContent{
+				RevocationType: elbv2types.RevocationTypeCrl,
+				S3Bucket:       awssdk.String(m.s3Bucket),
+				S3Key:          awssdk.String(crlKey),
+			})
+		}
+		m.logger.Info("adding trustStore revocations",
+			"arn", tsARN,
+			"count", len(revocationContents))
+		if _, err := m.elbv2Client.AddTrustStoreRevocationsWithContext(ctx, &elbv2sdk.AddTrustStoreRevocationsInput{
+			TrustStoreArn:      awssdk.String(tsARN),
+			RevocationContents: revocationContents,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if len(sdkRevocations) != 0 {
+		revocationIDs := make([]int64, 0, len(sdkRevocations))
+		for _, revocation := range sdkRevocations {
+			revocationIDs = append(revocationIDs, awssdk.ToInt64(revocation.RevocationId))
+		}
+		m.logger.Info("removing trustStore revocations",
+			"arn", tsARN,
+			"revocationIDs", revocationIDs)
+		if _, err := m.elbv2Client.RemoveTrustStoreRevocationsWithContext(ctx, &elbv2sdk.RemoveTrustStoreRevocationsInput{
+			TrustStoreArn: awssdk.String(tsARN),
+			RevocationIds: revocationIDs,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *defaultTrustStoreManager) updateSDKTrustStoreWithTags(ctx context.Context, resTS *elbv2model.TrustStore, sdkTS TrustStoreWithTags) error {
+	desiredTSTags := m.buildDesiredTags(resTS)
+	return m.taggingManager.ReconcileTags(ctx, awssdk.ToString(sdkTS.TrustStore.TrustStoreArn), desiredTSTags,
+		WithCurrentTags(sdkTS.Tags),
+		WithIgnoredTagKeys(m.externalManagedTags))
+}
+
+func (m *defaultTrustStoreManager) buildDesiredTags(resTS *elbv2model.TrustStore) map[string]string {
+	tsTags := m.trackingProvider.ResourceTags(resTS.Stack(), resTS, resTS.Spec.Tags)
+	tsTags[TagKeyTrustStoreDigest] = resTS.Spec.Digest
+	return tsTags
+}
+
+// stageObject uploads content to the configured bucket for ELB to import, and returns its key.
+func (m *defaultTrustStoreManager) stageObject(ctx context.Context, resTS *elbv2model.TrustStore, fileName string, content []byte) (string, error) {
+	key := fmt.Sprintf("%v/%v/%v/%v", trustStoreS3KeyPrefix, resTS.Spec.Name, resTS.Spec.Digest, fileName)
+	if _, err := m.s3Client.PutObjectWithContext(ctx, &s3sdk.PutObjectInput{
+		Bucket: awssdk.String(m.s3Bucket),
+		Key:    awssdk.String(key),
+		Body:   bytes.NewReader(content),
+	}); err != nil {
+		return "", errors.Wrapf(err, "failed to stage trustStore content in s3://%v/%v", m.s3Bucket, key)
+	}
+	return key, nil
+}
+
+// cleanupObject removes a staged object, failures are only logged since the object is no longer needed.
+func (m *defaultTrustStoreManager) cleanupObject(ctx context.Context, key string) {
+	if _, err := m.s3Client.DeleteObjectWithContext(ctx, &s3sdk.DeleteObjectInput{
+		Bucket: awssdk.String(m.s3Bucket),
+		Key:    awssdk.String(key),
+	}); err != nil {
+		m.logger.Error(err, "failed to cleanup staged trustStore content", "bucket", m.s3Bucket, "key", key)
+	}
+}
+
+func buildResTrustStoreStatus(sdkTS TrustStoreWithTags) elbv2model.TrustStoreStatus {
+	return elbv2model.TrustStoreStatus{
+		TrustStoreARN: awssdk.ToString(sdkTS.TrustStore.TrustStoreArn),
+	}
+}
+
+func isTrustStoreInUseError(err error) bool {
+	var inUseErr *elbv2types.TrustStoreInUseException
+	return errors.As(err, &inUseErr)
+}
@@ -0,0 +1,264 @@
+package elbv2
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	s3sdk "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/deploy/tracking"
+	coremodel "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_defaultTrustStoreManager_Create(t *testing.T) {
+	stack := coremodel.NewDefaultStack(coremodel.StackID{Namespace: "namespace", Name: "name"})
+	resTS := &elbv2model.TrustStore{
+		ResourceMeta: coremodel.NewResourceMeta(stack, "AWS::ElasticLoadBalancingV2::TrustStore", "Secret/ns/ca/ca.crt"),
+		Spec: elbv2model.TrustStoreSpec{
+			Name:                 "k8s-ns-ca-1234567890",
+			CACertificatesBundle: []byte("bundle"),
+			RevocationLists:      [][]byte{[]byte("crl")},
+			Digest:               "digest",
+		},
+	}
+	tests := []struct {
+		name             string
+		addRevocationErr error
+		wantDigestTagged bool
+		wantErr          error
+	}{
+		{
+			name:             "digest tag added once revocations are imported",
+			wantDigestTagged: true,
+		},
+		{
+			name:             "digest tag not added when revocations fail to import",
+			addRevocationErr: errors.New("invalid revocation content"),
+			wantErr:          errors.New("invalid revocation content"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			elbv2Client := services.NewMockELBV2(ctrl)
+			s3Client := services.NewMockS3(ctrl)
+			taggingManager := NewMockTaggingManager(ctrl)
+			m := &defaultTrustStoreManager{
+				elbv2Client:      elbv2Client,
+				s3Client:         s3Client,
+				s3Bucket:         "bucket",
+				trackingProvider: tracking.NewDefaultProvider("elbv2.k8s.aws", "cluster-name"),
+				taggingManager:   taggingManager,
+				logger:           logr.New(&log.NullLogSink{}),
+			}
+
+			s3Client.EXPECT().PutObjectWithContext(gomock.Any(), gomock.Any()).Return(&s3sdk.PutObjectOutput{}, nil).Times(2)
+			s3Client.EXPECT().DeleteObjectWithContext(gomock.Any(), gomock.Any()).Return(&s3sdk.DeleteObjectOutput{}, nil).Times(2)
+			elbv2Client.EXPECT().CreateTrustStoreWithContext(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, input *elbv2sdk.CreateTrustStoreInput) (*elbv2sdk.CreateTrustStoreOutput, error) {
+					for _, tag := range input.Tags {
+						assert.NotEqual(t, TagKeyTrustStoreDigest, awssdk.ToString(tag.Key))
+					}
+					return &elbv2sdk.CreateTrustStoreOutput{
+						TrustStores: []elbv2types.TrustStore{{TrustStoreArn: awssdk.String("ts-arn")}},
+					}, nil
+				})
+			elbv2Client.EXPECT().AddTrustStoreRevocationsWithContext(gomock.Any(), gomock.Any()).Return(&elbv2sdk.AddTrustStoreRevocationsOutput{}, tt.addRevocationErr)
+			if tt.wantDigestTagged {
+				taggingManager.EXPECT().ReconcileTags(gomock.Any(), "ts-arn", gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ string, desiredTags map[string]string, _ ...ReconcileTagsOption) error {
+						assert.Equal(t, "digest", desiredTags[TagKeyTrustStoreDigest])
+						return nil
+					})
+			}
+
+			status, err := m.Create(context.Background(), resTS)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "ts-arn", status.TrustStoreARN)
+			}
+		})
+	}
+}
+
+func Test_defaultTrustStoreManager_rotateSDKTrustStore(t *testing.T) {
+	stack := coremodel.NewDefaultStack(coremodel.StackID{Namespace: "namespace", Name: "name"})
+	tests := []struct {
+		name                string
+		s3Bucket            string
+		resTS               *elbv2model.TrustStore
+		sdkRevocations      []elbv2types.DescribeTrustStoreRevocation
+		wantStagedKeys      []string
+		wantAddRevocations  bool
+		wantRemovedRevIDs   []int64
+		wantErr             error
+		describeRevocations bool
+	}{
+		{
+			name:     "CA bundle rotated, revocation lists replaced",
+			s3Bucket: "bucket",
+			resTS: &elbv2model.TrustStore{
+				ResourceMeta: coremodel.NewResourceMeta(stack, "AWS::ElasticLoadBalancingV2::TrustStore", "Secret/ns/ca/ca.crt"),
+				Spec: elbv2model.TrustStoreSpec{
+					Name:                 "k8s-ns-ca-1234567890",
+					CACertificatesBundle: []byte("bundle"),
+					RevocationLists:      [][]byte{[]byte("crl")},
+					Digest:               "digest",
+				},
+			},
+			sdkRevocations: []elbv2types.DescribeTrustStoreRevocation{
+				{RevocationId: awssdk.Int64(1)},
+				{RevocationId: awssdk.Int64(2)},
+			},
+			wantStagedKeys: []string{
+				"aws-load-balancer-controller/trust-stores/k8s-ns-ca-1234567890/digest/ca-bundle.pem",
+				"aws-load-balancer-controller/trust-stores/k8s-ns-ca-1234567890/digest/crl-0.pem",
+			},
+			wantAddRevocations:  true,
+			wantRemovedRevIDs:   []int64{1, 2},
+			describeRevocations: true,
+		},
+		{
+			name:     "CA bundle rotated, revocation lists removed",
+			s3Bucket: "bucket",
+			resTS: &elbv2model.TrustStore{
+				ResourceMeta: coremodel.NewResourceMeta(stack, "AWS::ElasticLoadBalancingV2::TrustStore", "Secret/ns/ca/ca.crt"),
+				Spec: elbv2model.TrustStoreSpec{
+					Name:                 "k8s-ns-ca-1234567890",
+					CACertificatesBundle: []byte("bundle"),
+					Digest:               "digest",
+				},
+			},
+			sdkRevocations: []elbv2types.DescribeTrustStoreRevocation{
+				{RevocationId: awssdk.Int64(3)},
+			},
+			wantStagedKeys: []string{
+				"aws-load-balancer-controller/trust-stores/k8s-ns-ca-1234567890/digest/ca-bundle.pem",
+			},
+			wantRemovedRevIDs:   []int64{3},
+			describeRevocations: true,
+		},
+		{
+			name: "s3 bucket not configured",
+			resTS: &elbv2model.TrustStore{
+				ResourceMeta: coremodel.NewResourceMeta(stack, "AWS::ElasticLoadBalancingV2::TrustStore", "Secret/ns/ca/ca.crt"),
+				Spec: elbv2model.TrustStoreSpec{
+					Name:   "k8s-ns-ca-1234567890",
+					Digest: "digest",
+				},
+			},
+			wantErr: errors.New("trust-store-s3-bucket must be specified to update trustStore from CA bundle: Secret/ns/ca/ca.crt"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			elbv2Client := services.NewMockELBV2(ctrl)
+			s3Client := services.NewMockS3(ctrl)
+			m := &defaultTrustStoreManager{
+				elbv2Client: elbv2Client,
+				s3Client:    s3Client,
+				s3Bucket:    tt.s3Bucket,
+				logger:      logr.New(&log.NullLogSink{}),
+			}
+			sdkTS := TrustStoreWithTags{
+				TrustStore: &elbv2types.TrustStore{
+					TrustStoreArn: awssdk.String("ts-arn"),
+					Name:          awssdk.String("k8s-ns-ca-1234567890"),
+				},
+			}
+
+			for _, key := range tt.wantStagedKeys {
+				s3Client.EXPECT().PutObjectWithContext(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, input *s3sdk.PutObjectInput) (*s3sdk.PutObjectOutput, error) {
+						assert.Equal(t, tt.s3Bucket, awssdk.ToString(input.Bucket))
+						return &s3sdk.PutObjectOutput{}, nil
+					})
+				s3Client.EXPECT().DeleteObjectWithContext(gomock.Any(), &s3sdk.DeleteObjectInput{
+					Bucket: awssdk.String(tt.s3Bucket),
+					Key:    awssdk.String(key),
+				}).Return(&s3sdk.DeleteObjectOutput{}, nil)
+			}
+			if len(tt.wantStagedKeys) != 0 {
+				elbv2Client.EXPECT().ModifyTrustStoreWithContext(gomock.Any(), &elbv2sdk.ModifyTrustStoreInput{
+					TrustStoreArn:                awssdk.String("ts-arn"),
+					CaCertificatesBundleS3Bucket: awssdk.String(tt.s3Bucket),
+					CaCertificatesBundleS3Key:    awssdk.String(tt.wantStagedKeys[0]),
+				}).Return(&elbv2sdk.ModifyTrustStoreOutput{}, nil)
+			}
+			if tt.describeRevocations {
+				elbv2Client.EXPECT().DescribeTrustStoreRevocationsAsList(gomock.Any(), &elbv2sdk.DescribeTrustStoreRevocationsInput{
+					TrustStoreArn: awssdk.String("ts-arn"),
+				}).Return(tt.sdkRevocations, nil)
+			}
+			if tt.wantAddRevocations {
+				elbv2Client.EXPECT().AddTrustStoreRevocationsWithContext(gomock.Any(), &elbv2sdk.AddTrustStoreRevocationsInput{
+					TrustStoreArn: awssdk.String("ts-arn"),
+					RevocationContents: []elbv2types.RevocationContent{
+						{
+							RevocationType: elbv2types.RevocationTypeCrl,
+							S3Bucket:       awssdk.String(tt.s3Bucket),
+							S3Key:          awssdk.String(tt.wantStagedKeys[1]),
+						},
+					},
+				}).Return(&elbv2sdk.AddTrustStoreRevocationsOutput{}, nil)
+			}
+			if len(tt.wantRemovedRevIDs) != 0 {
+				elbv2Client.EXPECT().RemoveTrustStoreRevocationsWithContext(gomock.Any(), &elbv2sdk.RemoveTrustStoreRevocationsInput{
+					TrustStoreArn: awssdk.String("ts-arn"),
+					RevocationIds: tt.wantRemovedRevIDs,
+				}).Return(&elbv2sdk.RemoveTrustStoreRevocationsOutput{}, nil)
+			}
+
+			err := m.rotateSDKTrustStore(context.Background(), tt.resTS, sdkTS)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_isTrustStoreInUseError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "is TrustStoreInUse error",
+			err:  &elbv2types.TrustStoreInUseException{Message: awssdk.String("in use")},
+			want: true,
+		},
+		{
+			name: "wrapped TrustStoreInUse error",
+			err:  errors.Wrap(&elbv2types.TrustStoreInUseException{Message: awssdk.String("in use")}, "failed"),
+			want: true,
+		},
+		{
+			name: "is other error",
+			err:  errors.New("some other error"),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := isTrustStoreInUseError(tt.err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package elbv2
+
+import (
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	coremodel "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2"
+)
+
+func Test_matchResAndSDKTrustStores(t *testing.T) {
+	stack := coremodel.NewDefaultStack(coremodel.StackID{Namespace: "namespace", Name: "name"})
+	resTS1 := &elbv2model.TrustStore{
+		ResourceMeta: coremodel.NewResourceMeta(stack, "AWS::ElasticLoadBalancingV2::TrustStore", "Secret/ns/ca-1/ca.crt"),
+		Spec: elbv2model.TrustStoreSpec{
+			Name: "k8s-ns-ca1-1234567890",
+		},
+	}
+	resTS2 := &elbv2model.TrustStore{
+		ResourceMeta: coremodel.NewResourceMeta(stack, "AWS::ElasticLoadBalancingV2::TrustStore", "ConfigMap/ns/ca-2/ca.crt"),
+		Spec: elbv2model.TrustStoreSpec{
+			Name: "k8s-ns-ca2-1234567890",
+		},
+	}
+	sdkTS1 := TrustStoreWithTags{
+		TrustStore: &elbv2types.TrustStore{
+			TrustStoreArn: awssdk.String("arn-1"),
+			Name:          awssdk.String("k8s-ns-ca1-1234567890"),
+		},
+		Tags: map[string]string{
+			"ingress.k8s.aws/resource": "Secret/ns/ca-1/ca.crt",
+		},
+	}
+	sdkTS2 := TrustStoreWithTags{
+		TrustStore: &elbv2types.TrustStore{
+			TrustStoreArn: awssdk.String("arn-2"),
+			Name:          awssdk.String("k8s-ns-ca2-1234567890"),
+		},
+		Tags: map[string]string{
+			"ingress.k8s.aws/resource": "ConfigMap/ns/ca-2/ca.crt",
+		},
+	}
+	sdkTS2Renamed := TrustStoreWithTags{
+		TrustStore: &elbv2types.TrustStore{
+			TrustStoreArn: awssdk.String("arn-3"),
+			Name:          awssdk.String("k8s-ns-ca2-0987654321"),
+		},
+		Tags: map[string]string{
+			"ingress.k8s.aws/resource": "ConfigMap/ns/ca-2/ca.crt",
+		},
+	}
+	type args struct {
+		resTSs           []*elbv2model.TrustStore
+		sdkTSs           []TrustStoreWithTags
+		resourceIDTagKey string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []resAndSDKTrustStorePair
+		want1   []*elbv2model.TrustStore
+		want2   []TrustStoreWithTags
+		wantErr error
+	}{
+		{
+			name: "all TrustStore has match",
+			args: args{
+				resTSs:           []*elbv2model.TrustStore{resTS1, resTS2},
+				sdkTSs:           []TrustStoreWithTags{sdkTS1, sdkTS2},
+				resourceIDTagKey: "ingress.k8s.aws/resource",
+			},
+			want: []resAndSDKTrustStorePair{
+				{resTS: resTS2, sdkTS: sdkTS2},
+				{resTS: resTS1, sdkTS: sdkTS1},
+			},
+		},
+		{
+			name: "some res TrustStore don't have match",
+			args: args{
+				resTSs:           []*elbv2model.TrustStore{resTS1, resTS2},
+				sdkTSs:           []TrustStoreWithTags{sdkTS1},
+				resourceIDTagKey: "ingress.k8s.aws/resource",
+			},
+			want: []resAndSDKTrustStorePair{
+				{resTS: resTS1, sdkTS: sdkTS1},
+			},
+			want1: []*elbv2model.TrustStore{resTS2},
+		},
+		{
+			name: "some sdk TrustStore don't have match",
+			args: args{
+				resTSs:           []*elbv2model.TrustStore{resTS1},
+				sdkTSs:           []TrustStoreWithTags{sdkTS1, sdkTS2},
+				resourceIDTagKey: "ingress.k8s.aws/resource",
+			},
+			want: []resAndSDKTrustStorePair{
+				{resTS: resTS1, sdkTS: sdkTS1},
+			},
+			want2: []TrustStoreWithTags{sdkTS2},
+		},
+		{
+			name: "sdk TrustStore with different name requires replacement",
+			args: args{
+				resTSs:           []*elbv2model.TrustStore{resTS2},
+				sdkTSs:           []TrustStoreWithTags{sdkTS2Renamed},
+				resourceIDTagKey: "ingress.k8s.aws/resource",
+			},
+			want1: []*elbv2model.TrustStore{resTS2},
+			want2: []TrustStoreWithTags{sdkTS2Renamed},
+		},
+		{
+			name: "sdk TrustStore don't have resourceID tag",
+			args: args{
+				resTSs: []*elbv2model.TrustStore{resTS1},
+				sdkTSs: []TrustStoreWithTags{
+					{
+						TrustStore: &elbv2types.TrustStore{
+							TrustStoreArn: awssdk.String("arn-1"),
+						},
+						Tags: map[string]string{},
+					},
+				},
+				resourceIDTagKey: "ingress.k8s.aws/resource",
+			},
+			wantErr: errors.New("unexpected trustStore with no resourceID: arn-1"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1, got2, err := matchResAndSDKTrustStores(tt.args.resTSs, tt.args.sdkTSs, tt.args.resourceIDTagKey)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+				assert.Equal(t, tt.want1, got1)
+				assert.Equal(t, tt.want2, got2)
+			}
+		})
+	}
+}
@@ -0,0 +1,229 @@
+package s3
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// logDeliveryServicePrincipal is the service principal that delivers LoadBalancer logs in regions without a regional ELB account.
+	logDeliveryServicePrincipal = "logdelivery.elasticloadbalancing.amazonaws.com"
+	// logDeliveryStatementSIDPrefix is the prefix of bucket policy statements added by the controller.
+	logDeliveryStatementSIDPrefix = "AWSLoadBalancerControllerLogDelivery"
+	bucketPolicyVersion           = "2012-10-17"
+)
+
+// regionalELBAccountIDs are the accounts that deliver LoadBalancer logs in regions launched before August 2022.
+// see https://docs.aws.amazon.com/elasticloadbalancing/latest/application/enable-access-logging.html
+var regionalELBAccountIDs = map[string]string{
+	"us-east-1":      "127311923021",
+	"us-east-2":      "033677994240",
+	"us-west-1":      "027434742980",
+	"us-west-2":      "797873946194",
+	"af-south-1":     "098369216593",
+	"ap-east-1":      "754344448648",
+	"ap-southeast-3": "589379963580",
+	"ap-south-1":     "718504428378",
+	"ap-northeast-3": "383597477331",
+	"ap-northeast-2": "600734575887",
+	"ap-southeast-1": "114774131450",
+	"ap-southeast-2": "783225319266",
+	"ap-northeast-1": "582318560864",
+	"ca-central-1":   "985666609251",
+	"eu-central-1":   "054676820928",
+	"eu-west-1":      "156460612806",
+	"eu-west-2":      "652711504416",
+	"eu-south-1":     "635631232127",
+	"eu-west-3":      "009996457667",
+	"eu-north-1":     "897822967062",
+	"me-south-1":     "076674570225",
+	"sa-east-1":      "507241528517",
+	"us-gov-west-1":  "048591011584",
+	"us-gov-east-1":  "190560391635",
+	"cn-north-1":     "638102146993",
+	"cn-northwest-1": "037604701340",
+}
+
+// logDeliveryPrincipal is the principal that delivers LoadBalancer logs in a region.
+type logDeliveryPrincipal struct {
+	// AWS is the root ARN of the regional ELB account, empty if the region delivers with the service principal.
+	AWS string
+	// Service is the log delivery service principal, empty if the region delivers with the regional ELB account.
+	Service string
+}
+
+func buildLogDeliveryPrincipal(region string) logDeliveryPrincipal {
+	if accountID, ok := regionalELBAccountIDs[region]; ok {
+		return logDeliveryPrincipal{AWS: fmt.Sprintf("arn:%s:iam::%s:root", partitionForRegion(region), accountID)}
+	}
+	return logDeliveryPrincipal{Service: logDeliveryServicePrincipal}
+}
+
+// partitionForRegion returns the AWS partition of region.
+func partitionForRegion(region string) string {
+	switch {
+	case strings.HasPrefix(region, "cn-"):
+		return "aws-cn"
+	case strings.HasPrefix(region, "us-gov-"):
+		return "aws-us-gov"
+	case strings.HasPrefix(region, "us-isob-"):
+		return "aws-iso-b"
+	case strings.HasPrefix(region, "us-isof-"):
+		return "aws-iso-f"
+	case strings.HasPrefix(region, "us-iso-"):
+		return "aws-iso"
+	case strings.HasPrefix(region, "eu-isoe-"):
+		return "aws-iso-e"
+	case strings.HasPrefix(region, "eusc-"):
+		return "aws-eusc"
+	default:
+		return "aws"
+	}
+}
+
+// buildLogObjectsResource returns the S3 resource ARN of log objects delivered for accountID under prefix.
+func buildLogObjectsResource(partition string, bucketName string, prefix string, accountID string) string {
+	prefix = strings.Trim(prefix, "/")
+	if prefix == "" {
+		return fmt.Sprintf("arn:%s:s3:::%s/AWSLogs/%s/*", partition, bucketName, accountID)
+	}
+	return fmt.Sprintf("arn:%s:s3:::%s/%s/AWSLogs/%s/*", partition, bucketName, prefix, accountID)
+}
+
+// bucketPolicy is a parsed S3 bucket policy that preserves fields unknown to the controller.
+type bucketPolicy struct {
+	document   map[string]interface{}
+	statements []map[string]interface{}
+}
+
+func parseBucketPolicy(rawPolicy string) (*bucketPolicy, error) {
+	policy := &bucketPolicy{document: map[string]interface{}{}}
+	if strings.TrimSpace(rawPolicy) == "" {
+		policy.document["Version"] = bucketPolicyVersion
+		return policy, nil
+	}
+	if err := json.Unmarshal([]byte(rawPolicy), &policy.document); err != nil {
+		return nil, errors.Wrap(err, "failed to parse bucket policy")
+	}
+	switch rawStatements := policy.document["Statement"].(type) {
+	case map[string]interface{}:
+		policy.statements = append(policy.statements, rawStatements)
+	case []interface{}:
+		for _, rawStatement := range rawStatements {
+			if statement, ok := rawStatement.(map[string]interface{}); ok {
+				policy.statements = append(policy.statements, statement)
+			}
+		}
+	}
+	return policy, nil
+}
+
+// grantsPutObject checks whether any statement allows principal to put objects into resource.
+func (p *bucketPolicy) grantsPutObject(principal logDeliveryPrincipal, resource string) bool {
+	for _, statement := range p.statements {
+		if effect, _ := statement["Effect"].(string); effect != "Allow" {
+			continue
+		}
+		if !statementMatchesPrincipal(statement["Principal"], principal) {
+			continue
+		}
+		if !anyPatternMatches(stringOrStrings(statement["Action"]), "s3:PutObject", true) {
+			continue
+		}
+		if !anyPatternMatches(stringOrStrings(statement["Resource"]), resource, false) {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// addPutObjectGrant appends a statement that allows principal to put objects into resource.
+func (p *bucketPolicy) addPutObjectGrant(principal logDeliveryPrincipal, resource string) {
+	statementPrincipal := map[string]interface{}{}
+	if principal.AWS != "" {
+		statementPrincipal["AWS"] = principal.AWS
+	} else {
+		statementPrincipal["Service"] = principal.Service
+	}
+	resourceHash := sha256.Sum256([]byte(resource))
+	statement := map[string]interface{}{
+		"Sid":       logDeliveryStatementSIDPrefix + hex.EncodeToString(resourceHash[:])[:8],
+		"Effect":    "Allow",
+		"Principal": statementPrincipal,
+		"Action":    "s3:PutObject",
+		"Resource":  resource,
+	}
+	p.statements = append(p.statements, statement)
+}
+
+func (p *bucketPolicy) String() (string, error) {
+	statements := make([]interface{}, 0, len(p.statements))
+	for _, statement := range p.statements {
+		statements = append(statements, statement)
+	}
+	p.document["Statement"] = statements
+	payload, err := json.Marshal(p.document)
+	if err != nil {
+		return "", err
+	}
+	return string(payload), nil
+}
+
+func statementMatchesPrincipal(rawPrincipal interface{}, principal logDeliveryPrincipal) bool {
+	switch statementPrincipal := rawPrincipal.(type) {
+	case string:
+		return statementPrincipal == "*"
+	case map[string]interface{}:
+		for _, awsPrincipal := range stringOrStrings(statementPrincipal["AWS"]) {
+			if awsPrincipal == "*" {
+				return true
+			}
+			if principal.AWS != "" && (awsPrincipal == principal.AWS || strings.HasSuffix(principal.AWS, ":"+awsPrincipal+":root")) {
+				return true
+			}
+		}
+		for _, servicePrincipal := range stringOrStrings(statementPrincipal["Service"]) {
+			if servicePrincipal == logDeliveryServicePrincipal {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// anyPatternMatches checks whether value matches any of the IAM wildcard patterns.
+func anyPatternMatches(patterns []string, value string, ignoreCase bool) bool {
+	for _, pattern := range patterns {
+		expr := "^" + strings.NewReplacer(`\*`, ".*", `\?`, ".").Replace(regexp.QuoteMeta(pattern)) + "$"
+		if ignoreCase {
+			expr = "(?i)" + expr
+		}
+		if matched, _ := regexp.MatchString(expr, value); matched {
+			return true
+		}
+	}
+	return false
+}
+
+func stringOrStrings(raw interface{}) []string {
+	switch value := raw.(type) {
+	case string:
+		return []string{value}
+	case []interface{}:
+		var values []string
+		for _, item := range value {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+	return nil
+}
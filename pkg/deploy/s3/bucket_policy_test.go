@@ -0,0 +1,149 @@
+package s3
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_buildLogDeliveryPrincipal(t *testing.T) {
+	tests := []struct {
+		name   string
+		region string
+		want   logDeliveryPrincipal
+	}{
+		{
+			name:   "region with regional ELB account",
+			region: "us-west-2",
+			want:   logDeliveryPrincipal{AWS: "arn:aws:iam::797873946194:root"},
+		},
+		{
+			name:   "china region with regional ELB account",
+			region: "cn-north-1",
+			want:   logDeliveryPrincipal{AWS: "arn:aws-cn:iam::638102146993:root"},
+		},
+		{
+			name:   "region without regional ELB account",
+			region: "ap-southeast-5",
+			want:   logDeliveryPrincipal{Service: logDeliveryServicePrincipal},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, buildLogDeliveryPrincipal(tt.region))
+		})
+	}
+}
+
+func Test_buildLogObjectsResource(t *testing.T) {
+	tests := []struct {
+		name   string
+		prefix string
+		want   string
+	}{
+		{
+			name:   "without prefix",
+			prefix: "",
+			want:   "arn:aws:s3:::my-logs/AWSLogs/123456789012/*",
+		},
+		{
+			name:   "with prefix",
+			prefix: "/alb/access/",
+			want:   "arn:aws:s3:::my-logs/alb/access/AWSLogs/123456789012/*",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, buildLogObjectsResource("aws", "my-logs", tt.prefix, "123456789012"))
+		})
+	}
+}
+
+func Test_bucketPolicy_grantsPutObject(t *testing.T) {
+	accountPrincipal := logDeliveryPrincipal{AWS: "arn:aws:iam::797873946194:root"}
+	servicePrincipal := logDeliveryPrincipal{Service: logDeliveryServicePrincipal}
+	resource := "arn:aws:s3:::my-logs/alb/AWSLogs/123456789012/*"
+	tests := []struct {
+		name      string
+		policy    string
+		principal logDeliveryPrincipal
+		want      bool
+	}{
+		{
+			name:      "empty policy",
+			policy:    "",
+			principal: accountPrincipal,
+			want:      false,
+		},
+		{
+			name:      "exact grant for account principal",
+			policy:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::797873946194:root"},"Action":"s3:PutObject","Resource":"arn:aws:s3:::my-logs/alb/AWSLogs/123456789012/*"}]}`,
+			principal: accountPrincipal,
+			want:      true,
+		},
+		{
+			name:      "account id grant with wildcard action and resource",
+			policy:    `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Principal":{"AWS":["797873946194"]},"Action":["s3:*"],"Resource":"arn:aws:s3:::my-logs/*"}}`,
+			principal: accountPrincipal,
+			want:      true,
+		},
+		{
+			name:      "grant for service principal",
+			policy:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"logdelivery.elasticloadbalancing.amazonaws.com"},"Action":"s3:PutObject","Resource":"arn:aws:s3:::my-logs/alb/AWSLogs/*"}]}`,
+			principal: servicePrincipal,
+			want:      true,
+		},
+		{
+			name:      "deny statement isn't a grant",
+			policy:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":"*","Action":"s3:PutObject","Resource":"arn:aws:s3:::my-logs/*"}]}`,
+			principal: accountPrincipal,
+			want:      false,
+		},
+		{
+			name:      "grant for another prefix",
+			policy:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::797873946194:root"},"Action":"s3:PutObject","Resource":"arn:aws:s3:::my-logs/nlb/AWSLogs/123456789012/*"}]}`,
+			principal: accountPrincipal,
+			want:      false,
+		},
+		{
+			name:      "grant for another principal",
+			policy:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::033677994240:root"},"Action":"s3:PutObject","Resource":"arn:aws:s3:::my-logs/*"}]}`,
+			principal: accountPrincipal,
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := parseBucketPolicy(tt.policy)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, policy.grantsPutObject(tt.principal, resource))
+		})
+	}
+}
+
+func Test_bucketPolicy_addPutObjectGrant(t *testing.T) {
+	principal := logDeliveryPrincipal{AWS: "arn:aws:iam::797873946194:root"}
+	resource := "arn:aws:s3:::my-logs/AWSLogs/123456789012/*"
+	policy, err := parseBucketPolicy(`{"Version":"2012-10-17","Id":"existing","Statement":[{"Sid":"Existing","Effect":"Deny","Principal":"*","Action":"s3:*","Resource":"arn:aws:s3:::my-logs/*","Condition":{"Bool":{"aws:SecureTransport":"false"}}}]}`)
+	assert.NoError(t, err)
+	assert.False(t, policy.grantsPutObject(principal, resource))
+
+	policy.addPutObjectGrant(principal, resource)
+	assert.True(t, policy.grantsPutObject(principal, resource))
+	got, err := policy.String()
+	assert.NoError(t, err)
+
+	reparsed, err := parseBucketPolicy(got)
+	assert.NoError(t, err)
+	assert.Equal(t, "existing", reparsed.document["Id"])
+	assert.Len(t, reparsed.statements, 2)
+	assert.Equal(t, "Existing", reparsed.statements[0]["Sid"])
+	assert.Contains(t, reparsed.statements[0], "Condition")
+	assert.Regexp(t, "^"+logDeliveryStatementSIDPrefix+"[0-9a-f]{8}$", reparsed.statements[1]["Sid"])
+	assert.True(t, reparsed.grantsPutObject(principal, resource))
+}
+
+func Test_parseBucketPolicy_invalid(t *testing.T) {
+	_, err := parseBucketPolicy("{not json")
+	assert.Error(t, err)
+}
@@ -0,0 +1,268 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	s3sdk "github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	stssdk "github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/smithy-go"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/services"
+	s3model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/s3"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/shared_constants"
+)
+
+const (
+	// regionUSEast1 doesn't accept an explicit location constraint when creating buckets.
+	regionUSEast1 = "us-east-1"
+	// logExpirationRuleID is the ID of the lifecycle rule that expires log objects in buckets created by the controller.
+	logExpirationRuleID = "aws-load-balancer-controller-log-expiration"
+	// errCodeNoSuchBucketPolicy is the error code returned when a bucket has no policy.
+	errCodeNoSuchBucketPolicy = "NoSuchBucketPolicy"
+)
+
+// LogDeliveryError is returned when logs cannot be delivered to a bucket, the Reason is reported as a condition reason.
+type LogDeliveryError struct {
+	BucketName string
+	Reason     string
+	Message    string
+}
+
+func (e *LogDeliveryError) Error() string {
+	return fmt.Sprintf("log delivery to bucket %v is not possible: %v", e.BucketName, e.Message)
+}
+
+// LogBucketManager is responsible for making sure log buckets exist and grant log delivery.
+type LogBucketManager interface {
+	Reconcile(ctx context.Context, resBucket *s3model.LogBucket) error
+}
+
+// NewDefaultLogBucketManager constructs new defaultLogBucketManager.
+func NewDefaultLogBucketManager(s3Client services.S3, stsClient services.STS, region string, logger logr.Logger) *defaultLogBucketManager {
+	return &defaultLogBucketManager{
+		s3Client:  s3Client,
+		stsClient: stsClient,
+		region:    region,
+		logger:    logger,
+	}
+}
+
+var _ LogBucketManager = &defaultLogBucketManager{}
+
+// defaultLogBucketManager implement LogBucketManager
+type defaultLogBucketManager struct {
+	s3Client  services.S3
+	stsClient services.STS
+	region    string
+	logger    logr.Logger
+
+	accountIDMutex sync.Mutex
+	accountID      string
+}
+
+func (m *defaultLogBucketManager) Reconcile(ctx context.Context, resBucket *s3model.LogBucket) error {
+	if err := m.ensureBucketExists(ctx, resBucket); err != nil {
+		return err
+	}
+	return m.ensureBucketPolicyGrantsLogDelivery(ctx, resBucket)
+}
+
+func (m *defaultLogBucketManager) ensureBucketExists(ctx context.Context, resBucket *s3model.LogBucket) error {
+	bucketName := resBucket.Spec.BucketName
+	resp, err := m.s3Client.HeadBucketWithContext(ctx, &s3sdk.HeadBucketInput{
+		Bucket: awssdk.String(bucketName),
+	})
+	if err == nil {
+		if bucketRegion := awssdk.ToString(resp.BucketRegion); bucketRegion != "" && bucketRegion != m.region {
+			return &LogDeliveryError{
+				BucketName: bucketName,
+				Reason:     shared_constants.LogDeliveryConditionReasonBucketRegionMismatch,
+				Message:    fmt.Sprintf("bucket is in region %v, logs can only be delivered to buckets in region %v", bucketRegion, m.region),
+			}
+		}
+		return nil
+	}
+	if isHTTPStatusError(err, http.StatusForbidden) {
+		return &LogDeliveryError{
+			BucketName: bucketName,
+			Reason:     shared_constants.LogDeliveryConditionReasonBucketAccessDenied,
+			Message:    "access to bucket is denied",
+		}
+	}
+	var notFoundErr *s3types.NotFound
+	if !errors.As(err, &notFoundErr) && !isHTTPStatusError(err, http.StatusNotFound) {
+		return err
+	}
+	if !resBucket.Spec.CreateBucket {
+		return &LogDeliveryError{
+			BucketName: bucketName,
+			Reason:     shared_constants.LogDeliveryConditionReasonBucketNotFound,
+			Message:    "bucket doesn't exist",
+		}
+	}
+	return m.createBucket(ctx, resBucket)
+}
+
+func (m *defaultLogBucketManager) createBucket(ctx context.Context, resBucket *s3model.LogBucket) error {
+	bucketName := resBucket.Spec.BucketName
+	req := &s3sdk.CreateBucketInput{
+		Bucket: awssdk.String(bucketName),
+	}
+	if m.region != regionUSEast1 {
+		req.CreateBucketConfiguration = &s3types.CreateBucketConfiguration{
+			LocationConstraint: s3types.BucketLocationConstraint(m.region),
+		}
+	}
+	m.logger.Info("creating log bucket",
+		"stackID", resBucket.Stack().StackID(),
+		"resourceID", resBucket.ID(),
+		"bucketName", bucketName)
+	if _, err := m.s3Client.CreateBucketWithContext(ctx, req); err != nil {
+		return errors.Wrapf(err, "failed to create log bucket %v", bucketName)
+	}
+	m.logger.Info("created log bucket",
+		"stackID", resBucket.Stack().StackID(),
+		"resourceID", resBucket.ID(),
+		"bucketName", bucketName)
+
+	if resBucket.Spec.ExpirationDays == nil {
+		return nil
+	}
+	lifecycleReq := &s3sdk.PutBucketLifecycleConfigurationInput{
+		Bucket: awssdk.String(bucketName),
+		LifecycleConfiguration: &s3types.BucketLifecycleConfiguration{
+			Rules: []s3types.LifecycleRule{
+				{
+					ID:     awssdk.String(logExpirationRuleID),
+					Status: s3types.ExpirationStatusEnabled,
+					Filter: &s3types.LifecycleRuleFilter{
+						Prefix: awssdk.String(""),
+					},
+					Expiration: &s3types.LifecycleExpiration{
+						Days: resBucket.Spec.ExpirationDays,
+					},
+				},
+			},
+		},
+	}
+	if _, err := m.s3Client.PutBucketLifecycleConfigurationWithContext(ctx, lifecycleReq); err != nil {
+		return errors.Wrapf(err, "failed to configure lifecycle of log bucket %v", bucketName)
+	}
+	return nil
+}
+
+func (m *defaultLogBucketManager) ensureBucketPolicyGrantsLogDelivery(ctx context.Context, resBucket *s3model.LogBucket) error {
+	bucketName := resBucket.Spec.BucketName
+	accountID, err := m.getAccountID(ctx)
+	if err != nil {
+		return err
+	}
+	rawPolicy, err := m.getBucketPolicy(ctx, bucketName)
+	if err != nil {
+		return err
+	}
+	policy, err := parseBucketPolicy(rawPolicy)
+	if err != nil {
+		return err
+	}
+
+	principal := buildLogDeliveryPrincipal(m.region)
+	partition := partitionForRegion(m.region)
+	var missingResources []string
+	for _, prefix := range resBucket.Spec.Prefixes {
+		resource := buildLogObjectsResource(partition, bucketName, prefix, accountID)
+		if !policy.grantsPutObject(principal, resource) {
+			missingResources = append(missingResources, resource)
+		}
+	}
+	if len(missingResources) == 0 {
+		return nil
+	}
+	if !resBucket.Spec.ManageBucketPolicy {
+		return &LogDeliveryError{
+			BucketName: bucketName,
+			Reason:     shared_constants.LogDeliveryConditionReasonBucketPolicyMissingGrant,
+			Message:    fmt.Sprintf("bucket policy doesn't allow s3:PutObject on %v for log delivery", strings.Join(missingResources, ", ")),
+		}
+	}
+
+	for _, resource := range missingResources {
+		policy.addPutObjectGrant(principal, resource)
+	}
+	desiredPolicy, err := policy.String()
+	if err != nil {
+		return err
+	}
+	m.logger.Info("modifying log bucket policy",
+		"stackID", resBucket.Stack().StackID(),
+		"resourceID", resBucket.ID(),
+		"bucketName", bucketName,
+		"resources", missingResources)
+	if _, err := m.s3Client.PutBucketPolicyWithContext(ctx, &s3sdk.PutBucketPolicyInput{
+		Bucket: awssdk.String(bucketName),
+		Policy: awssdk.String(desiredPolicy),
+	}); err != nil {
+		if isHTTPStatusError(err, http.StatusForbidden) {
+			return &LogDeliveryError{
+				BucketName: bucketName,
+				Reason:     shared_constants.LogDeliveryConditionReasonBucketAccessDenied,
+				Message:    "modifying the bucket policy is denied",
+			}
+		}
+		return errors.Wrapf(err, "failed to modify policy of log bucket %v", bucketName)
+	}
+	m.logger.Info("modified log bucket policy",
+		"stackID", resBucket.Stack().StackID(),
+		"resourceID", resBucket.ID(),
+		"bucketName", bucketName)
+	return nil
+}
+
+func (m *defaultLogBucketManager) getBucketPolicy(ctx context.Context, bucketName string) (string, error) {
+	resp, err := m.s3Client.GetBucketPolicyWithContext(ctx, &s3sdk.GetBucketPolicyInput{
+		Bucket: awssdk.String(bucketName),
+	})
+	if err != nil {
+		var apiErr smithy.APIError
+		if errors.As(err, &apiErr) && apiErr.ErrorCode() == errCodeNoSuchBucketPolicy {
+			return "", nil
+		}
+		if isHTTPStatusError(err, http.StatusForbidden) {
+			return "", &LogDeliveryError{
+				BucketName: bucketName,
+				Reason:     shared_constants.LogDeliveryConditionReasonBucketAccessDenied,
+				Message:    "reading the bucket policy is denied",
+			}
+		}
+		return "", errors.Wrapf(err, "failed to get policy of log bucket %v", bucketName)
+	}
+	return awssdk.ToString(resp.Policy), nil
+}
+
+// getAccountID returns the account that LoadBalancers deliver logs for, which is the controller's account.
+func (m *defaultLogBucketManager) getAccountID(ctx context.Context) (string, error) {
+	m.accountIDMutex.Lock()
+	defer m.accountIDMutex.Unlock()
+	if m.accountID != "" {
+		return m.accountID, nil
+	}
+	resp, err := m.stsClient.GetCallerIdentityWithContext(ctx, &stssdk.GetCallerIdentityInput{})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get caller identity")
+	}
+	m.accountID = awssdk.ToString(resp.Account)
+	return m.accountID, nil
+}
+
+func isHTTPStatusError(err error, statusCode int) bool {
+	var respErr *awshttp.ResponseError
+	return errors.As(err, &respErr) && respErr.HTTPStatusCode() == statusCode
+}
@@ -0,0 +1,298 @@
+package s3
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	s3sdk "github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	stssdk "github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/smithy-go"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
+	s3model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/s3"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/shared_constants"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func newHTTPStatusError(statusCode int) error {
+	return &awshttp.ResponseError{
+		ResponseError: &smithyhttp.ResponseError{
+			Response: &smithyhttp.Response{Response: &http.Response{StatusCode: statusCode}},
+			Err:      errors.New("api error"),
+		},
+	}
+}
+
+func Test_defaultLogBucketManager_Reconcile(t *testing.T) {
+	const (
+		region    = "us-west-2"
+		accountID = "123456789012"
+	)
+	grantedPolicy := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::797873946194:root"},"Action":"s3:PutObject","Resource":"arn:aws:s3:::my-logs/alb/AWSLogs/123456789012/*"}]}`
+	type headBucketCall struct {
+		resp *s3sdk.HeadBucketOutput
+		err  error
+	}
+	type getBucketPolicyCall struct {
+		resp *s3sdk.GetBucketPolicyOutput
+		err  error
+	}
+	type putBucketPolicyCall struct {
+		err error
+	}
+	tests := []struct {
+		name                    string
+		spec                    s3model.LogBucketSpec
+		headBucketCall          headBucketCall
+		createBucketCall        *s3sdk.CreateBucketInput
+		putLifecycleCall        *s3sdk.PutBucketLifecycleConfigurationInput
+		getBucketPolicyCall     *getBucketPolicyCall
+		putBucketPolicyCall     *putBucketPolicyCall
+		wantPutPolicyStatements int
+		wantErr                 error
+		wantLogDeliveryReason   string
+	}{
+		{
+			name: "bucket exists and policy grants log delivery",
+			spec: s3model.LogBucketSpec{
+				BucketName:         "my-logs",
+				Prefixes:           []string{"alb"},
+				ManageBucketPolicy: true,
+			},
+			headBucketCall: headBucketCall{
+				resp: &s3sdk.HeadBucketOutput{BucketRegion: awssdk.String(region)},
+			},
+			getBucketPolicyCall: &getBucketPolicyCall{
+				resp: &s3sdk.GetBucketPolicyOutput{Policy: awssdk.String(grantedPolicy)},
+			},
+		},
+		{
+			name: "bucket exists without policy - policy is patched",
+			spec: s3model.LogBucketSpec{
+				BucketName:         "my-logs",
+				Prefixes:           []string{"alb", "nlb"},
+				ManageBucketPolicy: true,
+			},
+			headBucketCall: headBucketCall{
+				resp: &s3sdk.HeadBucketOutput{BucketRegion: awssdk.String(region)},
+			},
+			getBucketPolicyCall: &getBucketPolicyCall{
+				err: &smithy.GenericAPIError{Code: "NoSuchBucketPolicy"},
+			},
+			putBucketPolicyCall:     &putBucketPolicyCall{},
+			wantPutPolicyStatements: 2,
+		},
+		{
+			name: "bucket policy is partially granted - missing grant is appended",
+			spec: s3model.LogBucketSpec{
+				BucketName:         "my-logs",
+				Prefixes:           []string{"alb", "nlb"},
+				ManageBucketPolicy: true,
+			},
+			headBucketCall: headBucketCall{
+				resp: &s3sdk.HeadBucketOutput{BucketRegion: awssdk.String(region)},
+			},
+			getBucketPolicyCall: &getBucketPolicyCall{
+				resp: &s3sdk.GetBucketPolicyOutput{Policy: awssdk.String(grantedPolicy)},
+			},
+			putBucketPolicyCall:     &putBucketPolicyCall{},
+			wantPutPolicyStatements: 2,
+		},
+		{
+			name: "bucket policy misses grant in validate mode",
+			spec: s3model.LogBucketSpec{
+				BucketName: "my-logs",
+				Prefixes:   []string{"nlb"},
+			},
+			headBucketCall: headBucketCall{
+				resp: &s3sdk.HeadBucketOutput{BucketRegion: awssdk.String(region)},
+			},
+			getBucketPolicyCall: &getBucketPolicyCall{
+				resp: &s3sdk.GetBucketPolicyOutput{Policy: awssdk.String(grantedPolicy)},
+			},
+			wantLogDeliveryReason: shared_constants.LogDeliveryConditionReasonBucketPolicyMissingGrant,
+		},
+		{
+			name: "patching bucket policy is denied",
+			spec: s3model.LogBucketSpec{
+				BucketName:         "my-logs",
+				Prefixes:           []string{"nlb"},
+				ManageBucketPolicy: true,
+			},
+			headBucketCall: headBucketCall{
+				resp: &s3sdk.HeadBucketOutput{BucketRegion: awssdk.String(region)},
+			},
+			getBucketPolicyCall: &getBucketPolicyCall{
+				resp: &s3sdk.GetBucketPolicyOutput{Policy: awssdk.String(grantedPolicy)},
+			},
+			putBucketPolicyCall: &putBucketPolicyCall{
+				err: newHTTPStatusError(http.StatusForbidden),
+			},
+			wantLogDeliveryReason: shared_constants.LogDeliveryConditionReasonBucketAccessDenied,
+		},
+		{
+			name: "bucket in another region",
+			spec: s3model.LogBucketSpec{
+				BucketName: "my-logs",
+				Prefixes:   []string{"alb"},
+			},
+			headBucketCall: headBucketCall{
+				resp: &s3sdk.HeadBucketOutput{BucketRegion: awssdk.String("eu-west-1")},
+			},
+			wantLogDeliveryReason: shared_constants.LogDeliveryConditionReasonBucketRegionMismatch,
+		},
+		{
+			name: "bucket access denied",
+			spec: s3model.LogBucketSpec{
+				BucketName: "my-logs",
+				Prefixes:   []string{"alb"},
+			},
+			headBucketCall: headBucketCall{
+				err: newHTTPStatusError(http.StatusForbidden),
+			},
+			wantLogDeliveryReason: shared_constants.LogDeliveryConditionReasonBucketAccessDenied,
+		},
+		{
+			name: "bucket not found and not created",
+			spec: s3model.LogBucketSpec{
+				BucketName: "my-logs",
+				Prefixes:   []string{"alb"},
+			},
+			headBucketCall: headBucketCall{
+				err: &s3types.NotFound{},
+			},
+			wantLogDeliveryReason: shared_constants.LogDeliveryConditionReasonBucketNotFound,
+		},
+		{
+			name: "bucket not found and created with expiration",
+			spec: s3model.LogBucketSpec{
+				BucketName:         "my-logs",
+				Prefixes:           []string{"alb"},
+				ManageBucketPolicy: true,
+				CreateBucket:       true,
+				ExpirationDays:     awssdk.Int32(30),
+			},
+			headBucketCall: headBucketCall{
+				err: newHTTPStatusError(http.StatusNotFound),
+			},
+			createBucketCall: &s3sdk.CreateBucketInput{
+				Bucket: awssdk.String("my-logs"),
+				CreateBucketConfiguration: &s3types.CreateBucketConfiguration{
+					LocationConstraint: s3types.BucketLocationConstraint(region),
+				},
+			},
+			putLifecycleCall: &s3sdk.PutBucketLifecycleConfigurationInput{
+				Bucket: awssdk.String("my-logs"),
+				LifecycleConfiguration: &s3types.BucketLifecycleConfiguration{
+					Rules: []s3types.LifecycleRule{
+						{
+							ID:     awssdk.String(logExpirationRuleID),
+							Status: s3types.ExpirationStatusEnabled,
+							Filter: &s3types.LifecycleRuleFilter{
+								Prefix: awssdk.String(""),
+							},
+							Expiration: &s3types.LifecycleExpiration{
+								Days: awssdk.Int32(30),
+							},
+						},
+					},
+				},
+			},
+			getBucketPolicyCall: &getBucketPolicyCall{
+				err: &smithy.GenericAPIError{Code: "NoSuchBucketPolicy"},
+			},
+			putBucketPolicyCall:     &putBucketPolicyCall{},
+			wantPutPolicyStatements: 1,
+		},
+		{
+			name: "head bucket fails with unexpected error",
+			spec: s3model.LogBucketSpec{
+				BucketName: "my-logs",
+				Prefixes:   []string{"alb"},
+			},
+			headBucketCall: headBucketCall{
+				err: errors.New("some error"),
+			},
+			wantErr: errors.New("some error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ctx := context.Background()
+
+			s3Client := services.NewMockS3(ctrl)
+			stsClient := services.NewMockSTS(ctrl)
+			s3Client.EXPECT().HeadBucketWithContext(gomock.Any(), &s3sdk.HeadBucketInput{Bucket: awssdk.String(tt.spec.BucketName)}).
+				Return(tt.headBucketCall.resp, tt.headBucketCall.err)
+			if tt.createBucketCall != nil {
+				s3Client.EXPECT().CreateBucketWithContext(gomock.Any(), tt.createBucketCall).Return(&s3sdk.CreateBucketOutput{}, nil)
+			}
+			if tt.putLifecycleCall != nil {
+				s3Client.EXPECT().PutBucketLifecycleConfigurationWithContext(gomock.Any(), tt.putLifecycleCall).Return(&s3sdk.PutBucketLifecycleConfigurationOutput{}, nil)
+			}
+			if tt.getBucketPolicyCall != nil {
+				stsClient.EXPECT().GetCallerIdentityWithContext(gomock.Any(), gomock.Any()).
+					Return(&stssdk.GetCallerIdentityOutput{Account: awssdk.String(accountID)}, nil)
+				s3Client.EXPECT().GetBucketPolicyWithContext(gomock.Any(), &s3sdk.GetBucketPolicyInput{Bucket: awssdk.String(tt.spec.BucketName)}).
+					Return(tt.getBucketPolicyCall.resp, tt.getBucketPolicyCall.err)
+			}
+			if tt.putBucketPolicyCall != nil {
+				s3Client.EXPECT().PutBucketPolicyWithContext(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *s3sdk.PutBucketPolicyInput) (*s3sdk.PutBucketPolicyOutput, error) {
+						if tt.putBucketPolicyCall.err != nil {
+							return nil, tt.putBucketPolicyCall.err
+						}
+						policy, err := parseBucketPolicy(awssdk.ToString(req.Policy))
+						assert.NoError(t, err)
+						assert.Len(t, policy.statements, tt.wantPutPolicyStatements)
+						principal := buildLogDeliveryPrincipal(region)
+						for _, prefix := range tt.spec.Prefixes {
+							assert.True(t, policy.grantsPutObject(principal, buildLogObjectsResource("aws", tt.spec.BucketName, prefix, accountID)))
+						}
+						return &s3sdk.PutBucketPolicyOutput{}, nil
+					})
+			}
+
+			stack := core.NewDefaultStack(core.StackID{Namespace: "namespace", Name: "name"})
+			resBucket := s3model.NewLogBucket(stack, tt.spec.BucketName, tt.spec)
+			m := NewDefaultLogBucketManager(s3Client, stsClient, region, log.Log)
+			err := m.Reconcile(ctx, resBucket)
+			switch {
+			case tt.wantLogDeliveryReason != "":
+				var logDeliveryErr *LogDeliveryError
+				assert.True(t, errors.As(err, &logDeliveryErr))
+				assert.Equal(t, tt.wantLogDeliveryReason, logDeliveryErr.Reason)
+				assert.Equal(t, tt.spec.BucketName, logDeliveryErr.BucketName)
+			case tt.wantErr != nil:
+				assert.EqualError(t, err, tt.wantErr.Error())
+			default:
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_defaultLogBucketManager_getAccountID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stsClient := services.NewMockSTS(ctrl)
+	stsClient.EXPECT().GetCallerIdentityWithContext(gomock.Any(), gomock.Any()).
+		Return(&stssdk.GetCallerIdentityOutput{Account: awssdk.String("123456789012")}, nil).Times(1)
+	m := NewDefaultLogBucketManager(services.NewMockS3(ctrl), stsClient, "us-west-2", log.Log)
+	for i := 0; i < 2; i++ {
+		accountID, err := m.getAccountID(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "123456789012", accountID)
+	}
+}
@@ -0,0 +1,45 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
+	s3model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/s3"
+)
+
+// NewLogBucketSynthesizer constructs new logBucketSynthesizer
+func NewLogBucketSynthesizer(logBucketManager LogBucketManager, logger logr.Logger, stack core.Stack) *logBucketSynthesizer {
+	return &logBucketSynthesizer{
+		logBucketManager: logBucketManager,
+		logger:           logger,
+		stack:            stack,
+	}
+}
+
+// logBucketSynthesizer makes sure log buckets are ready before LoadBalancers enable logging into them.
+// log buckets are never deleted since they hold the delivered logs.
+type logBucketSynthesizer struct {
+	logBucketManager LogBucketManager
+	logger           logr.Logger
+	stack            core.Stack
+}
+
+func (s *logBucketSynthesizer) Synthesize(ctx context.Context) error {
+	var resBuckets []*s3model.LogBucket
+	if err := s.stack.ListResources(&resBuckets); err != nil {
+		return fmt.Errorf("[should never happen] failed to list resources: %w", err)
+	}
+	for _, resBucket := range resBuckets {
+		if err := s.logBucketManager.Reconcile(ctx, resBucket); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *logBucketSynthesizer) PostSynthesize(ctx context.Context) error {
+	// nothing to do here.
+	return nil
+}
@@ -3,12 +3,16 @@ package shield
 import (
 	"context"
 	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	shieldsdk "github.com/aws/aws-sdk-go-v2/service/shield"
 	shieldtypes "github.com/aws/aws-sdk-go-v2/service/shield/types"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/util/cache"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/services"
+	shieldmodel "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/shield"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/shared_constants"
+	"sync"
 	"time"
 )
 
@@ -17,6 +21,11 @@ const (
 	// service subscription rarely changes, cache it with longer period.
 	defaultSubscriptionStateCacheTTL = 2 * time.Hour
 	subscriptionStateCacheKey        = "subscriptionState"
+	// protection groups are only modified by the controller, cache the managed ones to avoid listing all protection groups on every reconcile.
+	defaultManagedProtectionGroupsCacheTTL = 10 * time.Minute
+	managedProtectionGroupsCacheKey        = "managedProtectionGroups"
+	// protection group tags rarely changes, cache whether a protection group is managed with longer period.
+	defaultProtectionGroupManagedCacheTTL = 2 * time.Hour
 )
 
 type ProtectionManager interface {
@@ -32,16 +41,48 @@ type ProtectionManager interface {
 
 	// IsSubscribed checks whether subscribed to shield service.
 	IsSubscribed(ctx context.Context) (bool, error)
+
+	// AssociateHealthCheck associates Route53 health check with shield protection for resource.
+	AssociateHealthCheck(ctx context.Context, resourceARN string, protectionID string, healthCheckID string) error
+
+	// DisassociateHealthCheck disassociates Route53 health check from shield protection for resource.
+	DisassociateHealthCheck(ctx context.Context, resourceARN string, protectionID string, healthCheckID string) error
+
+	// EnableApplicationLayerAutomaticResponse enables application layer automatic response for resource.
+	EnableApplicationLayerAutomaticResponse(ctx context.Context, resourceARN string, action shieldmodel.ApplicationLayerAutomaticResponseAction) error
+
+	// UpdateApplicationLayerAutomaticResponse updates the action of application layer automatic response for resource.
+	UpdateApplicationLayerAutomaticResponse(ctx context.Context, resourceARN string, action shieldmodel.ApplicationLayerAutomaticResponseAction) error
+
+	// DisableApplicationLayerAutomaticResponse disables application layer automatic response for resource.
+	DisableApplicationLayerAutomaticResponse(ctx context.Context, resourceARN string) error
+
+	// AddProtectionGroupMember adds resource to the members of the protection group managed by the controller.
+	// the protection group is created with the cluster tag if it doesn't exist.
+	// returns false if the protection group exists but isn't managed by the controller, it's left unchanged.
+	AddProtectionGroupMember(ctx context.Context, protectionGroupID string, resourceARN string) (bool, error)
+
+	// RemoveProtectionGroupMember removes resource from the members of the protection group managed by the controller.
+	// the protection group is deleted once it has no members left.
+	RemoveProtectionGroupMember(ctx context.Context, protectionGroupID string, resourceARN string) error
+
+	// ListManagedProtectionGroups returns the protection groups managed by the controller.
+	ListManagedProtectionGroups(ctx context.Context) ([]ProtectionGroupInfo, error)
 }
 
-func NewDefaultProtectionManager(shieldClient services.Shield, logger logr.Logger) *defaultProtectionManager {
+func NewDefaultProtectionManager(shieldClient services.Shield, clusterName string, logger logr.Logger) *defaultProtectionManager {
 	return &defaultProtectionManager{
 		shieldClient:                        shieldClient,
+		clusterName:                         clusterName,
 		logger:                              logger,
 		protectionInfoByResourceARNCache:    cache.NewExpiring(),
 		protectionInfoByResourceARNCacheTTL: defaultProtectionInfoByResourceARNCacheTTL,
 		subscriptionStateCache:              cache.NewExpiring(),
 		subscriptionStateCacheTTL:           defaultSubscriptionStateCacheTTL,
+		managedProtectionGroupsCache:        cache.NewExpiring(),
+		managedProtectionGroupsCacheTTL:     defaultManagedProtectionGroupsCacheTTL,
+		protectionGroupManagedCache:         cache.NewExpiring(),
+		protectionGroupManagedCacheTTL:      defaultProtectionGroupManagedCacheTTL,
 	}
 }
 
@@ -49,17 +90,39 @@ var _ ProtectionManager = &defaultProtectionManager{}
 
 type defaultProtectionManager struct {
 	shieldClient services.Shield
+	clusterName  string
 	logger       logr.Logger
 
 	protectionInfoByResourceARNCache    *cache.Expiring
 	protectionInfoByResourceARNCacheTTL time.Duration
 	subscriptionStateCache              *cache.Expiring
 	subscriptionStateCacheTTL           time.Duration
+	managedProtectionGroupsCache        *cache.Expiring
+	managedProtectionGroupsCacheTTL     time.Duration
+	protectionGroupManagedCache         *cache.Expiring
+	protectionGroupManagedCacheTTL      time.Duration
+
+	// protectionGroupLocks serializes the membership updates per protection group.
+	// protection groups are shared by loadBalancers across controllers, whose stack deployers share this manager.
+	protectionGroupLocks sync.Map
 }
 
 type ProtectionInfo struct {
 	Name string
 	ID   string
+	// HealthCheckIDs are the Route53 health checks associated with the protection.
+	HealthCheckIDs []string
+	// ApplicationLayerAutomaticResponseAction is the application layer automatic response action,
+	// empty if automatic response is disabled.
+	ApplicationLayerAutomaticResponseAction shieldmodel.ApplicationLayerAutomaticResponseAction
+}
+
+type ProtectionGroupInfo struct {
+	ID          string
+	ARN         string
+	Aggregation shieldtypes.ProtectionGroupAggregation
+	Pattern     shieldtypes.ProtectionGroupPattern
+	Members     []string
 }
 
 func (m *defaultProtectionManager) CreateProtection(ctx context.Context, resourceARN string, protectionName string) (string, error) {
@@ -127,8 +190,10 @@ func (m *defaultProtectionManager) GetProtection(ctx context.Context, resourceAR
 	}
 	if resp != nil && resp.Protection != nil {
 		protectionInfo = &ProtectionInfo{
-			Name: awssdk.ToString(resp.Protection.Name),
-			ID:   awssdk.ToString(resp.Protection.Id),
+			Name:                                    awssdk.ToString(resp.Protection.Name),
+			ID:                                      awssdk.ToString(resp.Protection.Id),
+			HealthCheckIDs:                          resp.Protection.HealthCheckIds,
+			ApplicationLayerAutomaticResponseAction: buildApplicationLayerAutomaticResponseAction(resp.Protection.ApplicationLayerAutomaticResponseConfiguration),
 		}
 	}
 	m.protectionInfoByResourceARNCache.Set(resourceARN, protectionInfo, m.protectionInfoByResourceARNCacheTTL)
@@ -151,3 +216,354 @@ func (m *defaultProtectionManager) IsSubscribed(ctx context.Context) (bool, erro
 	m.subscriptionStateCache.Set(subscriptionStateCacheKey, subscriptionState, m.subscriptionStateCacheTTL)
 	return shieldtypes.SubscriptionStateActive == subscriptionState, nil
 }
+
+func (m *defaultProtectionManager) AssociateHealthCheck(ctx context.Context, resourceARN string, protectionID string, healthCheckID string) error {
+	healthCheckARN, err := buildHealthCheckARN(resourceARN, healthCheckID)
+	if err != nil {
+		return err
+	}
+	req := &shieldsdk.AssociateHealthCheckInput{
+		ProtectionId:   awssdk.String(protectionID),
+		HealthCheckArn: awssdk.String(healthCheckARN),
+	}
+	m.logger.Info("associating shield health check",
+		"resourceARN", resourceARN,
+		"protectionID", protectionID,
+		"healthCheckARN", healthCheckARN)
+	if _, err := m.shieldClient.AssociateHealthCheckWithContext(ctx, req); err != nil {
+		return err
+	}
+	m.logger.Info("associated shield health check",
+		"resourceARN", resourceARN,
+		"healthCheckARN", healthCheckARN)
+	m.protectionInfoByResourceARNCache.Delete(resourceARN)
+	return nil
+}
+
+func (m *defaultProtectionManager) DisassociateHealthCheck(ctx context.Context, resourceARN string, protectionID string, healthCheckID string) error {
+	healthCheckARN, err := buildHealthCheckARN(resourceARN, healthCheckID)
+	if err != nil {
+		return err
+	}
+	req := &shieldsdk.DisassociateHealthCheckInput{
+		ProtectionId:   awssdk.String(protectionID),
+		HealthCheckArn: awssdk.String(healthCheckARN),
+	}
+	m.logger.Info("disassociating shield health check",
+		"resourceARN", resourceARN,
+		"protectionID", protectionID,
+		"healthCheckARN", healthCheckARN)
+	if _, err := m.shieldClient.DisassociateHealthCheckWithContext(ctx, req); err != nil {
+		return err
+	}
+	m.logger.Info("disassociated shield health check",
+		"resourceARN", resourceARN,
+		"healthCheckARN", healthCheckARN)
+	m.protectionInfoByResourceARNCache.Delete(resourceARN)
+	return nil
+}
+
+func (m *defaultProtectionManager) EnableApplicationLayerAutomaticResponse(ctx context.Context, resourceARN string, action shieldmodel.ApplicationLayerAutomaticResponseAction) error {
+	req := &shieldsdk.EnableApplicationLayerAutomaticResponseInput{
+		ResourceArn: awssdk.String(resourceARN),
+		Action:      buildSDKResponseAction(action),
+	}
+	m.logger.Info("enabling shield application layer automatic response",
+		"resourceARN", resourceARN,
+		"action", action)
+	if _, err := m.shieldClient.EnableApplicationLayerAutomaticResponseWithContext(ctx, req); err != nil {
+		return err
+	}
+	m.logger.Info("enabled shield application layer automatic response",
+		"resourceARN", resourceARN)
+	m.protectionInfoByResourceARNCache.Delete(resourceARN)
+	return nil
+}
+
+func (m *defaultProtectionManager) UpdateApplicationLayerAutomaticResponse(ctx context.Context, resourceARN string, action shieldmodel.ApplicationLayerAutomaticResponseAction) error {
+	req := &shieldsdk.UpdateApplicationLayerAutomaticResponseInput{
+		ResourceArn: awssdk.String(resourceARN),
+		Action:      buildSDKResponseAction(action),
+	}
+	m.logger.Info("modifying shield application layer automatic response",
+		"resourceARN", resourceARN,
+		"action", action)
+	if _, err := m.shieldClient.UpdateApplicationLayerAutomaticResponseWithContext(ctx, req); err != nil {
+		return err
+	}
+	m.logger.Info("modified shield application layer automatic response",
+		"resourceARN", resourceARN)
+	m.protectionInfoByResourceARNCache.Delete(resourceARN)
+	return nil
+}
+
+func (m *defaultProtectionManager) DisableApplicationLayerAutomaticResponse(ctx context.Context, resourceARN string) error {
+	req := &shieldsdk.DisableApplicationLayerAutomaticResponseInput{
+		ResourceArn: awssdk.String(resourceARN),
+	}
+	m.logger.Info("disabling shield application layer automatic response",
+		"resourceARN", resourceARN)
+	if _, err := m.shieldClient.DisableApplicationLayerAutomaticResponseWithContext(ctx, req); err != nil {
+		return err
+	}
+	m.logger.Info("disabled shield application layer automatic response",
+		"resourceARN", resourceARN)
+	m.protectionInfoByResourceARNCache.Delete(resourceARN)
+	return nil
+}
+
+func (m *defaultProtectionManager) AddProtectionGroupMember(ctx context.Context, protectionGroupID string, resourceARN string) (bool, error) {
+	unlock := m.lockProtectionGroup(protectionGroupID)
+	defer unlock()
+
+	protectionGroup, err := m.describeProtectionGroup(ctx, protectionGroupID)
+	if err != nil {
+		return false, err
+	}
+	if protectionGroup == nil {
+		if err := m.createProtectionGroup(ctx, protectionGroupID, []string{resourceARN}); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+	managed, err := m.isProtectionGroupManaged(ctx, *protectionGroup)
+	if err != nil {
+		return false, err
+	}
+	if !managed {
+		return false, nil
+	}
+	for _, member := range protectionGroup.Members {
+		if member == resourceARN {
+			return true, nil
+		}
+	}
+	members := append(append([]string(nil), protectionGroup.Members...), resourceARN)
+	if err := m.updateProtectionGroupMembers(ctx, *protectionGroup, members); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (m *defaultProtectionManager) RemoveProtectionGroupMember(ctx context.Context, protectionGroupID string, resourceARN string) error {
+	unlock := m.lockProtectionGroup(protectionGroupID)
+	defer unlock()
+
+	protectionGroup, err := m.describeProtectionGroup(ctx, protectionGroupID)
+	if err != nil {
+		return err
+	}
+	if protectionGroup == nil {
+		m.managedProtectionGroupsCache.Delete(managedProtectionGroupsCacheKey)
+		return nil
+	}
+	managed, err := m.isProtectionGroupManaged(ctx, *protectionGroup)
+	if err != nil {
+		return err
+	}
+	if !managed {
+		return nil
+	}
+	var members []string
+	for _, member := range protectionGroup.Members {
+		if member != resourceARN {
+			members = append(members, member)
+		}
+	}
+	if len(members) == len(protectionGroup.Members) {
+		return nil
+	}
+	if len(members) == 0 {
+		return m.deleteProtectionGroup(ctx, *protectionGroup)
+	}
+	return m.updateProtectionGroupMembers(ctx, *protectionGroup, members)
+}
+
+func (m *defaultProtectionManager) ListManagedProtectionGroups(ctx context.Context) ([]ProtectionGroupInfo, error) {
+	rawCacheItem, exists := m.managedProtectionGroupsCache.Get(managedProtectionGroupsCacheKey)
+	if exists {
+		return rawCacheItem.([]ProtectionGroupInfo), nil
+	}
+
+	req := &shieldsdk.ListProtectionGroupsInput{
+		InclusionFilters: &shieldtypes.InclusionProtectionGroupFilters{
+			Patterns: []shieldtypes.ProtectionGroupPattern{shieldtypes.ProtectionGroupPatternArbitrary},
+		},
+	}
+	sdkProtectionGroups, err := m.shieldClient.ListProtectionGroupsAsList(ctx, req)
+	if err != nil {
+		var resourceNotFoundException *shieldtypes.ResourceNotFoundException
+		if !errors.As(err, &resourceNotFoundException) {
+			return nil, err
+		}
+	}
+	var protectionGroups []ProtectionGroupInfo
+	for _, sdkProtectionGroup := range sdkProtectionGroups {
+		protectionGroup := buildProtectionGroupInfo(sdkProtectionGroup)
+		managed, err := m.isProtectionGroupManaged(ctx, protectionGroup)
+		if err != nil {
+			return nil, err
+		}
+		if managed {
+			protectionGroups = append(protectionGroups, protectionGroup)
+		}
+	}
+	m.managedProtectionGroupsCache.Set(managedProtectionGroupsCacheKey, protectionGroups, m.managedProtectionGroupsCacheTTL)
+	return protectionGroups, nil
+}
+
+// describeProtectionGroup returns shield protection group information, bypassing any cache since it's used to update the members.
+// returns nil if no protection group exists.
+func (m *defaultProtectionManager) describeProtectionGroup(ctx context.Context, protectionGroupID string) (*ProtectionGroupInfo, error) {
+	req := &shieldsdk.DescribeProtectionGroupInput{
+		ProtectionGroupId: awssdk.String(protectionGroupID),
+	}
+	resp, err := m.shieldClient.DescribeProtectionGroupWithContext(ctx, req)
+	if err != nil {
+		var resourceNotFoundException *shieldtypes.ResourceNotFoundException
+		if errors.As(err, &resourceNotFoundException) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if resp.ProtectionGroup == nil {
+		return nil, nil
+	}
+	protectionGroupInfo := buildProtectionGroupInfo(*resp.ProtectionGroup)
+	return &protectionGroupInfo, nil
+}
+
+// isProtectionGroupManaged checks whether the protection group is created by the controller, i.e. tagged with the cluster tag.
+func (m *defaultProtectionManager) isProtectionGroupManaged(ctx context.Context, protectionGroup ProtectionGroupInfo) (bool, error) {
+	if protectionGroup.Pattern != shieldtypes.ProtectionGroupPatternArbitrary {
+		return false, nil
+	}
+	rawCacheItem, exists := m.protectionGroupManagedCache.Get(protectionGroup.ARN)
+	if exists {
+		return rawCacheItem.(bool), nil
+	}
+	req := &shieldsdk.ListTagsForResourceInput{
+		ResourceARN: awssdk.String(protectionGroup.ARN),
+	}
+	resp, err := m.shieldClient.ListTagsForResourceWithContext(ctx, req)
+	if err != nil {
+		return false, err
+	}
+	managed := false
+	for _, tag := range resp.Tags {
+		if awssdk.ToString(tag.Key) == shared_constants.TagKeyK8sCluster && awssdk.ToString(tag.Value) == m.clusterName {
+			managed = true
+			break
+		}
+	}
+	m.protectionGroupManagedCache.Set(protectionGroup.ARN, managed, m.protectionGroupManagedCacheTTL)
+	return managed, nil
+}
+
+func (m *defaultProtectionManager) createProtectionGroup(ctx context.Context, protectionGroupID string, members []string) error {
+	req := &shieldsdk.CreateProtectionGroupInput{
+		ProtectionGroupId: awssdk.String(protectionGroupID),
+		Aggregation:       shieldtypes.ProtectionGroupAggregationSum,
+		Pattern:           shieldtypes.ProtectionGroupPatternArbitrary,
+		Members:           members,
+		Tags: []shieldtypes.Tag{
+			{
+				Key:   awssdk.String(shared_constants.TagKeyK8sCluster),
+				Value: awssdk.String(m.clusterName),
+			},
+		},
+	}
+	m.logger.Info("creating shield protection group",
+		"protectionGroupID", protectionGroupID,
+		"members", members)
+	if _, err := m.shieldClient.CreateProtectionGroupWithContext(ctx, req); err != nil {
+		return err
+	}
+	m.logger.Info("created shield protection group",
+		"protectionGroupID", protectionGroupID)
+	m.managedProtectionGroupsCache.Delete(managedProtectionGroupsCacheKey)
+	return nil
+}
+
+func (m *defaultProtectionManager) updateProtectionGroupMembers(ctx context.Context, protectionGroup ProtectionGroupInfo, members []string) error {
+	req := &shieldsdk.UpdateProtectionGroupInput{
+		ProtectionGroupId: awssdk.String(protectionGroup.ID),
+		Aggregation:       protectionGroup.Aggregation,
+		Pattern:           protectionGroup.Pattern,
+		Members:           members,
+	}
+	m.logger.Info("modifying shield protection group",
+		"protectionGroupID", protectionGroup.ID,
+		"members", members)
+	if _, err := m.shieldClient.UpdateProtectionGroupWithContext(ctx, req); err != nil {
+		return err
+	}
+	m.logger.Info("modified shield protection group",
+		"protectionGroupID", protectionGroup.ID)
+	m.managedProtectionGroupsCache.Delete(managedProtectionGroupsCacheKey)
+	return nil
+}
+
+func (m *defaultProtectionManager) deleteProtectionGroup(ctx context.Context, protectionGroup ProtectionGroupInfo) error {
+	req := &shieldsdk.DeleteProtectionGroupInput{
+		ProtectionGroupId: awssdk.String(protectionGroup.ID),
+	}
+	m.logger.Info("deleting shield protection group",
+		"protectionGroupID", protectionGroup.ID)
+	if _, err := m.shieldClient.DeleteProtectionGroupWithContext(ctx, req); err != nil {
+		return err
+	}
+	m.logger.Info("deleted shield protection group",
+		"protectionGroupID", protectionGroup.ID)
+	m.managedProtectionGroupsCache.Delete(managedProtectionGroupsCacheKey)
+	m.protectionGroupManagedCache.Delete(protectionGroup.ARN)
+	return nil
+}
+
+// buildHealthCheckARN builds the Route53 health check ARN within the same partition as resource.
+func buildHealthCheckARN(resourceARN string, healthCheckID string) (string, error) {
+	parsedResourceARN, err := arn.Parse(resourceARN)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to parse resource ARN: %v", resourceARN)
+	}
+	return arn.ARN{
+		Partition: parsedResourceARN.Partition,
+		Service:   "route53",
+		Resource:  "healthcheck/" + healthCheckID,
+	}.String(), nil
+}
+
+func buildSDKResponseAction(action shieldmodel.ApplicationLayerAutomaticResponseAction) *shieldtypes.ResponseAction {
+	if action == shieldmodel.ApplicationLayerAutomaticResponseActionBlock {
+		return &shieldtypes.ResponseAction{Block: &shieldtypes.BlockAction{}}
+	}
+	return &shieldtypes.ResponseAction{Count: &shieldtypes.CountAction{}}
+}
+
+func buildApplicationLayerAutomaticResponseAction(sdkConfig *shieldtypes.ApplicationLayerAutomaticResponseConfiguration) shieldmodel.ApplicationLayerAutomaticResponseAction {
+	if sdkConfig == nil || sdkConfig.Status != shieldtypes.ApplicationLayerAutomaticResponseStatusEnabled || sdkConfig.Action == nil {
+		return ""
+	}
+	if sdkConfig.Action.Block != nil {
+		return shieldmodel.ApplicationLayerAutomaticResponseActionBlock
+	}
+	return shieldmodel.ApplicationLayerAutomaticResponseActionCount
+}
+
+func buildProtectionGroupInfo(sdkProtectionGroup shieldtypes.ProtectionGroup) ProtectionGroupInfo {
+	return ProtectionGroupInfo{
+		ID:          awssdk.ToString(sdkProtectionGroup.ProtectionGroupId),
+		ARN:         awssdk.ToString(sdkProtectionGroup.ProtectionGroupArn),
+		Aggregation: sdkProtectionGroup.Aggregation,
+		Pattern:     sdkProtectionGroup.Pattern,
+		Members:     sdkProtectionGroup.Members,
+	}
+}
+
+// lockProtectionGroup acquires the membership lock of protection group, returns the func to release it.
+func (m *defaultProtectionManager) lockProtectionGroup(protectionGroupID string) func() {
+	rawLock, _ := m.protectionGroupLocks.LoadOrStore(protectionGroupID, &sync.Mutex{})
+	lock := rawLock.(*sync.Mutex)
+	lock.Lock()
+	return lock.Unlock
+}
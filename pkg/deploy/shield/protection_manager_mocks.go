@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	shield0 "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/shield"
 )
 
 // MockProtectionManager is a mock of ProtectionManager interface.
@@ -34,6 +35,35 @@ func (m *MockProtectionManager) EXPECT() *MockProtectionManagerMockRecorder {
 	return m.recorder
 }
 
+// AddProtectionGroupMember mocks base method.
+func (m *MockProtectionManager) AddProtectionGroupMember(arg0 context.Context, arg1, arg2 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddProtectionGroupMember", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddProtectionGroupMember indicates an expected call of AddProtectionGroupMember.
+func (mr *MockProtectionManagerMockRecorder) AddProtectionGroupMember(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProtectionGroupMember", reflect.TypeOf((*MockProtectionManager)(nil).AddProtectionGroupMember), arg0, arg1, arg2)
+}
+
+// AssociateHealthCheck mocks base method.
+func (m *MockProtectionManager) AssociateHealthCheck(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssociateHealthCheck", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssociateHealthCheck indicates an expected call of AssociateHealthCheck.
+func (mr *MockProtectionManagerMockRecorder) AssociateHealthCheck(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssociateHealthCheck", reflect.TypeOf((*MockProtectionManager)(nil).AssociateHealthCheck), arg0, arg1, arg2, arg3)
+}
+
 // CreateProtection mocks base method.
 func (m *MockProtectionManager) CreateProtection(arg0 context.Context, arg1, arg2 string) (string, error) {
 	m.ctrl.T.Helper()
@@ -63,6 +93,48 @@ func (mr *MockProtectionManagerMockRecorder) DeleteProtection(arg0, arg1, arg2 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProtection", reflect.TypeOf((*MockProtectionManager)(nil).DeleteProtection), arg0, arg1, arg2)
 }
 
+// DisableApplicationLayerAutomaticResponse mocks base method.
+func (m *MockProtectionManager) DisableApplicationLayerAutomaticResponse(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableApplicationLayerAutomaticResponse", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableApplicationLayerAutomaticResponse indicates an expected call of DisableApplicationLayerAutomaticResponse.
+func (mr *MockProtectionManagerMockRecorder) DisableApplicationLayerAutomaticResponse(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableApplicationLayerAutomaticResponse", reflect.TypeOf((*MockProtectionManager)(nil).DisableApplicationLayerAutomaticResponse), arg0, arg1)
+}
+
+// DisassociateHealthCheck mocks base method.
+func (m *MockProtectionManager) DisassociateHealthCheck(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisassociateHealthCheck", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisassociateHealthCheck indicates an expected call of DisassociateHealthCheck.
+func (mr *MockProtectionManagerMockRecorder) DisassociateHealthCheck(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisassociateHealthCheck", reflect.TypeOf((*MockProtectionManager)(nil).DisassociateHealthCheck), arg0, arg1, arg2, arg3)
+}
+
+// EnableApplicationLayerAutomaticResponse mocks base method.
+func (m *MockProtectionManager) EnableApplicationLayerAutomaticResponse(arg0 context.Context, arg1 string, arg2 shield0.ApplicationLayerAutomaticResponseAction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableApplicationLayerAutomaticResponse", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableApplicationLayerAutomaticResponse indicates an expected call of EnableApplicationLayerAutomaticResponse.
+func (mr *MockProtectionManagerMockRecorder) EnableApplicationLayerAutomaticResponse(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableApplicationLayerAutomaticResponse", reflect.TypeOf((*MockProtectionManager)(nil).EnableApplicationLayerAutomaticResponse), arg0, arg1, arg2)
+}
+
 // GetProtection mocks base method.
 func (m *MockProtectionManager) GetProtection(arg0 context.Context, arg1 string) (*ProtectionInfo, error) {
 	m.ctrl.T.Helper()
@@ -92,3 +164,46 @@ func (mr *MockProtectionManagerMockRecorder) IsSubscribed(arg0 interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSubscribed", reflect.TypeOf((*MockProtectionManager)(nil).IsSubscribed), arg0)
 }
+
+// ListManagedProtectionGroups mocks base method.
+func (m *MockProtectionManager) ListManagedProtectionGroups(arg0 context.Context) ([]ProtectionGroupInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListManagedProtectionGroups", arg0)
+	ret0, _ := ret[0].([]ProtectionGroupInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListManagedProtectionGroups indicates an expected call of ListManagedProtectionGroups.
+func (mr *MockProtectionManagerMockRecorder) ListManagedProtectionGroups(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListManagedProtectionGroups", reflect.TypeOf((*MockProtectionManager)(nil).ListManagedProtectionGroups), arg0)
+}
+
+// RemoveProtectionGroupMember mocks base method.
+func (m *MockProtectionManager) RemoveProtectionGroupMember(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveProtectionGroupMember", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveProtectionGroupMember indicates an expected call of RemoveProtectionGroupMember.
+func (mr *MockProtectionManagerMockRecorder) RemoveProtectionGroupMember(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveProtectionGroupMember", reflect.TypeOf((*MockProtectionManager)(nil).RemoveProtectionGroupMember), arg0, arg1, arg2)
+}
+
+// UpdateApplicationLayerAutomaticResponse mocks base method.
+func (m *MockProtectionManager) UpdateApplicationLayerAutomaticResponse(arg0 context.Context, arg1 string, arg2 shield0.ApplicationLayerAutomaticResponseAction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateApplicationLayerAutomaticResponse", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateApplicationLayerAutomaticResponse indicates an expected call of UpdateApplicationLayerAutomaticResponse.
+func (mr *MockProtectionManagerMockRecorder) UpdateApplicationLayerAutomaticResponse(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateApplicationLayerAutomaticResponse", reflect.TypeOf((*MockProtectionManager)(nil).UpdateApplicationLayerAutomaticResponse), arg0, arg1, arg2)
+}
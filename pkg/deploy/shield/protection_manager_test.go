@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
 	shieldsdk "github.com/aws/aws-sdk-go-v2/service/shield"
 	"github.com/go-logr/logr"
 	"github.com/golang/mock/gomock"
@@ -13,6 +14,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"k8s.io/apimachinery/pkg/util/cache"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/services"
+	shieldmodel "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/shield"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
@@ -169,3 +171,298 @@ func Test_defaultProtectionManager_IsSubscribed(t *testing.T) {
 		})
 	}
 }
+
+func Test_defaultProtectionManager_AssociateHealthCheck(t *testing.T) {
+	tests := []struct {
+		name               string
+		resourceARN        string
+		wantHealthCheckARN string
+		err                error
+		wantErr            error
+	}{
+		{
+			name:               "associates health check in aws partition",
+			resourceARN:        "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/lb/abc",
+			wantHealthCheckARN: "arn:aws:route53:::healthcheck/hc-1",
+		},
+		{
+			name:               "associates health check in china partition",
+			resourceARN:        "arn:aws-cn:elasticloadbalancing:cn-north-1:123456789012:loadbalancer/app/lb/abc",
+			wantHealthCheckARN: "arn:aws-cn:route53:::healthcheck/hc-1",
+		},
+		{
+			name:               "associate health check fails",
+			resourceARN:        "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/lb/abc",
+			wantHealthCheckARN: "arn:aws:route53:::healthcheck/hc-1",
+			err:                errors.New("some error"),
+			wantErr:            errors.New("some error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			shieldClient := services.NewMockShield(ctrl)
+			shieldClient.EXPECT().AssociateHealthCheckWithContext(gomock.Any(), &shieldsdk.AssociateHealthCheckInput{
+				ProtectionId:   awssdk.String("protection-id"),
+				HealthCheckArn: awssdk.String(tt.wantHealthCheckARN),
+			}).Return(&shieldsdk.AssociateHealthCheckOutput{}, tt.err)
+			m := NewDefaultProtectionManager(shieldClient, "cluster", logr.New(&log.NullLogSink{}))
+			m.protectionInfoByResourceARNCache.Set(tt.resourceARN, &ProtectionInfo{ID: "protection-id"}, time.Minute)
+			err := m.AssociateHealthCheck(context.Background(), tt.resourceARN, "protection-id", "hc-1")
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+			_, cached := m.protectionInfoByResourceARNCache.Get(tt.resourceARN)
+			assert.False(t, cached)
+		})
+	}
+}
+
+func Test_defaultProtectionManager_GetProtection_settings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	shieldClient := services.NewMockShield(ctrl)
+	shieldClient.EXPECT().DescribeProtectionWithContext(gomock.Any(), gomock.Any()).Return(&shieldsdk.DescribeProtectionOutput{
+		Protection: &shieldtypes.Protection{
+			Id:             awssdk.String("protection-id"),
+			Name:           awssdk.String("protection-name"),
+			HealthCheckIds: []string{"hc-1"},
+			ApplicationLayerAutomaticResponseConfiguration: &shieldtypes.ApplicationLayerAutomaticResponseConfiguration{
+				Status: shieldtypes.ApplicationLayerAutomaticResponseStatusEnabled,
+				Action: &shieldtypes.ResponseAction{Block: &shieldtypes.BlockAction{}},
+			},
+		},
+	}, nil)
+	m := NewDefaultProtectionManager(shieldClient, "cluster", logr.New(&log.NullLogSink{}))
+	got, err := m.GetProtection(context.Background(), "lb-arn")
+	assert.NoError(t, err)
+	assert.Equal(t, &ProtectionInfo{
+		Name:                                    "protection-name",
+		ID:                                      "protection-id",
+		HealthCheckIDs:                          []string{"hc-1"},
+		ApplicationLayerAutomaticResponseAction: shieldmodel.ApplicationLayerAutomaticResponseActionBlock,
+	}, got)
+}
+
+func Test_defaultProtectionManager_ListManagedProtectionGroups(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	shieldClient := services.NewMockShield(ctrl)
+	shieldClient.EXPECT().ListProtectionGroupsAsList(gomock.Any(), &shieldsdk.ListProtectionGroupsInput{
+		InclusionFilters: &shieldtypes.InclusionProtectionGroupFilters{
+			Patterns: []shieldtypes.ProtectionGroupPattern{shieldtypes.ProtectionGroupPatternArbitrary},
+		},
+	}).Return([]shieldtypes.ProtectionGroup{
+		{
+			ProtectionGroupId:  awssdk.String("group-1"),
+			ProtectionGroupArn: awssdk.String("group-1-arn"),
+			Aggregation:        shieldtypes.ProtectionGroupAggregationSum,
+			Pattern:            shieldtypes.ProtectionGroupPatternArbitrary,
+			Members:            []string{"lb-arn", "other-arn"},
+		},
+		{
+			ProtectionGroupId:  awssdk.String("group-2"),
+			ProtectionGroupArn: awssdk.String("group-2-arn"),
+			Aggregation:        shieldtypes.ProtectionGroupAggregationSum,
+			Pattern:            shieldtypes.ProtectionGroupPatternArbitrary,
+			Members:            []string{"lb-arn"},
+		},
+	}, nil).Times(1)
+	shieldClient.EXPECT().ListTagsForResourceWithContext(gomock.Any(), &shieldsdk.ListTagsForResourceInput{
+		ResourceARN: awssdk.String("group-1-arn"),
+	}).Return(&shieldsdk.ListTagsForResourceOutput{
+		Tags: []shieldtypes.Tag{{Key: awssdk.String("elbv2.k8s.aws/cluster"), Value: awssdk.String("cluster")}},
+	}, nil).Times(1)
+	shieldClient.EXPECT().ListTagsForResourceWithContext(gomock.Any(), &shieldsdk.ListTagsForResourceInput{
+		ResourceARN: awssdk.String("group-2-arn"),
+	}).Return(&shieldsdk.ListTagsForResourceOutput{
+		Tags: []shieldtypes.Tag{{Key: awssdk.String("elbv2.k8s.aws/cluster"), Value: awssdk.String("other-cluster")}},
+	}, nil).Times(1)
+	m := NewDefaultProtectionManager(shieldClient, "cluster", logr.New(&log.NullLogSink{}))
+	want := []ProtectionGroupInfo{
+		{
+			ID:          "group-1",
+			ARN:         "group-1-arn",
+			Aggregation: shieldtypes.ProtectionGroupAggregationSum,
+			Pattern:     shieldtypes.ProtectionGroupPatternArbitrary,
+			Members:     []string{"lb-arn", "other-arn"},
+		},
+	}
+	// the second invocation is served from cache.
+	for i := 0; i < 2; i++ {
+		got, err := m.ListManagedProtectionGroups(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+	}
+}
+
+func Test_defaultProtectionManager_AddProtectionGroupMember(t *testing.T) {
+	managedTags := &shieldsdk.ListTagsForResourceOutput{
+		Tags: []shieldtypes.Tag{{Key: awssdk.String("elbv2.k8s.aws/cluster"), Value: awssdk.String("cluster")}},
+	}
+	tests := []struct {
+		name              string
+		setupExpectations func(shieldClient *services.MockShield)
+		wantManaged       bool
+	}{
+		{
+			name: "protection group is created with cluster tag",
+			setupExpectations: func(shieldClient *services.MockShield) {
+				shieldClient.EXPECT().DescribeProtectionGroupWithContext(gomock.Any(), gomock.Any()).Return(nil, &shieldtypes.ResourceNotFoundException{})
+				shieldClient.EXPECT().CreateProtectionGroupWithContext(gomock.Any(), &shieldsdk.CreateProtectionGroupInput{
+					ProtectionGroupId: awssdk.String("group"),
+					Aggregation:       shieldtypes.ProtectionGroupAggregationSum,
+					Pattern:           shieldtypes.ProtectionGroupPatternArbitrary,
+					Members:           []string{"lb-arn"},
+					Tags:              []shieldtypes.Tag{{Key: awssdk.String("elbv2.k8s.aws/cluster"), Value: awssdk.String("cluster")}},
+				}).Return(&shieldsdk.CreateProtectionGroupOutput{}, nil)
+			},
+			wantManaged: true,
+		},
+		{
+			name: "resource is added to managed protection group",
+			setupExpectations: func(shieldClient *services.MockShield) {
+				shieldClient.EXPECT().DescribeProtectionGroupWithContext(gomock.Any(), gomock.Any()).Return(&shieldsdk.DescribeProtectionGroupOutput{
+					ProtectionGroup: &shieldtypes.ProtectionGroup{
+						ProtectionGroupId:  awssdk.String("group"),
+						ProtectionGroupArn: awssdk.String("group-arn"),
+						Aggregation:        shieldtypes.ProtectionGroupAggregationMax,
+						Pattern:            shieldtypes.ProtectionGroupPatternArbitrary,
+						Members:            []string{"other-arn"},
+					},
+				}, nil)
+				shieldClient.EXPECT().ListTagsForResourceWithContext(gomock.Any(), gomock.Any()).Return(managedTags, nil)
+				shieldClient.EXPECT().UpdateProtectionGroupWithContext(gomock.Any(), &shieldsdk.UpdateProtectionGroupInput{
+					ProtectionGroupId: awssdk.String("group"),
+					Aggregation:       shieldtypes.ProtectionGroupAggregationMax,
+					Pattern:           shieldtypes.ProtectionGroupPatternArbitrary,
+					Members:           []string{"other-arn", "lb-arn"},
+				}).Return(&shieldsdk.UpdateProtectionGroupOutput{}, nil)
+			},
+			wantManaged: true,
+		},
+		{
+			name: "unmanaged protection group is left unchanged",
+			setupExpectations: func(shieldClient *services.MockShield) {
+				shieldClient.EXPECT().DescribeProtectionGroupWithContext(gomock.Any(), gomock.Any()).Return(&shieldsdk.DescribeProtectionGroupOutput{
+					ProtectionGroup: &shieldtypes.ProtectionGroup{
+						ProtectionGroupId:  awssdk.String("group"),
+						ProtectionGroupArn: awssdk.String("group-arn"),
+						Pattern:            shieldtypes.ProtectionGroupPatternArbitrary,
+						Members:            []string{"other-arn"},
+					},
+				}, nil)
+				shieldClient.EXPECT().ListTagsForResourceWithContext(gomock.Any(), gomock.Any()).Return(&shieldsdk.ListTagsForResourceOutput{}, nil)
+			},
+			wantManaged: false,
+		},
+		{
+			name: "protection group with non-arbitrary pattern is left unchanged",
+			setupExpectations: func(shieldClient *services.MockShield) {
+				shieldClient.EXPECT().DescribeProtectionGroupWithContext(gomock.Any(), gomock.Any()).Return(&shieldsdk.DescribeProtectionGroupOutput{
+					ProtectionGroup: &shieldtypes.ProtectionGroup{
+						ProtectionGroupId:  awssdk.String("group"),
+						ProtectionGroupArn: awssdk.String("group-arn"),
+						Pattern:            shieldtypes.ProtectionGroupPatternByResourceType,
+					},
+				}, nil)
+			},
+			wantManaged: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			shieldClient := services.NewMockShield(ctrl)
+			tt.setupExpectations(shieldClient)
+			m := NewDefaultProtectionManager(shieldClient, "cluster", logr.New(&log.NullLogSink{}))
+			got, err := m.AddProtectionGroupMember(context.Background(), "group", "lb-arn")
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantManaged, got)
+		})
+	}
+}
+
+func Test_defaultProtectionManager_RemoveProtectionGroupMember(t *testing.T) {
+	managedTags := &shieldsdk.ListTagsForResourceOutput{
+		Tags: []shieldtypes.Tag{{Key: awssdk.String("elbv2.k8s.aws/cluster"), Value: awssdk.String("cluster")}},
+	}
+	tests := []struct {
+		name              string
+		setupExpectations func(shieldClient *services.MockShield)
+	}{
+		{
+			name: "resource is removed from managed protection group",
+			setupExpectations: func(shieldClient *services.MockShield) {
+				shieldClient.EXPECT().DescribeProtectionGroupWithContext(gomock.Any(), gomock.Any()).Return(&shieldsdk.DescribeProtectionGroupOutput{
+					ProtectionGroup: &shieldtypes.ProtectionGroup{
+						ProtectionGroupId:  awssdk.String("group"),
+						ProtectionGroupArn: awssdk.String("group-arn"),
+						Aggregation:        shieldtypes.ProtectionGroupAggregationSum,
+						Pattern:            shieldtypes.ProtectionGroupPatternArbitrary,
+						Members:            []string{"lb-arn", "other-arn"},
+					},
+				}, nil)
+				shieldClient.EXPECT().ListTagsForResourceWithContext(gomock.Any(), gomock.Any()).Return(managedTags, nil)
+				shieldClient.EXPECT().UpdateProtectionGroupWithContext(gomock.Any(), &shieldsdk.UpdateProtectionGroupInput{
+					ProtectionGroupId: awssdk.String("group"),
+					Aggregation:       shieldtypes.ProtectionGroupAggregationSum,
+					Pattern:           shieldtypes.ProtectionGroupPatternArbitrary,
+					Members:           []string{"other-arn"},
+				}).Return(&shieldsdk.UpdateProtectionGroupOutput{}, nil)
+			},
+		},
+		{
+			name: "managed protection group left without members is deleted",
+			setupExpectations: func(shieldClient *services.MockShield) {
+				shieldClient.EXPECT().DescribeProtectionGroupWithContext(gomock.Any(), gomock.Any()).Return(&shieldsdk.DescribeProtectionGroupOutput{
+					ProtectionGroup: &shieldtypes.ProtectionGroup{
+						ProtectionGroupId:  awssdk.String("group"),
+						ProtectionGroupArn: awssdk.String("group-arn"),
+						Pattern:            shieldtypes.ProtectionGroupPatternArbitrary,
+						Members:            []string{"lb-arn"},
+					},
+				}, nil)
+				shieldClient.EXPECT().ListTagsForResourceWithContext(gomock.Any(), gomock.Any()).Return(managedTags, nil)
+				shieldClient.EXPECT().DeleteProtectionGroupWithContext(gomock.Any(), &shieldsdk.DeleteProtectionGroupInput{
+					ProtectionGroupId: awssdk.String("group"),
+				}).Return(&shieldsdk.DeleteProtectionGroupOutput{}, nil)
+			},
+		},
+		{
+			name: "unmanaged protection group is left unchanged",
+			setupExpectations: func(shieldClient *services.MockShield) {
+				shieldClient.EXPECT().DescribeProtectionGroupWithContext(gomock.Any(), gomock.Any()).Return(&shieldsdk.DescribeProtectionGroupOutput{
+					ProtectionGroup: &shieldtypes.ProtectionGroup{
+						ProtectionGroupId:  awssdk.String("group"),
+						ProtectionGroupArn: awssdk.String("group-arn"),
+						Pattern:            shieldtypes.ProtectionGroupPatternArbitrary,
+						Members:            []string{"lb-arn"},
+					},
+				}, nil)
+				shieldClient.EXPECT().ListTagsForResourceWithContext(gomock.Any(), gomock.Any()).Return(&shieldsdk.ListTagsForResourceOutput{}, nil)
+			},
+		},
+		{
+			name: "protection group doesn't exist",
+			setupExpectations: func(shieldClient *services.MockShield) {
+				shieldClient.EXPECT().DescribeProtectionGroupWithContext(gomock.Any(), gomock.Any()).Return(nil, &shieldtypes.ResourceNotFoundException{})
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			shieldClient := services.NewMockShield(ctrl)
+			tt.setupExpectations(shieldClient)
+			m := NewDefaultProtectionManager(shieldClient, "cluster", logr.New(&log.NullLogSink{}))
+			err := m.RemoveProtectionGroupMember(context.Background(), "group", "lb-arn")
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -77,9 +77,98 @@ func (s *protectionSynthesizer) synthesizeProtectionsOnLB(ctx context.Context, l
 				"protectionID", protectionInfo.ID)
 		}
 	case enableProtection && protectionInfo == nil:
-		if _, err := s.protectionManager.CreateProtection(ctx, lbARN, protectionNameManaged); err != nil {
+		protectionID, err := s.protectionManager.CreateProtection(ctx, lbARN, protectionNameManaged)
+		if err != nil {
 			return errors.Wrap(err, "failed to create shield protection on LoadBalancer")
 		}
+		protectionInfo = &ProtectionInfo{
+			Name: protectionNameManaged,
+			ID:   protectionID,
+		}
+	}
+	if enableProtection {
+		return s.synthesizeProtectionSettings(ctx, lbARN, *protectionInfo, resProtections[0].Spec)
+	}
+	return nil
+}
+
+func (s *protectionSynthesizer) synthesizeProtectionSettings(ctx context.Context, lbARN string, protectionInfo ProtectionInfo, spec shieldmodel.ProtectionSpec) error {
+	if spec.HealthCheckIDs != nil {
+		if err := s.synthesizeHealthChecks(ctx, lbARN, protectionInfo, spec.HealthCheckIDs); err != nil {
+			return errors.Wrap(err, "failed to reconcile shield health checks on LoadBalancer")
+		}
+	}
+	if spec.ApplicationLayerAutomaticResponse != nil {
+		if err := s.synthesizeApplicationLayerAutomaticResponse(ctx, lbARN, protectionInfo, *spec.ApplicationLayerAutomaticResponse); err != nil {
+			return errors.Wrap(err, "failed to reconcile shield application layer automatic response on LoadBalancer")
+		}
+	}
+	if spec.ProtectionGroupIDs != nil {
+		if err := s.synthesizeProtectionGroups(ctx, lbARN, spec.ProtectionGroupIDs); err != nil {
+			return errors.Wrap(err, "failed to reconcile shield protection groups on LoadBalancer")
+		}
+	}
+	return nil
+}
+
+func (s *protectionSynthesizer) synthesizeHealthChecks(ctx context.Context, lbARN string, protectionInfo ProtectionInfo, desiredHealthCheckIDs []string) error {
+	desired := sets.NewString(desiredHealthCheckIDs...)
+	current := sets.NewString(protectionInfo.HealthCheckIDs...)
+	// disassociate first, shield limits the number of health checks associated with a protection.
+	for _, healthCheckID := range current.Difference(desired).List() {
+		if err := s.protectionManager.DisassociateHealthCheck(ctx, lbARN, protectionInfo.ID, healthCheckID); err != nil {
+			return err
+		}
+	}
+	for _, healthCheckID := range desired.Difference(current).List() {
+		if err := s.protectionManager.AssociateHealthCheck(ctx, lbARN, protectionInfo.ID, healthCheckID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *protectionSynthesizer) synthesizeApplicationLayerAutomaticResponse(ctx context.Context, lbARN string, protectionInfo ProtectionInfo, desired shieldmodel.ApplicationLayerAutomaticResponse) error {
+	currentAction := protectionInfo.ApplicationLayerAutomaticResponseAction
+	switch {
+	case !desired.Enabled && currentAction != "":
+		return s.protectionManager.DisableApplicationLayerAutomaticResponse(ctx, lbARN)
+	case desired.Enabled && currentAction == "":
+		return s.protectionManager.EnableApplicationLayerAutomaticResponse(ctx, lbARN, desired.Action)
+	case desired.Enabled && currentAction != desired.Action:
+		return s.protectionManager.UpdateApplicationLayerAutomaticResponse(ctx, lbARN, desired.Action)
+	}
+	return nil
+}
+
+func (s *protectionSynthesizer) synthesizeProtectionGroups(ctx context.Context, lbARN string, desiredProtectionGroupIDs []string) error {
+	desired := sets.NewString(desiredProtectionGroupIDs...)
+	var unmanagedProtectionGroupIDs []string
+	for _, protectionGroupID := range desired.List() {
+		managed, err := s.protectionManager.AddProtectionGroupMember(ctx, protectionGroupID, lbARN)
+		if err != nil {
+			return err
+		}
+		if !managed {
+			unmanagedProtectionGroupIDs = append(unmanagedProtectionGroupIDs, protectionGroupID)
+		}
+	}
+
+	managedProtectionGroups, err := s.protectionManager.ListManagedProtectionGroups(ctx)
+	if err != nil {
+		return err
+	}
+	for _, protectionGroup := range managedProtectionGroups {
+		if desired.Has(protectionGroup.ID) || !sets.NewString(protectionGroup.Members...).Has(lbARN) {
+			continue
+		}
+		if err := s.protectionManager.RemoveProtectionGroupMember(ctx, protectionGroup.ID, lbARN); err != nil {
+			return err
+		}
+	}
+	// the membership of the other protection groups is still reconciled, so the LoadBalancer doesn't stay in stale ones.
+	if len(unmanagedProtectionGroupIDs) != 0 {
+		return errors.Errorf("shield protection groups not managed by controller: %v", unmanagedProtectionGroupIDs)
 	}
 	return nil
 }
@@ -96,3 +185,54 @@ func mapResProtectionByResourceARN(resProtections []*shieldmodel.Protection) (ma
 	}
 	return resProtectionsByResARN, nil
 }
+
+// FindLoadBalancerARNsToDeleteFunc finds the ARNs of the LoadBalancers that will be deleted by the stack.
+type FindLoadBalancerARNsToDeleteFunc func(ctx context.Context) (sets.Set[string], error)
+
+// NewProtectionGroupCleanupSynthesizer constructs new protectionGroupCleanupSynthesizer
+func NewProtectionGroupCleanupSynthesizer(protectionManager ProtectionManager, findLoadBalancerARNsToDelete FindLoadBalancerARNsToDeleteFunc,
+	logger logr.Logger) *protectionGroupCleanupSynthesizer {
+	return &protectionGroupCleanupSynthesizer{
+		protectionManager:            protectionManager,
+		findLoadBalancerARNsToDelete: findLoadBalancerARNsToDelete,
+		logger:                       logger,
+	}
+}
+
+// protectionGroupCleanupSynthesizer removes the LoadBalancers that will be deleted from the protection groups managed by controller.
+// it must be invoked before the LoadBalancerSynthesizer, since the stack no longer references these LoadBalancers.
+type protectionGroupCleanupSynthesizer struct {
+	protectionManager            ProtectionManager
+	findLoadBalancerARNsToDelete FindLoadBalancerARNsToDeleteFunc
+	logger                       logr.Logger
+}
+
+func (s *protectionGroupCleanupSynthesizer) Synthesize(ctx context.Context) error {
+	managedProtectionGroups, err := s.protectionManager.ListManagedProtectionGroups(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to list shield protection groups")
+	}
+	if len(managedProtectionGroups) == 0 {
+		return nil
+	}
+	lbARNsToDelete, err := s.findLoadBalancerARNsToDelete(ctx)
+	if err != nil {
+		return err
+	}
+	for _, protectionGroup := range managedProtectionGroups {
+		for _, member := range protectionGroup.Members {
+			if !lbARNsToDelete.Has(member) {
+				continue
+			}
+			if err := s.protectionManager.RemoveProtectionGroupMember(ctx, protectionGroup.ID, member); err != nil {
+				return errors.Wrap(err, "failed to remove LoadBalancer from shield protection group")
+			}
+		}
+	}
+	return nil
+}
+
+func (s *protectionGroupCleanupSynthesizer) PostSynthesize(ctx context.Context) error {
+	// nothing to do here.
+	return nil
+}
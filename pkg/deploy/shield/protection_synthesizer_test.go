@@ -6,6 +6,7 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
 	shieldmodel "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/shield"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -247,3 +248,197 @@ func Test_protectionSynthesizer_Synthesize(t *testing.T) {
 		})
 	}
 }
+
+func Test_protectionSynthesizer_synthesizeProtectionSettings(t *testing.T) {
+	lbARN := "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/lb/abc"
+	protectionInfo := ProtectionInfo{
+		Name:                                    "managed by aws-load-balancer-controller",
+		ID:                                      "some-protection-id",
+		HealthCheckIDs:                          []string{"hc-1", "hc-2"},
+		ApplicationLayerAutomaticResponseAction: shieldmodel.ApplicationLayerAutomaticResponseActionCount,
+	}
+	tests := []struct {
+		name              string
+		spec              shieldmodel.ProtectionSpec
+		setupExpectations func(m *MockProtectionManager)
+		wantErr           string
+	}{
+		{
+			name: "nil settings are left unchanged",
+			spec: shieldmodel.ProtectionSpec{Enabled: true},
+		},
+		{
+			name: "health checks are associated and disassociated",
+			spec: shieldmodel.ProtectionSpec{
+				Enabled:        true,
+				HealthCheckIDs: []string{"hc-2", "hc-3"},
+			},
+			setupExpectations: func(m *MockProtectionManager) {
+				gomock.InOrder(
+					m.EXPECT().DisassociateHealthCheck(gomock.Any(), lbARN, "some-protection-id", "hc-1").Return(nil),
+					m.EXPECT().AssociateHealthCheck(gomock.Any(), lbARN, "some-protection-id", "hc-3").Return(nil),
+				)
+			},
+		},
+		{
+			name: "empty health checks disassociates all",
+			spec: shieldmodel.ProtectionSpec{
+				Enabled:        true,
+				HealthCheckIDs: []string{},
+			},
+			setupExpectations: func(m *MockProtectionManager) {
+				m.EXPECT().DisassociateHealthCheck(gomock.Any(), lbARN, "some-protection-id", "hc-1").Return(nil)
+				m.EXPECT().DisassociateHealthCheck(gomock.Any(), lbARN, "some-protection-id", "hc-2").Return(nil)
+			},
+		},
+		{
+			name: "automatic response action drifted",
+			spec: shieldmodel.ProtectionSpec{
+				Enabled: true,
+				ApplicationLayerAutomaticResponse: &shieldmodel.ApplicationLayerAutomaticResponse{
+					Enabled: true,
+					Action:  shieldmodel.ApplicationLayerAutomaticResponseActionBlock,
+				},
+			},
+			setupExpectations: func(m *MockProtectionManager) {
+				m.EXPECT().UpdateApplicationLayerAutomaticResponse(gomock.Any(), lbARN, shieldmodel.ApplicationLayerAutomaticResponseActionBlock).Return(nil)
+			},
+		},
+		{
+			name: "automatic response matches",
+			spec: shieldmodel.ProtectionSpec{
+				Enabled: true,
+				ApplicationLayerAutomaticResponse: &shieldmodel.ApplicationLayerAutomaticResponse{
+					Enabled: true,
+					Action:  shieldmodel.ApplicationLayerAutomaticResponseActionCount,
+				},
+			},
+		},
+		{
+			name: "automatic response disabled",
+			spec: shieldmodel.ProtectionSpec{
+				Enabled:                           true,
+				ApplicationLayerAutomaticResponse: &shieldmodel.ApplicationLayerAutomaticResponse{Enabled: false},
+			},
+			setupExpectations: func(m *MockProtectionManager) {
+				m.EXPECT().DisableApplicationLayerAutomaticResponse(gomock.Any(), lbARN).Return(nil)
+			},
+		},
+		{
+			name: "protection groups are joined and left, unmanaged protection groups are reported",
+			spec: shieldmodel.ProtectionSpec{
+				Enabled:            true,
+				ProtectionGroupIDs: []string{"existing", "new", "joined", "by-type"},
+			},
+			setupExpectations: func(m *MockProtectionManager) {
+				m.EXPECT().AddProtectionGroupMember(gomock.Any(), "by-type", lbARN).Return(false, nil)
+				m.EXPECT().AddProtectionGroupMember(gomock.Any(), "existing", lbARN).Return(true, nil)
+				m.EXPECT().AddProtectionGroupMember(gomock.Any(), "joined", lbARN).Return(true, nil)
+				m.EXPECT().AddProtectionGroupMember(gomock.Any(), "new", lbARN).Return(true, nil)
+				m.EXPECT().ListManagedProtectionGroups(gomock.Any()).Return([]ProtectionGroupInfo{
+					{
+						ID:      "joined",
+						Members: []string{lbARN},
+					},
+					{
+						ID:      "stale",
+						Members: []string{lbARN, "other-arn"},
+					},
+					{
+						ID:      "unrelated",
+						Members: []string{"other-arn"},
+					},
+				}, nil)
+				m.EXPECT().RemoveProtectionGroupMember(gomock.Any(), "stale", lbARN).Return(nil)
+			},
+			wantErr: "failed to reconcile shield protection groups on LoadBalancer: shield protection groups not managed by controller: [by-type]",
+		},
+		{
+			name: "failed to associate health check",
+			spec: shieldmodel.ProtectionSpec{
+				Enabled:        true,
+				HealthCheckIDs: []string{"hc-1", "hc-2", "hc-3"},
+			},
+			setupExpectations: func(m *MockProtectionManager) {
+				m.EXPECT().AssociateHealthCheck(gomock.Any(), lbARN, "some-protection-id", "hc-3").Return(fmt.Errorf("some error"))
+			},
+			wantErr: "failed to reconcile shield health checks on LoadBalancer: some error",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			protectionManager := NewMockProtectionManager(ctrl)
+			if tt.setupExpectations != nil {
+				tt.setupExpectations(protectionManager)
+			}
+			s := &protectionSynthesizer{
+				protectionManager: protectionManager,
+				logger:            logr.New(&log.NullLogSink{}),
+			}
+			err := s.synthesizeProtectionSettings(context.Background(), lbARN, protectionInfo, tt.spec)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_protectionGroupCleanupSynthesizer_Synthesize(t *testing.T) {
+	tests := []struct {
+		name              string
+		setupExpectations func(m *MockProtectionManager)
+		lbARNsToDelete    []string
+		wantErr           string
+	}{
+		{
+			name: "no managed protection groups",
+			setupExpectations: func(m *MockProtectionManager) {
+				m.EXPECT().ListManagedProtectionGroups(gomock.Any()).Return(nil, nil)
+			},
+		},
+		{
+			name: "LoadBalancers to delete are removed from managed protection groups",
+			setupExpectations: func(m *MockProtectionManager) {
+				m.EXPECT().ListManagedProtectionGroups(gomock.Any()).Return([]ProtectionGroupInfo{
+					{ID: "group-1", Members: []string{"lb-1", "lb-2"}},
+					{ID: "group-2", Members: []string{"lb-3"}},
+				}, nil)
+				m.EXPECT().RemoveProtectionGroupMember(gomock.Any(), "group-1", "lb-1").Return(nil)
+			},
+			lbARNsToDelete: []string{"lb-1"},
+		},
+		{
+			name: "failed to remove LoadBalancer",
+			setupExpectations: func(m *MockProtectionManager) {
+				m.EXPECT().ListManagedProtectionGroups(gomock.Any()).Return([]ProtectionGroupInfo{
+					{ID: "group-1", Members: []string{"lb-1"}},
+				}, nil)
+				m.EXPECT().RemoveProtectionGroupMember(gomock.Any(), "group-1", "lb-1").Return(fmt.Errorf("some error"))
+			},
+			lbARNsToDelete: []string{"lb-1"},
+			wantErr:        "failed to remove LoadBalancer from shield protection group: some error",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			protectionManager := NewMockProtectionManager(ctrl)
+			tt.setupExpectations(protectionManager)
+			findLoadBalancerARNsToDelete := func(ctx context.Context) (sets.Set[string], error) {
+				return sets.New[string](tt.lbARNsToDelete...), nil
+			}
+			s := NewProtectionGroupCleanupSynthesizer(protectionManager, findLoadBalancerARNsToDelete, logr.New(&log.NullLogSink{}))
+			err := s.Synthesize(context.Background())
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -6,14 +6,21 @@ import (
 	"sync"
 
 	awsmetrics "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/metrics/aws"
+	cloudwatchmodel "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/cloudwatch"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/ec2"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2"
+	s3model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/s3"
 
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/config"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/deploy/acm"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/deploy/cloudwatch"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/deploy/ec2"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/deploy/s3"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/deploy/shield"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/deploy/tracking"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/deploy/wafregional"
@@ -36,12 +43,13 @@ type StackDeployer interface {
 // NewDefaultStackDeployer constructs new defaultStackDeployer.
 func NewDefaultStackDeployer(cloud services.Cloud, k8sClient client.Client,
 	networkingManager networking.NetworkingManager, networkingSGManager networking.SecurityGroupManager, networkingSGReconciler networking.SecurityGroupReconciler,
-	elbv2TaggingManager elbv2.TaggingManager,
+	elbv2TaggingManager elbv2.TaggingManager, shieldProtectionManager shield.ProtectionManager,
 	config config.ControllerConfig, tagPrefix string, logger logr.Logger, metricsCollector lbcmetrics.MetricCollector, controllerName string, enhancedDefaultingPolicyEnabled bool,
 	targetGroupCollector awsmetrics.TargetGroupCollector, enableFrontendNLB bool,
 ) *defaultStackDeployer {
 	trackingProvider := tracking.NewDefaultProvider(tagPrefix, config.ClusterName)
 	ec2TaggingManager := ec2.NewDefaultTaggingManager(cloud.EC2(), networkingSGManager, cloud.VpcID(), logger)
+	cwTaggingManager := cloudwatch.NewDefaultTaggingManager(cloud.CloudWatch(), cloud.RGT(), logger)
 
 	return &defaultStackDeployer{
 		cloud:                               cloud,
@@ -52,17 +60,22 @@ func NewDefaultStackDeployer(cloud services.Cloud, k8sClient client.Client,
 		acmManager:                          acm.NewDefaultCertificateManager(cloud.ACM(), cloud.Route53(), config.IngressConfig.DefaultPCAArn, trackingProvider, logger),
 		ec2TaggingManager:                   ec2TaggingManager,
 		ec2SGManager:                        ec2.NewDefaultSecurityGroupManager(cloud.EC2(), networkingManager, trackingProvider, ec2TaggingManager, networkingSGReconciler, cloud.VpcID(), config.ExternalManagedTags, logger),
+		ec2ESManager:                        ec2.NewDefaultVPCEndpointServiceManager(cloud.EC2(), trackingProvider, ec2TaggingManager, config.ExternalManagedTags, logger),
 		acmTaggingManager:                   acm.NewDefaultTaggingManager(cloud.ACM(), config.FeatureGates, logger),
+		cwTaggingManager:                    cwTaggingManager,
+		cwAlarmManager:                      cloudwatch.NewDefaultMetricAlarmManager(cloud.CloudWatch(), trackingProvider, cwTaggingManager, config.ExternalManagedTags, logger),
 		elbv2TaggingManager:                 elbv2TaggingManager,
 		elbv2LBManager:                      elbv2.NewDefaultLoadBalancerManager(cloud.ELBV2(), trackingProvider, elbv2TaggingManager, config.ExternalManagedTags, config.FeatureGates, logger),
 		elbv2LSManager:                      elbv2.NewDefaultListenerManager(cloud.ELBV2(), trackingProvider, elbv2TaggingManager, config.ExternalManagedTags, config.FeatureGates, enhancedDefaultingPolicyEnabled, logger),
 		elbv2LRManager:                      elbv2.NewDefaultListenerRuleManager(cloud.ELBV2(), trackingProvider, elbv2TaggingManager, config.ExternalManagedTags, config.FeatureGates, logger),
 		elbv2TGManager:                      elbv2.NewDefaultTargetGroupManager(cloud.ELBV2(), trackingProvider, elbv2TaggingManager, cloud.VpcID(), config.ExternalManagedTags, logger),
+		elbv2TSManager:                      elbv2.NewDefaultTrustStoreManager(cloud.ELBV2(), cloud.S3(), config.TrustStoreS3Bucket, trackingProvider, elbv2TaggingManager, config.ExternalManagedTags, logger),
 		elbv2TGBManager:                     elbv2.NewDefaultTargetGroupBindingManager(k8sClient, trackingProvider, logger, targetGroupCollector),
 		elbv2FrontendNlbTargetsManager:      elbv2.NewFrontendNlbTargetsManager(cloud.ELBV2(), logger),
 		wafv2WebACLAssociationManager:       wafv2.NewDefaultWebACLAssociationManager(cloud.WAFv2(), logger),
 		wafRegionalWebACLAssociationManager: wafregional.NewDefaultWebACLAssociationManager(cloud.WAFRegional(), logger),
-		shieldProtectionManager:             shield.NewDefaultProtectionManager(cloud.Shield(), logger),
+		shieldProtectionManager:             shieldProtectionManager,
+		s3LogBucketManager:                  s3.NewDefaultLogBucketManager(cloud.S3(), cloud.STS(), cloud.Region(), logger),
 		featureGates:                        config.FeatureGates,
 		vpcID:                               cloud.VpcID(),
 		logger:                              logger,
@@ -84,17 +97,22 @@ type defaultStackDeployer struct {
 	acmManager                          acm.CertificateManager
 	ec2TaggingManager                   ec2.TaggingManager
 	ec2SGManager                        ec2.SecurityGroupManager
+	ec2ESManager                        ec2.VPCEndpointServiceManager
 	elbv2TaggingManager                 elbv2.TaggingManager
 	acmTaggingManager                   acm.TaggingManager
+	cwTaggingManager                    cloudwatch.TaggingManager
+	cwAlarmManager                      cloudwatch.MetricAlarmManager
 	elbv2LBManager                      elbv2.LoadBalancerManager
 	elbv2LSManager                      elbv2.ListenerManager
 	elbv2LRManager                      elbv2.ListenerRuleManager
 	elbv2TGManager                      elbv2.TargetGroupManager
+	elbv2TSManager                      elbv2.TrustStoreManager
 	elbv2TGBManager                     elbv2.TargetGroupBindingManager
 	elbv2FrontendNlbTargetsManager      elbv2.FrontendNlbTargetsManager
 	wafv2WebACLAssociationManager       wafv2.WebACLAssociationManager
 	wafRegionalWebACLAssociationManager wafregional.WebACLAssociationManager
 	shieldProtectionManager             shield.ProtectionManager
+	s3LogBucketManager                  s3.LogBucketManager
 	featureGates                        config.FeatureGates
 	vpcID                               string
 	metricsCollector                    lbcmetrics.MetricCollector
@@ -143,6 +161,58 @@ func (d *defaultStackDeployer) Deploy(ctx context.Context, stack core.Stack, met
 		synthesizers = append(synthesizers, acm.NewCertificateSynthesizer(d.acmManager, d.trackingProvider, d.acmTaggingManager, d.logger, stack))
 	}
 
+	// it's important that this synthesizer is called before the ListenerSynthesizer, due to the dependency
+	// trustStores can only be managed with a staging bucket, stacks without trustStores don't list them unless it's configured.
+	var resTSs []*elbv2model.TrustStore
+	stack.ListResources(&resTSs)
+	if len(d.controllerConfig.TrustStoreS3Bucket) != 0 || len(resTSs) != 0 {
+		synthesizers = append(synthesizers, elbv2.NewTrustStoreSynthesizer(d.trackingProvider, d.elbv2TaggingManager, d.elbv2TSManager, d.logger, stack))
+	}
+
+	// it's important that this synthesizer is called before the LoadBalancerSynthesizer,
+	// since LoadBalancer attributes enabling logs fail unless the log buckets grant log delivery.
+	var resLogBuckets []*s3model.LogBucket
+	stack.ListResources(&resLogBuckets)
+	if len(resLogBuckets) != 0 {
+		synthesizers = append(synthesizers, s3.NewLogBucketSynthesizer(d.s3LogBucketManager, d.logger, stack))
+	}
+
+	findSDKLoadBalancerARNsToDelete := func(ctx context.Context) (sets.Set[string], error) {
+		sdkLBs, err := elbv2.FindSDKLoadBalancersToDelete(ctx, d.trackingProvider, d.elbv2TaggingManager, stack)
+		if err != nil {
+			return nil, err
+		}
+		lbARNs := sets.New[string]()
+		for _, sdkLB := range sdkLBs {
+			lbARNs.Insert(awssdk.ToString(sdkLB.LoadBalancer.LoadBalancerArn))
+		}
+		return lbARNs, nil
+	}
+
+	// it's important that this synthesizer is called before the LoadBalancerSynthesizer,
+	// since LoadBalancers cannot be deleted while they're associated with vpcEndpointServices.
+	// vpcEndpointServices can only be managed with the feature enabled, which also requires the ec2:*VpcEndpointService* permissions.
+	var resESs []*ec2model.VPCEndpointService
+	stack.ListResources(&resESs)
+	if d.featureGates.Enabled(config.VPCEndpointServiceManagement) || len(resESs) != 0 {
+		synthesizers = append(synthesizers, ec2.NewVPCEndpointServiceCleanupSynthesizer(d.trackingProvider, d.ec2TaggingManager, d.ec2ESManager,
+			findSDKLoadBalancerARNsToDelete, d.logger, stack))
+	}
+
+	shieldSubscribed := false
+	if d.addonsConfig.ShieldEnabled {
+		var err error
+		shieldSubscribed, err = d.shieldProtectionManager.IsSubscribed(ctx)
+		if err != nil {
+			d.logger.Error(err, "unable to determine AWS Shield subscription state, skipping AWS shield reconciliation")
+		}
+	}
+	// it's important that this synthesizer is called before the LoadBalancerSynthesizer,
+	// since the LoadBalancers to delete are no longer referenced by the stack afterwards.
+	if shieldSubscribed {
+		synthesizers = append(synthesizers, shield.NewProtectionGroupCleanupSynthesizer(d.shieldProtectionManager, findSDKLoadBalancerARNsToDelete, d.logger))
+	}
+
 	synthesizers = append(synthesizers,
 		elbv2.NewTargetGroupSynthesizer(d.cloud.ELBV2(), d.trackingProvider, d.elbv2TaggingManager, d.elbv2TGManager, d.logger, d.featureGates, stack, findSDKTargetGroups),
 		elbv2.NewLoadBalancerSynthesizer(d.cloud.ELBV2(), d.trackingProvider, d.elbv2TaggingManager, d.elbv2LBManager, d.logger, d.featureGates, d.controllerConfig, stack),
@@ -150,19 +220,29 @@ func (d *defaultStackDeployer) Deploy(ctx context.Context, stack core.Stack, met
 		elbv2.NewListenerRuleSynthesizer(d.cloud.ELBV2(), d.elbv2TaggingManager, d.elbv2LRManager, d.logger, d.featureGates, stack),
 		elbv2.NewTargetGroupBindingSynthesizer(d.k8sClient, d.trackingProvider, d.elbv2TGBManager, d.logger, stack))
 
+	// it's important that this synthesizer is called after the LoadBalancerSynthesizer, since vpcEndpointServices reference the LoadBalancer ARN.
+	// vpcEndpointServices can only be managed with the feature enabled, stacks without them don't list them unless it's enabled.
+	if d.featureGates.Enabled(config.VPCEndpointServiceManagement) || len(resESs) != 0 {
+		synthesizers = append(synthesizers, ec2.NewVPCEndpointServiceSynthesizer(d.trackingProvider, d.ec2TaggingManager, d.ec2ESManager, d.logger, stack))
+	}
+
+	// it's important that this synthesizer is called after the TargetGroupSynthesizer and LoadBalancerSynthesizer,
+	// since metricAlarms are keyed to their ARNs.
+	// metricAlarms can only be managed with the feature enabled, stacks without them don't list them unless it's enabled.
+	var resAlarms []*cloudwatchmodel.MetricAlarm
+	stack.ListResources(&resAlarms)
+	if d.featureGates.Enabled(config.CloudWatchAlarmManagement) || len(resAlarms) != 0 {
+		synthesizers = append(synthesizers, cloudwatch.NewMetricAlarmSynthesizer(d.trackingProvider, d.cwTaggingManager, d.cwAlarmManager, d.logger, stack))
+	}
+
 	if d.addonsConfig.WAFV2Enabled {
 		synthesizers = append(synthesizers, wafv2.NewWebACLAssociationSynthesizer(d.wafv2WebACLAssociationManager, d.logger, stack))
 	}
 	if d.addonsConfig.WAFEnabled && d.cloud.WAFRegional().Available() {
 		synthesizers = append(synthesizers, wafregional.NewWebACLAssociationSynthesizer(d.wafRegionalWebACLAssociationManager, d.logger, stack))
 	}
-	if d.addonsConfig.ShieldEnabled {
-		shieldSubscribed, err := d.shieldProtectionManager.IsSubscribed(ctx)
-		if err != nil {
-			d.logger.Error(err, "unable to determine AWS Shield subscription state, skipping AWS shield reconciliation")
-		} else if shieldSubscribed {
-			synthesizers = append(synthesizers, shield.NewProtectionSynthesizer(d.shieldProtectionManager, d.logger, stack))
-		}
+	if shieldSubscribed {
+		synthesizers = append(synthesizers, shield.NewProtectionSynthesizer(d.shieldProtectionManager, d.logger, stack))
 	}
 
 	for _, synthesizer := range synthesizers {
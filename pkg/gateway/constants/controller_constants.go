@@ -29,6 +29,9 @@ const (
 
 	// ListenerRuleConfiguration the CRD name of ListenerRuleConfiguration
 	ListenerRuleConfiguration = "ListenerRuleConfiguration"
+
+	// TargetGroupConfiguration the CRD name of TargetGroupConfiguration
+	TargetGroupConfiguration = "TargetGroupConfiguration"
 )
 
 /*
@@ -95,3 +98,17 @@ const (
 	// AnnotationDryRunEnabledValue is the value that enables dry-run mode on a Gateway.
 	AnnotationDryRunEnabledValue = "true"
 )
+
+/*
+   Shared load balancer constants
+*/
+
+const (
+	// AnnotationSharedLoadBalancer is the annotation written by LBC that holds the name of the shared load balancer stack
+	// a Gateway has joined.
+	AnnotationSharedLoadBalancer = "gateway.k8s.aws/shared-load-balancer"
+
+	// AnnotationDedicatedLoadBalancerAddons is the annotation written by LBC while the load balancer previously provisioned
+	// for a Gateway that joined a shared load balancer is being deleted. It holds the addons still enabled on that load balancer.
+	AnnotationDedicatedLoadBalancerAddons = "gateway.k8s.aws/dedicated-load-balancer-addons"
+)
@@ -247,3 +247,42 @@ func GetNamespacedParamRefForGateway(gw *gwv1.Gateway) *gwv1.ParametersReference
 	}
 	return nil
 }
+
+// GetImpactedGatewaysFromFrontendCACertificate identifies the Gateways whose client certificate validation references the Secret or ConfigMap
+// in its CA certificate refs.
+func GetImpactedGatewaysFromFrontendCACertificate(ctx context.Context, k8sClient client.Client, kind string, objKey types.NamespacedName, gwController string) ([]*gwv1.Gateway, error) {
+	managedGateways, err := GetGatewaysManagedByLBController(ctx, k8sClient, gwController)
+	if err != nil {
+		return nil, err
+	}
+	impactedGateways := make([]*gwv1.Gateway, 0)
+	for _, gw := range managedGateways {
+		if gw.Spec.TLS == nil || gw.Spec.TLS.Frontend == nil {
+			continue
+		}
+		validations := []*gwv1.FrontendTLSValidation{gw.Spec.TLS.Frontend.Default.Validation}
+		for _, portCfg := range gw.Spec.TLS.Frontend.PerPort {
+			validations = append(validations, portCfg.TLS.Validation)
+		}
+		for _, validation := range validations {
+			if validation != nil && isFrontendTLSValidationReferencing(gw, validation, kind, objKey) {
+				impactedGateways = append(impactedGateways, gw)
+				break
+			}
+		}
+	}
+	return impactedGateways, nil
+}
+
+func isFrontendTLSValidationReferencing(gw *gwv1.Gateway, validation *gwv1.FrontendTLSValidation, kind string, objKey types.NamespacedName) bool {
+	for _, ref := range validation.CACertificateRefs {
+		refNamespace := gw.Namespace
+		if ref.Namespace != nil {
+			refNamespace = string(*ref.Namespace)
+		}
+		if ref.Group == "" && string(ref.Kind) == kind && string(ref.Name) == objKey.Name && refNamespace == objKey.Namespace {
+			return true
+		}
+	}
+	return false
+}
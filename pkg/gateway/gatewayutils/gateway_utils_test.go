@@ -1525,3 +1525,94 @@ func TestRemoveDuplicateParentRefs(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetImpactedGatewaysFromFrontendCACertificate(t *testing.T) {
+	gwClass := &gwv1.GatewayClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "alb-class"},
+		Spec:       gwv1.GatewayClassSpec{ControllerName: "gateway.k8s.aws/alb"},
+	}
+	gateways := []*gwv1.Gateway{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "gw-default-validation"},
+			Spec: gwv1.GatewaySpec{
+				GatewayClassName: "alb-class",
+				TLS: &gwv1.GatewayTLSConfig{
+					Frontend: &gwv1.FrontendTLSConfig{
+						Default: gwv1.TLSConfig{
+							Validation: &gwv1.FrontendTLSValidation{
+								CACertificateRefs: []gwv1.ObjectReference{{Kind: "ConfigMap", Name: "client-ca"}},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "other-ns", Name: "gw-per-port-validation"},
+			Spec: gwv1.GatewaySpec{
+				GatewayClassName: "alb-class",
+				TLS: &gwv1.GatewayTLSConfig{
+					Frontend: &gwv1.FrontendTLSConfig{
+						PerPort: []gwv1.TLSPortConfig{
+							{
+								Port: 443,
+								TLS: gwv1.TLSConfig{
+									Validation: &gwv1.FrontendTLSValidation{
+										CACertificateRefs: []gwv1.ObjectReference{{Kind: "Secret", Name: "client-ca", Namespace: ptr.To(gwv1.Namespace("ns"))}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "gw-without-validation"},
+			Spec:       gwv1.GatewaySpec{GatewayClassName: "alb-class"},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		kind   string
+		objKey types.NamespacedName
+		want   []string
+	}{
+		{
+			name:   "ConfigMap referenced by the default validation",
+			kind:   "ConfigMap",
+			objKey: types.NamespacedName{Namespace: "ns", Name: "client-ca"},
+			want:   []string{"ns/gw-default-validation"},
+		},
+		{
+			name:   "Secret referenced cross namespace by the per port validation",
+			kind:   "Secret",
+			objKey: types.NamespacedName{Namespace: "ns", Name: "client-ca"},
+			want:   []string{"other-ns/gw-per-port-validation"},
+		},
+		{
+			name:   "unreferenced object",
+			kind:   "ConfigMap",
+			objKey: types.NamespacedName{Namespace: "other-ns", Name: "client-ca"},
+			want:   []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sClient := testutils.GenerateTestClient()
+			ctx := context.Background()
+			assert.NoError(t, k8sClient.Create(ctx, gwClass.DeepCopy()))
+			for _, gw := range gateways {
+				assert.NoError(t, k8sClient.Create(ctx, gw.DeepCopy()))
+			}
+			got, err := GetImpactedGatewaysFromFrontendCACertificate(ctx, k8sClient, tt.kind, tt.objKey, "gateway.k8s.aws/alb")
+			assert.NoError(t, err)
+			gotKeys := make([]string, 0, len(got))
+			for _, gw := range got {
+				gotKeys = append(gotKeys, types.NamespacedName{Namespace: gw.Namespace, Name: gw.Name}.String())
+			}
+			assert.ElementsMatch(t, tt.want, gotKeys)
+		})
+	}
+}
@@ -64,3 +64,64 @@ func IsLBConfigInUseByGateway(ctx context.Context, lbConfig *elbv2gw.LoadBalance
 	}
 	return false, nil
 }
+
+// GetImpactedGatewaysFromTrustStoreCABundle identifies the Gateways whose LoadBalancerConfiguration, set on the Gateway or on its GatewayClass,
+// manages a trust store from the CA bundle held by the Secret or ConfigMap. CA bundles are looked up in the Gateway namespace.
+func GetImpactedGatewaysFromTrustStoreCABundle(ctx context.Context, k8sClient client.Client, kind elbv2gw.TrustStoreCABundleKind, objKey types.NamespacedName, gwController string) ([]*gwv1.Gateway, error) {
+	lbConfigList := &elbv2gw.LoadBalancerConfigurationList{}
+	if err := k8sClient.List(ctx, lbConfigList); err != nil {
+		return nil, err
+	}
+	impactedGateways := make(map[types.NamespacedName]*gwv1.Gateway)
+	for i := range lbConfigList.Items {
+		lbConfig := &lbConfigList.Items[i]
+		if !isLBConfigReferencingTrustStoreCABundle(lbConfig, kind, objKey.Name) {
+			continue
+		}
+		gateways, err := GetImpactedGatewaysFromLbConfig(ctx, k8sClient, lbConfig, gwController)
+		if err != nil {
+			return nil, err
+		}
+		gwClasses, err := GetImpactedGatewayClassesFromLbConfig(ctx, k8sClient, lbConfig, sets.New(gwController))
+		if err != nil {
+			return nil, err
+		}
+		for _, gwClass := range gwClasses {
+			gwClassGateways, err := GetGatewaysManagedByGatewayClass(ctx, k8sClient, gwClass)
+			if err != nil {
+				return nil, err
+			}
+			gateways = append(gateways, gwClassGateways...)
+		}
+		for _, gw := range gateways {
+			if gw.Namespace == objKey.Namespace {
+				impactedGateways[types.NamespacedName{Namespace: gw.Namespace, Name: gw.Name}] = gw
+			}
+		}
+	}
+	result := make([]*gwv1.Gateway, 0, len(impactedGateways))
+	for _, gw := range impactedGateways {
+		result = append(result, gw)
+	}
+	return result, nil
+}
+
+func isLBConfigReferencingTrustStoreCABundle(lbConfig *elbv2gw.LoadBalancerConfiguration, kind elbv2gw.TrustStoreCABundleKind, name string) bool {
+	if lbConfig.Spec.ListenerConfigurations == nil {
+		return false
+	}
+	for _, lsConfig := range *lbConfig.Spec.ListenerConfigurations {
+		if lsConfig.MutualAuthentication == nil || lsConfig.MutualAuthentication.TrustStoreCABundle == nil {
+			continue
+		}
+		caBundle := lsConfig.MutualAuthentication.TrustStoreCABundle
+		caBundleKind := elbv2gw.TrustStoreCABundleKindSecret
+		if caBundle.Kind != nil {
+			caBundleKind = *caBundle.Kind
+		}
+		if caBundleKind == kind && caBundle.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -5,6 +5,7 @@ import (
 	awssdk "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/v3/apis/gateway/v1"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/testutils"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -68,3 +69,123 @@ func Test_ResolveLoadBalancerConfig(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetImpactedGatewaysFromTrustStoreCABundle(t *testing.T) {
+	configMapKind := elbv2gw.TrustStoreCABundleKindConfigMap
+	gwLBConfig := &elbv2gw.LoadBalancerConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "gw-config"},
+		Spec: elbv2gw.LoadBalancerConfigurationSpec{
+			ListenerConfigurations: &[]elbv2gw.ListenerConfiguration{
+				{
+					ProtocolPort: "HTTPS:443",
+					MutualAuthentication: &elbv2gw.MutualAuthenticationAttributes{
+						Mode:               elbv2gw.MutualAuthenticationVerifyMode,
+						TrustStoreCABundle: &elbv2gw.TrustStoreCABundleSource{Kind: &configMapKind, Name: "client-ca"},
+					},
+				},
+			},
+		},
+	}
+	gwClassLBConfig := &elbv2gw.LoadBalancerConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "infra", Name: "class-config"},
+		Spec: elbv2gw.LoadBalancerConfigurationSpec{
+			ListenerConfigurations: &[]elbv2gw.ListenerConfiguration{
+				{
+					ProtocolPort: "HTTPS:443",
+					MutualAuthentication: &elbv2gw.MutualAuthenticationAttributes{
+						Mode:               elbv2gw.MutualAuthenticationVerifyMode,
+						TrustStoreCABundle: &elbv2gw.TrustStoreCABundleSource{Name: "client-ca"},
+					},
+				},
+			},
+		},
+	}
+	gwClasses := []*gwv1.GatewayClass{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "plain-class"},
+			Spec:       gwv1.GatewayClassSpec{ControllerName: "gateway.k8s.aws/alb"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "mtls-class"},
+			Spec: gwv1.GatewayClassSpec{
+				ControllerName: "gateway.k8s.aws/alb",
+				ParametersRef: &gwv1.ParametersReference{
+					Group:     "gateway.k8s.aws",
+					Kind:      "LoadBalancerConfiguration",
+					Name:      "class-config",
+					Namespace: (*gwv1.Namespace)(awssdk.String("infra")),
+				},
+			},
+		},
+	}
+	gateways := []*gwv1.Gateway{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "gw-with-config"},
+			Spec: gwv1.GatewaySpec{
+				GatewayClassName: "plain-class",
+				Infrastructure: &gwv1.GatewayInfrastructure{
+					ParametersRef: &gwv1.LocalParametersReference{Group: "gateway.k8s.aws", Kind: "LoadBalancerConfiguration", Name: "gw-config"},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "gw-without-config"},
+			Spec:       gwv1.GatewaySpec{GatewayClassName: "plain-class"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "gw-with-class-config"},
+			Spec:       gwv1.GatewaySpec{GatewayClassName: "mtls-class"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "other-ns", Name: "gw-with-class-config"},
+			Spec:       gwv1.GatewaySpec{GatewayClassName: "mtls-class"},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		kind   elbv2gw.TrustStoreCABundleKind
+		objKey types.NamespacedName
+		want   []string
+	}{
+		{
+			name:   "ConfigMap referenced by the Gateway LoadBalancerConfiguration",
+			kind:   elbv2gw.TrustStoreCABundleKindConfigMap,
+			objKey: types.NamespacedName{Namespace: "ns", Name: "client-ca"},
+			want:   []string{"ns/gw-with-config"},
+		},
+		{
+			name:   "Secret referenced by the GatewayClass LoadBalancerConfiguration",
+			kind:   elbv2gw.TrustStoreCABundleKindSecret,
+			objKey: types.NamespacedName{Namespace: "other-ns", Name: "client-ca"},
+			want:   []string{"other-ns/gw-with-class-config"},
+		},
+		{
+			name:   "unreferenced object",
+			kind:   elbv2gw.TrustStoreCABundleKindConfigMap,
+			objKey: types.NamespacedName{Namespace: "ns", Name: "other-ca"},
+			want:   []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sClient := testutils.GenerateTestClient()
+			ctx := context.Background()
+			assert.NoError(t, k8sClient.Create(ctx, gwLBConfig.DeepCopy()))
+			assert.NoError(t, k8sClient.Create(ctx, gwClassLBConfig.DeepCopy()))
+			for _, gwClass := range gwClasses {
+				assert.NoError(t, k8sClient.Create(ctx, gwClass.DeepCopy()))
+			}
+			for _, gw := range gateways {
+				assert.NoError(t, k8sClient.Create(ctx, gw.DeepCopy()))
+			}
+			got, err := GetImpactedGatewaysFromTrustStoreCABundle(ctx, k8sClient, tt.kind, tt.objKey, "gateway.k8s.aws/alb")
+			assert.NoError(t, err)
+			gotKeys := make([]string, 0, len(got))
+			for _, gw := range got {
+				gotKeys = append(gotKeys, types.NamespacedName{Namespace: gw.Namespace, Name: gw.Name}.String())
+			}
+			assert.ElementsMatch(t, tt.want, gotKeys)
+		})
+	}
+}
@@ -1,7 +1,10 @@
 package gateway
 
 import (
+	"fmt"
+	"reflect"
 	"sort"
+	"strings"
 
 	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/v3/apis/gateway/v1"
 )
@@ -89,6 +92,12 @@ func (merger *loadBalancerConfigMergerImpl) mergeListenerConfig(merged *elbv2gw.
 }
 
 func (merger *loadBalancerConfigMergerImpl) performTakeOneMerges(merged *elbv2gw.LoadBalancerConfigurationSpec, highPriority elbv2gw.LoadBalancerConfiguration, lowPriority elbv2gw.LoadBalancerConfiguration) {
+	if highPriority.Spec.ShareLoadBalancer != nil {
+		merged.ShareLoadBalancer = highPriority.Spec.ShareLoadBalancer
+	} else {
+		merged.ShareLoadBalancer = lowPriority.Spec.ShareLoadBalancer
+	}
+
 	if highPriority.Spec.LoadBalancerName != nil {
 		merged.LoadBalancerName = highPriority.Spec.LoadBalancerName
 	} else {
@@ -179,6 +188,24 @@ func (merger *loadBalancerConfigMergerImpl) performTakeOneMerges(merged *elbv2gw
 		merged.ShieldAdvanced = lowPriority.Spec.ShieldAdvanced
 	}
 
+	if highPriority.Spec.VPCEndpointService != nil {
+		merged.VPCEndpointService = highPriority.Spec.VPCEndpointService
+	} else {
+		merged.VPCEndpointService = lowPriority.Spec.VPCEndpointService
+	}
+
+	if highPriority.Spec.CloudWatchAlarms != nil {
+		merged.CloudWatchAlarms = highPriority.Spec.CloudWatchAlarms
+	} else {
+		merged.CloudWatchAlarms = lowPriority.Spec.CloudWatchAlarms
+	}
+
+	if highPriority.Spec.LoadBalancerLogs != nil {
+		merged.LoadBalancerLogs = highPriority.Spec.LoadBalancerLogs
+	} else {
+		merged.LoadBalancerLogs = lowPriority.Spec.LoadBalancerLogs
+	}
+
 	if highPriority.Spec.DisableSecurityGroup != nil {
 		merged.DisableSecurityGroup = highPriority.Spec.DisableSecurityGroup
 	} else {
@@ -191,3 +218,68 @@ func (merger *loadBalancerConfigMergerImpl) performTakeOneMerges(merged *elbv2gw
 		merged.DefaultTargetGroupConfiguration = lowPriority.Spec.DefaultTargetGroupConfiguration
 	}
 }
+
+// GetOverriddenFields returns the fields of the Gateway LoadBalancerConfiguration that aren't reflected in the merged configuration,
+// because the GatewayClass LoadBalancerConfiguration takes precedence. Tags, attributes and listener configurations are compared per key.
+func GetOverriddenFields(gwLbConfig elbv2gw.LoadBalancerConfiguration, mergedLbConfig elbv2gw.LoadBalancerConfiguration) []string {
+	var overridden []string
+
+	gwSpec := reflect.ValueOf(gwLbConfig.Spec)
+	mergedSpec := reflect.ValueOf(mergedLbConfig.Spec)
+	specType := gwSpec.Type()
+	for i := 0; i < specType.NumField(); i++ {
+		fieldName := strings.Split(specType.Field(i).Tag.Get("json"), ",")[0]
+		switch fieldName {
+		// mergingMode is only honored for the GatewayClass configuration, the keyed fields are compared below.
+		case "mergingMode", "tags", "loadBalancerAttributes", "listenerConfigurations":
+			continue
+		}
+		if gwSpec.Field(i).IsZero() {
+			continue
+		}
+		if !reflect.DeepEqual(gwSpec.Field(i).Interface(), mergedSpec.Field(i).Interface()) {
+			overridden = append(overridden, fieldName)
+		}
+	}
+
+	if gwLbConfig.Spec.Tags != nil {
+		mergedTags := map[string]string{}
+		if mergedLbConfig.Spec.Tags != nil {
+			mergedTags = *mergedLbConfig.Spec.Tags
+		}
+		var overriddenTags []string
+		for k, v := range *gwLbConfig.Spec.Tags {
+			if mergedValue, ok := mergedTags[k]; !ok || mergedValue != v {
+				overriddenTags = append(overriddenTags, fmt.Sprintf("tags[%s]", k))
+			}
+		}
+		sort.Strings(overriddenTags)
+		overridden = append(overridden, overriddenTags...)
+	}
+
+	mergedAttributes := make(map[string]string, len(mergedLbConfig.Spec.LoadBalancerAttributes))
+	for _, attr := range mergedLbConfig.Spec.LoadBalancerAttributes {
+		mergedAttributes[attr.Key] = attr.Value
+	}
+	for _, attr := range gwLbConfig.Spec.LoadBalancerAttributes {
+		if mergedValue, ok := mergedAttributes[attr.Key]; !ok || mergedValue != attr.Value {
+			overridden = append(overridden, fmt.Sprintf("loadBalancerAttributes[%s]", attr.Key))
+		}
+	}
+
+	if gwLbConfig.Spec.ListenerConfigurations != nil {
+		mergedListenerConfigs := make(map[elbv2gw.ProtocolPort]elbv2gw.ListenerConfiguration)
+		if mergedLbConfig.Spec.ListenerConfigurations != nil {
+			for _, cfg := range *mergedLbConfig.Spec.ListenerConfigurations {
+				mergedListenerConfigs[cfg.ProtocolPort] = cfg
+			}
+		}
+		for _, cfg := range *gwLbConfig.Spec.ListenerConfigurations {
+			if mergedCfg, ok := mergedListenerConfigs[cfg.ProtocolPort]; !ok || !reflect.DeepEqual(cfg, mergedCfg) {
+				overridden = append(overridden, fmt.Sprintf("listenerConfigurations[%s]", cfg.ProtocolPort))
+			}
+		}
+	}
+
+	return overridden
+}
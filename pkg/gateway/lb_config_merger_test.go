@@ -510,6 +510,27 @@ func Test_Merge(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "share load balancer from gw config",
+			gwClassLbConfig: elbv2gw.LoadBalancerConfiguration{
+				Spec: elbv2gw.LoadBalancerConfigurationSpec{
+					Scheme: &internalScheme,
+				},
+			},
+			gwLbConfig: elbv2gw.LoadBalancerConfiguration{
+				Spec: elbv2gw.LoadBalancerConfigurationSpec{
+					ShareLoadBalancer: awssdk.Bool(true),
+				},
+			},
+			expected: elbv2gw.LoadBalancerConfiguration{
+				Spec: elbv2gw.LoadBalancerConfigurationSpec{
+					ShareLoadBalancer:      awssdk.Bool(true),
+					Scheme:                 &internalScheme,
+					LoadBalancerAttributes: []elbv2gw.LoadBalancerAttribute{},
+					Tags:                   &map[string]string{},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -520,3 +541,97 @@ func Test_Merge(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetOverriddenFields(t *testing.T) {
+	preferGateway := elbv2gw.MergeModePreferGateway
+	internal := elbv2gw.LoadBalancerScheme("internal")
+	internetFacing := elbv2gw.LoadBalancerScheme("internet-facing")
+	testCases := []struct {
+		name            string
+		gwClassLbConfig elbv2gw.LoadBalancerConfiguration
+		gwLbConfig      elbv2gw.LoadBalancerConfiguration
+		expected        []string
+	}{
+		{
+			name: "no overlap",
+			gwClassLbConfig: elbv2gw.LoadBalancerConfiguration{
+				Spec: elbv2gw.LoadBalancerConfigurationSpec{
+					Scheme: &internal,
+				},
+			},
+			gwLbConfig: elbv2gw.LoadBalancerConfiguration{
+				Spec: elbv2gw.LoadBalancerConfigurationSpec{
+					LoadBalancerName: awssdk.String("gw-lb"),
+				},
+			},
+		},
+		{
+			name: "same values are not overridden",
+			gwClassLbConfig: elbv2gw.LoadBalancerConfiguration{
+				Spec: elbv2gw.LoadBalancerConfigurationSpec{
+					Scheme: &internal,
+					Tags:   &map[string]string{"team": "a"},
+				},
+			},
+			gwLbConfig: elbv2gw.LoadBalancerConfiguration{
+				Spec: elbv2gw.LoadBalancerConfigurationSpec{
+					Scheme: &internal,
+					Tags:   &map[string]string{"team": "a"},
+				},
+			},
+		},
+		{
+			name: "gateway class takes precedence",
+			gwClassLbConfig: elbv2gw.LoadBalancerConfiguration{
+				Spec: elbv2gw.LoadBalancerConfigurationSpec{
+					Scheme:         &internal,
+					SecurityGroups: &[]string{"sg-class"},
+					Tags:           &map[string]string{"team": "a", "env": "prod"},
+					LoadBalancerAttributes: []elbv2gw.LoadBalancerAttribute{
+						{Key: "idle_timeout.timeout_seconds", Value: "60"},
+					},
+					ListenerConfigurations: &[]elbv2gw.ListenerConfiguration{
+						{ProtocolPort: "HTTPS:443", DefaultCertificate: awssdk.String("arn:class")},
+					},
+				},
+			},
+			gwLbConfig: elbv2gw.LoadBalancerConfiguration{
+				Spec: elbv2gw.LoadBalancerConfigurationSpec{
+					Scheme:         &internetFacing,
+					SecurityGroups: &[]string{"sg-gw"},
+					Tags:           &map[string]string{"team": "b", "env": "prod", "app": "x"},
+					LoadBalancerAttributes: []elbv2gw.LoadBalancerAttribute{
+						{Key: "idle_timeout.timeout_seconds", Value: "120"},
+						{Key: "deletion_protection.enabled", Value: "true"},
+					},
+					ListenerConfigurations: &[]elbv2gw.ListenerConfiguration{
+						{ProtocolPort: "HTTPS:443", DefaultCertificate: awssdk.String("arn:gw")},
+						{ProtocolPort: "HTTP:80"},
+					},
+				},
+			},
+			expected: []string{"scheme", "securityGroups", "tags[team]", "loadBalancerAttributes[idle_timeout.timeout_seconds]", "listenerConfigurations[HTTPS:443]"},
+		},
+		{
+			name: "gateway takes precedence",
+			gwClassLbConfig: elbv2gw.LoadBalancerConfiguration{
+				Spec: elbv2gw.LoadBalancerConfigurationSpec{
+					MergingMode: &preferGateway,
+					Scheme:      &internal,
+				},
+			},
+			gwLbConfig: elbv2gw.LoadBalancerConfiguration{
+				Spec: elbv2gw.LoadBalancerConfigurationSpec{
+					Scheme: &internetFacing,
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			merged := NewLoadBalancerConfigMerger().Merge(tc.gwClassLbConfig, tc.gwLbConfig)
+			assert.Equal(t, tc.expected, GetOverriddenFields(tc.gwLbConfig, merged))
+		})
+	}
+}
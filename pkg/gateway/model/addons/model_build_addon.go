@@ -89,7 +89,7 @@ func (aob *addOnBuilderImpl) buildShield(lbCfg elbv2gw.LoadBalancerConfiguration
 		return false, makeNoOpPrestack()
 	}
 
-	return shieldEnabled, makeShieldPrestack(shieldEnabled)
+	return shieldEnabled, makeShieldPrestack(shieldEnabled, lbCfg.Spec.ShieldAdvanced)
 }
 
 func (aob *addOnBuilderImpl) buildProvisionedCapacity(lbSpec *elbv2model.LoadBalancerSpec, lbCfg elbv2gw.LoadBalancerConfiguration, previousAddonConfig []addon.Addon) bool {
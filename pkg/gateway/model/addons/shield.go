@@ -1,6 +1,7 @@
 package addons
 
 import (
+	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/v3/apis/gateway/v1"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
 	shieldmodel "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/shield"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/shared_constants"
@@ -8,19 +9,42 @@ import (
 
 type shield struct {
 	enabled bool
+	cfg     *elbv2gw.ShieldConfiguration
 }
 
 func (w *shield) AddToStack(stack core.Stack, lbARN core.StringToken) {
-	shieldmodel.NewProtection(stack, shared_constants.ResourceIDLoadBalancer, shieldmodel.ProtectionSpec{
+	spec := shieldmodel.ProtectionSpec{
 		Enabled:     w.enabled,
 		ResourceARN: lbARN,
-	})
+	}
+	// protection settings only apply to an enabled protection.
+	if w.enabled && w.cfg != nil {
+		spec.HealthCheckIDs = w.cfg.HealthCheckIDs
+		spec.ProtectionGroupIDs = w.cfg.ProtectionGroups
+		spec.ApplicationLayerAutomaticResponse = buildApplicationLayerAutomaticResponse(w.cfg.ApplicationLayerAutomaticResponse)
+	}
+	shieldmodel.NewProtection(stack, shared_constants.ResourceIDLoadBalancer, spec)
 }
 
 var _ PreStackAddon = &shield{}
 
-func makeShieldPrestack(enabled bool) PreStackAddon {
+func makeShieldPrestack(enabled bool, cfg *elbv2gw.ShieldConfiguration) PreStackAddon {
 	return &shield{
 		enabled: enabled,
+		cfg:     cfg,
+	}
+}
+
+func buildApplicationLayerAutomaticResponse(cfg *elbv2gw.ShieldApplicationLayerAutomaticResponse) *shieldmodel.ApplicationLayerAutomaticResponse {
+	if cfg == nil {
+		return nil
+	}
+	switch cfg.Action {
+	case elbv2gw.ShieldApplicationLayerAutomaticResponseActionBlock:
+		return &shieldmodel.ApplicationLayerAutomaticResponse{Enabled: true, Action: shieldmodel.ApplicationLayerAutomaticResponseActionBlock}
+	case elbv2gw.ShieldApplicationLayerAutomaticResponseActionCount:
+		return &shieldmodel.ApplicationLayerAutomaticResponse{Enabled: true, Action: shieldmodel.ApplicationLayerAutomaticResponseActionCount}
+	default:
+		return &shieldmodel.ApplicationLayerAutomaticResponse{Enabled: false}
 	}
 }
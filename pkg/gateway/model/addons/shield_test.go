@@ -0,0 +1,89 @@
+package addons
+
+import (
+	"github.com/stretchr/testify/assert"
+	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/v3/apis/gateway/v1"
+	coremodel "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
+	shieldmodel "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/shield"
+	"testing"
+)
+
+func Test_shield_AddToStack(t *testing.T) {
+	lbArn := coremodel.LiteralStringToken("test")
+	testCases := []struct {
+		name     string
+		enabled  bool
+		cfg      *elbv2gw.ShieldConfiguration
+		wantSpec shieldmodel.ProtectionSpec
+	}{
+		{
+			name:    "enabled without settings",
+			enabled: true,
+			cfg:     &elbv2gw.ShieldConfiguration{Enabled: true},
+			wantSpec: shieldmodel.ProtectionSpec{
+				Enabled:     true,
+				ResourceARN: lbArn,
+			},
+		},
+		{
+			name:    "enabled with settings",
+			enabled: true,
+			cfg: &elbv2gw.ShieldConfiguration{
+				Enabled:        true,
+				HealthCheckIDs: []string{"hc-1"},
+				ApplicationLayerAutomaticResponse: &elbv2gw.ShieldApplicationLayerAutomaticResponse{
+					Action: elbv2gw.ShieldApplicationLayerAutomaticResponseActionCount,
+				},
+				ProtectionGroups: []string{"group-1"},
+			},
+			wantSpec: shieldmodel.ProtectionSpec{
+				Enabled:        true,
+				ResourceARN:    lbArn,
+				HealthCheckIDs: []string{"hc-1"},
+				ApplicationLayerAutomaticResponse: &shieldmodel.ApplicationLayerAutomaticResponse{
+					Enabled: true,
+					Action:  shieldmodel.ApplicationLayerAutomaticResponseActionCount,
+				},
+				ProtectionGroupIDs: []string{"group-1"},
+			},
+		},
+		{
+			name:    "automatic response disabled",
+			enabled: true,
+			cfg: &elbv2gw.ShieldConfiguration{
+				Enabled: true,
+				ApplicationLayerAutomaticResponse: &elbv2gw.ShieldApplicationLayerAutomaticResponse{
+					Action: elbv2gw.ShieldApplicationLayerAutomaticResponseActionDisabled,
+				},
+			},
+			wantSpec: shieldmodel.ProtectionSpec{
+				Enabled:                           true,
+				ResourceARN:                       lbArn,
+				ApplicationLayerAutomaticResponse: &shieldmodel.ApplicationLayerAutomaticResponse{Enabled: false},
+			},
+		},
+		{
+			name:    "settings are ignored when disabled",
+			enabled: false,
+			cfg: &elbv2gw.ShieldConfiguration{
+				HealthCheckIDs:   []string{"hc-1"},
+				ProtectionGroups: []string{"group-1"},
+			},
+			wantSpec: shieldmodel.ProtectionSpec{
+				ResourceARN: lbArn,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			stack := coremodel.NewDefaultStack(coremodel.StackID{Namespace: "namespace", Name: "name"})
+			makeShieldPrestack(tc.enabled, tc.cfg).AddToStack(stack, lbArn)
+
+			var shieldResult []*shieldmodel.Protection
+			assert.NoError(t, stack.ListResources(&shieldResult))
+			assert.Equal(t, 1, len(shieldResult))
+			assert.Equal(t, tc.wantSpec, shieldResult[0].Spec)
+		})
+	}
+}
@@ -46,7 +46,7 @@ func NewModelBuilder(subnetsResolver networking.SubnetsResolver,
 	disableRestrictedSGRules bool, supportedAddons []addon.Addon, logger logr.Logger) Builder {
 
 	gwTagHelper := newTagHelper(sets.New(lbcConfig.ExternalManagedTags...), lbcConfig.DefaultTags, featureGates.Enabled(config.EnableDefaultTagsLowPriority))
-	subnetBuilder := newSubnetModelBuilder(loadBalancerType, trackingProvider, subnetsResolver, elbv2TaggingManager)
+	subnetBuilder := newSubnetModelBuilder(loadBalancerType, trackingProvider, subnetsResolver, elbv2TaggingManager, ec2Client)
 	sgBuilder := newSecurityGroupBuilder(gwTagHelper, clusterName, loadBalancerType, enableBackendSG, sgResolver, backendSGProvider, logger)
 	lbBuilder := newLoadBalancerBuilder(loadBalancerType, gwTagHelper, clusterName)
 	tgConfigConstructor := config2.NewTargetGroupConfigConstructor()
@@ -151,7 +151,12 @@ func (baseBuilder *baseModelBuilder) Build(ctx context.Context, gw *gwv1.Gateway
 
 	/* Subnets */
 
-	subnets, err := baseBuilder.subnetBuilder.buildLoadBalancerSubnets(ctx, lbConf.Spec.LoadBalancerSubnets, lbConf.Spec.LoadBalancerSubnetsSelector, scheme, ipAddressType, stack)
+	// the addresses don't need to be assigned when deleting the load balancer.
+	gwAddresses := gw.Spec.Addresses
+	if isDelete {
+		gwAddresses = nil
+	}
+	subnets, err := baseBuilder.subnetBuilder.buildLoadBalancerSubnets(ctx, lbConf.Spec.LoadBalancerSubnets, lbConf.Spec.LoadBalancerSubnetsSelector, gwAddresses, scheme, ipAddressType, stack)
 	if err != nil {
 		return nil, nil, nil, false, nil, err
 	}
@@ -167,6 +172,15 @@ func (baseBuilder *baseModelBuilder) Build(ctx context.Context, gw *gwv1.Gateway
 	if err != nil {
 		return nil, nil, nil, false, nil, err
 	}
+	if baseBuilder.loadBalancerType == elbv2model.LoadBalancerTypeApplication {
+		spec.LoadBalancerAttributes = mergeRouteLoadBalancerAttributes(spec.LoadBalancerAttributes, routeutils.BuildRequestHeaderLoadBalancerAttributes(routes))
+	}
+
+	if !isDelete {
+		if err := baseBuilder.buildLoadBalancerLogs(stack, &spec, lbConf); err != nil {
+			return nil, nil, nil, false, nil, err
+		}
+	}
 
 	addOnCfg := lbConf
 	if isDelete {
@@ -192,6 +206,14 @@ func (baseBuilder *baseModelBuilder) Build(ctx context.Context, gw *gwv1.Gateway
 		psa.AddToStack(stack, lb.LoadBalancerARN())
 	}
 
+	if err := baseBuilder.buildVPCEndpointService(stack, lb, lbConf); err != nil {
+		return nil, nil, nil, false, nil, err
+	}
+
+	if err := baseBuilder.buildMetricAlarms(stack, lb, lbConf, tgBuilder); err != nil {
+		return nil, nil, nil, false, nil, err
+	}
+
 	_ = elbv2model.NewFrontendNlbTargetGroupDesiredState(stack, tgBuilder.getLocalFrontendNlbData())
 
 	return stack, lb, newAddonConfig, securityGroups.backendSecurityGroupAllocated, secrets, nil
@@ -6,15 +6,21 @@ import (
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2"
 	elbv2modelk8s "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/shared_utils"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
 type mockTargetGroupBuilder struct {
 	tgs                  []*elbv2model.TargetGroup
 	localFrontendNlbData map[string]*elbv2model.FrontendNlbTargetGroupState
+	alarmTGs             []shared_utils.MetricAlarmTargetGroup
 	buildErr             error
 }
 
+func (m *mockTargetGroupBuilder) getMetricAlarmTargetGroups() ([]shared_utils.MetricAlarmTargetGroup, error) {
+	return m.alarmTGs, nil
+}
+
 func (m *mockTargetGroupBuilder) getLocalFrontendNlbData() map[string]*elbv2model.FrontendNlbTargetGroupState {
 	return m.localFrontendNlbData
 }
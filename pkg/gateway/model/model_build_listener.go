@@ -61,10 +61,11 @@ func (l listenerBuilderImpl) buildListeners(ctx context.Context, stack core.Stac
 	if len(gwLsPorts.Intersection(portsWithRoutes).List()) != 0 {
 		lbLsCfgs := mapLoadBalancerListenerConfigsByPort(lbCfg, gwLsCfgs)
 		for _, port := range gwLsPorts.Intersection(portsWithRoutes).List() {
-			ls, err := l.buildListener(ctx, stack, lb, gw, port, routes[port], lbCfg, gwLsCfgs[port], lbLsCfgs[port])
+			ls, lsSecretKeys, err := l.buildListener(ctx, stack, lb, gw, port, routes[port], lbCfg, gwLsCfgs[port], lbLsCfgs[port])
 			if err != nil {
 				return nil, err
 			}
+			secrets = append(secrets, lsSecretKeys...)
 
 			if ls == nil {
 				continue
@@ -84,25 +85,26 @@ func (l listenerBuilderImpl) buildListeners(ctx context.Context, stack core.Stac
 	return secrets, nil
 }
 
-func (l listenerBuilderImpl) buildListener(ctx context.Context, stack core.Stack, lb *elbv2model.LoadBalancer, gw *gwv1.Gateway, port int32, routes []routeutils.RouteDescriptor, lbCfg elbv2gw.LoadBalancerConfiguration, gwLsCfg gwListenerConfig, lbLsCfg *elbv2gw.ListenerConfiguration) (*elbv2model.Listener, error) {
+func (l listenerBuilderImpl) buildListener(ctx context.Context, stack core.Stack, lb *elbv2model.LoadBalancer, gw *gwv1.Gateway, port int32, routes []routeutils.RouteDescriptor, lbCfg elbv2gw.LoadBalancerConfiguration, gwLsCfg gwListenerConfig, lbLsCfg *elbv2gw.ListenerConfiguration) (*elbv2model.Listener, []types.NamespacedName, error) {
 	var listenerSpec *elbv2model.ListenerSpec
+	var secretKeys []types.NamespacedName
 
 	var err error
 	if l.loadBalancerType == elbv2model.LoadBalancerTypeApplication {
-		listenerSpec, err = l.buildL7ListenerSpec(ctx, lb, gw, lbCfg, port, gwLsCfg, lbLsCfg)
+		listenerSpec, secretKeys, err = l.buildL7ListenerSpec(ctx, stack, lb, gw, lbCfg, port, routes, gwLsCfg, lbLsCfg)
 	} else {
 		listenerSpec, err = l.buildL4ListenerSpec(ctx, stack, lb, gw, lbCfg, port, routes, gwLsCfg, lbLsCfg)
 	}
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if listenerSpec == nil {
-		return nil, nil
+		return nil, nil, nil
 	}
 
 	lsResID := fmt.Sprintf("%v", port)
-	return elbv2model.NewListener(stack, lsResID, *listenerSpec), nil
+	return elbv2model.NewListener(stack, lsResID, *listenerSpec), secretKeys, nil
 }
 
 func (l listenerBuilderImpl) buildListenerSpec(ctx context.Context, lb *elbv2model.LoadBalancer, gw *gwv1.Gateway, port int32, lbCfg elbv2gw.LoadBalancerConfiguration, gwLsCfg gwListenerConfig, lbLsCfg *elbv2gw.ListenerConfiguration) (*elbv2model.ListenerSpec, error) {
@@ -148,18 +150,37 @@ func (l listenerBuilderImpl) buildListenerSpec(ctx context.Context, lb *elbv2mod
 	return listenerSpec, nil
 }
 
-func (l listenerBuilderImpl) buildL7ListenerSpec(ctx context.Context, lb *elbv2model.LoadBalancer, gw *gwv1.Gateway, lbCfg elbv2gw.LoadBalancerConfiguration, port int32, gwLsCfg gwListenerConfig, lbLsCfg *elbv2gw.ListenerConfiguration) (*elbv2model.ListenerSpec, error) {
+func (l listenerBuilderImpl) buildL7ListenerSpec(ctx context.Context, stack core.Stack, lb *elbv2model.LoadBalancer, gw *gwv1.Gateway, lbCfg elbv2gw.LoadBalancerConfiguration, port int32, routes []routeutils.RouteDescriptor, gwLsCfg gwListenerConfig, lbLsCfg *elbv2gw.ListenerConfiguration) (*elbv2model.ListenerSpec, []types.NamespacedName, error) {
 	listenerSpec, err := l.buildListenerSpec(ctx, lb, gw, port, lbCfg, gwLsCfg, lbLsCfg)
 	if err != nil {
-		return &elbv2model.ListenerSpec{}, err
+		return &elbv2model.ListenerSpec{}, nil, err
+	}
+	defaultActions, err := l.buildL7ListenerConfiguredDefaultActions(ctx, stack, gw, port, listenerSpec.Protocol, lb.Spec.IPAddressType, lbLsCfg)
+	if err != nil {
+		return &elbv2model.ListenerSpec{}, nil, err
 	}
-	listenerSpec.DefaultActions = buildL7ListenerDefaultActions()
+	listenerSpec.DefaultActions = defaultActions
+	rulesWithPrecedence := routeutils.SortAllRulesByPrecedence(routes, port)
+	corsFilter := routeutils.GetListenerCORSFilter(rulesWithPrecedence)
+	listenerSpec.ListenerAttributes = mergeRouteListenerAttributes(listenerSpec.ListenerAttributes, routeutils.BuildCORSListenerAttributes(corsFilter))
+	listenerSpec.ListenerAttributes = mergeRouteListenerAttributes(listenerSpec.ListenerAttributes, routeutils.GetListenerResponseHeaderAttributes(rulesWithPrecedence))
 	mutualAuth, err := l.buildMutualAuthenticationAttributes(ctx, gwLsCfg, lbLsCfg)
 	if err != nil {
-		return &elbv2model.ListenerSpec{}, err
+		return &elbv2model.ListenerSpec{}, nil, err
+	}
+	secretKeys, err := l.buildManagedTrustStore(ctx, stack, gw, lbCfg, lbLsCfg, mutualAuth)
+	if err != nil {
+		return &elbv2model.ListenerSpec{}, nil, err
+	}
+	// mutualAuthentication of the LoadBalancerConfiguration takes precedence over the client certificate validation of the Gateway
+	if mutualAuth == nil {
+		mutualAuth, secretKeys, err = l.buildFrontendTLSMutualAuthentication(ctx, stack, gw, lbCfg, port, gwLsCfg)
+		if err != nil {
+			return &elbv2model.ListenerSpec{}, nil, err
+		}
 	}
 	listenerSpec.MutualAuthentication = mutualAuth
-	return listenerSpec, nil
+	return listenerSpec, secretKeys, nil
 }
 
 func (l listenerBuilderImpl) buildL4ListenerSpec(ctx context.Context, stack core.Stack, lb *elbv2model.LoadBalancer, gw *gwv1.Gateway, lbCfg elbv2gw.LoadBalancerConfiguration, port int32, routes []routeutils.RouteDescriptor, gwLsCfg gwListenerConfig, lbLsCfg *elbv2gw.ListenerConfiguration) (*elbv2model.ListenerSpec, error) {
@@ -167,6 +188,9 @@ func (l listenerBuilderImpl) buildL4ListenerSpec(ctx context.Context, stack core
 	if err != nil {
 		return &elbv2model.ListenerSpec{}, err
 	}
+	if lbLsCfg != nil && lbLsCfg.DefaultAction != nil {
+		return &elbv2model.ListenerSpec{}, errors.Errorf("defaultAction of listener %v is only supported for Application LoadBalancers", lbLsCfg.ProtocolPort)
+	}
 	alpnPolicy, err := buildListenerALPNPolicy(listenerSpec.Protocol, lbLsCfg)
 	if err != nil {
 		return &elbv2model.ListenerSpec{}, err
@@ -231,6 +255,7 @@ func (l listenerBuilderImpl) buildL4TargetGroupTuples(stack core.Stack, routes [
 func (l listenerBuilderImpl) buildListenerRules(ctx context.Context, stack core.Stack, ls *elbv2model.Listener, ipAddressType elbv2model.IPAddressType, gw *gwv1.Gateway, port int32, routes map[int32][]routeutils.RouteDescriptor) ([]types.NamespacedName, error) {
 	// sort all rules based on precedence
 	rulesWithPrecedenceOrder := routeutils.SortAllRulesByPrecedence(routes[port], port)
+	listenerCORSFilter := routeutils.GetListenerCORSFilter(rulesWithPrecedenceOrder)
 	secrets := make([]types.NamespacedName, 0)
 	var albRules []elbv2model.Rule
 	for _, ruleWithPrecedence := range rulesWithPrecedenceOrder {
@@ -312,6 +337,15 @@ func (l listenerBuilderImpl) buildListenerRules(ctx context.Context, stack core.
 			return nil, tagsErr
 		}
 
+		// Answer CORS preflight requests before they reach the actions of the rule, unless the listener doesn't insert the CORS headers.
+		if corsFilter := routeutils.GetHttpRuleCORSFilter(rule); corsFilter != nil && listenerCORSFilter != nil {
+			albRules = append(albRules, elbv2model.Rule{
+				Conditions: routeutils.BuildCORSPreflightRuleConditions(corsFilter, conditionsList),
+				Actions:    []elbv2model.Action{routeutils.BuildCORSPreflightAction()},
+				Tags:       tags,
+			})
+		}
+
 		albRules = append(albRules, elbv2model.Rule{
 			Conditions: conditionsList,
 			Actions:    actions,
@@ -342,6 +376,21 @@ func (l listenerBuilderImpl) buildListenerTags(lbCfg elbv2gw.LoadBalancerConfigu
 	return l.tagHelper.getLoadBalancerTags(lbCfg)
 }
 
+// mergeRouteListenerAttributes adds the response headers of the HTTPRoute CORS and ResponseHeaderModifier filters,
+// attributes explicitly configured in the LoadBalancerConfiguration take precedence.
+func mergeRouteListenerAttributes(attributes []elbv2model.ListenerAttribute, routeAttributes []elbv2model.ListenerAttribute) []elbv2model.ListenerAttribute {
+	configuredKeys := sets.New[string]()
+	for _, attr := range attributes {
+		configuredKeys.Insert(attr.Key)
+	}
+	for _, attr := range routeAttributes {
+		if !configuredKeys.Has(attr.Key) {
+			attributes = append(attributes, attr)
+		}
+	}
+	return attributes
+}
+
 func buildListenerAttributes(lsCfg *elbv2gw.ListenerConfiguration) ([]elbv2model.ListenerAttribute, error) {
 	if lsCfg == nil || lsCfg.ListenerAttributes == nil || len(lsCfg.ListenerAttributes) == 0 {
 		return []elbv2model.ListenerAttribute{}, nil
@@ -411,7 +460,94 @@ func (l listenerBuilderImpl) buildInferredTLSCertARNs(ctx context.Context, hostn
 	return l.certDiscovery.Discover(ctx, hosts.List(), nil)
 }
 
-// L7 listeners will always have 404 as default actions since we don't have dedicated backend
+// buildL7ListenerConfiguredDefaultActions builds the default actions from the defaultAction of the listener configuration,
+// L7 listeners without a configured default action have 404 as default actions.
+func (l listenerBuilderImpl) buildL7ListenerConfiguredDefaultActions(ctx context.Context, stack core.Stack, gw *gwv1.Gateway, port int32, protocol elbv2model.Protocol, ipAddressType elbv2model.IPAddressType, lbLsCfg *elbv2gw.ListenerConfiguration) ([]elbv2model.Action, error) {
+	if lbLsCfg == nil || lbLsCfg.DefaultAction == nil {
+		return buildL7ListenerDefaultActions(), nil
+	}
+	defaultAction := lbLsCfg.DefaultAction
+	switch defaultAction.Type {
+	case elbv2gw.ListenerDefaultActionTypeForward:
+		if defaultAction.ForwardConfig == nil {
+			return nil, errors.Errorf("missing forwardConfig in default action of listener %v", lbLsCfg.ProtocolPort)
+		}
+		route, backend, err := routeutils.LoadListenerDefaultBackend(ctx, l.k8sClient, gw, *defaultAction.ForwardConfig)
+		if err != nil {
+			return nil, err
+		}
+		arn, err := l.tgBuilder.buildTargetGroup(stack, gw, port, protocol, ipAddressType, route, *backend)
+		if err != nil {
+			return nil, err
+		}
+		return []elbv2model.Action{
+			{
+				Type: elbv2model.ActionTypeForward,
+				ForwardConfig: &elbv2model.ForwardActionConfig{
+					TargetGroups: []elbv2model.TargetGroupTuple{
+						{
+							TargetGroupARN: arn,
+						},
+					},
+				},
+			},
+		}, nil
+	case elbv2gw.ListenerDefaultActionTypeFixedResponse:
+		if defaultAction.FixedResponseConfig == nil {
+			return nil, errors.Errorf("missing fixedResponseConfig in default action of listener %v", lbLsCfg.ProtocolPort)
+		}
+		return []elbv2model.Action{buildL7ListenerFixedResponseDefaultAction(*defaultAction.FixedResponseConfig)}, nil
+	case elbv2gw.ListenerDefaultActionTypeRedirect:
+		if defaultAction.RedirectConfig == nil {
+			return nil, errors.Errorf("missing redirectConfig in default action of listener %v", lbLsCfg.ProtocolPort)
+		}
+		return []elbv2model.Action{buildL7ListenerRedirectDefaultAction(*defaultAction.RedirectConfig)}, nil
+	}
+	return nil, errors.Errorf("unsupported default action type %v of listener %v", defaultAction.Type, lbLsCfg.ProtocolPort)
+}
+
+func buildL7ListenerFixedResponseDefaultAction(cfg elbv2gw.FixedResponseActionConfig) elbv2model.Action {
+	contentType := cfg.ContentType
+	if contentType == nil {
+		contentType = awssdk.String("text/plain")
+	}
+	return elbv2model.Action{
+		Type: elbv2model.ActionTypeFixedResponse,
+		FixedResponseConfig: &elbv2model.FixedResponseActionConfig{
+			ContentType: contentType,
+			MessageBody: cfg.MessageBody,
+			StatusCode:  strconv.Itoa(int(cfg.StatusCode)),
+		},
+	}
+}
+
+func buildL7ListenerRedirectDefaultAction(cfg elbv2gw.ListenerDefaultRedirectConfig) elbv2model.Action {
+	statusCode := int32(302)
+	if cfg.StatusCode != nil {
+		statusCode = *cfg.StatusCode
+	}
+	var protocol *string
+	if cfg.Scheme != nil {
+		protocol = awssdk.String(strings.ToUpper(*cfg.Scheme))
+	}
+	var port *string
+	if cfg.Port != nil {
+		port = awssdk.String(strconv.Itoa(int(*cfg.Port)))
+	}
+	return elbv2model.Action{
+		Type: elbv2model.ActionTypeRedirect,
+		RedirectConfig: &elbv2model.RedirectActionConfig{
+			Host:       cfg.Hostname,
+			Path:       cfg.Path,
+			Port:       port,
+			Protocol:   protocol,
+			Query:      cfg.Query,
+			StatusCode: fmt.Sprintf("HTTP_%d", statusCode),
+		},
+	}
+}
+
+// L7 listeners have 404 as default actions unless a default action is configured, since we don't have dedicated backend
 func buildL7ListenerDefaultActions() []elbv2model.Action {
 	action404 := elbv2model.Action{
 		Type: elbv2model.ActionTypeFixedResponse,
@@ -462,8 +598,9 @@ func (l listenerBuilderImpl) buildMutualAuthenticationAttributes(ctx context.Con
 	mode := string(lbLsCfg.MutualAuthentication.Mode)
 
 	// Process trustStore information for verify mode
+	// trustStore managed from a CA bundle is built by buildManagedTrustStore
 	var trustStoreArn *string
-	if mode == string(elbv2model.MutualAuthenticationVerifyMode) {
+	if mode == string(elbv2model.MutualAuthenticationVerifyMode) && lbLsCfg.MutualAuthentication.TrustStoreCABundle == nil {
 		trustStoreName := awssdk.ToString(lbLsCfg.MutualAuthentication.TrustStore)
 		if !strings.HasPrefix(trustStoreName, "arn:") {
 			truststoreARNs, err := shared_utils.GetTrustStoreArnFromName(ctx, l.elbv2Client, []string{trustStoreName})
@@ -498,6 +635,71 @@ func (l listenerBuilderImpl) buildMutualAuthenticationAttributes(ctx context.Con
 	}, nil
 }
 
+// buildManagedTrustStore builds the trustStore managed from the CA bundle in the Gateway namespace into the stack,
+// and references it from the mutualAuthentication attributes.
+func (l listenerBuilderImpl) buildManagedTrustStore(ctx context.Context, stack core.Stack, gw *gwv1.Gateway, lbCfg elbv2gw.LoadBalancerConfiguration, lbLsCfg *elbv2gw.ListenerConfiguration, mutualAuth *elbv2model.MutualAuthenticationAttributes) ([]types.NamespacedName, error) {
+	if mutualAuth == nil || mutualAuth.Mode != string(elbv2model.MutualAuthenticationVerifyMode) || lbLsCfg.MutualAuthentication.TrustStoreCABundle == nil {
+		return nil, nil
+	}
+	caBundle := lbLsCfg.MutualAuthentication.TrustStoreCABundle
+	tags, err := l.tagHelper.getLoadBalancerTags(lbCfg)
+	if err != nil {
+		return nil, err
+	}
+	ref := shared_utils.TrustStoreCABundleRef{
+		Namespace:          gw.Namespace,
+		Name:               caBundle.Name,
+		Key:                awssdk.ToString(caBundle.Key),
+		RevocationListKeys: caBundle.RevocationListKeys,
+	}
+	if caBundle.Kind != nil {
+		ref.Kind = string(*caBundle.Kind)
+	}
+	ts, secretKey, err := shared_utils.BuildManagedTrustStore(ctx, stack, l.k8sClient, l.secretsManager, l.clusterName, tags, ref)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build trustStore for CA bundle %s", caBundle.Name)
+	}
+	mutualAuth.TrustStore = ts.TrustStoreARN()
+	if secretKey == nil {
+		return nil, nil
+	}
+	return []types.NamespacedName{*secretKey}, nil
+}
+
+// buildFrontendTLSMutualAuthentication translates the client certificate validation of the Gateway for the port into verify mode,
+// with a trustStore managed from the valid CA certificate refs.
+func (l listenerBuilderImpl) buildFrontendTLSMutualAuthentication(ctx context.Context, stack core.Stack, gw *gwv1.Gateway, lbCfg elbv2gw.LoadBalancerConfiguration, port int32, gwLsCfg gwListenerConfig) (*elbv2model.MutualAuthenticationAttributes, []types.NamespacedName, error) {
+	if gwLsCfg.protocol != elbv2model.ProtocolHTTPS {
+		return nil, nil, nil
+	}
+	validation := routeutils.GetFrontendTLSValidation(*gw, gwv1.PortNumber(port))
+	// listeners with an unsupported validation mode aren't accepted
+	if validation == nil || (validation.Mode != "" && validation.Mode != gwv1.AllowValidOnly) {
+		return nil, nil, nil
+	}
+	caCerts, err := routeutils.ResolveFrontendCACertificateRefs(ctx, l.k8sClient, *gw, validation)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(caCerts.CABundles) == 0 {
+		return nil, nil, nil
+	}
+	tags, err := l.tagHelper.getLoadBalancerTags(lbCfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	ts, secretKeys, err := shared_utils.BuildManagedTrustStoreFromCABundles(ctx, stack, l.k8sClient, l.secretsManager, l.clusterName, tags, caCerts.CABundles)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to build trustStore for the client certificate validation of port %d", port)
+	}
+	return &elbv2model.MutualAuthenticationAttributes{
+		Mode:                          string(elbv2model.MutualAuthenticationVerifyMode),
+		TrustStore:                    ts.TrustStoreARN(),
+		IgnoreClientCertificateExpiry: awssdk.Bool(false),
+		AdvertiseTrustStoreCaNames:    awssdk.String(""),
+	}, secretKeys, nil
+}
+
 func (l listenerBuilderImpl) buildSSLPolicy(gwLsCfg gwListenerConfig, lbLsCfg *elbv2gw.ListenerConfiguration) (*string, error) {
 	if !isSecureProtocol(gwLsCfg.protocol) {
 		return nil, nil
@@ -15,11 +15,14 @@ import (
 	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway/routeutils"
 	coremodel "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/testutils"
 
 	"github.com/golang/mock/gomock"
 	"github.com/pkg/errors"
@@ -1242,6 +1245,28 @@ func Test_buildMutualAuthenticationAttributes(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:     "verify mode with trustStoreCABundle should not resolve ARN",
+			protocol: elbv2model.ProtocolHTTPS,
+			gwLsCfg: gwListenerConfig{
+				protocol:  elbv2model.ProtocolHTTPS,
+				hostnames: sets.New[string]("example.com"),
+			},
+			lbLsCfg: &elbv2gw.ListenerConfiguration{
+				MutualAuthentication: &elbv2gw.MutualAuthenticationAttributes{
+					Mode: verifyMode,
+					TrustStoreCABundle: &elbv2gw.TrustStoreCABundleSource{
+						Name: "client-ca",
+					},
+				},
+			},
+			want: &elbv2model.MutualAuthenticationAttributes{
+				Mode:                          string(elbv2gw.MutualAuthenticationVerifyMode),
+				IgnoreClientCertificateExpiry: awssdk.Bool(false),
+				AdvertiseTrustStoreCaNames:    awssdk.String(""),
+			},
+			wantErr: false,
+		},
 		{
 			name:     "verify mode with truststore ARN should use ARN directly",
 			protocol: elbv2model.ProtocolHTTPS,
@@ -1422,6 +1447,124 @@ func Test_buildMutualAuthenticationAttributes(t *testing.T) {
 	}
 }
 
+func Test_buildFrontendTLSMutualAuthentication(t *testing.T) {
+	caBundle := "-----BEGIN CERTIFICATE-----\nMIIBszCCAVmgAwIBAgIUEXAMPLE=\n-----END CERTIFICATE-----\n"
+	clientCARef := gwv1.ObjectReference{Kind: "ConfigMap", Name: "client-ca"}
+	missingCARef := gwv1.ObjectReference{Kind: "ConfigMap", Name: "missing-ca"}
+	newGateway := func(frontend *gwv1.FrontendTLSConfig) *gwv1.Gateway {
+		gw := &gwv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "gw"},
+		}
+		if frontend != nil {
+			gw.Spec.TLS = &gwv1.GatewayTLSConfig{Frontend: frontend}
+		}
+		return gw
+	}
+
+	tests := []struct {
+		name              string
+		gw                *gwv1.Gateway
+		protocol          elbv2model.Protocol
+		wantMutualAuth    bool
+		wantTrustStoreIDs []string
+	}{
+		{
+			name:     "no client certificate validation",
+			gw:       newGateway(nil),
+			protocol: elbv2model.ProtocolHTTPS,
+		},
+		{
+			name: "non HTTPS listener",
+			gw: newGateway(&gwv1.FrontendTLSConfig{
+				Default: gwv1.TLSConfig{Validation: &gwv1.FrontendTLSValidation{CACertificateRefs: []gwv1.ObjectReference{clientCARef}}},
+			}),
+			protocol: elbv2model.ProtocolHTTP,
+		},
+		{
+			name: "default client certificate validation",
+			gw: newGateway(&gwv1.FrontendTLSConfig{
+				Default: gwv1.TLSConfig{Validation: &gwv1.FrontendTLSValidation{CACertificateRefs: []gwv1.ObjectReference{clientCARef}}},
+			}),
+			protocol:          elbv2model.ProtocolHTTPS,
+			wantMutualAuth:    true,
+			wantTrustStoreIDs: []string{"ConfigMap/ns/client-ca/ca.crt"},
+		},
+		{
+			name: "per port client certificate validation overrides default",
+			gw: newGateway(&gwv1.FrontendTLSConfig{
+				Default: gwv1.TLSConfig{Validation: &gwv1.FrontendTLSValidation{CACertificateRefs: []gwv1.ObjectReference{clientCARef}}},
+				PerPort: []gwv1.TLSPortConfig{
+					{Port: 443, TLS: gwv1.TLSConfig{}},
+				},
+			}),
+			protocol: elbv2model.ProtocolHTTPS,
+		},
+		{
+			name: "invalid CA certificate refs are skipped",
+			gw: newGateway(&gwv1.FrontendTLSConfig{
+				Default: gwv1.TLSConfig{Validation: &gwv1.FrontendTLSValidation{CACertificateRefs: []gwv1.ObjectReference{missingCARef, clientCARef}}},
+			}),
+			protocol:          elbv2model.ProtocolHTTPS,
+			wantMutualAuth:    true,
+			wantTrustStoreIDs: []string{"ConfigMap/ns/client-ca/ca.crt"},
+		},
+		{
+			name: "no valid CA certificate ref",
+			gw: newGateway(&gwv1.FrontendTLSConfig{
+				Default: gwv1.TLSConfig{Validation: &gwv1.FrontendTLSValidation{CACertificateRefs: []gwv1.ObjectReference{missingCARef}}},
+			}),
+			protocol: elbv2model.ProtocolHTTPS,
+		},
+		{
+			name: "insecure fallback mode isn't supported",
+			gw: newGateway(&gwv1.FrontendTLSConfig{
+				Default: gwv1.TLSConfig{Validation: &gwv1.FrontendTLSValidation{
+					CACertificateRefs: []gwv1.ObjectReference{clientCARef},
+					Mode:              gwv1.AllowInsecureFallback,
+				}},
+			}),
+			protocol: elbv2model.ProtocolHTTPS,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sClient := testutils.GenerateTestClient()
+			assert.NoError(t, k8sClient.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "client-ca"},
+				Data:       map[string]string{"ca.crt": caBundle},
+			}))
+			stack := coremodel.NewDefaultStack(coremodel.StackID{Namespace: "ns", Name: "gw"})
+			builder := &listenerBuilderImpl{
+				k8sClient:   k8sClient,
+				tagHelper:   newTagHelper(sets.New[string](), nil, false),
+				clusterName: "cluster",
+			}
+
+			got, secretKeys, err := builder.buildFrontendTLSMutualAuthentication(ctx, stack, tt.gw, elbv2gw.LoadBalancerConfiguration{}, 443, gwListenerConfig{protocol: tt.protocol})
+			assert.NoError(t, err)
+			assert.Empty(t, secretKeys)
+
+			var trustStores []*elbv2model.TrustStore
+			assert.NoError(t, stack.ListResources(&trustStores))
+			var trustStoreIDs []string
+			for _, ts := range trustStores {
+				trustStoreIDs = append(trustStoreIDs, ts.ID())
+			}
+			assert.Equal(t, tt.wantTrustStoreIDs, trustStoreIDs)
+
+			if !tt.wantMutualAuth {
+				assert.Nil(t, got)
+				return
+			}
+			assert.Equal(t, string(elbv2model.MutualAuthenticationVerifyMode), got.Mode)
+			assert.Equal(t, []coremodel.Resource{trustStores[0]}, got.TrustStore.Dependencies())
+			assert.Equal(t, awssdk.Bool(false), got.IgnoreClientCertificateExpiry)
+		})
+	}
+}
+
 func Test_BuildListenerRules(t *testing.T) {
 	autheticateBehavior := elbv2gw.AuthenticateCognitoActionConditionalBehaviorEnumAuthenticate
 	testCases := []struct {
@@ -1547,6 +1690,114 @@ func Test_BuildListenerRules(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:             "cors filter should result in preflight rule before the rule",
+			port:             80,
+			listenerProtocol: elbv2model.ProtocolHTTP,
+			ipAddressType:    elbv2model.IPAddressTypeIPV4,
+			routes: map[int32][]routeutils.RouteDescriptor{
+				80: {
+					&routeutils.MockRoute{
+						Kind:      routeutils.HTTPRouteKind,
+						Name:      "my-route",
+						Namespace: "my-route-ns",
+						Rules: []routeutils.RouteRule{
+							&routeutils.MockRule{
+								RawRule: &gwv1.HTTPRouteRule{
+									Filters: []gwv1.HTTPRouteFilter{
+										{
+											Type: gwv1.HTTPRouteFilterCORS,
+											CORS: &gwv1.HTTPCORSFilter{
+												AllowOrigins: []gwv1.CORSOrigin{"https://example.com"},
+											},
+										},
+									},
+									Matches: []gwv1.HTTPRouteMatch{
+										{
+											Path: &gwv1.HTTPPathMatch{
+												Type:  (*gwv1.PathMatchType)(awssdk.String("PathPrefix")),
+												Value: awssdk.String("/"),
+											},
+											Method: (*gwv1.HTTPMethod)(awssdk.String("GET")),
+										},
+									},
+								},
+								BackendRefs: []routeutils.Backend{
+									{
+										ServiceBackend: &routeutils.ServiceBackendConfig{},
+										Weight:         1,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedRules: []*elbv2model.ListenerRuleSpec{
+				{
+					Priority: 1,
+					Actions: []elbv2model.Action{
+						{
+							Type: "fixed-response",
+							FixedResponseConfig: &elbv2model.FixedResponseActionConfig{
+								ContentType: awssdk.String("text/plain"),
+								StatusCode:  "204",
+							},
+						},
+					},
+					Conditions: []elbv2model.RuleCondition{
+						{
+							Field: "path-pattern",
+							PathPatternConfig: &elbv2model.PathPatternConditionConfig{
+								Values: []string{"/*"},
+							},
+						},
+						{
+							Field: "http-request-method",
+							HTTPRequestMethodConfig: &elbv2model.HTTPRequestMethodConditionConfig{
+								Values: []string{"OPTIONS"},
+							},
+						},
+						{
+							Field: "http-header",
+							HTTPHeaderConfig: &elbv2model.HTTPHeaderConditionConfig{
+								HTTPHeaderName: "Origin",
+								Values:         []string{"https://example.com"},
+							},
+						},
+					},
+				},
+				{
+					Priority: 2,
+					Actions: []elbv2model.Action{
+						{
+							Type: "forward",
+							ForwardConfig: &elbv2model.ForwardActionConfig{
+								TargetGroups: []elbv2model.TargetGroupTuple{
+									{
+										Weight: awssdk.Int32(1),
+									},
+								},
+							},
+						},
+					},
+					Conditions: []elbv2model.RuleCondition{
+						{
+							Field: "path-pattern",
+							PathPatternConfig: &elbv2model.PathPatternConditionConfig{
+								Values: []string{"/*"},
+							},
+						},
+						{
+							Field: "http-request-method",
+							HTTPRequestMethodConfig: &elbv2model.HTTPRequestMethodConditionConfig{
+								Values: []string{"GET"},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name:             "redirect filter should result in redirect action - https",
 			port:             80,
@@ -2583,3 +2834,225 @@ func TestMergeProtocols_WithQuic(t *testing.T) {
 		})
 	}
 }
+
+func Test_buildL7ListenerConfiguredDefaultActions(t *testing.T) {
+	stack := coremodel.NewDefaultStack(coremodel.StackID{Namespace: "namespace", Name: "name"})
+	tg := &elbv2model.TargetGroup{
+		ResourceMeta: coremodel.NewResourceMeta(stack, "AWS::ElasticLoadBalancingV2::TargetGroup", "id-1"),
+		Status: &elbv2model.TargetGroupStatus{
+			TargetGroupARN: "arn1",
+		},
+	}
+	gw := &gwv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "gw-ns", Name: "gw"},
+	}
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "gw-ns", Name: "default-backend"},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{Protocol: corev1.ProtocolTCP, Port: 80, TargetPort: intstr.FromInt32(8080)},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		lbLsCfg       *elbv2gw.ListenerConfiguration
+		expected      []elbv2model.Action
+		expectedTGARN string
+		expectErr     bool
+	}{
+		{
+			name:     "no listener configuration",
+			expected: buildL7ListenerDefaultActions(),
+		},
+		{
+			name:     "no default action",
+			lbLsCfg:  &elbv2gw.ListenerConfiguration{ProtocolPort: "HTTP:80"},
+			expected: buildL7ListenerDefaultActions(),
+		},
+		{
+			name: "fixed response default action",
+			lbLsCfg: &elbv2gw.ListenerConfiguration{
+				ProtocolPort: "HTTP:80",
+				DefaultAction: &elbv2gw.ListenerDefaultAction{
+					Type: elbv2gw.ListenerDefaultActionTypeFixedResponse,
+					FixedResponseConfig: &elbv2gw.FixedResponseActionConfig{
+						StatusCode:  404,
+						ContentType: awssdk.String("text/html"),
+						MessageBody: awssdk.String("<h1>Not Found</h1>"),
+					},
+				},
+			},
+			expected: []elbv2model.Action{
+				{
+					Type: elbv2model.ActionTypeFixedResponse,
+					FixedResponseConfig: &elbv2model.FixedResponseActionConfig{
+						ContentType: awssdk.String("text/html"),
+						MessageBody: awssdk.String("<h1>Not Found</h1>"),
+						StatusCode:  "404",
+					},
+				},
+			},
+		},
+		{
+			name: "redirect default action",
+			lbLsCfg: &elbv2gw.ListenerConfiguration{
+				ProtocolPort: "HTTP:80",
+				DefaultAction: &elbv2gw.ListenerDefaultAction{
+					Type: elbv2gw.ListenerDefaultActionTypeRedirect,
+					RedirectConfig: &elbv2gw.ListenerDefaultRedirectConfig{
+						Scheme:     awssdk.String("https"),
+						Hostname:   awssdk.String("www.example.com"),
+						Port:       awssdk.Int32(443),
+						Path:       awssdk.String("/not-found"),
+						StatusCode: awssdk.Int32(301),
+					},
+				},
+			},
+			expected: []elbv2model.Action{
+				{
+					Type: elbv2model.ActionTypeRedirect,
+					RedirectConfig: &elbv2model.RedirectActionConfig{
+						Host:       awssdk.String("www.example.com"),
+						Path:       awssdk.String("/not-found"),
+						Port:       awssdk.String("443"),
+						Protocol:   awssdk.String("HTTPS"),
+						StatusCode: "HTTP_301",
+					},
+				},
+			},
+		},
+		{
+			name: "redirect default action defaults to 302",
+			lbLsCfg: &elbv2gw.ListenerConfiguration{
+				ProtocolPort: "HTTP:80",
+				DefaultAction: &elbv2gw.ListenerDefaultAction{
+					Type: elbv2gw.ListenerDefaultActionTypeRedirect,
+					RedirectConfig: &elbv2gw.ListenerDefaultRedirectConfig{
+						Scheme: awssdk.String("https"),
+					},
+				},
+			},
+			expected: []elbv2model.Action{
+				{
+					Type: elbv2model.ActionTypeRedirect,
+					RedirectConfig: &elbv2model.RedirectActionConfig{
+						Protocol:   awssdk.String("HTTPS"),
+						StatusCode: "HTTP_302",
+					},
+				},
+			},
+		},
+		{
+			name: "forward default action",
+			lbLsCfg: &elbv2gw.ListenerConfiguration{
+				ProtocolPort: "HTTP:80",
+				DefaultAction: &elbv2gw.ListenerDefaultAction{
+					Type: elbv2gw.ListenerDefaultActionTypeForward,
+					ForwardConfig: &elbv2gw.ListenerDefaultForwardConfig{
+						Name: "default-backend",
+						Port: 80,
+					},
+				},
+			},
+			expectedTGARN: "arn1",
+		},
+		{
+			name: "forward default action to unknown service",
+			lbLsCfg: &elbv2gw.ListenerConfiguration{
+				ProtocolPort: "HTTP:80",
+				DefaultAction: &elbv2gw.ListenerDefaultAction{
+					Type: elbv2gw.ListenerDefaultActionTypeForward,
+					ForwardConfig: &elbv2gw.ListenerDefaultForwardConfig{
+						Name: "unknown",
+						Port: 80,
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "missing action config",
+			lbLsCfg: &elbv2gw.ListenerConfiguration{
+				ProtocolPort: "HTTP:80",
+				DefaultAction: &elbv2gw.ListenerDefaultAction{
+					Type: elbv2gw.ListenerDefaultActionTypeFixedResponse,
+				},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k8sClient := testutils.GenerateTestClient()
+			assert.NoError(t, k8sClient.Create(context.Background(), svc.DeepCopy()))
+
+			builder := &listenerBuilderImpl{
+				k8sClient: k8sClient,
+				tgBuilder: &mockTargetGroupBuilder{tgs: []*elbv2model.TargetGroup{tg}},
+				logger:    logr.Discard(),
+			}
+
+			result, err := builder.buildL7ListenerConfiguredDefaultActions(context.Background(), stack, gw, 80, elbv2model.ProtocolHTTP, elbv2model.IPAddressTypeIPV4, tc.lbLsCfg)
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			if tc.expectedTGARN != "" {
+				assert.Len(t, result, 1)
+				assert.Equal(t, elbv2model.ActionTypeForward, result[0].Type)
+				assert.Len(t, result[0].ForwardConfig.TargetGroups, 1)
+				arn, _ := result[0].ForwardConfig.TargetGroups[0].TargetGroupARN.Resolve(context.Background())
+				assert.Equal(t, tc.expectedTGARN, arn)
+				return
+			}
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func Test_mergeRouteListenerAttributes(t *testing.T) {
+	testCases := []struct {
+		name               string
+		attributes         []elbv2model.ListenerAttribute
+		routeAttributes    []elbv2model.ListenerAttribute
+		expectedAttributes []elbv2model.ListenerAttribute
+	}{
+		{
+			name:            "removed cors filter resets the cors headers",
+			attributes:      []elbv2model.ListenerAttribute{},
+			routeAttributes: routeutils.BuildCORSListenerAttributes(nil),
+			expectedAttributes: []elbv2model.ListenerAttribute{
+				{Key: "routing.http.response.access_control_allow_origin.header_value", Value: ""},
+				{Key: "routing.http.response.access_control_allow_methods.header_value", Value: ""},
+				{Key: "routing.http.response.access_control_allow_headers.header_value", Value: ""},
+				{Key: "routing.http.response.access_control_allow_credentials.header_value", Value: ""},
+				{Key: "routing.http.response.access_control_expose_headers.header_value", Value: ""},
+				{Key: "routing.http.response.access_control_max_age.header_value", Value: ""},
+			},
+		},
+		{
+			name: "configured listener attributes take precedence over route attributes",
+			attributes: []elbv2model.ListenerAttribute{
+				{Key: "routing.http.response.access_control_max_age.header_value", Value: "30"},
+			},
+			routeAttributes: routeutils.BuildCORSListenerAttributes(nil),
+			expectedAttributes: []elbv2model.ListenerAttribute{
+				{Key: "routing.http.response.access_control_max_age.header_value", Value: "30"},
+				{Key: "routing.http.response.access_control_allow_origin.header_value", Value: ""},
+				{Key: "routing.http.response.access_control_allow_methods.header_value", Value: ""},
+				{Key: "routing.http.response.access_control_allow_headers.header_value", Value: ""},
+				{Key: "routing.http.response.access_control_allow_credentials.header_value", Value: ""},
+				{Key: "routing.http.response.access_control_expose_headers.header_value", Value: ""},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedAttributes, mergeRouteListenerAttributes(tc.attributes, tc.routeAttributes))
+		})
+	}
+}
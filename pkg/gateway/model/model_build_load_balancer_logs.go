@@ -0,0 +1,61 @@
+package model
+
+import (
+	"sort"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/v3/apis/gateway/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/shared_utils"
+)
+
+// buildLoadBalancerLogs builds the log buckets for the Gateway's load balancer, and enables the configured logs in its attributes.
+func (baseBuilder *baseModelBuilder) buildLoadBalancerLogs(stack core.Stack, spec *elbv2model.LoadBalancerSpec, lbConf elbv2gw.LoadBalancerConfiguration) error {
+	logsConf := lbConf.Spec.LoadBalancerLogs
+	if logsConf == nil {
+		return nil
+	}
+	logAttributes, err := shared_utils.BuildLoadBalancerLogs(stack, baseBuilder.loadBalancerType, shared_utils.LoadBalancerLogsConfig{
+		AccessLogs:      buildLogDeliveryConfig(logsConf.AccessLogs),
+		ConnectionLogs:  buildLogDeliveryConfig(logsConf.ConnectionLogs),
+		HealthCheckLogs: buildLogDeliveryConfig(logsConf.HealthCheckLogs),
+	})
+	if err != nil {
+		return err
+	}
+	attributes := make(map[string]string, len(spec.LoadBalancerAttributes))
+	for _, attr := range spec.LoadBalancerAttributes {
+		attributes[attr.Key] = attr.Value
+	}
+	if _, err := shared_utils.MergeLoadBalancerLogAttributes(attributes, logAttributes); err != nil {
+		return err
+	}
+	logAttributeKeys := make([]string, 0, len(logAttributes))
+	for key := range logAttributes {
+		if _, exists := attributes[key]; !exists {
+			logAttributeKeys = append(logAttributeKeys, key)
+		}
+	}
+	sort.Strings(logAttributeKeys)
+	for _, key := range logAttributeKeys {
+		spec.LoadBalancerAttributes = append(spec.LoadBalancerAttributes, elbv2model.LoadBalancerAttribute{
+			Key:   key,
+			Value: logAttributes[key],
+		})
+	}
+	return nil
+}
+
+func buildLogDeliveryConfig(deliveryConf *elbv2gw.S3LogDeliveryConfiguration) *shared_utils.LogDeliveryConfig {
+	if deliveryConf == nil {
+		return nil
+	}
+	return &shared_utils.LogDeliveryConfig{
+		Bucket:                   deliveryConf.Bucket,
+		Prefix:                   awssdk.ToString(deliveryConf.Prefix),
+		ValidateBucketPolicyOnly: deliveryConf.BucketPolicy != nil && *deliveryConf.BucketPolicy == elbv2gw.LogBucketPolicyModeValidate,
+		CreateBucket:             awssdk.ToBool(deliveryConf.CreateBucket),
+		ExpirationDays:           deliveryConf.ExpirationDays,
+	}
+}
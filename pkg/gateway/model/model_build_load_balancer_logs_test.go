@@ -0,0 +1,136 @@
+package model
+
+import (
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/v3/apis/gateway/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2"
+	s3model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/s3"
+)
+
+func Test_buildLoadBalancerLogs(t *testing.T) {
+	validate := elbv2gw.LogBucketPolicyModeValidate
+	tests := []struct {
+		name           string
+		lbType         elbv2model.LoadBalancerType
+		attributes     []elbv2model.LoadBalancerAttribute
+		logsConf       *elbv2gw.LoadBalancerLogsConfiguration
+		wantAttributes []elbv2model.LoadBalancerAttribute
+		wantBuckets    []s3model.LogBucketSpec
+		wantErr        string
+	}{
+		{
+			name:   "no logs configured",
+			lbType: elbv2model.LoadBalancerTypeApplication,
+			attributes: []elbv2model.LoadBalancerAttribute{
+				{Key: "idle_timeout.timeout_seconds", Value: "60"},
+			},
+			wantAttributes: []elbv2model.LoadBalancerAttribute{
+				{Key: "idle_timeout.timeout_seconds", Value: "60"},
+			},
+		},
+		{
+			name:   "access and connection logs",
+			lbType: elbv2model.LoadBalancerTypeApplication,
+			attributes: []elbv2model.LoadBalancerAttribute{
+				{Key: "access_logs.s3.enabled", Value: "true"},
+			},
+			logsConf: &elbv2gw.LoadBalancerLogsConfiguration{
+				AccessLogs: &elbv2gw.S3LogDeliveryConfiguration{
+					Bucket: "my-logs",
+					Prefix: awssdk.String("gw"),
+				},
+				ConnectionLogs: &elbv2gw.S3LogDeliveryConfiguration{
+					Bucket: "my-logs",
+					Prefix: awssdk.String("gw-connections"),
+				},
+			},
+			wantAttributes: []elbv2model.LoadBalancerAttribute{
+				{Key: "access_logs.s3.enabled", Value: "true"},
+				{Key: "access_logs.s3.bucket", Value: "my-logs"},
+				{Key: "access_logs.s3.prefix", Value: "gw"},
+				{Key: "connection_logs.s3.bucket", Value: "my-logs"},
+				{Key: "connection_logs.s3.enabled", Value: "true"},
+				{Key: "connection_logs.s3.prefix", Value: "gw-connections"},
+			},
+			wantBuckets: []s3model.LogBucketSpec{
+				{
+					BucketName:         "my-logs",
+					Prefixes:           []string{"gw", "gw-connections"},
+					ManageBucketPolicy: true,
+				},
+			},
+		},
+		{
+			name:   "access logs validated only and created bucket",
+			lbType: elbv2model.LoadBalancerTypeNetwork,
+			logsConf: &elbv2gw.LoadBalancerLogsConfiguration{
+				AccessLogs: &elbv2gw.S3LogDeliveryConfiguration{
+					Bucket:         "nlb-logs",
+					BucketPolicy:   &validate,
+					CreateBucket:   awssdk.Bool(true),
+					ExpirationDays: awssdk.Int32(14),
+				},
+			},
+			wantAttributes: []elbv2model.LoadBalancerAttribute{
+				{Key: "access_logs.s3.bucket", Value: "nlb-logs"},
+				{Key: "access_logs.s3.enabled", Value: "true"},
+				{Key: "access_logs.s3.prefix", Value: ""},
+			},
+			wantBuckets: []s3model.LogBucketSpec{
+				{
+					BucketName:     "nlb-logs",
+					Prefixes:       []string{""},
+					CreateBucket:   true,
+					ExpirationDays: awssdk.Int32(14),
+				},
+			},
+		},
+		{
+			name:   "conflicting explicit attribute",
+			lbType: elbv2model.LoadBalancerTypeApplication,
+			attributes: []elbv2model.LoadBalancerAttribute{
+				{Key: "access_logs.s3.bucket", Value: "other-logs"},
+			},
+			logsConf: &elbv2gw.LoadBalancerLogsConfiguration{
+				AccessLogs: &elbv2gw.S3LogDeliveryConfiguration{Bucket: "my-logs"},
+			},
+			wantErr: "conflicting load balancer attributes access_logs.s3.bucket: other-logs | my-logs",
+		},
+		{
+			name:   "health check logs for network load balancer",
+			lbType: elbv2model.LoadBalancerTypeNetwork,
+			logsConf: &elbv2gw.LoadBalancerLogsConfiguration{
+				HealthCheckLogs: &elbv2gw.S3LogDeliveryConfiguration{Bucket: "my-logs"},
+			},
+			wantErr: "health check logs are only supported by application load balancers",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder := &baseModelBuilder{loadBalancerType: tt.lbType}
+			stack := core.NewDefaultStack(core.StackID{Namespace: "ns", Name: "gw"})
+			spec := &elbv2model.LoadBalancerSpec{LoadBalancerAttributes: tt.attributes}
+			lbConf := elbv2gw.LoadBalancerConfiguration{
+				Spec: elbv2gw.LoadBalancerConfigurationSpec{LoadBalancerLogs: tt.logsConf},
+			}
+			err := builder.buildLoadBalancerLogs(stack, spec, lbConf)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantAttributes, spec.LoadBalancerAttributes)
+			var resBuckets []*s3model.LogBucket
+			assert.NoError(t, stack.ListResources(&resBuckets))
+			var gotBuckets []s3model.LogBucketSpec
+			for _, resBucket := range resBuckets {
+				gotBuckets = append(gotBuckets, resBucket.Spec)
+			}
+			assert.Equal(t, tt.wantBuckets, gotBuckets)
+		})
+	}
+}
@@ -5,9 +5,13 @@ import (
 	"encoding/hex"
 	"fmt"
 	"regexp"
+
+	"k8s.io/apimachinery/pkg/util/sets"
 	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/v3/apis/gateway/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/shared_utils"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
@@ -42,6 +46,11 @@ func (lbModelBuilder *loadBalancerBuilderImpl) buildLoadBalancerSpec(scheme elbv
 	if err != nil {
 		return elbv2model.LoadBalancerSpec{}, err
 	}
+	federationGroupTags, err := shared_utils.BuildFederationGroupTags(gw)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	tags = algorithm.MergeStringMap(federationGroupTags, tags)
 
 	spec := elbv2model.LoadBalancerSpec{
 		Name:                   name,
@@ -111,3 +120,18 @@ func (lbModelBuilder *loadBalancerBuilderImpl) buildLoadBalancerAttributes(lbCon
 	}
 	return attributes
 }
+
+// mergeRouteLoadBalancerAttributes adds the load balancer attributes of the HTTPRoute RequestHeaderModifier filters,
+// attributes explicitly configured in the LoadBalancerConfiguration take precedence.
+func mergeRouteLoadBalancerAttributes(attributes []elbv2model.LoadBalancerAttribute, routeAttributes []elbv2model.LoadBalancerAttribute) []elbv2model.LoadBalancerAttribute {
+	configuredKeys := sets.New[string]()
+	for _, attr := range attributes {
+		configuredKeys.Insert(attr.Key)
+	}
+	for _, attr := range routeAttributes {
+		if !configuredKeys.Has(attr.Key) {
+			attributes = append(attributes, attr)
+		}
+	}
+	return attributes
+}
@@ -180,3 +180,32 @@ func TestLoadBalancerBuilderImpl_BuildLoadBalancerAttributes(t *testing.T) {
 		})
 	}
 }
+
+func Test_mergeRouteLoadBalancerAttributes(t *testing.T) {
+	xffRemove := elbv2model.LoadBalancerAttribute{Key: "routing.http.xff_header_processing.mode", Value: "remove"}
+	tests := []struct {
+		name            string
+		attributes      []elbv2model.LoadBalancerAttribute
+		routeAttributes []elbv2model.LoadBalancerAttribute
+		want            []elbv2model.LoadBalancerAttribute
+	}{
+		{
+			name:            "route attributes are added",
+			attributes:      []elbv2model.LoadBalancerAttribute{{Key: "idle_timeout.timeout_seconds", Value: "60"}},
+			routeAttributes: []elbv2model.LoadBalancerAttribute{xffRemove},
+			want:            []elbv2model.LoadBalancerAttribute{{Key: "idle_timeout.timeout_seconds", Value: "60"}, xffRemove},
+		},
+		{
+			name:            "configured attributes take precedence",
+			attributes:      []elbv2model.LoadBalancerAttribute{{Key: "routing.http.xff_header_processing.mode", Value: "preserve"}},
+			routeAttributes: []elbv2model.LoadBalancerAttribute{xffRemove},
+			want:            []elbv2model.LoadBalancerAttribute{{Key: "routing.http.xff_header_processing.mode", Value: "preserve"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, mergeRouteLoadBalancerAttributes(tt.attributes, tt.routeAttributes))
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package model
+
+import (
+	"github.com/pkg/errors"
+	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/v3/apis/gateway/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/shared_utils"
+)
+
+// buildMetricAlarms builds the CloudWatch alarms for the Gateway's load balancer and target groups.
+func (baseBuilder *baseModelBuilder) buildMetricAlarms(stack core.Stack, lb *elbv2model.LoadBalancer, lbConf elbv2gw.LoadBalancerConfiguration, tgBuilder targetGroupBuilder) error {
+	var alarmsCFG shared_utils.MetricAlarmsConfig
+	if alarmsConf := lbConf.Spec.CloudWatchAlarms; alarmsConf != nil {
+		thresholds, err := shared_utils.ParseMetricAlarmThresholds(alarmsConf.Thresholds)
+		if err != nil {
+			return err
+		}
+		alarmsCFG = shared_utils.MetricAlarmsConfig{
+			Thresholds:   thresholds,
+			AlarmActions: alarmsConf.AlarmActions,
+		}
+	}
+	alarmTGs, err := tgBuilder.getMetricAlarmTargetGroups()
+	if err != nil {
+		return err
+	}
+	if alarmsCFG.IsEmpty() && !hasMetricAlarmThresholdOverrides(alarmTGs) {
+		return nil
+	}
+	if !baseBuilder.featureGates.Enabled(config.CloudWatchAlarmManagement) {
+		return errors.Errorf("CloudWatch alarms cannot be managed unless the %v feature gate is enabled", config.CloudWatchAlarmManagement)
+	}
+	tags, err := baseBuilder.gwTagHelper.getLoadBalancerTags(lbConf)
+	if err != nil {
+		return err
+	}
+	_, err = shared_utils.BuildMetricAlarms(stack, baseBuilder.clusterName, baseBuilder.loadBalancerType, lb.LoadBalancerARN(), alarmsCFG, alarmTGs, tags)
+	return err
+}
+
+func hasMetricAlarmThresholdOverrides(alarmTGs []shared_utils.MetricAlarmTargetGroup) bool {
+	for _, alarmTG := range alarmTGs {
+		if len(alarmTG.Thresholds) != 0 {
+			return true
+		}
+	}
+	return false
+}
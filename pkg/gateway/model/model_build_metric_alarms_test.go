@@ -0,0 +1,127 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/v3/apis/gateway/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/config"
+	cloudwatchmodel "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/cloudwatch"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/shared_utils"
+)
+
+func Test_buildMetricAlarms(t *testing.T) {
+	tests := []struct {
+		name                 string
+		lbType               elbv2model.LoadBalancerType
+		featureGateEnabled   bool
+		lbConf               elbv2gw.LoadBalancerConfiguration
+		tgThresholds         map[string]float64
+		wantThresholdByResID map[string]float64
+		wantErr              string
+	}{
+		{
+			name:               "no alarms configured",
+			lbType:             elbv2model.LoadBalancerTypeApplication,
+			featureGateEnabled: true,
+		},
+		{
+			name:               "alarms configured",
+			lbType:             elbv2model.LoadBalancerTypeApplication,
+			featureGateEnabled: true,
+			lbConf: elbv2gw.LoadBalancerConfiguration{
+				Spec: elbv2gw.LoadBalancerConfigurationSpec{
+					CloudWatchAlarms: &elbv2gw.CloudWatchAlarmsConfiguration{
+						Thresholds: map[string]string{
+							"UnHealthyHostCount":     "1",
+							"HTTPCode_ELB_5XX_Count": "10",
+						},
+						AlarmActions: []string{"arn:aws:sns:us-west-2:111122223333:lb-alarms"},
+					},
+				},
+			},
+			wantThresholdByResID: map[string]float64{
+				"HTTPCode_ELB_5XX_Count":          10,
+				"ns-gw-svc:80/UnHealthyHostCount": 1,
+			},
+		},
+		{
+			name:               "target group thresholds only",
+			lbType:             elbv2model.LoadBalancerTypeNetwork,
+			featureGateEnabled: true,
+			tgThresholds:       map[string]float64{"UnHealthyHostCount": 2},
+			wantThresholdByResID: map[string]float64{
+				"ns-gw-svc:80/UnHealthyHostCount": 2,
+			},
+		},
+		{
+			name:               "invalid threshold",
+			lbType:             elbv2model.LoadBalancerTypeApplication,
+			featureGateEnabled: true,
+			lbConf: elbv2gw.LoadBalancerConfiguration{
+				Spec: elbv2gw.LoadBalancerConfigurationSpec{
+					CloudWatchAlarms: &elbv2gw.CloudWatchAlarmsConfiguration{
+						Thresholds: map[string]string{"RequestCount": "1"},
+					},
+				},
+			},
+			wantErr: "unsupported CloudWatch alarm metric RequestCount, supported metrics: HTTPCode_ELB_5XX_Count, TargetResponseTime, UnHealthyHostCount",
+		},
+		{
+			name:               "feature gate disabled",
+			lbType:             elbv2model.LoadBalancerTypeApplication,
+			featureGateEnabled: false,
+			lbConf: elbv2gw.LoadBalancerConfiguration{
+				Spec: elbv2gw.LoadBalancerConfigurationSpec{
+					CloudWatchAlarms: &elbv2gw.CloudWatchAlarmsConfiguration{
+						Thresholds: map[string]string{"UnHealthyHostCount": "1"},
+					},
+				},
+			},
+			wantErr: "CloudWatch alarms cannot be managed unless the CloudWatchAlarmManagement feature gate is enabled",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			featureGates := config.NewFeatureGates()
+			if tt.featureGateEnabled {
+				featureGates.Enable(config.CloudWatchAlarmManagement)
+			}
+			builder := &baseModelBuilder{
+				clusterName:      "cluster",
+				loadBalancerType: tt.lbType,
+				featureGates:     featureGates,
+				gwTagHelper:      newTagHelper(sets.New[string](), nil, false),
+			}
+			stack := core.NewDefaultStack(core.StackID{Namespace: "ns", Name: "gw"})
+			lb := elbv2model.NewLoadBalancer(stack, "LoadBalancer", elbv2model.LoadBalancerSpec{})
+			tg := elbv2model.NewTargetGroup(stack, "ns-gw-svc:80", elbv2model.TargetGroupSpec{})
+			tgBuilder := &mockTargetGroupBuilder{
+				alarmTGs: []shared_utils.MetricAlarmTargetGroup{
+					{ResID: tg.ID(), TargetGroupARN: tg.TargetGroupARN(), Thresholds: tt.tgThresholds},
+				},
+			}
+			err := builder.buildMetricAlarms(stack, lb, tt.lbConf, tgBuilder)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			var resAlarms []*cloudwatchmodel.MetricAlarm
+			stack.ListResources(&resAlarms)
+			if tt.wantThresholdByResID == nil {
+				assert.Empty(t, resAlarms)
+				return
+			}
+			gotThresholdByResID := make(map[string]float64)
+			for _, resAlarm := range resAlarms {
+				gotThresholdByResID[resAlarm.ID()] = resAlarm.Spec.Threshold
+				assert.Equal(t, []core.Resource{lb}, resAlarm.Spec.LoadBalancerARN.Dependencies())
+			}
+			assert.Equal(t, tt.wantThresholdByResID, gotThresholdByResID)
+		})
+	}
+}
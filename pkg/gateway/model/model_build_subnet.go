@@ -7,12 +7,14 @@ import (
 	"github.com/pkg/errors"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/v3/apis/elbv2/v1beta1"
 	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/v3/apis/gateway/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/services"
 	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/deploy/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/deploy/tracking"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway/model/subnet"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/networking"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
 type buildLoadBalancerSubnetsOutput struct {
@@ -22,19 +24,20 @@ type buildLoadBalancerSubnetsOutput struct {
 }
 
 type subnetModelBuilder interface {
-	buildLoadBalancerSubnets(ctx context.Context, gwSubnetConfig *[]elbv2gw.SubnetConfiguration, gwSubnetTagSelectors *map[string][]string, scheme elbv2model.LoadBalancerScheme, ipAddressType elbv2model.IPAddressType, stack core.Stack) (buildLoadBalancerSubnetsOutput, error)
+	buildLoadBalancerSubnets(ctx context.Context, gwSubnetConfig *[]elbv2gw.SubnetConfiguration, gwSubnetTagSelectors *map[string][]string, gwAddresses []gwv1.GatewaySpecAddress, scheme elbv2model.LoadBalancerScheme, ipAddressType elbv2model.IPAddressType, stack core.Stack) (buildLoadBalancerSubnetsOutput, error)
 }
 
 type subnetModelBuilderImpl struct {
-	loadBalancerType   elbv2model.LoadBalancerType
-	subnetMutatorChain []subnet.Mutator
+	loadBalancerType      elbv2model.LoadBalancerType
+	subnetMutatorChain    []subnet.Mutator
+	gatewayAddressMutator subnet.GatewayAddressMutator
 
 	trackingProvider    tracking.Provider
 	subnetsResolver     networking.SubnetsResolver
 	elbv2TaggingManager elbv2deploy.TaggingManager
 }
 
-func newSubnetModelBuilder(loadBalancerType elbv2model.LoadBalancerType, trackingProvider tracking.Provider, subnetsResolver networking.SubnetsResolver, elbv2TaggingManager elbv2deploy.TaggingManager) subnetModelBuilder {
+func newSubnetModelBuilder(loadBalancerType elbv2model.LoadBalancerType, trackingProvider tracking.Provider, subnetsResolver networking.SubnetsResolver, elbv2TaggingManager elbv2deploy.TaggingManager, ec2Client services.EC2) subnetModelBuilder {
 	var subnetMutatorChain []subnet.Mutator
 
 	if loadBalancerType == elbv2model.LoadBalancerTypeNetwork {
@@ -47,8 +50,9 @@ func newSubnetModelBuilder(loadBalancerType elbv2model.LoadBalancerType, trackin
 	}
 
 	return &subnetModelBuilderImpl{
-		loadBalancerType:   loadBalancerType,
-		subnetMutatorChain: subnetMutatorChain,
+		loadBalancerType:      loadBalancerType,
+		subnetMutatorChain:    subnetMutatorChain,
+		gatewayAddressMutator: subnet.NewGatewayAddressMutator(ec2Client),
 
 		trackingProvider:    trackingProvider,
 		subnetsResolver:     subnetsResolver,
@@ -56,13 +60,17 @@ func newSubnetModelBuilder(loadBalancerType elbv2model.LoadBalancerType, trackin
 	}
 }
 
-func (subnetBuilder *subnetModelBuilderImpl) buildLoadBalancerSubnets(ctx context.Context, gwSubnetConfig *[]elbv2gw.SubnetConfiguration, gwSubnetTagSelectors *map[string][]string, scheme elbv2model.LoadBalancerScheme, ipAddressType elbv2model.IPAddressType, stack core.Stack) (buildLoadBalancerSubnetsOutput, error) {
+func (subnetBuilder *subnetModelBuilderImpl) buildLoadBalancerSubnets(ctx context.Context, gwSubnetConfig *[]elbv2gw.SubnetConfiguration, gwSubnetTagSelectors *map[string][]string, gwAddresses []gwv1.GatewaySpecAddress, scheme elbv2model.LoadBalancerScheme, ipAddressType elbv2model.IPAddressType, stack core.Stack) (buildLoadBalancerSubnetsOutput, error) {
 	sourceNATEnabled, err := subnetBuilder.validateSubnetsInput(gwSubnetConfig, scheme, ipAddressType)
 
 	if err != nil {
 		return buildLoadBalancerSubnetsOutput{}, err
 	}
 
+	if err := subnetBuilder.validateGatewayAddressesInput(gwSubnetConfig, gwAddresses); err != nil {
+		return buildLoadBalancerSubnetsOutput{}, err
+	}
+
 	resolvedEC2Subnets, err := subnetBuilder.resolveEC2Subnets(ctx, stack, gwSubnetConfig, gwSubnetTagSelectors, scheme, ipAddressType)
 
 	if err != nil {
@@ -90,6 +98,12 @@ func (subnetBuilder *subnetModelBuilderImpl) buildLoadBalancerSubnets(ctx contex
 		}
 	}
 
+	if len(gwAddresses) != 0 {
+		if err := subnetBuilder.gatewayAddressMutator.Mutate(ctx, resultPtrs, resolvedEC2Subnets, gwAddresses, scheme, ipAddressType); err != nil {
+			return buildLoadBalancerSubnetsOutput{}, err
+		}
+	}
+
 	result := make([]elbv2model.SubnetMapping, 0, len(resultPtrs))
 
 	for _, v := range resultPtrs {
@@ -176,6 +190,32 @@ func (subnetBuilder *subnetModelBuilderImpl) validateSubnetsInput(subnetConfigsP
 	return sourceNATSpecified, nil
 }
 
+// validateGatewayAddressesInput validates that the Gateway addresses can be assigned to the load balancer.
+// The Gateway addresses can't be combined with the address allocations of the LoadBalancerConfiguration subnets.
+func (subnetBuilder *subnetModelBuilderImpl) validateGatewayAddressesInput(subnetConfigsPtr *[]elbv2gw.SubnetConfiguration, gwAddresses []gwv1.GatewaySpecAddress) error {
+	if len(gwAddresses) == 0 {
+		return nil
+	}
+
+	if subnetBuilder.loadBalancerType != elbv2model.LoadBalancerTypeNetwork {
+		return &subnet.AddressError{
+			Reason:  gwv1.GatewayReasonAddressNotUsable,
+			Message: "addresses are only supported for Network LoadBalancers",
+		}
+	}
+
+	if subnetConfigsPtr != nil && len(*subnetConfigsPtr) != 0 {
+		subnetConfig := (*subnetConfigsPtr)[0]
+		if subnetConfig.EIPAllocation != nil || subnetConfig.PrivateIPv4Allocation != nil || subnetConfig.IPv6Allocation != nil {
+			return &subnet.AddressError{
+				Reason:  gwv1.GatewayReasonAddressNotUsable,
+				Message: "addresses cannot be specified together with address allocations of the LoadBalancerConfiguration subnets",
+			}
+		}
+	}
+	return nil
+}
+
 func (subnetBuilder *subnetModelBuilderImpl) resolveEC2Subnets(ctx context.Context, stack core.Stack, subnetConfigsPtr *[]elbv2gw.SubnetConfiguration, subnetTagSelector *map[string][]string, scheme elbv2model.LoadBalancerScheme, ipAddressType elbv2model.IPAddressType) ([]ec2types.Subnet, error) {
 	// if we have identifiers, query directly by them.
 	// this assumes that validateSubnetsInput() was already ran on the input.
@@ -16,6 +16,7 @@ import (
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/networking"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 	"testing"
 )
 
@@ -25,7 +26,7 @@ func Test_NewSubnetModelBuilder(t *testing.T) {
 	subnetResolver := networking.NewDefaultSubnetsResolver(nil, nil, "", "", false, false, false, logr.Discard())
 	taggingManager := elbv2deploy.NewDefaultTaggingManager(nil, "", nil, nil, logr.Discard())
 
-	builderNLB := newSubnetModelBuilder(elbv2model.LoadBalancerTypeNetwork, trackingProvider, subnetResolver, taggingManager)
+	builderNLB := newSubnetModelBuilder(elbv2model.LoadBalancerTypeNetwork, trackingProvider, subnetResolver, taggingManager, nil)
 	subnetBuilderNLB := builderNLB.(*subnetModelBuilderImpl)
 
 	assert.Equal(t, trackingProvider, subnetBuilderNLB.trackingProvider)
@@ -33,7 +34,7 @@ func Test_NewSubnetModelBuilder(t *testing.T) {
 	assert.Equal(t, taggingManager, subnetBuilderNLB.elbv2TaggingManager)
 	assert.Equal(t, 4, len(subnetBuilderNLB.subnetMutatorChain))
 
-	builderALB := newSubnetModelBuilder(elbv2model.LoadBalancerTypeApplication, trackingProvider, subnetResolver, taggingManager)
+	builderALB := newSubnetModelBuilder(elbv2model.LoadBalancerTypeApplication, trackingProvider, subnetResolver, taggingManager, nil)
 	subnetBuilderALB := builderALB.(*subnetModelBuilderImpl)
 
 	assert.Equal(t, trackingProvider, subnetBuilderALB.trackingProvider)
@@ -97,7 +98,7 @@ func Test_BuildLoadBalancerSubnets(t *testing.T) {
 		},
 	}
 
-	output, err := builder.buildLoadBalancerSubnets(context.Background(), &gwSubnetConfig, nil, elbv2model.LoadBalancerSchemeInternal, elbv2model.IPAddressTypeIPV4, nil)
+	output, err := builder.buildLoadBalancerSubnets(context.Background(), &gwSubnetConfig, nil, nil, elbv2model.LoadBalancerSchemeInternal, elbv2model.IPAddressTypeIPV4, nil)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedMappings, output.subnets)
@@ -390,6 +391,86 @@ func (m *mockProvider) LegacyTagKeys() []string {
 	panic("implement me")
 }
 
+func Test_ValidateGatewayAddressesInput(t *testing.T) {
+	testCases := []struct {
+		name         string
+		lbType       elbv2model.LoadBalancerType
+		subnetConfig []elbv2gw.SubnetConfiguration
+		gwAddresses  []gwv1.GatewaySpecAddress
+
+		expectErr bool
+	}{
+		{
+			name:   "no addresses",
+			lbType: elbv2model.LoadBalancerTypeApplication,
+		},
+		{
+			name:   "addresses for nlb",
+			lbType: elbv2model.LoadBalancerTypeNetwork,
+			subnetConfig: []elbv2gw.SubnetConfiguration{
+				{
+					Identifier: "foo",
+				},
+			},
+			gwAddresses: []gwv1.GatewaySpecAddress{
+				{Value: "10.0.0.10"},
+			},
+		},
+		{
+			name:   "addresses for alb",
+			lbType: elbv2model.LoadBalancerTypeApplication,
+			gwAddresses: []gwv1.GatewaySpecAddress{
+				{Value: "10.0.0.10"},
+			},
+			expectErr: true,
+		},
+		{
+			name:   "addresses with eip allocations",
+			lbType: elbv2model.LoadBalancerTypeNetwork,
+			subnetConfig: []elbv2gw.SubnetConfiguration{
+				{
+					EIPAllocation: awssdk.String("foo"),
+				},
+			},
+			gwAddresses: []gwv1.GatewaySpecAddress{
+				{Value: "3.3.3.3"},
+			},
+			expectErr: true,
+		},
+		{
+			name:   "addresses with private ipv4 allocations",
+			lbType: elbv2model.LoadBalancerTypeNetwork,
+			subnetConfig: []elbv2gw.SubnetConfiguration{
+				{
+					PrivateIPv4Allocation: awssdk.String("10.0.0.10"),
+				},
+			},
+			gwAddresses: []gwv1.GatewaySpecAddress{
+				{Value: "10.0.0.10"},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			builder := subnetModelBuilderImpl{
+				loadBalancerType: tc.lbType,
+			}
+
+			err := builder.validateGatewayAddressesInput(&tc.subnetConfig, tc.gwAddresses)
+
+			if tc.expectErr {
+				var addressErr *subnet.AddressError
+				assert.ErrorAs(t, err, &addressErr)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
 func Test_ResolveEC2Subnets(t *testing.T) {
 
 	type subnetCall struct {
@@ -15,6 +15,7 @@ import (
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/v3/apis/elbv2/v1beta1"
 	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/v3/apis/gateway/v1"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway/multicluster"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway/routeutils"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/k8s"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
@@ -34,6 +35,7 @@ type targetGroupBuilder interface {
 	buildTargetGroup(stack core.Stack,
 		gw *gwv1.Gateway, listenerPort int32, listenerProtocol elbv2model.Protocol, lbIPType elbv2model.IPAddressType, routeDescriptor routeutils.RouteDescriptor, backend routeutils.Backend) (core.StringToken, error)
 	getLocalFrontendNlbData() map[string]*elbv2model.FrontendNlbTargetGroupState
+	getMetricAlarmTargetGroups() ([]shared_utils.MetricAlarmTargetGroup, error)
 }
 
 type targetGroupBuilderImpl struct {
@@ -44,6 +46,7 @@ type targetGroupBuilderImpl struct {
 
 	tagHelper               tagHelper
 	tgByResID               map[string]*elbv2model.TargetGroup
+	tgPropsByResID          map[string]*elbv2gw.TargetGroupProps
 	tgPropertiesConstructor gateway.TargetGroupConfigConstructor
 
 	tgbNetworkBuilder          targetGroupBindingNetworkBuilder
@@ -77,6 +80,27 @@ func (builder *targetGroupBuilderImpl) getLocalFrontendNlbData() map[string]*elb
 	return builder.localFrontendNlbData
 }
 
+// getMetricAlarmTargetGroups returns the target groups built so far, along with their CloudWatch alarm threshold overrides.
+func (builder *targetGroupBuilderImpl) getMetricAlarmTargetGroups() ([]shared_utils.MetricAlarmTargetGroup, error) {
+	alarmTGs := make([]shared_utils.MetricAlarmTargetGroup, 0, len(builder.tgByResID))
+	for tgResID, tg := range builder.tgByResID {
+		var thresholds map[string]float64
+		if tgProps := builder.tgPropsByResID[tgResID]; tgProps != nil {
+			var err error
+			thresholds, err = shared_utils.ParseMetricAlarmThresholds(tgProps.CloudWatchAlarmThresholds)
+			if err != nil {
+				return nil, err
+			}
+		}
+		alarmTGs = append(alarmTGs, shared_utils.MetricAlarmTargetGroup{
+			ResID:          tgResID,
+			TargetGroupARN: tg.TargetGroupARN(),
+			Thresholds:     thresholds,
+		})
+	}
+	return alarmTGs, nil
+}
+
 func newTargetGroupBuilder(clusterName string, vpcId string, tagHelper tagHelper, loadBalancerType elbv2model.LoadBalancerType, tgbNetworkBuilder targetGroupBindingNetworkBuilder, tgPropertiesConstructor gateway.TargetGroupConfigConstructor, defaultTargetType string, targetGroupNameToArnMapper shared_utils.TargetGroupARNMapper) targetGroupBuilder {
 	return &targetGroupBuilderImpl{
 		loadBalancerType:                          loadBalancerType,
@@ -86,6 +110,7 @@ func newTargetGroupBuilder(clusterName string, vpcId string, tagHelper tagHelper
 		tgPropertiesConstructor:                   tgPropertiesConstructor,
 		targetGroupNameToArnMapper:                targetGroupNameToArnMapper,
 		tgByResID:                                 make(map[string]*elbv2model.TargetGroup),
+		tgPropsByResID:                            make(map[string]*elbv2gw.TargetGroupProps),
 		localFrontendNlbData:                      make(map[string]*elbv2model.FrontendNlbTargetGroupState),
 		tagHelper:                                 tagHelper,
 		defaultTargetType:                         elbv2model.TargetType(defaultTargetType),
@@ -126,6 +151,14 @@ func (builder *targetGroupBuilderImpl) buildTargetGroup(stack core.Stack,
 		return tg.TargetGroupARN(), nil
 	}
 
+	if backend.ServiceImportBackend != nil {
+		tg, err := builder.buildTargetGroupFromServiceImport(stack, gw, listenerProtocol, lbIPType, routeDescriptor, *backend.ServiceImportBackend)
+		if err != nil {
+			return nil, err
+		}
+		return tg.TargetGroupARN(), nil
+	}
+
 	if backend.LiteralTargetGroup != nil {
 		arn, err := builder.buildTargetGroupFromStaticName(*backend.LiteralTargetGroup)
 		return arn, err
@@ -163,6 +196,7 @@ func (builder *targetGroupBuilderImpl) buildTargetGroupFromService(stack core.St
 	tgOut.bindingSpec.Template.Spec.TargetGroupARN = tg.TargetGroupARN()
 	elbv2modelk8s.NewTargetGroupBindingResource(stack, tg.ID(), tgOut.bindingSpec)
 	builder.tgByResID[tgResID] = tg
+	builder.tgPropsByResID[tgResID] = targetGroupProps
 	return tg, nil
 }
 
@@ -181,6 +215,7 @@ func (builder *targetGroupBuilderImpl) buildTargetGroupFromGateway(stack core.St
 
 	tg := elbv2model.NewTargetGroup(stack, tgResID, tgSpec)
 	builder.tgByResID[tgResID] = tg
+	builder.tgPropsByResID[tgResID] = targetGroupProps
 
 	builder.localFrontendNlbData[tgSpec.Name] = &elbv2model.FrontendNlbTargetGroupState{
 		Name:       tgSpec.Name,
@@ -193,6 +228,39 @@ func (builder *targetGroupBuilderImpl) buildTargetGroupFromGateway(stack core.St
 	return tg, nil
 }
 
+// buildTargetGroupFromServiceImport builds a multi-cluster target group without a TargetGroupBinding.
+// Every cluster exporting the Service discovers the target group by its ServiceImport tags and binds its own endpoints to it.
+func (builder *targetGroupBuilderImpl) buildTargetGroupFromServiceImport(stack core.Stack,
+	gw *gwv1.Gateway, listenerProtocol elbv2model.Protocol, lbIPType elbv2model.IPAddressType, routeDescriptor routeutils.RouteDescriptor, backendConfig routeutils.ServiceImportBackendConfig) (*elbv2model.TargetGroup, error) {
+	targetGroupProps := backendConfig.GetTargetGroupProps()
+	serviceImportKey := backendConfig.GetBackendNamespacedName()
+	// qualify the backend name with its kind, so it doesn't collide with a Service of the same name.
+	backendKey := types.NamespacedName{
+		Namespace: serviceImportKey.Namespace,
+		Name:      fmt.Sprintf("%s/%s", multicluster.ServiceImportKind, serviceImportKey.Name),
+	}
+	tgResID := builder.buildTargetGroupResourceID(k8s.NamespacedName(gw), backendKey, routeDescriptor.GetRouteNamespacedName(), routeDescriptor.GetRouteKind(), backendConfig.GetIdentifierPort(), nil)
+	if tg, exists := builder.tgByResID[tgResID]; exists {
+		return tg, nil
+	}
+
+	tgSpec, err := builder.buildTargetGroupSpec(gw, routeDescriptor, listenerProtocol, lbIPType, &backendConfig, targetGroupProps)
+	if err != nil {
+		return nil, err
+	}
+	if tgSpec.Tags == nil {
+		tgSpec.Tags = make(map[string]string)
+	}
+	for k, v := range multicluster.BuildServiceImportTags(builder.clusterName, serviceImportKey, backendConfig.GetIdentifierPort().IntVal) {
+		tgSpec.Tags[k] = v
+	}
+
+	tg := elbv2model.NewTargetGroup(stack, tgResID, tgSpec)
+	builder.tgByResID[tgResID] = tg
+	builder.tgPropsByResID[tgResID] = targetGroupProps
+	return tg, nil
+}
+
 func (builder *targetGroupBuilderImpl) buildTargetGroupFromStaticName(cfg routeutils.LiteralTargetGroupConfig) (core.StringToken, error) {
 
 	tgArn, err := builder.targetGroupNameToArnMapper.GetArnByName(context.Background(), cfg.Name)
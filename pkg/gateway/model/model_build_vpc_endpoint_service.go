@@ -0,0 +1,44 @@
+package model
+
+import (
+	"github.com/pkg/errors"
+	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/v3/apis/gateway/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/ec2"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2"
+)
+
+const (
+	resourceIDVPCEndpointService = "VPCEndpointService"
+)
+
+// buildVPCEndpointService builds the VPC endpoint service (AWS PrivateLink) exposing the Gateway's Network Load Balancer.
+func (baseBuilder *baseModelBuilder) buildVPCEndpointService(stack core.Stack, lb *elbv2model.LoadBalancer, lbConf elbv2gw.LoadBalancerConfiguration) error {
+	esConf := lbConf.Spec.VPCEndpointService
+	if esConf == nil {
+		return nil
+	}
+	if baseBuilder.loadBalancerType != elbv2model.LoadBalancerTypeNetwork {
+		return errors.New("vpcEndpointService is only supported for Network Load Balancer gateways")
+	}
+	if !baseBuilder.featureGates.Enabled(config.VPCEndpointServiceManagement) {
+		return errors.Errorf("VPC endpoint services cannot be managed unless the %v feature gate is enabled", config.VPCEndpointServiceManagement)
+	}
+	tags, err := baseBuilder.gwTagHelper.getLoadBalancerTags(lbConf)
+	if err != nil {
+		return err
+	}
+	var privateDNSName *string
+	if esConf.PrivateDNSName != nil && *esConf.PrivateDNSName != "" {
+		privateDNSName = esConf.PrivateDNSName
+	}
+	ec2model.NewVPCEndpointService(stack, resourceIDVPCEndpointService, ec2model.VPCEndpointServiceSpec{
+		NetworkLoadBalancerARN: lb.LoadBalancerARN(),
+		AcceptanceRequired:     esConf.AcceptanceRequired != nil && *esConf.AcceptanceRequired,
+		PrivateDNSName:         privateDNSName,
+		AllowedPrincipals:      esConf.AllowedPrincipals,
+		Tags:                   tags,
+	})
+	return nil
+}
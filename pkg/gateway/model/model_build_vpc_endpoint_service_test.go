@@ -0,0 +1,122 @@
+package model
+
+import (
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/v3/apis/gateway/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/ec2"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2"
+)
+
+func Test_buildVPCEndpointService(t *testing.T) {
+	tests := []struct {
+		name               string
+		lbType             elbv2model.LoadBalancerType
+		featureGateEnabled bool
+		lbConf             elbv2gw.LoadBalancerConfiguration
+		wantSpec           *ec2model.VPCEndpointServiceSpec
+		wantErr            string
+	}{
+		{
+			name:               "no endpoint service configured",
+			lbType:             elbv2model.LoadBalancerTypeNetwork,
+			featureGateEnabled: true,
+		},
+		{
+			name:               "endpoint service configured",
+			lbType:             elbv2model.LoadBalancerTypeNetwork,
+			featureGateEnabled: true,
+			lbConf: elbv2gw.LoadBalancerConfiguration{
+				Spec: elbv2gw.LoadBalancerConfigurationSpec{
+					Tags: &map[string]string{"team": "backend"},
+					VPCEndpointService: &elbv2gw.VPCEndpointServiceConfiguration{
+						AllowedPrincipals:  []string{"arn:aws:iam::111122223333:root"},
+						AcceptanceRequired: awssdk.Bool(true),
+						PrivateDNSName:     awssdk.String("api.example.com"),
+					},
+				},
+			},
+			wantSpec: &ec2model.VPCEndpointServiceSpec{
+				AcceptanceRequired: true,
+				PrivateDNSName:     awssdk.String("api.example.com"),
+				AllowedPrincipals:  []string{"arn:aws:iam::111122223333:root"},
+				Tags:               map[string]string{"team": "backend"},
+			},
+		},
+		{
+			name:               "endpoint service configured with defaults",
+			lbType:             elbv2model.LoadBalancerTypeNetwork,
+			featureGateEnabled: true,
+			lbConf: elbv2gw.LoadBalancerConfiguration{
+				Spec: elbv2gw.LoadBalancerConfigurationSpec{
+					VPCEndpointService: &elbv2gw.VPCEndpointServiceConfiguration{
+						PrivateDNSName: awssdk.String(""),
+					},
+				},
+			},
+			wantSpec: &ec2model.VPCEndpointServiceSpec{
+				Tags: map[string]string{},
+			},
+		},
+		{
+			name:               "endpoint service on application load balancer",
+			lbType:             elbv2model.LoadBalancerTypeApplication,
+			featureGateEnabled: true,
+			lbConf: elbv2gw.LoadBalancerConfiguration{
+				Spec: elbv2gw.LoadBalancerConfigurationSpec{
+					VPCEndpointService: &elbv2gw.VPCEndpointServiceConfiguration{},
+				},
+			},
+			wantErr: "vpcEndpointService is only supported for Network Load Balancer gateways",
+		},
+		{
+			name:   "feature gate disabled",
+			lbType: elbv2model.LoadBalancerTypeNetwork,
+			lbConf: elbv2gw.LoadBalancerConfiguration{
+				Spec: elbv2gw.LoadBalancerConfigurationSpec{
+					VPCEndpointService: &elbv2gw.VPCEndpointServiceConfiguration{},
+				},
+			},
+			wantErr: "VPC endpoint services cannot be managed unless the VPCEndpointServiceManagement feature gate is enabled",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			featureGates := config.NewFeatureGates()
+			if tt.featureGateEnabled {
+				featureGates.Enable(config.VPCEndpointServiceManagement)
+			}
+			builder := &baseModelBuilder{
+				loadBalancerType: tt.lbType,
+				featureGates:     featureGates,
+				gwTagHelper:      newTagHelper(sets.New[string](), nil, false),
+			}
+			stack := core.NewDefaultStack(core.StackID{Namespace: "ns", Name: "gw"})
+			lb := elbv2model.NewLoadBalancer(stack, "LoadBalancer", elbv2model.LoadBalancerSpec{})
+			err := builder.buildVPCEndpointService(stack, lb, tt.lbConf)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			var resESs []*ec2model.VPCEndpointService
+			stack.ListResources(&resESs)
+			if tt.wantSpec == nil {
+				assert.Empty(t, resESs)
+				return
+			}
+			assert.Len(t, resESs, 1)
+			resES := resESs[0]
+			assert.Equal(t, []core.Resource{lb}, resES.Spec.NetworkLoadBalancerARN.Dependencies())
+			assert.Equal(t, tt.wantSpec.AcceptanceRequired, resES.Spec.AcceptanceRequired)
+			assert.Equal(t, tt.wantSpec.PrivateDNSName, resES.Spec.PrivateDNSName)
+			assert.Equal(t, tt.wantSpec.AllowedPrincipals, resES.Spec.AllowedPrincipals)
+			assert.Equal(t, tt.wantSpec.Tags, resES.Spec.Tags)
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package subnet
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"sort"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/services"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/networking"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+const (
+	eipAllocationIDPrefix = "eipalloc-"
+)
+
+// AddressError is returned when the addresses requested in the Gateway spec cannot be assigned to the load balancer.
+// The Reason is reported as the reason of the Gateway Programmed condition, or Accepted condition for UnsupportedAddress.
+type AddressError struct {
+	Reason  gwv1.GatewayConditionReason
+	Message string
+}
+
+func (e *AddressError) Error() string {
+	return fmt.Sprintf("unable to assign gateway addresses: %v", e.Message)
+}
+
+func newAddressError(reason gwv1.GatewayConditionReason, format string, args ...interface{}) *AddressError {
+	return &AddressError{
+		Reason:  reason,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+// GatewayAddressMutator assigns the addresses requested in the Gateway spec to the subnets of the load balancer.
+type GatewayAddressMutator interface {
+	Mutate(ctx context.Context, elbSubnets []*elbv2model.SubnetMapping, ec2Subnets []ec2types.Subnet, addresses []gwv1.GatewaySpecAddress,
+		scheme elbv2model.LoadBalancerScheme, ipAddressType elbv2model.IPAddressType) error
+}
+
+type gatewayAddressMutator struct {
+	ec2Client services.EC2
+
+	// networking.GetSubnetAssociatedIPv4CIDRs(ec2Subnet)
+	ipv4PrefixResolver func(subnet ec2types.Subnet) ([]netip.Prefix, error)
+
+	// networking.GetSubnetAssociatedIPv6CIDRs(ec2Subnet)
+	ipv6PrefixResolver func(subnet ec2types.Subnet) ([]netip.Prefix, error)
+
+	// networking.FilterIPsWithinCIDRs([]netip.Addr{ipAddress}, subnetCIDRs)
+	ipCidrFilter func(ips []netip.Addr, cidrs []netip.Prefix) []netip.Addr
+}
+
+func NewGatewayAddressMutator(ec2Client services.EC2) GatewayAddressMutator {
+	return &gatewayAddressMutator{
+		ec2Client:          ec2Client,
+		ipv4PrefixResolver: networking.GetSubnetAssociatedIPv4CIDRs,
+		ipv6PrefixResolver: networking.GetSubnetAssociatedIPv6CIDRs,
+		ipCidrFilter:       networking.FilterIPsWithinCIDRs,
+	}
+}
+
+// Mutate maps the Gateway addresses onto the subnet mappings:
+//   - NamedAddress is the allocation ID of an Elastic IP address, for internet-facing load balancers.
+//   - IPv4 IPAddress is the public IP of an Elastic IP address for internet-facing load balancers, or a private IPv4 address for internal load balancers.
+//   - IPv6 IPAddress is an IPv6 address, for dualstack load balancers.
+//
+// Elastic IP addresses are assigned in order to the subnets sorted by availability zone, so the assignment doesn't depend on the order subnets are resolved in,
+// while private IPv4 and IPv6 addresses are assigned to the subnet they belong to.
+func (mutator *gatewayAddressMutator) Mutate(ctx context.Context, elbSubnets []*elbv2model.SubnetMapping, ec2Subnets []ec2types.Subnet, addresses []gwv1.GatewaySpecAddress,
+	scheme elbv2model.LoadBalancerScheme, ipAddressType elbv2model.IPAddressType) error {
+	if len(addresses) == 0 {
+		return nil
+	}
+
+	var eipAllocations []string
+	var eipPublicIPs []string
+	var privateIPv4Addrs []netip.Addr
+	var ipv6Addrs []netip.Addr
+	for _, address := range addresses {
+		addressType := gwv1.IPAddressType
+		if address.Type != nil {
+			addressType = *address.Type
+		}
+		if address.Value == "" {
+			return newAddressError(gwv1.GatewayReasonAddressNotAssigned, "addresses of type %v must specify a value, dynamic assignment isn't supported", addressType)
+		}
+
+		switch addressType {
+		case gwv1.NamedAddressType:
+			if !strings.HasPrefix(address.Value, eipAllocationIDPrefix) {
+				return newAddressError(gwv1.GatewayReasonAddressNotUsable, "named address %v must be an Elastic IP allocation ID", address.Value)
+			}
+			if scheme != elbv2model.LoadBalancerSchemeInternetFacing {
+				return newAddressError(gwv1.GatewayReasonAddressNotUsable, "Elastic IP allocation %v can only be used for internet facing load balancers", address.Value)
+			}
+			eipAllocations = append(eipAllocations, address.Value)
+		case gwv1.IPAddressType:
+			ipAddress, err := netip.ParseAddr(address.Value)
+			if err != nil {
+				return newAddressError(gwv1.GatewayReasonAddressNotUsable, "address %v must be a valid IP address", address.Value)
+			}
+			switch {
+			case ipAddress.Is6():
+				if ipAddressType == elbv2model.IPAddressTypeIPV4 {
+					return newAddressError(gwv1.GatewayReasonAddressNotUsable, "IPv6 address %v can only be used for dualstack load balancers", address.Value)
+				}
+				ipv6Addrs = append(ipv6Addrs, ipAddress)
+			case scheme == elbv2model.LoadBalancerSchemeInternetFacing:
+				eipPublicIPs = append(eipPublicIPs, address.Value)
+			default:
+				privateIPv4Addrs = append(privateIPv4Addrs, ipAddress)
+			}
+		default:
+			return newAddressError(gwv1.GatewayReasonUnsupportedAddress, "address type %v is not supported", addressType)
+		}
+	}
+
+	if len(eipPublicIPs) != 0 {
+		allocations, err := mutator.resolveEIPAllocations(ctx, eipPublicIPs)
+		if err != nil {
+			return err
+		}
+		eipAllocations = append(eipAllocations, allocations...)
+	}
+	if len(eipAllocations) != 0 {
+		if len(eipAllocations) != len(elbSubnets) {
+			return newAddressError(gwv1.GatewayReasonAddressNotUsable, "expect one Elastic IP address for each of the %v subnets, got %v", len(elbSubnets), len(eipAllocations))
+		}
+		for i, subnetIndex := range sortSubnetIndexesByAvailabilityZone(ec2Subnets) {
+			elbSubnets[subnetIndex].AllocationID = awssdk.String(eipAllocations[i])
+		}
+	}
+
+	if err := mutator.assignAddressesWithinSubnets(elbSubnets, ec2Subnets, privateIPv4Addrs, mutator.ipv4PrefixResolver,
+		func(elbSubnet *elbv2model.SubnetMapping, ipAddress string) {
+			elbSubnet.PrivateIPv4Address = awssdk.String(ipAddress)
+		}); err != nil {
+		return err
+	}
+	return mutator.assignAddressesWithinSubnets(elbSubnets, ec2Subnets, ipv6Addrs, mutator.ipv6PrefixResolver,
+		func(elbSubnet *elbv2model.SubnetMapping, ipAddress string) {
+			elbSubnet.IPv6Address = awssdk.String(ipAddress)
+		})
+}
+
+// resolveEIPAllocations resolves the allocation IDs of the Elastic IP addresses with the public IPs.
+func (mutator *gatewayAddressMutator) resolveEIPAllocations(ctx context.Context, publicIPs []string) ([]string, error) {
+	resp, err := mutator.ec2Client.DescribeAddressesWithContext(ctx, &ec2sdk.DescribeAddressesInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   awssdk.String("public-ip"),
+				Values: publicIPs,
+			},
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe Elastic IP addresses")
+	}
+	allocationIDByPublicIP := make(map[string]string, len(resp.Addresses))
+	for _, eip := range resp.Addresses {
+		allocationIDByPublicIP[awssdk.ToString(eip.PublicIp)] = awssdk.ToString(eip.AllocationId)
+	}
+	allocations := make([]string, 0, len(publicIPs))
+	for _, publicIP := range publicIPs {
+		allocationID, ok := allocationIDByPublicIP[publicIP]
+		if !ok || allocationID == "" {
+			return nil, newAddressError(gwv1.GatewayReasonAddressNotUsable, "address %v is not an Elastic IP address of the account", publicIP)
+		}
+		allocations = append(allocations, allocationID)
+	}
+	return allocations, nil
+}
+
+// sortSubnetIndexesByAvailabilityZone returns the indexes of subnets sorted by their availability zone.
+func sortSubnetIndexesByAvailabilityZone(ec2Subnets []ec2types.Subnet) []int {
+	subnetIndexes := make([]int, 0, len(ec2Subnets))
+	for i := range ec2Subnets {
+		subnetIndexes = append(subnetIndexes, i)
+	}
+	sort.SliceStable(subnetIndexes, func(i, j int) bool {
+		return awssdk.ToString(ec2Subnets[subnetIndexes[i]].AvailabilityZone) < awssdk.ToString(ec2Subnets[subnetIndexes[j]].AvailabilityZone)
+	})
+	return subnetIndexes
+}
+
+// assignAddressesWithinSubnets assigns each address to the subnet it belongs to, every subnet gets at most one address.
+func (mutator *gatewayAddressMutator) assignAddressesWithinSubnets(elbSubnets []*elbv2model.SubnetMapping, ec2Subnets []ec2types.Subnet, ipAddrs []netip.Addr,
+	prefixResolver func(subnet ec2types.Subnet) ([]netip.Prefix, error), assign func(elbSubnet *elbv2model.SubnetMapping, ipAddress string)) error {
+	if len(ipAddrs) == 0 {
+		return nil
+	}
+	assignedAddrs := make(map[netip.Addr]bool, len(ipAddrs))
+	for i, elbSubnet := range elbSubnets {
+		ec2Subnet := ec2Subnets[i]
+		subnetCIDRs, err := prefixResolver(ec2Subnet)
+		if err != nil {
+			return err
+		}
+		addrsWithinSubnet := mutator.ipCidrFilter(ipAddrs, subnetCIDRs)
+		if len(addrsWithinSubnet) == 0 {
+			continue
+		}
+		if len(addrsWithinSubnet) > 1 {
+			return newAddressError(gwv1.GatewayReasonAddressNotUsable, "expect at most one address for subnet %v, got %v", awssdk.ToString(ec2Subnet.SubnetId), addrsWithinSubnet)
+		}
+		assign(elbSubnet, addrsWithinSubnet[0].String())
+		assignedAddrs[addrsWithinSubnet[0]] = true
+	}
+	for _, ipAddr := range ipAddrs {
+		if !assignedAddrs[ipAddr] {
+			return newAddressError(gwv1.GatewayReasonAddressNotUsable, "address %v doesn't belong to any subnet of the load balancer", ipAddr.String())
+		}
+	}
+	return nil
+}
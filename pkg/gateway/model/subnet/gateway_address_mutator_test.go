@@ -0,0 +1,293 @@
+package subnet
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/services"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func Test_GatewayAddressMutator(t *testing.T) {
+	type describeAddressesCall struct {
+		publicIPs []string
+		addresses []ec2types.Address
+	}
+	namedAddress := gwv1.NamedAddressType
+	hostnameAddress := gwv1.HostnameAddressType
+
+	testCases := []struct {
+		name                  string
+		addresses             []gwv1.GatewaySpecAddress
+		scheme                elbv2model.LoadBalancerScheme
+		ipAddressType         elbv2model.IPAddressType
+		describeAddressesCall *describeAddressesCall
+		// reverseSubnets resolves the subnets of the load balancer in reverse availability zone order.
+		reverseSubnets bool
+
+		expectedSubnets []*elbv2model.SubnetMapping
+		expectedErr     *AddressError
+	}{
+		{
+			name: "no addresses",
+			expectedSubnets: []*elbv2model.SubnetMapping{
+				{SubnetID: "subnet-1"}, {SubnetID: "subnet-2"},
+			},
+		},
+		{
+			name: "named addresses are eip allocations",
+			addresses: []gwv1.GatewaySpecAddress{
+				{Type: &namedAddress, Value: "eipalloc-1"},
+				{Type: &namedAddress, Value: "eipalloc-2"},
+			},
+			scheme:        elbv2model.LoadBalancerSchemeInternetFacing,
+			ipAddressType: elbv2model.IPAddressTypeIPV4,
+			expectedSubnets: []*elbv2model.SubnetMapping{
+				{SubnetID: "subnet-1", AllocationID: awssdk.String("eipalloc-1")},
+				{SubnetID: "subnet-2", AllocationID: awssdk.String("eipalloc-2")},
+			},
+		},
+		{
+			name: "named addresses are assigned by availability zone regardless of subnet order",
+			addresses: []gwv1.GatewaySpecAddress{
+				{Type: &namedAddress, Value: "eipalloc-1"},
+				{Type: &namedAddress, Value: "eipalloc-2"},
+			},
+			scheme:         elbv2model.LoadBalancerSchemeInternetFacing,
+			ipAddressType:  elbv2model.IPAddressTypeIPV4,
+			reverseSubnets: true,
+			expectedSubnets: []*elbv2model.SubnetMapping{
+				{SubnetID: "subnet-2", AllocationID: awssdk.String("eipalloc-2")},
+				{SubnetID: "subnet-1", AllocationID: awssdk.String("eipalloc-1")},
+			},
+		},
+		{
+			name: "public ip addresses are resolved to eip allocations",
+			addresses: []gwv1.GatewaySpecAddress{
+				{Value: "3.3.3.3"},
+				{Value: "4.4.4.4"},
+			},
+			scheme:        elbv2model.LoadBalancerSchemeInternetFacing,
+			ipAddressType: elbv2model.IPAddressTypeIPV4,
+			describeAddressesCall: &describeAddressesCall{
+				publicIPs: []string{"3.3.3.3", "4.4.4.4"},
+				addresses: []ec2types.Address{
+					{PublicIp: awssdk.String("4.4.4.4"), AllocationId: awssdk.String("eipalloc-4")},
+					{PublicIp: awssdk.String("3.3.3.3"), AllocationId: awssdk.String("eipalloc-3")},
+				},
+			},
+			expectedSubnets: []*elbv2model.SubnetMapping{
+				{SubnetID: "subnet-1", AllocationID: awssdk.String("eipalloc-3")},
+				{SubnetID: "subnet-2", AllocationID: awssdk.String("eipalloc-4")},
+			},
+		},
+		{
+			name: "public ip address that isn't an eip",
+			addresses: []gwv1.GatewaySpecAddress{
+				{Value: "3.3.3.3"},
+				{Value: "4.4.4.4"},
+			},
+			scheme:        elbv2model.LoadBalancerSchemeInternetFacing,
+			ipAddressType: elbv2model.IPAddressTypeIPV4,
+			describeAddressesCall: &describeAddressesCall{
+				publicIPs: []string{"3.3.3.3", "4.4.4.4"},
+				addresses: []ec2types.Address{
+					{PublicIp: awssdk.String("3.3.3.3"), AllocationId: awssdk.String("eipalloc-3")},
+				},
+			},
+			expectedErr: &AddressError{
+				Reason:  gwv1.GatewayReasonAddressNotUsable,
+				Message: "address 4.4.4.4 is not an Elastic IP address of the account",
+			},
+		},
+		{
+			name: "eip count mismatch subnet count",
+			addresses: []gwv1.GatewaySpecAddress{
+				{Type: &namedAddress, Value: "eipalloc-1"},
+			},
+			scheme:        elbv2model.LoadBalancerSchemeInternetFacing,
+			ipAddressType: elbv2model.IPAddressTypeIPV4,
+			expectedErr: &AddressError{
+				Reason:  gwv1.GatewayReasonAddressNotUsable,
+				Message: "expect one Elastic IP address for each of the 2 subnets, got 1",
+			},
+		},
+		{
+			name: "named address for internal load balancer",
+			addresses: []gwv1.GatewaySpecAddress{
+				{Type: &namedAddress, Value: "eipalloc-1"},
+			},
+			scheme:        elbv2model.LoadBalancerSchemeInternal,
+			ipAddressType: elbv2model.IPAddressTypeIPV4,
+			expectedErr: &AddressError{
+				Reason:  gwv1.GatewayReasonAddressNotUsable,
+				Message: "Elastic IP allocation eipalloc-1 can only be used for internet facing load balancers",
+			},
+		},
+		{
+			name: "private ipv4 addresses are assigned to the subnet they belong to",
+			addresses: []gwv1.GatewaySpecAddress{
+				{Value: "10.0.1.10"},
+				{Value: "10.0.0.10"},
+			},
+			scheme:        elbv2model.LoadBalancerSchemeInternal,
+			ipAddressType: elbv2model.IPAddressTypeIPV4,
+			expectedSubnets: []*elbv2model.SubnetMapping{
+				{SubnetID: "subnet-1", PrivateIPv4Address: awssdk.String("10.0.0.10")},
+				{SubnetID: "subnet-2", PrivateIPv4Address: awssdk.String("10.0.1.10")},
+			},
+		},
+		{
+			name: "private ipv4 address outside of subnets",
+			addresses: []gwv1.GatewaySpecAddress{
+				{Value: "10.0.2.10"},
+			},
+			scheme:        elbv2model.LoadBalancerSchemeInternal,
+			ipAddressType: elbv2model.IPAddressTypeIPV4,
+			expectedErr: &AddressError{
+				Reason:  gwv1.GatewayReasonAddressNotUsable,
+				Message: "address 10.0.2.10 doesn't belong to any subnet of the load balancer",
+			},
+		},
+		{
+			name: "multiple private ipv4 addresses within one subnet",
+			addresses: []gwv1.GatewaySpecAddress{
+				{Value: "10.0.0.10"},
+				{Value: "10.0.0.11"},
+			},
+			scheme:        elbv2model.LoadBalancerSchemeInternal,
+			ipAddressType: elbv2model.IPAddressTypeIPV4,
+			expectedErr: &AddressError{
+				Reason:  gwv1.GatewayReasonAddressNotUsable,
+				Message: "expect at most one address for subnet subnet-1, got [10.0.0.10 10.0.0.11]",
+			},
+		},
+		{
+			name: "ipv6 addresses for dualstack load balancer",
+			addresses: []gwv1.GatewaySpecAddress{
+				{Value: "10.0.0.10"},
+				{Value: "2600:1f13:837:8501::10"},
+			},
+			scheme:        elbv2model.LoadBalancerSchemeInternal,
+			ipAddressType: elbv2model.IPAddressTypeDualStack,
+			expectedSubnets: []*elbv2model.SubnetMapping{
+				{SubnetID: "subnet-1", PrivateIPv4Address: awssdk.String("10.0.0.10")},
+				{SubnetID: "subnet-2", IPv6Address: awssdk.String("2600:1f13:837:8501::10")},
+			},
+		},
+		{
+			name: "ipv6 address for ipv4 load balancer",
+			addresses: []gwv1.GatewaySpecAddress{
+				{Value: "2600:1f13:837:8501::10"},
+			},
+			scheme:        elbv2model.LoadBalancerSchemeInternal,
+			ipAddressType: elbv2model.IPAddressTypeIPV4,
+			expectedErr: &AddressError{
+				Reason:  gwv1.GatewayReasonAddressNotUsable,
+				Message: "IPv6 address 2600:1f13:837:8501::10 can only be used for dualstack load balancers",
+			},
+		},
+		{
+			name: "invalid ip address",
+			addresses: []gwv1.GatewaySpecAddress{
+				{Value: "10.0.0"},
+			},
+			scheme:        elbv2model.LoadBalancerSchemeInternal,
+			ipAddressType: elbv2model.IPAddressTypeIPV4,
+			expectedErr: &AddressError{
+				Reason:  gwv1.GatewayReasonAddressNotUsable,
+				Message: "address 10.0.0 must be a valid IP address",
+			},
+		},
+		{
+			name: "address without value",
+			addresses: []gwv1.GatewaySpecAddress{
+				{Value: ""},
+			},
+			scheme:        elbv2model.LoadBalancerSchemeInternal,
+			ipAddressType: elbv2model.IPAddressTypeIPV4,
+			expectedErr: &AddressError{
+				Reason:  gwv1.GatewayReasonAddressNotAssigned,
+				Message: "addresses of type IPAddress must specify a value, dynamic assignment isn't supported",
+			},
+		},
+		{
+			name: "hostname address isn't supported",
+			addresses: []gwv1.GatewaySpecAddress{
+				{Type: &hostnameAddress, Value: "example.com"},
+			},
+			scheme:        elbv2model.LoadBalancerSchemeInternal,
+			ipAddressType: elbv2model.IPAddressTypeIPV4,
+			expectedErr: &AddressError{
+				Reason:  gwv1.GatewayReasonUnsupportedAddress,
+				Message: "address type Hostname is not supported",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ec2Client := services.NewMockEC2(ctrl)
+			if tc.describeAddressesCall != nil {
+				ec2Client.EXPECT().DescribeAddressesWithContext(gomock.Any(), &ec2sdk.DescribeAddressesInput{
+					Filters: []ec2types.Filter{
+						{
+							Name:   awssdk.String("public-ip"),
+							Values: tc.describeAddressesCall.publicIPs,
+						},
+					},
+				}).Return(&ec2sdk.DescribeAddressesOutput{Addresses: tc.describeAddressesCall.addresses}, nil)
+			}
+
+			elbSubnets := []*elbv2model.SubnetMapping{
+				{SubnetID: "subnet-1"},
+				{SubnetID: "subnet-2"},
+			}
+			ec2Subnets := []ec2types.Subnet{
+				{
+					SubnetId:         awssdk.String("subnet-1"),
+					AvailabilityZone: awssdk.String("us-west-2a"),
+					CidrBlock:        awssdk.String("10.0.0.0/24"),
+				},
+				{
+					SubnetId:         awssdk.String("subnet-2"),
+					AvailabilityZone: awssdk.String("us-west-2b"),
+					CidrBlock:        awssdk.String("10.0.1.0/24"),
+					Ipv6CidrBlockAssociationSet: []ec2types.SubnetIpv6CidrBlockAssociation{
+						{
+							Ipv6CidrBlock: awssdk.String("2600:1f13:837:8501::/64"),
+							Ipv6CidrBlockState: &ec2types.SubnetCidrBlockState{
+								State: ec2types.SubnetCidrBlockStateCodeAssociated,
+							},
+						},
+					},
+				},
+			}
+
+			if tc.reverseSubnets {
+				elbSubnets[0], elbSubnets[1] = elbSubnets[1], elbSubnets[0]
+				ec2Subnets[0], ec2Subnets[1] = ec2Subnets[1], ec2Subnets[0]
+			}
+
+			mutator := NewGatewayAddressMutator(ec2Client)
+			err := mutator.Mutate(context.Background(), elbSubnets, ec2Subnets, tc.addresses, tc.scheme, tc.ipAddressType)
+			if tc.expectedErr != nil {
+				var addressErr *AddressError
+				assert.ErrorAs(t, err, &addressErr)
+				assert.Equal(t, tc.expectedErr, addressErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedSubnets, elbSubnets)
+		})
+	}
+}
@@ -0,0 +1,316 @@
+package multicluster
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	rgttypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/v3/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ServiceExportLabelKey is the label on the TargetGroupBindings that bind an exported Service to multi-cluster target groups.
+	ServiceExportLabelKey = "gateway.k8s.aws/service-export"
+)
+
+// ServiceExportBinder binds the endpoints of exported Services to the multi-cluster target groups of their ServiceImport.
+type ServiceExportBinder interface {
+	// Bind ensures a multi-cluster TargetGroupBinding for the Service of the ServiceExport in every target group that
+	// routes to its ServiceImport, and removes the TargetGroupBindings of target groups that no longer do.
+	Bind(ctx context.Context, serviceExport *unstructured.Unstructured) error
+}
+
+// NewDefaultServiceExportBinder constructs new defaultServiceExportBinder.
+func NewDefaultServiceExportBinder(k8sClient client.Client, rgt services.RGT, elbv2Client services.ELBV2, allowedImportingClusters []string, logger logr.Logger) *defaultServiceExportBinder {
+	return &defaultServiceExportBinder{
+		k8sClient:                k8sClient,
+		rgt:                      rgt,
+		elbv2Client:              elbv2Client,
+		allowedImportingClusters: allowedImportingClusters,
+		logger:                   logger,
+	}
+}
+
+var _ ServiceExportBinder = &defaultServiceExportBinder{}
+
+type defaultServiceExportBinder struct {
+	k8sClient   client.Client
+	rgt         services.RGT
+	elbv2Client services.ELBV2
+	// allowedImportingClusters are the clusters whose multi-cluster target groups the exported Services can be bound to.
+	allowedImportingClusters []string
+	logger                   logr.Logger
+}
+
+// multiClusterTargetGroup is a target group that routes to a port of a ServiceImport.
+type multiClusterTargetGroup struct {
+	arn  string
+	name string
+	port int32
+}
+
+func (b *defaultServiceExportBinder) Bind(ctx context.Context, serviceExport *unstructured.Unstructured) error {
+	serviceKey := types.NamespacedName{Namespace: serviceExport.GetNamespace(), Name: serviceExport.GetName()}
+	desiredTGBs, err := b.buildDesiredTargetGroupBindings(ctx, serviceExport, serviceKey)
+	if err != nil {
+		return err
+	}
+
+	currentTGBList := &elbv2api.TargetGroupBindingList{}
+	if err := b.k8sClient.List(ctx, currentTGBList, client.InNamespace(serviceKey.Namespace),
+		client.MatchingLabels{ServiceExportLabelKey: serviceKey.Name}); err != nil {
+		return errors.Wrap(err, "failed to list TargetGroupBindings")
+	}
+	currentTGBByName := make(map[string]*elbv2api.TargetGroupBinding, len(currentTGBList.Items))
+	for i := range currentTGBList.Items {
+		currentTGBByName[currentTGBList.Items[i].Name] = &currentTGBList.Items[i]
+	}
+
+	for _, desiredTGB := range desiredTGBs {
+		currentTGB, exists := currentTGBByName[desiredTGB.Name]
+		delete(currentTGBByName, desiredTGB.Name)
+		if !exists {
+			if err := b.k8sClient.Create(ctx, desiredTGB); err != nil {
+				return errors.Wrapf(err, "failed to create TargetGroupBinding %v", k8s.NamespacedName(desiredTGB))
+			}
+			b.logger.Info("created multi-cluster TargetGroupBinding", "targetGroupBinding", k8s.NamespacedName(desiredTGB), "arn", desiredTGB.Spec.TargetGroupARN)
+			continue
+		}
+		if equality.Semantic.DeepEqual(currentTGB.Spec.ServiceRef, desiredTGB.Spec.ServiceRef) &&
+			equality.Semantic.DeepEqual(currentTGB.Spec.Networking, desiredTGB.Spec.Networking) {
+			continue
+		}
+		oldTGB := currentTGB.DeepCopy()
+		currentTGB.Spec.ServiceRef = desiredTGB.Spec.ServiceRef
+		currentTGB.Spec.Networking = desiredTGB.Spec.Networking
+		if err := b.k8sClient.Patch(ctx, currentTGB, client.MergeFrom(oldTGB)); err != nil {
+			return errors.Wrapf(err, "failed to update TargetGroupBinding %v", k8s.NamespacedName(currentTGB))
+		}
+	}
+
+	for _, staleTGB := range currentTGBByName {
+		if err := b.k8sClient.Delete(ctx, staleTGB); err != nil {
+			if client.IgnoreNotFound(err) != nil {
+				return errors.Wrapf(err, "failed to delete TargetGroupBinding %v", k8s.NamespacedName(staleTGB))
+			}
+		}
+		b.logger.Info("deleted multi-cluster TargetGroupBinding", "targetGroupBinding", k8s.NamespacedName(staleTGB))
+	}
+	return nil
+}
+
+// buildDesiredTargetGroupBindings builds a TargetGroupBinding for each multi-cluster target group whose port is exposed by the exported Service.
+func (b *defaultServiceExportBinder) buildDesiredTargetGroupBindings(ctx context.Context, serviceExport *unstructured.Unstructured, serviceKey types.NamespacedName) ([]*elbv2api.TargetGroupBinding, error) {
+	svc := &corev1.Service{}
+	if err := b.k8sClient.Get(ctx, serviceKey, svc); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return nil, nil
+		}
+		return nil, err
+	}
+	targetGroups, err := b.discoverTargetGroups(ctx, serviceKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(targetGroups) == 0 {
+		return nil, nil
+	}
+	lbSecurityGroupsByTG, err := b.resolveLoadBalancerSecurityGroups(ctx, targetGroups)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerRef := metav1.OwnerReference{
+		APIVersion:         serviceExport.GetAPIVersion(),
+		Kind:               serviceExport.GetKind(),
+		Name:               serviceExport.GetName(),
+		UID:                serviceExport.GetUID(),
+		Controller:         awssdk.Bool(true),
+		BlockOwnerDeletion: awssdk.Bool(true),
+	}
+	targetType := elbv2api.TargetTypeIP
+	var desiredTGBs []*elbv2api.TargetGroupBinding
+	for _, tg := range targetGroups {
+		svcPort := findServicePort(svc, tg.port)
+		if svcPort == nil {
+			b.logger.Info("exported service doesn't expose the port of the multi-cluster target group",
+				"service", serviceKey, "port", tg.port, "arn", tg.arn)
+			continue
+		}
+		desiredTGBs = append(desiredTGBs, &elbv2api.TargetGroupBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:       serviceKey.Namespace,
+				Name:            tg.name,
+				Labels:          map[string]string{ServiceExportLabelKey: serviceKey.Name},
+				OwnerReferences: []metav1.OwnerReference{ownerRef},
+			},
+			Spec: elbv2api.TargetGroupBindingSpec{
+				TargetGroupARN: tg.arn,
+				TargetType:     &targetType,
+				ServiceRef: elbv2api.ServiceReference{
+					Name: serviceKey.Name,
+					Port: intstr.FromInt32(svcPort.Port),
+				},
+				Networking:              buildTargetGroupBindingNetworking(lbSecurityGroupsByTG[tg.arn], *svcPort),
+				MultiClusterTargetGroup: true,
+			},
+		})
+	}
+	return desiredTGBs, nil
+}
+
+// discoverTargetGroups finds the multi-cluster target groups tagged with the ServiceImport of the exported Service by the allowed importing clusters.
+func (b *defaultServiceExportBinder) discoverTargetGroups(ctx context.Context, serviceKey types.NamespacedName) ([]multiClusterTargetGroup, error) {
+	if len(b.allowedImportingClusters) == 0 {
+		return nil, nil
+	}
+	resources, err := b.rgt.GetResourcesAsList(ctx, &resourcegroupstaggingapi.GetResourcesInput{
+		ResourceTypeFilters: []string{services.ResourceTypeELBTargetGroup},
+		TagFilters: []rgttypes.TagFilter{
+			{
+				Key:    awssdk.String(ServiceImportTagKey),
+				Values: []string{serviceImportTagValue(serviceKey)},
+			},
+			{
+				Key:    awssdk.String(ServiceImportClusterTagKey),
+				Values: b.allowedImportingClusters,
+			},
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to discover target groups for ServiceImport %v", serviceKey)
+	}
+
+	var targetGroups []multiClusterTargetGroup
+	for _, resource := range resources {
+		arn := awssdk.ToString(resource.ResourceARN)
+		name, ok := targetGroupNameFromARN(arn)
+		if !ok {
+			b.logger.Info("ignoring multi-cluster target group with invalid ARN", "arn", arn)
+			continue
+		}
+		tags := services.ParseRGTTags(resource.Tags)
+		port, err := strconv.ParseInt(tags[ServiceImportPortTagKey], 10, 32)
+		if err != nil {
+			b.logger.Info("ignoring multi-cluster target group with invalid port tag", "arn", arn, "port", tags[ServiceImportPortTagKey])
+			continue
+		}
+		targetGroups = append(targetGroups, multiClusterTargetGroup{
+			arn:  arn,
+			name: name,
+			port: int32(port),
+		})
+	}
+	sort.Slice(targetGroups, func(i, j int) bool {
+		return targetGroups[i].arn < targetGroups[j].arn
+	})
+	return targetGroups, nil
+}
+
+// resolveLoadBalancerSecurityGroups returns the security groups of the load balancers that route to each target group.
+func (b *defaultServiceExportBinder) resolveLoadBalancerSecurityGroups(ctx context.Context, targetGroups []multiClusterTargetGroup) (map[string][]string, error) {
+	tgARNs := make([]string, 0, len(targetGroups))
+	for _, tg := range targetGroups {
+		tgARNs = append(tgARNs, tg.arn)
+	}
+	sdkTGs, err := b.elbv2Client.DescribeTargetGroupsAsList(ctx, &elbv2sdk.DescribeTargetGroupsInput{
+		TargetGroupArns: tgARNs,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe multi-cluster target groups")
+	}
+	lbARNs := sets.New[string]()
+	for _, sdkTG := range sdkTGs {
+		lbARNs.Insert(sdkTG.LoadBalancerArns...)
+	}
+	if len(lbARNs) == 0 {
+		return nil, nil
+	}
+	sdkLBs, err := b.elbv2Client.DescribeLoadBalancersAsList(ctx, &elbv2sdk.DescribeLoadBalancersInput{
+		LoadBalancerArns: sets.List(lbARNs),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe load balancers of multi-cluster target groups")
+	}
+	securityGroupsByLB := make(map[string][]string, len(sdkLBs))
+	for _, sdkLB := range sdkLBs {
+		securityGroupsByLB[awssdk.ToString(sdkLB.LoadBalancerArn)] = sdkLB.SecurityGroups
+	}
+
+	securityGroupsByTG := make(map[string][]string, len(sdkTGs))
+	for _, sdkTG := range sdkTGs {
+		securityGroups := sets.New[string]()
+		for _, lbARN := range sdkTG.LoadBalancerArns {
+			securityGroups.Insert(securityGroupsByLB[lbARN]...)
+		}
+		securityGroupsByTG[awssdk.ToString(sdkTG.TargetGroupArn)] = sets.List(securityGroups)
+	}
+	return securityGroupsByTG, nil
+}
+
+// buildTargetGroupBindingNetworking allows the security groups of the load balancers to access the target port of the Service.
+// Load balancers without security groups rely on the security groups of the pods to allow traffic.
+func buildTargetGroupBindingNetworking(lbSecurityGroups []string, svcPort corev1.ServicePort) *elbv2api.TargetGroupBindingNetworking {
+	if len(lbSecurityGroups) == 0 {
+		return nil
+	}
+	peers := make([]elbv2api.NetworkingPeer, 0, len(lbSecurityGroups))
+	for _, sgID := range lbSecurityGroups {
+		peers = append(peers, elbv2api.NetworkingPeer{
+			SecurityGroup: &elbv2api.SecurityGroup{GroupID: sgID},
+		})
+	}
+	protocol := elbv2api.NetworkingProtocolTCP
+	if svcPort.Protocol == corev1.ProtocolUDP {
+		protocol = elbv2api.NetworkingProtocolUDP
+	}
+	targetPort := svcPort.TargetPort
+	return &elbv2api.TargetGroupBindingNetworking{
+		Ingress: []elbv2api.NetworkingIngressRule{
+			{
+				From: peers,
+				Ports: []elbv2api.NetworkingPort{
+					{
+						Protocol: &protocol,
+						Port:     &targetPort,
+					},
+				},
+			},
+		},
+	}
+}
+
+func findServicePort(svc *corev1.Service, port int32) *corev1.ServicePort {
+	for i := range svc.Spec.Ports {
+		if svc.Spec.Ports[i].Port == port {
+			return &svc.Spec.Ports[i]
+		}
+	}
+	return nil
+}
+
+// targetGroupNameFromARN returns the name of a target group from its ARN, e.g. arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/my-tg/73e2d6bc24d8a067
+func targetGroupNameFromARN(arn string) (string, bool) {
+	parts := strings.Split(arn, "/")
+	if len(parts) != 3 || parts[1] == "" {
+		return "", false
+	}
+	return parts[1], true
+}
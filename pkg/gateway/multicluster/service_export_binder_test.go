@@ -0,0 +1,260 @@
+package multicluster
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	rgttypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/v3/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/testutils"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func Test_defaultServiceExportBinder_Bind(t *testing.T) {
+	tgARN1 := "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/k8s-tg-1/1111111111111111"
+	tgARN2 := "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/k8s-tg-2/2222222222222222"
+	tgARN3 := "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/k8s-tg-3/3333333333333333"
+	lbARN := "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/lb-1/1111111111111111"
+
+	serviceExport := NewServiceExport()
+	serviceExport.SetNamespace("default")
+	serviceExport.SetName("web")
+	serviceExport.SetUID("export-uid")
+
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web"},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{Name: "http", Protocol: corev1.ProtocolTCP, Port: 80, TargetPort: intstr.FromInt32(8080)},
+			},
+		},
+	}
+
+	tcp := elbv2api.NetworkingProtocolTCP
+	targetPort := intstr.FromInt32(8080)
+	ipTargetType := elbv2api.TargetTypeIP
+	newTGB := func(name string, arn string, networking *elbv2api.TargetGroupBindingNetworking) *elbv2api.TargetGroupBinding {
+		return &elbv2api.TargetGroupBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      name,
+				Labels:    map[string]string{ServiceExportLabelKey: "web"},
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion:         APIGroup + "/" + APIVersion,
+						Kind:               ServiceExportKind,
+						Name:               "web",
+						UID:                "export-uid",
+						Controller:         awssdk.Bool(true),
+						BlockOwnerDeletion: awssdk.Bool(true),
+					},
+				},
+			},
+			Spec: elbv2api.TargetGroupBindingSpec{
+				TargetGroupARN: arn,
+				TargetType:     &ipTargetType,
+				ServiceRef: elbv2api.ServiceReference{
+					Name: "web",
+					Port: intstr.FromInt32(80),
+				},
+				Networking:              networking,
+				MultiClusterTargetGroup: true,
+			},
+		}
+	}
+	lbNetworking := &elbv2api.TargetGroupBindingNetworking{
+		Ingress: []elbv2api.NetworkingIngressRule{
+			{
+				From: []elbv2api.NetworkingPeer{
+					{SecurityGroup: &elbv2api.SecurityGroup{GroupID: "sg-1"}},
+					{SecurityGroup: &elbv2api.SecurityGroup{GroupID: "sg-2"}},
+				},
+				Ports: []elbv2api.NetworkingPort{
+					{Protocol: &tcp, Port: &targetPort},
+				},
+			},
+		},
+	}
+	taggedTG := func(arn string, port string) rgttypes.ResourceTagMapping {
+		return rgttypes.ResourceTagMapping{
+			ResourceARN: awssdk.String(arn),
+			Tags: []rgttypes.Tag{
+				{Key: awssdk.String(ServiceImportTagKey), Value: awssdk.String("default/web")},
+				{Key: awssdk.String(ServiceImportPortTagKey), Value: awssdk.String(port)},
+				{Key: awssdk.String(ServiceImportClusterTagKey), Value: awssdk.String("cluster-a")},
+			},
+		}
+	}
+
+	allowedImportingClusters := []string{"cluster-a", "cluster-b"}
+
+	tests := []struct {
+		name                     string
+		svc                      *corev1.Service
+		allowedImportingClusters []string
+		existingTGBs             []*elbv2api.TargetGroupBinding
+		taggedTGs                []rgttypes.ResourceTagMapping
+		describedTGs             []elbv2types.TargetGroup
+		describedLBs             []elbv2types.LoadBalancer
+		wantTGBs                 []*elbv2api.TargetGroupBinding
+		wantDescribeLBs          bool
+	}{
+		{
+			name:                     "creates TargetGroupBindings for target groups of the exported service ports",
+			svc:                      svc,
+			allowedImportingClusters: allowedImportingClusters,
+			taggedTGs: []rgttypes.ResourceTagMapping{
+				taggedTG(tgARN1, "80"),
+				taggedTG(tgARN2, "443"),
+				taggedTG(tgARN3, "invalid"),
+			},
+			describedTGs: []elbv2types.TargetGroup{
+				{TargetGroupArn: awssdk.String(tgARN1), LoadBalancerArns: []string{lbARN}},
+				{TargetGroupArn: awssdk.String(tgARN2), LoadBalancerArns: []string{lbARN}},
+			},
+			describedLBs: []elbv2types.LoadBalancer{
+				{LoadBalancerArn: awssdk.String(lbARN), SecurityGroups: []string{"sg-2", "sg-1"}},
+			},
+			wantDescribeLBs: true,
+			wantTGBs: []*elbv2api.TargetGroupBinding{
+				newTGB("k8s-tg-1", tgARN1, lbNetworking),
+			},
+		},
+		{
+			name:                     "updates outdated and deletes stale TargetGroupBindings",
+			svc:                      svc,
+			allowedImportingClusters: allowedImportingClusters,
+			existingTGBs: []*elbv2api.TargetGroupBinding{
+				newTGB("k8s-tg-1", tgARN1, nil),
+				newTGB("k8s-tg-2", tgARN2, nil),
+			},
+			taggedTGs: []rgttypes.ResourceTagMapping{
+				taggedTG(tgARN1, "80"),
+			},
+			describedTGs: []elbv2types.TargetGroup{
+				{TargetGroupArn: awssdk.String(tgARN1), LoadBalancerArns: []string{lbARN}},
+			},
+			describedLBs: []elbv2types.LoadBalancer{
+				{LoadBalancerArn: awssdk.String(lbARN), SecurityGroups: []string{"sg-1", "sg-2"}},
+			},
+			wantDescribeLBs: true,
+			wantTGBs: []*elbv2api.TargetGroupBinding{
+				newTGB("k8s-tg-1", tgARN1, lbNetworking),
+			},
+		},
+		{
+			name:                     "target groups not attached to a load balancer don't get networking rules",
+			svc:                      svc,
+			allowedImportingClusters: allowedImportingClusters,
+			taggedTGs: []rgttypes.ResourceTagMapping{
+				taggedTG(tgARN1, "80"),
+			},
+			describedTGs: []elbv2types.TargetGroup{
+				{TargetGroupArn: awssdk.String(tgARN1)},
+			},
+			wantTGBs: []*elbv2api.TargetGroupBinding{
+				newTGB("k8s-tg-1", tgARN1, nil),
+			},
+		},
+		{
+			name:                     "deletes all TargetGroupBindings when the exported service doesn't exist",
+			allowedImportingClusters: allowedImportingClusters,
+			existingTGBs: []*elbv2api.TargetGroupBinding{
+				newTGB("k8s-tg-1", tgARN1, lbNetworking),
+			},
+		},
+		{
+			name: "deletes all TargetGroupBindings when no importing cluster is allowed",
+			svc:  svc,
+			existingTGBs: []*elbv2api.TargetGroupBinding{
+				newTGB("k8s-tg-1", tgARN1, lbNetworking),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			k8sClient := testutils.GenerateTestClient()
+			if tt.svc != nil {
+				assert.NoError(t, k8sClient.Create(ctx, tt.svc.DeepCopy()))
+			}
+			for _, tgb := range tt.existingTGBs {
+				assert.NoError(t, k8sClient.Create(ctx, tgb.DeepCopy()))
+			}
+
+			mockRGT := services.NewMockRGT(ctrl)
+			mockELBV2 := services.NewMockELBV2(ctrl)
+			if tt.svc != nil && len(tt.allowedImportingClusters) != 0 {
+				mockRGT.EXPECT().GetResourcesAsList(gomock.Any(), &resourcegroupstaggingapi.GetResourcesInput{
+					ResourceTypeFilters: []string{services.ResourceTypeELBTargetGroup},
+					TagFilters: []rgttypes.TagFilter{
+						{Key: awssdk.String(ServiceImportTagKey), Values: []string{"default/web"}},
+						{Key: awssdk.String(ServiceImportClusterTagKey), Values: tt.allowedImportingClusters},
+					},
+				}).Return(tt.taggedTGs, nil)
+				mockELBV2.EXPECT().DescribeTargetGroupsAsList(gomock.Any(), gomock.Any()).Return(tt.describedTGs, nil)
+			}
+			if tt.wantDescribeLBs {
+				mockELBV2.EXPECT().DescribeLoadBalancersAsList(gomock.Any(), &elbv2sdk.DescribeLoadBalancersInput{
+					LoadBalancerArns: []string{lbARN},
+				}).Return(tt.describedLBs, nil)
+			}
+
+			binder := NewDefaultServiceExportBinder(k8sClient, mockRGT, mockELBV2, tt.allowedImportingClusters, logr.Discard())
+			assert.NoError(t, binder.Bind(ctx, serviceExport))
+
+			tgbList := &elbv2api.TargetGroupBindingList{}
+			assert.NoError(t, k8sClient.List(ctx, tgbList, client.InNamespace("default")))
+			assert.Len(t, tgbList.Items, len(tt.wantTGBs))
+			for i, wantTGB := range tt.wantTGBs {
+				gotTGB := tgbList.Items[i]
+				assert.Equal(t, wantTGB.Name, gotTGB.Name)
+				assert.Equal(t, wantTGB.Labels, gotTGB.Labels)
+				assert.Equal(t, wantTGB.OwnerReferences, gotTGB.OwnerReferences)
+				assert.Equal(t, wantTGB.Spec, gotTGB.Spec)
+			}
+		})
+	}
+}
+
+func Test_targetGroupNameFromARN(t *testing.T) {
+	tests := []struct {
+		name     string
+		arn      string
+		wantName string
+		wantOK   bool
+	}{
+		{
+			name:     "valid target group ARN",
+			arn:      "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/my-tg/73e2d6bc24d8a067",
+			wantName: "my-tg",
+			wantOK:   true,
+		},
+		{
+			name: "invalid target group ARN",
+			arn:  "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, ok := targetGroupNameFromARN(tt.arn)
+			assert.Equal(t, tt.wantName, name)
+			assert.Equal(t, tt.wantOK, ok)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package multicluster
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// APIGroup is the API group of the Multi-Cluster Services API.
+	APIGroup = "multicluster.x-k8s.io"
+	// APIVersion is the version of the Multi-Cluster Services API.
+	APIVersion = "v1alpha1"
+
+	ServiceImportKind = "ServiceImport"
+	ServiceExportKind = "ServiceExport"
+
+	// ServiceImportTypeHeadless is the type of ServiceImports without a clusterset IP.
+	ServiceImportTypeHeadless = "Headless"
+
+	// ServiceImportTagKey is the tag on a multi-cluster target group that identifies the ServiceImport it routes to, as namespace/name.
+	ServiceImportTagKey = "gateway.k8s.aws/service-import"
+	// ServiceImportPortTagKey is the tag on a multi-cluster target group that identifies the port of the ServiceImport it routes to.
+	ServiceImportPortTagKey = "gateway.k8s.aws/service-import-port"
+	// ServiceImportClusterTagKey is the tag on a multi-cluster target group that identifies the cluster importing the ServiceImport.
+	ServiceImportClusterTagKey = "gateway.k8s.aws/service-import-cluster"
+)
+
+var (
+	ServiceImportGVK = schema.GroupVersionKind{Group: APIGroup, Version: APIVersion, Kind: ServiceImportKind}
+	ServiceExportGVK = schema.GroupVersionKind{Group: APIGroup, Version: APIVersion, Kind: ServiceExportKind}
+)
+
+// ServiceImportPort is a port of a ServiceImport.
+type ServiceImportPort struct {
+	Name        string
+	Protocol    corev1.Protocol
+	AppProtocol *string
+	Port        int32
+}
+
+// NewServiceImport constructs an empty ServiceImport object to be filled by the k8s client.
+func NewServiceImport() *unstructured.Unstructured {
+	serviceImport := &unstructured.Unstructured{}
+	serviceImport.SetGroupVersionKind(ServiceImportGVK)
+	return serviceImport
+}
+
+// NewServiceExport constructs an empty ServiceExport object to be filled by the k8s client.
+func NewServiceExport() *unstructured.Unstructured {
+	serviceExport := &unstructured.Unstructured{}
+	serviceExport.SetGroupVersionKind(ServiceExportGVK)
+	return serviceExport
+}
+
+// NewServiceExportList constructs an empty ServiceExport list to be filled by the k8s client.
+func NewServiceExportList() *unstructured.UnstructuredList {
+	serviceExportList := &unstructured.UnstructuredList{}
+	serviceExportList.SetGroupVersionKind(ServiceExportGVK.GroupVersion().WithKind(ServiceExportKind + "List"))
+	return serviceExportList
+}
+
+// GetServiceImportType returns the spec.type of a ServiceImport.
+func GetServiceImportType(serviceImport *unstructured.Unstructured) string {
+	importType, _, _ := unstructured.NestedString(serviceImport.Object, "spec", "type")
+	return importType
+}
+
+// GetServiceImportPorts returns the spec.ports of a ServiceImport.
+func GetServiceImportPorts(serviceImport *unstructured.Unstructured) ([]ServiceImportPort, error) {
+	rawPorts, _, err := unstructured.NestedSlice(serviceImport.Object, "spec", "ports")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse ServiceImport ports")
+	}
+	ports := make([]ServiceImportPort, 0, len(rawPorts))
+	for _, rawPort := range rawPorts {
+		portObj, ok := rawPort.(map[string]interface{})
+		if !ok {
+			return nil, errors.Errorf("failed to parse ServiceImport port: %v", rawPort)
+		}
+		port, _, err := unstructured.NestedInt64(portObj, "port")
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse ServiceImport port")
+		}
+		name, _, _ := unstructured.NestedString(portObj, "name")
+		protocol, _, _ := unstructured.NestedString(portObj, "protocol")
+		if protocol == "" {
+			protocol = string(corev1.ProtocolTCP)
+		}
+		var appProtocol *string
+		if rawAppProtocol, found, _ := unstructured.NestedString(portObj, "appProtocol"); found {
+			appProtocol = &rawAppProtocol
+		}
+		ports = append(ports, ServiceImportPort{
+			Name:        name,
+			Protocol:    corev1.Protocol(protocol),
+			AppProtocol: appProtocol,
+			Port:        int32(port),
+		})
+	}
+	return ports, nil
+}
+
+// BuildServiceImportTags returns the tags that identify the importing cluster and the ServiceImport port a multi-cluster target group routes to.
+func BuildServiceImportTags(clusterName string, serviceImportKey types.NamespacedName, port int32) map[string]string {
+	return map[string]string{
+		ServiceImportTagKey:        serviceImportTagValue(serviceImportKey),
+		ServiceImportPortTagKey:    strconv.Itoa(int(port)),
+		ServiceImportClusterTagKey: clusterName,
+	}
+}
+
+func serviceImportTagValue(serviceImportKey types.NamespacedName) string {
+	return fmt.Sprintf("%s/%s", serviceImportKey.Namespace, serviceImportKey.Name)
+}
@@ -1,16 +1,19 @@
 package referencecounter
 
 import (
+	"sort"
+	"sync"
+
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
-	"sync"
 )
 
 // ServiceReferenceCounter tracks gateways and their relations to service objects.
 type ServiceReferenceCounter interface {
 	UpdateRelations(svcs []types.NamespacedName, gateway types.NamespacedName, isDelete bool)
 	IsEligibleForRemoval(svcName types.NamespacedName, expectedGateways []types.NamespacedName) bool
+	GetGatewaysForService(svcName types.NamespacedName) []types.NamespacedName
 }
 
 type serviceReferenceCounter struct {
@@ -84,6 +87,22 @@ func (t *serviceReferenceCounter) IsEligibleForRemoval(svcName types.NamespacedN
 	return true
 }
 
+// GetGatewaysForService returns the gateways that reference a particular service, sorted by namespace and name.
+func (t *serviceReferenceCounter) GetGatewaysForService(svcName types.NamespacedName) []types.NamespacedName {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	var gateways []types.NamespacedName
+	for gw, svcs := range t.relations {
+		if svcs.Has(svcName) {
+			gateways = append(gateways, gw)
+		}
+	}
+	sort.Slice(gateways, func(i, j int) bool {
+		return gateways[i].String() < gateways[j].String()
+	})
+	return gateways
+}
+
 func NewServiceReferenceCounter() ServiceReferenceCounter {
 	return &serviceReferenceCounter{
 		relations: make(map[types.NamespacedName]sets.Set[types.NamespacedName]),
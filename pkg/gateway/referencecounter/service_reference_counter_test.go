@@ -422,3 +422,22 @@ func TestServiceReferenceCounter(t *testing.T) {
 	})
 
 }
+
+func TestServiceReferenceCounter_GetGatewaysForService(t *testing.T) {
+	refCounter := NewServiceReferenceCounter()
+	svc1 := types.NamespacedName{Name: "svc1", Namespace: "ns"}
+	svc2 := types.NamespacedName{Name: "svc2", Namespace: "ns"}
+	gw1 := types.NamespacedName{Name: "gw1", Namespace: "ns"}
+	gw2 := types.NamespacedName{Name: "gw2", Namespace: "other-ns"}
+
+	assert.Empty(t, refCounter.GetGatewaysForService(svc1))
+
+	refCounter.UpdateRelations([]types.NamespacedName{svc1, svc2}, gw2, false)
+	refCounter.UpdateRelations([]types.NamespacedName{svc1}, gw1, false)
+	assert.Equal(t, []types.NamespacedName{gw1, gw2}, refCounter.GetGatewaysForService(svc1))
+	assert.Equal(t, []types.NamespacedName{gw2}, refCounter.GetGatewaysForService(svc2))
+
+	refCounter.UpdateRelations(nil, gw2, true)
+	assert.Equal(t, []types.NamespacedName{gw1}, refCounter.GetGatewaysForService(svc1))
+	assert.Empty(t, refCounter.GetGatewaysForService(svc2))
+}
@@ -11,6 +11,7 @@ import (
 	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/v3/apis/gateway/v1"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway/constants"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway/multicluster"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -54,30 +55,49 @@ type TargetGroupConfigurator interface {
 
 // Backend an abstraction on the Gateway Backend, meant to hide the underlying backend type from consumers (unless they really want to see it :))
 type Backend struct {
-	ServiceBackend     *ServiceBackendConfig
-	LiteralTargetGroup *LiteralTargetGroupConfig
-	GatewayBackend     *GatewayBackendConfig
-	Weight             int
+	ServiceBackend       *ServiceBackendConfig
+	LiteralTargetGroup   *LiteralTargetGroupConfig
+	GatewayBackend       *GatewayBackendConfig
+	ServiceImportBackend *ServiceImportBackendConfig
+	Weight               int
+}
+
+// routeBackendRef a backend reference of a route rule, along with the TargetGroupConfigurations attached to it through ExtensionRef filters.
+type routeBackendRef struct {
+	gwv1.BackendRef
+	targetGroupConfigRefs []gwv1.LocalObjectReference
+}
+
+// toRouteBackendRefs converts the backend references of routes that don't support backend filters.
+func toRouteBackendRefs(backendRefs []gwv1.BackendRef) []routeBackendRef {
+	refs := make([]routeBackendRef, 0, len(backendRefs))
+	for _, backendRef := range backendRefs {
+		refs = append(refs, routeBackendRef{BackendRef: backendRef})
+	}
+	return refs
 }
 
 type attachedRuleAccumulator[RuleType any] interface {
-	accumulateRules(ctx context.Context, k8sClient client.Client, route preLoadRouteDescriptor, rules []RuleType, backendRefIterator func(RuleType) []gwv1.BackendRef, listenerRuleConfigRefs func(RuleType) []gwv1.LocalObjectReference, ruleConverter func(*RuleType, []Backend, *elbv2gw.ListenerRuleConfiguration) RouteRule, gatewayDefaultTGConfig *elbv2gw.TargetGroupConfiguration) ([]RouteRule, []routeLoadError)
+	accumulateRules(ctx context.Context, k8sClient client.Client, route preLoadRouteDescriptor, rules []RuleType, backendRefIterator func(RuleType) []routeBackendRef, listenerRuleConfigRefs func(RuleType) []gwv1.LocalObjectReference, ruleConverter func(*RuleType, []Backend, *elbv2gw.ListenerRuleConfiguration) RouteRule, gatewayDefaultTGConfig *elbv2gw.TargetGroupConfiguration) ([]RouteRule, []routeLoadError)
 }
 
 type attachedRuleAccumulatorImpl[RuleType any] struct {
 	backendLoader            func(ctx context.Context, k8sClient client.Client, backendRef gwv1.BackendRef, routeIdentifier types.NamespacedName, routeKind RouteKind, gatewayDefaultTGConfig *elbv2gw.TargetGroupConfiguration) (*Backend, error, error)
 	listenerRuleConfigLoader func(ctx context.Context, k8sClient client.Client, routeIdentifier types.NamespacedName, routeKind RouteKind, listenerRuleConfigRefs []gwv1.LocalObjectReference) (*elbv2gw.ListenerRuleConfiguration, error, error)
+	routeTGConfigLoader      func(ctx context.Context, k8sClient client.Client, routeIdentifier types.NamespacedName, routeKind RouteKind, targetGroupConfigRefs []gwv1.LocalObjectReference) (*elbv2gw.TargetGroupConfiguration, error, error)
 }
 
 func newAttachedRuleAccumulator[RuleType any](backendLoader func(ctx context.Context, k8sClient client.Client, backendRef gwv1.BackendRef, routeIdentifier types.NamespacedName, routeKind RouteKind, gatewayDefaultTGConfig *elbv2gw.TargetGroupConfiguration) (*Backend, error, error),
-	listenerRuleConfigLoader func(ctx context.Context, k8sClient client.Client, routeIdentifier types.NamespacedName, routeKind RouteKind, listenerRuleConfigRefs []gwv1.LocalObjectReference) (*elbv2gw.ListenerRuleConfiguration, error, error)) attachedRuleAccumulator[RuleType] {
+	listenerRuleConfigLoader func(ctx context.Context, k8sClient client.Client, routeIdentifier types.NamespacedName, routeKind RouteKind, listenerRuleConfigRefs []gwv1.LocalObjectReference) (*elbv2gw.ListenerRuleConfiguration, error, error),
+	routeTGConfigLoader func(ctx context.Context, k8sClient client.Client, routeIdentifier types.NamespacedName, routeKind RouteKind, targetGroupConfigRefs []gwv1.LocalObjectReference) (*elbv2gw.TargetGroupConfiguration, error, error)) attachedRuleAccumulator[RuleType] {
 	return &attachedRuleAccumulatorImpl[RuleType]{
 		backendLoader:            backendLoader,
 		listenerRuleConfigLoader: listenerRuleConfigLoader,
+		routeTGConfigLoader:      routeTGConfigLoader,
 	}
 }
 
-func (ara *attachedRuleAccumulatorImpl[RuleType]) accumulateRules(ctx context.Context, k8sClient client.Client, route preLoadRouteDescriptor, rules []RuleType, backendRefIterator func(RuleType) []gwv1.BackendRef, listenerRuleConfigRefs func(RuleType) []gwv1.LocalObjectReference, ruleConverter func(*RuleType, []Backend, *elbv2gw.ListenerRuleConfiguration) RouteRule, gatewayDefaultTGConfig *elbv2gw.TargetGroupConfiguration) ([]RouteRule, []routeLoadError) {
+func (ara *attachedRuleAccumulatorImpl[RuleType]) accumulateRules(ctx context.Context, k8sClient client.Client, route preLoadRouteDescriptor, rules []RuleType, backendRefIterator func(RuleType) []routeBackendRef, listenerRuleConfigRefs func(RuleType) []gwv1.LocalObjectReference, ruleConverter func(*RuleType, []Backend, *elbv2gw.ListenerRuleConfiguration) RouteRule, gatewayDefaultTGConfig *elbv2gw.TargetGroupConfiguration) ([]RouteRule, []routeLoadError) {
 	convertedRules := make([]RouteRule, 0)
 	allErrors := make([]routeLoadError, 0)
 	for _, rule := range rules {
@@ -99,7 +119,14 @@ func (ara *attachedRuleAccumulatorImpl[RuleType]) accumulateRules(ctx context.Co
 		// If ListenerRuleConfig is loaded properly without any warning errors, then only load backends, else it should be treated as no valid backend to send with fixed 503 response
 		if lrcWarningErr == nil {
 			for _, backend := range backendRefIterator(rule) {
-				convertedBackend, warningErr, fatalErr := ara.backendLoader(ctx, k8sClient, backend, route.GetRouteNamespacedName(), route.GetRouteKind(), gatewayDefaultTGConfig)
+				convertedBackend, warningErr, fatalErr := ara.backendLoader(ctx, k8sClient, backend.BackendRef, route.GetRouteNamespacedName(), route.GetRouteKind(), gatewayDefaultTGConfig)
+				if convertedBackend != nil && len(backend.targetGroupConfigRefs) != 0 {
+					// A backend with an invalid TargetGroupConfiguration filter is not routed to, same as an invalid backend.
+					warningErr, fatalErr = ara.applyRouteTargetGroupConfig(ctx, k8sClient, route, convertedBackend, backend.targetGroupConfigRefs)
+					if warningErr != nil {
+						convertedBackend = nil
+					}
+				}
 				if warningErr != nil {
 					allErrors = append(allErrors, routeLoadError{
 						Err: warningErr,
@@ -124,6 +151,17 @@ func (ara *attachedRuleAccumulatorImpl[RuleType]) accumulateRules(ctx context.Co
 	return convertedRules, allErrors
 }
 
+// applyRouteTargetGroupConfig loads the TargetGroupConfiguration attached to a backend by the route, and applies it on top of
+// the Service and Gateway level configurations of the backend.
+// returns (warning error, fatal error)
+func (ara *attachedRuleAccumulatorImpl[RuleType]) applyRouteTargetGroupConfig(ctx context.Context, k8sClient client.Client, route preLoadRouteDescriptor, backend *Backend, targetGroupConfigRefs []gwv1.LocalObjectReference) (error, error) {
+	routeTGConfig, warningErr, fatalErr := ara.routeTGConfigLoader(ctx, k8sClient, route.GetRouteNamespacedName(), route.GetRouteKind(), targetGroupConfigRefs)
+	if warningErr != nil || fatalErr != nil {
+		return warningErr, fatalErr
+	}
+	return applyRouteTargetGroupConfigToBackend(backend, routeTGConfig, route.GetRouteNamespacedName(), route.GetRouteKind()), nil
+}
+
 // returns (loaded backend, warning error, fatal error)
 // warning error -> continue with reconcile cycle.
 // fatal error -> stop reconcile cycle (probably k8s api outage)
@@ -133,6 +171,7 @@ func commonBackendLoader(ctx context.Context, k8sClient client.Client, backendRe
 	var serviceBackend *ServiceBackendConfig
 	var literalTargetGroup *LiteralTargetGroupConfig
 	var gatewayBackend *GatewayBackendConfig
+	var serviceImportBackend *ServiceImportBackendConfig
 	var warn error
 	var fatal error
 	// We only support references of type service.
@@ -142,13 +181,15 @@ func commonBackendLoader(ctx context.Context, k8sClient client.Client, backendRe
 		literalTargetGroup, warn, fatal = literalTargetGroupLoader(backendRef)
 	} else if string(*backendRef.Kind) == gatewayKind {
 		gatewayBackend, warn, fatal = gatewayLoader(ctx, k8sClient, routeIdentifier, routeKind, backendRef)
+	} else if string(*backendRef.Kind) == multicluster.ServiceImportKind && backendRef.Group != nil && string(*backendRef.Group) == multicluster.APIGroup {
+		serviceImportBackend, warn, fatal = serviceImportLoader(ctx, k8sClient, routeIdentifier, routeKind, backendRef, gatewayDefaultTGConfig)
 	}
 
 	if warn != nil || fatal != nil {
 		return nil, warn, fatal
 	}
 
-	if serviceBackend == nil && literalTargetGroup == nil && gatewayBackend == nil {
+	if serviceBackend == nil && literalTargetGroup == nil && gatewayBackend == nil && serviceImportBackend == nil {
 		initialErrorMessage := "Unknown backend reference kind"
 		wrappedGatewayErrorMessage := generateInvalidMessageWithRouteDetails(initialErrorMessage, routeKind, routeIdentifier)
 		return nil, wrapError(errors.Errorf("%s", initialErrorMessage), gwv1.GatewayReasonListenersNotValid, gwv1.RouteReasonInvalidKind, &wrappedGatewayErrorMessage, nil), nil
@@ -174,10 +215,11 @@ func commonBackendLoader(ctx context.Context, k8sClient client.Client, backendRe
 		return nil, nil, errors.Errorf("Weight [%d] must be less than or equal to %d", weight, maxWeight)
 	}
 	return &Backend{
-		ServiceBackend:     serviceBackend,
-		GatewayBackend:     gatewayBackend,
-		LiteralTargetGroup: literalTargetGroup,
-		Weight:             weight,
+		ServiceBackend:       serviceBackend,
+		GatewayBackend:       gatewayBackend,
+		LiteralTargetGroup:   literalTargetGroup,
+		ServiceImportBackend: serviceImportBackend,
+		Weight:               weight,
 	}, nil, nil
 }
 
@@ -189,6 +231,7 @@ func literalTargetGroupLoader(backendRef gwv1.BackendRef) (*LiteralTargetGroupCo
 
 // LookUpTargetGroupConfiguration given a service, lookup the target group configuration associated with the service.
 // recall that target group configuration always lives within the same namespace as the service.
+// when multiple target group configurations reference the same object, the oldest one takes precedence, ties are broken by name.
 func LookUpTargetGroupConfiguration(ctx context.Context, k8sClient client.Client, objectKind string, objectMetadata types.NamespacedName) (*elbv2gw.TargetGroupConfiguration, error) {
 	tgConfigList := &elbv2gw.TargetGroupConfigurationList{}
 
@@ -197,7 +240,9 @@ func LookUpTargetGroupConfiguration(ctx context.Context, k8sClient client.Client
 		return nil, err
 	}
 
-	for _, tgConfig := range tgConfigList.Items {
+	var selected *elbv2gw.TargetGroupConfiguration
+	for i := range tgConfigList.Items {
+		tgConfig := &tgConfigList.Items[i]
 		// Skip TGCs without targetReference (those are only used as defaults via LBC)
 		if tgConfig.Spec.TargetReference == nil {
 			continue
@@ -216,11 +261,23 @@ func LookUpTargetGroupConfiguration(ctx context.Context, k8sClient client.Client
 		}
 
 		// TODO - Add an index for this
-		if tgConfig.Spec.TargetReference.Name == objectMetadata.Name {
-			return &tgConfig, nil
+		if tgConfig.Spec.TargetReference.Name != objectMetadata.Name {
+			continue
+		}
+
+		if selected == nil || targetGroupConfigurationPrecedes(tgConfig, selected) {
+			selected = tgConfig
 		}
 	}
-	return nil, nil
+	return selected, nil
+}
+
+// targetGroupConfigurationPrecedes returns true when tgConfig takes precedence over other.
+func targetGroupConfigurationPrecedes(tgConfig *elbv2gw.TargetGroupConfiguration, other *elbv2gw.TargetGroupConfiguration) bool {
+	if !tgConfig.CreationTimestamp.Equal(&other.CreationTimestamp) {
+		return tgConfig.CreationTimestamp.Before(&other.CreationTimestamp)
+	}
+	return tgConfig.Name < other.Name
 }
 
 func listenerRuleConfigLoader(ctx context.Context, k8sClient client.Client, routeIdentifier types.NamespacedName, routeKind RouteKind, listenerRuleConfigsRefs []gwv1.LocalObjectReference) (*elbv2gw.ListenerRuleConfiguration, error, error) {
@@ -0,0 +1,201 @@
+package routeutils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/v3/apis/gateway/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway/multicluster"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/shared_constants"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/shared_utils"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+var _ TargetGroupConfigurator = &ServiceImportBackendConfig{}
+
+// ServiceImportBackendConfig is a multi-cluster Service backend.
+// Its target group is shared by every cluster exporting the Service, which register their endpoints into it.
+type ServiceImportBackendConfig struct {
+	serviceImportKey types.NamespacedName
+	targetGroupProps *elbv2gw.TargetGroupProps
+	port             multicluster.ServiceImportPort
+}
+
+func NewServiceImportBackendConfig(serviceImportKey types.NamespacedName, targetGroupProps *elbv2gw.TargetGroupProps, port multicluster.ServiceImportPort) *ServiceImportBackendConfig {
+	return &ServiceImportBackendConfig{
+		serviceImportKey: serviceImportKey,
+		targetGroupProps: targetGroupProps,
+		port:             port,
+	}
+}
+
+// GetTargetType ServiceImport based backends always register pods from each exporting cluster as IP targets.
+func (s *ServiceImportBackendConfig) GetTargetType(_ elbv2model.TargetType) elbv2model.TargetType {
+	return elbv2model.TargetTypeIP
+}
+
+func (s *ServiceImportBackendConfig) GetTargetGroupProps() *elbv2gw.TargetGroupProps {
+	return s.targetGroupProps
+}
+
+func (s *ServiceImportBackendConfig) GetBackendNamespacedName() types.NamespacedName {
+	return s.serviceImportKey
+}
+
+func (s *ServiceImportBackendConfig) GetIdentifierPort() intstr.IntOrString {
+	return intstr.FromInt32(s.port.Port)
+}
+
+// GetExternalTrafficPolicy doesn't really apply to this backend type, so we return the most permissive type.
+func (s *ServiceImportBackendConfig) GetExternalTrafficPolicy() corev1.ServiceExternalTrafficPolicyType {
+	return corev1.ServiceExternalTrafficPolicyTypeCluster
+}
+
+// GetIPAddressType ServiceImport based backends always register IPv4 targets, as the IP families of the exporting clusters aren't known.
+func (s *ServiceImportBackendConfig) GetIPAddressType() elbv2model.TargetGroupIPAddressType {
+	return elbv2model.TargetGroupIPAddressTypeIPv4
+}
+
+// GetTargetGroupPort uses the ServiceImport port, the targets are registered with the target port of each exporting cluster.
+func (s *ServiceImportBackendConfig) GetTargetGroupPort(_ elbv2model.TargetType) int32 {
+	return s.port.Port
+}
+
+func (s *ServiceImportBackendConfig) GetHealthCheckPort(_ elbv2model.TargetType, _ bool) (intstr.IntOrString, error) {
+	portConfigNotExist := s.targetGroupProps == nil || s.targetGroupProps.HealthCheckConfig == nil || s.targetGroupProps.HealthCheckConfig.HealthCheckPort == nil
+
+	if portConfigNotExist || *s.targetGroupProps.HealthCheckConfig.HealthCheckPort == shared_constants.HealthCheckPortTrafficPort {
+		return intstr.FromString(shared_constants.HealthCheckPortTrafficPort), nil
+	}
+
+	healthCheckPort := intstr.Parse(*s.targetGroupProps.HealthCheckConfig.HealthCheckPort)
+	if healthCheckPort.Type == intstr.Int {
+		return healthCheckPort, nil
+	}
+	return intstr.IntOrString{}, errors.New("cannot use named healthCheckPort for ServiceImport backends")
+}
+
+func (s *ServiceImportBackendConfig) GetProtocolVersion() *elbv2model.ProtocolVersion {
+	if s.port.AppProtocol == nil {
+		return nil
+	}
+
+	switch *s.port.AppProtocol {
+	case "kubernetes.io/h2c":
+		return &http2
+	default:
+		return nil
+	}
+}
+
+func serviceImportLoader(ctx context.Context, k8sClient client.Client, routeIdentifier types.NamespacedName, routeKind RouteKind, backendRef gwv1.BackendRef, gatewayDefaultTGConfig *elbv2gw.TargetGroupConfiguration) (*ServiceImportBackendConfig, error, error) {
+	if backendRef.Port == nil {
+		initialErrorMessage := "Port is required"
+		wrappedGatewayErrorMessage := generateInvalidMessageWithRouteDetails(initialErrorMessage, routeKind, routeIdentifier)
+		return nil, wrapError(errors.Errorf("%s", initialErrorMessage), gwv1.GatewayReasonListenersNotValid, gwv1.RouteReasonUnsupportedValue, &wrappedGatewayErrorMessage, nil), nil
+	}
+
+	var importNamespace string
+	if backendRef.Namespace == nil {
+		importNamespace = routeIdentifier.Namespace
+	} else {
+		importNamespace = string(*backendRef.Namespace)
+	}
+
+	importIdentifier := types.NamespacedName{
+		Namespace: importNamespace,
+		Name:      string(backendRef.Name),
+	}
+
+	// Check for reference grant when performing cross namespace route -> service import reference
+	if importIdentifier.Namespace != routeIdentifier.Namespace {
+		allowed, err := shared_utils.ValidateCrossNamespaceReference(ctx, k8sClient, routeIdentifier.Namespace, gatewayAPIGroup, string(routeKind), multicluster.APIGroup, multicluster.ServiceImportKind, importIdentifier.Namespace, importIdentifier.Name)
+		if err != nil {
+			// Currently, this API only fails for a k8s related error message, hence no status update + make the error fatal.
+			return nil, nil, errors.Wrapf(err, "Unable to perform reference grant check")
+		}
+
+		// We should not give any hints about the existence of this resource, therefore, we return nil.
+		if !allowed {
+			wrappedGatewayErrorMessage := generateInvalidMessageWithRouteDetails(referenceGrantNotExists, routeKind, routeIdentifier)
+			return nil, wrapError(errors.Errorf("%s", referenceGrantNotExists), gwv1.GatewayReasonListenersNotValid, gwv1.RouteReasonRefNotPermitted, &wrappedGatewayErrorMessage, nil), nil
+		}
+	}
+
+	serviceImport := multicluster.NewServiceImport()
+	err := k8sClient.Get(ctx, importIdentifier, serviceImport)
+	if err != nil {
+		var initialErrorMessage string
+		if meta.IsNoMatchError(err) {
+			// ServiceImport CRD is not installed.
+			initialErrorMessage = fmt.Sprintf("ServiceImport (%s:%s) not found, the %s API is not installed", importIdentifier.Namespace, importIdentifier.Name, multicluster.APIGroup)
+		} else if client.IgnoreNotFound(err) == nil {
+			initialErrorMessage = fmt.Sprintf("ServiceImport (%s:%s) not found)", importIdentifier.Namespace, importIdentifier.Name)
+		} else {
+			// Otherwise, general error. No need for status update.
+			return nil, nil, errors.Wrap(err, fmt.Sprintf("Unable to fetch service import object %+v", importIdentifier))
+		}
+		wrappedGatewayErrorMessage := generateInvalidMessageWithRouteDetails(initialErrorMessage, routeKind, routeIdentifier)
+		return nil, wrapError(errors.Errorf("%s", initialErrorMessage), gwv1.GatewayReasonListenersNotValid, gwv1.RouteReasonBackendNotFound, &wrappedGatewayErrorMessage, nil), nil
+	}
+
+	if multicluster.GetServiceImportType(serviceImport) == multicluster.ServiceImportTypeHeadless {
+		initialErrorMessage := fmt.Sprintf("Headless ServiceImport (%s:%s) is not supported", importIdentifier.Namespace, importIdentifier.Name)
+		wrappedGatewayErrorMessage := generateInvalidMessageWithRouteDetails(initialErrorMessage, routeKind, routeIdentifier)
+		return nil, wrapError(errors.Errorf("%s", initialErrorMessage), gwv1.GatewayReasonListenersNotValid, gwv1.RouteReasonUnsupportedValue, &wrappedGatewayErrorMessage, nil), nil
+	}
+
+	importPorts, err := multicluster.GetServiceImportPorts(serviceImport)
+	if err != nil {
+		wrappedGatewayErrorMessage := generateInvalidMessageWithRouteDetails(err.Error(), routeKind, routeIdentifier)
+		return nil, wrapError(err, gwv1.GatewayReasonListenersNotValid, gwv1.RouteReasonUnsupportedValue, &wrappedGatewayErrorMessage, nil), nil
+	}
+
+	var importPort *multicluster.ServiceImportPort
+	for i := range importPorts {
+		if importPorts[i].Port == int32(*backendRef.Port) {
+			importPort = &importPorts[i]
+			break
+		}
+	}
+
+	if importPort == nil {
+		initialErrorMessage := fmt.Sprintf("Unable to find service import port for port %d", *backendRef.Port)
+		wrappedGatewayErrorMessage := generateInvalidMessageWithRouteDetails(initialErrorMessage, routeKind, routeIdentifier)
+		return nil, wrapError(errors.Errorf("%s", initialErrorMessage), gwv1.GatewayReasonListenersNotValid, gwv1.RouteReasonBackendNotFound, &wrappedGatewayErrorMessage, nil), nil
+	}
+
+	tgConfig, err := LookUpTargetGroupConfiguration(ctx, k8sClient, multicluster.ServiceImportKind, importIdentifier)
+	if err != nil {
+		// As of right now, this error can only be thrown because of a k8s api error hence no status update.
+		return nil, nil, errors.Wrap(err, fmt.Sprintf("Unable to fetch tg config object"))
+	}
+
+	var gatewayProps *elbv2gw.TargetGroupProps
+	if gatewayDefaultTGConfig != nil {
+		gatewayProps = tgConfigConstructor.ConstructTargetGroupConfigForRoute(gatewayDefaultTGConfig, routeIdentifier.Name, routeIdentifier.Namespace, string(routeKind))
+	}
+
+	var serviceImportProps *elbv2gw.TargetGroupProps
+	if tgConfig != nil {
+		serviceImportProps = tgConfigConstructor.ConstructTargetGroupConfigForRoute(tgConfig, routeIdentifier.Name, routeIdentifier.Namespace, string(routeKind))
+	}
+
+	tgProps := tgConfigConstructor.MergeProps(serviceImportProps, gatewayProps)
+
+	// The pods of every exporting cluster are registered by their own controllers, which is only possible with IP targets.
+	if tgProps != nil && tgProps.TargetType != nil && elbv2model.TargetType(*tgProps.TargetType) != elbv2model.TargetTypeIP {
+		initialErrorMessage := fmt.Sprintf("ServiceImport (%s:%s) only supports the ip target type", importIdentifier.Namespace, importIdentifier.Name)
+		wrappedGatewayErrorMessage := generateInvalidMessageWithRouteDetails(initialErrorMessage, routeKind, routeIdentifier)
+		return nil, wrapError(errors.Errorf("%s", initialErrorMessage), gwv1.GatewayReasonListenersNotValid, gwv1.RouteReasonUnsupportedValue, &wrappedGatewayErrorMessage, nil), nil
+	}
+
+	return NewServiceImportBackendConfig(importIdentifier, tgProps, *importPort), nil, nil
+}
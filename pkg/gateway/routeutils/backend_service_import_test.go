@@ -0,0 +1,267 @@
+package routeutils
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/v3/apis/gateway/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway/multicluster"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/shared_constants"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/testutils"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func TestCommonBackendLoader_ServiceImport(t *testing.T) {
+	namespaceToUse := "current-namespace"
+	importNameToUse := "current-import"
+	routeIdentifier := types.NamespacedName{
+		Namespace: namespaceToUse,
+		Name:      "my-route",
+	}
+	port80 := gwv1.PortNumber(80)
+	serviceImportGroup := gwv1.Group(multicluster.APIGroup)
+	serviceImportKind := gwv1.Kind(multicluster.ServiceImportKind)
+
+	newServiceImport := func(importType string, ports ...interface{}) *unstructured.Unstructured {
+		serviceImport := multicluster.NewServiceImport()
+		serviceImport.SetNamespace(namespaceToUse)
+		serviceImport.SetName(importNameToUse)
+		serviceImport.Object["spec"] = map[string]interface{}{
+			"type":  importType,
+			"ports": ports,
+		}
+		return serviceImport
+	}
+	ipTargetType := elbv2gw.TargetTypeIP
+	instanceTargetType := elbv2gw.TargetTypeInstance
+	newTGConfig := func(targetType *elbv2gw.TargetType) *elbv2gw.TargetGroupConfiguration {
+		return &elbv2gw.TargetGroupConfiguration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "tg1",
+				Namespace: namespaceToUse,
+			},
+			Spec: elbv2gw.TargetGroupConfigurationSpec{
+				TargetReference: &elbv2gw.Reference{
+					Kind: awssdk.String(multicluster.ServiceImportKind),
+					Name: importNameToUse,
+				},
+				DefaultConfiguration: elbv2gw.TargetGroupProps{
+					TargetType: targetType,
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		serviceImport *unstructured.Unstructured
+		tgConfig      *elbv2gw.TargetGroupConfiguration
+		backendRef    gwv1.BackendRef
+
+		expectWarning bool
+		expectedPort  multicluster.ServiceImportPort
+		expectedProps *elbv2gw.TargetGroupProps
+	}{
+		{
+			name: "service import backend",
+			serviceImport: newServiceImport("ClusterSetIP",
+				map[string]interface{}{"name": "http", "protocol": "TCP", "port": int64(80), "appProtocol": "kubernetes.io/h2c"},
+				map[string]interface{}{"name": "https", "protocol": "TCP", "port": int64(443)},
+			),
+			backendRef: gwv1.BackendRef{
+				BackendObjectReference: gwv1.BackendObjectReference{
+					Group: &serviceImportGroup,
+					Kind:  &serviceImportKind,
+					Name:  gwv1.ObjectName(importNameToUse),
+					Port:  &port80,
+				},
+			},
+			expectedPort: multicluster.ServiceImportPort{
+				Name:        "http",
+				Protocol:    "TCP",
+				AppProtocol: awssdk.String("kubernetes.io/h2c"),
+				Port:        80,
+			},
+		},
+		{
+			name: "service import backend with target group configuration",
+			serviceImport: newServiceImport("ClusterSetIP",
+				map[string]interface{}{"port": int64(80)},
+			),
+			tgConfig: newTGConfig(&ipTargetType),
+			backendRef: gwv1.BackendRef{
+				BackendObjectReference: gwv1.BackendObjectReference{
+					Group: &serviceImportGroup,
+					Kind:  &serviceImportKind,
+					Name:  gwv1.ObjectName(importNameToUse),
+					Port:  &port80,
+				},
+			},
+			expectedPort: multicluster.ServiceImportPort{
+				Protocol: "TCP",
+				Port:     80,
+			},
+			expectedProps: &elbv2gw.TargetGroupProps{
+				TargetType: &ipTargetType,
+			},
+		},
+		{
+			name: "service import backend with instance target type",
+			serviceImport: newServiceImport("ClusterSetIP",
+				map[string]interface{}{"port": int64(80)},
+			),
+			tgConfig: newTGConfig(&instanceTargetType),
+			backendRef: gwv1.BackendRef{
+				BackendObjectReference: gwv1.BackendObjectReference{
+					Group: &serviceImportGroup,
+					Kind:  &serviceImportKind,
+					Name:  gwv1.ObjectName(importNameToUse),
+					Port:  &port80,
+				},
+			},
+			expectWarning: true,
+		},
+		{
+			name: "service import not found",
+			backendRef: gwv1.BackendRef{
+				BackendObjectReference: gwv1.BackendObjectReference{
+					Group: &serviceImportGroup,
+					Kind:  &serviceImportKind,
+					Name:  gwv1.ObjectName(importNameToUse),
+					Port:  &port80,
+				},
+			},
+			expectWarning: true,
+		},
+		{
+			name: "headless service import",
+			serviceImport: newServiceImport("Headless",
+				map[string]interface{}{"port": int64(80)},
+			),
+			backendRef: gwv1.BackendRef{
+				BackendObjectReference: gwv1.BackendObjectReference{
+					Group: &serviceImportGroup,
+					Kind:  &serviceImportKind,
+					Name:  gwv1.ObjectName(importNameToUse),
+					Port:  &port80,
+				},
+			},
+			expectWarning: true,
+		},
+		{
+			name: "service import port not found",
+			serviceImport: newServiceImport("ClusterSetIP",
+				map[string]interface{}{"port": int64(443)},
+			),
+			backendRef: gwv1.BackendRef{
+				BackendObjectReference: gwv1.BackendObjectReference{
+					Group: &serviceImportGroup,
+					Kind:  &serviceImportKind,
+					Name:  gwv1.ObjectName(importNameToUse),
+					Port:  &port80,
+				},
+			},
+			expectWarning: true,
+		},
+		{
+			name: "missing port",
+			serviceImport: newServiceImport("ClusterSetIP",
+				map[string]interface{}{"port": int64(80)},
+			),
+			backendRef: gwv1.BackendRef{
+				BackendObjectReference: gwv1.BackendObjectReference{
+					Group: &serviceImportGroup,
+					Kind:  &serviceImportKind,
+					Name:  gwv1.ObjectName(importNameToUse),
+				},
+			},
+			expectWarning: true,
+		},
+		{
+			name: "cross namespace service import without reference grant",
+			serviceImport: newServiceImport("ClusterSetIP",
+				map[string]interface{}{"port": int64(80)},
+			),
+			backendRef: gwv1.BackendRef{
+				BackendObjectReference: gwv1.BackendObjectReference{
+					Group:     &serviceImportGroup,
+					Kind:      &serviceImportKind,
+					Name:      gwv1.ObjectName(importNameToUse),
+					Namespace: (*gwv1.Namespace)(awssdk.String("other-namespace")),
+					Port:      &port80,
+				},
+			},
+			expectWarning: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k8sClient := testutils.GenerateTestClient()
+			if tc.serviceImport != nil {
+				assert.NoError(t, k8sClient.Create(context.Background(), tc.serviceImport))
+			}
+			if tc.tgConfig != nil {
+				assert.NoError(t, k8sClient.Create(context.Background(), tc.tgConfig))
+			}
+
+			result, warningErr, fatalErr := commonBackendLoader(context.Background(), k8sClient, tc.backendRef, routeIdentifier, HTTPRouteKind, nil)
+			assert.NoError(t, fatalErr)
+			if tc.expectWarning {
+				assert.Error(t, warningErr)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, warningErr)
+			assert.Nil(t, result.ServiceBackend)
+			assert.Equal(t, 1, result.Weight)
+			assert.Equal(t, types.NamespacedName{Namespace: namespaceToUse, Name: importNameToUse}, result.ServiceImportBackend.GetBackendNamespacedName())
+			assert.Equal(t, tc.expectedPort, result.ServiceImportBackend.port)
+			assert.Equal(t, tc.expectedProps, result.ServiceImportBackend.GetTargetGroupProps())
+		})
+	}
+}
+
+func TestServiceImportBackendConfig(t *testing.T) {
+	port := multicluster.ServiceImportPort{
+		Protocol:    "TCP",
+		AppProtocol: awssdk.String("kubernetes.io/h2c"),
+		Port:        8080,
+	}
+	config := NewServiceImportBackendConfig(types.NamespacedName{Namespace: "ns", Name: "import"}, nil, port)
+
+	assert.Equal(t, elbv2model.TargetTypeIP, config.GetTargetType(elbv2model.TargetTypeInstance))
+	assert.Equal(t, elbv2model.TargetGroupIPAddressTypeIPv4, config.GetIPAddressType())
+	assert.Equal(t, int32(8080), config.GetTargetGroupPort(elbv2model.TargetTypeIP))
+	assert.Equal(t, intstr.FromInt32(8080), config.GetIdentifierPort())
+	assert.Equal(t, &http2, config.GetProtocolVersion())
+
+	healthCheckPort, err := config.GetHealthCheckPort(elbv2model.TargetTypeIP, false)
+	assert.NoError(t, err)
+	assert.Equal(t, intstr.FromString(shared_constants.HealthCheckPortTrafficPort), healthCheckPort)
+
+	config = NewServiceImportBackendConfig(types.NamespacedName{Namespace: "ns", Name: "import"}, &elbv2gw.TargetGroupProps{
+		HealthCheckConfig: &elbv2gw.HealthCheckConfiguration{
+			HealthCheckPort: awssdk.String("9000"),
+		},
+	}, port)
+	healthCheckPort, err = config.GetHealthCheckPort(elbv2model.TargetTypeIP, false)
+	assert.NoError(t, err)
+	assert.Equal(t, intstr.FromInt32(9000), healthCheckPort)
+
+	config = NewServiceImportBackendConfig(types.NamespacedName{Namespace: "ns", Name: "import"}, &elbv2gw.TargetGroupProps{
+		HealthCheckConfig: &elbv2gw.HealthCheckConfiguration{
+			HealthCheckPort: awssdk.String("health"),
+		},
+	}, port)
+	_, err = config.GetHealthCheckPort(elbv2model.TargetTypeIP, false)
+	assert.Error(t, err)
+}
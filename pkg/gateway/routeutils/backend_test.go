@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	awssdk "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/stretchr/testify/assert"
@@ -570,6 +571,95 @@ func Test_lookUpTargetGroupConfiguration(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "multiple tg configs for the same svc, oldest wins",
+			kind: serviceKind,
+			allTargetGroupConfigurations: []elbv2gw.TargetGroupConfiguration{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              "a-newer",
+						Namespace:         "namespace",
+						CreationTimestamp: metav1.NewTime(time.Date(2024, 1, 2, 0, 0, 0, 0, time.Local)),
+					},
+					Spec: elbv2gw.TargetGroupConfigurationSpec{
+						TargetReference: &elbv2gw.Reference{
+							Name: "svc1",
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              "b-older",
+						Namespace:         "namespace",
+						CreationTimestamp: metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local)),
+					},
+					Spec: elbv2gw.TargetGroupConfigurationSpec{
+						TargetReference: &elbv2gw.Reference{
+							Name: "svc1",
+						},
+					},
+				},
+			},
+			objectMetadata: types.NamespacedName{
+				Namespace: "namespace",
+				Name:      "svc1",
+			},
+			expectedTGConfiguration: &elbv2gw.TargetGroupConfiguration{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "b-older",
+					Namespace:         "namespace",
+					CreationTimestamp: metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local)),
+				},
+				Spec: elbv2gw.TargetGroupConfigurationSpec{
+					TargetReference: &elbv2gw.Reference{
+						Name: "svc1",
+					},
+				},
+			},
+		},
+		{
+			name: "multiple tg configs for the same svc created at the same time, name breaks the tie",
+			kind: serviceKind,
+			allTargetGroupConfigurations: []elbv2gw.TargetGroupConfiguration{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "tg2",
+						Namespace: "namespace",
+					},
+					Spec: elbv2gw.TargetGroupConfigurationSpec{
+						TargetReference: &elbv2gw.Reference{
+							Name: "svc1",
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "tg1",
+						Namespace: "namespace",
+					},
+					Spec: elbv2gw.TargetGroupConfigurationSpec{
+						TargetReference: &elbv2gw.Reference{
+							Name: "svc1",
+						},
+					},
+				},
+			},
+			objectMetadata: types.NamespacedName{
+				Namespace: "namespace",
+				Name:      "svc1",
+			},
+			expectedTGConfiguration: &elbv2gw.TargetGroupConfiguration{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "tg1",
+					Namespace: "namespace",
+				},
+				Spec: elbv2gw.TargetGroupConfigurationSpec{
+					TargetReference: &elbv2gw.Reference{
+						Name: "svc1",
+					},
+				},
+			},
+		},
 		{
 			name: "sad path, svc name different",
 			kind: serviceKind,
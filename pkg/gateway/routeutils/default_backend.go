@@ -0,0 +1,41 @@
+package routeutils
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/v3/apis/gateway/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// LoadListenerDefaultBackend loads the Service of a listener default action configured in the LoadBalancerConfiguration.
+// The default action doesn't belong to any route, so the Service is loaded on behalf of an HTTPRoute named after the Gateway.
+// The returned route descriptor is used to build the target group of the backend.
+func LoadListenerDefaultBackend(ctx context.Context, k8sClient client.Client, gw *gwv1.Gateway, forwardConfig elbv2gw.ListenerDefaultForwardConfig) (RouteDescriptor, *Backend, error) {
+	route := convertHTTPRoute(gwv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         gw.Namespace,
+			Name:              gw.Name,
+			CreationTimestamp: gw.CreationTimestamp,
+		},
+	})
+	port := gwv1.PortNumber(forwardConfig.Port)
+	backendRef := gwv1.BackendRef{
+		BackendObjectReference: gwv1.BackendObjectReference{
+			Name: gwv1.ObjectName(forwardConfig.Name),
+			Port: &port,
+		},
+	}
+
+	backend, warningErr, fatalErr := commonBackendLoader(ctx, k8sClient, backendRef, route.GetRouteNamespacedName(), route.GetRouteKind(), nil)
+	if fatalErr != nil {
+		return nil, nil, fatalErr
+	}
+	if warningErr != nil {
+		return nil, nil, errors.Wrapf(warningErr, "failed to load default backend %s:%d of Gateway %s", forwardConfig.Name, forwardConfig.Port, k8s.NamespacedName(gw))
+	}
+	return route, backend, nil
+}
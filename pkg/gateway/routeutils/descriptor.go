@@ -22,6 +22,7 @@ type routeMetadataDescriptor interface {
 	GetRawRoute() interface{}
 	GetBackendRefs() []gwv1.BackendRef
 	GetRouteListenerRuleConfigRefs() []gwv1.LocalObjectReference
+	GetRouteTargetGroupConfigRefs() []gwv1.LocalObjectReference
 	GetRouteGeneration() int64
 	GetRouteCreateTimestamp() time.Time
 	// GetCompatibleHostnamesByPort returns the compatible hostnames for each listener port.
@@ -0,0 +1,183 @@
+package routeutils
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/shared_utils"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// FrontendCACertificates holds the CA certificate refs of a client certificate validation resolved into trust store CA bundles.
+type FrontendCACertificates struct {
+	// CABundles are the CA bundles of the valid CA certificate refs.
+	CABundles []shared_utils.TrustStoreCABundleRef
+	// InvalidReason is the ResolvedRefs reason of the first invalid CA certificate ref, empty when all refs are valid.
+	InvalidReason gwv1.ListenerConditionReason
+	// InvalidMessage explains why the first invalid CA certificate ref is invalid.
+	InvalidMessage string
+}
+
+// GetFrontendTLSValidation returns the client certificate validation configured on the Gateway for the HTTPS listeners of the port,
+// the per port configuration takes precedence over the default one.
+func GetFrontendTLSValidation(gw gwv1.Gateway, port gwv1.PortNumber) *gwv1.FrontendTLSValidation {
+	if gw.Spec.TLS == nil || gw.Spec.TLS.Frontend == nil {
+		return nil
+	}
+	for _, portCfg := range gw.Spec.TLS.Frontend.PerPort {
+		if portCfg.Port == port {
+			return portCfg.TLS.Validation
+		}
+	}
+	return gw.Spec.TLS.Frontend.Default.Validation
+}
+
+// ResolveFrontendCACertificateRefs resolves the CA certificate refs of the client certificate validation into trust store CA bundles.
+// Refs of an unsupported kind, not permitted by a ReferenceGrant, or that don't hold a PEM encoded certificate in the ca.crt key are skipped.
+func ResolveFrontendCACertificateRefs(ctx context.Context, k8sClient client.Client, gw gwv1.Gateway, validation *gwv1.FrontendTLSValidation) (FrontendCACertificates, error) {
+	var result FrontendCACertificates
+	for _, ref := range validation.CACertificateRefs {
+		caBundle, reason, message, err := resolveFrontendCACertificateRef(ctx, k8sClient, gw, ref)
+		if err != nil {
+			return FrontendCACertificates{}, err
+		}
+		if reason != "" {
+			if result.InvalidReason == "" {
+				result.InvalidReason = reason
+				result.InvalidMessage = message
+			}
+			continue
+		}
+		result.CABundles = append(result.CABundles, caBundle)
+	}
+	return result, nil
+}
+
+func resolveFrontendCACertificateRef(ctx context.Context, k8sClient client.Client, gw gwv1.Gateway, ref gwv1.ObjectReference) (shared_utils.TrustStoreCABundleRef, gwv1.ListenerConditionReason, string, error) {
+	kind := string(ref.Kind)
+	if string(ref.Group) != coreAPIGroup || (kind != shared_utils.TrustStoreCABundleKindConfigMap && kind != shared_utils.TrustStoreCABundleKindSecret) {
+		return shared_utils.TrustStoreCABundleRef{}, gwv1.ListenerReasonInvalidCACertificateKind,
+			fmt.Sprintf("Unsupported kind %s for CA certificate ref %s, must be %s or %s", kind, ref.Name, shared_utils.TrustStoreCABundleKindConfigMap, shared_utils.TrustStoreCABundleKindSecret), nil
+	}
+
+	objKey := types.NamespacedName{Namespace: gw.Namespace, Name: string(ref.Name)}
+	if ref.Namespace != nil {
+		objKey.Namespace = string(*ref.Namespace)
+	}
+	if objKey.Namespace != gw.Namespace {
+		allowed, err := shared_utils.ValidateCrossNamespaceReference(ctx, k8sClient, gw.Namespace, gatewayAPIGroup, gatewayKind, coreAPIGroup, kind, objKey.Namespace, objKey.Name)
+		if err != nil {
+			return shared_utils.TrustStoreCABundleRef{}, "", "", err
+		}
+		if !allowed {
+			return shared_utils.TrustStoreCABundleRef{}, gwv1.ListenerReasonRefNotPermitted,
+				fmt.Sprintf("CA certificate ref %s %s: %s", kind, objKey, referenceGrantNotExists), nil
+		}
+	}
+
+	var data map[string][]byte
+	var obj client.Object
+	if kind == shared_utils.TrustStoreCABundleKindSecret {
+		obj = &corev1.Secret{}
+	} else {
+		obj = &corev1.ConfigMap{}
+	}
+	if err := k8sClient.Get(ctx, objKey, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return shared_utils.TrustStoreCABundleRef{}, gwv1.ListenerReasonInvalidCACertificateRef,
+				fmt.Sprintf("CA certificate ref %s %s not found", kind, objKey), nil
+		}
+		return shared_utils.TrustStoreCABundleRef{}, "", "", err
+	}
+	switch o := obj.(type) {
+	case *corev1.Secret:
+		data = o.Data
+	case *corev1.ConfigMap:
+		data = make(map[string][]byte, len(o.Data)+len(o.BinaryData))
+		for key, value := range o.Data {
+			data[key] = []byte(value)
+		}
+		for key, value := range o.BinaryData {
+			data[key] = value
+		}
+	}
+	if !shared_utils.ContainsPEMCertificate(data[shared_utils.DefaultTrustStoreCABundleKey]) {
+		return shared_utils.TrustStoreCABundleRef{}, gwv1.ListenerReasonInvalidCACertificateRef,
+			fmt.Sprintf("CA certificate ref %s %s has no PEM encoded certificate in key %s", kind, objKey, shared_utils.DefaultTrustStoreCABundleKey), nil
+	}
+
+	return shared_utils.TrustStoreCABundleRef{
+		Kind:      kind,
+		Namespace: objKey.Namespace,
+		Name:      objKey.Name,
+		Key:       shared_utils.DefaultTrustStoreCABundleKey,
+	}, "", "", nil
+}
+
+// validateFrontendTLS reports the client certificate validation of the Gateway in the validation results of the HTTPS listeners.
+// A listener isn't accepted when the validation mode isn't supported or when none of its CA certificate refs is valid.
+func validateFrontendTLS(ctx context.Context, k8sClient client.Client, gw gwv1.Gateway, configuredListeners allListeners, validationResults *ValidatedGatewayListeners) error {
+	if gw.Spec.TLS == nil || gw.Spec.TLS.Frontend == nil {
+		return nil
+	}
+
+	caCertsByPort := make(map[gwv1.PortNumber]FrontendCACertificates)
+	validateHTTPSListeners := func(listeners []gwv1.Listener, results *ListenerValidationResults) error {
+		for _, listener := range listeners {
+			result, exists := results.Results[listener.Name]
+			if !exists || !result.IsValid || listener.Protocol != gwv1.HTTPSProtocolType {
+				continue
+			}
+			validation := GetFrontendTLSValidation(gw, listener.Port)
+			if validation == nil {
+				continue
+			}
+			if validation.Mode != "" && validation.Mode != gwv1.AllowValidOnly {
+				result.IsValid = false
+				result.Reason = gwv1.ListenerReasonUnsupportedValue
+				result.Message = fmt.Sprintf("Unsupported client certificate validation mode %s for listener %s, only %s is supported", validation.Mode, listener.Name, gwv1.AllowValidOnly)
+				results.Results[listener.Name] = result
+				results.HasErrors = true
+				continue
+			}
+
+			caCerts, resolved := caCertsByPort[listener.Port]
+			if !resolved {
+				var err error
+				caCerts, err = ResolveFrontendCACertificateRefs(ctx, k8sClient, gw, validation)
+				if err != nil {
+					return err
+				}
+				caCertsByPort[listener.Port] = caCerts
+			}
+			if caCerts.InvalidReason == "" {
+				continue
+			}
+			result.ResolvedRefsReason = caCerts.InvalidReason
+			result.ResolvedRefsMessage = caCerts.InvalidMessage
+			if len(caCerts.CABundles) == 0 {
+				result.IsValid = false
+				result.Reason = gwv1.ListenerReasonNoValidCACertificate
+				result.Message = fmt.Sprintf("No valid CA certificate for the client certificate validation of listener %s", listener.Name)
+				results.HasErrors = true
+			}
+			results.Results[listener.Name] = result
+		}
+		return nil
+	}
+
+	if err := validateHTTPSListeners(configuredListeners.GatewayListeners, &validationResults.GatewayListenerValidation); err != nil {
+		return err
+	}
+	for nsn, lsResults := range validationResults.ListenerSetListenerValidation {
+		if err := validateHTTPSListeners(extractListenerFromListenerSource(configuredListeners.ListenerSetListeners.listenersPerListenerSet[nsn]), &lsResults); err != nil {
+			return err
+		}
+		validationResults.ListenerSetListenerValidation[nsn] = lsResults
+	}
+	return nil
+}
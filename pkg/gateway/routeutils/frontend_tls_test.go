@@ -0,0 +1,287 @@
+package routeutils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/shared_utils"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/testutils"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwbeta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const testFrontendCABundle = `-----BEGIN CERTIFICATE-----
+MIIBszCCAVmgAwIBAgIUEXAMPLE=
+-----END CERTIFICATE-----
+`
+
+func Test_GetFrontendTLSValidation(t *testing.T) {
+	defaultValidation := &gwv1.FrontendTLSValidation{CACertificateRefs: []gwv1.ObjectReference{{Kind: "ConfigMap", Name: "default-ca"}}}
+	portValidation := &gwv1.FrontendTLSValidation{CACertificateRefs: []gwv1.ObjectReference{{Kind: "ConfigMap", Name: "port-ca"}}}
+
+	testCases := []struct {
+		name     string
+		tls      *gwv1.GatewayTLSConfig
+		port     gwv1.PortNumber
+		expected *gwv1.FrontendTLSValidation
+	}{
+		{
+			name: "no tls config",
+			port: 443,
+		},
+		{
+			name: "no frontend tls config",
+			tls:  &gwv1.GatewayTLSConfig{},
+			port: 443,
+		},
+		{
+			name: "default validation",
+			tls: &gwv1.GatewayTLSConfig{
+				Frontend: &gwv1.FrontendTLSConfig{
+					Default: gwv1.TLSConfig{Validation: defaultValidation},
+					PerPort: []gwv1.TLSPortConfig{{Port: 8443, TLS: gwv1.TLSConfig{Validation: portValidation}}},
+				},
+			},
+			port:     443,
+			expected: defaultValidation,
+		},
+		{
+			name: "per port validation",
+			tls: &gwv1.GatewayTLSConfig{
+				Frontend: &gwv1.FrontendTLSConfig{
+					Default: gwv1.TLSConfig{Validation: defaultValidation},
+					PerPort: []gwv1.TLSPortConfig{{Port: 8443, TLS: gwv1.TLSConfig{Validation: portValidation}}},
+				},
+			},
+			port:     8443,
+			expected: portValidation,
+		},
+		{
+			name: "per port config without validation disables the default one",
+			tls: &gwv1.GatewayTLSConfig{
+				Frontend: &gwv1.FrontendTLSConfig{
+					Default: gwv1.TLSConfig{Validation: defaultValidation},
+					PerPort: []gwv1.TLSPortConfig{{Port: 8443}},
+				},
+			},
+			port: 8443,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gw := gwv1.Gateway{Spec: gwv1.GatewaySpec{TLS: tc.tls}}
+			assert.Equal(t, tc.expected, GetFrontendTLSValidation(gw, tc.port))
+		})
+	}
+}
+
+func Test_ResolveFrontendCACertificateRefs(t *testing.T) {
+	testCases := []struct {
+		name            string
+		refs            []gwv1.ObjectReference
+		referenceGrants []gwbeta1.ReferenceGrant
+		expected        FrontendCACertificates
+	}{
+		{
+			name: "configmap and secret refs",
+			refs: []gwv1.ObjectReference{
+				{Kind: "ConfigMap", Name: "client-ca"},
+				{Kind: "Secret", Name: "client-ca-secret"},
+			},
+			expected: FrontendCACertificates{
+				CABundles: []shared_utils.TrustStoreCABundleRef{
+					{Kind: "ConfigMap", Namespace: "gw-ns", Name: "client-ca", Key: "ca.crt"},
+					{Kind: "Secret", Namespace: "gw-ns", Name: "client-ca-secret", Key: "ca.crt"},
+				},
+			},
+		},
+		{
+			name: "unsupported kind",
+			refs: []gwv1.ObjectReference{
+				{Group: "example.com", Kind: "ConfigMap", Name: "client-ca"},
+			},
+			expected: FrontendCACertificates{
+				InvalidReason:  gwv1.ListenerReasonInvalidCACertificateKind,
+				InvalidMessage: "Unsupported kind ConfigMap for CA certificate ref client-ca, must be ConfigMap or Secret",
+			},
+		},
+		{
+			name: "missing ref and ref without certificate are skipped",
+			refs: []gwv1.ObjectReference{
+				{Kind: "ConfigMap", Name: "missing-ca"},
+				{Kind: "ConfigMap", Name: "not-a-ca"},
+				{Kind: "ConfigMap", Name: "client-ca"},
+			},
+			expected: FrontendCACertificates{
+				CABundles: []shared_utils.TrustStoreCABundleRef{
+					{Kind: "ConfigMap", Namespace: "gw-ns", Name: "client-ca", Key: "ca.crt"},
+				},
+				InvalidReason:  gwv1.ListenerReasonInvalidCACertificateRef,
+				InvalidMessage: "CA certificate ref ConfigMap gw-ns/missing-ca not found",
+			},
+		},
+		{
+			name: "cross namespace ref without reference grant",
+			refs: []gwv1.ObjectReference{
+				{Kind: "ConfigMap", Name: "shared-ca", Namespace: ptr(gwv1.Namespace("ca-ns"))},
+			},
+			expected: FrontendCACertificates{
+				InvalidReason:  gwv1.ListenerReasonRefNotPermitted,
+				InvalidMessage: "CA certificate ref ConfigMap ca-ns/shared-ca: No explicit ReferenceGrant exists to allow the reference.",
+			},
+		},
+		{
+			name: "cross namespace ref with reference grant",
+			refs: []gwv1.ObjectReference{
+				{Kind: "ConfigMap", Name: "shared-ca", Namespace: ptr(gwv1.Namespace("ca-ns"))},
+			},
+			referenceGrants: []gwbeta1.ReferenceGrant{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ca-ns", Name: "grant"},
+					Spec: gwbeta1.ReferenceGrantSpec{
+						From: []gwbeta1.ReferenceGrantFrom{{Group: gatewayAPIGroup, Kind: gatewayKind, Namespace: "gw-ns"}},
+						To:   []gwbeta1.ReferenceGrantTo{{Group: coreAPIGroup, Kind: "ConfigMap"}},
+					},
+				},
+			},
+			expected: FrontendCACertificates{
+				CABundles: []shared_utils.TrustStoreCABundleRef{
+					{Kind: "ConfigMap", Namespace: "ca-ns", Name: "shared-ca", Key: "ca.crt"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sClient := testutils.GenerateTestClient()
+			assert.NoError(t, k8sClient.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "gw-ns", Name: "client-ca"},
+				Data:       map[string]string{"ca.crt": testFrontendCABundle},
+			}))
+			assert.NoError(t, k8sClient.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "gw-ns", Name: "not-a-ca"},
+				Data:       map[string]string{"ca.crt": "not a certificate"},
+			}))
+			assert.NoError(t, k8sClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "gw-ns", Name: "client-ca-secret"},
+				Data:       map[string][]byte{"ca.crt": []byte(testFrontendCABundle)},
+			}))
+			assert.NoError(t, k8sClient.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ca-ns", Name: "shared-ca"},
+				Data:       map[string]string{"ca.crt": testFrontendCABundle},
+			}))
+			for i := range tc.referenceGrants {
+				assert.NoError(t, k8sClient.Create(ctx, &tc.referenceGrants[i]))
+			}
+			gw := gwv1.Gateway{ObjectMeta: metav1.ObjectMeta{Namespace: "gw-ns", Name: "gw"}}
+
+			result, err := ResolveFrontendCACertificateRefs(ctx, k8sClient, gw, &gwv1.FrontendTLSValidation{CACertificateRefs: tc.refs})
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func Test_validateFrontendTLS(t *testing.T) {
+	validRef := gwv1.ObjectReference{Kind: "ConfigMap", Name: "client-ca"}
+	missingRef := gwv1.ObjectReference{Kind: "ConfigMap", Name: "missing-ca"}
+	listeners := []gwv1.Listener{
+		{Name: "http", Port: 80, Protocol: gwv1.HTTPProtocolType},
+		{Name: "https", Port: 443, Protocol: gwv1.HTTPSProtocolType},
+	}
+
+	testCases := []struct {
+		name              string
+		validation        *gwv1.FrontendTLSValidation
+		expectedHTTPS     ListenerValidationResult
+		expectedHasErrors bool
+	}{
+		{
+			name:       "valid CA certificate refs",
+			validation: &gwv1.FrontendTLSValidation{CACertificateRefs: []gwv1.ObjectReference{validRef}},
+			expectedHTTPS: ListenerValidationResult{
+				ListenerName: "https",
+				IsValid:      true,
+			},
+		},
+		{
+			name:       "some CA certificate refs are invalid",
+			validation: &gwv1.FrontendTLSValidation{CACertificateRefs: []gwv1.ObjectReference{missingRef, validRef}},
+			expectedHTTPS: ListenerValidationResult{
+				ListenerName:        "https",
+				IsValid:             true,
+				ResolvedRefsReason:  gwv1.ListenerReasonInvalidCACertificateRef,
+				ResolvedRefsMessage: "CA certificate ref ConfigMap gw-ns/missing-ca not found",
+			},
+		},
+		{
+			name:       "no valid CA certificate ref",
+			validation: &gwv1.FrontendTLSValidation{CACertificateRefs: []gwv1.ObjectReference{missingRef}},
+			expectedHTTPS: ListenerValidationResult{
+				ListenerName:        "https",
+				IsValid:             false,
+				Reason:              gwv1.ListenerReasonNoValidCACertificate,
+				Message:             "No valid CA certificate for the client certificate validation of listener https",
+				ResolvedRefsReason:  gwv1.ListenerReasonInvalidCACertificateRef,
+				ResolvedRefsMessage: "CA certificate ref ConfigMap gw-ns/missing-ca not found",
+			},
+			expectedHasErrors: true,
+		},
+		{
+			name: "insecure fallback mode",
+			validation: &gwv1.FrontendTLSValidation{
+				CACertificateRefs: []gwv1.ObjectReference{validRef},
+				Mode:              gwv1.AllowInsecureFallback,
+			},
+			expectedHTTPS: ListenerValidationResult{
+				ListenerName: "https",
+				IsValid:      false,
+				Reason:       gwv1.ListenerReasonUnsupportedValue,
+				Message:      "Unsupported client certificate validation mode AllowInsecureFallback for listener https, only AllowValidOnly is supported",
+			},
+			expectedHasErrors: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sClient := testutils.GenerateTestClient()
+			assert.NoError(t, k8sClient.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "gw-ns", Name: "client-ca"},
+				Data:       map[string]string{"ca.crt": testFrontendCABundle},
+			}))
+			gw := gwv1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "gw-ns", Name: "gw"},
+				Spec: gwv1.GatewaySpec{
+					Listeners: listeners,
+					TLS: &gwv1.GatewayTLSConfig{
+						Frontend: &gwv1.FrontendTLSConfig{Default: gwv1.TLSConfig{Validation: tc.validation}},
+					},
+				},
+			}
+			validationResults := ValidatedGatewayListeners{
+				GatewayListenerValidation: ListenerValidationResults{
+					Results: map[gwv1.SectionName]ListenerValidationResult{
+						"http":  {ListenerName: "http", IsValid: true},
+						"https": {ListenerName: "https", IsValid: true},
+					},
+				},
+				ListenerSetListenerValidation: map[types.NamespacedName]ListenerValidationResults{},
+			}
+
+			err := validateFrontendTLS(ctx, k8sClient, gw, allListeners{GatewayListeners: listeners}, &validationResults)
+			assert.NoError(t, err)
+			assert.Equal(t, ListenerValidationResult{ListenerName: "http", IsValid: true}, validationResults.GatewayListenerValidation.Results["http"])
+			assert.Equal(t, tc.expectedHTTPS, validationResults.GatewayListenerValidation.Results["https"])
+			assert.Equal(t, tc.expectedHasErrors, validationResults.HasErrors())
+		})
+	}
+}
@@ -21,7 +21,7 @@ GRPC specific features of the route.
 
 var _ RouteRule = &convertedGRPCRouteRule{}
 
-var defaultGRPCRuleAccumulator = newAttachedRuleAccumulator[gwv1.GRPCRouteRule](commonBackendLoader, listenerRuleConfigLoader)
+var defaultGRPCRuleAccumulator = newAttachedRuleAccumulator[gwv1.GRPCRouteRule](commonBackendLoader, listenerRuleConfigLoader, routeTargetGroupConfigLoader)
 
 type convertedGRPCRouteRule struct {
 	rule               *gwv1.GRPCRouteRule
@@ -60,10 +60,13 @@ type grpcRouteDescription struct {
 
 func (grpcRoute *grpcRouteDescription) loadAttachedRules(ctx context.Context, k8sClient client.Client, gatewayDefaultTGConfig *elbv2gw.TargetGroupConfiguration) (RouteDescriptor, []routeLoadError) {
 	convertedRules, allErrors := grpcRoute.ruleAccumulator.accumulateRules(ctx, k8sClient, grpcRoute, grpcRoute.route.Spec.Rules,
-		func(rule gwv1.GRPCRouteRule) []gwv1.BackendRef {
-			refs := make([]gwv1.BackendRef, 0, len(rule.BackendRefs))
+		func(rule gwv1.GRPCRouteRule) []routeBackendRef {
+			refs := make([]routeBackendRef, 0, len(rule.BackendRefs))
 			for _, grpcRef := range rule.BackendRefs {
-				refs = append(refs, grpcRef.BackendRef)
+				refs = append(refs, routeBackendRef{
+					BackendRef:            grpcRef.BackendRef,
+					targetGroupConfigRefs: getGRPCBackendTargetGroupConfigRefs(grpcRef),
+				})
 			}
 			return refs
 		}, func(rule gwv1.GRPCRouteRule) []gwv1.LocalObjectReference {
@@ -76,6 +79,7 @@ func (grpcRoute *grpcRouteDescription) loadAttachedRules(ctx context.Context, k8
 			return convertGRPCRouteRule(grr, backends, listenerRuleConfiguration)
 		}, gatewayDefaultTGConfig)
 	grpcRoute.rules = convertedRules
+	allErrors = append(allErrors, validateRouteTargetGroupConfigs(grpcRoute)...)
 	return grpcRoute, allErrors
 }
 
@@ -145,6 +149,28 @@ func (grpcRoute *grpcRouteDescription) GetRouteGeneration() int64 {
 	return grpcRoute.route.Generation
 }
 
+// GetRouteTargetGroupConfigRefs returns all TargetGroupConfiguration references from
+// ExtensionRef filters of the backends in the GRPCRoute
+func (grpcRoute *grpcRouteDescription) GetRouteTargetGroupConfigRefs() []gwv1.LocalObjectReference {
+	targetGroupConfigs := make([]gwv1.LocalObjectReference, 0)
+	for _, rule := range grpcRoute.route.Spec.Rules {
+		for _, grpcRef := range rule.BackendRefs {
+			targetGroupConfigs = append(targetGroupConfigs, getGRPCBackendTargetGroupConfigRefs(grpcRef)...)
+		}
+	}
+	return targetGroupConfigs
+}
+
+// getGRPCBackendTargetGroupConfigRefs returns the TargetGroupConfiguration references from the ExtensionRef filters of a backend
+func getGRPCBackendTargetGroupConfigRefs(backendRef gwv1.GRPCBackendRef) []gwv1.LocalObjectReference {
+	return getTargetGroupConfigForBackendGeneric(backendRef.Filters,
+		func(filter gwv1.GRPCRouteFilter) bool {
+			return filter.Type == gwv1.GRPCRouteFilterExtensionRef
+		}, func(filter gwv1.GRPCRouteFilter) *gwv1.LocalObjectReference {
+			return filter.ExtensionRef
+		})
+}
+
 func (grpcRoute *grpcRouteDescription) GetRouteCreateTimestamp() time.Time {
 	return grpcRoute.route.CreationTimestamp.Time
 }
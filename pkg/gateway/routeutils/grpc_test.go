@@ -193,7 +193,7 @@ func Test_GRPC_LoadAttachedRules(t *testing.T) {
 			}},
 		},
 		rules:           nil,
-		ruleAccumulator: newAttachedRuleAccumulator[gwv1.GRPCRouteRule](mockBackendLoader, mockListenerRuleConfigLoader),
+		ruleAccumulator: newAttachedRuleAccumulator[gwv1.GRPCRouteRule](mockBackendLoader, mockListenerRuleConfigLoader, routeTargetGroupConfigLoader),
 	}
 
 	result, errs := routeDescription.loadAttachedRules(context.Background(), nil, nil)
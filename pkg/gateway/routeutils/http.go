@@ -21,7 +21,7 @@ HTTP specific features of the route.
 
 var _ RouteRule = &convertedHTTPRouteRule{}
 
-var defaultHTTPRuleAccumulator = newAttachedRuleAccumulator[gwv1.HTTPRouteRule](commonBackendLoader, listenerRuleConfigLoader)
+var defaultHTTPRuleAccumulator = newAttachedRuleAccumulator[gwv1.HTTPRouteRule](commonBackendLoader, listenerRuleConfigLoader, routeTargetGroupConfigLoader)
 
 type convertedHTTPRouteRule struct {
 	rule               *gwv1.HTTPRouteRule
@@ -64,10 +64,13 @@ func (httpRoute *httpRouteDescription) GetAttachedRules() []RouteRule {
 
 func (httpRoute *httpRouteDescription) loadAttachedRules(ctx context.Context, k8sClient client.Client, gatewayDefaultTGConfig *elbv2gw.TargetGroupConfiguration) (RouteDescriptor, []routeLoadError) {
 	convertedRules, allErrors := httpRoute.ruleAccumulator.accumulateRules(ctx, k8sClient, httpRoute, httpRoute.route.Spec.Rules,
-		func(rule gwv1.HTTPRouteRule) []gwv1.BackendRef {
-			refs := make([]gwv1.BackendRef, 0, len(rule.BackendRefs))
+		func(rule gwv1.HTTPRouteRule) []routeBackendRef {
+			refs := make([]routeBackendRef, 0, len(rule.BackendRefs))
 			for _, httpRef := range rule.BackendRefs {
-				refs = append(refs, httpRef.BackendRef)
+				refs = append(refs, routeBackendRef{
+					BackendRef:            httpRef.BackendRef,
+					targetGroupConfigRefs: getHTTPBackendTargetGroupConfigRefs(httpRef),
+				})
 			}
 			return refs
 		}, func(rule gwv1.HTTPRouteRule) []gwv1.LocalObjectReference {
@@ -81,6 +84,9 @@ func (httpRoute *httpRouteDescription) loadAttachedRules(ctx context.Context, k8
 			return convertHTTPRouteRule(hrr, backends, listenerRuleConfiguration)
 		}, gatewayDefaultTGConfig)
 	httpRoute.rules = convertedRules
+	allErrors = append(allErrors, validateHTTPRouteCORSFilters(httpRoute)...)
+	allErrors = append(allErrors, validateHTTPRouteHeaderModifiers(httpRoute)...)
+	allErrors = append(allErrors, validateRouteTargetGroupConfigs(httpRoute)...)
 	return httpRoute, allErrors
 }
 
@@ -138,6 +144,28 @@ func (httpRoute *httpRouteDescription) GetRouteListenerRuleConfigRefs() []gwv1.L
 	return listenerRuleConfigs
 }
 
+// GetRouteTargetGroupConfigRefs returns all TargetGroupConfiguration references from
+// ExtensionRef filters of the backends in the HTTPRoute
+func (httpRoute *httpRouteDescription) GetRouteTargetGroupConfigRefs() []gwv1.LocalObjectReference {
+	targetGroupConfigs := make([]gwv1.LocalObjectReference, 0)
+	for _, rule := range httpRoute.route.Spec.Rules {
+		for _, httpRef := range rule.BackendRefs {
+			targetGroupConfigs = append(targetGroupConfigs, getHTTPBackendTargetGroupConfigRefs(httpRef)...)
+		}
+	}
+	return targetGroupConfigs
+}
+
+// getHTTPBackendTargetGroupConfigRefs returns the TargetGroupConfiguration references from the ExtensionRef filters of a backend
+func getHTTPBackendTargetGroupConfigRefs(backendRef gwv1.HTTPBackendRef) []gwv1.LocalObjectReference {
+	return getTargetGroupConfigForBackendGeneric(backendRef.Filters,
+		func(filter gwv1.HTTPRouteFilter) bool {
+			return filter.Type == gwv1.HTTPRouteFilterExtensionRef
+		}, func(filter gwv1.HTTPRouteFilter) *gwv1.LocalObjectReference {
+			return filter.ExtensionRef
+		})
+}
+
 func (httpRoute *httpRouteDescription) GetRouteCreateTimestamp() time.Time {
 	return httpRoute.route.CreationTimestamp.Time
 }
@@ -77,6 +77,9 @@ type ListenerValidationResult struct {
 	Message             string
 	SupportedKinds      []gwv1.RouteGroupKind
 	AttachedRoutesCount int32
+	// ResolvedRefsReason overrides the ResolvedRefs condition of an accepted listener with unresolved references.
+	ResolvedRefsReason  gwv1.ListenerConditionReason
+	ResolvedRefsMessage string
 }
 
 type ListenerValidationResults struct {
@@ -136,6 +136,9 @@ func (l *loaderImpl) LoadRoutesForGateway(ctx context.Context, gw gwv1.Gateway,
 	}
 
 	listenerValidationResults := validateListeners(gatewayListeners, gw.Generation, controllerName)
+	if err := validateFrontendTLS(ctx, l.k8sClient, gw, gatewayListeners, &listenerValidationResults); err != nil {
+		return nil, err
+	}
 
 	//  2. Map routes to relevant listeners
 	mapResult, err := l.mapper.mapListenersAndRoutes(ctx, gw, gatewayListeners, loadedRoutes, listenerValidationResults)
@@ -153,7 +156,12 @@ func (l *loaderImpl) LoadRoutesForGateway(ctx context.Context, gw gwv1.Gateway,
 		return nil, err
 	}
 
-	// 4. update status for accepted routes - generate per matched parentRef
+	// 4. ALB applies a single set of CORS headers per listener, report routes whose CORS filter isn't applied.
+	routeStatusUpdates = append(routeStatusUpdates, generateUnsupportedCORSRouteData(loadedRoute, mapResult.matchedParentRefs)...)
+	// ALB also sets a single set of response headers per listener, report routes whose ResponseHeaderModifier is overridden.
+	routeStatusUpdates = append(routeStatusUpdates, generateConflictingResponseHeaderRouteData(loadedRoute, mapResult.matchedParentRefs)...)
+
+	// 5. update status for accepted routes - generate per matched parentRef
 	for _, routeList := range loadedRoute {
 		for _, route := range routeList {
 			routeKey := route.GetRouteIdentifier()
@@ -92,6 +92,11 @@ func (m *mockRoute) GetRouteListenerRuleConfigRefs() []gwv1.LocalObjectReference
 	panic("implement me")
 }
 
+func (m *mockRoute) GetRouteTargetGroupConfigRefs() []gwv1.LocalObjectReference {
+	//TODO implement me
+	panic("implement me")
+}
+
 func (m *mockRoute) GetRouteGeneration() int64 {
 	return m.generation
 }
@@ -102,8 +107,7 @@ func (m *mockRoute) GetRawRoute() interface{} {
 }
 
 func (m *mockRoute) GetAttachedRules() []RouteRule {
-	//TODO implement me
-	panic("implement me")
+	return nil
 }
 
 func (m *mockRoute) GetRouteCreateTimestamp() time.Time {
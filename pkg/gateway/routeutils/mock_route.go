@@ -47,6 +47,11 @@ func (m *MockRoute) GetRouteListenerRuleConfigRefs() []gwv1.LocalObjectReference
 	panic("implement me")
 }
 
+func (m *MockRoute) GetRouteTargetGroupConfigRefs() []gwv1.LocalObjectReference {
+	//TODO implement me
+	panic("implement me")
+}
+
 func (m *MockRoute) GetRouteNamespacedName() types.NamespacedName {
 	return types.NamespacedName{
 		Namespace: m.Namespace,
@@ -59,7 +64,7 @@ func (m *MockRoute) GetRouteKind() RouteKind {
 }
 
 func (m *MockRoute) GetRouteIdentifier() string {
-	panic("implement me")
+	return string(m.GetRouteKind()) + "-" + m.GetRouteNamespacedName().String()
 }
 
 func (m *MockRoute) GetHostnames() []gwv1.Hostname {
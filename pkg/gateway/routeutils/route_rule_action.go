@@ -243,6 +243,12 @@ func buildHttpRuleRedirectActionsBasedOnFilter(filters []gwv1.HTTPRouteFilter, r
 			continue
 		case gwv1.HTTPRouteFilterURLRewrite:
 			continue
+		case gwv1.HTTPRouteFilterCORS:
+			// CORS is implemented by a preflight rule and listener attributes, see route_rule_cors.go
+			continue
+		case gwv1.HTTPRouteFilterRequestHeaderModifier, gwv1.HTTPRouteFilterResponseHeaderModifier:
+			// header modifiers are implemented by transforms and attributes, see route_rule_header_modifier.go
+			continue
 		default:
 			return nil, errors.Errorf("Unsupported filter type: %v. Only request redirect is supported. To specify header modification, please configure it through LoadBalancerConfiguration.", filter.Type)
 		}
@@ -144,11 +144,23 @@ func Test_BuildHttpRuleRedirectActionsBasedOnFilter(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name: "unsupported filter type",
+			name: "header modifier filters are implemented by transforms and attributes",
 			filters: []gwv1.HTTPRouteFilter{
 				{
 					Type: gwv1.HTTPRouteFilterRequestHeaderModifier,
 				},
+				{
+					Type: gwv1.HTTPRouteFilterResponseHeaderModifier,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "unsupported filter type",
+			filters: []gwv1.HTTPRouteFilter{
+				{
+					Type: gwv1.HTTPRouteFilterRequestMirror,
+				},
 			},
 			wantErr:     true,
 			errContains: "Unsupported filter type",
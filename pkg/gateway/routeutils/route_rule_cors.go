@@ -0,0 +1,305 @@
+package routeutils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/equality"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+const (
+	// ALB listener attributes that insert the CORS headers in every response of the listener.
+	listenerAttributeCORSAllowOrigin      = "routing.http.response.access_control_allow_origin.header_value"
+	listenerAttributeCORSAllowMethods     = "routing.http.response.access_control_allow_methods.header_value"
+	listenerAttributeCORSAllowHeaders     = "routing.http.response.access_control_allow_headers.header_value"
+	listenerAttributeCORSAllowCredentials = "routing.http.response.access_control_allow_credentials.header_value"
+	listenerAttributeCORSExposeHeaders    = "routing.http.response.access_control_expose_headers.header_value"
+	listenerAttributeCORSMaxAge           = "routing.http.response.access_control_max_age.header_value"
+
+	corsWildcard        = "*"
+	corsOriginHeader    = "Origin"
+	corsPreflightCode   = "204"
+	corsDefaultMaxAge   = 5
+	corsHeaderSeparator = ","
+)
+
+// corsListenerAttributeKeys are the listener attributes inserting the CORS headers, in the order they are built.
+var corsListenerAttributeKeys = []string{
+	listenerAttributeCORSAllowOrigin,
+	listenerAttributeCORSAllowMethods,
+	listenerAttributeCORSAllowHeaders,
+	listenerAttributeCORSAllowCredentials,
+	listenerAttributeCORSExposeHeaders,
+	listenerAttributeCORSMaxAge,
+}
+
+// corsAllMethods is used in place of the method wildcard, which ALB doesn't accept as header value.
+var corsAllMethods = []string{"GET", "HEAD", "POST", "PUT", "DELETE", "CONNECT", "OPTIONS", "TRACE", "PATCH"}
+
+// GetHttpRuleCORSFilter returns the CORS filter of an HTTPRoute rule, or nil if the rule doesn't configure one.
+func GetHttpRuleCORSFilter(rule RouteRule) *gwv1.HTTPCORSFilter {
+	httpRule, ok := rule.GetRawRouteRule().(*gwv1.HTTPRouteRule)
+	if !ok || httpRule == nil {
+		return nil
+	}
+	return getCORSFilter(httpRule.Filters)
+}
+
+func getCORSFilter(filters []gwv1.HTTPRouteFilter) *gwv1.HTTPCORSFilter {
+	for _, filter := range filters {
+		if filter.Type == gwv1.HTTPRouteFilterCORS && filter.CORS != nil {
+			return filter.CORS
+		}
+	}
+	return nil
+}
+
+// GetListenerCORSFilter returns the CORS filter that configures the CORS response headers of a listener, or nil if none applies.
+// ALB inserts the same CORS headers in all responses of a listener, see resolveListenerCORSFilter.
+func GetListenerCORSFilter(rulesWithPrecedence []RulePrecedence) *gwv1.HTTPCORSFilter {
+	cors, _ := resolveListenerCORSFilter(rulesWithPrecedence)
+	return cors
+}
+
+// resolveListenerCORSFilter returns the CORS filter that configures the CORS response headers of a listener.
+// As ALB inserts the same CORS headers in all responses of a listener, a CORS filter is only applied when a single route
+// is attached to the listener and its rules don't configure different CORS filters. Otherwise, it returns why no CORS filter is applied.
+func resolveListenerCORSFilter(rulesWithPrecedence []RulePrecedence) (*gwv1.HTTPCORSFilter, string) {
+	var listenerCORS *gwv1.HTTPCORSFilter
+	routeKeys := make(map[string]bool)
+	for _, ruleWithPrecedence := range rulesWithPrecedence {
+		routeKeys[ruleWithPrecedence.CommonRulePrecedence.RouteDescriptor.GetRouteIdentifier()] = true
+		cors := GetHttpRuleCORSFilter(ruleWithPrecedence.CommonRulePrecedence.Rule)
+		if cors == nil {
+			continue
+		}
+		if listenerCORS != nil && !equality.Semantic.DeepEqual(cors, listenerCORS) {
+			return nil, "rules of the route configure different CORS filters"
+		}
+		listenerCORS = cors
+	}
+	if listenerCORS != nil && len(routeKeys) > 1 {
+		return nil, "other routes are attached to the listener"
+	}
+	return listenerCORS, ""
+}
+
+// BuildCORSPreflightRuleConditions builds the conditions of the rule answering the preflight requests of a rule with a CORS filter.
+// Preflight requests don't carry the headers nor the method of the actual request, so only the host, path, query string and
+// source IP conditions of the rule are kept.
+func BuildCORSPreflightRuleConditions(cors *gwv1.HTTPCORSFilter, ruleConditions []elbv2model.RuleCondition) []elbv2model.RuleCondition {
+	conditions := make([]elbv2model.RuleCondition, 0, len(ruleConditions)+2)
+	for _, condition := range ruleConditions {
+		if condition.Field == elbv2model.RuleConditionFieldHTTPHeader || condition.Field == elbv2model.RuleConditionFieldHTTPRequestMethod {
+			continue
+		}
+		conditions = append(conditions, condition)
+	}
+
+	origins := make([]string, 0, len(cors.AllowOrigins))
+	for _, origin := range cors.AllowOrigins {
+		origins = append(origins, string(origin))
+	}
+	if len(origins) == 0 {
+		origins = []string{corsWildcard}
+	}
+	conditions = append(conditions,
+		elbv2model.RuleCondition{
+			Field: elbv2model.RuleConditionFieldHTTPRequestMethod,
+			HTTPRequestMethodConfig: &elbv2model.HTTPRequestMethodConditionConfig{
+				Values: []string{"OPTIONS"},
+			},
+		},
+		elbv2model.RuleCondition{
+			Field: elbv2model.RuleConditionFieldHTTPHeader,
+			HTTPHeaderConfig: &elbv2model.HTTPHeaderConditionConfig{
+				HTTPHeaderName: corsOriginHeader,
+				Values:         origins,
+			},
+		},
+	)
+	return conditions
+}
+
+// BuildCORSPreflightAction builds the action answering the preflight requests, the CORS headers are inserted by the listener.
+func BuildCORSPreflightAction() elbv2model.Action {
+	return elbv2model.Action{
+		Type: elbv2model.ActionTypeFixedResponse,
+		FixedResponseConfig: &elbv2model.FixedResponseActionConfig{
+			ContentType: awssdk.String("text/plain"),
+			StatusCode:  corsPreflightCode,
+		},
+	}
+}
+
+// BuildCORSListenerAttributes builds the listener attributes inserting the CORS response headers configured by the filter.
+// Listener attributes are reconciled by difference, so the headers that aren't configured, or that ALB can't represent
+// (see validateCORSFilter), are reset with an empty value, which also removes the headers of a removed filter.
+func BuildCORSListenerAttributes(cors *gwv1.HTTPCORSFilter) []elbv2model.ListenerAttribute {
+	headerValues := buildCORSHeaderValues(cors)
+	attributes := make([]elbv2model.ListenerAttribute, 0, len(corsListenerAttributeKeys))
+	for _, key := range corsListenerAttributeKeys {
+		attributes = append(attributes, elbv2model.ListenerAttribute{Key: key, Value: headerValues[key]})
+	}
+	return attributes
+}
+
+// buildCORSHeaderValues returns the CORS header values configured by the filter, by listener attribute.
+func buildCORSHeaderValues(cors *gwv1.HTTPCORSFilter) map[string]string {
+	headerValues := make(map[string]string)
+	if cors == nil {
+		return headerValues
+	}
+	allowCredentials := awssdk.ToBool(cors.AllowCredentials)
+
+	if len(cors.AllowOrigins) == 1 && isStaticCORSOrigin(cors.AllowOrigins[0], allowCredentials) {
+		headerValues[listenerAttributeCORSAllowOrigin] = string(cors.AllowOrigins[0])
+	}
+
+	if len(cors.AllowMethods) != 0 {
+		methods := make([]string, 0, len(cors.AllowMethods))
+		for _, method := range cors.AllowMethods {
+			if string(method) == corsWildcard {
+				methods = corsAllMethods
+				break
+			}
+			methods = append(methods, string(method))
+		}
+		headerValues[listenerAttributeCORSAllowMethods] = strings.Join(methods, corsHeaderSeparator)
+	}
+
+	if value, ok := joinCORSHeaderNames(cors.AllowHeaders, allowCredentials); ok {
+		headerValues[listenerAttributeCORSAllowHeaders] = value
+	}
+
+	if allowCredentials {
+		headerValues[listenerAttributeCORSAllowCredentials] = "true"
+	}
+
+	if value, ok := joinCORSHeaderNames(cors.ExposeHeaders, allowCredentials); ok {
+		headerValues[listenerAttributeCORSExposeHeaders] = value
+	}
+
+	maxAge := cors.MaxAge
+	if maxAge == 0 {
+		maxAge = corsDefaultMaxAge
+	}
+	headerValues[listenerAttributeCORSMaxAge] = strconv.Itoa(int(maxAge))
+	return headerValues
+}
+
+// validateCORSFilter returns the options of the CORS filter that ALB can't honor.
+// ALB inserts static header values, so it can't echo the origin or the requested headers of the request.
+func validateCORSFilter(cors *gwv1.HTTPCORSFilter) []string {
+	allowCredentials := awssdk.ToBool(cors.AllowCredentials)
+	var unsupported []string
+	if len(cors.AllowOrigins) > 1 {
+		unsupported = append(unsupported, "allowOrigins with multiple origins, Access-Control-Allow-Origin can only contain a single origin")
+	} else if len(cors.AllowOrigins) == 1 && !isStaticCORSOrigin(cors.AllowOrigins[0], allowCredentials) {
+		unsupported = append(unsupported, fmt.Sprintf("allowOrigins %q, the request origin can't be echoed in Access-Control-Allow-Origin", cors.AllowOrigins[0]))
+	}
+	if _, ok := joinCORSHeaderNames(cors.AllowHeaders, allowCredentials); !ok {
+		unsupported = append(unsupported, "allowHeaders wildcard with allowCredentials, the requested headers can't be echoed in Access-Control-Allow-Headers")
+	}
+	if _, ok := joinCORSHeaderNames(cors.ExposeHeaders, allowCredentials); !ok {
+		unsupported = append(unsupported, "exposeHeaders wildcard with allowCredentials")
+	}
+	return unsupported
+}
+
+// validateHTTPRouteCORSFilters reports the CORS filter options that ALB can't honor as a non-fatal error, the rest of the filter is still applied.
+func validateHTTPRouteCORSFilters(httpRoute *httpRouteDescription) []routeLoadError {
+	var loadErrors []routeLoadError
+	for _, rule := range httpRoute.route.Spec.Rules {
+		cors := getCORSFilter(rule.Filters)
+		if cors == nil {
+			continue
+		}
+		unsupported := validateCORSFilter(cors)
+		if len(unsupported) == 0 {
+			continue
+		}
+		initialErrorMessage := fmt.Sprintf("CORS filter options not supported by ALB: %s", strings.Join(unsupported, "; "))
+		wrappedGatewayErrorMessage := generateInvalidMessageWithRouteDetails(initialErrorMessage, httpRoute.GetRouteKind(), httpRoute.GetRouteNamespacedName())
+		loadErrors = append(loadErrors, routeLoadError{
+			Err: wrapError(errors.Errorf("%s", initialErrorMessage), gwv1.GatewayReasonListenersNotValid, gwv1.RouteReasonUnsupportedValue, &wrappedGatewayErrorMessage, nil),
+		})
+	}
+	return loadErrors
+}
+
+// findUnsupportedCORSRoutes returns the routes with a CORS filter that isn't applied to the listener of the port, and why.
+func findUnsupportedCORSRoutes(routes []RouteDescriptor, port int32) ([]RouteDescriptor, string) {
+	if !hasCORSFilter(routes) {
+		return nil, ""
+	}
+	rulesWithPrecedence := SortAllRulesByPrecedence(routes, port)
+	_, reason := resolveListenerCORSFilter(rulesWithPrecedence)
+	if reason == "" {
+		return nil, ""
+	}
+	var unsupportedRoutes []RouteDescriptor
+	seen := make(map[string]bool)
+	for _, ruleWithPrecedence := range rulesWithPrecedence {
+		route := ruleWithPrecedence.CommonRulePrecedence.RouteDescriptor
+		if GetHttpRuleCORSFilter(ruleWithPrecedence.CommonRulePrecedence.Rule) == nil || seen[route.GetRouteIdentifier()] {
+			continue
+		}
+		seen[route.GetRouteIdentifier()] = true
+		unsupportedRoutes = append(unsupportedRoutes, route)
+	}
+	return unsupportedRoutes, reason
+}
+
+// generateUnsupportedCORSRouteData generates the route status of the routes whose CORS filter isn't applied to the listener.
+func generateUnsupportedCORSRouteData(routesByPort map[int32][]RouteDescriptor, matchedParentRefs map[string][]gwv1.ParentReference) []RouteData {
+	var routeData []RouteData
+	for port, routes := range routesByPort {
+		unsupportedRoutes, reason := findUnsupportedCORSRoutes(routes, port)
+		for _, route := range unsupportedRoutes {
+			message := fmt.Sprintf("CORS filter isn't applied on port %d: %s, ALB applies a single CORS configuration to all responses of a listener", port, reason)
+			for _, parentRef := range matchedParentRefs[route.GetRouteIdentifier()] {
+				routeData = append(routeData, GenerateRouteData(false, true, string(gwv1.RouteReasonUnsupportedValue), message, route.GetRouteNamespacedName(), route.GetRouteKind(), route.GetRouteGeneration(), parentRef))
+			}
+		}
+	}
+	return routeData
+}
+
+func hasCORSFilter(routes []RouteDescriptor) bool {
+	for _, route := range routes {
+		for _, rule := range route.GetAttachedRules() {
+			if GetHttpRuleCORSFilter(rule) != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isStaticCORSOrigin checks whether the origin can be returned as is in Access-Control-Allow-Origin.
+func isStaticCORSOrigin(origin gwv1.CORSOrigin, allowCredentials bool) bool {
+	if string(origin) == corsWildcard {
+		return !allowCredentials
+	}
+	return !strings.Contains(string(origin), corsWildcard)
+}
+
+func joinCORSHeaderNames(headerNames []gwv1.HTTPHeaderName, allowCredentials bool) (string, bool) {
+	if len(headerNames) == 0 {
+		return "", true
+	}
+	values := make([]string, 0, len(headerNames))
+	for _, headerName := range headerNames {
+		if string(headerName) == corsWildcard && allowCredentials {
+			return "", false
+		}
+		values = append(values, string(headerName))
+	}
+	return strings.Join(values, corsHeaderSeparator), true
+}
@@ -0,0 +1,327 @@
+package routeutils
+
+import (
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func Test_BuildCORSListenerAttributes(t *testing.T) {
+	testCases := []struct {
+		name     string
+		cors     *gwv1.HTTPCORSFilter
+		expected []elbv2model.ListenerAttribute
+	}{
+		{
+			name: "no cors filter resets the headers of a removed filter",
+			expected: []elbv2model.ListenerAttribute{
+				{Key: "routing.http.response.access_control_allow_origin.header_value", Value: ""},
+				{Key: "routing.http.response.access_control_allow_methods.header_value", Value: ""},
+				{Key: "routing.http.response.access_control_allow_headers.header_value", Value: ""},
+				{Key: "routing.http.response.access_control_allow_credentials.header_value", Value: ""},
+				{Key: "routing.http.response.access_control_expose_headers.header_value", Value: ""},
+				{Key: "routing.http.response.access_control_max_age.header_value", Value: ""},
+			},
+		},
+		{
+			name: "single origin with all options",
+			cors: &gwv1.HTTPCORSFilter{
+				AllowOrigins:     []gwv1.CORSOrigin{"https://example.com"},
+				AllowCredentials: awssdk.Bool(true),
+				AllowMethods:     []gwv1.HTTPMethodWithWildcard{"GET", "PUT"},
+				AllowHeaders:     []gwv1.HTTPHeaderName{"x-custom", "authorization"},
+				ExposeHeaders:    []gwv1.HTTPHeaderName{"x-request-id"},
+				MaxAge:           600,
+			},
+			expected: []elbv2model.ListenerAttribute{
+				{Key: "routing.http.response.access_control_allow_origin.header_value", Value: "https://example.com"},
+				{Key: "routing.http.response.access_control_allow_methods.header_value", Value: "GET,PUT"},
+				{Key: "routing.http.response.access_control_allow_headers.header_value", Value: "x-custom,authorization"},
+				{Key: "routing.http.response.access_control_allow_credentials.header_value", Value: "true"},
+				{Key: "routing.http.response.access_control_expose_headers.header_value", Value: "x-request-id"},
+				{Key: "routing.http.response.access_control_max_age.header_value", Value: "600"},
+			},
+		},
+		{
+			name: "wildcards without credentials",
+			cors: &gwv1.HTTPCORSFilter{
+				AllowOrigins: []gwv1.CORSOrigin{"*"},
+				AllowMethods: []gwv1.HTTPMethodWithWildcard{"*"},
+				AllowHeaders: []gwv1.HTTPHeaderName{"*"},
+			},
+			expected: []elbv2model.ListenerAttribute{
+				{Key: "routing.http.response.access_control_allow_origin.header_value", Value: "*"},
+				{Key: "routing.http.response.access_control_allow_methods.header_value", Value: "GET,HEAD,POST,PUT,DELETE,CONNECT,OPTIONS,TRACE,PATCH"},
+				{Key: "routing.http.response.access_control_allow_headers.header_value", Value: "*"},
+				{Key: "routing.http.response.access_control_allow_credentials.header_value", Value: ""},
+				{Key: "routing.http.response.access_control_expose_headers.header_value", Value: ""},
+				{Key: "routing.http.response.access_control_max_age.header_value", Value: "5"},
+			},
+		},
+		{
+			name: "headers that can't be static are omitted",
+			cors: &gwv1.HTTPCORSFilter{
+				AllowOrigins:     []gwv1.CORSOrigin{"*"},
+				AllowCredentials: awssdk.Bool(true),
+				AllowHeaders:     []gwv1.HTTPHeaderName{"*"},
+				MaxAge:           10,
+			},
+			expected: []elbv2model.ListenerAttribute{
+				{Key: "routing.http.response.access_control_allow_origin.header_value", Value: ""},
+				{Key: "routing.http.response.access_control_allow_methods.header_value", Value: ""},
+				{Key: "routing.http.response.access_control_allow_headers.header_value", Value: ""},
+				{Key: "routing.http.response.access_control_allow_credentials.header_value", Value: "true"},
+				{Key: "routing.http.response.access_control_expose_headers.header_value", Value: ""},
+				{Key: "routing.http.response.access_control_max_age.header_value", Value: "10"},
+			},
+		},
+		{
+			name: "multiple origins",
+			cors: &gwv1.HTTPCORSFilter{
+				AllowOrigins: []gwv1.CORSOrigin{"https://a.example.com", "https://b.example.com"},
+				MaxAge:       5,
+			},
+			expected: []elbv2model.ListenerAttribute{
+				{Key: "routing.http.response.access_control_allow_origin.header_value", Value: ""},
+				{Key: "routing.http.response.access_control_allow_methods.header_value", Value: ""},
+				{Key: "routing.http.response.access_control_allow_headers.header_value", Value: ""},
+				{Key: "routing.http.response.access_control_allow_credentials.header_value", Value: ""},
+				{Key: "routing.http.response.access_control_expose_headers.header_value", Value: ""},
+				{Key: "routing.http.response.access_control_max_age.header_value", Value: "5"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, BuildCORSListenerAttributes(tc.cors))
+		})
+	}
+}
+
+func Test_validateCORSFilter(t *testing.T) {
+	testCases := []struct {
+		name     string
+		cors     *gwv1.HTTPCORSFilter
+		expected []string
+	}{
+		{
+			name: "supported filter",
+			cors: &gwv1.HTTPCORSFilter{
+				AllowOrigins:     []gwv1.CORSOrigin{"https://example.com"},
+				AllowCredentials: awssdk.Bool(true),
+				AllowMethods:     []gwv1.HTTPMethodWithWildcard{"*"},
+				AllowHeaders:     []gwv1.HTTPHeaderName{"x-custom"},
+			},
+		},
+		{
+			name: "multiple origins",
+			cors: &gwv1.HTTPCORSFilter{
+				AllowOrigins: []gwv1.CORSOrigin{"https://a.example.com", "https://b.example.com"},
+			},
+			expected: []string{"allowOrigins with multiple origins, Access-Control-Allow-Origin can only contain a single origin"},
+		},
+		{
+			name: "wildcard host origin",
+			cors: &gwv1.HTTPCORSFilter{
+				AllowOrigins: []gwv1.CORSOrigin{"https://*.example.com"},
+			},
+			expected: []string{`allowOrigins "https://*.example.com", the request origin can't be echoed in Access-Control-Allow-Origin`},
+		},
+		{
+			name: "wildcards with credentials",
+			cors: &gwv1.HTTPCORSFilter{
+				AllowOrigins:     []gwv1.CORSOrigin{"*"},
+				AllowCredentials: awssdk.Bool(true),
+				AllowHeaders:     []gwv1.HTTPHeaderName{"*"},
+				ExposeHeaders:    []gwv1.HTTPHeaderName{"*"},
+			},
+			expected: []string{
+				`allowOrigins "*", the request origin can't be echoed in Access-Control-Allow-Origin`,
+				"allowHeaders wildcard with allowCredentials, the requested headers can't be echoed in Access-Control-Allow-Headers",
+				"exposeHeaders wildcard with allowCredentials",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, validateCORSFilter(tc.cors))
+		})
+	}
+}
+
+func Test_validateHTTPRouteCORSFilters(t *testing.T) {
+	route := convertHTTPRoute(gwv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "route"},
+		Spec: gwv1.HTTPRouteSpec{
+			Rules: []gwv1.HTTPRouteRule{
+				{
+					Filters: []gwv1.HTTPRouteFilter{
+						{
+							Type: gwv1.HTTPRouteFilterCORS,
+							CORS: &gwv1.HTTPCORSFilter{AllowOrigins: []gwv1.CORSOrigin{"https://example.com"}},
+						},
+					},
+				},
+				{
+					Filters: []gwv1.HTTPRouteFilter{
+						{
+							Type: gwv1.HTTPRouteFilterCORS,
+							CORS: &gwv1.HTTPCORSFilter{AllowOrigins: []gwv1.CORSOrigin{"https://a.example.com", "https://b.example.com"}},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	loadErrors := validateHTTPRouteCORSFilters(route)
+	assert.Len(t, loadErrors, 1)
+	assert.False(t, loadErrors[0].Fatal)
+	var loaderErr LoaderError
+	assert.ErrorAs(t, loadErrors[0].Err, &loaderErr)
+	assert.Equal(t, gwv1.RouteReasonUnsupportedValue, loaderErr.GetRouteReason())
+	assert.Equal(t, "CORS filter options not supported by ALB: allowOrigins with multiple origins, Access-Control-Allow-Origin can only contain a single origin", loaderErr.GetRouteMessage())
+}
+
+func Test_BuildCORSPreflightRuleConditions(t *testing.T) {
+	ruleConditions := []elbv2model.RuleCondition{
+		{
+			Field:            elbv2model.RuleConditionFieldHostHeader,
+			HostHeaderConfig: &elbv2model.HostHeaderConditionConfig{Values: []string{"example.com"}},
+		},
+		{
+			Field:             elbv2model.RuleConditionFieldPathPattern,
+			PathPatternConfig: &elbv2model.PathPatternConditionConfig{Values: []string{"/api/*"}},
+		},
+		{
+			Field:            elbv2model.RuleConditionFieldHTTPHeader,
+			HTTPHeaderConfig: &elbv2model.HTTPHeaderConditionConfig{HTTPHeaderName: "x-version", Values: []string{"2"}},
+		},
+		{
+			Field:                   elbv2model.RuleConditionFieldHTTPRequestMethod,
+			HTTPRequestMethodConfig: &elbv2model.HTTPRequestMethodConditionConfig{Values: []string{"POST"}},
+		},
+	}
+	preflightConditions := func(origins ...string) []elbv2model.RuleCondition {
+		return []elbv2model.RuleCondition{
+			ruleConditions[0],
+			ruleConditions[1],
+			{
+				Field:                   elbv2model.RuleConditionFieldHTTPRequestMethod,
+				HTTPRequestMethodConfig: &elbv2model.HTTPRequestMethodConditionConfig{Values: []string{"OPTIONS"}},
+			},
+			{
+				Field:            elbv2model.RuleConditionFieldHTTPHeader,
+				HTTPHeaderConfig: &elbv2model.HTTPHeaderConditionConfig{HTTPHeaderName: "Origin", Values: origins},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		cors     *gwv1.HTTPCORSFilter
+		expected []elbv2model.RuleCondition
+	}{
+		{
+			name:     "allowed origins",
+			cors:     &gwv1.HTTPCORSFilter{AllowOrigins: []gwv1.CORSOrigin{"https://a.example.com", "https://*.example.org"}},
+			expected: preflightConditions("https://a.example.com", "https://*.example.org"),
+		},
+		{
+			name:     "no allowed origins",
+			cors:     &gwv1.HTTPCORSFilter{},
+			expected: preflightConditions("*"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, BuildCORSPreflightRuleConditions(tc.cors, ruleConditions))
+		})
+	}
+}
+
+func Test_findUnsupportedCORSRoutes(t *testing.T) {
+	newRoute := func(name string, creationTime time.Time, corsFilters ...*gwv1.HTTPCORSFilter) *MockRoute {
+		var rules []RouteRule
+		for _, cors := range corsFilters {
+			rule := &gwv1.HTTPRouteRule{}
+			if cors != nil {
+				rule.Filters = []gwv1.HTTPRouteFilter{{Type: gwv1.HTTPRouteFilterCORS, CORS: cors}}
+			}
+			rules = append(rules, &MockRule{RawRule: rule})
+		}
+		return &MockRoute{
+			Kind:         HTTPRouteKind,
+			Name:         name,
+			Namespace:    "ns",
+			CreationTime: creationTime,
+			Rules:        rules,
+		}
+	}
+	now := time.Now()
+	cors1 := &gwv1.HTTPCORSFilter{AllowOrigins: []gwv1.CORSOrigin{"https://a.example.com"}}
+	cors2 := &gwv1.HTTPCORSFilter{AllowOrigins: []gwv1.CORSOrigin{"https://b.example.com"}}
+
+	testCases := []struct {
+		name           string
+		routes         []RouteDescriptor
+		expected       []string
+		expectedReason string
+		expectedCORS   *gwv1.HTTPCORSFilter
+	}{
+		{
+			name:   "no cors filter",
+			routes: []RouteDescriptor{newRoute("r1", now, nil)},
+		},
+		{
+			name:         "single route owns the listener",
+			routes:       []RouteDescriptor{newRoute("r1", now, cors1, nil, cors1.DeepCopy())},
+			expectedCORS: cors1,
+		},
+		{
+			name:           "single route with different cors filters",
+			routes:         []RouteDescriptor{newRoute("r1", now, cors1, cors2)},
+			expected:       []string{"ns/r1"},
+			expectedReason: "rules of the route configure different CORS filters",
+		},
+		{
+			name: "same cors filter on routes sharing the listener",
+			routes: []RouteDescriptor{
+				newRoute("r1", now, cors1),
+				newRoute("r2", now.Add(time.Minute), cors1.DeepCopy()),
+			},
+			expected:       []string{"ns/r1", "ns/r2"},
+			expectedReason: "other routes are attached to the listener",
+		},
+		{
+			name: "route without cors filter sharing the listener",
+			routes: []RouteDescriptor{
+				newRoute("r2", now.Add(time.Minute), nil),
+				newRoute("r1", now, cors1),
+			},
+			expected:       []string{"ns/r1"},
+			expectedReason: "other routes are attached to the listener",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			unsupportedRoutes, reason := findUnsupportedCORSRoutes(tc.routes, 80)
+			var unsupported []string
+			for _, route := range unsupportedRoutes {
+				unsupported = append(unsupported, route.GetRouteNamespacedName().String())
+			}
+			assert.Equal(t, tc.expected, unsupported)
+			assert.Equal(t, tc.expectedReason, reason)
+			assert.Equal(t, tc.expectedCORS, GetListenerCORSFilter(SortAllRulesByPrecedence(tc.routes, 80)))
+		})
+	}
+}
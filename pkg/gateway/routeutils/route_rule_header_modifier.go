@@ -0,0 +1,336 @@
+package routeutils
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/equality"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+const (
+	// ALB listener attributes that set a response header in every response of the listener.
+	listenerAttributeStrictTransportSecurity = "routing.http.response.strict_transport_security.header_value"
+	listenerAttributeContentSecurityPolicy   = "routing.http.response.content_security_policy.header_value"
+	listenerAttributeXContentTypeOptions     = "routing.http.response.x_content_type_options.header_value"
+	listenerAttributeXFrameOptions           = "routing.http.response.x_frame_options.header_value"
+	listenerAttributeServerEnabled           = "routing.http.response.server.enabled"
+
+	// ALB load balancer attribute that controls the X-Forwarded-For request header.
+	loadBalancerAttributeXFFHeaderProcessingMode = "routing.http.xff_header_processing.mode"
+	xffHeaderProcessingModeRemove                = "remove"
+	xffHeaderProcessingModeAppend                = "append"
+
+	headerHost                    = "Host"
+	headerXForwardedFor           = "X-Forwarded-For"
+	headerServer                  = "Server"
+	headerStrictTransportSecurity = "Strict-Transport-Security"
+	headerContentSecurityPolicy   = "Content-Security-Policy"
+	headerXContentTypeOptions     = "X-Content-Type-Options"
+	headerXFrameOptions           = "X-Frame-Options"
+)
+
+// responseHeaderListenerAttributes maps the canonical name of the response headers ALB can set to their listener attribute.
+var responseHeaderListenerAttributes = map[string]string{
+	headerStrictTransportSecurity: listenerAttributeStrictTransportSecurity,
+	headerContentSecurityPolicy:   listenerAttributeContentSecurityPolicy,
+	headerXContentTypeOptions:     listenerAttributeXContentTypeOptions,
+	headerXFrameOptions:           listenerAttributeXFrameOptions,
+}
+
+// responseHeaderListenerAttributeDefaults are the default values of the response header listener attributes, in the order they are built.
+// Listener attributes are reconciled by difference, so the defaults are set when no modifier sets the headers, which also reverts a removed modifier.
+var responseHeaderListenerAttributeDefaults = []elbv2model.ListenerAttribute{
+	{Key: listenerAttributeStrictTransportSecurity, Value: ""},
+	{Key: listenerAttributeContentSecurityPolicy, Value: ""},
+	{Key: listenerAttributeXContentTypeOptions, Value: ""},
+	{Key: listenerAttributeXFrameOptions, Value: ""},
+	{Key: listenerAttributeServerEnabled, Value: "true"},
+}
+
+// responseHeaderValuePatterns restricts the values of the response headers for which ALB only accepts specific values.
+var responseHeaderValuePatterns = map[string]*regexp.Regexp{
+	headerXContentTypeOptions: regexp.MustCompile(`^nosniff$`),
+	headerXFrameOptions:       regexp.MustCompile(`^(DENY|SAMEORIGIN|ALLOW-FROM \S+)$`),
+}
+
+var responseHeaderValueDescriptions = map[string]string{
+	headerXContentTypeOptions: "nosniff",
+	headerXFrameOptions:       "DENY, SAMEORIGIN and ALLOW-FROM <uri>",
+}
+
+// getHTTPHeaderModifierFilter returns the header modifier of the given filter type, or nil if the filters don't configure one.
+func getHTTPHeaderModifierFilter(filters []gwv1.HTTPRouteFilter, filterType gwv1.HTTPRouteFilterType) *gwv1.HTTPHeaderFilter {
+	for _, filter := range filters {
+		if filter.Type != filterType {
+			continue
+		}
+		switch filterType {
+		case gwv1.HTTPRouteFilterRequestHeaderModifier:
+			if filter.RequestHeaderModifier != nil {
+				return filter.RequestHeaderModifier
+			}
+		case gwv1.HTTPRouteFilterResponseHeaderModifier:
+			if filter.ResponseHeaderModifier != nil {
+				return filter.ResponseHeaderModifier
+			}
+		}
+	}
+	return nil
+}
+
+// GetHttpRuleResponseHeaderModifier returns the response header modifier of an HTTPRoute rule, or nil if the rule doesn't configure one.
+func GetHttpRuleResponseHeaderModifier(rule RouteRule) *gwv1.HTTPHeaderFilter {
+	httpRule, ok := rule.GetRawRouteRule().(*gwv1.HTTPRouteRule)
+	if !ok || httpRule == nil {
+		return nil
+	}
+	return getHTTPHeaderModifierFilter(httpRule.Filters, gwv1.HTTPRouteFilterResponseHeaderModifier)
+}
+
+// GetListenerResponseHeaderAttributes returns the listener attributes setting the response headers of a listener.
+// ALB sets the same response headers in all responses of a listener, so the response header modifier of the rule
+// with the highest precedence that ALB can express wins.
+func GetListenerResponseHeaderAttributes(rulesWithPrecedence []RulePrecedence) []elbv2model.ListenerAttribute {
+	return buildResponseHeaderListenerAttributes(getListenerResponseHeaderValues(rulesWithPrecedence))
+}
+
+// getListenerResponseHeaderValues returns the response header values of the rule with the highest precedence that ALB can express.
+func getListenerResponseHeaderValues(rulesWithPrecedence []RulePrecedence) map[string]string {
+	for _, ruleWithPrecedence := range rulesWithPrecedence {
+		if headerValues := buildResponseHeaderValues(GetHttpRuleResponseHeaderModifier(ruleWithPrecedence.CommonRulePrecedence.Rule)); len(headerValues) != 0 {
+			return headerValues
+		}
+	}
+	return nil
+}
+
+// BuildResponseHeaderListenerAttributes builds the listener attributes setting or removing the response headers of the modifier.
+// Modifications that ALB can't express are omitted (see validateHeaderModifier), and the headers that aren't modified are reset to their defaults.
+func BuildResponseHeaderListenerAttributes(modifier *gwv1.HTTPHeaderFilter) []elbv2model.ListenerAttribute {
+	return buildResponseHeaderListenerAttributes(buildResponseHeaderValues(modifier))
+}
+
+func buildResponseHeaderListenerAttributes(headerValues map[string]string) []elbv2model.ListenerAttribute {
+	attributes := make([]elbv2model.ListenerAttribute, 0, len(responseHeaderListenerAttributeDefaults))
+	for _, defaultAttr := range responseHeaderListenerAttributeDefaults {
+		attr := defaultAttr
+		if value, ok := headerValues[attr.Key]; ok {
+			attr.Value = value
+		}
+		attributes = append(attributes, attr)
+	}
+	return attributes
+}
+
+// buildResponseHeaderValues returns the values of the response headers ALB can express for the modifier, by listener attribute.
+func buildResponseHeaderValues(modifier *gwv1.HTTPHeaderFilter) map[string]string {
+	headerValues := make(map[string]string)
+	if modifier == nil {
+		return headerValues
+	}
+	for _, header := range modifier.Set {
+		headerName := canonicalHeaderName(header.Name)
+		key, ok := responseHeaderListenerAttributes[headerName]
+		if !ok || !isSupportedResponseHeaderValue(headerName, header.Value) {
+			continue
+		}
+		headerValues[key] = header.Value
+	}
+	for _, headerName := range modifier.Remove {
+		if canonicalHeaderName(gwv1.HTTPHeaderName(headerName)) == headerServer {
+			headerValues[listenerAttributeServerEnabled] = "false"
+		}
+	}
+	return headerValues
+}
+
+// BuildRequestHeaderLoadBalancerAttributes builds the load balancer attributes for the request header modifiers of the routes.
+// ALB processes the X-Forwarded-For header for the whole load balancer, so removing it in any rule removes it for all requests.
+// Load balancer attributes are reconciled by difference, so the default mode is set when no rule removes it, which also reverts a removed modifier.
+func BuildRequestHeaderLoadBalancerAttributes(routesByPort map[int32][]RouteDescriptor) []elbv2model.LoadBalancerAttribute {
+	for _, routes := range routesByPort {
+		for _, route := range routes {
+			for _, rule := range route.GetAttachedRules() {
+				httpRule, ok := rule.GetRawRouteRule().(*gwv1.HTTPRouteRule)
+				if !ok || httpRule == nil {
+					continue
+				}
+				modifier := getHTTPHeaderModifierFilter(httpRule.Filters, gwv1.HTTPRouteFilterRequestHeaderModifier)
+				if modifier == nil {
+					continue
+				}
+				for _, headerName := range modifier.Remove {
+					if canonicalHeaderName(gwv1.HTTPHeaderName(headerName)) == headerXForwardedFor {
+						return []elbv2model.LoadBalancerAttribute{
+							{
+								Key:   loadBalancerAttributeXFFHeaderProcessingMode,
+								Value: xffHeaderProcessingModeRemove,
+							},
+						}
+					}
+				}
+			}
+		}
+	}
+	return []elbv2model.LoadBalancerAttribute{
+		{
+			Key:   loadBalancerAttributeXFFHeaderProcessingMode,
+			Value: xffHeaderProcessingModeAppend,
+		},
+	}
+}
+
+// buildRequestHeaderModifierTransforms builds the transforms of the request header modifier, ALB can only rewrite the Host header.
+func buildRequestHeaderModifierTransforms(modifier *gwv1.HTTPHeaderFilter) []elbv2model.Transform {
+	if modifier == nil {
+		return nil
+	}
+	for _, header := range modifier.Set {
+		if canonicalHeaderName(header.Name) == headerHost {
+			return []elbv2model.Transform{generateHostHeaderRewriteTransform(gwv1.PreciseHostname(header.Value))}
+		}
+	}
+	return nil
+}
+
+// validateHeaderModifier returns the modifications of the header modifier that ALB can't express.
+func validateHeaderModifier(modifier *gwv1.HTTPHeaderFilter, filterType gwv1.HTTPRouteFilterType) []string {
+	var unsupported []string
+	if filterType == gwv1.HTTPRouteFilterRequestHeaderModifier {
+		for _, header := range modifier.Set {
+			if canonicalHeaderName(header.Name) != headerHost {
+				unsupported = append(unsupported, fmt.Sprintf("set %q, ALB can only rewrite the Host request header", header.Name))
+			}
+		}
+		for _, header := range modifier.Add {
+			unsupported = append(unsupported, fmt.Sprintf("add %q, ALB can't add request headers", header.Name))
+		}
+		for _, headerName := range modifier.Remove {
+			if canonicalHeaderName(gwv1.HTTPHeaderName(headerName)) != headerXForwardedFor {
+				unsupported = append(unsupported, fmt.Sprintf("remove %q, ALB can only remove the X-Forwarded-For request header", headerName))
+			}
+		}
+		return unsupported
+	}
+
+	for _, header := range modifier.Set {
+		headerName := canonicalHeaderName(header.Name)
+		if _, ok := responseHeaderListenerAttributes[headerName]; !ok {
+			unsupported = append(unsupported, fmt.Sprintf("set %q, ALB can only set the Strict-Transport-Security, Content-Security-Policy, X-Content-Type-Options and X-Frame-Options response headers", header.Name))
+		} else if !isSupportedResponseHeaderValue(headerName, header.Value) {
+			unsupported = append(unsupported, fmt.Sprintf("set %q to %q, ALB only supports %s", header.Name, header.Value, responseHeaderValueDescriptions[headerName]))
+		}
+	}
+	for _, header := range modifier.Add {
+		unsupported = append(unsupported, fmt.Sprintf("add %q, ALB can't add response headers", header.Name))
+	}
+	for _, headerName := range modifier.Remove {
+		if canonicalHeaderName(gwv1.HTTPHeaderName(headerName)) != headerServer {
+			unsupported = append(unsupported, fmt.Sprintf("remove %q, ALB can only remove the Server response header", headerName))
+		}
+	}
+	return unsupported
+}
+
+// validateHTTPRouteHeaderModifiers reports the header modifications that ALB can't express as a non-fatal error,
+// the rest of the modifications are still applied.
+func validateHTTPRouteHeaderModifiers(httpRoute *httpRouteDescription) []routeLoadError {
+	var loadErrors []routeLoadError
+	for _, rule := range httpRoute.route.Spec.Rules {
+		var unsupported []string
+		if modifier := getHTTPHeaderModifierFilter(rule.Filters, gwv1.HTTPRouteFilterRequestHeaderModifier); modifier != nil {
+			unsupported = append(unsupported, validateHeaderModifier(modifier, gwv1.HTTPRouteFilterRequestHeaderModifier)...)
+			if len(buildRequestHeaderModifierTransforms(modifier)) != 0 && hasURLRewriteHostname(rule.Filters) {
+				unsupported = append(unsupported, fmt.Sprintf("set %q together with a URLRewrite hostname", headerHost))
+			}
+		}
+		if modifier := getHTTPHeaderModifierFilter(rule.Filters, gwv1.HTTPRouteFilterResponseHeaderModifier); modifier != nil {
+			unsupported = append(unsupported, validateHeaderModifier(modifier, gwv1.HTTPRouteFilterResponseHeaderModifier)...)
+		}
+		if len(unsupported) == 0 {
+			continue
+		}
+		initialErrorMessage := fmt.Sprintf("header modifier filter options not supported by ALB: %s", strings.Join(unsupported, "; "))
+		wrappedGatewayErrorMessage := generateInvalidMessageWithRouteDetails(initialErrorMessage, httpRoute.GetRouteKind(), httpRoute.GetRouteNamespacedName())
+		loadErrors = append(loadErrors, routeLoadError{
+			Err: wrapError(errors.Errorf("%s", initialErrorMessage), gwv1.GatewayReasonListenersNotValid, gwv1.RouteReasonUnsupportedValue, &wrappedGatewayErrorMessage, nil),
+		})
+	}
+	return loadErrors
+}
+
+// findConflictingResponseHeaderRoutes returns the routes setting response headers different from the ones configuring the listener of the port.
+func findConflictingResponseHeaderRoutes(routes []RouteDescriptor, port int32) []RouteDescriptor {
+	if !hasResponseHeaderModifier(routes) {
+		return nil
+	}
+	rulesWithPrecedence := SortAllRulesByPrecedence(routes, port)
+	listenerHeaderValues := getListenerResponseHeaderValues(rulesWithPrecedence)
+	if len(listenerHeaderValues) == 0 {
+		return nil
+	}
+	var conflictingRoutes []RouteDescriptor
+	seen := make(map[string]bool)
+	for _, ruleWithPrecedence := range rulesWithPrecedence {
+		headerValues := buildResponseHeaderValues(GetHttpRuleResponseHeaderModifier(ruleWithPrecedence.CommonRulePrecedence.Rule))
+		if len(headerValues) == 0 || equality.Semantic.DeepEqual(headerValues, listenerHeaderValues) {
+			continue
+		}
+		route := ruleWithPrecedence.CommonRulePrecedence.RouteDescriptor
+		if seen[route.GetRouteIdentifier()] {
+			continue
+		}
+		seen[route.GetRouteIdentifier()] = true
+		conflictingRoutes = append(conflictingRoutes, route)
+	}
+	return conflictingRoutes
+}
+
+// generateConflictingResponseHeaderRouteData generates the route status of the routes whose response header modifier doesn't configure the listener.
+func generateConflictingResponseHeaderRouteData(routesByPort map[int32][]RouteDescriptor, matchedParentRefs map[string][]gwv1.ParentReference) []RouteData {
+	var routeData []RouteData
+	for port, routes := range routesByPort {
+		for _, route := range findConflictingResponseHeaderRoutes(routes, port) {
+			message := fmt.Sprintf("ResponseHeaderModifier filter is overridden by the ResponseHeaderModifier filter of another route on port %d, ALB applies a single set of response headers per listener", port)
+			for _, parentRef := range matchedParentRefs[route.GetRouteIdentifier()] {
+				routeData = append(routeData, GenerateRouteData(false, true, string(gwv1.RouteReasonUnsupportedValue), message, route.GetRouteNamespacedName(), route.GetRouteKind(), route.GetRouteGeneration(), parentRef))
+			}
+		}
+	}
+	return routeData
+}
+
+func hasResponseHeaderModifier(routes []RouteDescriptor) bool {
+	for _, route := range routes {
+		for _, rule := range route.GetAttachedRules() {
+			if GetHttpRuleResponseHeaderModifier(rule) != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func hasURLRewriteHostname(filters []gwv1.HTTPRouteFilter) bool {
+	for _, filter := range filters {
+		if filter.URLRewrite != nil && filter.URLRewrite.Hostname != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func isSupportedResponseHeaderValue(headerName string, value string) bool {
+	pattern, ok := responseHeaderValuePatterns[headerName]
+	return !ok || pattern.MatchString(value)
+}
+
+// canonicalHeaderName returns the canonical format of the header name, header names are case-insensitive.
+func canonicalHeaderName(headerName gwv1.HTTPHeaderName) string {
+	return http.CanonicalHeaderKey(string(headerName))
+}
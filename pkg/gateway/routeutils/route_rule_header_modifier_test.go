@@ -0,0 +1,296 @@
+package routeutils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func Test_BuildResponseHeaderListenerAttributes(t *testing.T) {
+	testCases := []struct {
+		name     string
+		modifier *gwv1.HTTPHeaderFilter
+		expected []elbv2model.ListenerAttribute
+	}{
+		{
+			name: "no modifier resets the headers of a removed modifier",
+			expected: []elbv2model.ListenerAttribute{
+				{Key: "routing.http.response.strict_transport_security.header_value", Value: ""},
+				{Key: "routing.http.response.content_security_policy.header_value", Value: ""},
+				{Key: "routing.http.response.x_content_type_options.header_value", Value: ""},
+				{Key: "routing.http.response.x_frame_options.header_value", Value: ""},
+				{Key: "routing.http.response.server.enabled", Value: "true"},
+			},
+		},
+		{
+			name: "supported headers",
+			modifier: &gwv1.HTTPHeaderFilter{
+				Set: []gwv1.HTTPHeader{
+					{Name: "strict-transport-security", Value: "max-age=31536000"},
+					{Name: "Content-Security-Policy", Value: "default-src 'self'"},
+					{Name: "X-Content-Type-Options", Value: "nosniff"},
+					{Name: "X-Frame-Options", Value: "SAMEORIGIN"},
+				},
+				Remove: []string{"server"},
+			},
+			expected: []elbv2model.ListenerAttribute{
+				{Key: "routing.http.response.strict_transport_security.header_value", Value: "max-age=31536000"},
+				{Key: "routing.http.response.content_security_policy.header_value", Value: "default-src 'self'"},
+				{Key: "routing.http.response.x_content_type_options.header_value", Value: "nosniff"},
+				{Key: "routing.http.response.x_frame_options.header_value", Value: "SAMEORIGIN"},
+				{Key: "routing.http.response.server.enabled", Value: "false"},
+			},
+		},
+		{
+			name: "unsupported modifications are omitted",
+			modifier: &gwv1.HTTPHeaderFilter{
+				Set: []gwv1.HTTPHeader{
+					{Name: "X-Custom", Value: "value"},
+					{Name: "X-Frame-Options", Value: "invalid"},
+				},
+				Add: []gwv1.HTTPHeader{
+					{Name: "Strict-Transport-Security", Value: "max-age=31536000"},
+				},
+				Remove: []string{"X-Custom"},
+			},
+			expected: []elbv2model.ListenerAttribute{
+				{Key: "routing.http.response.strict_transport_security.header_value", Value: ""},
+				{Key: "routing.http.response.content_security_policy.header_value", Value: ""},
+				{Key: "routing.http.response.x_content_type_options.header_value", Value: ""},
+				{Key: "routing.http.response.x_frame_options.header_value", Value: ""},
+				{Key: "routing.http.response.server.enabled", Value: "true"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, BuildResponseHeaderListenerAttributes(tc.modifier))
+		})
+	}
+}
+
+func Test_validateHeaderModifier(t *testing.T) {
+	testCases := []struct {
+		name       string
+		modifier   *gwv1.HTTPHeaderFilter
+		filterType gwv1.HTTPRouteFilterType
+		expected   []string
+	}{
+		{
+			name: "supported request modifications",
+			modifier: &gwv1.HTTPHeaderFilter{
+				Set:    []gwv1.HTTPHeader{{Name: "host", Value: "example.com"}},
+				Remove: []string{"x-forwarded-for"},
+			},
+			filterType: gwv1.HTTPRouteFilterRequestHeaderModifier,
+		},
+		{
+			name: "unsupported request modifications",
+			modifier: &gwv1.HTTPHeaderFilter{
+				Set:    []gwv1.HTTPHeader{{Name: "X-Custom", Value: "value"}},
+				Add:    []gwv1.HTTPHeader{{Name: "Host", Value: "example.com"}},
+				Remove: []string{"X-Forwarded-Proto"},
+			},
+			filterType: gwv1.HTTPRouteFilterRequestHeaderModifier,
+			expected: []string{
+				`set "X-Custom", ALB can only rewrite the Host request header`,
+				`add "Host", ALB can't add request headers`,
+				`remove "X-Forwarded-Proto", ALB can only remove the X-Forwarded-For request header`,
+			},
+		},
+		{
+			name: "supported response modifications",
+			modifier: &gwv1.HTTPHeaderFilter{
+				Set: []gwv1.HTTPHeader{
+					{Name: "X-Frame-Options", Value: "ALLOW-FROM https://example.com"},
+					{Name: "x-content-type-options", Value: "nosniff"},
+				},
+				Remove: []string{"Server"},
+			},
+			filterType: gwv1.HTTPRouteFilterResponseHeaderModifier,
+		},
+		{
+			name: "unsupported response modifications",
+			modifier: &gwv1.HTTPHeaderFilter{
+				Set: []gwv1.HTTPHeader{
+					{Name: "X-Custom", Value: "value"},
+					{Name: "X-Content-Type-Options", Value: "sniff"},
+				},
+				Add:    []gwv1.HTTPHeader{{Name: "Strict-Transport-Security", Value: "max-age=31536000"}},
+				Remove: []string{"X-Powered-By"},
+			},
+			filterType: gwv1.HTTPRouteFilterResponseHeaderModifier,
+			expected: []string{
+				`set "X-Custom", ALB can only set the Strict-Transport-Security, Content-Security-Policy, X-Content-Type-Options and X-Frame-Options response headers`,
+				`set "X-Content-Type-Options" to "sniff", ALB only supports nosniff`,
+				`add "Strict-Transport-Security", ALB can't add response headers`,
+				`remove "X-Powered-By", ALB can only remove the Server response header`,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, validateHeaderModifier(tc.modifier, tc.filterType))
+		})
+	}
+}
+
+func Test_validateHTTPRouteHeaderModifiers(t *testing.T) {
+	hostname := gwv1.PreciseHostname("rewrite.example.com")
+	route := convertHTTPRoute(gwv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "route"},
+		Spec: gwv1.HTTPRouteSpec{
+			Rules: []gwv1.HTTPRouteRule{
+				{
+					Filters: []gwv1.HTTPRouteFilter{
+						{
+							Type:                  gwv1.HTTPRouteFilterRequestHeaderModifier,
+							RequestHeaderModifier: &gwv1.HTTPHeaderFilter{Set: []gwv1.HTTPHeader{{Name: "Host", Value: "example.com"}}},
+						},
+					},
+				},
+				{
+					Filters: []gwv1.HTTPRouteFilter{
+						{
+							Type:                  gwv1.HTTPRouteFilterRequestHeaderModifier,
+							RequestHeaderModifier: &gwv1.HTTPHeaderFilter{Set: []gwv1.HTTPHeader{{Name: "Host", Value: "example.com"}}},
+						},
+						{
+							Type:       gwv1.HTTPRouteFilterURLRewrite,
+							URLRewrite: &gwv1.HTTPURLRewriteFilter{Hostname: &hostname},
+						},
+						{
+							Type:                   gwv1.HTTPRouteFilterResponseHeaderModifier,
+							ResponseHeaderModifier: &gwv1.HTTPHeaderFilter{Remove: []string{"X-Powered-By"}},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	loadErrors := validateHTTPRouteHeaderModifiers(route)
+	assert.Len(t, loadErrors, 1)
+	assert.False(t, loadErrors[0].Fatal)
+	var loaderErr LoaderError
+	assert.ErrorAs(t, loadErrors[0].Err, &loaderErr)
+	assert.Equal(t, gwv1.RouteReasonUnsupportedValue, loaderErr.GetRouteReason())
+	assert.Equal(t, `header modifier filter options not supported by ALB: set "Host" together with a URLRewrite hostname; remove "X-Powered-By", ALB can only remove the Server response header`, loaderErr.GetRouteMessage())
+}
+
+func Test_BuildRequestHeaderLoadBalancerAttributes(t *testing.T) {
+	newRoute := func(modifier *gwv1.HTTPHeaderFilter) *MockRoute {
+		rule := &gwv1.HTTPRouteRule{}
+		if modifier != nil {
+			rule.Filters = []gwv1.HTTPRouteFilter{{Type: gwv1.HTTPRouteFilterRequestHeaderModifier, RequestHeaderModifier: modifier}}
+		}
+		return &MockRoute{
+			Kind:  HTTPRouteKind,
+			Rules: []RouteRule{&MockRule{RawRule: rule}},
+		}
+	}
+
+	testCases := []struct {
+		name         string
+		routesByPort map[int32][]RouteDescriptor
+		expected     []elbv2model.LoadBalancerAttribute
+	}{
+		{
+			name: "no request header modifier resets the x-forwarded-for processing mode",
+			routesByPort: map[int32][]RouteDescriptor{
+				80: {newRoute(nil)},
+			},
+			expected: []elbv2model.LoadBalancerAttribute{
+				{Key: "routing.http.xff_header_processing.mode", Value: "append"},
+			},
+		},
+		{
+			name: "request header modifier without x-forwarded-for removal",
+			routesByPort: map[int32][]RouteDescriptor{
+				80: {newRoute(&gwv1.HTTPHeaderFilter{Set: []gwv1.HTTPHeader{{Name: "Host", Value: "example.com"}}})},
+			},
+			expected: []elbv2model.LoadBalancerAttribute{
+				{Key: "routing.http.xff_header_processing.mode", Value: "append"},
+			},
+		},
+		{
+			name: "x-forwarded-for removal",
+			routesByPort: map[int32][]RouteDescriptor{
+				80:  {newRoute(nil)},
+				443: {newRoute(&gwv1.HTTPHeaderFilter{Remove: []string{"x-forwarded-for"}})},
+			},
+			expected: []elbv2model.LoadBalancerAttribute{
+				{Key: "routing.http.xff_header_processing.mode", Value: "remove"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, BuildRequestHeaderLoadBalancerAttributes(tc.routesByPort))
+		})
+	}
+}
+
+func Test_findConflictingResponseHeaderRoutes(t *testing.T) {
+	newRoute := func(name string, creationTime time.Time, modifier *gwv1.HTTPHeaderFilter) *MockRoute {
+		rule := &gwv1.HTTPRouteRule{}
+		if modifier != nil {
+			rule.Filters = []gwv1.HTTPRouteFilter{{Type: gwv1.HTTPRouteFilterResponseHeaderModifier, ResponseHeaderModifier: modifier}}
+		}
+		return &MockRoute{
+			Kind:         HTTPRouteKind,
+			Name:         name,
+			Namespace:    "ns",
+			CreationTime: creationTime,
+			Rules:        []RouteRule{&MockRule{RawRule: rule}},
+		}
+	}
+	now := time.Now()
+	hsts := &gwv1.HTTPHeaderFilter{Set: []gwv1.HTTPHeader{{Name: "Strict-Transport-Security", Value: "max-age=31536000"}}}
+	noServer := &gwv1.HTTPHeaderFilter{Remove: []string{"Server"}}
+	unsupported := &gwv1.HTTPHeaderFilter{Set: []gwv1.HTTPHeader{{Name: "X-Custom", Value: "value"}}}
+
+	testCases := []struct {
+		name     string
+		routes   []RouteDescriptor
+		expected []string
+	}{
+		{
+			name:   "no response header modifier",
+			routes: []RouteDescriptor{newRoute("r1", now, nil)},
+		},
+		{
+			name: "same response headers",
+			routes: []RouteDescriptor{
+				newRoute("r1", now, hsts),
+				newRoute("r2", now.Add(time.Minute), hsts.DeepCopy()),
+				newRoute("r3", now.Add(2*time.Minute), unsupported),
+			},
+		},
+		{
+			name: "different response headers, the oldest route wins",
+			routes: []RouteDescriptor{
+				newRoute("r2", now.Add(time.Minute), noServer),
+				newRoute("r1", now, hsts),
+			},
+			expected: []string{"ns/r2"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var conflicting []string
+			for _, route := range findConflictingResponseHeaderRoutes(tc.routes, 80) {
+				conflicting = append(conflicting, route.GetRouteNamespacedName().String())
+			}
+			assert.Equal(t, tc.expected, conflicting)
+		})
+	}
+}
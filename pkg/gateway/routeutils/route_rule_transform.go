@@ -41,6 +41,10 @@ func buildHTTPRuleTransforms(rule *gwv1.HTTPRouteRule, httpMatch *gwv1.HTTPRoute
 				transforms = append(transforms, generateURLRewritePathTransform(*rf.RequestRedirect.Path, httpMatch))
 			}
 		}
+		// The URLRewrite hostname takes precedence over a Host header set by the RequestHeaderModifier.
+		if !hasURLRewriteHostname(rule.Filters) {
+			transforms = append(transforms, buildRequestHeaderModifierTransforms(getHTTPHeaderModifierFilter(rule.Filters, gwv1.HTTPRouteFilterRequestHeaderModifier))...)
+		}
 	}
 
 	return transforms
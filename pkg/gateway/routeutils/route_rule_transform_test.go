@@ -182,6 +182,83 @@ func Test_BuildRoutingRuleTransforms(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "request header modifier host rewrite",
+			route: &mockRoute{
+				routeKind: HTTPRouteKind,
+			},
+			rule: RulePrecedence{
+				CommonRulePrecedence: CommonRulePrecedence{
+					Rule: convertHTTPRouteRule(&gwv1.HTTPRouteRule{
+						Filters: []gwv1.HTTPRouteFilter{
+							{
+								Type: gwv1.HTTPRouteFilterRequestHeaderModifier,
+								RequestHeaderModifier: &gwv1.HTTPHeaderFilter{
+									Set: []gwv1.HTTPHeader{
+										{Name: "x-custom", Value: "ignored"},
+										{Name: "host", Value: "bar.com"},
+									},
+								},
+							},
+						},
+					}, nil, nil),
+				},
+			},
+			expected: []elbv2.Transform{
+				{
+					Type: elbv2.TransformTypeHostHeaderRewrite,
+					HostHeaderRewriteConfig: &elbv2.RewriteConfigObject{
+						Rewrites: []elbv2.RewriteConfig{
+							{
+								Regex:   ".*",
+								Replace: "bar.com",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "url rewrite hostname takes precedence over request header modifier host",
+			route: &mockRoute{
+				routeKind: HTTPRouteKind,
+			},
+			rule: RulePrecedence{
+				CommonRulePrecedence: CommonRulePrecedence{
+					Rule: convertHTTPRouteRule(&gwv1.HTTPRouteRule{
+						Filters: []gwv1.HTTPRouteFilter{
+							{
+								Type: gwv1.HTTPRouteFilterRequestHeaderModifier,
+								RequestHeaderModifier: &gwv1.HTTPHeaderFilter{
+									Set: []gwv1.HTTPHeader{
+										{Name: "Host", Value: "bar.com"},
+									},
+								},
+							},
+							{
+								Type: gwv1.HTTPRouteFilterURLRewrite,
+								URLRewrite: &gwv1.HTTPURLRewriteFilter{
+									Hostname: (*gwv1.PreciseHostname)(awssdk.String("foo.com")),
+								},
+							},
+						},
+					}, nil, nil),
+				},
+			},
+			expected: []elbv2.Transform{
+				{
+					Type: elbv2.TransformTypeHostHeaderRewrite,
+					HostHeaderRewriteConfig: &elbv2.RewriteConfigObject{
+						Rewrites: []elbv2.RewriteConfig{
+							{
+								Regex:   ".*",
+								Replace: "foo.com",
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
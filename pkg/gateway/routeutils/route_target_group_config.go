@@ -0,0 +1,163 @@
+package routeutils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/v3/apis/gateway/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway/constants"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// getTargetGroupConfigForBackendGeneric is a generic helper that extracts TargetGroupConfiguration
+// references from ExtensionRef filters of a route backend
+func getTargetGroupConfigForBackendGeneric[FilterType any](
+	filters []FilterType,
+	isExtensionRefType func(filter FilterType) bool,
+	getExtensionRef func(filter FilterType) *gwv1.LocalObjectReference,
+) []gwv1.LocalObjectReference {
+	targetGroupConfigRefs := make([]gwv1.LocalObjectReference, 0)
+	for _, filter := range filters {
+		if !isExtensionRefType(filter) {
+			continue
+		}
+		extRef := getExtensionRef(filter)
+		if extRef != nil &&
+			string(extRef.Group) == constants.ControllerCRDGroupVersion &&
+			string(extRef.Kind) == constants.TargetGroupConfiguration {
+			targetGroupConfigRefs = append(targetGroupConfigRefs, gwv1.LocalObjectReference{
+				Group: constants.ControllerCRDGroupVersion,
+				Kind:  constants.TargetGroupConfiguration,
+				Name:  extRef.Name,
+			})
+		}
+	}
+	return targetGroupConfigRefs
+}
+
+// returns (loaded target group config, warning error, fatal error)
+// routeTargetGroupConfigLoader loads the TargetGroupConfiguration attached to a route backend, it lives in the namespace of the route.
+func routeTargetGroupConfigLoader(ctx context.Context, k8sClient client.Client, routeIdentifier types.NamespacedName, routeKind RouteKind, targetGroupConfigRefs []gwv1.LocalObjectReference) (*elbv2gw.TargetGroupConfiguration, error, error) {
+	if len(targetGroupConfigRefs) == 0 {
+		return nil, nil, nil
+	}
+	// This is warning error so that the reconcile cycle does not stop.
+	if len(targetGroupConfigRefs) > 1 {
+		initialErrorMessage := "Only one target group config can be referenced per backend, found multiple"
+		wrappedGatewayErrorMessage := generateInvalidMessageWithRouteDetails(initialErrorMessage, routeKind, routeIdentifier)
+		return nil, wrapError(errors.Errorf("%s", initialErrorMessage), gwv1.GatewayReasonListenersNotValid, gwv1.RouteReasonIncompatibleFilters, &wrappedGatewayErrorMessage, nil), nil
+	}
+	tgConfigId := types.NamespacedName{
+		Namespace: routeIdentifier.Namespace,
+		Name:      string(targetGroupConfigRefs[0].Name),
+	}
+	tgConfig := &elbv2gw.TargetGroupConfiguration{}
+	if err := k8sClient.Get(ctx, tgConfigId, tgConfig); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			initialErrorMessage := fmt.Sprintf("TargetGroupConfiguration [%v] not found", tgConfigId.String())
+			wrappedGatewayErrorMessage := generateInvalidMessageWithRouteDetails(initialErrorMessage, routeKind, routeIdentifier)
+			return nil, wrapError(errors.Errorf("%s", initialErrorMessage), gwv1.GatewayReasonListenersNotValid, gwv1.RouteReasonIncompatibleFilters, &wrappedGatewayErrorMessage, nil), nil
+		}
+		return nil, nil, errors.Wrapf(err, "Unable to load target group config [%v] for route [%v]", tgConfigId.String(), routeIdentifier.String())
+	}
+	// A TGC with targetReference is attached to its target, it can't be attached to route backends as well.
+	if tgConfig.Spec.TargetReference != nil {
+		initialErrorMessage := fmt.Sprintf("TargetGroupConfiguration [%v] has targetReference set, it can't be referenced by a backend filter", tgConfigId.String())
+		wrappedGatewayErrorMessage := generateInvalidMessageWithRouteDetails(initialErrorMessage, routeKind, routeIdentifier)
+		return nil, wrapError(errors.Errorf("%s", initialErrorMessage), gwv1.GatewayReasonListenersNotValid, gwv1.RouteReasonIncompatibleFilters, &wrappedGatewayErrorMessage, nil), nil
+	}
+	return tgConfig, nil, nil
+}
+
+// applyRouteTargetGroupConfigToBackend merges the target group properties of the route TargetGroupConfiguration into the backend.
+// Route fields win, the Service and Gateway level properties already resolved for the backend are used as fallback.
+func applyRouteTargetGroupConfigToBackend(backend *Backend, routeTGConfig *elbv2gw.TargetGroupConfiguration, routeIdentifier types.NamespacedName, routeKind RouteKind) error {
+	if routeTGConfig == nil {
+		return nil
+	}
+	routeProps := tgConfigConstructor.ConstructTargetGroupConfigForRoute(routeTGConfig, routeIdentifier.Name, routeIdentifier.Namespace, string(routeKind))
+	switch {
+	case backend.ServiceBackend != nil:
+		backend.ServiceBackend.targetGroupProps = tgConfigConstructor.MergeProps(routeProps, backend.ServiceBackend.targetGroupProps)
+	case backend.ServiceImportBackend != nil:
+		backend.ServiceImportBackend.targetGroupProps = tgConfigConstructor.MergeProps(routeProps, backend.ServiceImportBackend.targetGroupProps)
+	default:
+		initialErrorMessage := fmt.Sprintf("TargetGroupConfiguration [%v] can only be attached to Service or ServiceImport backends", k8s.NamespacedName(routeTGConfig))
+		wrappedGatewayErrorMessage := generateInvalidMessageWithRouteDetails(initialErrorMessage, routeKind, routeIdentifier)
+		return wrapError(errors.Errorf("%s", initialErrorMessage), gwv1.GatewayReasonListenersNotValid, gwv1.RouteReasonIncompatibleFilters, &wrappedGatewayErrorMessage, nil)
+	}
+	return nil
+}
+
+// validateRouteTargetGroupConfigs detects backends of a route that share a target group but resolve to different
+// target group properties, which happens when the route attaches different TargetGroupConfigurations to them.
+// The target group is built from the first backend reference, later ones are reported as conflicting.
+func validateRouteTargetGroupConfigs(route RouteDescriptor) []routeLoadError {
+	var loadErrors []routeLoadError
+	propsByTargetGroup := make(map[string]*elbv2gw.TargetGroupProps)
+	reported := make(map[string]bool)
+	for _, rule := range route.GetAttachedRules() {
+		for _, backend := range rule.GetBackends() {
+			var configurator TargetGroupConfigurator
+			if backend.ServiceBackend != nil {
+				configurator = backend.ServiceBackend
+			} else if backend.ServiceImportBackend != nil {
+				configurator = backend.ServiceImportBackend
+			} else {
+				continue
+			}
+			port := configurator.GetIdentifierPort()
+			key := fmt.Sprintf("%s:%s", configurator.GetBackendNamespacedName(), port.String())
+			props, exists := propsByTargetGroup[key]
+			if !exists {
+				propsByTargetGroup[key] = configurator.GetTargetGroupProps()
+				continue
+			}
+			if reported[key] || equality.Semantic.DeepEqual(props, configurator.GetTargetGroupProps()) {
+				continue
+			}
+			reported[key] = true
+			initialErrorMessage := fmt.Sprintf("Backend %s is referenced with conflicting TargetGroupConfigurations, the configuration of its first reference is used", key)
+			wrappedGatewayErrorMessage := generateInvalidMessageWithRouteDetails(initialErrorMessage, route.GetRouteKind(), route.GetRouteNamespacedName())
+			loadErrors = append(loadErrors, routeLoadError{
+				Err: wrapError(errors.Errorf("%s", initialErrorMessage), gwv1.GatewayReasonListenersNotValid, gwv1.RouteReasonIncompatibleFilters, &wrappedGatewayErrorMessage, nil),
+			})
+		}
+	}
+	return loadErrors
+}
+
+// IsTargetGroupConfigInUseByRoutes checks if any L7 route attaches the TargetGroupConfiguration to one of its backends.
+func IsTargetGroupConfigInUseByRoutes(ctx context.Context, tgConfig *elbv2gw.TargetGroupConfiguration, k8sClient client.Client) (bool, error) {
+	l7Routes, err := ListL7Routes(ctx, k8sClient)
+	if err != nil {
+		return false, err
+	}
+	return len(FilterRoutesByTargetGroupCfg(l7Routes, tgConfig)) > 0, nil
+}
+
+// FilterRoutesByTargetGroupCfg filters a slice of routes based on backend TargetGroupConfiguration reference.
+// Returns a new slice containing only routes that attach the specified TargetGroupConfiguration to a backend.
+func FilterRoutesByTargetGroupCfg(routes []preLoadRouteDescriptor, tgConfig *elbv2gw.TargetGroupConfiguration) []preLoadRouteDescriptor {
+	if tgConfig == nil || len(routes) == 0 {
+		return []preLoadRouteDescriptor{}
+	}
+	filteredRoutes := make([]preLoadRouteDescriptor, 0, len(routes))
+	for _, route := range routes {
+		if route.GetRouteNamespacedName().Namespace != tgConfig.Namespace {
+			continue
+		}
+		for _, ref := range route.GetRouteTargetGroupConfigRefs() {
+			if string(ref.Name) == tgConfig.Name {
+				filteredRoutes = append(filteredRoutes, route)
+				break
+			}
+		}
+	}
+	return filteredRoutes
+}
@@ -0,0 +1,319 @@
+package routeutils
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	elbv2gw "sigs.k8s.io/aws-load-balancer-controller/v3/apis/gateway/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/gateway/constants"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/testutils"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func tgcExtensionRefFilter(name string) gwv1.HTTPRouteFilter {
+	return gwv1.HTTPRouteFilter{
+		Type: gwv1.HTTPRouteFilterExtensionRef,
+		ExtensionRef: &gwv1.LocalObjectReference{
+			Group: constants.ControllerCRDGroupVersion,
+			Kind:  constants.TargetGroupConfiguration,
+			Name:  gwv1.ObjectName(name),
+		},
+	}
+}
+
+func Test_getHTTPBackendTargetGroupConfigRefs(t *testing.T) {
+	backendRef := gwv1.HTTPBackendRef{
+		Filters: []gwv1.HTTPRouteFilter{
+			tgcExtensionRefFilter("tgc"),
+			{
+				Type: gwv1.HTTPRouteFilterExtensionRef,
+				ExtensionRef: &gwv1.LocalObjectReference{
+					Group: constants.ControllerCRDGroupVersion,
+					Kind:  constants.ListenerRuleConfiguration,
+					Name:  "lrc",
+				},
+			},
+			{
+				Type: gwv1.HTTPRouteFilterRequestHeaderModifier,
+			},
+		},
+	}
+	assert.Equal(t, []gwv1.LocalObjectReference{
+		{
+			Group: constants.ControllerCRDGroupVersion,
+			Kind:  constants.TargetGroupConfiguration,
+			Name:  "tgc",
+		},
+	}, getHTTPBackendTargetGroupConfigRefs(backendRef))
+	assert.Empty(t, getHTTPBackendTargetGroupConfigRefs(gwv1.HTTPBackendRef{}))
+}
+
+func Test_routeTargetGroupConfigLoader(t *testing.T) {
+	routeIdentifier := types.NamespacedName{Namespace: "ns", Name: "route"}
+	ref := func(name string) gwv1.LocalObjectReference {
+		return gwv1.LocalObjectReference{Group: constants.ControllerCRDGroupVersion, Kind: constants.TargetGroupConfiguration, Name: gwv1.ObjectName(name)}
+	}
+
+	tests := []struct {
+		name          string
+		refs          []gwv1.LocalObjectReference
+		expectWarning bool
+		expectName    string
+	}{
+		{
+			name: "no references",
+		},
+		{
+			name:          "multiple references",
+			refs:          []gwv1.LocalObjectReference{ref("route-tgc"), ref("other")},
+			expectWarning: true,
+		},
+		{
+			name:          "not found",
+			refs:          []gwv1.LocalObjectReference{ref("missing")},
+			expectWarning: true,
+		},
+		{
+			name:          "configuration with targetReference",
+			refs:          []gwv1.LocalObjectReference{ref("svc-tgc")},
+			expectWarning: true,
+		},
+		{
+			name:       "loaded",
+			refs:       []gwv1.LocalObjectReference{ref("route-tgc")},
+			expectName: "route-tgc",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			k8sClient := testutils.GenerateTestClient()
+			assert.NoError(t, k8sClient.Create(context.Background(), &elbv2gw.TargetGroupConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "route-tgc"},
+			}))
+			assert.NoError(t, k8sClient.Create(context.Background(), &elbv2gw.TargetGroupConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "svc-tgc"},
+				Spec: elbv2gw.TargetGroupConfigurationSpec{
+					TargetReference: &elbv2gw.Reference{Name: "svc"},
+				},
+			}))
+
+			tgConfig, warningErr, fatalErr := routeTargetGroupConfigLoader(context.Background(), k8sClient, routeIdentifier, HTTPRouteKind, tc.refs)
+			assert.NoError(t, fatalErr)
+			if tc.expectWarning {
+				assert.Error(t, warningErr)
+				assert.Equal(t, gwv1.RouteReasonIncompatibleFilters, warningErr.(LoaderError).GetRouteReason())
+				assert.Nil(t, tgConfig)
+				return
+			}
+			assert.NoError(t, warningErr)
+			if tc.expectName == "" {
+				assert.Nil(t, tgConfig)
+				return
+			}
+			assert.Equal(t, tc.expectName, tgConfig.Name)
+		})
+	}
+}
+
+func Test_applyRouteTargetGroupConfigToBackend(t *testing.T) {
+	routeIdentifier := types.NamespacedName{Namespace: "ns", Name: "route"}
+	ipTarget := elbv2gw.TargetTypeIP
+	instanceTarget := elbv2gw.TargetTypeInstance
+	routeTGConfig := &elbv2gw.TargetGroupConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "route-tgc"},
+		Spec: elbv2gw.TargetGroupConfigurationSpec{
+			DefaultConfiguration: elbv2gw.TargetGroupProps{
+				TargetType: &ipTarget,
+				HealthCheckConfig: &elbv2gw.HealthCheckConfiguration{
+					HealthCheckPath: awssdk.String("/route"),
+				},
+			},
+		},
+	}
+
+	t.Run("route configuration takes precedence over service configuration", func(t *testing.T) {
+		backend := &Backend{
+			ServiceBackend: NewServiceBackendConfig(&corev1.Service{}, &elbv2gw.TargetGroupProps{
+				TargetType:      &instanceTarget,
+				TargetGroupName: awssdk.String("svc-tg"),
+			}, &corev1.ServicePort{}),
+		}
+		assert.NoError(t, applyRouteTargetGroupConfigToBackend(backend, routeTGConfig, routeIdentifier, HTTPRouteKind))
+		props := backend.ServiceBackend.GetTargetGroupProps()
+		assert.Equal(t, ipTarget, *props.TargetType)
+		assert.Equal(t, "/route", *props.HealthCheckConfig.HealthCheckPath)
+		assert.Equal(t, "svc-tg", *props.TargetGroupName)
+	})
+
+	t.Run("service backend without service configuration", func(t *testing.T) {
+		backend := &Backend{
+			ServiceBackend: NewServiceBackendConfig(&corev1.Service{}, nil, &corev1.ServicePort{}),
+		}
+		assert.NoError(t, applyRouteTargetGroupConfigToBackend(backend, routeTGConfig, routeIdentifier, HTTPRouteKind))
+		assert.Equal(t, ipTarget, *backend.ServiceBackend.GetTargetGroupProps().TargetType)
+	})
+
+	t.Run("unsupported backend", func(t *testing.T) {
+		backend := &Backend{
+			LiteralTargetGroup: &LiteralTargetGroupConfig{Name: "tg"},
+		}
+		err := applyRouteTargetGroupConfigToBackend(backend, routeTGConfig, routeIdentifier, HTTPRouteKind)
+		assert.Error(t, err)
+		assert.Equal(t, gwv1.RouteReasonIncompatibleFilters, err.(LoaderError).GetRouteReason())
+	})
+}
+
+func Test_validateRouteTargetGroupConfigs(t *testing.T) {
+	svc := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "svc"}}
+	svcPort := &corev1.ServicePort{Port: 80, TargetPort: intstr.FromInt32(8080)}
+	ipTarget := elbv2gw.TargetTypeIP
+	instanceTarget := elbv2gw.TargetTypeInstance
+	newRoute := func(props ...*elbv2gw.TargetGroupProps) *httpRouteDescription {
+		rules := make([]RouteRule, 0, len(props))
+		for _, p := range props {
+			rules = append(rules, &convertedHTTPRouteRule{
+				backends: []Backend{{ServiceBackend: NewServiceBackendConfig(svc, p, svcPort), Weight: 1}},
+			})
+		}
+		return &httpRouteDescription{
+			route: &gwv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "route"}},
+			rules: rules,
+		}
+	}
+
+	assert.Empty(t, validateRouteTargetGroupConfigs(newRoute(nil, nil)))
+	assert.Empty(t, validateRouteTargetGroupConfigs(newRoute(&elbv2gw.TargetGroupProps{TargetType: &ipTarget}, &elbv2gw.TargetGroupProps{TargetType: &ipTarget})))
+
+	errs := validateRouteTargetGroupConfigs(newRoute(&elbv2gw.TargetGroupProps{TargetType: &ipTarget}, &elbv2gw.TargetGroupProps{TargetType: &instanceTarget}, nil))
+	assert.Len(t, errs, 1)
+	assert.False(t, errs[0].Fatal)
+	assert.Equal(t, gwv1.RouteReasonIncompatibleFilters, errs[0].Err.(LoaderError).GetRouteReason())
+	assert.Contains(t, errs[0].Err.Error(), "ns/svc:8080")
+}
+
+func Test_HTTP_LoadAttachedRules_RouteTargetGroupConfig(t *testing.T) {
+	ctx := context.Background()
+	k8sClient := testutils.GenerateTestClient()
+	ipTarget := elbv2gw.TargetTypeIP
+	instanceTarget := elbv2gw.TargetTypeInstance
+	assert.NoError(t, k8sClient.Create(ctx, &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "svc"},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{{Name: "http", Port: 80, TargetPort: intstr.FromInt32(8080)}},
+		},
+	}))
+	assert.NoError(t, k8sClient.Create(ctx, &elbv2gw.TargetGroupConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "svc-tgc"},
+		Spec: elbv2gw.TargetGroupConfigurationSpec{
+			TargetReference: &elbv2gw.Reference{Name: "svc"},
+			DefaultConfiguration: elbv2gw.TargetGroupProps{
+				TargetType:      &instanceTarget,
+				TargetGroupName: awssdk.String("svc-tg"),
+			},
+		},
+	}))
+	assert.NoError(t, k8sClient.Create(ctx, &elbv2gw.TargetGroupConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "route-tgc"},
+		Spec: elbv2gw.TargetGroupConfigurationSpec{
+			DefaultConfiguration: elbv2gw.TargetGroupProps{
+				TargetType: &ipTarget,
+			},
+		},
+	}))
+
+	port := gwv1.PortNumber(80)
+	backendRef := func(filters ...gwv1.HTTPRouteFilter) gwv1.HTTPBackendRef {
+		return gwv1.HTTPBackendRef{
+			BackendRef: gwv1.BackendRef{
+				BackendObjectReference: gwv1.BackendObjectReference{Name: "svc", Port: &port},
+			},
+			Filters: filters,
+		}
+	}
+
+	t.Run("route configuration is merged over the service configuration", func(t *testing.T) {
+		route := convertHTTPRoute(gwv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "route"},
+			Spec: gwv1.HTTPRouteSpec{
+				Rules: []gwv1.HTTPRouteRule{
+					{BackendRefs: []gwv1.HTTPBackendRef{backendRef(tgcExtensionRefFilter("route-tgc"))}},
+				},
+			},
+		})
+		loaded, errs := route.loadAttachedRules(ctx, k8sClient, nil)
+		assert.Empty(t, errs)
+		backends := loaded.GetAttachedRules()[0].GetBackends()
+		assert.Len(t, backends, 1)
+		props := backends[0].ServiceBackend.GetTargetGroupProps()
+		assert.Equal(t, ipTarget, *props.TargetType)
+		assert.Equal(t, "svc-tg", *props.TargetGroupName)
+		assert.Equal(t, []gwv1.LocalObjectReference{
+			{Group: constants.ControllerCRDGroupVersion, Kind: constants.TargetGroupConfiguration, Name: "route-tgc"},
+		}, loaded.GetRouteTargetGroupConfigRefs())
+	})
+
+	t.Run("missing route configuration drops the backend", func(t *testing.T) {
+		route := convertHTTPRoute(gwv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "route"},
+			Spec: gwv1.HTTPRouteSpec{
+				Rules: []gwv1.HTTPRouteRule{
+					{BackendRefs: []gwv1.HTTPBackendRef{backendRef(tgcExtensionRefFilter("missing"))}},
+				},
+			},
+		})
+		loaded, errs := route.loadAttachedRules(ctx, k8sClient, nil)
+		assert.Len(t, errs, 1)
+		assert.False(t, errs[0].Fatal)
+		assert.Empty(t, loaded.GetAttachedRules()[0].GetBackends())
+	})
+
+	t.Run("conflicting configurations for the same backend", func(t *testing.T) {
+		route := convertHTTPRoute(gwv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "route"},
+			Spec: gwv1.HTTPRouteSpec{
+				Rules: []gwv1.HTTPRouteRule{
+					{BackendRefs: []gwv1.HTTPBackendRef{backendRef(tgcExtensionRefFilter("route-tgc"))}},
+					{BackendRefs: []gwv1.HTTPBackendRef{backendRef()}},
+				},
+			},
+		})
+		loaded, errs := route.loadAttachedRules(ctx, k8sClient, nil)
+		assert.Len(t, errs, 1)
+		assert.Equal(t, gwv1.RouteReasonIncompatibleFilters, errs[0].Err.(LoaderError).GetRouteReason())
+		assert.Len(t, loaded.GetAttachedRules(), 2)
+	})
+}
+
+func TestFilterRoutesByTargetGroupCfg(t *testing.T) {
+	tgConfig := &elbv2gw.TargetGroupConfiguration{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "route-tgc"}}
+	referencing := convertHTTPRoute(gwv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "referencing"},
+		Spec: gwv1.HTTPRouteSpec{
+			Rules: []gwv1.HTTPRouteRule{
+				{BackendRefs: []gwv1.HTTPBackendRef{{Filters: []gwv1.HTTPRouteFilter{tgcExtensionRefFilter("route-tgc")}}}},
+			},
+		},
+	})
+	otherNamespace := convertHTTPRoute(gwv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: "referencing"},
+		Spec: gwv1.HTTPRouteSpec{
+			Rules: []gwv1.HTTPRouteRule{
+				{BackendRefs: []gwv1.HTTPBackendRef{{Filters: []gwv1.HTTPRouteFilter{tgcExtensionRefFilter("route-tgc")}}}},
+			},
+		},
+	})
+	notReferencing := convertHTTPRoute(gwv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "other"},
+	})
+
+	filtered := FilterRoutesByTargetGroupCfg([]preLoadRouteDescriptor{referencing, otherNamespace, notReferencing}, tgConfig)
+	assert.Equal(t, []preLoadRouteDescriptor{referencing}, filtered)
+	assert.Empty(t, FilterRoutesByTargetGroupCfg([]preLoadRouteDescriptor{referencing}, nil))
+}
@@ -21,7 +21,7 @@ TCP specific features of the route.
 
 var _ RouteRule = &convertedTCPRouteRule{}
 
-var defaultTCPRuleAccumulator = newAttachedRuleAccumulator[gwv1.TCPRouteRule](commonBackendLoader, listenerRuleConfigLoader)
+var defaultTCPRuleAccumulator = newAttachedRuleAccumulator[gwv1.TCPRouteRule](commonBackendLoader, listenerRuleConfigLoader, routeTargetGroupConfigLoader)
 
 type convertedTCPRouteRule struct {
 	rule               *gwv1.TCPRouteRule
@@ -63,8 +63,8 @@ func (tcpRoute *tcpRouteDescription) GetAttachedRules() []RouteRule {
 }
 
 func (tcpRoute *tcpRouteDescription) loadAttachedRules(ctx context.Context, k8sClient client.Client, gatewayDefaultTGConfig *elbv2gw.TargetGroupConfiguration) (RouteDescriptor, []routeLoadError) {
-	convertedRules, allErrors := tcpRoute.ruleAccumulator.accumulateRules(ctx, k8sClient, tcpRoute, tcpRoute.route.Spec.Rules, func(rule gwv1.TCPRouteRule) []gwv1.BackendRef {
-		return rule.BackendRefs
+	convertedRules, allErrors := tcpRoute.ruleAccumulator.accumulateRules(ctx, k8sClient, tcpRoute, tcpRoute.route.Spec.Rules, func(rule gwv1.TCPRouteRule) []routeBackendRef {
+		return toRouteBackendRefs(rule.BackendRefs)
 	}, func(rule gwv1.TCPRouteRule) []gwv1.LocalObjectReference {
 		return []gwv1.LocalObjectReference{}
 	}, func(trr *gwv1.TCPRouteRule, backends []Backend, listenerRuleConfiguration *elbv2gw.ListenerRuleConfiguration) RouteRule {
@@ -116,6 +116,10 @@ func (tcpRoute *tcpRouteDescription) GetRouteListenerRuleConfigRefs() []gwv1.Loc
 	return []gwv1.LocalObjectReference{}
 }
 
+func (tcpRoute *tcpRouteDescription) GetRouteTargetGroupConfigRefs() []gwv1.LocalObjectReference {
+	return []gwv1.LocalObjectReference{}
+}
+
 func (tcpRoute *tcpRouteDescription) GetRouteGeneration() int64 {
 	return tcpRoute.route.Generation
 }
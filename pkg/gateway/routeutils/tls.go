@@ -21,7 +21,7 @@ TLS specific features of the route.
 
 var _ RouteRule = &convertedTLSRouteRule{}
 
-var defaultTLSRuleAccumulator = newAttachedRuleAccumulator[gwv1.TLSRouteRule](commonBackendLoader, listenerRuleConfigLoader)
+var defaultTLSRuleAccumulator = newAttachedRuleAccumulator[gwv1.TLSRouteRule](commonBackendLoader, listenerRuleConfigLoader, routeTargetGroupConfigLoader)
 
 type convertedTLSRouteRule struct {
 	rule               *gwv1.TLSRouteRule
@@ -63,8 +63,8 @@ func (tlsRoute *tlsRouteDescription) GetAttachedRules() []RouteRule {
 }
 
 func (tlsRoute *tlsRouteDescription) loadAttachedRules(ctx context.Context, k8sClient client.Client, gatewayDefaultTGConfig *elbv2gw.TargetGroupConfiguration) (RouteDescriptor, []routeLoadError) {
-	convertedRules, allErrors := tlsRoute.ruleAccumulator.accumulateRules(ctx, k8sClient, tlsRoute, tlsRoute.route.Spec.Rules, func(rule gwv1.TLSRouteRule) []gwv1.BackendRef {
-		return rule.BackendRefs
+	convertedRules, allErrors := tlsRoute.ruleAccumulator.accumulateRules(ctx, k8sClient, tlsRoute, tlsRoute.route.Spec.Rules, func(rule gwv1.TLSRouteRule) []routeBackendRef {
+		return toRouteBackendRefs(rule.BackendRefs)
 	}, func(rule gwv1.TLSRouteRule) []gwv1.LocalObjectReference {
 		return []gwv1.LocalObjectReference{}
 	}, func(trr *gwv1.TLSRouteRule, backends []Backend, listenerRuleConfiguration *elbv2gw.ListenerRuleConfiguration) RouteRule {
@@ -120,6 +120,10 @@ func (tlsRoute *tlsRouteDescription) GetRouteListenerRuleConfigRefs() []gwv1.Loc
 	return []gwv1.LocalObjectReference{}
 }
 
+func (tlsRoute *tlsRouteDescription) GetRouteTargetGroupConfigRefs() []gwv1.LocalObjectReference {
+	return []gwv1.LocalObjectReference{}
+}
+
 func (tlsRoute *tlsRouteDescription) GetRouteCreateTimestamp() time.Time {
 	return tlsRoute.route.CreationTimestamp.Time
 }
@@ -21,7 +21,7 @@ UDP specific features of the route.
 
 var _ RouteRule = &convertedUDPRouteRule{}
 
-var defaultUDPRuleAccumulator = newAttachedRuleAccumulator[gwv1.UDPRouteRule](commonBackendLoader, listenerRuleConfigLoader)
+var defaultUDPRuleAccumulator = newAttachedRuleAccumulator[gwv1.UDPRouteRule](commonBackendLoader, listenerRuleConfigLoader, routeTargetGroupConfigLoader)
 
 type convertedUDPRouteRule struct {
 	rule               *gwv1.UDPRouteRule
@@ -63,8 +63,8 @@ func (udpRoute *udpRouteDescription) GetAttachedRules() []RouteRule {
 }
 
 func (udpRoute *udpRouteDescription) loadAttachedRules(ctx context.Context, k8sClient client.Client, gatewayDefaultTGConfig *elbv2gw.TargetGroupConfiguration) (RouteDescriptor, []routeLoadError) {
-	convertedRules, allErrors := udpRoute.ruleAccumulator.accumulateRules(ctx, k8sClient, udpRoute, udpRoute.route.Spec.Rules, func(rule gwv1.UDPRouteRule) []gwv1.BackendRef {
-		return rule.BackendRefs
+	convertedRules, allErrors := udpRoute.ruleAccumulator.accumulateRules(ctx, k8sClient, udpRoute, udpRoute.route.Spec.Rules, func(rule gwv1.UDPRouteRule) []routeBackendRef {
+		return toRouteBackendRefs(rule.BackendRefs)
 	}, func(rule gwv1.UDPRouteRule) []gwv1.LocalObjectReference {
 		return []gwv1.LocalObjectReference{}
 	}, func(urr *gwv1.UDPRouteRule, backends []Backend, listenerRuleConfiguration *elbv2gw.ListenerRuleConfiguration) RouteRule {
@@ -120,6 +120,10 @@ func (udpRoute *udpRouteDescription) GetRouteListenerRuleConfigRefs() []gwv1.Loc
 	return []gwv1.LocalObjectReference{}
 }
 
+func (udpRoute *udpRouteDescription) GetRouteTargetGroupConfigRefs() []gwv1.LocalObjectReference {
+	return []gwv1.LocalObjectReference{}
+}
+
 func (udpRoute *udpRouteDescription) GetRouteCreateTimestamp() time.Time {
 	return udpRoute.route.CreationTimestamp.Time
 }
@@ -26,6 +26,7 @@ var _ RouteDescriptor = &mockPreLoadRouteDescriptor{}
 type mockPreLoadRouteDescriptor struct {
 	backendRefs                []gwv1.BackendRef
 	listenerRuleConfigurations []gwv1.LocalObjectReference
+	targetGroupConfigurations  []gwv1.LocalObjectReference
 	namespacedName             types.NamespacedName
 	compatibleHostnames        []gwv1.Hostname
 }
@@ -67,6 +68,10 @@ func (m mockPreLoadRouteDescriptor) GetRouteListenerRuleConfigRefs() []gwv1.Loca
 	return m.listenerRuleConfigurations
 }
 
+func (m mockPreLoadRouteDescriptor) GetRouteTargetGroupConfigRefs() []gwv1.LocalObjectReference {
+	return m.targetGroupConfigurations
+}
+
 func (m mockPreLoadRouteDescriptor) GetRouteGeneration() int64 {
 	//TODO implement me
 	panic("implement me")
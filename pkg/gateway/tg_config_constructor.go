@@ -125,6 +125,12 @@ func (t *targetGroupConfigConstructorImpl) performTakeOneMerges(merged, highPrio
 		merged.TargetControlPort = defaultProps.TargetControlPort
 	}
 
+	if highPriority.CloudWatchAlarmThresholds != nil {
+		merged.CloudWatchAlarmThresholds = highPriority.CloudWatchAlarmThresholds
+	} else {
+		merged.CloudWatchAlarmThresholds = defaultProps.CloudWatchAlarmThresholds
+	}
+
 	if highPriority.EnableMultiCluster != nil {
 		merged.EnableMultiCluster = highPriority.EnableMultiCluster
 	} else {
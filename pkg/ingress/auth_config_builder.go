@@ -2,7 +2,10 @@ package ingress
 
 import (
 	"context"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/pkg/errors"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/v3/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/annotations"
 )
 
@@ -142,3 +145,46 @@ func (b *defaultAuthConfigBuilder) buildAuthSessionTimeout(_ context.Context, sv
 	}
 	return rawAuthSessionTimeout, nil
 }
+
+// buildIngressClassAuthConfig builds the AuthConfig from the authentication settings of IngressClassParams.
+func buildIngressClassAuthConfig(ingClassAuthCfg elbv2api.AuthenticationConfig) AuthConfig {
+	authCfg := AuthConfig{
+		Type:                     AuthType(ingClassAuthCfg.Type),
+		OnUnauthenticatedRequest: defaultAuthOnUnauthenticatedRequest,
+		Scope:                    awssdk.ToString(ingClassAuthCfg.Scope),
+		SessionCookieName:        awssdk.ToString(ingClassAuthCfg.SessionCookieName),
+		SessionTimeout:           awssdk.ToInt64(ingClassAuthCfg.SessionTimeout),
+	}
+	if ingClassAuthCfg.OnUnauthenticatedRequest != nil {
+		authCfg.OnUnauthenticatedRequest = string(*ingClassAuthCfg.OnUnauthenticatedRequest)
+	}
+	if ingClassAuthCfg.Scope == nil {
+		authCfg.Scope = defaultAuthScope
+	}
+	if ingClassAuthCfg.SessionCookieName == nil {
+		authCfg.SessionCookieName = defaultAuthSessionCookieName
+	}
+	if ingClassAuthCfg.SessionTimeout == nil {
+		authCfg.SessionTimeout = defaultAuthSessionTimeout
+	}
+	if ingClassAuthCfg.IDPConfigCognito != nil {
+		authCfg.IDPConfigCognito = &AuthIDPConfigCognito{
+			UserPoolARN:                      ingClassAuthCfg.IDPConfigCognito.UserPoolARN,
+			UserPoolClientID:                 ingClassAuthCfg.IDPConfigCognito.UserPoolClientID,
+			UserPoolDomain:                   ingClassAuthCfg.IDPConfigCognito.UserPoolDomain,
+			AuthenticationRequestExtraParams: ingClassAuthCfg.IDPConfigCognito.AuthenticationRequestExtraParams,
+		}
+	}
+	if ingClassAuthCfg.IDPConfigOIDC != nil {
+		authCfg.IDPConfigOIDC = &AuthIDPConfigOIDC{
+			Issuer:                           ingClassAuthCfg.IDPConfigOIDC.Issuer,
+			AuthorizationEndpoint:            ingClassAuthCfg.IDPConfigOIDC.AuthorizationEndpoint,
+			TokenEndpoint:                    ingClassAuthCfg.IDPConfigOIDC.TokenEndpoint,
+			UserInfoEndpoint:                 ingClassAuthCfg.IDPConfigOIDC.UserInfoEndpoint,
+			SecretName:                       ingClassAuthCfg.IDPConfigOIDC.SecretName,
+			SecretNamespace:                  ingClassAuthCfg.IDPConfigOIDC.SecretNamespace,
+			AuthenticationRequestExtraParams: ingClassAuthCfg.IDPConfigOIDC.AuthenticationRequestExtraParams,
+		}
+	}
+	return authCfg
+}
@@ -444,6 +444,9 @@ type AuthIDPConfigOIDC struct {
 	// The k8s secretName.
 	SecretName string `json:"secretName"`
 
+	// The k8s secretNamespace, only set from IngressClassParams. Defaults to the namespace of Ingress.
+	SecretNamespace string `json:"-"`
+
 	// The query parameters (up to 10) to include in the redirect request to the authorization endpoint.
 	// +optional
 	AuthenticationRequestExtraParams map[string]string `json:"authenticationRequestExtraParams,omitempty"`
@@ -12,6 +12,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/v3/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/annotations"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -350,6 +351,22 @@ func (b *defaultEnhancedBackendBuilder) buildJwtValidationConfig(_ context.Conte
 	return &jwtValidationConfig, nil
 }
 
+// buildIngressClassJwtValidationConfig builds the JwtValidationConfig from the jwtValidation settings of IngressClassParams.
+func buildIngressClassJwtValidationConfig(ingClassJwtValidationCfg elbv2api.JwtValidationConfig) *JwtValidationConfig {
+	jwtValidationConfig := &JwtValidationConfig{
+		JwksEndpoint: ingClassJwtValidationCfg.JwksEndpoint,
+		Issuer:       ingClassJwtValidationCfg.Issuer,
+	}
+	for _, additionalClaim := range ingClassJwtValidationCfg.AdditionalClaims {
+		jwtValidationConfig.AdditionalClaims = append(jwtValidationConfig.AdditionalClaims, JwtAdditionalClaim{
+			Format: jwtAdditionalClaimFormat(additionalClaim.Format),
+			Name:   additionalClaim.Name,
+			Values: additionalClaim.Values,
+		})
+	}
+	return jwtValidationConfig
+}
+
 // build503ResponseAction generates a 503 fixed response action when forward to a single non-existent Kubernetes Service.
 func (b *defaultEnhancedBackendBuilder) build503ResponseAction(messageBody string) Action {
 	return Action{
@@ -17,6 +17,7 @@ import (
 func (t *defaultModelBuildTask) buildActions(ctx context.Context, protocol elbv2model.Protocol, ing ClassifiedIngress, backend EnhancedBackend) ([]elbv2model.Action, error) {
 	var actions []elbv2model.Action
 	if protocol == elbv2model.ProtocolHTTPS {
+		backend = t.applyIngressClassAuthConfig(ing.IngClassConfig, backend)
 		authAction, err := t.buildAuthAction(ctx, ing.Ing.Namespace, backend)
 		if err != nil {
 			return nil, err
@@ -46,6 +47,29 @@ func (t *defaultModelBuildTask) buildActions(ctx context.Context, protocol elbv2
 	return actions, nil
 }
 
+// applyIngressClassAuthConfig overrides the authentication and JWT validation settings of backend with the ones from IngressClassParams.
+// Note: the settings specified via IngressClass takes higher priority than the annotations on Ingress or Service.
+func (t *defaultModelBuildTask) applyIngressClassAuthConfig(ingClassConfig ClassConfiguration, backend EnhancedBackend) EnhancedBackend {
+	if ingClassConfig.IngClassParams == nil {
+		return backend
+	}
+	ingClassAuthCfg := ingClassConfig.IngClassParams.Spec.Authentication
+	ingClassJwtValidationCfg := ingClassConfig.IngClassParams.Spec.JwtValidation
+	if ingClassAuthCfg != nil {
+		backend.AuthConfig = buildIngressClassAuthConfig(*ingClassAuthCfg)
+		if backend.AuthConfig.Type != AuthTypeNone {
+			backend.JwtValidationConfig = nil
+		}
+	}
+	if ingClassJwtValidationCfg != nil {
+		backend.JwtValidationConfig = buildIngressClassJwtValidationConfig(*ingClassJwtValidationCfg)
+		if ingClassAuthCfg == nil {
+			backend.AuthConfig = AuthConfig{Type: AuthTypeNone}
+		}
+	}
+	return backend
+}
+
 func (t *defaultModelBuildTask) buildBackendAction(ctx context.Context, ing ClassifiedIngress, actionCfg Action) (elbv2model.Action, error) {
 	switch actionCfg.Type {
 	case ActionTypeFixedResponse:
@@ -184,8 +208,12 @@ func (t *defaultModelBuildTask) buildAuthenticateOIDCAction(ctx context.Context,
 		return elbv2model.Action{}, errors.New("missing IDPConfigOIDC")
 	}
 	onUnauthenticatedRequest := elbv2model.AuthenticateOIDCActionConditionalBehavior(authCfg.OnUnauthenticatedRequest)
+	secretNamespace := namespace
+	if authCfg.IDPConfigOIDC.SecretNamespace != "" {
+		secretNamespace = authCfg.IDPConfigOIDC.SecretNamespace
+	}
 	secretKey := types.NamespacedName{
-		Namespace: namespace,
+		Namespace: secretNamespace,
 		Name:      authCfg.IDPConfigOIDC.SecretName,
 	}
 	secret, err := t.secretsManager.GetSecret(ctx, t.k8sClient, secretKey)
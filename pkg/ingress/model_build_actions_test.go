@@ -17,6 +17,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/v3/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2"
@@ -222,6 +223,56 @@ func Test_defaultModelBuildTask_buildAuthenticateOIDCAction(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "clientID & clientSecret configured - secret namespace from IngressClassParams",
+			env: env{
+				secrets: []*corev1.Secret{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "auth-system",
+							Name:      "my-k8s-secret",
+						},
+						Data: map[string][]byte{
+							"clientID":     []byte("my-client-id"),
+							"clientSecret": []byte("my-client-secret"),
+						},
+					},
+				},
+			},
+			args: args{
+				authCfg: AuthConfig{
+					Type: AuthTypeOIDC,
+					IDPConfigOIDC: &AuthIDPConfigOIDC{
+						Issuer:                "https://example.com",
+						AuthorizationEndpoint: "https://authorization.example.com",
+						TokenEndpoint:         "https://token.example.com",
+						UserInfoEndpoint:      "https://userinfo.example.co",
+						SecretName:            "my-k8s-secret",
+						SecretNamespace:       "auth-system",
+					},
+					OnUnauthenticatedRequest: "authenticate",
+					Scope:                    "openid",
+					SessionCookieName:        "AWSELBAuthSessionCookie",
+					SessionTimeout:           604800,
+				},
+				namespace: "my-ns",
+			},
+			want: elbv2model.Action{
+				Type: elbv2model.ActionTypeAuthenticateOIDC,
+				AuthenticateOIDCConfig: &elbv2model.AuthenticateOIDCActionConfig{
+					Issuer:                   "https://example.com",
+					AuthorizationEndpoint:    "https://authorization.example.com",
+					TokenEndpoint:            "https://token.example.com",
+					UserInfoEndpoint:         "https://userinfo.example.co",
+					ClientID:                 "my-client-id",
+					ClientSecret:             "my-client-secret",
+					OnUnauthenticatedRequest: authBehaviorAuthenticate,
+					Scope:                    awssdk.String("openid"),
+					SessionCookieName:        awssdk.String("AWSELBAuthSessionCookie"),
+					SessionTimeout:           awssdk.Int64(604800),
+				},
+			},
+		},
 		{
 			name: "missing IDPConfigOIDC",
 			args: args{
@@ -332,6 +383,166 @@ func Test_defaultModelBuildTask_buildAuthenticateOIDCAction(t *testing.T) {
 	}
 }
 
+func Test_defaultModelBuildTask_applyIngressClassAuthConfig(t *testing.T) {
+	annotationAuthCfg := AuthConfig{
+		Type: AuthTypeCognito,
+		IDPConfigCognito: &AuthIDPConfigCognito{
+			UserPoolARN:      "arn:aws:cognito-idp:us-west-2:123456789012:userpool/us-west-2_abc",
+			UserPoolClientID: "client-id",
+			UserPoolDomain:   "my-domain",
+		},
+		OnUnauthenticatedRequest: "deny",
+		Scope:                    "email",
+		SessionCookieName:        "my-session-cookie",
+		SessionTimeout:           3600,
+	}
+	annotationJwtValidationCfg := &JwtValidationConfig{
+		JwksEndpoint: "https://annotation.example.com/.well-known/jwks.json",
+		Issuer:       "https://annotation.example.com",
+	}
+	forwardAction := Action{
+		Type: ActionTypeForward,
+		ForwardConfig: &ForwardActionConfig{
+			TargetGroups: []TargetGroupTuple{{ServiceName: awssdk.String("svc-1")}},
+		},
+	}
+	tests := []struct {
+		name           string
+		ingClassConfig ClassConfiguration
+		backend        EnhancedBackend
+		want           EnhancedBackend
+	}{
+		{
+			name:           "no IngressClassParams",
+			ingClassConfig: ClassConfiguration{},
+			backend: EnhancedBackend{
+				Action:     forwardAction,
+				AuthConfig: annotationAuthCfg,
+			},
+			want: EnhancedBackend{
+				Action:     forwardAction,
+				AuthConfig: annotationAuthCfg,
+			},
+		},
+		{
+			name: "IngressClassParams without authentication or jwtValidation",
+			ingClassConfig: ClassConfiguration{
+				IngClassParams: &elbv2api.IngressClassParams{},
+			},
+			backend: EnhancedBackend{
+				Action:              forwardAction,
+				JwtValidationConfig: annotationJwtValidationCfg,
+			},
+			want: EnhancedBackend{
+				Action:              forwardAction,
+				JwtValidationConfig: annotationJwtValidationCfg,
+			},
+		},
+		{
+			name: "IngressClassParams oidc authentication overrides annotations",
+			ingClassConfig: ClassConfiguration{
+				IngClassParams: &elbv2api.IngressClassParams{
+					Spec: elbv2api.IngressClassParamsSpec{
+						Authentication: &elbv2api.AuthenticationConfig{
+							Type: elbv2api.AuthTypeOIDC,
+							IDPConfigOIDC: &elbv2api.AuthIDPConfigOIDC{
+								Issuer:                "https://example.com",
+								AuthorizationEndpoint: "https://authorization.example.com",
+								TokenEndpoint:         "https://token.example.com",
+								UserInfoEndpoint:      "https://userinfo.example.com",
+								SecretName:            "my-oidc-secret",
+								SecretNamespace:       "auth-system",
+							},
+						},
+					},
+				},
+			},
+			backend: EnhancedBackend{
+				Action:              forwardAction,
+				AuthConfig:          annotationAuthCfg,
+				JwtValidationConfig: annotationJwtValidationCfg,
+			},
+			want: EnhancedBackend{
+				Action: forwardAction,
+				AuthConfig: AuthConfig{
+					Type: AuthTypeOIDC,
+					IDPConfigOIDC: &AuthIDPConfigOIDC{
+						Issuer:                "https://example.com",
+						AuthorizationEndpoint: "https://authorization.example.com",
+						TokenEndpoint:         "https://token.example.com",
+						UserInfoEndpoint:      "https://userinfo.example.com",
+						SecretName:            "my-oidc-secret",
+						SecretNamespace:       "auth-system",
+					},
+					OnUnauthenticatedRequest: "authenticate",
+					Scope:                    "openid",
+					SessionCookieName:        "AWSELBAuthSessionCookie",
+					SessionTimeout:           604800,
+				},
+			},
+		},
+		{
+			name: "IngressClassParams none authentication disables annotation authentication",
+			ingClassConfig: ClassConfiguration{
+				IngClassParams: &elbv2api.IngressClassParams{
+					Spec: elbv2api.IngressClassParamsSpec{
+						Authentication: &elbv2api.AuthenticationConfig{
+							Type: elbv2api.AuthTypeNone,
+						},
+					},
+				},
+			},
+			backend: EnhancedBackend{
+				Action:     forwardAction,
+				AuthConfig: annotationAuthCfg,
+			},
+			want: EnhancedBackend{
+				Action: forwardAction,
+				AuthConfig: AuthConfig{
+					Type:                     AuthTypeNone,
+					OnUnauthenticatedRequest: "authenticate",
+					Scope:                    "openid",
+					SessionCookieName:        "AWSELBAuthSessionCookie",
+					SessionTimeout:           604800,
+				},
+			},
+		},
+		{
+			name: "IngressClassParams jwtValidation overrides annotations and disables annotation authentication",
+			ingClassConfig: ClassConfiguration{
+				IngClassParams: &elbv2api.IngressClassParams{
+					Spec: elbv2api.IngressClassParamsSpec{
+						JwtValidation: &elbv2api.JwtValidationConfig{
+							JwksEndpoint: "https://issuer.example.com/.well-known/jwks.json",
+							Issuer:       "https://issuer.example.com",
+						},
+					},
+				},
+			},
+			backend: EnhancedBackend{
+				Action:              forwardAction,
+				AuthConfig:          annotationAuthCfg,
+				JwtValidationConfig: annotationJwtValidationCfg,
+			},
+			want: EnhancedBackend{
+				Action:     forwardAction,
+				AuthConfig: AuthConfig{Type: AuthTypeNone},
+				JwtValidationConfig: &JwtValidationConfig{
+					JwksEndpoint: "https://issuer.example.com/.well-known/jwks.json",
+					Issuer:       "https://issuer.example.com",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{}
+			got := task.applyIngressClassAuthConfig(tt.ingClassConfig, tt.backend)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func Test_defaultModelBuildTask_buildJwtValidationAction(t *testing.T) {
 	type args struct {
 		jwtValidationConfig *JwtValidationConfig
@@ -17,6 +17,7 @@ import (
 	networking "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/v3/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/config"
@@ -325,31 +326,47 @@ func (t *defaultModelBuildTask) computeIngressExplicitPrefixListIDs(_ context.Co
 }
 
 type MutualAuthenticationConfig struct {
-	Port                          int32   `json:"port"`
-	Mode                          string  `json:"mode"`
-	TrustStore                    *string `json:"trustStore,omitempty"`
-	IgnoreClientCertificateExpiry *bool   `json:"ignoreClientCertificateExpiry,omitempty"`
-	AdvertiseTrustStoreCaNames    *string `json:"advertiseTrustStoreCaNames,omitempty"`
+	Port                          int32                     `json:"port"`
+	Mode                          string                    `json:"mode"`
+	TrustStore                    *string                   `json:"trustStore,omitempty"`
+	TrustStoreCABundle            *TrustStoreCABundleConfig `json:"trustStoreCABundle,omitempty"`
+	IgnoreClientCertificateExpiry *bool                     `json:"ignoreClientCertificateExpiry,omitempty"`
+	AdvertiseTrustStoreCaNames    *string                   `json:"advertiseTrustStoreCaNames,omitempty"`
 }
 
-func (t *defaultModelBuildTask) computeIngressMutualAuthentication(ctx context.Context, ing *ClassifiedIngress) (map[int32]*elbv2model.MutualAuthenticationAttributes, error) {
-	var rawMtlsConfigString string
-	if exists := t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixMutualAuthentication, &rawMtlsConfigString, ing.Ing.Annotations); !exists {
-		return nil, nil
-	}
-
-	var ingressAnnotationEntries []MutualAuthenticationConfig
+// TrustStoreCABundleConfig references the CA bundle of a trust store managed by the controller.
+type TrustStoreCABundleConfig struct {
+	Kind string `json:"kind,omitempty"`
+	// Namespace can only be specified via IngressClassParams, the Ingress namespace is used otherwise.
+	Namespace          string   `json:"-"`
+	Name               string   `json:"name"`
+	Key                string   `json:"key,omitempty"`
+	RevocationListKeys []string `json:"revocationListKeys,omitempty"`
+}
 
-	if err := json.Unmarshal([]byte(rawMtlsConfigString), &ingressAnnotationEntries); err != nil {
-		return nil, errors.Wrapf(err, "failed to parse mutualAuthentication configuration from ingress annotation: `%s`", rawMtlsConfigString)
-	}
-	if len(ingressAnnotationEntries) == 0 {
-		return nil, errors.Errorf("empty mutualAuthentication configuration from ingress annotation: `%s`", rawMtlsConfigString)
+func (t *defaultModelBuildTask) computeIngressMutualAuthentication(ctx context.Context, ing *ClassifiedIngress) (map[int32]*elbv2model.MutualAuthenticationAttributes, error) {
+	var mtlsConfigEntries []MutualAuthenticationConfig
+	if ing.IngClassConfig.IngClassParams != nil && len(ing.IngClassConfig.IngClassParams.Spec.MutualAuthentication) != 0 {
+		mtlsConfigEntries = buildIngressClassMutualAuthenticationConfigs(ing.IngClassConfig.IngClassParams.Spec.MutualAuthentication)
+	} else {
+		var rawMtlsConfigString string
+		if exists := t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixMutualAuthentication, &rawMtlsConfigString, ing.Ing.Annotations); !exists {
+			return nil, nil
+		}
+		if err := json.Unmarshal([]byte(rawMtlsConfigString), &mtlsConfigEntries); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse mutualAuthentication configuration from ingress annotation: `%s`", rawMtlsConfigString)
+		}
+		if len(mtlsConfigEntries) == 0 {
+			return nil, errors.Errorf("empty mutualAuthentication configuration from ingress annotation: `%s`", rawMtlsConfigString)
+		}
 	}
-	portAndMtlsAttributesMap, err := t.parseMtlsConfigEntries(ctx, ingressAnnotationEntries)
+	portAndMtlsAttributesMap, err := t.parseMtlsConfigEntries(ctx, mtlsConfigEntries)
 	if err != nil {
 		return nil, err
 	}
+	if err := t.buildManagedTrustStoresForMtlsConfigEntries(ctx, ing, mtlsConfigEntries, portAndMtlsAttributesMap); err != nil {
+		return nil, err
+	}
 
 	parsedPortAndMtlsAttributes, err := t.parseMtlsAttributesForTrustStoreNames(ctx, portAndMtlsAttributesMap)
 	if err != nil {
@@ -358,6 +375,39 @@ func (t *defaultModelBuildTask) computeIngressMutualAuthentication(ctx context.C
 	return parsedPortAndMtlsAttributes, nil
 }
 
+// buildIngressClassMutualAuthenticationConfigs converts the mutualAuthentication settings from IngressClassParams.
+// Note: the settings specified via IngressClass takes higher priority than the annotation on Ingress.
+func buildIngressClassMutualAuthenticationConfigs(ingClassMtlsConfigs []elbv2api.MutualAuthenticationConfig) []MutualAuthenticationConfig {
+	mtlsConfigs := make([]MutualAuthenticationConfig, 0, len(ingClassMtlsConfigs))
+	for _, cfg := range ingClassMtlsConfigs {
+		var advertiseTrustStoreCaNames *string
+		if cfg.AdvertiseTrustStoreCaNames != nil {
+			advertiseTrustStoreCaNames = awssdk.String(string(*cfg.AdvertiseTrustStoreCaNames))
+		}
+		var trustStoreCABundle *TrustStoreCABundleConfig
+		if cfg.TrustStoreCABundle != nil {
+			trustStoreCABundle = &TrustStoreCABundleConfig{
+				Namespace:          awssdk.ToString(cfg.TrustStoreCABundle.Namespace),
+				Name:               cfg.TrustStoreCABundle.Name,
+				Key:                awssdk.ToString(cfg.TrustStoreCABundle.Key),
+				RevocationListKeys: cfg.TrustStoreCABundle.RevocationListKeys,
+			}
+			if cfg.TrustStoreCABundle.Kind != nil {
+				trustStoreCABundle.Kind = string(*cfg.TrustStoreCABundle.Kind)
+			}
+		}
+		mtlsConfigs = append(mtlsConfigs, MutualAuthenticationConfig{
+			Port:                          cfg.Port,
+			Mode:                          string(cfg.Mode),
+			TrustStore:                    cfg.TrustStore,
+			TrustStoreCABundle:            trustStoreCABundle,
+			IgnoreClientCertificateExpiry: cfg.IgnoreClientCertificateExpiry,
+			AdvertiseTrustStoreCaNames:    advertiseTrustStoreCaNames,
+		})
+	}
+	return mtlsConfigs
+}
+
 func (t *defaultModelBuildTask) parseMtlsConfigEntries(_ context.Context, entries []MutualAuthenticationConfig) (map[int32]*elbv2model.MutualAuthenticationAttributes, error) {
 	portAndMtlsAttributes := make(map[int32]*elbv2model.MutualAuthenticationAttributes, len(entries))
 
@@ -368,7 +418,9 @@ func (t *defaultModelBuildTask) parseMtlsConfigEntries(_ context.Context, entrie
 		ignoreClientCert := mutualAuthenticationConfig.IgnoreClientCertificateExpiry
 		advertiseTrustStoreCaNames := mutualAuthenticationConfig.AdvertiseTrustStoreCaNames
 
-		err := t.validateMutualAuthenticationConfig(port, mode, truststoreNameOrArn, ignoreClientCert, advertiseTrustStoreCaNames)
+		hasTrustStoreCABundle := mutualAuthenticationConfig.TrustStoreCABundle != nil
+
+		err := t.validateMutualAuthenticationConfig(port, mode, truststoreNameOrArn, hasTrustStoreCABundle, ignoreClientCert, advertiseTrustStoreCaNames)
 		if err != nil {
 			return nil, err
 		}
@@ -376,12 +428,17 @@ func (t *defaultModelBuildTask) parseMtlsConfigEntries(_ context.Context, entrie
 		if mode == string(elbv2model.MutualAuthenticationVerifyMode) && ignoreClientCert == nil {
 			ignoreClientCert = awssdk.Bool(false)
 		}
-		portAndMtlsAttributes[port] = &elbv2model.MutualAuthenticationAttributes{Mode: mode, TrustStoreArn: awssdk.String(truststoreNameOrArn), IgnoreClientCertificateExpiry: ignoreClientCert, AdvertiseTrustStoreCaNames: advertiseTrustStoreCaNames}
+		attributes := &elbv2model.MutualAuthenticationAttributes{Mode: mode, IgnoreClientCertificateExpiry: ignoreClientCert, AdvertiseTrustStoreCaNames: advertiseTrustStoreCaNames}
+		// the trustStore of a managed CA bundle is built into the stack separately, see buildManagedTrustStoresForMtlsConfigEntries.
+		if !hasTrustStoreCABundle {
+			attributes.TrustStoreArn = awssdk.String(truststoreNameOrArn)
+		}
+		portAndMtlsAttributes[port] = attributes
 	}
 	return portAndMtlsAttributes, nil
 }
 
-func (t *defaultModelBuildTask) validateMutualAuthenticationConfig(port int32, mode string, truststoreNameOrArn string, ignoreClientCert *bool, advertiseTrustStoreCaNames *string) error {
+func (t *defaultModelBuildTask) validateMutualAuthenticationConfig(port int32, mode string, truststoreNameOrArn string, hasTrustStoreCABundle bool, ignoreClientCert *bool, advertiseTrustStoreCaNames *string) error {
 	// Verify port value is valid for ALB: [1, 65535]
 	if port < 1 || port > 65535 {
 		return errors.Errorf("listen port must be within [1, 65535]: %v", port)
@@ -395,14 +452,22 @@ func (t *defaultModelBuildTask) validateMutualAuthenticationConfig(port int32, m
 	if !slices.Contains(validMutualAuthenticationModes, mode) {
 		return errors.Errorf("mutualAuthentication mode value must be among [%v, %v, %v] for port %v : %s", elbv2model.MutualAuthenticationOffMode, elbv2model.MutualAuthenticationPassthroughMode, elbv2model.MutualAuthenticationVerifyMode, port, mode)
 	}
+	// Verify if the mutualAuthentication trustStore and trustStoreCABundle are not both specified
+	if truststoreNameOrArn != "" && hasTrustStoreCABundle {
+		return errors.Errorf("trustStore and trustStoreCABundle are mutually exclusive for port %v", port)
+	}
 	// Verify if the mutualAuthentication truststoreNameOrArn is not empty for Verify mode
-	if mode == string(elbv2model.MutualAuthenticationVerifyMode) && truststoreNameOrArn == "" {
+	if mode == string(elbv2model.MutualAuthenticationVerifyMode) && truststoreNameOrArn == "" && !hasTrustStoreCABundle {
 		return errors.Errorf("trustStore is required when mutualAuthentication mode is verify for port %v", port)
 	}
 	// Verify if the mutualAuthentication truststoreNameOrArn is empty for Off and Passthrough modes
 	if (mode == string(elbv2model.MutualAuthenticationOffMode) || mode == string(elbv2model.MutualAuthenticationPassthroughMode)) && truststoreNameOrArn != "" {
 		return errors.Errorf("Mutual Authentication mode %s does not support trustStore for port %v", mode, port)
 	}
+	// Verify if the mutualAuthentication trustStoreCABundle is empty for Off and Passthrough modes
+	if (mode == string(elbv2model.MutualAuthenticationOffMode) || mode == string(elbv2model.MutualAuthenticationPassthroughMode)) && hasTrustStoreCABundle {
+		return errors.Errorf("Mutual Authentication mode %s does not support trustStoreCABundle for port %v", mode, port)
+	}
 	// Verify if the mutualAuthentication ignoreClientCert is valid for Off and Passthrough modes
 	if (mode == string(elbv2model.MutualAuthenticationOffMode) || mode == string(elbv2model.MutualAuthenticationPassthroughMode)) && ignoreClientCert != nil {
 		return errors.Errorf("Mutual Authentication mode %s does not support ignoring client certificate expiry for port %v", mode, port)
@@ -424,12 +489,58 @@ func (t *defaultModelBuildTask) validateMutualAuthenticationConfig(port int32, m
 	return nil
 }
 
+// buildManagedTrustStoresForMtlsConfigEntries builds the trustStores managed from CA bundles into the stack,
+// and references them from the mutualAuthentication attributes of the corresponding ports.
+func (t *defaultModelBuildTask) buildManagedTrustStoresForMtlsConfigEntries(ctx context.Context, ing *ClassifiedIngress,
+	entries []MutualAuthenticationConfig, portAndMtlsAttributes map[int32]*elbv2model.MutualAuthenticationAttributes) error {
+	for _, entry := range entries {
+		if entry.TrustStoreCABundle == nil {
+			continue
+		}
+		tags, err := t.buildTrustStoreTags(ctx, ing)
+		if err != nil {
+			return err
+		}
+		namespace := entry.TrustStoreCABundle.Namespace
+		if namespace == "" {
+			namespace = ing.Ing.Namespace
+		}
+		ts, secretKey, err := shared_utils.BuildManagedTrustStore(ctx, t.stack, t.k8sClient, t.secretsManager, t.clusterName,
+			tags, shared_utils.TrustStoreCABundleRef{
+				Kind:               entry.TrustStoreCABundle.Kind,
+				Namespace:          namespace,
+				Name:               entry.TrustStoreCABundle.Name,
+				Key:                entry.TrustStoreCABundle.Key,
+				RevocationListKeys: entry.TrustStoreCABundle.RevocationListKeys,
+			})
+		if err != nil {
+			return errors.Wrapf(err, "failed to build trustStore for port %v", entry.Port)
+		}
+		if secretKey != nil {
+			t.secretKeys = append(t.secretKeys, *secretKey)
+		}
+		portAndMtlsAttributes[entry.Port].TrustStore = ts.TrustStoreARN()
+	}
+	return nil
+}
+
+func (t *defaultModelBuildTask) buildTrustStoreTags(_ context.Context, ing *ClassifiedIngress) (map[string]string, error) {
+	ingTags, err := t.buildIngressResourceTags(*ing)
+	if err != nil {
+		return nil, err
+	}
+	return algorithm.MergeStringMap(t.defaultTags, ingTags), nil
+}
+
 func (t *defaultModelBuildTask) parseMtlsAttributesForTrustStoreNames(ctx context.Context, portAndMtlsAttributes map[int32]*elbv2model.MutualAuthenticationAttributes) (map[int32]*elbv2model.MutualAuthenticationAttributes, error) {
 	var trustStoreNames []string
 	trustStoreNameAndPortMap := make(map[string][]int32)
 
 	for port, attributes := range portAndMtlsAttributes {
 		mode := attributes.Mode
+		if attributes.TrustStore != nil {
+			continue
+		}
 		truststoreNameOrArn := awssdk.ToString(attributes.TrustStoreArn)
 		if mode == string(elbv2model.MutualAuthenticationVerifyMode) && !strings.HasPrefix(truststoreNameOrArn, "arn:") {
 			trustStoreNameAndPortMap[truststoreNameOrArn] = append(trustStoreNameAndPortMap[truststoreNameOrArn], port)
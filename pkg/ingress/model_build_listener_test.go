@@ -130,6 +130,44 @@ func Test_computeIngressListenPortConfigByPort_MutualAuthentication(t *testing.T
 			},
 			want: []WantStruct{{port: 443, mutualAuth: &(elbv2.MutualAuthenticationAttributes{Mode: "off", TrustStoreArn: nil, IgnoreClientCertificateExpiry: nil})}, {port: 80, mutualAuth: &(elbv2.MutualAuthenticationAttributes{Mode: "verify", TrustStoreArn: awssdk.String("arn:aws:elasticloadbalancing:trustStoreArn"), AdvertiseTrustStoreCaNames: awssdk.String("on"), IgnoreClientCertificateExpiry: nil})}},
 		},
+		{
+			name: "Listener Config when MutualAuthentication is specified in IngressClassParams, it takes priority over annotation",
+			fields: fields{
+				ingGroup: Group{
+					ID: GroupID{Name: "explicit-group"},
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace: "awesome-ns",
+									Name:      "ing-1",
+									Annotations: map[string]string{
+										"alb.ingress.kubernetes.io/listen-ports":          `[{"HTTPS": 443}, {"HTTPS": 80}]`,
+										"alb.ingress.kubernetes.io/mutual-authentication": `[{"port":443,"mode":"off"}, {"port":80,"mode":"passthrough"}]`,
+										"alb.ingress.kubernetes.io/certificate-arn":       "arn:aws:iam::123456789:server-certificate/new-clb-cert",
+									},
+								},
+							},
+							IngClassConfig: ClassConfiguration{
+								IngClassParams: &elbv2api.IngressClassParams{
+									Spec: elbv2api.IngressClassParamsSpec{
+										MutualAuthentication: []elbv2api.MutualAuthenticationConfig{
+											{
+												Port:                       443,
+												Mode:                       elbv2api.MutualAuthenticationModeVerify,
+												TrustStore:                 awssdk.String("arn:aws:elasticloadbalancing:trustStoreArn"),
+												AdvertiseTrustStoreCaNames: &[]elbv2api.AdvertiseTrustStoreCaNames{elbv2api.AdvertiseTrustStoreCaNamesOn}[0],
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: []WantStruct{{port: 443, mutualAuth: &(elbv2.MutualAuthenticationAttributes{Mode: "verify", TrustStoreArn: awssdk.String("arn:aws:elasticloadbalancing:trustStoreArn"), AdvertiseTrustStoreCaNames: awssdk.String("on"), IgnoreClientCertificateExpiry: awssdk.Bool(false)})}, {port: 80, mutualAuth: nil}},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -586,13 +624,14 @@ func Test_buildListenerAttributes(t *testing.T) {
 
 func Test_validateMutualAuthenticationConfig(t *testing.T) {
 	tests := []struct {
-		name                 string
-		port                 int32
-		mode                 string
-		trustStoreARN        string
-		ignoreClientCert     *bool
-		advertiseCANames     *string
-		expectedErrorMessage *string
+		name                  string
+		port                  int32
+		mode                  string
+		trustStoreARN         string
+		hasTrustStoreCABundle bool
+		ignoreClientCert      *bool
+		advertiseCANames      *string
+		expectedErrorMessage  *string
 	}{
 		{
 			name: "happy path no validation error off mode",
@@ -696,6 +735,27 @@ func Test_validateMutualAuthenticationConfig(t *testing.T) {
 			advertiseCANames:     awssdk.String("on"),
 			expectedErrorMessage: awssdk.String("Authentication mode passthrough does not support advertiseTrustStoreCaNames for port 800"),
 		},
+		{
+			name:                  "happy path no validation error verify mode with trustStoreCABundle",
+			port:                  800,
+			mode:                  string(elbv2model.MutualAuthenticationVerifyMode),
+			hasTrustStoreCABundle: true,
+		},
+		{
+			name:                  "trustStore and trustStoreCABundle both set",
+			port:                  800,
+			mode:                  string(elbv2model.MutualAuthenticationVerifyMode),
+			trustStoreARN:         "truststore",
+			hasTrustStoreCABundle: true,
+			expectedErrorMessage:  awssdk.String("trustStore and trustStoreCABundle are mutually exclusive for port 800"),
+		},
+		{
+			name:                  "trustStoreCABundle set with passthrough mode",
+			port:                  800,
+			mode:                  string(elbv2model.MutualAuthenticationPassthroughMode),
+			hasTrustStoreCABundle: true,
+			expectedErrorMessage:  awssdk.String("Mutual Authentication mode passthrough does not support trustStoreCABundle for port 800"),
+		},
 		{
 			name:                 "advertise ca set with invalid value",
 			port:                 800,
@@ -709,7 +769,7 @@ func Test_validateMutualAuthenticationConfig(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			task := &defaultModelBuildTask{}
-			res := task.validateMutualAuthenticationConfig(tt.port, tt.mode, tt.trustStoreARN, tt.ignoreClientCert, tt.advertiseCANames)
+			res := task.validateMutualAuthenticationConfig(tt.port, tt.mode, tt.trustStoreARN, tt.hasTrustStoreCABundle, tt.ignoreClientCert, tt.advertiseCANames)
 
 			if tt.expectedErrorMessage == nil {
 				assert.Nil(t, res)
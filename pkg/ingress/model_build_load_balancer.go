@@ -12,6 +12,7 @@ import (
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/algorithm"
@@ -23,6 +24,7 @@ import (
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/networking"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/shared_utils"
 )
 
 func (t *defaultModelBuildTask) buildLoadBalancer(ctx context.Context, listenPortConfigByPort map[int32]listenPortConfig) (*elbv2model.LoadBalancer, error) {
@@ -387,6 +389,10 @@ func (t *defaultModelBuildTask) buildLoadBalancerAttributes(_ context.Context) (
 	if err != nil {
 		return nil, err
 	}
+	ingGroupAttributes, err = t.buildLoadBalancerLogs(ingGroupAttributes)
+	if err != nil {
+		return nil, err
+	}
 	attributes := make([]elbv2model.LoadBalancerAttribute, 0, len(ingGroupAttributes))
 	for attrKey, attrValue := range ingGroupAttributes {
 		attributes = append(attributes, elbv2model.LoadBalancerAttribute{
@@ -402,6 +408,15 @@ func (t *defaultModelBuildTask) buildLoadBalancerTags(_ context.Context) (map[st
 	if err != nil {
 		return nil, err
 	}
+	ingGroupObjs := make([]metav1.Object, 0, len(t.ingGroup.Members))
+	for _, member := range t.ingGroup.Members {
+		ingGroupObjs = append(ingGroupObjs, member.Ing)
+	}
+	federationGroupTags, err := shared_utils.BuildFederationGroupTags(ingGroupObjs...)
+	if err != nil {
+		return nil, err
+	}
+	ingGroupTags = algorithm.MergeStringMap(federationGroupTags, ingGroupTags)
 
 	if t.featureGates.Enabled(config.EnableDefaultTagsLowPriority) {
 		return algorithm.MergeStringMap(ingGroupTags, t.defaultTags), nil
@@ -2,6 +2,8 @@ package ingress
 
 import (
 	"context"
+	"strings"
+
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/annotations"
@@ -17,6 +19,8 @@ const (
 	wafv2ACLARNNone = "none"
 	// sentinel annotation value to disable wafRegional on resources.
 	webACLIDNone = "none"
+	// sentinel annotation value to disable shield application layer automatic response.
+	shieldAutomaticResponseDisabled = "disabled"
 )
 
 func (t *defaultModelBuildTask) buildLoadBalancerAddOns(ctx context.Context, lbARN core.StringToken) error {
@@ -108,6 +112,10 @@ func (t *defaultModelBuildTask) buildWAFv2WebACLAssociation(ctx context.Context,
 func (t *defaultModelBuildTask) buildWAFRegionalWebACLAssociation(_ context.Context, lbARN core.StringToken) (*wafregionalmodel.WebACLAssociation, error) {
 	explicitWebACLIDs := sets.NewString()
 	for _, member := range t.ingGroup.Members {
+		if member.IngClassConfig.IngClassParams != nil && member.IngClassConfig.IngClassParams.Spec.WAFACLID != "" {
+			explicitWebACLIDs.Insert(member.IngClassConfig.IngClassParams.Spec.WAFACLID)
+			continue
+		}
 		rawWebACLID := ""
 		if exists := t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixWAFACLID, &rawWebACLID, member.Ing.Annotations); !exists {
 			_ = t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixWebACLID, &rawWebACLID, member.Ing.Annotations)
@@ -142,6 +150,10 @@ func (t *defaultModelBuildTask) buildWAFRegionalWebACLAssociation(_ context.Cont
 func (t *defaultModelBuildTask) buildShieldProtection(_ context.Context, lbARN core.StringToken) (*shieldmodel.Protection, error) {
 	explicitEnableProtections := make(map[bool]struct{})
 	for _, member := range t.ingGroup.Members {
+		if member.IngClassConfig.IngClassParams != nil && member.IngClassConfig.IngClassParams.Spec.ShieldAdvancedProtection != nil {
+			explicitEnableProtections[*member.IngClassConfig.IngClassParams.Spec.ShieldAdvancedProtection] = struct{}{}
+			continue
+		}
 		rawEnableProtection := false
 		exists, err := t.annotationParser.ParseBoolAnnotation(annotations.IngressSuffixShieldAdvancedProtection, &rawEnableProtection, member.Ing.Annotations)
 		if err != nil {
@@ -158,9 +170,79 @@ func (t *defaultModelBuildTask) buildShieldProtection(_ context.Context, lbARN c
 		return nil, errors.New("conflicting enable shield advanced protection")
 	}
 	_, enableProtection := explicitEnableProtections[true]
+	healthCheckIDs, err := t.buildShieldStringListSetting(annotations.IngressSuffixShieldAdvancedHealthCheckIDs)
+	if err != nil {
+		return nil, err
+	}
+	automaticResponse, err := t.buildShieldApplicationLayerAutomaticResponse()
+	if err != nil {
+		return nil, err
+	}
+	protectionGroupIDs, err := t.buildShieldStringListSetting(annotations.IngressSuffixShieldAdvancedProtectionGroups)
+	if err != nil {
+		return nil, err
+	}
+	if !enableProtection && (healthCheckIDs != nil || automaticResponse != nil || protectionGroupIDs != nil) {
+		return nil, errors.New("shield advanced settings cannot be configured when shield advanced protection is disabled")
+	}
 	protection := shieldmodel.NewProtection(t.stack, shared_constants.ResourceIDLoadBalancer, shieldmodel.ProtectionSpec{
-		Enabled:     enableProtection,
-		ResourceARN: lbARN,
+		Enabled:                           enableProtection,
+		ResourceARN:                       lbARN,
+		HealthCheckIDs:                    healthCheckIDs,
+		ApplicationLayerAutomaticResponse: automaticResponse,
+		ProtectionGroupIDs:                protectionGroupIDs,
 	})
 	return protection, nil
 }
+
+// buildShieldStringListSetting returns the merged value of a string list shield annotation across IngressGroup members.
+// returns nil if none of the members specified the annotation.
+func (t *defaultModelBuildTask) buildShieldStringListSetting(annotation string) ([]string, error) {
+	explicitValues := make(map[string][]string)
+	for _, member := range t.ingGroup.Members {
+		var rawValues []string
+		if exists := t.annotationParser.ParseStringSliceAnnotation(annotation, &rawValues, member.Ing.Annotations); !exists {
+			continue
+		}
+		values := sets.NewString(rawValues...).List()
+		explicitValues[strings.Join(values, ",")] = values
+	}
+	if len(explicitValues) == 0 {
+		return nil, nil
+	}
+	if len(explicitValues) > 1 {
+		return nil, errors.Errorf("conflicting %v: %v", annotation, sets.StringKeySet(explicitValues).List())
+	}
+	for _, values := range explicitValues {
+		return values, nil
+	}
+	return nil, nil
+}
+
+func (t *defaultModelBuildTask) buildShieldApplicationLayerAutomaticResponse() (*shieldmodel.ApplicationLayerAutomaticResponse, error) {
+	explicitActions := sets.NewString()
+	for _, member := range t.ingGroup.Members {
+		rawAction := ""
+		if exists := t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixShieldAdvancedAutomaticResponse, &rawAction, member.Ing.Annotations); !exists {
+			continue
+		}
+		explicitActions.Insert(strings.ToLower(rawAction))
+	}
+	if len(explicitActions) == 0 {
+		return nil, nil
+	}
+	if len(explicitActions) > 1 {
+		return nil, errors.Errorf("conflicting shield advanced automatic response: %v", explicitActions.List())
+	}
+	action, _ := explicitActions.PopAny()
+	switch action {
+	case strings.ToLower(string(shieldmodel.ApplicationLayerAutomaticResponseActionBlock)):
+		return &shieldmodel.ApplicationLayerAutomaticResponse{Enabled: true, Action: shieldmodel.ApplicationLayerAutomaticResponseActionBlock}, nil
+	case strings.ToLower(string(shieldmodel.ApplicationLayerAutomaticResponseActionCount)):
+		return &shieldmodel.ApplicationLayerAutomaticResponse{Enabled: true, Action: shieldmodel.ApplicationLayerAutomaticResponseActionCount}, nil
+	case shieldAutomaticResponseDisabled:
+		return &shieldmodel.ApplicationLayerAutomaticResponse{Enabled: false}, nil
+	default:
+		return nil, errors.Errorf("unknown shield advanced automatic response: %v, must be one of block, count or disabled", action)
+	}
+}
@@ -561,6 +561,61 @@ func Test_defaultModelBuildTask_buildWAFRegionalWebACLAssociation(t *testing.T)
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "when ingressClassParams has wafAclId set, it takes priority over annotation",
+			fields: fields{
+				ingGroup: Group{
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace: "awesome-ns",
+									Name:      "awesome-ing-0",
+									Annotations: map[string]string{
+										"alb.ingress.kubernetes.io/waf-acl-id": "none",
+									},
+								},
+							},
+							IngClassConfig: ClassConfiguration{
+								IngClassParams: &v1beta1.IngressClassParams{
+									Spec: v1beta1.IngressClassParamsSpec{
+										WAFACLID: "web-acl-id-1",
+									},
+								},
+							},
+						},
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace: "awesome-ns",
+									Name:      "awesome-ing-1",
+									Annotations: map[string]string{
+										"alb.ingress.kubernetes.io/waf-acl-id": "web-acl-id-2",
+									},
+								},
+							},
+							IngClassConfig: ClassConfiguration{
+								IngClassParams: &v1beta1.IngressClassParams{
+									Spec: v1beta1.IngressClassParamsSpec{
+										WAFACLID: "web-acl-id-1",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				lbARN: core.LiteralStringToken("awesome-lb-arn"),
+			},
+			want: &wafregionalmodel.WebACLAssociation{
+				Spec: wafregionalmodel.WebACLAssociationSpec{
+					WebACLID:    "web-acl-id-1",
+					ResourceARN: core.LiteralStringToken("awesome-lb-arn"),
+				},
+			},
+			wantErr: assert.NoError,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -825,6 +880,221 @@ func Test_defaultModelBuildTask_buildShieldProtection(t *testing.T) {
 				return false
 			},
 		},
+		{
+			name: "when ingressClassParams has shieldAdvancedProtection set, it takes priority over annotation",
+			fields: fields{
+				ingGroup: Group{
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace: "awesome-ns",
+									Name:      "awesome-ing-0",
+									Annotations: map[string]string{
+										"alb.ingress.kubernetes.io/shield-advanced-protection": "false",
+									},
+								},
+							},
+							IngClassConfig: ClassConfiguration{
+								IngClassParams: &v1beta1.IngressClassParams{
+									Spec: v1beta1.IngressClassParamsSpec{
+										ShieldAdvancedProtection: awssdk.Bool(true),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				lbARN: core.LiteralStringToken("awesome-lb-arn"),
+			},
+			want: &shieldmodel.Protection{
+				Spec: shieldmodel.ProtectionSpec{
+					Enabled:     true,
+					ResourceARN: core.LiteralStringToken("awesome-lb-arn"),
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "when shield advanced settings are set across ingresses",
+			fields: fields{
+				ingGroup: Group{
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace: "awesome-ns",
+									Name:      "awesome-ing-0",
+									Annotations: map[string]string{
+										"alb.ingress.kubernetes.io/shield-advanced-protection":         "true",
+										"alb.ingress.kubernetes.io/shield-advanced-health-check-ids":   "hc-2, hc-1",
+										"alb.ingress.kubernetes.io/shield-advanced-automatic-response": "Block",
+									},
+								},
+							},
+						},
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace: "awesome-ns",
+									Name:      "awesome-ing-1",
+									Annotations: map[string]string{
+										"alb.ingress.kubernetes.io/shield-advanced-protection":        "true",
+										"alb.ingress.kubernetes.io/shield-advanced-health-check-ids":  "hc-1,hc-2",
+										"alb.ingress.kubernetes.io/shield-advanced-protection-groups": "group-1",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				lbARN: core.LiteralStringToken("awesome-lb-arn"),
+			},
+			want: &shieldmodel.Protection{
+				Spec: shieldmodel.ProtectionSpec{
+					Enabled:        true,
+					ResourceARN:    core.LiteralStringToken("awesome-lb-arn"),
+					HealthCheckIDs: []string{"hc-1", "hc-2"},
+					ApplicationLayerAutomaticResponse: &shieldmodel.ApplicationLayerAutomaticResponse{
+						Enabled: true,
+						Action:  shieldmodel.ApplicationLayerAutomaticResponseActionBlock,
+					},
+					ProtectionGroupIDs: []string{"group-1"},
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "when shield advanced automatic response is disabled",
+			fields: fields{
+				ingGroup: Group{
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace: "awesome-ns",
+									Name:      "awesome-ing-0",
+									Annotations: map[string]string{
+										"alb.ingress.kubernetes.io/shield-advanced-protection":         "true",
+										"alb.ingress.kubernetes.io/shield-advanced-automatic-response": "disabled",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				lbARN: core.LiteralStringToken("awesome-lb-arn"),
+			},
+			want: &shieldmodel.Protection{
+				Spec: shieldmodel.ProtectionSpec{
+					Enabled:                           true,
+					ResourceARN:                       core.LiteralStringToken("awesome-lb-arn"),
+					ApplicationLayerAutomaticResponse: &shieldmodel.ApplicationLayerAutomaticResponse{Enabled: false},
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "when shield advanced health check ids conflict",
+			fields: fields{
+				ingGroup: Group{
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace: "awesome-ns",
+									Name:      "awesome-ing-0",
+									Annotations: map[string]string{
+										"alb.ingress.kubernetes.io/shield-advanced-protection":       "true",
+										"alb.ingress.kubernetes.io/shield-advanced-health-check-ids": "hc-1",
+									},
+								},
+							},
+						},
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace: "awesome-ns",
+									Name:      "awesome-ing-1",
+									Annotations: map[string]string{
+										"alb.ingress.kubernetes.io/shield-advanced-health-check-ids": "hc-2",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				lbARN: core.LiteralStringToken("awesome-lb-arn"),
+			},
+			want: nil,
+			wantErr: func(t assert.TestingT, err error, msgAndArgs ...interface{}) bool {
+				return assert.EqualError(t, err, "conflicting shield-advanced-health-check-ids: [hc-1 hc-2]", msgAndArgs...)
+			},
+		},
+		{
+			name: "when shield advanced automatic response is invalid",
+			fields: fields{
+				ingGroup: Group{
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace: "awesome-ns",
+									Name:      "awesome-ing-0",
+									Annotations: map[string]string{
+										"alb.ingress.kubernetes.io/shield-advanced-protection":         "true",
+										"alb.ingress.kubernetes.io/shield-advanced-automatic-response": "allow",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				lbARN: core.LiteralStringToken("awesome-lb-arn"),
+			},
+			want: nil,
+			wantErr: func(t assert.TestingT, err error, msgAndArgs ...interface{}) bool {
+				return assert.EqualError(t, err, "unknown shield advanced automatic response: allow, must be one of block, count or disabled", msgAndArgs...)
+			},
+		},
+		{
+			name: "when shield advanced settings are set while protection is disabled",
+			fields: fields{
+				ingGroup: Group{
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace: "awesome-ns",
+									Name:      "awesome-ing-0",
+									Annotations: map[string]string{
+										"alb.ingress.kubernetes.io/shield-advanced-protection":        "false",
+										"alb.ingress.kubernetes.io/shield-advanced-protection-groups": "group-1",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				lbARN: core.LiteralStringToken("awesome-lb-arn"),
+			},
+			want: nil,
+			wantErr: func(t assert.TestingT, err error, msgAndArgs ...interface{}) bool {
+				return assert.EqualError(t, err, "shield advanced settings cannot be configured when shield advanced protection is disabled", msgAndArgs...)
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
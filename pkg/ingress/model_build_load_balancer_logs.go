@@ -0,0 +1,43 @@
+package ingress
+
+import (
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/v3/apis/elbv2/v1beta1"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/shared_utils"
+)
+
+// buildLoadBalancerLogs builds the log buckets configured via IngressClassParams,
+// and merges the attributes enabling the configured logs into the LoadBalancer attributes.
+func (t *defaultModelBuildTask) buildLoadBalancerLogs(attributes map[string]string) (map[string]string, error) {
+	if len(t.ingGroup.Members) == 0 {
+		return attributes, nil
+	}
+	ingClassParams := t.ingGroup.Members[0].IngClassConfig.IngClassParams
+	if ingClassParams == nil || ingClassParams.Spec.LoadBalancerLogs == nil {
+		return attributes, nil
+	}
+	logsConfig := ingClassParams.Spec.LoadBalancerLogs
+	logAttributes, err := shared_utils.BuildLoadBalancerLogs(t.stack, elbv2model.LoadBalancerTypeApplication, shared_utils.LoadBalancerLogsConfig{
+		AccessLogs:      buildLogDeliveryConfig(logsConfig.AccessLogs),
+		ConnectionLogs:  buildLogDeliveryConfig(logsConfig.ConnectionLogs),
+		HealthCheckLogs: buildLogDeliveryConfig(logsConfig.HealthCheckLogs),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return shared_utils.MergeLoadBalancerLogAttributes(attributes, logAttributes)
+}
+
+func buildLogDeliveryConfig(deliveryConfig *elbv2api.S3LogDeliveryConfig) *shared_utils.LogDeliveryConfig {
+	if deliveryConfig == nil {
+		return nil
+	}
+	return &shared_utils.LogDeliveryConfig{
+		Bucket:                   deliveryConfig.Bucket,
+		Prefix:                   awssdk.ToString(deliveryConfig.Prefix),
+		ValidateBucketPolicyOnly: deliveryConfig.BucketPolicy != nil && *deliveryConfig.BucketPolicy == elbv2api.LogBucketPolicyModeValidate,
+		CreateBucket:             awssdk.ToBool(deliveryConfig.CreateBucket),
+		ExpirationDays:           deliveryConfig.ExpirationDays,
+	}
+}
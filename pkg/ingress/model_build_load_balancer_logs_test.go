@@ -0,0 +1,120 @@
+package ingress
+
+import (
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/v3/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
+	s3model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/s3"
+)
+
+func Test_defaultModelBuildTask_buildLoadBalancerLogs(t *testing.T) {
+	validate := elbv2api.LogBucketPolicyModeValidate
+	tests := []struct {
+		name           string
+		ingClassParams *elbv2api.IngressClassParams
+		attributes     map[string]string
+		want           map[string]string
+		wantBuckets    []s3model.LogBucketSpec
+		wantErr        string
+	}{
+		{
+			name:       "no IngressClassParams",
+			attributes: map[string]string{"idle_timeout.timeout_seconds": "60"},
+			want:       map[string]string{"idle_timeout.timeout_seconds": "60"},
+		},
+		{
+			name: "IngressClassParams without logs",
+			ingClassParams: &elbv2api.IngressClassParams{
+				ObjectMeta: metav1.ObjectMeta{Name: "awesome-class"},
+			},
+			attributes: map[string]string{"idle_timeout.timeout_seconds": "60"},
+			want:       map[string]string{"idle_timeout.timeout_seconds": "60"},
+		},
+		{
+			name: "IngressClassParams with access and health check logs",
+			ingClassParams: &elbv2api.IngressClassParams{
+				ObjectMeta: metav1.ObjectMeta{Name: "awesome-class"},
+				Spec: elbv2api.IngressClassParamsSpec{
+					LoadBalancerLogs: &elbv2api.LoadBalancerLogsConfig{
+						AccessLogs: &elbv2api.S3LogDeliveryConfig{
+							Bucket:       "alb-logs",
+							Prefix:       awssdk.String("ingress"),
+							BucketPolicy: &validate,
+						},
+						HealthCheckLogs: &elbv2api.S3LogDeliveryConfig{
+							Bucket:       "alb-logs",
+							Prefix:       awssdk.String("ingress-health"),
+							BucketPolicy: &validate,
+						},
+					},
+				},
+			},
+			attributes: map[string]string{"idle_timeout.timeout_seconds": "60"},
+			want: map[string]string{
+				"idle_timeout.timeout_seconds": "60",
+				"access_logs.s3.enabled":       "true",
+				"access_logs.s3.bucket":        "alb-logs",
+				"access_logs.s3.prefix":        "ingress",
+				"health_check_logs.s3.enabled": "true",
+				"health_check_logs.s3.bucket":  "alb-logs",
+				"health_check_logs.s3.prefix":  "ingress-health",
+			},
+			wantBuckets: []s3model.LogBucketSpec{
+				{
+					BucketName: "alb-logs",
+					Prefixes:   []string{"ingress", "ingress-health"},
+				},
+			},
+		},
+		{
+			name: "IngressClassParams logs conflicting with annotation",
+			ingClassParams: &elbv2api.IngressClassParams{
+				ObjectMeta: metav1.ObjectMeta{Name: "awesome-class"},
+				Spec: elbv2api.IngressClassParamsSpec{
+					LoadBalancerLogs: &elbv2api.LoadBalancerLogsConfig{
+						AccessLogs: &elbv2api.S3LogDeliveryConfig{Bucket: "alb-logs"},
+					},
+				},
+			},
+			attributes: map[string]string{"access_logs.s3.enabled": "false"},
+			wantErr:    "conflicting load balancer attributes access_logs.s3.enabled: false | true",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stack := core.NewDefaultStack(core.StackID{Namespace: "awesome-ns", Name: "ing-1"})
+			task := &defaultModelBuildTask{
+				stack: stack,
+				ingGroup: Group{
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "ing-1"}},
+							IngClassConfig: ClassConfiguration{
+								IngClassParams: tt.ingClassParams,
+							},
+						},
+					},
+				},
+			}
+			got, err := task.buildLoadBalancerLogs(tt.attributes)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			var resBuckets []*s3model.LogBucket
+			assert.NoError(t, stack.ListResources(&resBuckets))
+			var gotBuckets []s3model.LogBucketSpec
+			for _, resBucket := range resBuckets {
+				gotBuckets = append(gotBuckets, resBucket.Spec)
+			}
+			assert.Equal(t, tt.wantBuckets, gotBuckets)
+		})
+	}
+}
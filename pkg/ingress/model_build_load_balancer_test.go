@@ -407,6 +407,68 @@ func Test_defaultModelBuildTask_buildLoadBalancerTags(t *testing.T) {
 			},
 			wantErr: errors.New("conflicting tag k3: v3a | v3b"),
 		},
+		{
+			name: "federation group annotation on one Ingress of the group",
+			fields: fields{
+				ingGroup: Group{
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Annotations: map[string]string{
+										"alb.ingress.kubernetes.io/tags": "k1=v1",
+										"aga.k8s.aws/federation-group":   "web",
+									},
+								},
+							},
+						},
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Annotations: map[string]string{},
+								},
+							},
+						},
+					},
+				},
+				defaultTags: map[string]string{
+					"k2": "v2",
+				},
+			},
+			want: map[string]string{
+				"k1":                           "v1",
+				"k2":                           "v2",
+				"aga.k8s.aws/federation-group": "web",
+			},
+		},
+		{
+			name: "conflicting federation group annotations",
+			fields: fields{
+				ingGroup: Group{
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Annotations: map[string]string{
+										"aga.k8s.aws/federation-group": "web",
+									},
+								},
+							},
+						},
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Annotations: map[string]string{
+										"aga.k8s.aws/federation-group": "api",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("conflicting federation group aga.k8s.aws/federation-group: web | api"),
+		},
 		{
 			name: "non empty external managed tags, no conflicts",
 			fields: fields{
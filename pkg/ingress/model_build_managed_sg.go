@@ -40,9 +40,19 @@ func (t *defaultModelBuildTask) buildManagedSecurityGroupSpec(ctx context.Contex
 		Description: "[k8s] Managed SecurityGroup for LoadBalancer",
 		Tags:        tags,
 		Ingress:     ingressPermissions,
+		Egress:      t.buildManagedSecurityGroupEgressPermissions(ctx),
 	}, nil
 }
 
+// buildManagedSecurityGroupEgressPermissions builds the egress permissions of managed securityGroup.
+// when egress is restricted, the only egress permissions needed are the ones towards targets, which are managed along with TargetGroupBinding networking.
+func (t *defaultModelBuildTask) buildManagedSecurityGroupEgressPermissions(_ context.Context) []ec2model.IPPermission {
+	if !t.restrictSGEgress {
+		return nil
+	}
+	return []ec2model.IPPermission{}
+}
+
 var invalidSecurityGroupNamePtn, _ = regexp.Compile("[[:^alnum:]]")
 
 func (t *defaultModelBuildTask) buildManagedSecurityGroupName(_ context.Context) string {
@@ -11,6 +11,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/config"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/ec2"
 )
 
 func Test_defaultModelBuildTask_buildManagedSecurityGroupTags(t *testing.T) {
@@ -368,3 +369,31 @@ func Test_defaultModelBuildTask_buildManagedSecurityGroupTags_FeatureGate(t *tes
 		})
 	}
 }
+
+func Test_defaultModelBuildTask_buildManagedSecurityGroupEgressPermissions(t *testing.T) {
+	tests := []struct {
+		name             string
+		restrictSGEgress bool
+		want             []ec2model.IPPermission
+	}{
+		{
+			name:             "egress not restricted",
+			restrictSGEgress: false,
+			want:             nil,
+		},
+		{
+			name:             "egress restricted",
+			restrictSGEgress: true,
+			want:             []ec2model.IPPermission{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				restrictSGEgress: tt.restrictSGEgress,
+			}
+			got := task.buildManagedSecurityGroupEgressPermissions(context.Background())
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package ingress
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/shared_utils"
+)
+
+// buildMetricAlarms builds the CloudWatch alarms for the LoadBalancer and all TargetGroups of the IngressGroup.
+func (t *defaultModelBuildTask) buildMetricAlarms(ctx context.Context, lbARN core.StringToken) error {
+	alarmsCFG, err := t.buildMetricAlarmsConfig(ctx)
+	if err != nil {
+		return err
+	}
+	if alarmsCFG.IsEmpty() {
+		return nil
+	}
+	if !t.featureGates.Enabled(config.CloudWatchAlarmManagement) {
+		return errors.Errorf("CloudWatch alarms cannot be managed unless the %v feature gate is enabled", config.CloudWatchAlarmManagement)
+	}
+	tags, err := t.buildLoadBalancerTags(ctx)
+	if err != nil {
+		return err
+	}
+	alarmTGs := make([]shared_utils.MetricAlarmTargetGroup, 0, len(t.tgByResID))
+	for tgResID, tg := range t.tgByResID {
+		alarmTGs = append(alarmTGs, shared_utils.MetricAlarmTargetGroup{
+			ResID:          tgResID,
+			TargetGroupARN: tg.TargetGroupARN(),
+		})
+	}
+	_, err = shared_utils.BuildMetricAlarms(t.stack, t.clusterName, elbv2model.LoadBalancerTypeApplication, lbARN, alarmsCFG, alarmTGs, tags)
+	return err
+}
+
+// buildMetricAlarmsConfig merges the CloudWatch alarms configured by members of the IngressGroup.
+func (t *defaultModelBuildTask) buildMetricAlarmsConfig(_ context.Context) (shared_utils.MetricAlarmsConfig, error) {
+	memberCFGs := make([]shared_utils.MetricAlarmsConfig, 0, len(t.ingGroup.Members))
+	for _, member := range t.ingGroup.Members {
+		var rawThresholds map[string]string
+		if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.IngressSuffixCloudWatchAlarmThresholds, &rawThresholds, member.Ing.Annotations); err != nil {
+			return shared_utils.MetricAlarmsConfig{}, err
+		}
+		thresholds, err := shared_utils.ParseMetricAlarmThresholds(rawThresholds)
+		if err != nil {
+			return shared_utils.MetricAlarmsConfig{}, errors.Wrapf(err, "failed to parse CloudWatch alarms of ingress %v/%v", member.Ing.Namespace, member.Ing.Name)
+		}
+		var alarmActions []string
+		t.annotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixCloudWatchAlarmActions, &alarmActions, member.Ing.Annotations)
+		memberCFGs = append(memberCFGs, shared_utils.MetricAlarmsConfig{
+			Thresholds:   thresholds,
+			AlarmActions: alarmActions,
+		})
+	}
+	return shared_utils.MergeMetricAlarmsConfig(memberCFGs...)
+}
@@ -0,0 +1,90 @@
+package ingress
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/shared_utils"
+)
+
+func Test_defaultModelBuildTask_buildMetricAlarmsConfig(t *testing.T) {
+	tests := []struct {
+		name              string
+		memberAnnotations []map[string]string
+		want              shared_utils.MetricAlarmsConfig
+		wantErr           string
+	}{
+		{
+			name:              "no alarms configured",
+			memberAnnotations: []map[string]string{{}},
+			want:              shared_utils.MetricAlarmsConfig{},
+		},
+		{
+			name: "alarms merged across members",
+			memberAnnotations: []map[string]string{
+				{
+					"alb.ingress.kubernetes.io/cloudwatch-alarm-thresholds": "UnHealthyHostCount=1",
+					"alb.ingress.kubernetes.io/cloudwatch-alarm-actions":    "arn:aws:sns:us-west-2:111122223333:topic-1",
+				},
+				{
+					"alb.ingress.kubernetes.io/cloudwatch-alarm-thresholds": "UnHealthyHostCount=1,TargetResponseTime=0.5",
+					"alb.ingress.kubernetes.io/cloudwatch-alarm-actions":    "arn:aws:sns:us-west-2:111122223333:topic-2",
+				},
+			},
+			want: shared_utils.MetricAlarmsConfig{
+				Thresholds: map[string]float64{
+					"UnHealthyHostCount": 1,
+					"TargetResponseTime": 0.5,
+				},
+				AlarmActions: []string{"arn:aws:sns:us-west-2:111122223333:topic-1", "arn:aws:sns:us-west-2:111122223333:topic-2"},
+			},
+		},
+		{
+			name: "conflicting thresholds across members",
+			memberAnnotations: []map[string]string{
+				{"alb.ingress.kubernetes.io/cloudwatch-alarm-thresholds": "UnHealthyHostCount=1"},
+				{"alb.ingress.kubernetes.io/cloudwatch-alarm-thresholds": "UnHealthyHostCount=3"},
+			},
+			wantErr: "conflicting CloudWatch alarm thresholds for metric UnHealthyHostCount: 1 | 3",
+		},
+		{
+			name: "unsupported metric",
+			memberAnnotations: []map[string]string{
+				{"alb.ingress.kubernetes.io/cloudwatch-alarm-thresholds": "RequestCount=1"},
+			},
+			wantErr: "failed to parse CloudWatch alarms of ingress ns/ing-0: unsupported CloudWatch alarm metric RequestCount, supported metrics: HTTPCode_ELB_5XX_Count, TargetResponseTime, UnHealthyHostCount",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var members []ClassifiedIngress
+			for i, memberAnnotations := range tt.memberAnnotations {
+				members = append(members, ClassifiedIngress{
+					Ing: &networking.Ingress{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace:   "ns",
+							Name:        fmt.Sprintf("ing-%d", i),
+							Annotations: memberAnnotations,
+						},
+					},
+				})
+			}
+			task := &defaultModelBuildTask{
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+				ingGroup:         Group{Members: members},
+			}
+			got, err := task.buildMetricAlarmsConfig(context.Background())
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -72,6 +72,10 @@ func (t *defaultModelBuildTask) buildTargetGroupBindingSpec(ctx context.Context,
 	if err != nil {
 		return elbv2modelk8s.TargetGroupBindingResourceSpec{}, err
 	}
+	targetZonePolicy, err := t.buildTargetGroupBindingTargetZonePolicy(ing, svc)
+	if err != nil {
+		return elbv2modelk8s.TargetGroupBindingResourceSpec{}, err
+	}
 
 	return elbv2modelk8s.TargetGroupBindingResourceSpec{
 		Template: elbv2modelk8s.TargetGroupBindingTemplate{
@@ -92,6 +96,7 @@ func (t *defaultModelBuildTask) buildTargetGroupBindingSpec(ctx context.Context,
 				VpcID:                   t.vpcID,
 				MultiClusterTargetGroup: multiTg,
 				TargetGroupProtocol:     &tg.Spec.Protocol,
+				TargetZonePolicy:        targetZonePolicy,
 			},
 		},
 	}, nil
@@ -174,11 +179,11 @@ func (t *defaultModelBuildTask) buildTargetGroupSpec(ctx context.Context,
 	if err != nil {
 		return elbv2model.TargetGroupSpec{}, err
 	}
-	healthCheckConfig, err := t.buildTargetGroupHealthCheckConfig(ctx, svc, svcAndIngAnnotations, targetType, tgProtocol, tgProtocolVersion)
+	healthCheckConfig, err := t.buildTargetGroupHealthCheckConfig(ctx, svc, svcAndIngAnnotations, targetType, tgProtocol, tgProtocolVersion, ing.IngClassConfig)
 	if err != nil {
 		return elbv2model.TargetGroupSpec{}, err
 	}
-	tgAttributes, err := t.buildTargetGroupAttributes(ctx, svcAndIngAnnotations)
+	tgAttributes, err := t.buildTargetGroupAttributes(ctx, svcAndIngAnnotations, ing.IngClassConfig)
 	if err != nil {
 		return elbv2model.TargetGroupSpec{}, err
 	}
@@ -343,7 +348,7 @@ func (t *defaultModelBuildTask) buildTargetGroupProtocolVersion(_ context.Contex
 	}
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupHealthCheckConfig(ctx context.Context, svc *corev1.Service, svcAndIngAnnotations map[string]string, targetType elbv2model.TargetType, tgProtocol elbv2model.Protocol, tgProtocolVersion elbv2model.ProtocolVersion) (elbv2model.TargetGroupHealthCheckConfig, error) {
+func (t *defaultModelBuildTask) buildTargetGroupHealthCheckConfig(ctx context.Context, svc *corev1.Service, svcAndIngAnnotations map[string]string, targetType elbv2model.TargetType, tgProtocol elbv2model.Protocol, tgProtocolVersion elbv2model.ProtocolVersion, ingClassConfig ClassConfiguration) (elbv2model.TargetGroupHealthCheckConfig, error) {
 	healthCheckPort, err := t.buildTargetGroupHealthCheckPort(ctx, svc, svcAndIngAnnotations, targetType)
 	if err != nil {
 		return elbv2model.TargetGroupHealthCheckConfig{}, err
@@ -370,7 +375,7 @@ func (t *defaultModelBuildTask) buildTargetGroupHealthCheckConfig(ctx context.Co
 	if err != nil {
 		return elbv2model.TargetGroupHealthCheckConfig{}, err
 	}
-	return elbv2model.TargetGroupHealthCheckConfig{
+	healthCheckConfig := elbv2model.TargetGroupHealthCheckConfig{
 		Port:                    &healthCheckPort,
 		Protocol:                healthCheckProtocol,
 		Path:                    &healthCheckPath,
@@ -379,7 +384,55 @@ func (t *defaultModelBuildTask) buildTargetGroupHealthCheckConfig(ctx context.Co
 		TimeoutSeconds:          awssdk.Int32(int32(healthCheckTimeoutSeconds)),
 		HealthyThresholdCount:   awssdk.Int32(int32(healthCheckHealthyThresholdCount)),
 		UnhealthyThresholdCount: awssdk.Int32(healthCheckUnhealthyThresholdCount),
-	}, nil
+	}
+	if err := t.applyIngressClassHealthCheckConfig(ctx, svc, targetType, tgProtocolVersion, ingClassConfig, &healthCheckConfig); err != nil {
+		return elbv2model.TargetGroupHealthCheckConfig{}, err
+	}
+	return healthCheckConfig, nil
+}
+
+// applyIngressClassHealthCheckConfig overrides the healthCheck settings with the ones specified via IngressClassParams.
+// Note: the healthCheck settings specified via IngressClass takes higher priority than the annotations on Ingress or Service.
+func (t *defaultModelBuildTask) applyIngressClassHealthCheckConfig(_ context.Context, svc *corev1.Service, targetType elbv2model.TargetType, tgProtocolVersion elbv2model.ProtocolVersion,
+	ingClassConfig ClassConfiguration, healthCheckConfig *elbv2model.TargetGroupHealthCheckConfig) error {
+	if ingClassConfig.IngClassParams == nil || ingClassConfig.IngClassParams.Spec.TargetGroupHealthCheck == nil {
+		return nil
+	}
+	ingClassHealthCheck := ingClassConfig.IngClassParams.Spec.TargetGroupHealthCheck
+	if ingClassHealthCheck.Port != nil {
+		healthCheckPort, err := t.resolveTargetGroupHealthCheckPort(svc, intstr.Parse(ingClassHealthCheck.Port.String()), targetType)
+		if err != nil {
+			return err
+		}
+		healthCheckConfig.Port = &healthCheckPort
+	}
+	if ingClassHealthCheck.Protocol != nil {
+		healthCheckConfig.Protocol = elbv2model.Protocol(*ingClassHealthCheck.Protocol)
+	}
+	if ingClassHealthCheck.Path != nil {
+		healthCheckConfig.Path = awssdk.String(*ingClassHealthCheck.Path)
+	}
+	if ingClassHealthCheck.SuccessCodes != nil {
+		successCodes := *ingClassHealthCheck.SuccessCodes
+		if tgProtocolVersion == elbv2model.ProtocolVersionGRPC {
+			healthCheckConfig.Matcher = &elbv2model.HealthCheckMatcher{GRPCCode: &successCodes}
+		} else {
+			healthCheckConfig.Matcher = &elbv2model.HealthCheckMatcher{HTTPCode: &successCodes}
+		}
+	}
+	if ingClassHealthCheck.IntervalSeconds != nil {
+		healthCheckConfig.IntervalSeconds = awssdk.Int32(*ingClassHealthCheck.IntervalSeconds)
+	}
+	if ingClassHealthCheck.TimeoutSeconds != nil {
+		healthCheckConfig.TimeoutSeconds = awssdk.Int32(*ingClassHealthCheck.TimeoutSeconds)
+	}
+	if ingClassHealthCheck.HealthyThresholdCount != nil {
+		healthCheckConfig.HealthyThresholdCount = awssdk.Int32(*ingClassHealthCheck.HealthyThresholdCount)
+	}
+	if ingClassHealthCheck.UnhealthyThresholdCount != nil {
+		healthCheckConfig.UnhealthyThresholdCount = awssdk.Int32(*ingClassHealthCheck.UnhealthyThresholdCount)
+	}
+	return nil
 }
 
 func (t *defaultModelBuildTask) buildTargetGroupHealthCheckPort(_ context.Context, svc *corev1.Service, svcAndIngAnnotations map[string]string, targetType elbv2model.TargetType) (intstr.IntOrString, error) {
@@ -387,10 +440,14 @@ func (t *defaultModelBuildTask) buildTargetGroupHealthCheckPort(_ context.Contex
 	if exist := t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixHealthCheckPort, &rawHealthCheckPort, svcAndIngAnnotations); !exist {
 		return intstr.FromString(shared_constants.HealthCheckPortTrafficPort), nil
 	}
-	if rawHealthCheckPort == shared_constants.HealthCheckPortTrafficPort {
+	return t.resolveTargetGroupHealthCheckPort(svc, intstr.Parse(rawHealthCheckPort), targetType)
+}
+
+// resolveTargetGroupHealthCheckPort resolves the healthCheckPort into either traffic-port or a numeric port.
+func (t *defaultModelBuildTask) resolveTargetGroupHealthCheckPort(svc *corev1.Service, healthCheckPort intstr.IntOrString, targetType elbv2model.TargetType) (intstr.IntOrString, error) {
+	if healthCheckPort.String() == shared_constants.HealthCheckPortTrafficPort {
 		return intstr.FromString(shared_constants.HealthCheckPortTrafficPort), nil
 	}
-	healthCheckPort := intstr.Parse(rawHealthCheckPort)
 	if healthCheckPort.Type == intstr.Int {
 		return healthCheckPort, nil
 	}
@@ -489,11 +546,20 @@ func (t *defaultModelBuildTask) buildTargetGroupHealthCheckUnhealthyThresholdCou
 	return rawHealthCheckUnhealthyThresholdCount, nil
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupAttributes(_ context.Context, svcAndIngAnnotations map[string]string) ([]elbv2model.TargetGroupAttribute, error) {
+// buildTargetGroupAttributes builds the TargetGroup attributes.
+// Note: the attributes specified via IngressClass takes higher priority than the attributes specified via annotation on Ingress or Service.
+func (t *defaultModelBuildTask) buildTargetGroupAttributes(_ context.Context, svcAndIngAnnotations map[string]string, ingClassConfig ClassConfiguration) ([]elbv2model.TargetGroupAttribute, error) {
 	var rawAttributes map[string]string
 	if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.IngressSuffixTargetGroupAttributes, &rawAttributes, svcAndIngAnnotations); err != nil {
 		return nil, err
 	}
+	if ingClassConfig.IngClassParams != nil && len(ingClassConfig.IngClassParams.Spec.TargetGroupAttributes) != 0 {
+		ingClassAttributes := make(map[string]string, len(ingClassConfig.IngClassParams.Spec.TargetGroupAttributes))
+		for _, attr := range ingClassConfig.IngClassParams.Spec.TargetGroupAttributes {
+			ingClassAttributes[attr.Key] = attr.Value
+		}
+		rawAttributes = algorithm.MergeStringMap(ingClassAttributes, rawAttributes)
+	}
 	attributes := make([]elbv2model.TargetGroupAttribute, 0, len(rawAttributes))
 	for attrKey, attrValue := range rawAttributes {
 		attributes = append(attributes, elbv2model.TargetGroupAttribute{
@@ -551,6 +617,23 @@ func (t *defaultModelBuildTask) buildTargetGroupBindingMultiClusterFlag(ing Clas
 	return t.getMultiClusterTgFlag(svc.Annotations)
 }
 
+// buildTargetGroupBindingTargetZonePolicy builds the policy for targets in availability zones not enabled on the load balancer.
+// The policy only affects target registration, the subnets of the load balancer are never extended to cover new zones.
+func (t *defaultModelBuildTask) buildTargetGroupBindingTargetZonePolicy(ing ClassifiedIngress, svc *corev1.Service) (*elbv2api.TargetZonePolicy, error) {
+	svcAndIngAnnotations := algorithm.MergeStringMap(svc.Annotations, ing.Ing.Annotations)
+	var rawPolicy string
+	if exists := t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixTargetZonePolicy, &rawPolicy, svcAndIngAnnotations); !exists {
+		return nil, nil
+	}
+	policy := elbv2api.TargetZonePolicy(rawPolicy)
+	switch policy {
+	case elbv2api.TargetZonePolicyRegister, elbv2api.TargetZonePolicySkip, elbv2api.TargetZonePolicyDefer:
+		return &policy, nil
+	default:
+		return nil, errors.Errorf("unknown target zone policy: %v", rawPolicy)
+	}
+}
+
 func (t *defaultModelBuildTask) getMultiClusterTgFlag(annotationMap map[string]string) (bool, error) {
 	var rawEnabled bool
 	exists, err := t.annotationParser.ParseBoolAnnotation(annotations.IngressLBSuffixMultiClusterTargetGroup, &rawEnabled, annotationMap)
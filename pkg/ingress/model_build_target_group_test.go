@@ -625,6 +625,218 @@ func Test_defaultModelBuildTask_buildTargetGroupHealthCheckPath(t *testing.T) {
 	}
 }
 
+func Test_defaultModelBuildTask_buildTargetGroupHealthCheckConfig(t *testing.T) {
+	healthCheckPortTrafficPort := intstr.FromString(shared_constants.HealthCheckPortTrafficPort)
+	healthCheckPortNamed := intstr.FromString("health")
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "svc-1",
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "health",
+					Port:       8081,
+					TargetPort: intstr.FromInt32(9091),
+					NodePort:   32081,
+				},
+			},
+		},
+	}
+	type args struct {
+		svcAndIngAnnotations map[string]string
+		targetType           elbv2model.TargetType
+		tgProtocolVersion    elbv2model.ProtocolVersion
+		ingClassConfig       ClassConfiguration
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    elbv2model.TargetGroupHealthCheckConfig
+		wantErr error
+	}{
+		{
+			name: "without IngressClassParams",
+			args: args{
+				svcAndIngAnnotations: map[string]string{
+					"alb.ingress.kubernetes.io/healthcheck-path": "/ping",
+				},
+				targetType:        elbv2model.TargetTypeIP,
+				tgProtocolVersion: elbv2model.ProtocolVersionHTTP1,
+			},
+			want: elbv2model.TargetGroupHealthCheckConfig{
+				Port:                    &healthCheckPortTrafficPort,
+				Protocol:                elbv2model.ProtocolHTTP,
+				Path:                    awssdk.String("/ping"),
+				Matcher:                 &elbv2model.HealthCheckMatcher{HTTPCode: awssdk.String("200")},
+				IntervalSeconds:         awssdk.Int32(15),
+				TimeoutSeconds:          awssdk.Int32(5),
+				HealthyThresholdCount:   awssdk.Int32(2),
+				UnhealthyThresholdCount: awssdk.Int32(2),
+			},
+		},
+		{
+			name: "with IngressClassParams overriding annotations",
+			args: args{
+				svcAndIngAnnotations: map[string]string{
+					"alb.ingress.kubernetes.io/healthcheck-path":             "/ping",
+					"alb.ingress.kubernetes.io/healthcheck-interval-seconds": "30",
+				},
+				targetType:        elbv2model.TargetTypeIP,
+				tgProtocolVersion: elbv2model.ProtocolVersionHTTP1,
+				ingClassConfig: ClassConfiguration{
+					IngClassParams: &elbv2api.IngressClassParams{
+						Spec: elbv2api.IngressClassParamsSpec{
+							TargetGroupHealthCheck: &elbv2api.TargetGroupHealthCheckConfig{
+								Port:                    &healthCheckPortNamed,
+								Protocol:                &[]elbv2api.HealthCheckProtocol{elbv2api.HealthCheckProtocolHTTPS}[0],
+								Path:                    awssdk.String("/healthz"),
+								SuccessCodes:            awssdk.String("200-299"),
+								UnhealthyThresholdCount: awssdk.Int32(5),
+							},
+						},
+					},
+				},
+			},
+			want: elbv2model.TargetGroupHealthCheckConfig{
+				Port:                    &[]intstr.IntOrString{intstr.FromInt32(9091)}[0],
+				Protocol:                elbv2model.ProtocolHTTPS,
+				Path:                    awssdk.String("/healthz"),
+				Matcher:                 &elbv2model.HealthCheckMatcher{HTTPCode: awssdk.String("200-299")},
+				IntervalSeconds:         awssdk.Int32(30),
+				TimeoutSeconds:          awssdk.Int32(5),
+				HealthyThresholdCount:   awssdk.Int32(2),
+				UnhealthyThresholdCount: awssdk.Int32(5),
+			},
+		},
+		{
+			name: "with IngressClassParams success codes for GRPC",
+			args: args{
+				targetType:        elbv2model.TargetTypeInstance,
+				tgProtocolVersion: elbv2model.ProtocolVersionGRPC,
+				ingClassConfig: ClassConfiguration{
+					IngClassParams: &elbv2api.IngressClassParams{
+						Spec: elbv2api.IngressClassParamsSpec{
+							TargetGroupHealthCheck: &elbv2api.TargetGroupHealthCheckConfig{
+								Port:         &healthCheckPortNamed,
+								SuccessCodes: awssdk.String("0-99"),
+							},
+						},
+					},
+				},
+			},
+			want: elbv2model.TargetGroupHealthCheckConfig{
+				Port:                    &[]intstr.IntOrString{intstr.FromInt32(32081)}[0],
+				Protocol:                elbv2model.ProtocolHTTP,
+				Path:                    awssdk.String("/AWS.ALB/healthcheck"),
+				Matcher:                 &elbv2model.HealthCheckMatcher{GRPCCode: awssdk.String("0-99")},
+				IntervalSeconds:         awssdk.Int32(15),
+				TimeoutSeconds:          awssdk.Int32(5),
+				HealthyThresholdCount:   awssdk.Int32(2),
+				UnhealthyThresholdCount: awssdk.Int32(2),
+			},
+		},
+		{
+			name: "with IngressClassParams referencing unknown port",
+			args: args{
+				targetType:        elbv2model.TargetTypeIP,
+				tgProtocolVersion: elbv2model.ProtocolVersionHTTP1,
+				ingClassConfig: ClassConfiguration{
+					IngClassParams: &elbv2api.IngressClassParams{
+						Spec: elbv2api.IngressClassParamsSpec{
+							TargetGroupHealthCheck: &elbv2api.TargetGroupHealthCheckConfig{
+								Port: &[]intstr.IntOrString{intstr.FromString("unknown")}[0],
+							},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("failed to resolve healthCheckPort: unable to find port unknown on service awesome-ns/svc-1"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				annotationParser:                          annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+				defaultHealthCheckPathHTTP:                "/",
+				defaultHealthCheckPathGRPC:                "/AWS.ALB/healthcheck",
+				defaultHealthCheckMatcherHTTPCode:         "200",
+				defaultHealthCheckMatcherGRPCCode:         "12",
+				defaultHealthCheckIntervalSeconds:         15,
+				defaultHealthCheckTimeoutSeconds:          5,
+				defaultHealthCheckHealthyThresholdCount:   2,
+				defaultHealthCheckUnhealthyThresholdCount: 2,
+			}
+			got, err := task.buildTargetGroupHealthCheckConfig(context.Background(), svc, tt.args.svcAndIngAnnotations, tt.args.targetType,
+				elbv2model.ProtocolHTTP, tt.args.tgProtocolVersion, tt.args.ingClassConfig)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_defaultModelBuildTask_buildTargetGroupAttributes(t *testing.T) {
+	type args struct {
+		svcAndIngAnnotations map[string]string
+		ingClassConfig       ClassConfiguration
+	}
+	tests := []struct {
+		name string
+		args args
+		want []elbv2model.TargetGroupAttribute
+	}{
+		{
+			name: "without IngressClassParams",
+			args: args{
+				svcAndIngAnnotations: map[string]string{
+					"alb.ingress.kubernetes.io/target-group-attributes": "deregistration_delay.timeout_seconds=60",
+				},
+			},
+			want: []elbv2model.TargetGroupAttribute{
+				{Key: "deregistration_delay.timeout_seconds", Value: "60"},
+			},
+		},
+		{
+			name: "with IngressClassParams overriding annotations",
+			args: args{
+				svcAndIngAnnotations: map[string]string{
+					"alb.ingress.kubernetes.io/target-group-attributes": "deregistration_delay.timeout_seconds=60,stickiness.enabled=true",
+				},
+				ingClassConfig: ClassConfiguration{
+					IngClassParams: &elbv2api.IngressClassParams{
+						Spec: elbv2api.IngressClassParamsSpec{
+							TargetGroupAttributes: []elbv2api.Attribute{
+								{Key: "deregistration_delay.timeout_seconds", Value: "30"},
+								{Key: "load_balancing.algorithm.type", Value: "least_outstanding_requests"},
+							},
+						},
+					},
+				},
+			},
+			want: []elbv2model.TargetGroupAttribute{
+				{Key: "deregistration_delay.timeout_seconds", Value: "30"},
+				{Key: "load_balancing.algorithm.type", Value: "least_outstanding_requests"},
+				{Key: "stickiness.enabled", Value: "true"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+			}
+			got, err := task.buildTargetGroupAttributes(context.Background(), tt.args.svcAndIngAnnotations, tt.args.ingClassConfig)
+			assert.NoError(t, err)
+			assert.ElementsMatch(t, tt.want, got)
+		})
+	}
+}
+
 func Test_defaultModelBuildTask_buildTargetGroupHealthCheckMatcher(t *testing.T) {
 	type fields struct {
 		defaultHealthCheckMatcherHTTPCode string
@@ -1122,6 +1334,103 @@ func Test_defaultModelBuildTask_buildTargetGroupBindingMultiClusterFlag(t *testi
 		})
 	}
 }
+func Test_defaultModelBuildTask_buildTargetGroupBindingTargetZonePolicy(t *testing.T) {
+	deferPolicy := elbv2api.TargetZonePolicyDefer
+	skipPolicy := elbv2api.TargetZonePolicySkip
+	tests := []struct {
+		name    string
+		ing     ClassifiedIngress
+		svc     *corev1.Service
+		want    *elbv2api.TargetZonePolicy
+		wantErr bool
+	}{
+		{
+			name: "no annotation",
+			ing: ClassifiedIngress{
+				Ing: &networking.Ingress{},
+			},
+			svc:  &corev1.Service{},
+			want: nil,
+		},
+		{
+			name: "ing annotation",
+			ing: ClassifiedIngress{
+				Ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/target-zone-policy": "Defer",
+						},
+					},
+				},
+			},
+			svc:  &corev1.Service{},
+			want: &deferPolicy,
+		},
+		{
+			name: "svc annotation",
+			ing: ClassifiedIngress{
+				Ing: &networking.Ingress{},
+			},
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"alb.ingress.kubernetes.io/target-zone-policy": "Skip",
+					},
+				},
+			},
+			want: &skipPolicy,
+		},
+		{
+			name: "ing and svc annotation - svc takes precedence",
+			ing: ClassifiedIngress{
+				Ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/target-zone-policy": "Defer",
+						},
+					},
+				},
+			},
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"alb.ingress.kubernetes.io/target-zone-policy": "Skip",
+					},
+				},
+			},
+			want: &skipPolicy,
+		},
+		{
+			name: "unknown policy",
+			ing: ClassifiedIngress{
+				Ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/target-zone-policy": "Ignore",
+						},
+					},
+				},
+			},
+			svc:     &corev1.Service{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+			}
+			got, err := task.buildTargetGroupBindingTargetZonePolicy(tt.ing, tt.svc)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
 func Test_defaultModelBuildTask_buildTargetGroupSpec(t *testing.T) {
 	type args struct {
 		ing     ClassifiedIngress
@@ -61,7 +61,7 @@ func NewDefaultModelBuilder(k8sClient client.Client, eventRecorder record.EventR
 	trackingProvider tracking.Provider, elbv2TaggingManager elbv2deploy.TaggingManager, featureGates config.FeatureGates,
 	vpcID string, clusterName string, defaultTags map[string]string, externalManagedTags []string, defaultSSLPolicy string, defaultTargetType string, defaultLoadBalancerScheme string,
 	backendSGProvider networkingpkg.BackendSGProvider, sgResolver networkingpkg.SecurityGroupResolver,
-	enableBackendSG bool, defaultEnableManageBackendSGRules bool, disableRestrictedSGRules bool, allowedCAARNs []string, enableIPTargetType bool, enableACMCertificates bool, defaultCAArn string, targetGroupNameToArnMapper shared_utils.TargetGroupARNMapper, secretsManager k8s.SecretsManager, logger logr.Logger, metricsCollector lbcmetrics.MetricCollector,
+	enableBackendSG bool, defaultEnableManageBackendSGRules bool, disableRestrictedSGRules bool, restrictSGEgress bool, allowedCAARNs []string, enableIPTargetType bool, enableACMCertificates bool, defaultCAArn string, targetGroupNameToArnMapper shared_utils.TargetGroupARNMapper, secretsManager k8s.SecretsManager, logger logr.Logger, metricsCollector lbcmetrics.MetricCollector,
 	certDiscovery certs.CertDiscovery,
 ) *defaultModelBuilder {
 	ruleOptimizer := NewDefaultRuleOptimizer(logger)
@@ -95,6 +95,7 @@ func NewDefaultModelBuilder(k8sClient client.Client, eventRecorder record.EventR
 		enableManageBackendSGRules: defaultEnableManageBackendSGRules,
 		enableACMCertificates:      enableACMCertificates,
 		disableRestrictedSGRules:   disableRestrictedSGRules,
+		restrictSGEgress:           restrictSGEgress,
 		enableIPTargetType:         enableIPTargetType,
 		targetGroupNameToArnMapper: targetGroupNameToArnMapper,
 		webACLNameToArnMapper:      newWebACLNameToArnMapper(wafv2Client, defaultWebACLNameToARNCacheTTL),
@@ -139,6 +140,7 @@ type defaultModelBuilder struct {
 	enableManageBackendSGRules bool
 	enableACMCertificates      bool
 	disableRestrictedSGRules   bool
+	restrictSGEgress           bool
 	enableIPTargetType         bool
 	targetGroupNameToArnMapper shared_utils.TargetGroupARNMapper
 	webACLNameToArnMapper      *webACLNameToArnMapper
@@ -177,6 +179,7 @@ func (b *defaultModelBuilder) Build(ctx context.Context, ingGroup Group, metrics
 		enableManageBackendSGRules: b.enableManageBackendSGRules,
 		enableACMCertificates:      b.enableACMCertificates,
 		disableRestrictedSGRules:   b.disableRestrictedSGRules,
+		restrictSGEgress:           b.restrictSGEgress,
 		enableIPTargetType:         b.enableIPTargetType,
 		metricsCollector:           b.metricsCollector,
 
@@ -261,6 +264,7 @@ type defaultModelBuildTask struct {
 	enableBackendSG            bool
 	enableManageBackendSGRules bool
 	disableRestrictedSGRules   bool
+	restrictSGEgress           bool
 	enableIPTargetType         bool
 
 	defaultTags                               map[string]string
@@ -377,6 +381,10 @@ func (t *defaultModelBuildTask) run(ctx context.Context) error {
 		return ctrlerrors.NewErrorWithMetrics(controllerName, "build_load_balancer_addons", err, t.metricsCollector)
 	}
 
+	if err := t.buildMetricAlarms(ctx, lb.LoadBalancerARN()); err != nil {
+		return ctrlerrors.NewErrorWithMetrics(controllerName, "build_metric_alarms", err, t.metricsCollector)
+	}
+
 	if err := t.buildFrontendNlbModel(ctx, lb, listenerPortConfigByIngress); err != nil {
 		return ctrlerrors.NewErrorWithMetrics(controllerName, "build_frontend_nlb", err, t.metricsCollector)
 	}
@@ -462,12 +470,7 @@ func (t *defaultModelBuildTask) mergeListenPortConfigs(ctx context.Context, list
 		for j, cert := range cfg.listenPortConfig.tlsCerts {
 			// Use resource identity for dedup when available (auto-created certs),
 			// fall back to resolved ARN for literal tokens (e.g. certificate-arn).
-			var certKey string
-			if deps := cert.Dependencies(); len(deps) > 0 && deps[0].Type() != "" && deps[0].ID() != "" {
-				certKey = fmt.Sprintf("%s/%s", deps[0].Type(), deps[0].ID())
-			} else {
-				certKey, _ = cert.Resolve(ctx)
-			}
+			certKey := buildStringTokenKey(ctx, cert)
 			// The first certificate is ignored as it is the default certificate, which has already been added to the mergedTLSCerts.
 			if i == defaultCertMemberIndex && j == 0 {
 				continue
@@ -483,7 +486,7 @@ func (t *defaultModelBuildTask) mergeListenPortConfigs(ctx context.Context, list
 			if mergedMtlsAttributesProvider == nil {
 				mergedMtlsAttributesProvider = &cfg.ingKey
 				mergedMtlsAttributes = cfg.listenPortConfig.mutualAuthentication
-			} else if !reflect.DeepEqual(mergedMtlsAttributes, cfg.listenPortConfig.mutualAuthentication) {
+			} else if !isMutualAuthenticationAttributesEqual(ctx, mergedMtlsAttributes, cfg.listenPortConfig.mutualAuthentication) {
 				return listenPortConfig{}, errors.Errorf("conflicting mTLS Attributes, %v: %v | %v: %v",
 					*mergedMtlsAttributesProvider, mergedMtlsAttributes, cfg.ingKey, cfg.listenPortConfig.mutualAuthentication)
 			}
@@ -654,3 +657,26 @@ func (w *webACLNameToArnMapper) getArnByName(ctx context.Context, webACLName str
 	}
 	return "", errors.New("Unable to find web acl named " + webACLName)
 }
+
+// isMutualAuthenticationAttributesEqual checks whether two mutualAuthentication attributes are equal,
+// managed trustStores are compared by resource identity since their ARN is not resolved yet.
+func isMutualAuthenticationAttributesEqual(ctx context.Context, lhs *elbv2model.MutualAuthenticationAttributes, rhs *elbv2model.MutualAuthenticationAttributes) bool {
+	if (lhs.TrustStore == nil) != (rhs.TrustStore == nil) {
+		return false
+	}
+	if lhs.TrustStore != nil && buildStringTokenKey(ctx, lhs.TrustStore) != buildStringTokenKey(ctx, rhs.TrustStore) {
+		return false
+	}
+	lhsCopy, rhsCopy := *lhs, *rhs
+	lhsCopy.TrustStore, rhsCopy.TrustStore = nil, nil
+	return reflect.DeepEqual(lhsCopy, rhsCopy)
+}
+
+// buildStringTokenKey identifies a token by resource identity when available, and by resolved value otherwise.
+func buildStringTokenKey(ctx context.Context, token core.StringToken) string {
+	if deps := token.Dependencies(); len(deps) > 0 && deps[0].Type() != "" && deps[0].ID() != "" {
+		return fmt.Sprintf("%s/%s", deps[0].Type(), deps[0].ID())
+	}
+	key, _ := token.Resolve(ctx)
+	return key
+}
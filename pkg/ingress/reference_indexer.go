@@ -2,12 +2,17 @@ package ingress
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 
 	awssdk "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/go-logr/logr"
 	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/v3/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/shared_utils"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -16,10 +21,15 @@ const (
 	IndexKeyServiceRefName = "ingress.serviceRef.name"
 	// IndexKeySecretRefName is index key for secrets referenced by Ingress or Service.
 	IndexKeySecretRefName = "ingress.secretRef.name"
+	// IndexKeyIngressClassParamsSecretRefName is index key for secrets referenced by IngressClassParams, in namespace/name format.
+	IndexKeyIngressClassParamsSecretRefName = "ingressClassParams.secretRef.namespacedName"
 	// IndexKeyIngressClassRefName is index key for ingressClass referenced by Ingress.
 	IndexKeyIngressClassRefName = "ingress.ingressClassRef.name"
 	// IndexKeyIngressClassParamsRefName is index key for ingressClassParams referenced by IngressClass.
 	IndexKeyIngressClassParamsRefName = "ingressClass.ingressClassParamsRef.name"
+	// IndexKeyTrustStoreCABundleRefName is index key for the Secrets and ConfigMaps holding the CA bundles of the trustStores
+	// referenced by Ingress or IngressClassParams, see BuildTrustStoreCABundleRefIndexKey.
+	IndexKeyTrustStoreCABundleRefName = "ingress.trustStoreCABundleRef.name"
 )
 
 // ReferenceIndexer has the ability to index Ingresses with referenced objects.
@@ -28,17 +38,24 @@ type ReferenceIndexer interface {
 	BuildServiceRefIndexes(ctx context.Context, ing *networking.Ingress) []string
 	// BuildSecretRefIndexes returns the name of related Secret objects.
 	BuildSecretRefIndexes(ctx context.Context, ingOrSvc client.Object) []string
+	// BuildIngressClassParamsSecretRefIndexes returns the namespace and name of related Secret objects referenced by IngressClassParams.
+	BuildIngressClassParamsSecretRefIndexes(ctx context.Context, ingClassParams *elbv2api.IngressClassParams) []string
 	// BuildIngressClassRefIndexes returns the name of related IngressClass objects.
 	BuildIngressClassRefIndexes(ctx context.Context, ing *networking.Ingress) []string
 	// BuildIngressClassParamsRefIndexes returns the name of related IngressClassParams objects.
 	BuildIngressClassParamsRefIndexes(ctx context.Context, ingClass *networking.IngressClass) []string
+	// BuildTrustStoreCABundleRefIndexes returns the kind and name of the objects holding the trustStore CA bundles referenced by Ingress.
+	BuildTrustStoreCABundleRefIndexes(ctx context.Context, ing *networking.Ingress) []string
+	// BuildIngressClassParamsTrustStoreCABundleRefIndexes returns the kind and name of the objects holding the trustStore CA bundles referenced by IngressClassParams.
+	BuildIngressClassParamsTrustStoreCABundleRefIndexes(ctx context.Context, ingClassParams *elbv2api.IngressClassParams) []string
 }
 
 // NewDefaultReferenceIndexer constructs new defaultReferenceIndexer.
-func NewDefaultReferenceIndexer(enhancedBackendBuilder EnhancedBackendBuilder, authConfigBuilder AuthConfigBuilder, logger logr.Logger) *defaultReferenceIndexer {
+func NewDefaultReferenceIndexer(enhancedBackendBuilder EnhancedBackendBuilder, authConfigBuilder AuthConfigBuilder, annotationParser annotations.Parser, logger logr.Logger) *defaultReferenceIndexer {
 	return &defaultReferenceIndexer{
 		enhancedBackendBuilder: enhancedBackendBuilder,
 		authConfigBuilder:      authConfigBuilder,
+		annotationParser:       annotationParser,
 		logger:                 logger,
 	}
 }
@@ -49,6 +66,7 @@ var _ ReferenceIndexer = &defaultReferenceIndexer{}
 type defaultReferenceIndexer struct {
 	enhancedBackendBuilder EnhancedBackendBuilder
 	authConfigBuilder      AuthConfigBuilder
+	annotationParser       annotations.Parser
 	logger                 logr.Logger
 }
 
@@ -92,6 +110,18 @@ func (i *defaultReferenceIndexer) BuildSecretRefIndexes(ctx context.Context, ing
 	return extractSecretNamesFromAuthConfig(authCfg)
 }
 
+func (i *defaultReferenceIndexer) BuildIngressClassParamsSecretRefIndexes(_ context.Context, ingClassParams *elbv2api.IngressClassParams) []string {
+	authCfg := ingClassParams.Spec.Authentication
+	if authCfg == nil || authCfg.IDPConfigOIDC == nil {
+		return nil
+	}
+	secretKey := types.NamespacedName{
+		Namespace: authCfg.IDPConfigOIDC.SecretNamespace,
+		Name:      authCfg.IDPConfigOIDC.SecretName,
+	}
+	return []string{secretKey.String()}
+}
+
 func (i *defaultReferenceIndexer) BuildIngressClassRefIndexes(_ context.Context, ing *networking.Ingress) []string {
 	if ing.Spec.IngressClassName == nil {
 		return nil
@@ -114,6 +144,50 @@ func (i *defaultReferenceIndexer) BuildIngressClassParamsRefIndexes(_ context.Co
 	return []string{ingClassParamsName}
 }
 
+func (i *defaultReferenceIndexer) BuildTrustStoreCABundleRefIndexes(_ context.Context, ing *networking.Ingress) []string {
+	var rawMtlsConfigString string
+	if exists := i.annotationParser.ParseStringAnnotation(annotations.IngressSuffixMutualAuthentication, &rawMtlsConfigString, ing.Annotations); !exists {
+		return nil
+	}
+	var mtlsConfigEntries []MutualAuthenticationConfig
+	if err := json.Unmarshal([]byte(rawMtlsConfigString), &mtlsConfigEntries); err != nil {
+		i.logger.Error(err, "failed to build Ingress indexes",
+			"indexKey", IndexKeyTrustStoreCABundleRefName)
+		return nil
+	}
+	indexKeys := sets.NewString()
+	for _, entry := range mtlsConfigEntries {
+		if entry.TrustStoreCABundle != nil {
+			indexKeys.Insert(BuildTrustStoreCABundleRefIndexKey(entry.TrustStoreCABundle.Kind, entry.TrustStoreCABundle.Name))
+		}
+	}
+	return indexKeys.List()
+}
+
+func (i *defaultReferenceIndexer) BuildIngressClassParamsTrustStoreCABundleRefIndexes(_ context.Context, ingClassParams *elbv2api.IngressClassParams) []string {
+	indexKeys := sets.NewString()
+	for _, cfg := range ingClassParams.Spec.MutualAuthentication {
+		if cfg.TrustStoreCABundle == nil {
+			continue
+		}
+		var kind string
+		if cfg.TrustStoreCABundle.Kind != nil {
+			kind = string(*cfg.TrustStoreCABundle.Kind)
+		}
+		indexKeys.Insert(BuildTrustStoreCABundleRefIndexKey(kind, cfg.TrustStoreCABundle.Name))
+	}
+	return indexKeys.List()
+}
+
+// BuildTrustStoreCABundleRefIndexKey returns the IndexKeyTrustStoreCABundleRefName value of the Secret or ConfigMap holding a trustStore CA bundle.
+// the namespace isn't part of the value, Ingresses are looked up within the namespace of the object, and IngressClassParams are cluster-scoped.
+func BuildTrustStoreCABundleRefIndexKey(kind string, name string) string {
+	if len(kind) == 0 {
+		kind = shared_utils.TrustStoreCABundleKindSecret
+	}
+	return fmt.Sprintf("%s/%s", kind, name)
+}
+
 func extractServiceNamesFromAction(action Action) []string {
 	if action.Type != ActionTypeForward || action.ForwardConfig == nil {
 		return nil
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	networking "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/v3/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/annotations"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -377,6 +378,64 @@ func Test_defaultReferenceIndexer_BuildSecretRefIndexes(t *testing.T) {
 	}
 }
 
+func Test_defaultReferenceIndexer_BuildIngressClassParamsSecretRefIndexes(t *testing.T) {
+	tests := []struct {
+		name           string
+		ingClassParams *elbv2api.IngressClassParams
+		want           []string
+	}{
+		{
+			name:           "no authentication",
+			ingClassParams: &elbv2api.IngressClassParams{},
+			want:           nil,
+		},
+		{
+			name: "cognito authentication",
+			ingClassParams: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					Authentication: &elbv2api.AuthenticationConfig{
+						Type: elbv2api.AuthTypeCognito,
+						IDPConfigCognito: &elbv2api.AuthIDPConfigCognito{
+							UserPoolARN:      "arn:aws:cognito-idp:us-west-2:123456789012:userpool/us-west-2_abc",
+							UserPoolClientID: "client-id",
+							UserPoolDomain:   "my-domain",
+						},
+					},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "oidc authentication",
+			ingClassParams: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					Authentication: &elbv2api.AuthenticationConfig{
+						Type: elbv2api.AuthTypeOIDC,
+						IDPConfigOIDC: &elbv2api.AuthIDPConfigOIDC{
+							Issuer:                "https://example.com",
+							AuthorizationEndpoint: "https://authorization.example.com",
+							TokenEndpoint:         "https://token.example.com",
+							UserInfoEndpoint:      "https://userinfo.example.com",
+							SecretName:            "my-oidc-secret",
+							SecretNamespace:       "auth-system",
+						},
+					},
+				},
+			},
+			want: []string{"auth-system/my-oidc-secret"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := &defaultReferenceIndexer{
+				logger: logr.New(&log.NullLogSink{}),
+			}
+			got := i.BuildIngressClassParamsSecretRefIndexes(context.Background(), tt.ingClassParams)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func Test_defaultReferenceIndexer_BuildIngressClassRefIndexes(t *testing.T) {
 	type args struct {
 		ing *networking.Ingress
@@ -516,3 +575,87 @@ func Test_defaultReferenceIndexer_BuildIngressClassParamsRefIndexes(t *testing.T
 		})
 	}
 }
+
+func Test_defaultReferenceIndexer_BuildTrustStoreCABundleRefIndexes(t *testing.T) {
+	tests := []struct {
+		name string
+		ing  *networking.Ingress
+		want []string
+	}{
+		{
+			name: "ingress with trustStore CA bundles",
+			ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-ing",
+					Annotations: map[string]string{
+						"alb.ingress.kubernetes.io/mutual-authentication": `[{"port":443,"mode":"verify","trustStoreCABundle":{"kind":"ConfigMap","name":"ca-bundle"}},{"port":8443,"mode":"verify","trustStoreCABundle":{"name":"ca-secret"}},{"port":9443,"mode":"verify","trustStore":"my-ts"}]`,
+					},
+				},
+			},
+			want: []string{"ConfigMap/ca-bundle", "Secret/ca-secret"},
+		},
+		{
+			name: "ingress with invalid mutual-authentication annotation",
+			ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-ing",
+					Annotations: map[string]string{
+						"alb.ingress.kubernetes.io/mutual-authentication": `{"port":443`,
+					},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "ingress with no annotation",
+			ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-ing",
+				},
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := &defaultReferenceIndexer{
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+				logger:           logr.New(&log.NullLogSink{}),
+			}
+			got := i.BuildTrustStoreCABundleRefIndexes(context.Background(), tt.ing)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_defaultReferenceIndexer_BuildIngressClassParamsTrustStoreCABundleRefIndexes(t *testing.T) {
+	configMapKind := elbv2api.TrustStoreCABundleKindConfigMap
+	ingClassParams := &elbv2api.IngressClassParams{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-ing-class-params",
+		},
+		Spec: elbv2api.IngressClassParamsSpec{
+			MutualAuthentication: []elbv2api.MutualAuthenticationConfig{
+				{
+					Port: 443,
+					Mode: "verify",
+					TrustStoreCABundle: &elbv2api.TrustStoreCABundleSource{
+						Kind:      &configMapKind,
+						Namespace: awssdk.String("ca-namespace"),
+						Name:      "ca-bundle",
+					},
+				},
+				{
+					Port:       8443,
+					Mode:       "verify",
+					TrustStore: awssdk.String("my-ts"),
+				},
+			},
+		},
+	}
+	i := &defaultReferenceIndexer{
+		logger: logr.New(&log.NullLogSink{}),
+	}
+	got := i.BuildIngressClassParamsTrustStoreCABundleRefIndexes(context.Background(), ingClassParams)
+	assert.Equal(t, []string{"ConfigMap/ca-bundle"}, got)
+}
@@ -52,6 +52,9 @@ func TestAllIngressAnnotationsCovered(t *testing.T) {
 			annotations.IngressSuffixWAFv2ACLARN,
 			annotations.IngressSuffixWAFv2ACLName,
 			annotations.IngressSuffixShieldAdvancedProtection,
+			annotations.IngressSuffixShieldAdvancedHealthCheckIDs,
+			annotations.IngressSuffixShieldAdvancedAutomaticResponse,
+			annotations.IngressSuffixShieldAdvancedProtectionGroups,
 		},
 		ListenerConfig: {
 			annotations.IngressSuffixListenPorts,
@@ -158,7 +161,7 @@ func TestAllIngressAnnotationsCovered(t *testing.T) {
 
 	// Total IngressSuffix* + IngressLBSuffix* constants in pkg/annotations/constants.go.
 	// Update when adding new annotations: grep -c 'IngressSuffix\|IngressLBSuffix' pkg/annotations/constants.go
-	const totalExpectedAnnotations = 66
+	const totalExpectedAnnotations = 69
 
 	assert.Equal(t, totalExpectedAnnotations, len(all),
 		"Annotation count mismatch. A new Ingress annotation was likely added to pkg/annotations/constants.go. "+
@@ -125,6 +125,9 @@ var lbLevelAnnotationSuffixes = []string{
 	annotations.IngressSuffixWAFv2ACLARN,
 	annotations.IngressSuffixWAFv2ACLName,
 	annotations.IngressSuffixShieldAdvancedProtection,
+	annotations.IngressSuffixShieldAdvancedHealthCheckIDs,
+	annotations.IngressSuffixShieldAdvancedAutomaticResponse,
+	annotations.IngressSuffixShieldAdvancedProtectionGroups,
 	annotations.IngressSuffixLoadBalancerCapacityReservation,
 	annotations.IngressSuffixMutualAuthentication,
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	gatewayv1beta1 "sigs.k8s.io/aws-load-balancer-controller/v3/apis/gateway/v1"
@@ -126,7 +127,14 @@ func buildLoadBalancerConfigSpec(annos map[string]string, listenPorts []listenPo
 	}
 
 	if v := getBool(annos, annotations.IngressSuffixShieldAdvancedProtection); v != nil {
-		spec.ShieldAdvanced = &gatewayv1beta1.ShieldConfiguration{Enabled: *v}
+		spec.ShieldAdvanced = &gatewayv1beta1.ShieldConfiguration{
+			Enabled:          *v,
+			HealthCheckIDs:   getStringSlice(annos, annotations.IngressSuffixShieldAdvancedHealthCheckIDs),
+			ProtectionGroups: getStringSlice(annos, annotations.IngressSuffixShieldAdvancedProtectionGroups),
+		}
+		if action := buildShieldAutomaticResponseAction(getString(annos, annotations.IngressSuffixShieldAdvancedAutomaticResponse)); action != "" {
+			spec.ShieldAdvanced.ApplicationLayerAutomaticResponse = &gatewayv1beta1.ShieldApplicationLayerAutomaticResponse{Action: action}
+		}
 	}
 
 	listenerConfigs := buildListenerConfigurations(annos, listenPorts)
@@ -137,6 +145,19 @@ func buildLoadBalancerConfigSpec(annos map[string]string, listenPorts []listenPo
 	return spec
 }
 
+// buildShieldAutomaticResponseAction maps the shield-advanced-automatic-response annotation value to its Gateway API action.
+func buildShieldAutomaticResponseAction(v string) gatewayv1beta1.ShieldApplicationLayerAutomaticResponseAction {
+	switch strings.ToLower(v) {
+	case "block":
+		return gatewayv1beta1.ShieldApplicationLayerAutomaticResponseActionBlock
+	case "count":
+		return gatewayv1beta1.ShieldApplicationLayerAutomaticResponseActionCount
+	case "disabled":
+		return gatewayv1beta1.ShieldApplicationLayerAutomaticResponseActionDisabled
+	}
+	return ""
+}
+
 // buildListenerConfigurations builds ListenerConfiguration entries from annotations and listen-ports.
 func buildListenerConfigurations(annos map[string]string, listenPorts []listenPortEntry) []gatewayv1beta1.ListenerConfiguration {
 	if len(listenPorts) == 0 {
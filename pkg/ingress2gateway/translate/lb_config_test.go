@@ -95,6 +95,24 @@ func TestBuildLoadBalancerConfigResource(t *testing.T) {
 				assert.True(t, lbc.Spec.ShieldAdvanced.Enabled)
 			},
 		},
+		{
+			name: "shield advanced settings",
+			annos: map[string]string{
+				"alb.ingress.kubernetes.io/shield-advanced-protection":         "true",
+				"alb.ingress.kubernetes.io/shield-advanced-health-check-ids":   "hc-1",
+				"alb.ingress.kubernetes.io/shield-advanced-automatic-response": "block",
+				"alb.ingress.kubernetes.io/shield-advanced-protection-groups":  "group-1,group-2",
+			},
+			ports: []listenPortEntry{{Protocol: "HTTP", Port: 80}},
+			check: func(t *testing.T, lbc *gatewayv1beta1.LoadBalancerConfiguration) {
+				require.NotNil(t, lbc.Spec.ShieldAdvanced)
+				assert.True(t, lbc.Spec.ShieldAdvanced.Enabled)
+				assert.Equal(t, []string{"hc-1"}, lbc.Spec.ShieldAdvanced.HealthCheckIDs)
+				assert.Equal(t, []string{"group-1", "group-2"}, lbc.Spec.ShieldAdvanced.ProtectionGroups)
+				require.NotNil(t, lbc.Spec.ShieldAdvanced.ApplicationLayerAutomaticResponse)
+				assert.Equal(t, gatewayv1beta1.ShieldApplicationLayerAutomaticResponseActionBlock, lbc.Spec.ShieldAdvanced.ApplicationLayerAutomaticResponse.Action)
+			},
+		},
 		{
 			name: "subnets and security groups",
 			annos: map[string]string{
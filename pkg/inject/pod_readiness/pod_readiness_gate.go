@@ -14,61 +14,213 @@ import (
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"strings"
+	"time"
 )
 
 // NewPodReadinessGate constructs new PodReadinessGate
-func NewPodReadinessGate(config PodReadinessGateConfig, k8sClient client.Client, logger logr.Logger) *PodReadinessGate {
+func NewPodReadinessGate(config PodReadinessGateConfig, k8sClient client.Client,
+	deregistrationDelayResolver targetgroupbinding.DeregistrationDelayResolver, logger logr.Logger) *PodReadinessGate {
 	return &PodReadinessGate{
-		config:    config,
-		k8sClient: k8sClient,
-		logger:    logger,
+		config:                      config,
+		k8sClient:                   k8sClient,
+		deregistrationDelayResolver: deregistrationDelayResolver,
+		logger:                      logger,
 	}
 }
 
 // PodReadinessGate is a pod mutator that adds targetHealth readiness gates to pods matching the target group bindings
 type PodReadinessGate struct {
-	config    PodReadinessGateConfig
-	k8sClient client.Client
-	logger    logr.Logger
+	config                      PodReadinessGateConfig
+	k8sClient                   client.Client
+	deregistrationDelayResolver targetgroupbinding.DeregistrationDelayResolver
+	logger                      logr.Logger
 }
 
 // Mutate adds the targetHealth readiness gates to the pod if there are target group bindings on the same namespace as the pod
-// and referring to existing services matching the pod labels
+// and referring to existing services matching the pod labels.
+// It also adds the GlobalAccelerator endpoint health readiness gates when enabled on the namespace, and the pod drain finalizers
+// and preStop sleep for matching target group bindings with coordinated pod drain enabled.
 func (m *PodReadinessGate) Mutate(ctx context.Context, pod *corev1.Pod) error {
+	// see https://github.com/kubernetes/kubernetes/issues/88282 and https://github.com/kubernetes/kubernetes/issues/76680
+	req := webhook.ContextGetAdmissionRequest(ctx)
 	if !m.config.EnablePodReadinessGateInject {
-		return nil
+		podDrainEnabled, err := m.isCoordinatedPodDrainEnabled(ctx, req.Namespace)
+		if err != nil {
+			return err
+		}
+		if !podDrainEnabled {
+			return nil
+		}
 	}
 
-	// see https://github.com/kubernetes/kubernetes/issues/88282 and https://github.com/kubernetes/kubernetes/issues/76680
-	req := webhook.ContextGetAdmissionRequest(ctx)
-	targetHealthCondTypes, err := m.computeTargetHealthReadinessGateConditionTypes(ctx, req.Namespace, pod)
+	matchingTGBs, err := m.findMatchingIPTargetGroupBindings(ctx, req.Namespace, pod)
 	if err != nil {
 		return err
 	}
 
-	if len(targetHealthCondTypes) > 0 {
+	if m.config.EnablePodReadinessGateInject {
+		m.injectTargetHealthReadinessGates(ctx, pod, matchingTGBs)
+		if err := m.injectAGAEndpointHealthReadinessGates(ctx, req.Namespace, pod, matchingTGBs); err != nil {
+			return err
+		}
+	}
+	return m.injectPodDrainFinalizers(ctx, req.Namespace, pod, matchingTGBs)
+}
+
+// injectAGAEndpointHealthReadinessGates injects the GlobalAccelerator endpoint health readiness gate for matching TargetGroupBindings
+// when it's enabled on the namespace.
+func (m *PodReadinessGate) injectAGAEndpointHealthReadinessGates(ctx context.Context, namespace string, pod *corev1.Pod, matchingTGBs []elbv2api.TargetGroupBinding) error {
+	if len(matchingTGBs) == 0 {
+		return nil
+	}
+	enabled, err := m.isNamespaceAGAEndpointHealthReadinessGateEnabled(ctx, namespace)
+	if err != nil {
+		return err
+	}
+	if !enabled {
+		return nil
+	}
+	for i := range matchingTGBs {
+		condType := targetgroupbinding.BuildAGAEndpointHealthPodConditionType(&matchingTGBs[i])
+		if !k8s.IsPodHasReadinessGate(pod, condType) {
+			pod.Spec.ReadinessGates = append(pod.Spec.ReadinessGates, corev1.PodReadinessGate{
+				ConditionType: condType,
+			})
+		}
+	}
+	return nil
+}
+
+// injectTargetHealthReadinessGates adds the targetHealth readiness gates for matchingTGBs to the pod.
+func (m *PodReadinessGate) injectTargetHealthReadinessGates(ctx context.Context, pod *corev1.Pod, matchingTGBs []elbv2api.TargetGroupBinding) {
+	if len(matchingTGBs) > 0 {
 		// legacy readiness gates are removed for maintaining backwards compatibility.
 		m.removeLegacyTargetHealthReadinessGates(ctx, pod)
 	}
 
-	for _, condType := range targetHealthCondTypes {
+	for i := range matchingTGBs {
+		condType := targetgroupbinding.BuildTargetHealthPodConditionType(&matchingTGBs[i])
 		if !k8s.IsPodHasReadinessGate(pod, condType) {
 			pod.Spec.ReadinessGates = append(pod.Spec.ReadinessGates, corev1.PodReadinessGate{
 				ConditionType: condType,
 			})
 		}
 	}
+}
+
+// injectPodDrainFinalizers adds the pod drain finalizers for matchingTGBs with coordinated pod drain enabled to the pod.
+// coordinated pod drain is enabled either on the TargetGroupBinding or on the namespace of the pod.
+// Since finalizers don't delay the termination of containers, it also injects a preStop sleep that covers the deregistration delay.
+func (m *PodReadinessGate) injectPodDrainFinalizers(ctx context.Context, namespace string, pod *corev1.Pod, matchingTGBs []elbv2api.TargetGroupBinding) error {
+	if len(matchingTGBs) == 0 {
+		return nil
+	}
+	namespaceDrainEnabled, err := m.isNamespaceCoordinatedPodDrainEnabled(ctx, namespace)
+	if err != nil {
+		return err
+	}
+	var deregistrationDelay time.Duration
+	for i := range matchingTGBs {
+		if !namespaceDrainEnabled && !matchingTGBs[i].Spec.CoordinatedPodDrain {
+			continue
+		}
+		finalizer := targetgroupbinding.BuildPodDrainFinalizer(&matchingTGBs[i])
+		if !k8s.HasFinalizer(pod, finalizer) {
+			pod.Finalizers = append(pod.Finalizers, finalizer)
+		}
+		tgbDeregistrationDelay, err := m.deregistrationDelayResolver.ResolveDeregistrationDelay(ctx, &matchingTGBs[i])
+		if err != nil {
+			return errors.Wrap(err, "unable to determine pod drain preStop sleep")
+		}
+		if tgbDeregistrationDelay > deregistrationDelay {
+			deregistrationDelay = tgbDeregistrationDelay
+		}
+	}
+	injectPodDrainPreStopSleep(pod, deregistrationDelay)
 	return nil
 }
 
-// computeTargetHealthReadinessGateConditionTypes computes the desired condition types for targetHealth readiness gate.
-func (m *PodReadinessGate) computeTargetHealthReadinessGateConditionTypes(ctx context.Context, namespace string, pod *corev1.Pod) ([]corev1.PodConditionType, error) {
+// injectPodDrainPreStopSleep adds a preStop sleep to the containers of the pod without a preStop hook, so that they keep serving while their targets drain.
+// the sleep is bounded by both the deregistration delay and terminationGracePeriodSeconds of the pod.
+func injectPodDrainPreStopSleep(pod *corev1.Pod, deregistrationDelay time.Duration) {
+	sleepSeconds := int64(deregistrationDelay / time.Second)
+	terminationGracePeriodSeconds := int64(corev1.DefaultTerminationGracePeriodSeconds)
+	if pod.Spec.TerminationGracePeriodSeconds != nil {
+		terminationGracePeriodSeconds = *pod.Spec.TerminationGracePeriodSeconds
+	}
+	if sleepSeconds > terminationGracePeriodSeconds {
+		sleepSeconds = terminationGracePeriodSeconds
+	}
+	if sleepSeconds <= 0 {
+		return
+	}
+	for i := range pod.Spec.Containers {
+		container := &pod.Spec.Containers[i]
+		if container.Lifecycle != nil && container.Lifecycle.PreStop != nil {
+			continue
+		}
+		if container.Lifecycle == nil {
+			container.Lifecycle = &corev1.Lifecycle{}
+		}
+		container.Lifecycle.PreStop = &corev1.LifecycleHandler{
+			Sleep: &corev1.SleepAction{Seconds: sleepSeconds},
+		}
+	}
+}
+
+// isCoordinatedPodDrainEnabled checks whether coordinated pod drain is enabled on the namespace or on any TargetGroupBinding in the namespace.
+func (m *PodReadinessGate) isCoordinatedPodDrainEnabled(ctx context.Context, namespace string) (bool, error) {
+	namespaceDrainEnabled, err := m.isNamespaceCoordinatedPodDrainEnabled(ctx, namespace)
+	if err != nil {
+		return false, err
+	}
+	if namespaceDrainEnabled {
+		return true, nil
+	}
+	tgbList := &elbv2api.TargetGroupBindingList{}
+	if err := m.k8sClient.List(ctx, tgbList, client.InNamespace(namespace)); err != nil {
+		return false, errors.Wrap(err, "unable to determine coordinated pod drain")
+	}
+	for _, tgb := range tgbList.Items {
+		if tgb.Spec.CoordinatedPodDrain {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// isNamespaceCoordinatedPodDrainEnabled checks whether coordinated pod drain is enabled via label on the namespace.
+func (m *PodReadinessGate) isNamespaceCoordinatedPodDrainEnabled(ctx context.Context, namespace string) (bool, error) {
+	ns := &corev1.Namespace{}
+	if err := m.k8sClient.Get(ctx, types.NamespacedName{Name: namespace}, ns); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, errors.Wrap(err, "unable to determine coordinated pod drain")
+	}
+	return ns.Labels[targetgroupbinding.LabelCoordinatedPodDrain] == "enabled", nil
+}
+
+// isNamespaceAGAEndpointHealthReadinessGateEnabled checks whether the GlobalAccelerator endpoint health readiness gate is enabled on the namespace.
+func (m *PodReadinessGate) isNamespaceAGAEndpointHealthReadinessGateEnabled(ctx context.Context, namespace string) (bool, error) {
+	ns := &corev1.Namespace{}
+	if err := m.k8sClient.Get(ctx, types.NamespacedName{Name: namespace}, ns); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, errors.Wrap(err, "unable to determine GlobalAccelerator endpoint health readinessGates")
+	}
+	return ns.Labels[targetgroupbinding.LabelAGAEndpointHealthReadinessGateInject] == "enabled", nil
+}
+
+// findMatchingIPTargetGroupBindings finds the TargetGroupBindings with ip targetType that refer to services matching the pod labels.
+func (m *PodReadinessGate) findMatchingIPTargetGroupBindings(ctx context.Context, namespace string, pod *corev1.Pod) ([]elbv2api.TargetGroupBinding, error) {
 	tgbList := &elbv2api.TargetGroupBindingList{}
 	if err := m.k8sClient.List(ctx, tgbList, client.InNamespace(namespace)); err != nil {
 		m.logger.V(1).Info("unable to list TargetGroupBindings", "namespace", namespace)
 		return nil, errors.Wrap(err, "unable to determine targetHealth readinessGates")
 	}
-	var targetHealthCondTypes []corev1.PodConditionType
+	var matchingTGBs []elbv2api.TargetGroupBinding
 	for _, tgb := range tgbList.Items {
 		if tgb.Spec.TargetType == nil || (*tgb.Spec.TargetType) != elbv2api.TargetTypeIP {
 			continue
@@ -91,11 +243,10 @@ func (m *PodReadinessGate) computeTargetHealthReadinessGateConditionTypes(ctx co
 			svcSelector = labels.SelectorFromSet(svc.Spec.Selector)
 		}
 		if svcSelector.Matches(labels.Set(pod.Labels)) {
-			targetHealthCondType := targetgroupbinding.BuildTargetHealthPodConditionType(&tgb)
-			targetHealthCondTypes = append(targetHealthCondTypes, targetHealthCondType)
+			matchingTGBs = append(matchingTGBs, tgb)
 		}
 	}
-	return targetHealthCondTypes, nil
+	return matchingTGBs, nil
 }
 
 // removeLegacyTargetHealthReadinessGates removes existing legacy targetHealth readiness gates.
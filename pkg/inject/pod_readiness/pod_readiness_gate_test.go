@@ -3,7 +3,9 @@ package pod_readiness
 import (
 	"context"
 	"testing"
+	"time"
 
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/assert"
 	admissionv1 "k8s.io/api/admission/v1"
@@ -18,6 +20,14 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
+type staticDeregistrationDelayResolver struct {
+	deregistrationDelay time.Duration
+}
+
+func (r *staticDeregistrationDelayResolver) ResolveDeregistrationDelay(_ context.Context, _ *elbv2api.TargetGroupBinding) (time.Duration, error) {
+	return r.deregistrationDelay, nil
+}
+
 func Test_PodReadinessGate_Mutate(t *testing.T) {
 	testNS1 := "name-space-1"
 	testNS2 := "name-space-2"
@@ -115,15 +125,62 @@ func Test_PodReadinessGate_Mutate(t *testing.T) {
 		},
 	}
 
+	tgb6 := &elbv2api.TargetGroupBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "tgb-6-l6qw6",
+			Namespace: testNS1,
+		},
+		Spec: elbv2api.TargetGroupBindingSpec{
+			TargetType: &targetTypeIP,
+			ServiceRef: elbv2api.ServiceReference{
+				Name: svc1.Name,
+			},
+			CoordinatedPodDrain: true,
+		},
+	}
+	tgb7 := &elbv2api.TargetGroupBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "tgb-7-l6qw7",
+			Namespace: testNS1,
+		},
+		Spec: elbv2api.TargetGroupBindingSpec{
+			TargetType: &targetTypeInstance,
+			ServiceRef: elbv2api.ServiceReference{
+				Name: svc1.Name,
+			},
+			CoordinatedPodDrain: true,
+		},
+	}
+	ns1DrainEnabled := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testNS1,
+			Labels: map[string]string{
+				"elbv2.k8s.aws/coordinated-pod-drain": "enabled",
+			},
+		},
+	}
+
+	ns1AGAEndpointHealthEnabled := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testNS1,
+			Labels: map[string]string{
+				"aga.k8s.aws/pod-readiness-gate-inject": "enabled",
+			},
+		},
+	}
+
 	tests := []struct {
-		name      string
-		namespace string
-		services  []*corev1.Service
-		tgbList   []*elbv2api.TargetGroupBinding
-		pod       *corev1.Pod
-		want      []corev1.PodReadinessGate
-		config    PodReadinessGateConfig
-		wantError bool
+		name           string
+		namespace      string
+		namespaces     []*corev1.Namespace
+		services       []*corev1.Service
+		tgbList        []*elbv2api.TargetGroupBinding
+		pod            *corev1.Pod
+		want           []corev1.PodReadinessGate
+		wantFinalizers []string
+		wantContainers []corev1.Container
+		config         PodReadinessGateConfig
+		wantError      bool
 	}{
 		{
 			name:      "matching tgb with ip targetType",
@@ -339,6 +396,224 @@ func Test_PodReadinessGate_Mutate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:      "coordinated pod drain enabled on tgb",
+			namespace: testNS1,
+			services:  []*corev1.Service{svc1},
+			tgbList:   []*elbv2api.TargetGroupBinding{tgb1, tgb6, tgb7},
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						"app": "app-1",
+						"svc": "svc1",
+					},
+				},
+			},
+			want: []corev1.PodReadinessGate{
+				{
+					ConditionType: "target-health.elbv2.k8s.aws/tgb-1-l6qw1",
+				},
+				{
+					ConditionType: "target-health.elbv2.k8s.aws/tgb-6-l6qw6",
+				},
+			},
+			wantFinalizers: []string{"pod-drain.elbv2.k8s.aws/tgb-6-l6qw6"},
+			config: PodReadinessGateConfig{
+				EnablePodReadinessGateInject: true,
+			},
+		},
+		{
+			name:       "coordinated pod drain enabled on namespace",
+			namespace:  testNS1,
+			namespaces: []*corev1.Namespace{ns1DrainEnabled},
+			services:   []*corev1.Service{svc1},
+			tgbList:    []*elbv2api.TargetGroupBinding{tgb1, tgb6},
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						"app": "app-1",
+						"svc": "svc1",
+					},
+					Finalizers: []string{"pod-drain.elbv2.k8s.aws/tgb-6-l6qw6"},
+				},
+			},
+			want: []corev1.PodReadinessGate{
+				{
+					ConditionType: "target-health.elbv2.k8s.aws/tgb-1-l6qw1",
+				},
+				{
+					ConditionType: "target-health.elbv2.k8s.aws/tgb-6-l6qw6",
+				},
+			},
+			wantFinalizers: []string{"pod-drain.elbv2.k8s.aws/tgb-6-l6qw6", "pod-drain.elbv2.k8s.aws/tgb-1-l6qw1"},
+			config: PodReadinessGateConfig{
+				EnablePodReadinessGateInject: true,
+			},
+		},
+		{
+			name:      "coordinated pod drain with readiness gate inject disabled",
+			namespace: testNS1,
+			services:  []*corev1.Service{svc1},
+			tgbList:   []*elbv2api.TargetGroupBinding{tgb6},
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						"app": "app-1",
+						"svc": "svc1",
+					},
+				},
+			},
+			want:           nil,
+			wantFinalizers: []string{"pod-drain.elbv2.k8s.aws/tgb-6-l6qw6"},
+		},
+		{
+			name:      "coordinated pod drain injects preStop sleep bounded by termination grace period",
+			namespace: testNS1,
+			services:  []*corev1.Service{svc1},
+			tgbList:   []*elbv2api.TargetGroupBinding{tgb6},
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						"app": "app-1",
+						"svc": "svc1",
+					},
+				},
+				Spec: corev1.PodSpec{
+					TerminationGracePeriodSeconds: awssdk.Int64(60),
+					Containers: []corev1.Container{
+						{
+							Name: "app",
+						},
+						{
+							Name: "sidecar",
+							Lifecycle: &corev1.Lifecycle{
+								PreStop: &corev1.LifecycleHandler{
+									Exec: &corev1.ExecAction{Command: []string{"/bin/shutdown"}},
+								},
+							},
+						},
+					},
+				},
+			},
+			want:           nil,
+			wantFinalizers: []string{"pod-drain.elbv2.k8s.aws/tgb-6-l6qw6"},
+			wantContainers: []corev1.Container{
+				{
+					Name: "app",
+					Lifecycle: &corev1.Lifecycle{
+						PreStop: &corev1.LifecycleHandler{
+							Sleep: &corev1.SleepAction{Seconds: 60},
+						},
+					},
+				},
+				{
+					Name: "sidecar",
+					Lifecycle: &corev1.Lifecycle{
+						PreStop: &corev1.LifecycleHandler{
+							Exec: &corev1.ExecAction{Command: []string{"/bin/shutdown"}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:      "coordinated pod drain injects preStop sleep bounded by deregistration delay",
+			namespace: testNS1,
+			services:  []*corev1.Service{svc1},
+			tgbList:   []*elbv2api.TargetGroupBinding{tgb6},
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						"app": "app-1",
+						"svc": "svc1",
+					},
+				},
+				Spec: corev1.PodSpec{
+					TerminationGracePeriodSeconds: awssdk.Int64(600),
+					Containers: []corev1.Container{
+						{
+							Name: "app",
+						},
+					},
+				},
+			},
+			want:           nil,
+			wantFinalizers: []string{"pod-drain.elbv2.k8s.aws/tgb-6-l6qw6"},
+			wantContainers: []corev1.Container{
+				{
+					Name: "app",
+					Lifecycle: &corev1.Lifecycle{
+						PreStop: &corev1.LifecycleHandler{
+							Sleep: &corev1.SleepAction{Seconds: 300},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:      "readiness gate inject disabled without coordinated pod drain",
+			namespace: testNS1,
+			services:  []*corev1.Service{svc1},
+			tgbList:   []*elbv2api.TargetGroupBinding{tgb1},
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						"app": "app-1",
+						"svc": "svc1",
+					},
+				},
+			},
+			want:           nil,
+			wantFinalizers: nil,
+		},
+		{
+			name:       "GlobalAccelerator endpoint health readiness gate enabled on namespace",
+			namespace:  testNS1,
+			namespaces: []*corev1.Namespace{ns1AGAEndpointHealthEnabled},
+			services:   []*corev1.Service{svc1},
+			tgbList:    []*elbv2api.TargetGroupBinding{tgb1, tgb5},
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						"app": "app-1",
+						"svc": "svc1",
+					},
+				},
+			},
+			want: []corev1.PodReadinessGate{
+				{
+					ConditionType: "target-health.elbv2.k8s.aws/tgb-1-l6qw1",
+				},
+				{
+					ConditionType: "aga-endpoint-health.aga.k8s.aws/tgb-1-l6qw1",
+				},
+			},
+			config: PodReadinessGateConfig{
+				EnablePodReadinessGateInject: true,
+			},
+		},
+		{
+			name:      "GlobalAccelerator endpoint health readiness gate not enabled on namespace",
+			namespace: testNS1,
+			services:  []*corev1.Service{svc1},
+			tgbList:   []*elbv2api.TargetGroupBinding{tgb1},
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						"app": "app-1",
+						"svc": "svc1",
+					},
+				},
+			},
+			want: []corev1.PodReadinessGate{
+				{
+					ConditionType: "target-health.elbv2.k8s.aws/tgb-1-l6qw1",
+				},
+			},
+			config: PodReadinessGateConfig{
+				EnablePodReadinessGateInject: true,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -347,6 +622,9 @@ func Test_PodReadinessGate_Mutate(t *testing.T) {
 			clientgoscheme.AddToScheme(k8sSchema)
 			elbv2api.AddToScheme(k8sSchema)
 			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			for _, ns := range tt.namespaces {
+				assert.NoError(t, k8sClient.Create(ctx, ns.DeepCopy()))
+			}
 			for _, svc := range tt.services {
 				assert.NoError(t, k8sClient.Create(ctx, svc.DeepCopy()))
 			}
@@ -356,13 +634,17 @@ func Test_PodReadinessGate_Mutate(t *testing.T) {
 			ctx = webhook.ContextWithAdmissionRequest(ctx, admission.Request{
 				AdmissionRequest: admissionv1.AdmissionRequest{Namespace: tt.namespace},
 			})
-			readinessGateInjector := NewPodReadinessGate(tt.config, k8sClient, logr.New(&log.NullLogSink{}))
+			readinessGateInjector := NewPodReadinessGate(tt.config, k8sClient, &staticDeregistrationDelayResolver{deregistrationDelay: 300 * time.Second}, logr.New(&log.NullLogSink{}))
 			err := readinessGateInjector.Mutate(ctx, tt.pod)
 			if tt.wantError {
 				assert.Error(t, err)
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, tt.want, tt.pod.Spec.ReadinessGates)
+				assert.Equal(t, tt.wantFinalizers, tt.pod.Finalizers)
+				if tt.wantContainers != nil {
+					assert.Equal(t, tt.wantContainers, tt.pod.Spec.Containers)
+				}
 			}
 		})
 	}
@@ -31,6 +31,9 @@ type PodInfo struct {
 	PodIP          string
 	CreationTime   v1.Time
 
+	DeletionTimestamp *v1.Time
+	Finalizers        []string
+
 	// DefaultQUICServerID the fallback server id when no per-port quic server ids are configured.
 	DefaultQUICServerID *string
 	// PerPortServerIds a mapping of container port to its respective quic server id
@@ -77,6 +80,21 @@ func (i *PodInfo) HasAnyOfReadinessGates(conditionTypes []corev1.PodConditionTyp
 	return false
 }
 
+// IsTerminating returns whether podInfo is being deleted.
+func (i *PodInfo) IsTerminating() bool {
+	return i.DeletionTimestamp != nil
+}
+
+// HasFinalizer returns whether podInfo has specified finalizer.
+func (i *PodInfo) HasFinalizer(finalizer string) bool {
+	for _, f := range i.Finalizers {
+		if f == finalizer {
+			return true
+		}
+	}
+	return false
+}
+
 // IsContainersReady returns whether podInfo is ContainersReady.
 func (i *PodInfo) IsContainersReady() bool {
 	containersReadyCond, exists := i.GetPodCondition(corev1.ContainersReady)
@@ -195,6 +213,8 @@ func (podInfoBuilder *podInfoBuilder) buildPodInfo(pod *corev1.Pod) PodInfo {
 		NodeName:            pod.Spec.NodeName,
 		PodIP:               pod.Status.PodIP,
 		CreationTime:        pod.CreationTimestamp,
+		DeletionTimestamp:   pod.DeletionTimestamp,
+		Finalizers:          pod.Finalizers,
 
 		ENIInfos: podENIInfos,
 	}
@@ -147,6 +147,48 @@ func TestPodInfo_IsContainersReady(t *testing.T) {
 	}
 }
 
+func TestPodInfo_HasFinalizer(t *testing.T) {
+	tests := []struct {
+		name      string
+		pod       PodInfo
+		finalizer string
+		want      bool
+	}{
+		{
+			name: "pod has the finalizer",
+			pod: PodInfo{
+				Key:        types.NamespacedName{Namespace: "ns-1", Name: "pod-1"},
+				Finalizers: []string{"pod-drain.elbv2.k8s.aws/tgb-1", "pod-drain.elbv2.k8s.aws/tgb-2"},
+			},
+			finalizer: "pod-drain.elbv2.k8s.aws/tgb-2",
+			want:      true,
+		},
+		{
+			name: "pod doesn't have the finalizer",
+			pod: PodInfo{
+				Key:        types.NamespacedName{Namespace: "ns-1", Name: "pod-1"},
+				Finalizers: []string{"pod-drain.elbv2.k8s.aws/tgb-1"},
+			},
+			finalizer: "pod-drain.elbv2.k8s.aws/tgb-2",
+			want:      false,
+		},
+		{
+			name: "pod doesn't have any finalizer",
+			pod: PodInfo{
+				Key: types.NamespacedName{Namespace: "ns-1", Name: "pod-1"},
+			},
+			finalizer: "pod-drain.elbv2.k8s.aws/tgb-1",
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.pod.HasFinalizer(tt.finalizer)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestPodInfo_GetPodCondition(t *testing.T) {
 	type args struct {
 		conditionType corev1.PodConditionType
@@ -1,6 +1,7 @@
 package k8s
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -31,3 +32,11 @@ func IsResourceKindAvailable(resList *metav1.APIResourceList, kind string) bool
 	}
 	return false
 }
+
+// NewConfigMapMetadata returns the metadata-only object to watch ConfigMaps with.
+// ConfigMaps aren't cached by the client, only their metadata is cached to watch the ones referenced by other objects.
+func NewConfigMapMetadata() *metav1.PartialObjectMetadata {
+	cm := &metav1.PartialObjectMetadata{}
+	cm.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("ConfigMap"))
+	return cm
+}
@@ -21,6 +21,11 @@ type MetricCollector interface {
 	// Due to some architectural constraints, we can only emit this metric for pods that are using readiness gates.
 	ObservePodReadinessGateReady(namespace string, tgbName string, duration time.Duration)
 	ObserveQUICTargetMissingServerId(namespace string, tgbName string)
+	ObserveTargetsInUncoveredZones(namespace string, tgbName string, count int)
+	// ResetTargetsInUncoveredZones removes the targets in uncovered zones gauge of a TargetGroupBinding that is no longer checked.
+	ResetTargetsInUncoveredZones(namespace string, tgbName string)
+	// ObservePodDrainDuration this metric is useful to determine how long terminating pods are held for their targets to drain.
+	ObservePodDrainDuration(namespace string, tgbName string, duration time.Duration)
 	ObserveControllerReconcileError(controller string, errorType string)
 	ObserveControllerReconcileLatency(controller string, stage string, fn func())
 	ObserveWebhookValidationError(webhookName string, errorType string)
@@ -41,6 +46,15 @@ type noOpCollector struct{}
 func (n *noOpCollector) ObserveQUICTargetMissingServerId(namespace string, tgbName string) {
 }
 
+func (n *noOpCollector) ObserveTargetsInUncoveredZones(_ string, _ string, _ int) {
+}
+
+func (n *noOpCollector) ResetTargetsInUncoveredZones(_ string, _ string) {
+}
+
+func (n *noOpCollector) ObservePodDrainDuration(_ string, _ string, _ time.Duration) {
+}
+
 func (n *noOpCollector) ObservePodReadinessGateReady(_ string, _ string, _ time.Duration) {
 }
 
@@ -93,6 +107,27 @@ func (c *collector) ObserveQUICTargetMissingServerId(namespace string, tgbName s
 	}).Inc()
 }
 
+func (c *collector) ObserveTargetsInUncoveredZones(namespace string, tgbName string, count int) {
+	c.instruments.targetsInUncoveredZones.With(prometheus.Labels{
+		labelNamespace: namespace,
+		labelName:      tgbName,
+	}).Set(float64(count))
+}
+
+func (c *collector) ResetTargetsInUncoveredZones(namespace string, tgbName string) {
+	c.instruments.targetsInUncoveredZones.Delete(prometheus.Labels{
+		labelNamespace: namespace,
+		labelName:      tgbName,
+	})
+}
+
+func (c *collector) ObservePodDrainDuration(namespace string, tgbName string, duration time.Duration) {
+	c.instruments.podDrainSeconds.With(prometheus.Labels{
+		labelNamespace: namespace,
+		labelName:      tgbName,
+	}).Observe(duration.Seconds())
+}
+
 func (c *collector) ObserveControllerReconcileError(controller string, errorCategory string) {
 	c.instruments.controllerReconcileErrors.With(prometheus.Labels{
 		labelController:    controller,
@@ -26,6 +26,10 @@ const (
 	MetricControllerTopTalkers = "controller_top_talkers"
 	// MetricQuicTargetMissingServerId tracks the total number of QUIC targets attempted to be registered without a generated server id.
 	MetricQuicTargetMissingServerId = "quic_target_missing_server_id"
+	// MetricTargetsInUncoveredZones tracks the number of targets located in availability zones not enabled on the load balancer.
+	MetricTargetsInUncoveredZones = "targets_in_uncovered_zones"
+	// MetricPodDrainDuration tracks the time to drain the targets of terminating pods before releasing them.
+	MetricPodDrainDuration = "pod_drain_duration_seconds"
 )
 
 const (
@@ -47,6 +51,8 @@ type instruments struct {
 	webhookMutationFailure        *prometheus.CounterVec
 	controllerCacheObjectCount    *prometheus.GaugeVec
 	controllerReconcileTopTalkers *prometheus.GaugeVec
+	targetsInUncoveredZones       *prometheus.GaugeVec
+	podDrainSeconds               *prometheus.HistogramVec
 }
 
 // newInstruments allocates and register new metrics to registerer
@@ -101,7 +107,20 @@ func newInstruments(registerer prometheus.Registerer) *instruments {
 		Help:      "Counts the number of reconciliations triggered per resource",
 	}, []string{labelController, labelNamespace, labelName})
 
-	registerer.MustRegister(podReadinessFlipSeconds, controllerReconcileErrors, controllerReconcileStageDuration, webhookValidationFailure, webhookMutationFailure, controllerCacheObjectCount, controllerReconcileTopTalkers)
+	targetsInUncoveredZones := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: metricSubsystem,
+		Name:      MetricTargetsInUncoveredZones,
+		Help:      "Number of targets located in availability zones not enabled on the load balancer.",
+	}, []string{labelNamespace, labelName})
+
+	podDrainSeconds := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Subsystem: metricSubsystem,
+		Name:      MetricPodDrainDuration,
+		Help:      "Latency from pod termination until its targets are drained and the pod is released.",
+		Buckets:   []float64{5, 10, 30, 60, 120, 180, 240, 300, 360, 420, 480, 540, 600},
+	}, []string{labelNamespace, labelName})
+
+	registerer.MustRegister(podReadinessFlipSeconds, controllerReconcileErrors, controllerReconcileStageDuration, webhookValidationFailure, webhookMutationFailure, controllerCacheObjectCount, controllerReconcileTopTalkers, targetsInUncoveredZones, podDrainSeconds)
 	return &instruments{
 		podReadinessFlipSeconds:       podReadinessFlipSeconds,
 		controllerReconcileErrors:     controllerReconcileErrors,
@@ -111,5 +130,7 @@ func newInstruments(registerer prometheus.Registerer) *instruments {
 		controllerCacheObjectCount:    controllerCacheObjectCount,
 		controllerReconcileTopTalkers: controllerReconcileTopTalkers,
 		quicTargetsMissingServerId:    controllerQuicTargetMissingServerId,
+		targetsInUncoveredZones:       targetsInUncoveredZones,
+		podDrainSeconds:               podDrainSeconds,
 	}
 }
@@ -26,6 +26,12 @@ type MockHistogramMetric struct {
 	duration  time.Duration
 }
 
+type MockGaugeMetric struct {
+	labelNamespace string
+	labelName      string
+	value          int
+}
+
 type MockCounterMetric struct {
 	labelController    string
 	labelErrorCategory string
@@ -47,6 +53,30 @@ func (m *MockCollector) ObserveQUICTargetMissingServerId(namespace string, tgbNa
 	})
 }
 
+func (m *MockCollector) ObserveTargetsInUncoveredZones(namespace string, tgbName string, count int) {
+	m.Invocations[MetricTargetsInUncoveredZones] = append(m.Invocations[MetricTargetsInUncoveredZones], MockGaugeMetric{
+		labelNamespace: namespace,
+		labelName:      tgbName,
+		value:          count,
+	})
+}
+
+func (m *MockCollector) ResetTargetsInUncoveredZones(namespace string, tgbName string) {
+	invocations := m.Invocations[MetricTargetsInUncoveredZones][:0]
+	for _, invocation := range m.Invocations[MetricTargetsInUncoveredZones] {
+		gauge := invocation.(MockGaugeMetric)
+		if gauge.labelNamespace == namespace && gauge.labelName == tgbName {
+			continue
+		}
+		invocations = append(invocations, invocation)
+	}
+	m.Invocations[MetricTargetsInUncoveredZones] = invocations
+}
+
+func (m *MockCollector) ObservePodDrainDuration(namespace string, tgbName string, d time.Duration) {
+	m.recordHistogram(MetricPodDrainDuration, namespace, tgbName, d)
+}
+
 func (m *MockCollector) ObserveControllerReconcileError(controller string, errorCategory string) {
 	m.Invocations[MetricControllerReconcileErrors] = append(m.Invocations[MetricControllerReconcileErrors], MockCounterMetric{
 		labelController:    controller,
@@ -90,7 +120,7 @@ func (m *MockCollector) ObserveControllerTopTalkers(controller, namespace string
 }
 
 func (m *MockCollector) recordHistogram(metricName string, namespace string, name string, d time.Duration) {
-	m.Invocations[metricName] = append(m.Invocations[MetricPodReadinessGateReady], MockHistogramMetric{
+	m.Invocations[metricName] = append(m.Invocations[metricName], MockHistogramMetric{
 		namespace: namespace,
 		name:      name,
 		duration:  d,
@@ -115,6 +115,29 @@ type IPSet struct {
 	IpAddressFamily string `json:"ipAddressFamily,omitempty"`
 }
 
+// ByoipCIDR is a BYOIP address range that one of the accelerator's static IP addresses is allocated from.
+type ByoipCIDR struct {
+	// IPAddressFamily is the IP address family of the address range.
+	IPAddressFamily string `json:"ipAddressFamily"`
+
+	// Cidr is the address range in CIDR notation.
+	Cidr string `json:"cidr"`
+}
+
+// FlowLogs defines the flow logs attributes of the accelerator.
+type FlowLogs struct {
+	// Enabled indicates whether flow logs are enabled.
+	Enabled bool `json:"enabled"`
+
+	// S3Bucket is the name of the Amazon S3 bucket for the flow logs.
+	// +optional
+	S3Bucket string `json:"s3Bucket,omitempty"`
+
+	// S3Prefix is the prefix for the location in the Amazon S3 bucket for the flow logs.
+	// +optional
+	S3Prefix string `json:"s3Prefix,omitempty"`
+}
+
 // AcceleratorSpec defines the desired state of Accelerator
 type AcceleratorSpec struct {
 	// Name is the name of the Global Accelerator.
@@ -124,6 +147,10 @@ type AcceleratorSpec struct {
 	// +optional
 	IpAddresses []string `json:"ipAddresses,omitempty"`
 
+	// ByoipCIDRs optionally specifies the BYOIP address ranges that static IP addresses are allocated from.
+	// +optional
+	ByoipCIDRs []ByoipCIDR `json:"byoipCIDRs,omitempty"`
+
 	// IPAddressType is the value for the address type.
 	// +optional
 	IPAddressType IPAddressType `json:"ipAddressType,omitempty"`
@@ -135,6 +162,10 @@ type AcceleratorSpec struct {
 	// Tags defines list of Tags on the Global Accelerator.
 	// +optional
 	Tags map[string]string `json:"tags,omitempty"`
+
+	// FlowLogs defines the flow logs attributes, nil leaves them unchanged.
+	// +optional
+	FlowLogs *FlowLogs `json:"flowLogs,omitempty"`
 }
 
 // AcceleratorStatus defines the observed state of Accelerator
@@ -86,6 +86,10 @@ type EndpointConfiguration struct {
 	// ClientIPPreservationEnabled indicates whether client IP preservation is enabled for this endpoint.
 	// +optional
 	ClientIPPreservationEnabled *bool `json:"clientIPPreservationEnabled,omitempty"`
+
+	// AttachmentARN is the ARN of the cross-account attachment that grants the endpoint owned by another account.
+	// +optional
+	AttachmentARN *string `json:"attachmentARN,omitempty"`
 }
 
 // EndpointGroupSpec defines the desired state of EndpointGroup
@@ -113,4 +117,24 @@ type EndpointGroupSpec struct {
 type EndpointGroupStatus struct {
 	// EndpointGroupARN is the Amazon Resource Name (ARN) of the endpoint group.
 	EndpointGroupARN string `json:"endpointGroupARN"`
+
+	// CrossAccountAttachments is the state of the cross-account attachments of endpoints owned by other accounts.
+	// +optional
+	CrossAccountAttachments []CrossAccountAttachmentStatus `json:"crossAccountAttachments,omitempty"`
+}
+
+// CrossAccountAttachmentStatus is the state of the cross-account attachment of an endpoint owned by another account.
+type CrossAccountAttachmentStatus struct {
+	// EndpointID is the ID of the endpoint.
+	EndpointID string `json:"endpointID"`
+
+	// AttachmentARN is the ARN of the cross-account attachment.
+	AttachmentARN string `json:"attachmentARN"`
+
+	// Attached indicates whether the attachment grants the accelerator permission to use the endpoint.
+	Attached bool `json:"attached"`
+
+	// Message explains the state.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
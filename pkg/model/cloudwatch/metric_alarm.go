@@ -0,0 +1,103 @@
+package cloudwatch
+
+import (
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
+)
+
+var _ core.Resource = &MetricAlarm{}
+
+// MetricAlarm represents a CloudWatch metric alarm on a LoadBalancer or TargetGroup metric.
+type MetricAlarm struct {
+	core.ResourceMeta `json:"-"`
+
+	// desired state of MetricAlarm
+	Spec MetricAlarmSpec `json:"spec"`
+
+	// observed state of MetricAlarm
+	// +optional
+	Status *MetricAlarmStatus `json:"status,omitempty"`
+}
+
+// NewMetricAlarm constructs new MetricAlarm resource.
+func NewMetricAlarm(stack core.Stack, id string, spec MetricAlarmSpec) *MetricAlarm {
+	alarm := &MetricAlarm{
+		ResourceMeta: core.NewResourceMeta(stack, "AWS::CloudWatch::Alarm", id),
+		Spec:         spec,
+		Status:       nil,
+	}
+	stack.AddResource(alarm)
+	alarm.registerDependencies(stack)
+	return alarm
+}
+
+// SetStatus sets the MetricAlarm's status
+func (a *MetricAlarm) SetStatus(status MetricAlarmStatus) {
+	a.Status = &status
+}
+
+// register dependencies for MetricAlarm.
+func (a *MetricAlarm) registerDependencies(stack core.Stack) {
+	for _, dep := range a.Spec.LoadBalancerARN.Dependencies() {
+		stack.AddDependency(dep, a)
+	}
+	if a.Spec.TargetGroupARN != nil {
+		for _, dep := range a.Spec.TargetGroupARN.Dependencies() {
+			stack.AddDependency(dep, a)
+		}
+	}
+}
+
+// MetricAlarmSpec defines the desired state of MetricAlarm
+type MetricAlarmSpec struct {
+	// The name of the alarm.
+	AlarmName string `json:"alarmName"`
+
+	// The description of the alarm.
+	// +optional
+	AlarmDescription *string `json:"alarmDescription,omitempty"`
+
+	// The namespace of the metric, either AWS/ApplicationELB or AWS/NetworkELB.
+	Namespace string `json:"namespace"`
+
+	// The name of the metric.
+	MetricName string `json:"metricName"`
+
+	// The statistic to apply to the metric.
+	Statistic string `json:"statistic"`
+
+	// The arithmetic operation to use when comparing the statistic and threshold.
+	ComparisonOperator string `json:"comparisonOperator"`
+
+	// The value to compare with the statistic.
+	Threshold float64 `json:"threshold"`
+
+	// The length, in seconds, of the period the statistic is applied to.
+	Period int32 `json:"period"`
+
+	// The number of periods over which data is compared to the threshold.
+	EvaluationPeriods int32 `json:"evaluationPeriods"`
+
+	// How the alarm handles missing data points.
+	TreatMissingData string `json:"treatMissingData"`
+
+	// The ARN of the LoadBalancer the metric is reported for.
+	LoadBalancerARN core.StringToken `json:"loadBalancerARN"`
+
+	// The ARN of the TargetGroup the metric is reported for, for TargetGroup level metrics.
+	// +optional
+	TargetGroupARN core.StringToken `json:"targetGroupARN,omitempty"`
+
+	// The ARNs of the actions to execute when the alarm transitions into ALARM state.
+	// +optional
+	AlarmActions []string `json:"alarmActions,omitempty"`
+
+	// The tags.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// MetricAlarmStatus defines the observed state of MetricAlarm
+type MetricAlarmStatus struct {
+	// The Amazon Resource Name (ARN) of the alarm.
+	AlarmARN string `json:"alarmARN"`
+}
@@ -61,6 +61,11 @@ type SecurityGroupSpec struct {
 
 	// +optional
 	Ingress []IPPermission `json:"ingress,omitempty"`
+
+	// Egress permissions of the security group, excluding the ones managed for TargetGroupBinding networking.
+	// egress permissions are left untouched when nil.
+	// +optional
+	Egress []IPPermission `json:"egress,omitempty"`
 }
 
 // SecurityGroupStatus defines the observed state of SecurityGroup
@@ -0,0 +1,90 @@
+package ec2
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
+)
+
+var _ core.Resource = &VPCEndpointService{}
+
+// VPCEndpointService represents a EC2 VPC endpoint service (AWS PrivateLink) for a Network LoadBalancer.
+type VPCEndpointService struct {
+	core.ResourceMeta `json:"-"`
+
+	// desired state of VPCEndpointService
+	Spec VPCEndpointServiceSpec `json:"spec"`
+
+	// observed state of VPCEndpointService
+	// +optional
+	Status *VPCEndpointServiceStatus `json:"status,omitempty"`
+}
+
+// NewVPCEndpointService constructs new VPCEndpointService resource.
+func NewVPCEndpointService(stack core.Stack, id string, spec VPCEndpointServiceSpec) *VPCEndpointService {
+	es := &VPCEndpointService{
+		ResourceMeta: core.NewResourceMeta(stack, "AWS::EC2::VPCEndpointService", id),
+		Spec:         spec,
+		Status:       nil,
+	}
+	stack.AddResource(es)
+	es.registerDependencies(stack)
+	return es
+}
+
+// SetStatus sets the VPCEndpointService's status
+func (es *VPCEndpointService) SetStatus(status VPCEndpointServiceStatus) {
+	es.Status = &status
+}
+
+// ServiceName returns a token for this VPCEndpointService's serviceName.
+func (es *VPCEndpointService) ServiceName() core.StringToken {
+	return core.NewResourceFieldStringToken(es, "status/serviceName",
+		func(ctx context.Context, res core.Resource, fieldPath string) (s string, err error) {
+			es := res.(*VPCEndpointService)
+			if es.Status == nil {
+				return "", errors.Errorf("VPCEndpointService is not fulfilled yet: %v", es.ID())
+			}
+			return es.Status.ServiceName, nil
+		},
+	)
+}
+
+// register dependencies for VPCEndpointService.
+func (es *VPCEndpointService) registerDependencies(stack core.Stack) {
+	for _, dep := range es.Spec.NetworkLoadBalancerARN.Dependencies() {
+		stack.AddDependency(dep, es)
+	}
+}
+
+// VPCEndpointServiceSpec defines the desired state of VPCEndpointService
+type VPCEndpointServiceSpec struct {
+	// The Amazon Resource Name (ARN) of the Network Load Balancer.
+	NetworkLoadBalancerARN core.StringToken `json:"networkLoadBalancerARN"`
+
+	// Indicates whether requests from service consumers to create an endpoint must be accepted.
+	AcceptanceRequired bool `json:"acceptanceRequired"`
+
+	// The private DNS name to assign to the endpoint service.
+	// +optional
+	PrivateDNSName *string `json:"privateDNSName,omitempty"`
+
+	// The ARNs of the principals allowed to discover and connect to the endpoint service.
+	// connections from the accounts of these principals are accepted automatically.
+	// +optional
+	AllowedPrincipals []string `json:"allowedPrincipals,omitempty"`
+
+	// The tags.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// VPCEndpointServiceStatus defines the observed state of VPCEndpointService
+type VPCEndpointServiceStatus struct {
+	// The ID of the endpoint service.
+	ServiceID string `json:"serviceID"`
+
+	// The service name that consumers use to create endpoints.
+	ServiceName string `json:"serviceName"`
+}
@@ -116,6 +116,10 @@ type TargetGroupBindingSpec struct {
 	// TargetGroupProtocol is the Protocol of the TargetGroup. If unspecified, it will be automatically inferred.
 	// +optional
 	TargetGroupProtocol *elbv2.Protocol `json:"targetGroupProtocol,omitempty"`
+
+	// targetZonePolicy defines how targets in availability zones that are not enabled on the LoadBalancer are handled.
+	// +optional
+	TargetZonePolicy *elbv2api.TargetZonePolicy `json:"targetZonePolicy,omitempty"`
 }
 
 // Template for TargetGroupBinding Custom Resource.
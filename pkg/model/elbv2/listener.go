@@ -60,6 +60,12 @@ func (ls *Listener) registerDependencies(stack core.Stack) {
 		}
 	}
 
+	if ls.Spec.MutualAuthentication != nil && ls.Spec.MutualAuthentication.TrustStore != nil {
+		for _, dep := range ls.Spec.MutualAuthentication.TrustStore.Dependencies() {
+			stack.AddDependency(dep, ls)
+		}
+	}
+
 	for _, dep := range ls.Spec.LoadBalancerARN.Dependencies() {
 		stack.AddDependency(dep, ls)
 	}
@@ -113,6 +119,10 @@ type MutualAuthenticationAttributes struct {
 
 	TrustStoreArn *string `json:"trustStoreArn,omitempty"`
 
+	// TrustStore references a trust store managed by the controller, it takes precedence over TrustStoreArn.
+	// +optional
+	TrustStore core.StringToken `json:"trustStore,omitempty"`
+
 	IgnoreClientCertificateExpiry *bool   `json:"ignoreClientCertificateExpiry,omitempty"`
 	AdvertiseTrustStoreCaNames    *string `json:"advertiseTrustStoreCaNames,omitempty"`
 }
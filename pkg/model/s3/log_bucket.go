@@ -0,0 +1,44 @@
+package s3
+
+import (
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
+)
+
+var _ core.Resource = &LogBucket{}
+
+// LogBucket represents a S3 bucket that receives LoadBalancer logs.
+type LogBucket struct {
+	core.ResourceMeta `json:"-"`
+
+	// desired state of LogBucket
+	Spec LogBucketSpec `json:"spec"`
+}
+
+// NewLogBucket constructs new LogBucket resource.
+func NewLogBucket(stack core.Stack, id string, spec LogBucketSpec) *LogBucket {
+	bucket := &LogBucket{
+		ResourceMeta: core.NewResourceMeta(stack, "AWS::S3::Bucket", id),
+		Spec:         spec,
+	}
+	stack.AddResource(bucket)
+	return bucket
+}
+
+// LogBucketSpec defines the desired state of LogBucket
+type LogBucketSpec struct {
+	// The name of the bucket.
+	BucketName string `json:"bucketName"`
+
+	// The prefixes that logs are delivered under, empty prefix means the bucket root.
+	Prefixes []string `json:"prefixes"`
+
+	// Whether the bucket policy is patched to grant log delivery, otherwise it's only validated.
+	ManageBucketPolicy bool `json:"manageBucketPolicy"`
+
+	// Whether the bucket is created if it doesn't exist.
+	CreateBucket bool `json:"createBucket"`
+
+	// The number of days after which log objects expire, applied when the bucket is created.
+	// +optional
+	ExpirationDays *int32 `json:"expirationDays,omitempty"`
+}
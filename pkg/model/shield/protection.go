@@ -31,4 +31,33 @@ func (p *Protection) registerDependencies(stack core.Stack) {
 type ProtectionSpec struct {
 	Enabled     bool             `json:"enabled"`
 	ResourceARN core.StringToken `json:"resourceARN"`
+
+	// HealthCheckIDs are the Route53 health check IDs associated with the protection for health-based detection.
+	// nil leaves the associated health checks unchanged.
+	// +optional
+	HealthCheckIDs []string `json:"healthCheckIDs,omitempty"`
+
+	// ApplicationLayerAutomaticResponse configures application layer automatic mitigation.
+	// nil leaves the automatic response settings unchanged.
+	// +optional
+	ApplicationLayerAutomaticResponse *ApplicationLayerAutomaticResponse `json:"applicationLayerAutomaticResponse,omitempty"`
+
+	// ProtectionGroupIDs are the protection groups that the protected resource should be a member of.
+	// nil leaves protection group membership unchanged.
+	// +optional
+	ProtectionGroupIDs []string `json:"protectionGroupIDs,omitempty"`
+}
+
+// ApplicationLayerAutomaticResponseAction is the WAF rule action used by application layer automatic mitigation.
+type ApplicationLayerAutomaticResponseAction string
+
+const (
+	ApplicationLayerAutomaticResponseActionBlock ApplicationLayerAutomaticResponseAction = "Block"
+	ApplicationLayerAutomaticResponseActionCount ApplicationLayerAutomaticResponseAction = "Count"
+)
+
+// ApplicationLayerAutomaticResponse defines the desired application layer automatic response settings.
+type ApplicationLayerAutomaticResponse struct {
+	Enabled bool                                    `json:"enabled"`
+	Action  ApplicationLayerAutomaticResponseAction `json:"action,omitempty"`
 }
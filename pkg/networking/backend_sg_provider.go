@@ -23,10 +23,13 @@ import (
 	networking "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/services"
+	ctrlerrors "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/error"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/k8s"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/runtime"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/shard"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
@@ -35,6 +38,11 @@ const (
 	defaultSGDeletionPollInterval = 2 * time.Second
 	defaultSGDeletionTimeout      = 2 * time.Minute
 
+	// with sharding, the interval at which the global shard reconciles the auto-generated SG.
+	defaultSGReconcileInterval = 1 * time.Minute
+	// with sharding, the duration after which other shards look up the auto-generated SG again until the global shard created it.
+	defaultSGResolveRequeueDuration = 30 * time.Second
+
 	resourceTypeSecurityGroup = "security-group"
 	tagValueBackend           = "backend-sg"
 
@@ -59,16 +67,17 @@ type BackendSGProvider interface {
 
 // NewBackendSGProvider constructs a new  defaultBackendSGProvider
 func NewBackendSGProvider(clusterName string, backendSG string, vpcID string,
-	ec2Client services.EC2, k8sClient client.Client, defaultTags map[string]string, enableGatewayCheck bool, logger logr.Logger) *defaultBackendSGProvider {
+	ec2Client services.EC2, k8sClient client.Client, defaultTags map[string]string, enableGatewayCheck bool, shardManager shard.Manager, logger logr.Logger) *defaultBackendSGProvider {
 	return &defaultBackendSGProvider{
-		vpcID:       vpcID,
-		clusterName: clusterName,
-		backendSG:   backendSG,
-		defaultTags: defaultTags,
-		ec2Client:   ec2Client,
-		k8sClient:   k8sClient,
-		logger:      logger,
-		mutex:       sync.Mutex{},
+		vpcID:        vpcID,
+		clusterName:  clusterName,
+		backendSG:    backendSG,
+		defaultTags:  defaultTags,
+		ec2Client:    ec2Client,
+		k8sClient:    k8sClient,
+		shardManager: shardManager,
+		logger:       logger,
+		mutex:        sync.Mutex{},
 
 		enableGatewayCheck: enableGatewayCheck,
 
@@ -101,6 +110,7 @@ func NewBackendSGProvider(clusterName string, backendSG string, vpcID string,
 
 		defaultDeletionPollInterval: defaultSGDeletionPollInterval,
 		defaultDeletionTimeout:      defaultSGDeletionTimeout,
+		reconcileInterval:           defaultSGReconcileInterval,
 	}
 }
 
@@ -116,6 +126,7 @@ type defaultBackendSGProvider struct {
 	defaultTags     map[string]string
 	ec2Client       services.EC2
 	k8sClient       client.Client
+	shardManager    shard.Manager
 	logger          logr.Logger
 	// objectsMap keeps track of whether the backend SG is required for any tracked resources in the cluster.
 	// If any entry in the map is true, or there are resources with this controller specific finalizers which
@@ -132,6 +143,7 @@ type defaultBackendSGProvider struct {
 
 	defaultDeletionPollInterval time.Duration
 	defaultDeletionTimeout      time.Duration
+	reconcileInterval           time.Duration
 }
 
 func (p *defaultBackendSGProvider) Get(ctx context.Context, resourceType ResourceType, activeResources []types.NamespacedName) (string, error) {
@@ -158,6 +170,10 @@ func (p *defaultBackendSGProvider) Release(ctx context.Context, resourceType Res
 	}()
 	p.updateObjectsMap(ctx, resourceType, inactiveResources, false)
 	p.logger.V(1).Info("release backend SG", "inactive", inactiveResources)
+	// only the global shard deletes the auto-generated SG, so that it isn't deleted while another shard allocates it.
+	if !p.shardManager.IsGlobalShard() {
+		return nil
+	}
 	if required, err := p.isBackendSGRequired(ctx); required || err != nil {
 		return err
 	}
@@ -254,12 +270,69 @@ func (p *defaultBackendSGProvider) existsInObjectMap(resourceType ResourceType,
 	return false
 }
 
+// Start implements manager.Runnable.
+// With sharding, only the global shard creates and deletes the auto-generated SG, other shards resolve it read-only.
+// Resources reconciled by other shards aren't tracked in objectsMap, so the global shard periodically ensures the SG exists
+// as long as any of them carries the controller finalizers, and deletes it otherwise.
+func (p *defaultBackendSGProvider) Start(ctx context.Context) error {
+	if len(p.backendSG) > 0 || !p.shardManager.Enabled() {
+		return nil
+	}
+	wait.UntilWithContext(ctx, p.reconcileBackendSG, p.reconcileInterval)
+	return nil
+}
+
+func (p *defaultBackendSGProvider) reconcileBackendSG(ctx context.Context) {
+	if !p.shardManager.IsGlobalShard() {
+		return
+	}
+	required, err := p.isBackendSGRequired(ctx)
+	if err != nil {
+		p.logger.Error(err, "failed to check whether backend SG is required")
+		return
+	}
+	if !required {
+		if err := p.releaseSG(ctx); err != nil {
+			p.logger.Error(err, "failed to release backend SG")
+		}
+		return
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if err := p.ensureBackendSG(ctx); err != nil {
+		p.logger.Error(err, "failed to auto-create backend SG")
+	}
+}
+
 func (p *defaultBackendSGProvider) allocateBackendSG(ctx context.Context, resourceType ResourceType, activeResources []types.NamespacedName) error {
 	p.mutex.Lock()
 	defer p.mutex.Unlock()
 
 	p.updateObjectsMap(ctx, resourceType, activeResources, true)
-	if len(p.autoGeneratedSG) > 0 {
+	if !p.shardManager.IsGlobalShard() {
+		return p.resolveBackendSG(ctx)
+	}
+	return p.ensureBackendSG(ctx)
+}
+
+// resolveBackendSG looks up the auto-generated SG created by the global shard, without modifying it.
+func (p *defaultBackendSGProvider) resolveBackendSG(ctx context.Context) error {
+	sg, err := p.getBackendSGFromEC2(ctx, p.getBackendSGName(), p.vpcID)
+	if err != nil {
+		return err
+	}
+	if sg == nil {
+		p.autoGeneratedSG = ""
+		return ctrlerrors.NewRequeueNeededAfter("backend SG is not yet created by the global shard", defaultSGResolveRequeueDuration)
+	}
+	p.autoGeneratedSG = awssdk.ToString(sg.GroupId)
+	return nil
+}
+
+// ensureBackendSG creates the auto-generated SG if it doesn't exist yet.
+func (p *defaultBackendSGProvider) ensureBackendSG(ctx context.Context) error {
+	// with sharding, the auto-generated SG might have been released by a previous global shard, so it's looked up again.
+	if len(p.autoGeneratedSG) > 0 && !p.shardManager.Enabled() {
 		return nil
 	}
 
@@ -409,6 +482,16 @@ func (p *defaultBackendSGProvider) getBackendSGFromEC2(ctx context.Context, sgNa
 func (p *defaultBackendSGProvider) releaseSG(ctx context.Context) error {
 	p.mutex.Lock()
 	defer p.mutex.Unlock()
+	// with sharding, the auto-generated SG might have been allocated by a previous global shard.
+	if len(p.autoGeneratedSG) == 0 && p.shardManager.Enabled() {
+		sg, err := p.getBackendSGFromEC2(ctx, p.getBackendSGName(), p.vpcID)
+		if err != nil {
+			return err
+		}
+		if sg != nil {
+			p.autoGeneratedSG = awssdk.ToString(sg.GroupId)
+		}
+	}
 	if len(p.autoGeneratedSG) == 0 {
 		return nil
 	}
@@ -423,7 +506,7 @@ func (p *defaultBackendSGProvider) releaseSG(ctx context.Context) error {
 	if err := runtime.RetryImmediateOnError(p.defaultDeletionPollInterval, p.defaultDeletionTimeout, isSecurityGroupDependencyViolationError, func() error {
 		_, err := p.ec2Client.DeleteSecurityGroupWithContext(ctx, req)
 		return err
-	}); err != nil {
+	}); err != nil && !isEC2SecurityGroupNotFoundError(err) {
 		return errors.Wrap(err, "failed to delete securityGroup")
 	}
 	p.logger.Info("deleted securityGroup", "ID", p.autoGeneratedSG)
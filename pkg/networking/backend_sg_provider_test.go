@@ -24,6 +24,8 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/services"
+	ctrlerrors "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/error"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/shard"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
@@ -516,7 +518,7 @@ func Test_defaultBackendSGProvider_Get(t *testing.T) {
 			}
 			k8sClient := mock_client.NewMockClient(ctrl)
 			sgProvider := NewBackendSGProvider(defaultClusterName, tt.fields.backendSG,
-				defaultVPCID, ec2Client, k8sClient, tt.fields.defaultTags, tt.fields.enableGatewayCheck, logr.New(&log.NullLogSink{}))
+				defaultVPCID, ec2Client, k8sClient, tt.fields.defaultTags, tt.fields.enableGatewayCheck, shard.NewNoopManager(), logr.New(&log.NullLogSink{}))
 
 			resourceType := ResourceTypeIngress
 			var activeResources []types.NamespacedName
@@ -1058,7 +1060,7 @@ func Test_defaultBackendSGProvider_Release(t *testing.T) {
 			ec2Client := services.NewMockEC2(ctrl)
 			k8sClient := mock_client.NewMockClient(ctrl)
 			sgProvider := NewBackendSGProvider(defaultClusterName, tt.fields.backendSG,
-				defaultVPCID, ec2Client, k8sClient, tt.fields.defaultTags, tt.fields.enableGatewayCheck, logr.New(&log.NullLogSink{}))
+				defaultVPCID, ec2Client, k8sClient, tt.fields.defaultTags, tt.fields.enableGatewayCheck, shard.NewNoopManager(), logr.New(&log.NullLogSink{}))
 			if len(tt.fields.autogenSG) > 0 {
 				sgProvider.backendSG = ""
 				sgProvider.autoGeneratedSG = tt.fields.autogenSG
@@ -1135,3 +1137,128 @@ func Test_defaultBackendSGProvider_Release(t *testing.T) {
 		})
 	}
 }
+
+// shardedManager is a shard.Manager with sharding enabled, that is or isn't the global shard.
+type shardedManager struct {
+	shard.Manager
+	isGlobalShard bool
+}
+
+func (m *shardedManager) Enabled() bool {
+	return true
+}
+
+func (m *shardedManager) IsGlobalShard() bool {
+	return m.isGlobalShard
+}
+
+func Test_defaultBackendSGProvider_Sharding(t *testing.T) {
+	defaultEC2Filters := []ec2types.Filter{
+		{
+			Name:   awssdk.String("vpc-id"),
+			Values: []string{defaultVPCID},
+		},
+		{
+			Name:   awssdk.String("tag:elbv2.k8s.aws/cluster"),
+			Values: []string{defaultClusterName},
+		},
+		{
+			Name:   awssdk.String("tag:elbv2.k8s.aws/resource"),
+			Values: []string{"backend-sg"},
+		},
+	}
+	ing := &networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  "awesome-ns",
+			Name:       "awesome-ing",
+			Finalizers: []string{"ingress.k8s.aws/resources"},
+		},
+	}
+	existingSG := ec2types.SecurityGroup{
+		GroupId: awssdk.String("sg-autogen"),
+		Tags: []ec2types.Tag{
+			{Key: awssdk.String("elbv2.k8s.aws/cluster"), Value: awssdk.String(defaultClusterName)},
+			{Key: awssdk.String("elbv2.k8s.aws/resource"), Value: awssdk.String("backend-sg")},
+		},
+	}
+
+	t.Run("other shards resolve the existing SG without modifying it", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ec2Client := services.NewMockEC2(ctrl)
+		ec2Client.EXPECT().DescribeSecurityGroupsAsList(gomock.Any(), &ec2sdk.DescribeSecurityGroupsInput{Filters: defaultEC2Filters}).
+			Return([]ec2types.SecurityGroup{existingSG}, nil)
+		sgProvider := NewBackendSGProvider(defaultClusterName, "", defaultVPCID, ec2Client, nil, map[string]string{"k": "v"}, false,
+			&shardedManager{Manager: shard.NewNoopManager()}, logr.New(&log.NullLogSink{}))
+
+		got, err := sgProvider.Get(context.Background(), ResourceTypeIngress, []types.NamespacedName{k8s.NamespacedName(ing)})
+		assert.NoError(t, err)
+		assert.Equal(t, "sg-autogen", got)
+	})
+
+	t.Run("other shards requeue until the global shard created the SG", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ec2Client := services.NewMockEC2(ctrl)
+		ec2Client.EXPECT().DescribeSecurityGroupsAsList(gomock.Any(), &ec2sdk.DescribeSecurityGroupsInput{Filters: defaultEC2Filters}).
+			Return(nil, nil)
+		sgProvider := NewBackendSGProvider(defaultClusterName, "", defaultVPCID, ec2Client, nil, nil, false,
+			&shardedManager{Manager: shard.NewNoopManager()}, logr.New(&log.NullLogSink{}))
+
+		_, err := sgProvider.Get(context.Background(), ResourceTypeIngress, []types.NamespacedName{k8s.NamespacedName(ing)})
+		var requeueNeededAfter *ctrlerrors.RequeueNeededAfter
+		assert.True(t, errors.As(err, &requeueNeededAfter))
+	})
+
+	t.Run("other shards don't delete the SG", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ec2Client := services.NewMockEC2(ctrl)
+		sgProvider := NewBackendSGProvider(defaultClusterName, "", defaultVPCID, ec2Client, nil, nil, false,
+			&shardedManager{Manager: shard.NewNoopManager()}, logr.New(&log.NullLogSink{}))
+		sgProvider.autoGeneratedSG = "sg-autogen"
+
+		err := sgProvider.Release(context.Background(), ResourceTypeIngress, []types.NamespacedName{k8s.NamespacedName(ing)})
+		assert.NoError(t, err)
+	})
+
+	t.Run("global shard creates the SG required by resources of other shards", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ec2Client := services.NewMockEC2(ctrl)
+		ec2Client.EXPECT().DescribeSecurityGroupsAsList(gomock.Any(), &ec2sdk.DescribeSecurityGroupsInput{Filters: defaultEC2Filters}).
+			Return(nil, nil)
+		ec2Client.EXPECT().CreateSecurityGroupWithContext(gomock.Any(), gomock.Any()).
+			Return(&ec2sdk.CreateSecurityGroupOutput{GroupId: awssdk.String("sg-autogen")}, nil)
+		k8sClient := mock_client.NewMockClient(ctrl)
+		k8sClient.EXPECT().List(gomock.Any(), &networking.IngressList{}, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, list *networking.IngressList, opts ...client.ListOption) error {
+				list.Items = []networking.Ingress{*ing}
+				return nil
+			},
+		)
+		sgProvider := NewBackendSGProvider(defaultClusterName, "", defaultVPCID, ec2Client, k8sClient, nil, false,
+			&shardedManager{Manager: shard.NewNoopManager(), isGlobalShard: true}, logr.New(&log.NullLogSink{}))
+
+		sgProvider.reconcileBackendSG(context.Background())
+		assert.Equal(t, "sg-autogen", sgProvider.autoGeneratedSG)
+	})
+
+	t.Run("global shard deletes the SG once no resource requires it", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ec2Client := services.NewMockEC2(ctrl)
+		ec2Client.EXPECT().DescribeSecurityGroupsAsList(gomock.Any(), &ec2sdk.DescribeSecurityGroupsInput{Filters: defaultEC2Filters}).
+			Return([]ec2types.SecurityGroup{existingSG}, nil)
+		ec2Client.EXPECT().DeleteSecurityGroupWithContext(gomock.Any(), &ec2sdk.DeleteSecurityGroupInput{GroupId: awssdk.String("sg-autogen")}).
+			Return(&ec2sdk.DeleteSecurityGroupOutput{}, nil)
+		k8sClient := mock_client.NewMockClient(ctrl)
+		k8sClient.EXPECT().List(gomock.Any(), &networking.IngressList{}, gomock.Any()).Return(nil).Times(2)
+		k8sClient.EXPECT().List(gomock.Any(), &corev1.ServiceList{}, gomock.Any()).Return(nil).Times(2)
+		sgProvider := NewBackendSGProvider(defaultClusterName, "", defaultVPCID, ec2Client, k8sClient, nil, false,
+			&shardedManager{Manager: shard.NewNoopManager(), isGlobalShard: true}, logr.New(&log.NullLogSink{}))
+
+		sgProvider.reconcileBackendSG(context.Background())
+		assert.Equal(t, "", sgProvider.autoGeneratedSG)
+	})
+}
@@ -15,12 +15,15 @@ import (
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/v3/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/backend"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/shard"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/shared_constants"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -31,6 +34,12 @@ const (
 	defaultTgbMaxPort                   = int32(65535)
 )
 
+// NewNonTGBNetworkingPermissionSelector constructs a permission selector that matches permissions not managed for TargetGroupBinding networking.
+func NewNonTGBNetworkingPermissionSelector() labels.Selector {
+	requirement, _ := labels.NewRequirement(tgbNetworkingIPPermissionLabelKey, selection.DoesNotExist, nil)
+	return labels.NewSelector().Add(*requirement)
+}
+
 // NetworkingManager manages the networking for targetGroupBindings.
 type NetworkingManager interface {
 	// ReconcileForPodEndpoints reconcile network settings for TargetGroupBindings with podEndpoints.
@@ -48,7 +57,7 @@ type NetworkingManager interface {
 
 // NewDefaultNetworkingManager constructs defaultNetworkingManager.
 func NewDefaultNetworkingManager(k8sClient client.Client, podENIResolver PodENIInfoResolver, nodeENIResolver NodeENIInfoResolver,
-	sgManager SecurityGroupManager, sgReconciler SecurityGroupReconciler, vpcID string, clusterName string, serviceTargetENISGTags map[string]string, logger logr.Logger, disabledRestrictedSGRulesFlag bool) NetworkingManager {
+	sgManager SecurityGroupManager, sgReconciler SecurityGroupReconciler, vpcID string, clusterName string, serviceTargetENISGTags map[string]string, shardManager shard.Manager, logger logr.Logger, disabledRestrictedSGRulesFlag bool, restrictSGEgressFlag bool) NetworkingManager {
 
 	return &defaultNetworkingManager{
 		k8sClient:              k8sClient,
@@ -59,6 +68,7 @@ func NewDefaultNetworkingManager(k8sClient client.Client, podENIResolver PodENII
 		vpcID:                  vpcID,
 		clusterName:            clusterName,
 		serviceTargetENISGTags: serviceTargetENISGTags,
+		shardManager:           shardManager,
 		logger:                 logger,
 
 		mutex:                         sync.Mutex{},
@@ -66,6 +76,10 @@ func NewDefaultNetworkingManager(k8sClient client.Client, podENIResolver PodENII
 		trackedEndpointSGs:            sets.NewString(),
 		trackedEndpointSGsInitialized: false,
 		disableRestrictedSGRules:      disabledRestrictedSGRulesFlag,
+		restrictSGEgress:              restrictSGEgressFlag,
+
+		trackedLoadBalancerSGs:            sets.NewString(),
+		trackedLoadBalancerSGsInitialized: false,
 	}
 }
 
@@ -79,6 +93,7 @@ type defaultNetworkingManager struct {
 	vpcID                  string
 	clusterName            string
 	serviceTargetENISGTags map[string]string
+	shardManager           shard.Manager
 	logger                 logr.Logger
 
 	// mutex will serialize our TargetGroup's networking reconcile requests.
@@ -95,6 +110,16 @@ type defaultNetworkingManager struct {
 	trackedEndpointSGsInitialized bool
 	// disableRestrictedSGRules specifies whether to use restricted security group rules
 	disableRestrictedSGRules bool
+	// restrictSGEgress specifies whether to restrict egress rules of controller-managed loadBalancer securityGroups
+	// to the endpoint securityGroups and ports needed by TargetGroupBindings.
+	restrictSGEgress bool
+
+	// trackedLoadBalancerSGs are the full set of loadBalancer securityGroups that we have managed outbound rules to satisfying
+	// targetGroupBinding's network requirements.
+	// we'll GC outbound rules from these securityGroups if it's no longer needed by TargetGroupBindings.
+	trackedLoadBalancerSGs sets.String
+	// whether we have initialized trackedLoadBalancerSGs from AWS.
+	trackedLoadBalancerSGsInitialized bool
 }
 
 func (m *defaultNetworkingManager) ReconcileForPodEndpoints(ctx context.Context, tgb *elbv2api.TargetGroupBinding, endpoints []backend.PodEndpoint) error {
@@ -126,6 +151,10 @@ func (m *defaultNetworkingManager) Cleanup(ctx context.Context, tgb *elbv2api.Ta
 }
 
 func (m *defaultNetworkingManager) AttemptGarbageCollection(ctx context.Context) error {
+	// endpoint SGs are shared by all shards, only the global shard garbage collects them.
+	if !m.shardManager.IsGlobalShard() {
+		return nil
+	}
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 	tgbsWithNetworking, err := m.fetchTGBsWithNetworking(ctx)
@@ -214,6 +243,11 @@ func (m *defaultNetworkingManager) computeIngressPermissionsPerSGWithNodePortEnd
 }
 
 func (m *defaultNetworkingManager) reconcileWithIngressPermissionsPerSG(ctx context.Context, tgb *elbv2api.TargetGroupBinding, ingressPermissionsPerSG map[string][]IPPermissionInfo) error {
+	// securityGroup rules are aggregated across TargetGroupBindings of all shards, only the global shard reconciles them.
+	// other shards delegate the networking of their TargetGroupBindings to the global shard.
+	if !m.shardManager.IsGlobalShard() {
+		return nil
+	}
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
@@ -240,6 +274,12 @@ func (m *defaultNetworkingManager) reconcileWithIngressPermissionsPerSG(ctx cont
 		}
 	}
 
+	if m.restrictSGEgress {
+		if err := m.reconcileEgressForLoadBalancerSGs(ctx, computedForAllTGBs); err != nil {
+			sgReconciliationErrors = append(sgReconciliationErrors, err)
+		}
+	}
+
 	if computedForAllTGBs {
 		if err := m.gcIngressPermissionsFromUnusedEndpointSGs(ctx, aggregatedIngressPermissionsPerSG); err != nil {
 			return err
@@ -526,6 +566,138 @@ func (m *defaultNetworkingManager) gcIngressPermissionsFromUnusedEndpointSGs(ctx
 	return nil
 }
 
+// reconcileEgressForLoadBalancerSGs will reconcile egress permissions on controller-managed loadBalancer SecurityGroups,
+// so that they only allow traffic towards the endpoint SecurityGroups and ports needed by TargetGroupBindings.
+// once ingress permissions are computed for all TargetGroupBindings, egress permissions are revoked from loadBalancer SecurityGroups
+// that are no longer used by any TargetGroupBinding(e.g. after the TargetGroupBinding is deleted).
+func (m *defaultNetworkingManager) reconcileEgressForLoadBalancerSGs(ctx context.Context, computedForAllTGBs bool) error {
+	egressPermissionsPerSG := computeEgressPermissionsPerSG(m.ingressPermissionsPerSGByTGB)
+
+	var sgReconciliationErrors []error
+	for _, sgID := range sets.StringKeySet(egressPermissionsPerSG).List() {
+		isLoadBalancerSG, err := m.isLoadBalancerSG(ctx, sgID)
+		if err != nil {
+			sgReconciliationErrors = append(sgReconciliationErrors, err)
+			continue
+		}
+		if !isLoadBalancerSG {
+			continue
+		}
+		m.trackLoadBalancerSGs(ctx, sgID)
+		err = m.sgReconciler.ReconcileEgress(ctx, sgID, egressPermissionsPerSG[sgID],
+			WithAuthorizeOnly(!computedForAllTGBs))
+		if err != nil {
+			if isEC2SecurityGroupNotFoundError(err) {
+				m.unTrackLoadBalancerSGs(ctx, sgID)
+				continue
+			}
+			sgReconciliationErrors = append(sgReconciliationErrors, err)
+		}
+	}
+
+	if computedForAllTGBs {
+		if err := m.gcEgressPermissionsFromUnusedLoadBalancerSGs(ctx, egressPermissionsPerSG); err != nil {
+			sgReconciliationErrors = append(sgReconciliationErrors, err)
+		}
+	}
+
+	if len(sgReconciliationErrors) > 0 {
+		return libErrors.Join(sgReconciliationErrors...)
+	}
+	return nil
+}
+
+// gcEgressPermissionsFromUnusedLoadBalancerSGs will garbage collect egress permissions from loadBalancer SecurityGroups that are no longer used by TargetGroupBindings.
+func (m *defaultNetworkingManager) gcEgressPermissionsFromUnusedLoadBalancerSGs(ctx context.Context, egressPermissionsPerSG map[string][]IPPermissionInfo) error {
+	loadBalancerSGs, err := m.fetchLoadBalancerSGs(ctx)
+	if err != nil {
+		return err
+	}
+	usedLoadBalancerSGs := sets.StringKeySet(egressPermissionsPerSG)
+	unusedLoadBalancerSGs := loadBalancerSGs.Difference(usedLoadBalancerSGs)
+
+	for _, sgID := range unusedLoadBalancerSGs.List() {
+		err := m.sgReconciler.ReconcileEgress(ctx, sgID, nil)
+		if err != nil {
+			if isEC2SecurityGroupNotFoundError(err) {
+				m.unTrackLoadBalancerSGs(ctx, sgID)
+				continue
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// isLoadBalancerSG checks whether the securityGroup is created by this controller for loadBalancers.
+// we consider a securityGroup as a loadBalancer securityGroup if it have the controller's cluster tag.
+// the securityGroup info is served from the cache of sgManager.
+func (m *defaultNetworkingManager) isLoadBalancerSG(ctx context.Context, sgID string) (bool, error) {
+	sgInfoByID, err := m.sgManager.FetchSGInfosByID(ctx, []string{sgID})
+	if err != nil {
+		if isEC2SecurityGroupNotFoundError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	sgInfo, exists := sgInfoByID[sgID]
+	if !exists {
+		return false, nil
+	}
+	return sgInfo.Tags[shared_constants.TagKeyK8sCluster] == m.clusterName, nil
+}
+
+// computeEgressPermissionsPerSG computes the egress permissions per source SecurityGroup that mirror the ingress permissions per endpoint SecurityGroup of each TargetGroupBinding.
+// ingress permissions referencing source SecurityGroups are mirrored onto these SecurityGroups.
+// ingress permissions from CIDRs (e.g. preserved client IPs) are mirrored onto the source SecurityGroups of the same TargetGroupBinding,
+// since the traffic towards these ports still leaves the loadBalancer through them.
+func computeEgressPermissionsPerSG(ingressPermissionsPerSGByTGB map[types.NamespacedName]map[string][]IPPermissionInfo) map[string][]IPPermissionInfo {
+	permByHashCodePerSG := make(map[string]map[string]IPPermissionInfo)
+	addEgressPermission := func(sourceSGID string, endpointSGID string, permission IPPermissionInfo) {
+		egressPermission := NewGroupIDIPPermission(awssdk.ToString(permission.Permission.IpProtocol),
+			permission.Permission.FromPort, permission.Permission.ToPort, endpointSGID, permission.Labels)
+		if _, ok := permByHashCodePerSG[sourceSGID]; !ok {
+			permByHashCodePerSG[sourceSGID] = make(map[string]IPPermissionInfo)
+		}
+		permByHashCodePerSG[sourceSGID][egressPermission.HashCode()] = egressPermission
+	}
+
+	for _, ingressPermissionsPerSG := range ingressPermissionsPerSGByTGB {
+		sourceSGIDs := sets.NewString()
+		for endpointSGID, permissions := range ingressPermissionsPerSG {
+			for _, permission := range permissions {
+				for _, groupPair := range permission.Permission.UserIdGroupPairs {
+					sourceSGID := awssdk.ToString(groupPair.GroupId)
+					if sourceSGID == "" {
+						continue
+					}
+					sourceSGIDs.Insert(sourceSGID)
+					addEgressPermission(sourceSGID, endpointSGID, permission)
+				}
+			}
+		}
+		for endpointSGID, permissions := range ingressPermissionsPerSG {
+			for _, permission := range permissions {
+				if len(permission.Permission.IpRanges) == 0 && len(permission.Permission.Ipv6Ranges) == 0 {
+					continue
+				}
+				for _, sourceSGID := range sourceSGIDs.List() {
+					addEgressPermission(sourceSGID, endpointSGID, permission)
+				}
+			}
+		}
+	}
+	egressPermissionsPerSG := make(map[string][]IPPermissionInfo, len(permByHashCodePerSG))
+	for sgID, permByHashCode := range permByHashCodePerSG {
+		perms := make([]IPPermissionInfo, 0, len(permByHashCode))
+		for _, hashCode := range sets.StringKeySet(permByHashCode).List() {
+			perms = append(perms, permByHashCode[hashCode])
+		}
+		egressPermissionsPerSG[sgID] = perms
+	}
+	return egressPermissionsPerSG
+}
+
 // fetchTGBsWithNetworking returns all targetGroupsBindings with networking rules in cluster.
 func (m *defaultNetworkingManager) fetchTGBsWithNetworking(ctx context.Context) (map[types.NamespacedName]*elbv2api.TargetGroupBinding, error) {
 	tgbList := &elbv2api.TargetGroupBindingList{}
@@ -609,6 +781,50 @@ func (m *defaultNetworkingManager) unTrackEndpointSGs(_ context.Context, sgIDs .
 	m.trackedEndpointSGs.Delete(sgIDs...)
 }
 
+// fetchLoadBalancerSGs will return tracked loadBalancer SecurityGroups.
+func (m *defaultNetworkingManager) fetchLoadBalancerSGs(ctx context.Context) (sets.String, error) {
+	if !m.trackedLoadBalancerSGsInitialized {
+		loadBalancerSGs, err := m.fetchLoadBalancerSGsFromAWS(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m.trackLoadBalancerSGs(ctx, loadBalancerSGs...)
+		m.trackedLoadBalancerSGsInitialized = true
+	}
+	return m.trackedLoadBalancerSGs, nil
+}
+
+// trackLoadBalancerSGs will track these loadBalancer SecurityGroups.
+func (m *defaultNetworkingManager) trackLoadBalancerSGs(_ context.Context, sgIDs ...string) {
+	m.trackedLoadBalancerSGs.Insert(sgIDs...)
+}
+
+// unTrackLoadBalancerSGs will unTrack these loadBalancer SecurityGroups.
+func (m *defaultNetworkingManager) unTrackLoadBalancerSGs(_ context.Context, sgIDs ...string) {
+	m.trackedLoadBalancerSGs.Delete(sgIDs...)
+}
+
+// fetchLoadBalancerSGsFromAWS will return all loadBalancer securityGroups created by this controller from AWS API.
+func (m *defaultNetworkingManager) fetchLoadBalancerSGsFromAWS(ctx context.Context) ([]string, error) {
+	req := &ec2sdk.DescribeSecurityGroupsInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   awssdk.String("tag:" + shared_constants.TagKeyK8sCluster),
+				Values: []string{m.clusterName},
+			},
+			{
+				Name:   awssdk.String("vpc-id"),
+				Values: []string{m.vpcID},
+			},
+		},
+	}
+	sgInfoByID, err := m.sgManager.FetchSGInfosByRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return sets.StringKeySet(sgInfoByID).List(), nil
+}
+
 // fetchEndpointSGsFromAWS will return all endpoint securityGroups from AWS API.
 // we consider a securityGroup as a endpoint securityGroup if it have the cluster tag.
 // note: not all endpoint securityGroup have the cluster Tag(e.g. if a ENI only have a single securityGroup, it will still be used as endpoint securityGroup)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -19,6 +20,8 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/v3/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/shard"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/shared_constants"
 )
 
 func Test_defaultNetworkingManager_computeIngressPermissionsForTGBNetworking(t *testing.T) {
@@ -2059,6 +2062,7 @@ func Test_AttemptGarbageCollection(t *testing.T) {
 				ingressPermissionsPerSGByTGB: make(map[types.NamespacedName]map[string][]IPPermissionInfo),
 				trackedEndpointSGs:           sets.NewString(),
 				sgReconciler:                 mockReconciler,
+				shardManager:                 shard.NewNoopManager(),
 			}
 
 			for _, nsn := range tt.cachedTgbs {
@@ -2084,7 +2088,8 @@ type reconcileIngressCall struct {
 	opts               []SecurityGroupReconcileOption
 }
 type mockSGReconciler struct {
-	calls []reconcileIngressCall
+	calls       []reconcileIngressCall
+	egressCalls []reconcileIngressCall
 }
 
 func (m *mockSGReconciler) ReconcileIngress(ctx context.Context, sgID string, desiredPermissions []IPPermissionInfo, opts ...SecurityGroupReconcileOption) error {
@@ -2095,3 +2100,233 @@ func (m *mockSGReconciler) ReconcileIngress(ctx context.Context, sgID string, de
 	})
 	return nil
 }
+
+func (m *mockSGReconciler) ReconcileEgress(ctx context.Context, sgID string, desiredPermissions []IPPermissionInfo, opts ...SecurityGroupReconcileOption) error {
+	m.egressCalls = append(m.egressCalls, reconcileIngressCall{
+		sgID:               sgID,
+		desiredPermissions: desiredPermissions,
+		opts:               opts,
+	})
+	return nil
+}
+
+func Test_computeEgressPermissionsPerSG(t *testing.T) {
+	tgbLabels := map[string]string{tgbNetworkingIPPermissionLabelKey: tgbNetworkingIPPermissionLabelValue}
+	tgbA := types.NamespacedName{Namespace: "ns", Name: "tgb-a"}
+	tgbB := types.NamespacedName{Namespace: "ns", Name: "tgb-b"}
+	tests := []struct {
+		name                         string
+		ingressPermissionsPerSGByTGB map[types.NamespacedName]map[string][]IPPermissionInfo
+		want                         map[string][]IPPermissionInfo
+	}{
+		{
+			name:                         "no ingress permissions",
+			ingressPermissionsPerSGByTGB: nil,
+			want:                         map[string][]IPPermissionInfo{},
+		},
+		{
+			name: "source securityGroup permissions are mirrored",
+			ingressPermissionsPerSGByTGB: map[types.NamespacedName]map[string][]IPPermissionInfo{
+				tgbA: {
+					"sg-node-a": {
+						NewGroupIDIPPermission("tcp", awssdk.Int32(8080), awssdk.Int32(8080), "sg-backend", tgbLabels),
+					},
+					"sg-node-b": {
+						NewGroupIDIPPermission("tcp", awssdk.Int32(8080), awssdk.Int32(8080), "sg-backend", tgbLabels),
+					},
+				},
+				tgbB: {
+					"sg-node-b": {
+						NewGroupIDIPPermission("udp", awssdk.Int32(53), awssdk.Int32(53), "sg-lb", tgbLabels),
+					},
+				},
+			},
+			want: map[string][]IPPermissionInfo{
+				"sg-backend": {
+					NewGroupIDIPPermission("tcp", awssdk.Int32(8080), awssdk.Int32(8080), "sg-node-a", tgbLabels),
+					NewGroupIDIPPermission("tcp", awssdk.Int32(8080), awssdk.Int32(8080), "sg-node-b", tgbLabels),
+				},
+				"sg-lb": {
+					NewGroupIDIPPermission("udp", awssdk.Int32(53), awssdk.Int32(53), "sg-node-b", tgbLabels),
+				},
+			},
+		},
+		{
+			name: "CIDR permissions are mirrored onto the source securityGroups of the same TargetGroupBinding",
+			ingressPermissionsPerSGByTGB: map[types.NamespacedName]map[string][]IPPermissionInfo{
+				tgbA: {
+					"sg-node-a": {
+						NewGroupIDIPPermission("tcp", awssdk.Int32(8080), awssdk.Int32(8080), "sg-backend", tgbLabels),
+						NewCIDRIPPermission("tcp", awssdk.Int32(9090), awssdk.Int32(9090), "10.0.0.0/16", tgbLabels),
+					},
+					"sg-node-b": {
+						NewCIDRv6IPPermission("tcp", awssdk.Int32(9090), awssdk.Int32(9090), "2001:db8::/32", tgbLabels),
+					},
+				},
+				tgbB: {
+					"sg-node-c": {
+						NewCIDRIPPermission("tcp", awssdk.Int32(9090), awssdk.Int32(9090), "10.0.0.0/16", tgbLabels),
+					},
+				},
+			},
+			want: map[string][]IPPermissionInfo{
+				"sg-backend": {
+					NewGroupIDIPPermission("tcp", awssdk.Int32(8080), awssdk.Int32(8080), "sg-node-a", tgbLabels),
+					NewGroupIDIPPermission("tcp", awssdk.Int32(9090), awssdk.Int32(9090), "sg-node-a", tgbLabels),
+					NewGroupIDIPPermission("tcp", awssdk.Int32(9090), awssdk.Int32(9090), "sg-node-b", tgbLabels),
+				},
+			},
+		},
+		{
+			name: "duplicate permissions are merged",
+			ingressPermissionsPerSGByTGB: map[types.NamespacedName]map[string][]IPPermissionInfo{
+				tgbA: {
+					"sg-node-a": {
+						NewGroupIDIPPermission("tcp", awssdk.Int32(80), awssdk.Int32(8080), "sg-backend", tgbLabels),
+					},
+				},
+				tgbB: {
+					"sg-node-a": {
+						NewGroupIDIPPermission("tcp", awssdk.Int32(80), awssdk.Int32(8080), "sg-backend", tgbLabels),
+					},
+				},
+			},
+			want: map[string][]IPPermissionInfo{
+				"sg-backend": {
+					NewGroupIDIPPermission("tcp", awssdk.Int32(80), awssdk.Int32(8080), "sg-node-a", tgbLabels),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := computeEgressPermissionsPerSG(tt.ingressPermissionsPerSGByTGB)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_defaultNetworkingManager_reconcileEgressForLoadBalancerSGs(t *testing.T) {
+	tgbLabels := map[string]string{tgbNetworkingIPPermissionLabelKey: tgbNetworkingIPPermissionLabelValue}
+	ingressPermissionsPerSGByTGB := map[types.NamespacedName]map[string][]IPPermissionInfo{
+		{Namespace: "ns", Name: "tgb"}: {
+			"sg-node": {
+				NewGroupIDIPPermission("tcp", awssdk.Int32(8080), awssdk.Int32(8080), "sg-backend", tgbLabels),
+				NewGroupIDIPPermission("tcp", awssdk.Int32(8443), awssdk.Int32(8443), "sg-user-provided", tgbLabels),
+			},
+		},
+	}
+	tests := []struct {
+		name                            string
+		computedForAllTGBs              bool
+		trackedLoadBalancerSGs          sets.String
+		loadBalancerSGsFromAWS          map[string]SecurityGroupInfo
+		wantEgressCalls                 []reconcileIngressCall
+		wantTrackedLoadBalancerSGs      sets.String
+		wantFetchLoadBalancerSGsFromAWS bool
+	}{
+		{
+			name:                   "computed for all TargetGroupBindings, unused loadBalancer securityGroups are revoked",
+			computedForAllTGBs:     true,
+			trackedLoadBalancerSGs: sets.NewString("sg-deleted-tgb"),
+			wantEgressCalls: []reconcileIngressCall{
+				{
+					sgID: "sg-backend",
+					desiredPermissions: []IPPermissionInfo{
+						NewGroupIDIPPermission("tcp", awssdk.Int32(8080), awssdk.Int32(8080), "sg-node", tgbLabels),
+					},
+				},
+				{
+					sgID: "sg-deleted-tgb",
+				},
+			},
+			wantTrackedLoadBalancerSGs: sets.NewString("sg-backend", "sg-deleted-tgb"),
+		},
+		{
+			name:                   "not computed for all TargetGroupBindings, unused loadBalancer securityGroups are left untouched",
+			computedForAllTGBs:     false,
+			trackedLoadBalancerSGs: sets.NewString("sg-deleted-tgb"),
+			wantEgressCalls: []reconcileIngressCall{
+				{
+					sgID: "sg-backend",
+					desiredPermissions: []IPPermissionInfo{
+						NewGroupIDIPPermission("tcp", awssdk.Int32(8080), awssdk.Int32(8080), "sg-node", tgbLabels),
+					},
+				},
+			},
+			wantTrackedLoadBalancerSGs: sets.NewString("sg-backend", "sg-deleted-tgb"),
+		},
+		{
+			name:               "loadBalancer securityGroups are initialized from AWS",
+			computedForAllTGBs: true,
+			loadBalancerSGsFromAWS: map[string]SecurityGroupInfo{
+				"sg-backend":    {SecurityGroupID: "sg-backend"},
+				"sg-frontend-a": {SecurityGroupID: "sg-frontend-a"},
+			},
+			wantEgressCalls: []reconcileIngressCall{
+				{
+					sgID: "sg-backend",
+					desiredPermissions: []IPPermissionInfo{
+						NewGroupIDIPPermission("tcp", awssdk.Int32(8080), awssdk.Int32(8080), "sg-node", tgbLabels),
+					},
+				},
+				{
+					sgID: "sg-frontend-a",
+				},
+			},
+			wantTrackedLoadBalancerSGs:      sets.NewString("sg-backend", "sg-frontend-a"),
+			wantFetchLoadBalancerSGsFromAWS: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			sgManager := NewMockSecurityGroupManager(ctrl)
+			sgManager.EXPECT().FetchSGInfosByID(gomock.Any(), []string{"sg-backend"}).Return(map[string]SecurityGroupInfo{
+				"sg-backend": {SecurityGroupID: "sg-backend", Tags: map[string]string{shared_constants.TagKeyK8sCluster: "cluster"}},
+			}, nil)
+			sgManager.EXPECT().FetchSGInfosByID(gomock.Any(), []string{"sg-user-provided"}).Return(map[string]SecurityGroupInfo{
+				"sg-user-provided": {SecurityGroupID: "sg-user-provided"},
+			}, nil)
+			if tt.wantFetchLoadBalancerSGsFromAWS {
+				sgManager.EXPECT().FetchSGInfosByRequest(gomock.Any(), &ec2sdk.DescribeSecurityGroupsInput{
+					Filters: []ec2types.Filter{
+						{
+							Name:   awssdk.String("tag:" + shared_constants.TagKeyK8sCluster),
+							Values: []string{"cluster"},
+						},
+						{
+							Name:   awssdk.String("vpc-id"),
+							Values: []string{"vpc-1"},
+						},
+					},
+				}).Return(tt.loadBalancerSGsFromAWS, nil)
+			}
+			trackedLoadBalancerSGs := sets.NewString()
+			if tt.trackedLoadBalancerSGs != nil {
+				trackedLoadBalancerSGs = tt.trackedLoadBalancerSGs
+			}
+			mockReconciler := &mockSGReconciler{}
+			m := &defaultNetworkingManager{
+				sgManager:                         sgManager,
+				sgReconciler:                      mockReconciler,
+				clusterName:                       "cluster",
+				vpcID:                             "vpc-1",
+				ingressPermissionsPerSGByTGB:      ingressPermissionsPerSGByTGB,
+				trackedLoadBalancerSGs:            trackedLoadBalancerSGs,
+				trackedLoadBalancerSGsInitialized: !tt.wantFetchLoadBalancerSGsFromAWS,
+			}
+
+			err := m.reconcileEgressForLoadBalancerSGs(context.Background(), tt.computedForAllTGBs)
+			assert.NoError(t, err)
+			assert.Equal(t, len(tt.wantEgressCalls), len(mockReconciler.egressCalls))
+			for i, call := range mockReconciler.egressCalls {
+				assert.Equal(t, tt.wantEgressCalls[i].sgID, call.sgID)
+				assert.Equal(t, tt.wantEgressCalls[i].desiredPermissions, call.desiredPermissions)
+			}
+			assert.Equal(t, tt.wantTrackedLoadBalancerSGs, m.trackedLoadBalancerSGs)
+			assert.Empty(t, mockReconciler.calls)
+		})
+	}
+}
@@ -53,6 +53,12 @@ type SecurityGroupManager interface {
 
 	// RevokeSGIngress will revoke Ingress permissions from SecurityGroup.
 	RevokeSGIngress(ctx context.Context, sgID string, permissions []IPPermissionInfo) error
+
+	// AuthorizeSGEgress will authorize Egress permissions to SecurityGroup.
+	AuthorizeSGEgress(ctx context.Context, sgID string, permissions []IPPermissionInfo) error
+
+	// RevokeSGEgress will revoke Egress permissions from SecurityGroup.
+	RevokeSGEgress(ctx context.Context, sgID string, permissions []IPPermissionInfo) error
 }
 
 // NewDefaultSecurityGroupManager constructs new defaultSecurityGroupManager.
@@ -166,6 +172,44 @@ func (m *defaultSecurityGroupManager) RevokeSGIngress(ctx context.Context, sgID
 	return nil
 }
 
+func (m *defaultSecurityGroupManager) AuthorizeSGEgress(ctx context.Context, sgID string, permissions []IPPermissionInfo) error {
+	sdkIPPermissions := buildSDKIPPermissions(permissions)
+	req := &ec2sdk.AuthorizeSecurityGroupEgressInput{
+		GroupId:       awssdk.String(sgID),
+		IpPermissions: sdkIPPermissions,
+	}
+	m.logger.Info("authorizing securityGroup egress",
+		"securityGroupID", sgID,
+		"permission", sdkIPPermissions)
+	if _, err := m.ec2Client.AuthorizeSecurityGroupEgressWithContext(ctx, req); err != nil {
+		return err
+	}
+	m.logger.Info("authorized securityGroup egress",
+		"securityGroupID", sgID)
+
+	m.clearSGInfosFromCache(sgID)
+	return nil
+}
+
+func (m *defaultSecurityGroupManager) RevokeSGEgress(ctx context.Context, sgID string, permissions []IPPermissionInfo) error {
+	sdkIPPermissions := buildSDKIPPermissions(permissions)
+	req := &ec2sdk.RevokeSecurityGroupEgressInput{
+		GroupId:       awssdk.String(sgID),
+		IpPermissions: sdkIPPermissions,
+	}
+	m.logger.Info("revoking securityGroup egress",
+		"securityGroupID", sgID,
+		"permission", sdkIPPermissions)
+	if _, err := m.ec2Client.RevokeSecurityGroupEgressWithContext(ctx, req); err != nil {
+		return err
+	}
+	m.logger.Info("revoked securityGroup egress",
+		"securityGroupID", sgID)
+
+	m.clearSGInfosFromCache(sgID)
+	return nil
+}
+
 func (m *defaultSecurityGroupManager) fetchSGInfosFromCache(sgIDs []string) map[string]SecurityGroupInfo {
 	m.sgInfoCacheMutex.RLock()
 	defer m.sgInfoCacheMutex.RUnlock()
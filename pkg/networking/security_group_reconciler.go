@@ -48,6 +48,9 @@ func WithAuthorizeOnly(authorizeOnly bool) SecurityGroupReconcileOption {
 type SecurityGroupReconciler interface {
 	// ReconcileIngress will reconcile Ingress permission on SecurityGroup to be desiredPermission.
 	ReconcileIngress(ctx context.Context, sgID string, desiredPermissions []IPPermissionInfo, opts ...SecurityGroupReconcileOption) error
+
+	// ReconcileEgress will reconcile Egress permission on SecurityGroup to be desiredPermission.
+	ReconcileEgress(ctx context.Context, sgID string, desiredPermissions []IPPermissionInfo, opts ...SecurityGroupReconcileOption) error
 }
 
 // NewDefaultSecurityGroupReconciler constructs new defaultSecurityGroupReconciler.
@@ -67,6 +70,14 @@ type defaultSecurityGroupReconciler struct {
 }
 
 func (r *defaultSecurityGroupReconciler) ReconcileIngress(ctx context.Context, sgID string, desiredPermissions []IPPermissionInfo, opts ...SecurityGroupReconcileOption) error {
+	return r.reconcilePermissions(ctx, sgID, desiredPermissions, ingressPermissionsAccessor{sgManager: r.sgManager}, opts...)
+}
+
+func (r *defaultSecurityGroupReconciler) ReconcileEgress(ctx context.Context, sgID string, desiredPermissions []IPPermissionInfo, opts ...SecurityGroupReconcileOption) error {
+	return r.reconcilePermissions(ctx, sgID, desiredPermissions, egressPermissionsAccessor{sgManager: r.sgManager}, opts...)
+}
+
+func (r *defaultSecurityGroupReconciler) reconcilePermissions(ctx context.Context, sgID string, desiredPermissions []IPPermissionInfo, accessor permissionsAccessor, opts ...SecurityGroupReconcileOption) error {
 	reconcileOpts := SecurityGroupReconcileOptions{
 		PermissionSelector: labels.Everything(),
 	}
@@ -78,49 +89,50 @@ func (r *defaultSecurityGroupReconciler) ReconcileIngress(ctx context.Context, s
 	}
 	sgInfo := sgInfoByID[sgID]
 
-	if err := r.reconcileIngressWithSGInfo(ctx, sgInfo, desiredPermissions, false, reconcileOpts); err != nil {
+	if err := r.reconcilePermissionsWithSGInfo(ctx, sgInfo, desiredPermissions, accessor, false, reconcileOpts); err != nil {
 		if !r.shouldRetryWithoutCache(err) {
 			return err
 		}
 		revokeFirst := r.shouldRemoveSGRulesFirst(err)
-		r.logger.Info("Retrying ReconcileIngress without using cache", "revokeFirst", revokeFirst)
+		r.logger.Info("Retrying securityGroup reconcile without using cache", "direction", accessor.direction(), "revokeFirst", revokeFirst)
 		sgInfoByID, err := r.sgManager.FetchSGInfosByID(ctx, []string{sgID}, WithReloadIgnoringCache())
 		if err != nil {
 			return err
 		}
 		sgInfo := sgInfoByID[sgID]
-		return r.reconcileIngressWithSGInfo(ctx, sgInfo, desiredPermissions, revokeFirst, reconcileOpts)
+		return r.reconcilePermissionsWithSGInfo(ctx, sgInfo, desiredPermissions, accessor, revokeFirst, reconcileOpts)
 	}
 	return nil
 }
 
-func (r *defaultSecurityGroupReconciler) reconcileIngressWithSGInfo(ctx context.Context, sgInfo SecurityGroupInfo, desiredPermissions []IPPermissionInfo, revokeFirst bool, reconcileOpts SecurityGroupReconcileOptions) error {
-	extraPermissions := diffIPPermissionInfos(sgInfo.Ingress, desiredPermissions)
+func (r *defaultSecurityGroupReconciler) reconcilePermissionsWithSGInfo(ctx context.Context, sgInfo SecurityGroupInfo, desiredPermissions []IPPermissionInfo, accessor permissionsAccessor, revokeFirst bool, reconcileOpts SecurityGroupReconcileOptions) error {
+	currentPermissions := accessor.current(sgInfo)
+	extraPermissions := diffIPPermissionInfos(currentPermissions, desiredPermissions)
 	permissionsToRevoke := make([]IPPermissionInfo, 0, len(extraPermissions))
 	for _, permission := range extraPermissions {
 		if reconcileOpts.PermissionSelector.Matches(labels.Set(permission.Labels)) {
 			permissionsToRevoke = append(permissionsToRevoke, permission)
 		}
 	}
-	permissionsToGrant := diffIPPermissionInfos(desiredPermissions, sgInfo.Ingress)
+	permissionsToGrant := diffIPPermissionInfos(desiredPermissions, currentPermissions)
 
 	if revokeFirst {
 		if len(permissionsToRevoke) > 0 && !reconcileOpts.AuthorizeOnly {
-			if err := r.sgManager.RevokeSGIngress(ctx, sgInfo.SecurityGroupID, permissionsToRevoke); err != nil {
+			if err := accessor.revoke(ctx, sgInfo.SecurityGroupID, permissionsToRevoke); err != nil {
 				return err
 			}
 		}
 	}
 
 	if len(permissionsToGrant) > 0 {
-		if err := r.sgManager.AuthorizeSGIngress(ctx, sgInfo.SecurityGroupID, permissionsToGrant); err != nil {
+		if err := accessor.authorize(ctx, sgInfo.SecurityGroupID, permissionsToGrant); err != nil {
 			return err
 		}
 	}
 
 	if !revokeFirst {
 		if len(permissionsToRevoke) > 0 && !reconcileOpts.AuthorizeOnly {
-			if err := r.sgManager.RevokeSGIngress(ctx, sgInfo.SecurityGroupID, permissionsToRevoke); err != nil {
+			if err := accessor.revoke(ctx, sgInfo.SecurityGroupID, permissionsToRevoke); err != nil {
 				return err
 			}
 		}
@@ -129,6 +141,54 @@ func (r *defaultSecurityGroupReconciler) reconcileIngressWithSGInfo(ctx context.
 	return nil
 }
 
+// permissionsAccessor abstracts the direction(ingress or egress) of permissions being reconciled.
+type permissionsAccessor interface {
+	direction() string
+	current(sgInfo SecurityGroupInfo) []IPPermissionInfo
+	authorize(ctx context.Context, sgID string, permissions []IPPermissionInfo) error
+	revoke(ctx context.Context, sgID string, permissions []IPPermissionInfo) error
+}
+
+type ingressPermissionsAccessor struct {
+	sgManager SecurityGroupManager
+}
+
+func (a ingressPermissionsAccessor) direction() string {
+	return "ingress"
+}
+
+func (a ingressPermissionsAccessor) current(sgInfo SecurityGroupInfo) []IPPermissionInfo {
+	return sgInfo.Ingress
+}
+
+func (a ingressPermissionsAccessor) authorize(ctx context.Context, sgID string, permissions []IPPermissionInfo) error {
+	return a.sgManager.AuthorizeSGIngress(ctx, sgID, permissions)
+}
+
+func (a ingressPermissionsAccessor) revoke(ctx context.Context, sgID string, permissions []IPPermissionInfo) error {
+	return a.sgManager.RevokeSGIngress(ctx, sgID, permissions)
+}
+
+type egressPermissionsAccessor struct {
+	sgManager SecurityGroupManager
+}
+
+func (a egressPermissionsAccessor) direction() string {
+	return "egress"
+}
+
+func (a egressPermissionsAccessor) current(sgInfo SecurityGroupInfo) []IPPermissionInfo {
+	return sgInfo.Egress
+}
+
+func (a egressPermissionsAccessor) authorize(ctx context.Context, sgID string, permissions []IPPermissionInfo) error {
+	return a.sgManager.AuthorizeSGEgress(ctx, sgID, permissions)
+}
+
+func (a egressPermissionsAccessor) revoke(ctx context.Context, sgID string, permissions []IPPermissionInfo) error {
+	return a.sgManager.RevokeSGEgress(ctx, sgID, permissions)
+}
+
 // shouldRetryWithoutCache tests whether we should retry SecurityGroup rules reconcile without cache.
 func (r *defaultSecurityGroupReconciler) shouldRetryWithoutCache(err error) bool {
 	var apiErr smithy.APIError
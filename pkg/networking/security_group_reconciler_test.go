@@ -593,3 +593,93 @@ func TestReconcileSGIngress_RehydrateCache(t *testing.T) {
 		})
 	}
 }
+
+func TestReconcileSGEgress(t *testing.T) {
+	sgId := "sgId"
+	allowAllEgress := IPPermissionInfo{
+		Permission: ec2types.IpPermission{
+			IpProtocol: awssdk.String("-1"),
+			IpRanges: []ec2types.IpRange{
+				{
+					CidrIp: awssdk.String("0.0.0.0/0"),
+				},
+			},
+		},
+	}
+	targetEgress := IPPermissionInfo{
+		Permission: ec2types.IpPermission{
+			FromPort:   awssdk.Int32(8080),
+			ToPort:     awssdk.Int32(8080),
+			IpProtocol: awssdk.String("tcp"),
+			UserIdGroupPairs: []ec2types.UserIdGroupPair{
+				{
+					GroupId: awssdk.String("sg-target"),
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		inputSGRules   []IPPermissionInfo
+		sgInfo         SecurityGroupInfo
+		authorizeOnly  bool
+		authorizeData  []IPPermissionInfo
+		revokeData     []IPPermissionInfo
+		authorizeCalls int
+		revokeCalls    int
+	}{
+		{
+			name:         "default egress should be replaced with desired egress",
+			inputSGRules: []IPPermissionInfo{targetEgress},
+			sgInfo: SecurityGroupInfo{
+				SecurityGroupID: sgId,
+				Egress:          []IPPermissionInfo{allowAllEgress},
+			},
+			authorizeData:  []IPPermissionInfo{targetEgress},
+			revokeData:     []IPPermissionInfo{allowAllEgress},
+			authorizeCalls: 1,
+			revokeCalls:    1,
+		},
+		{
+			name:         "default egress should be kept when authorize only",
+			inputSGRules: []IPPermissionInfo{targetEgress},
+			sgInfo: SecurityGroupInfo{
+				SecurityGroupID: sgId,
+				Egress:          []IPPermissionInfo{allowAllEgress},
+			},
+			authorizeOnly:  true,
+			authorizeData:  []IPPermissionInfo{targetEgress},
+			authorizeCalls: 1,
+		},
+		{
+			name:         "ingress permissions should not be considered for egress",
+			inputSGRules: []IPPermissionInfo{targetEgress},
+			sgInfo: SecurityGroupInfo{
+				SecurityGroupID: sgId,
+				Ingress:         []IPPermissionInfo{targetEgress},
+				Egress:          []IPPermissionInfo{targetEgress},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			sgManager := NewMockSecurityGroupManager(ctrl)
+			reconciler := &defaultSecurityGroupReconciler{
+				sgManager: sgManager,
+				logger:    logr.New(&log.NullLogSink{}),
+			}
+
+			sgManager.EXPECT().FetchSGInfosByID(gomock.Any(), []string{sgId}).Return(map[string]SecurityGroupInfo{sgId: tt.sgInfo}, nil)
+			sgManager.EXPECT().AuthorizeSGEgress(gomock.Any(), sgId, tt.authorizeData).Return(nil).Times(tt.authorizeCalls)
+			sgManager.EXPECT().RevokeSGEgress(gomock.Any(), sgId, tt.revokeData).Return(nil).Times(tt.revokeCalls)
+
+			err := reconciler.ReconcileEgress(context.Background(), sgId, tt.inputSGRules, WithAuthorizeOnly(tt.authorizeOnly))
+			assert.NoError(t, err)
+		})
+	}
+}
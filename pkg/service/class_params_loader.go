@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/v3/apis/elbv2/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ClassParamsLoader loads the ServiceClassParams for Services.
+type ClassParamsLoader interface {
+	// Load returns the ServiceClassParams for the loadBalancerClass of the Service, or nil if there is none.
+	// Services without loadBalancerClass use the ServiceClassParams for the loadBalancerClass of the controller.
+	// It returns an error if the namespace of the Service isn't allowed by the ServiceClassParams.
+	Load(ctx context.Context, svc *corev1.Service) (*elbv2api.ServiceClassParams, error)
+}
+
+// NewDefaultClassParamsLoader constructs new defaultClassParamsLoader.
+func NewDefaultClassParamsLoader(k8sClient client.Client, loadBalancerClass string) *defaultClassParamsLoader {
+	return &defaultClassParamsLoader{
+		k8sClient:         k8sClient,
+		loadBalancerClass: loadBalancerClass,
+	}
+}
+
+var _ ClassParamsLoader = &defaultClassParamsLoader{}
+
+type defaultClassParamsLoader struct {
+	k8sClient client.Client
+	// loadBalancerClass is the loadBalancerClass of the controller, it applies to the Services without loadBalancerClass.
+	loadBalancerClass string
+}
+
+func (l *defaultClassParamsLoader) Load(ctx context.Context, svc *corev1.Service) (*elbv2api.ServiceClassParams, error) {
+	loadBalancerClass := ServiceLoadBalancerClass(svc, l.loadBalancerClass)
+	if loadBalancerClass == "" {
+		return nil, nil
+	}
+	classParams, err := l.findClassParams(ctx, loadBalancerClass)
+	if err != nil || classParams == nil {
+		return nil, err
+	}
+	if err := l.validateNamespaceRestriction(ctx, svc, classParams); err != nil {
+		return nil, err
+	}
+	return classParams, nil
+}
+
+func (l *defaultClassParamsLoader) validateNamespaceRestriction(ctx context.Context, svc *corev1.Service, classParams *elbv2api.ServiceClassParams) error {
+	// when namespaceSelector is empty, it matches every namespace
+	if classParams.Spec.NamespaceSelector == nil {
+		return nil
+	}
+	svcNS := &corev1.Namespace{}
+	if err := l.k8sClient.Get(ctx, types.NamespacedName{Name: svc.Namespace}, svcNS); err != nil {
+		return err
+	}
+	selector, err := metav1.LabelSelectorAsSelector(classParams.Spec.NamespaceSelector)
+	if err != nil {
+		return err
+	}
+	if !selector.Matches(labels.Set(svcNS.Labels)) {
+		return errors.Errorf("namespaceSelector of ServiceClassParams %v mismatch", classParams.Name)
+	}
+	return nil
+}
+
+// ServiceLoadBalancerClass returns the loadBalancerClass of the Service, or the loadBalancerClass of the controller if the Service doesn't specify one.
+// Services without loadBalancerClass are managed by the controller via the load balancer type annotation.
+func ServiceLoadBalancerClass(svc *corev1.Service, controllerLoadBalancerClass string) string {
+	if svc.Spec.LoadBalancerClass == nil || *svc.Spec.LoadBalancerClass == "" {
+		return controllerLoadBalancerClass
+	}
+	return *svc.Spec.LoadBalancerClass
+}
+
+// findClassParams finds the ServiceClassParams for the loadBalancerClass, or nil if there is none.
+// It returns an error if multiple ServiceClassParams are for the loadBalancerClass.
+func (l *defaultClassParamsLoader) findClassParams(ctx context.Context, loadBalancerClass string) (*elbv2api.ServiceClassParams, error) {
+	classParamsList := &elbv2api.ServiceClassParamsList{}
+	if err := l.k8sClient.List(ctx, classParamsList); err != nil {
+		// ServiceClassParams CRD is not installed.
+		if meta.IsNoMatchError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "failed to list ServiceClassParams")
+	}
+	var matchedClassParams *elbv2api.ServiceClassParams
+	for i := range classParamsList.Items {
+		if classParamsList.Items[i].Spec.LoadBalancerClass != loadBalancerClass {
+			continue
+		}
+		if matchedClassParams != nil {
+			return nil, errors.Errorf("multiple ServiceClassParams found for loadBalancerClass %v: %v, %v",
+				loadBalancerClass, matchedClassParams.Name, classParamsList.Items[i].Name)
+		}
+		matchedClassParams = &classParamsList.Items[i]
+	}
+	return matchedClassParams, nil
+}
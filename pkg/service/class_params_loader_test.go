@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/v3/apis/elbv2/v1beta1"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_defaultClassParamsLoader_Load(t *testing.T) {
+	newClassParams := func(name string, loadBalancerClass string, namespaceSelector *metav1.LabelSelector) *elbv2api.ServiceClassParams {
+		return &elbv2api.ServiceClassParams{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: elbv2api.ServiceClassParamsSpec{
+				LoadBalancerClass: loadBalancerClass,
+				NamespaceSelector: namespaceSelector,
+			},
+		}
+	}
+	teamSelector := &metav1.LabelSelector{MatchLabels: map[string]string{"team": "awesome"}}
+
+	tests := []struct {
+		name              string
+		loadBalancerClass *string
+		namespaceLabels   map[string]string
+		classParamsList   []*elbv2api.ServiceClassParams
+		want              *elbv2api.ServiceClassParams
+		wantErr           error
+	}{
+		{
+			name:              "service without loadBalancerClass uses ServiceClassParams for loadBalancerClass of controller",
+			loadBalancerClass: nil,
+			classParamsList: []*elbv2api.ServiceClassParams{
+				newClassParams("params-1", "example.com/nlb", nil),
+				newClassParams("params-2", "service.k8s.aws/nlb", nil),
+			},
+			want: newClassParams("params-2", "service.k8s.aws/nlb", nil),
+		},
+		{
+			name:              "service with empty loadBalancerClass uses ServiceClassParams for loadBalancerClass of controller",
+			loadBalancerClass: awssdk.String(""),
+			namespaceLabels:   map[string]string{"team": "other"},
+			classParamsList: []*elbv2api.ServiceClassParams{
+				newClassParams("params-1", "service.k8s.aws/nlb", teamSelector),
+			},
+			wantErr: errors.New("namespaceSelector of ServiceClassParams params-1 mismatch"),
+		},
+		{
+			name:              "service without loadBalancerClass and no ServiceClassParams for loadBalancerClass of controller",
+			loadBalancerClass: nil,
+			classParamsList: []*elbv2api.ServiceClassParams{
+				newClassParams("params-1", "example.com/nlb", nil),
+			},
+			want: nil,
+		},
+		{
+			name:              "no ServiceClassParams for loadBalancerClass",
+			loadBalancerClass: awssdk.String("service.k8s.aws/nlb"),
+			classParamsList: []*elbv2api.ServiceClassParams{
+				newClassParams("params-1", "example.com/nlb", nil),
+			},
+			want: nil,
+		},
+		{
+			name:              "ServiceClassParams found for loadBalancerClass",
+			loadBalancerClass: awssdk.String("service.k8s.aws/nlb"),
+			namespaceLabels:   map[string]string{"team": "awesome"},
+			classParamsList: []*elbv2api.ServiceClassParams{
+				newClassParams("params-1", "example.com/nlb", nil),
+				newClassParams("params-2", "service.k8s.aws/nlb", teamSelector),
+			},
+			want: newClassParams("params-2", "service.k8s.aws/nlb", teamSelector),
+		},
+		{
+			name:              "multiple ServiceClassParams for loadBalancerClass",
+			loadBalancerClass: awssdk.String("service.k8s.aws/nlb"),
+			classParamsList: []*elbv2api.ServiceClassParams{
+				newClassParams("params-1", "service.k8s.aws/nlb", nil),
+				newClassParams("params-2", "service.k8s.aws/nlb", nil),
+			},
+			wantErr: errors.New("multiple ServiceClassParams found for loadBalancerClass service.k8s.aws/nlb: params-1, params-2"),
+		},
+		{
+			name:              "namespace not allowed by ServiceClassParams",
+			loadBalancerClass: awssdk.String("service.k8s.aws/nlb"),
+			namespaceLabels:   map[string]string{"team": "other"},
+			classParamsList: []*elbv2api.ServiceClassParams{
+				newClassParams("params-1", "service.k8s.aws/nlb", teamSelector),
+			},
+			wantErr: errors.New("namespaceSelector of ServiceClassParams params-1 mismatch"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			assert.NoError(t, k8sClient.Create(ctx, &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{Name: "awesome-ns", Labels: tt.namespaceLabels},
+			}))
+			for _, classParams := range tt.classParamsList {
+				assert.NoError(t, k8sClient.Create(ctx, classParams.DeepCopy()))
+			}
+
+			loader := NewDefaultClassParamsLoader(k8sClient, "service.k8s.aws/nlb")
+			got, err := loader.Load(ctx, &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-svc"},
+				Spec: corev1.ServiceSpec{
+					LoadBalancerClass: tt.loadBalancerClass,
+				},
+			})
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+			if tt.want == nil {
+				assert.Nil(t, got)
+				return
+			}
+			assert.Equal(t, tt.want.Name, got.Name)
+			assert.Equal(t, tt.want.Spec, got.Spec)
+		})
+	}
+}
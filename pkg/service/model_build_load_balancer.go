@@ -13,6 +13,7 @@ import (
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/types"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/v3/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/config"
@@ -116,9 +117,8 @@ func (t *defaultModelBuildTask) buildLoadBalancerSecurityGroups(ctx context.Cont
 		}
 		return nil, nil
 	}
-	var sgNameOrIDs []string
 	var lbSGTokens []core.StringToken
-	t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixLoadBalancerSecurityGroups, &sgNameOrIDs, t.service.Annotations)
+	sgNameOrIDs := t.buildLoadBalancerSecurityGroupNameOrIDs()
 	if len(sgNameOrIDs) == 0 {
 		managedSG, err := t.buildManagedSecurityGroup(ctx, ipAddressType)
 		if err != nil {
@@ -164,6 +164,23 @@ func (t *defaultModelBuildTask) buildLoadBalancerSecurityGroups(ctx context.Cont
 	return lbSGTokens, nil
 }
 
+// buildLoadBalancerSecurityGroupNameOrIDs returns the names or IDs of the frontend security groups specified for the service.
+// The security groups enforced by the ServiceClassParams take precedence over the annotation, and the default security groups
+// of the ServiceClassParams apply when the annotation is absent.
+func (t *defaultModelBuildTask) buildLoadBalancerSecurityGroupNameOrIDs() []string {
+	if enforced := t.enforcedClassSettings(); enforced != nil && len(enforced.SecurityGroups) != 0 {
+		return enforced.SecurityGroups
+	}
+	var sgNameOrIDs []string
+	if exists := t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixLoadBalancerSecurityGroups, &sgNameOrIDs, t.service.Annotations); exists {
+		return sgNameOrIDs
+	}
+	if defaults := t.defaultClassSettings(); defaults != nil {
+		return defaults.SecurityGroups
+	}
+	return nil
+}
+
 func (t *defaultModelBuildTask) buildManageSecurityGroupRulesFlag(ctx context.Context) (bool, error) {
 	manageSGRules := t.enableManageBackendSGRules
 
@@ -229,6 +246,9 @@ func (t *defaultModelBuildTask) buildSecurityGroupsInboundRulesOnPrivateLink(_ c
 }
 
 func (t *defaultModelBuildTask) buildLoadBalancerScheme(ctx context.Context) (elbv2model.LoadBalancerScheme, error) {
+	if enforced := t.enforcedClassSettings(); enforced != nil && enforced.Scheme != nil {
+		return elbv2model.LoadBalancerScheme(*enforced.Scheme), nil
+	}
 	scheme, explicitSchemeSpecified, err := t.buildLoadBalancerSchemeViaAnnotation(ctx)
 	if err != nil {
 		return elbv2model.LoadBalancerSchemeInternal, err
@@ -250,6 +270,9 @@ func (t *defaultModelBuildTask) buildLoadBalancerScheme(ctx context.Context) (el
 			return "", errors.New("invalid load balancer scheme")
 		}
 	}
+	if defaults := t.defaultClassSettings(); defaults != nil && defaults.Scheme != nil {
+		return elbv2model.LoadBalancerScheme(*defaults.Scheme), nil
+	}
 	return t.defaultLoadBalancerScheme, nil
 }
 
@@ -312,16 +335,51 @@ func (t *defaultModelBuildTask) buildAdditionalResourceTags(_ context.Context) (
 			return nil, errors.Errorf("external managed tag key %v cannot be specified on Service", tagKey)
 		}
 	}
+	var enforcedClassTags, defaultClassTags map[string]string
+	if enforced := t.enforcedClassSettings(); enforced != nil {
+		enforcedClassTags = buildClassSettingsTags(enforced.Tags)
+	}
+	if defaults := t.defaultClassSettings(); defaults != nil {
+		defaultClassTags = buildClassSettingsTags(defaults.Tags)
+	}
+	for _, classTags := range []map[string]string{enforcedClassTags, defaultClassTags} {
+		for tagKey := range classTags {
+			if t.externalManagedTags.Has(tagKey) {
+				return nil, errors.Errorf("external managed tag key %v cannot be specified on ServiceClassParams %v", tagKey, t.classParams.Name)
+			}
+		}
+	}
+	svcTags := algorithm.MergeStringMap(enforcedClassTags, annotationTags, defaultClassTags)
 
 	if t.featureGates.Enabled(config.EnableDefaultTagsLowPriority) {
-		return algorithm.MergeStringMap(annotationTags, t.defaultTags), nil
+		return algorithm.MergeStringMap(svcTags, t.defaultTags), nil
 	}
-	mergedTags := algorithm.MergeStringMap(t.defaultTags, annotationTags)
+	mergedTags := algorithm.MergeStringMap(t.defaultTags, svcTags)
 	return mergedTags, nil
 }
 
+// buildClassSettingsTags builds the AWS Tags of ServiceClassParams settings.
+func buildClassSettingsTags(tags []elbv2api.Tag) map[string]string {
+	if len(tags) == 0 {
+		return nil
+	}
+	classTags := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		classTags[tag.Key] = tag.Value
+	}
+	return classTags
+}
+
 func (t *defaultModelBuildTask) buildLoadBalancerTags(ctx context.Context) (map[string]string, error) {
-	return t.buildAdditionalResourceTags(ctx)
+	additionalTags, err := t.buildAdditionalResourceTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+	federationGroupTags, err := shared_utils.BuildFederationGroupTags(t.service)
+	if err != nil {
+		return nil, err
+	}
+	return algorithm.MergeStringMap(federationGroupTags, additionalTags), nil
 }
 
 func (t *defaultModelBuildTask) buildLoadBalancerSubnetMappings(_ context.Context, ipAddressType elbv2model.IPAddressType, scheme elbv2model.LoadBalancerScheme, ec2Subnets []ec2types.Subnet, enablePrefixForIpv6SourceNat elbv2model.EnablePrefixForIpv6SourceNat) ([]elbv2model.SubnetMapping, error) {
@@ -434,6 +492,13 @@ func (t *defaultModelBuildTask) buildLoadBalancerSubnetMappings(_ context.Contex
 }
 
 func (t *defaultModelBuildTask) buildLoadBalancerSubnets(ctx context.Context, scheme elbv2model.LoadBalancerScheme, ipAddressType elbv2model.IPAddressType) ([]ec2types.Subnet, error) {
+	if enforced := t.enforcedClassSettings(); enforced != nil && enforced.Subnets != nil {
+		return t.subnetsResolver.ResolveViaSelector(ctx, *enforced.Subnets,
+			networking.WithSubnetsResolveLBType(elbv2model.LoadBalancerTypeNetwork),
+			networking.WithSubnetsResolveLBScheme(scheme),
+			networking.WithSubnetsResolveLBIPAddressType(ipAddressType),
+		)
+	}
 	var rawSubnetNameOrIDs []string
 	if exists := t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixSubnets, &rawSubnetNameOrIDs, t.service.Annotations); exists {
 		return t.subnetsResolver.ResolveViaNameOrIDSlice(ctx, rawSubnetNameOrIDs,
@@ -460,6 +525,13 @@ func (t *defaultModelBuildTask) buildLoadBalancerSubnets(ctx context.Context, sc
 			networking.WithSubnetsResolveLBIPAddressType(ipAddressType),
 		)
 	}
+	if defaults := t.defaultClassSettings(); defaults != nil && defaults.Subnets != nil {
+		return t.subnetsResolver.ResolveViaSelector(ctx, *defaults.Subnets,
+			networking.WithSubnetsResolveLBType(elbv2model.LoadBalancerTypeNetwork),
+			networking.WithSubnetsResolveLBScheme(scheme),
+			networking.WithSubnetsResolveLBIPAddressType(ipAddressType),
+		)
+	}
 
 	// for internet-facing Load Balancers, the subnets mush have at least 8 available IP addresses;
 	// for internal Load Balancers, this is only required if private ip address is not assigned
@@ -489,10 +561,29 @@ func (t *defaultModelBuildTask) buildLoadBalancerAttributes(_ context.Context) (
 	if err != nil {
 		return []elbv2model.LoadBalancerAttribute{}, err
 	}
-	mergedAttributes := algorithm.MergeStringMap(specificAttributes, loadBalancerAttributes)
+	var enforcedClassAttributes, defaultClassAttributes map[string]string
+	if enforced := t.enforcedClassSettings(); enforced != nil {
+		enforcedClassAttributes = buildClassSettingsAttributes(enforced.LoadBalancerAttributes)
+	}
+	if defaults := t.defaultClassSettings(); defaults != nil {
+		defaultClassAttributes = buildClassSettingsAttributes(defaults.LoadBalancerAttributes)
+	}
+	mergedAttributes := algorithm.MergeStringMap(enforcedClassAttributes, specificAttributes, loadBalancerAttributes, defaultClassAttributes)
 	return shared_utils.MakeAttributesSliceFromMap(mergedAttributes), nil
 }
 
+// buildClassSettingsAttributes builds the custom attributes of ServiceClassParams settings.
+func buildClassSettingsAttributes(attributes []elbv2api.Attribute) map[string]string {
+	if len(attributes) == 0 {
+		return nil
+	}
+	classAttributes := make(map[string]string, len(attributes))
+	for _, attr := range attributes {
+		classAttributes[attr.Key] = attr.Value
+	}
+	return classAttributes
+}
+
 func (t *defaultModelBuildTask) buildLoadBalancerMinimumCapacity(_ context.Context) (*elbv2model.MinimumLoadBalancerCapacity, error) {
 	if !t.featureGates.Enabled(config.LBCapacityReservation) {
 		return nil, nil
@@ -18,6 +18,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/v3/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/config"
 	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/deploy/elbv2"
@@ -1396,6 +1397,197 @@ func Test_defaultModelBuilderTask_buildLoadBalancerSubnets(t *testing.T) {
 	}
 }
 
+func Test_defaultModelBuildTask_buildLoadBalancerScheme(t *testing.T) {
+	type listLoadBalancerCall struct {
+		sdkLBs []elbv2deploy.LoadBalancerWithTags
+		err    error
+	}
+	internetFacing := elbv2api.LoadBalancerSchemeInternetFacing
+	internal := elbv2api.LoadBalancerSchemeInternal
+	tests := []struct {
+		name                   string
+		svc                    *corev1.Service
+		classParams            *elbv2api.ServiceClassParams
+		listLoadBalancersCalls []listLoadBalancerCall
+		want                   elbv2.LoadBalancerScheme
+	}{
+		{
+			name: "no ServiceClassParams",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "svc-1"},
+			},
+			listLoadBalancersCalls: []listLoadBalancerCall{{}},
+			want:                   elbv2.LoadBalancerSchemeInternal,
+		},
+		{
+			name: "enforced scheme takes precedence over annotation",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "svc-1",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-scheme": "internet-facing",
+					},
+				},
+			},
+			classParams: &elbv2api.ServiceClassParams{
+				Spec: elbv2api.ServiceClassParamsSpec{
+					Enforced: &elbv2api.ServiceLoadBalancerSettings{Scheme: &internal},
+				},
+			},
+			want: elbv2.LoadBalancerSchemeInternal,
+		},
+		{
+			name: "annotation takes precedence over default scheme",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "svc-1",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-scheme": "internal",
+					},
+				},
+			},
+			classParams: &elbv2api.ServiceClassParams{
+				Spec: elbv2api.ServiceClassParamsSpec{
+					Defaults: &elbv2api.ServiceLoadBalancerSettings{Scheme: &internetFacing},
+				},
+			},
+			want: elbv2.LoadBalancerSchemeInternal,
+		},
+		{
+			name: "default scheme applies without annotation",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "svc-1"},
+			},
+			classParams: &elbv2api.ServiceClassParams{
+				Spec: elbv2api.ServiceClassParamsSpec{
+					Defaults: &elbv2api.ServiceLoadBalancerSettings{Scheme: &internetFacing},
+				},
+			},
+			listLoadBalancersCalls: []listLoadBalancerCall{{}},
+			want:                   elbv2.LoadBalancerSchemeInternetFacing,
+		},
+		{
+			name: "existing load balancer scheme takes precedence over default scheme",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "svc-1"},
+			},
+			classParams: &elbv2api.ServiceClassParams{
+				Spec: elbv2api.ServiceClassParamsSpec{
+					Defaults: &elbv2api.ServiceLoadBalancerSettings{Scheme: &internetFacing},
+				},
+			},
+			listLoadBalancersCalls: []listLoadBalancerCall{
+				{
+					sdkLBs: []elbv2deploy.LoadBalancerWithTags{
+						{
+							LoadBalancer: &elbv2types.LoadBalancer{
+								Scheme: elbv2types.LoadBalancerSchemeEnumInternal,
+							},
+						},
+					},
+				},
+			},
+			want: elbv2.LoadBalancerSchemeInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			elbv2TaggingManager := elbv2deploy.NewMockTaggingManager(ctrl)
+			for _, call := range tt.listLoadBalancersCalls {
+				elbv2TaggingManager.EXPECT().ListLoadBalancers(gomock.Any(), gomock.Any()).Return(call.sdkLBs, call.err)
+			}
+			clusterName := "cluster-name"
+			builder := &defaultModelBuildTask{
+				clusterName:               clusterName,
+				service:                   tt.svc,
+				classParams:               tt.classParams,
+				stack:                     core.NewDefaultStack(core.StackID{Namespace: "default", Name: "svc-1"}),
+				annotationParser:          annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io"),
+				trackingProvider:          tracking.NewDefaultProvider("service.k8s.aws", clusterName),
+				elbv2TaggingManager:       elbv2TaggingManager,
+				featureGates:              config.NewFeatureGates(),
+				defaultLoadBalancerScheme: elbv2.LoadBalancerSchemeInternal,
+			}
+			got, err := builder.buildLoadBalancerScheme(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_defaultModelBuildTask_buildLoadBalancerSecurityGroupNameOrIDs(t *testing.T) {
+	tests := []struct {
+		name        string
+		svc         *corev1.Service
+		classParams *elbv2api.ServiceClassParams
+		want        []string
+	}{
+		{
+			name: "no annotation, no ServiceClassParams",
+			svc:  &corev1.Service{},
+			want: nil,
+		},
+		{
+			name: "enforced security groups take precedence over annotation",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-security-groups": "sg-a",
+					},
+				},
+			},
+			classParams: &elbv2api.ServiceClassParams{
+				Spec: elbv2api.ServiceClassParamsSpec{
+					Enforced: &elbv2api.ServiceLoadBalancerSettings{SecurityGroups: []string{"sg-enforced"}},
+					Defaults: &elbv2api.ServiceLoadBalancerSettings{SecurityGroups: []string{"sg-default"}},
+				},
+			},
+			want: []string{"sg-enforced"},
+		},
+		{
+			name: "annotation takes precedence over default security groups",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-security-groups": "sg-a, sg-b",
+					},
+				},
+			},
+			classParams: &elbv2api.ServiceClassParams{
+				Spec: elbv2api.ServiceClassParamsSpec{
+					Defaults: &elbv2api.ServiceLoadBalancerSettings{SecurityGroups: []string{"sg-default"}},
+				},
+			},
+			want: []string{"sg-a", "sg-b"},
+		},
+		{
+			name: "default security groups apply without annotation",
+			svc:  &corev1.Service{},
+			classParams: &elbv2api.ServiceClassParams{
+				Spec: elbv2api.ServiceClassParamsSpec{
+					Defaults: &elbv2api.ServiceLoadBalancerSettings{SecurityGroups: []string{"sg-default"}},
+				},
+			},
+			want: []string{"sg-default"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder := &defaultModelBuildTask{
+				service:          tt.svc,
+				classParams:      tt.classParams,
+				annotationParser: annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io"),
+			}
+			assert.Equal(t, tt.want, builder.buildLoadBalancerSecurityGroupNameOrIDs())
+		})
+	}
+}
+
 func Test_defaultModelBuildTask_buildLoadBalancerIPAddressType(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -1608,6 +1800,7 @@ func Test_defaultModelBuildTask_buildAdditionalResourceTags(t *testing.T) {
 		service             *corev1.Service
 		defaultTags         map[string]string
 		externalManagedTags sets.String
+		classParams         *elbv2api.ServiceClassParams
 	}
 	tests := []struct {
 		name    string
@@ -1716,6 +1909,59 @@ func Test_defaultModelBuildTask_buildAdditionalResourceTags(t *testing.T) {
 			},
 			wantErr: errors.New("external managed tag key k3 cannot be specified on Service"),
 		},
+		{
+			name: "ServiceClassParams enforced and default tags",
+			fields: fields{
+				service: &corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							"service.beta.kubernetes.io/aws-load-balancer-additional-resource-tags": "k1=v1,k2=v2a,k3=v3a",
+						},
+					},
+				},
+				defaultTags: map[string]string{
+					"k4": "v4",
+				},
+				classParams: &elbv2api.ServiceClassParams{
+					ObjectMeta: metav1.ObjectMeta{Name: "awesome-class"},
+					Spec: elbv2api.ServiceClassParamsSpec{
+						Enforced: &elbv2api.ServiceLoadBalancerSettings{
+							Tags: []elbv2api.Tag{{Key: "k2", Value: "v2"}},
+						},
+						Defaults: &elbv2api.ServiceLoadBalancerSettings{
+							Tags: []elbv2api.Tag{{Key: "k3", Value: "v3"}, {Key: "k5", Value: "v5"}},
+						},
+					},
+				},
+			},
+			want: map[string]string{
+				"k1": "v1",
+				"k2": "v2",
+				"k3": "v3a",
+				"k4": "v4",
+				"k5": "v5",
+			},
+		},
+		{
+			name: "ServiceClassParams tags - has collision with external tags",
+			fields: fields{
+				service: &corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{},
+					},
+				},
+				externalManagedTags: sets.NewString("k3"),
+				classParams: &elbv2api.ServiceClassParams{
+					ObjectMeta: metav1.ObjectMeta{Name: "awesome-class"},
+					Spec: elbv2api.ServiceClassParamsSpec{
+						Defaults: &elbv2api.ServiceLoadBalancerSettings{
+							Tags: []elbv2api.Tag{{Key: "k3", Value: "v3"}},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("external managed tag key k3 cannot be specified on ServiceClassParams awesome-class"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1723,6 +1969,7 @@ func Test_defaultModelBuildTask_buildAdditionalResourceTags(t *testing.T) {
 				service:             tt.fields.service,
 				defaultTags:         tt.fields.defaultTags,
 				externalManagedTags: tt.fields.externalManagedTags,
+				classParams:         tt.fields.classParams,
 				annotationParser:    annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io"),
 				featureGates:        config.NewFeatureGates(),
 			}
@@ -2011,6 +2258,37 @@ func Test_defaultModelBuildTask_buildLoadBalancerTags(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:                "no default tags, annotation tags and federation group annotation",
+			enabledFeatureGates: func() config.FeatureGates { return config.NewFeatureGates() },
+			defaultTags:         map[string]string{},
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-additional-resource-tags": "k1=v1",
+						"aga.k8s.aws/federation-group":                                          "web",
+					},
+				},
+			},
+			wantTags: map[string]string{
+				"k1":                           "v1",
+				"aga.k8s.aws/federation-group": "web",
+			},
+			wantErr: false,
+		},
+		{
+			name:                "empty federation group annotation",
+			enabledFeatureGates: func() config.FeatureGates { return config.NewFeatureGates() },
+			defaultTags:         map[string]string{},
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"aga.k8s.aws/federation-group": "",
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name:                "default tags, no annotation tags",
 			enabledFeatureGates: func() config.FeatureGates { return config.NewFeatureGates() },
@@ -534,3 +534,31 @@ func Test_defaultModelBuildTask_buildManagedSecurityGroupTags(t *testing.T) {
 		})
 	}
 }
+
+func Test_defaultModelBuildTask_buildManagedSecurityGroupEgressPermissions(t *testing.T) {
+	tests := []struct {
+		name             string
+		restrictSGEgress bool
+		want             []ec2model.IPPermission
+	}{
+		{
+			name:             "egress not restricted",
+			restrictSGEgress: false,
+			want:             nil,
+		},
+		{
+			name:             "egress restricted",
+			restrictSGEgress: true,
+			want:             []ec2model.IPPermission{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				restrictSGEgress: tt.restrictSGEgress,
+			}
+			got := task.buildManagedSecurityGroupEgressPermissions(context.Background())
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
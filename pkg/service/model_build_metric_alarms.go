@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/config"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/shared_utils"
+)
+
+func (t *defaultModelBuildTask) buildMetricAlarms(ctx context.Context) error {
+	var rawThresholds map[string]string
+	if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.SvcLBSuffixCloudWatchAlarmThresholds, &rawThresholds, t.service.Annotations); err != nil {
+		return err
+	}
+	thresholds, err := shared_utils.ParseMetricAlarmThresholds(rawThresholds)
+	if err != nil {
+		return err
+	}
+	var alarmActions []string
+	t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixCloudWatchAlarmActions, &alarmActions, t.service.Annotations)
+	alarmsCFG := shared_utils.MetricAlarmsConfig{
+		Thresholds:   thresholds,
+		AlarmActions: alarmActions,
+	}
+	if alarmsCFG.IsEmpty() {
+		return nil
+	}
+	if !t.featureGates.Enabled(config.CloudWatchAlarmManagement) {
+		return errors.Errorf("CloudWatch alarms cannot be managed unless the %v feature gate is enabled", config.CloudWatchAlarmManagement)
+	}
+	tags, err := t.buildLoadBalancerTags(ctx)
+	if err != nil {
+		return err
+	}
+	alarmTGs := make([]shared_utils.MetricAlarmTargetGroup, 0, len(t.tgByResID))
+	for tgResID, tg := range t.tgByResID {
+		alarmTGs = append(alarmTGs, shared_utils.MetricAlarmTargetGroup{
+			ResID:          tgResID,
+			TargetGroupARN: tg.TargetGroupARN(),
+		})
+	}
+	_, err = shared_utils.BuildMetricAlarms(t.stack, t.clusterName, elbv2model.LoadBalancerTypeNetwork, t.loadBalancer.LoadBalancerARN(), alarmsCFG, alarmTGs, tags)
+	return err
+}
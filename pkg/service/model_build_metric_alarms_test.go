@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/config"
+	cloudwatchmodel "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/cloudwatch"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2"
+)
+
+func Test_defaultModelBuildTask_buildMetricAlarms(t *testing.T) {
+	tests := []struct {
+		name               string
+		featureGateEnabled bool
+		annotations        map[string]string
+		wantResIDs         []string
+		wantThreshold      float64
+		wantAlarmActions   []string
+		wantErr            string
+	}{
+		{
+			name:               "no alarms configured",
+			featureGateEnabled: true,
+		},
+		{
+			name:               "alarms configured",
+			featureGateEnabled: true,
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-cloudwatch-alarm-thresholds": "UnHealthyHostCount=2",
+				"service.beta.kubernetes.io/aws-load-balancer-cloudwatch-alarm-actions":    "arn:aws:sns:us-west-2:111122223333:lb-alarms",
+			},
+			wantResIDs:       []string{"ns/svc:80/UnHealthyHostCount"},
+			wantThreshold:    2,
+			wantAlarmActions: []string{"arn:aws:sns:us-west-2:111122223333:lb-alarms"},
+		},
+		{
+			name:               "application LoadBalancer metric",
+			featureGateEnabled: true,
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-cloudwatch-alarm-thresholds": "HTTPCode_ELB_5XX_Count=10",
+			},
+			wantErr: "CloudWatch alarm metric HTTPCode_ELB_5XX_Count is not supported for network LoadBalancers",
+		},
+		{
+			name:               "feature gate disabled",
+			featureGateEnabled: false,
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-cloudwatch-alarm-thresholds": "UnHealthyHostCount=1",
+			},
+			wantErr: "CloudWatch alarms cannot be managed unless the CloudWatchAlarmManagement feature gate is enabled",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			featureGates := config.NewFeatureGates()
+			if tt.featureGateEnabled {
+				featureGates.Enable(config.CloudWatchAlarmManagement)
+			}
+			stack := core.NewDefaultStack(core.StackID{Namespace: "ns", Name: "svc"})
+			lb := elbv2model.NewLoadBalancer(stack, "LoadBalancer", elbv2model.LoadBalancerSpec{})
+			tg := elbv2model.NewTargetGroup(stack, "ns/svc:80", elbv2model.TargetGroupSpec{})
+			task := &defaultModelBuildTask{
+				annotationParser: annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io"),
+				featureGates:     featureGates,
+				clusterName:      "cluster",
+				service: &corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   "ns",
+						Name:        "svc",
+						Annotations: tt.annotations,
+					},
+				},
+				stack:               stack,
+				loadBalancer:        lb,
+				tgByResID:           map[string]*elbv2model.TargetGroup{"ns/svc:80": tg},
+				externalManagedTags: sets.NewString(),
+			}
+			err := task.buildMetricAlarms(context.Background())
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			var resAlarms []*cloudwatchmodel.MetricAlarm
+			stack.ListResources(&resAlarms)
+			var gotResIDs []string
+			for _, resAlarm := range resAlarms {
+				gotResIDs = append(gotResIDs, resAlarm.ID())
+				assert.Equal(t, "AWS/NetworkELB", resAlarm.Spec.Namespace)
+				assert.Equal(t, tt.wantThreshold, resAlarm.Spec.Threshold)
+				assert.Equal(t, tt.wantAlarmActions, resAlarm.Spec.AlarmActions)
+				assert.Equal(t, []core.Resource{lb}, resAlarm.Spec.LoadBalancerARN.Dependencies())
+				assert.Equal(t, []core.Resource{tg}, resAlarm.Spec.TargetGroupARN.Dependencies())
+			}
+			assert.Equal(t, tt.wantResIDs, gotResIDs)
+		})
+	}
+}
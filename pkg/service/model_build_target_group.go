@@ -192,7 +192,7 @@ func (t *defaultModelBuildTask) buildTargetGroupHealthCheckConfigDefault(ctx con
 	if err != nil {
 		return nil, err
 	}
-	return &elbv2model.TargetGroupHealthCheckConfig{
+	healthCheckConfig := &elbv2model.TargetGroupHealthCheckConfig{
 		Port:                    &healthCheckPort,
 		Protocol:                healthCheckProtocol,
 		Path:                    healthCheckPathPtr,
@@ -201,7 +201,11 @@ func (t *defaultModelBuildTask) buildTargetGroupHealthCheckConfigDefault(ctx con
 		TimeoutSeconds:          healthCheckTimeoutSecondsPtr,
 		HealthyThresholdCount:   &healthyThresholdCount,
 		UnhealthyThresholdCount: &unhealthyThresholdCount,
-	}, nil
+	}
+	if err := t.applyClassSettingsHealthCheck(svc, baseSvcAnnotations, targetType, t.defaultHealthCheckPath, true, healthCheckConfig); err != nil {
+		return nil, err
+	}
+	return healthCheckConfig, nil
 }
 
 func (t *defaultModelBuildTask) buildTargetGroupHealthCheckConfigForInstanceModeLocal(ctx context.Context, svc *corev1.Service, baseSvcAnnotations map[string]string, targetType elbv2model.TargetType) (*elbv2model.TargetGroupHealthCheckConfig, error) {
@@ -231,7 +235,7 @@ func (t *defaultModelBuildTask) buildTargetGroupHealthCheckConfigForInstanceMode
 	if err != nil {
 		return nil, err
 	}
-	return &elbv2model.TargetGroupHealthCheckConfig{
+	healthCheckConfig := &elbv2model.TargetGroupHealthCheckConfig{
 		Port:                    &healthCheckPort,
 		Protocol:                healthCheckProtocol,
 		Path:                    healthCheckPathPtr,
@@ -240,7 +244,96 @@ func (t *defaultModelBuildTask) buildTargetGroupHealthCheckConfigForInstanceMode
 		TimeoutSeconds:          healthCheckTimeoutSecondsPtr,
 		HealthyThresholdCount:   &healthyThresholdCount,
 		UnhealthyThresholdCount: &unhealthyThresholdCount,
-	}, nil
+	}
+	// the default health check of the ServiceClassParams doesn't apply, since kube-proxy serves the health check on the healthCheckNodePort.
+	if err := t.applyClassSettingsHealthCheck(svc, baseSvcAnnotations, targetType, t.defaultHealthCheckPathForInstanceModeLocal, false, healthCheckConfig); err != nil {
+		return nil, err
+	}
+	return healthCheckConfig, nil
+}
+
+// applyClassSettingsHealthCheck applies the health check settings of the ServiceClassParams to the health check configuration.
+// Enforced settings override the annotations, while default settings only apply to the fields that aren't specified with annotations.
+func (t *defaultModelBuildTask) applyClassSettingsHealthCheck(svc *corev1.Service, baseSvcAnnotations map[string]string, targetType elbv2model.TargetType,
+	defaultHealthCheckPath string, applyDefaults bool, healthCheckConfig *elbv2model.TargetGroupHealthCheckConfig) error {
+	if t.classParams == nil {
+		return nil
+	}
+	settings := &elbv2api.ServiceHealthCheckConfig{}
+	if enforced := t.enforcedClassSettings(); enforced != nil && enforced.HealthCheck != nil {
+		settings = enforced.HealthCheck.DeepCopy()
+	}
+	if defaults := t.defaultClassSettings(); applyDefaults && defaults != nil && defaults.HealthCheck != nil {
+		annotated := func(suffix string) bool {
+			var rawValue string
+			return t.annotationParser.ParseStringAnnotation(suffix, &rawValue, baseSvcAnnotations)
+		}
+		if settings.Port == nil && !annotated(annotations.SvcLBSuffixHCPort) {
+			settings.Port = defaults.HealthCheck.Port
+		}
+		if settings.Protocol == nil && !annotated(annotations.SvcLBSuffixHCProtocol) {
+			settings.Protocol = defaults.HealthCheck.Protocol
+		}
+		if settings.Path == nil && !annotated(annotations.SvcLBSuffixHCPath) {
+			settings.Path = defaults.HealthCheck.Path
+		}
+		if settings.SuccessCodes == nil && !annotated(annotations.SvcLBSuffixHCSuccessCodes) {
+			settings.SuccessCodes = defaults.HealthCheck.SuccessCodes
+		}
+		if settings.IntervalSeconds == nil && !annotated(annotations.SvcLBSuffixHCInterval) {
+			settings.IntervalSeconds = defaults.HealthCheck.IntervalSeconds
+		}
+		if settings.TimeoutSeconds == nil && !annotated(annotations.SvcLBSuffixHCTimeout) {
+			settings.TimeoutSeconds = defaults.HealthCheck.TimeoutSeconds
+		}
+		if settings.HealthyThresholdCount == nil && !annotated(annotations.SvcLBSuffixHCHealthyThreshold) {
+			settings.HealthyThresholdCount = defaults.HealthCheck.HealthyThresholdCount
+		}
+		if settings.UnhealthyThresholdCount == nil && !annotated(annotations.SvcLBSuffixHCUnhealthyThreshold) {
+			settings.UnhealthyThresholdCount = defaults.HealthCheck.UnhealthyThresholdCount
+		}
+	}
+
+	if settings.Port != nil {
+		healthCheckPort, err := t.resolveTargetGroupHealthCheckPort(svc, settings.Port.String(), targetType)
+		if err != nil {
+			return err
+		}
+		healthCheckConfig.Port = &healthCheckPort
+	}
+	if settings.Protocol != nil {
+		healthCheckConfig.Protocol = elbv2model.Protocol(*settings.Protocol)
+	}
+	if healthCheckConfig.Protocol == elbv2model.ProtocolTCP {
+		healthCheckConfig.Path = nil
+		healthCheckConfig.Matcher = nil
+	} else {
+		if settings.Path != nil {
+			healthCheckConfig.Path = awssdk.String(*settings.Path)
+		} else if healthCheckConfig.Path == nil {
+			healthCheckConfig.Path = awssdk.String(defaultHealthCheckPath)
+		}
+		if t.featureGates.Enabled(config.NLBHealthCheckAdvancedConfig) {
+			if settings.SuccessCodes != nil {
+				healthCheckConfig.Matcher = &elbv2model.HealthCheckMatcher{HTTPCode: awssdk.String(*settings.SuccessCodes)}
+			} else if healthCheckConfig.Matcher == nil {
+				healthCheckConfig.Matcher = &elbv2model.HealthCheckMatcher{HTTPCode: awssdk.String(t.defaultHealthCheckMatcherHTTPCode)}
+			}
+		}
+	}
+	if settings.IntervalSeconds != nil {
+		healthCheckConfig.IntervalSeconds = awssdk.Int32(*settings.IntervalSeconds)
+	}
+	if settings.TimeoutSeconds != nil && t.featureGates.Enabled(config.NLBHealthCheckAdvancedConfig) {
+		healthCheckConfig.TimeoutSeconds = awssdk.Int32(*settings.TimeoutSeconds)
+	}
+	if settings.HealthyThresholdCount != nil {
+		healthCheckConfig.HealthyThresholdCount = awssdk.Int32(*settings.HealthyThresholdCount)
+	}
+	if settings.UnhealthyThresholdCount != nil {
+		healthCheckConfig.UnhealthyThresholdCount = awssdk.Int32(*settings.UnhealthyThresholdCount)
+	}
+	return nil
 }
 
 var invalidTargetGroupNamePattern = regexp.MustCompile("[[:^alnum:]]")
@@ -328,6 +421,11 @@ func (t *defaultModelBuildTask) buildTargetGroupAttributes(ctx context.Context,
 	// Start with defaults
 	rawAttributes := make(map[string]string)
 	rawAttributes[shared_constants.TGAttributeProxyProtocolV2Enabled] = strconv.FormatBool(t.defaultProxyProtocolV2Enabled)
+	if defaults := t.defaultClassSettings(); defaults != nil {
+		for _, attr := range defaults.TargetGroupAttributes {
+			rawAttributes[attr.Key] = attr.Value
+		}
+	}
 
 	// Get base and port-specific attributes
 	baseAndPortAttributes, err := t.buildPortSpecificTargetGroupAttributes(ctx, port)
@@ -373,6 +471,13 @@ func (t *defaultModelBuildTask) buildTargetGroupAttributes(ctx context.Context,
 		rawAttributes[shared_constants.TGAttributeProxyProtocolV2Enabled] = "true"
 	}
 
+	// Attributes enforced by the ServiceClassParams take precedence over the annotations
+	if enforced := t.enforcedClassSettings(); enforced != nil {
+		for _, attr := range enforced.TargetGroupAttributes {
+			rawAttributes[attr.Key] = attr.Value
+		}
+	}
+
 	// Convert map to sorted array of attributes
 	attributes := make([]elbv2model.TargetGroupAttribute, 0, len(rawAttributes))
 	for attrKey, attrValue := range rawAttributes {
@@ -431,6 +536,11 @@ func (t *defaultModelBuildTask) buildTargetGroupPort(_ context.Context, targetTy
 func (t *defaultModelBuildTask) buildTargetGroupHealthCheckPort(_ context.Context, svc *corev1.Service, baseSvcAnnotations map[string]string, defaultHealthCheckPort string, targetType elbv2model.TargetType) (intstr.IntOrString, error) {
 	rawHealthCheckPort := defaultHealthCheckPort
 	t.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixHCPort, &rawHealthCheckPort, baseSvcAnnotations)
+	return t.resolveTargetGroupHealthCheckPort(svc, rawHealthCheckPort, targetType)
+}
+
+// resolveTargetGroupHealthCheckPort resolves the health check port, which is "traffic-port", a port number or the name of a service port.
+func (t *defaultModelBuildTask) resolveTargetGroupHealthCheckPort(svc *corev1.Service, rawHealthCheckPort string, targetType elbv2model.TargetType) (intstr.IntOrString, error) {
 	if rawHealthCheckPort == shared_constants.HealthCheckPortTrafficPort {
 		return intstr.FromString(rawHealthCheckPort), nil
 	}
@@ -585,6 +695,10 @@ func (t *defaultModelBuildTask) buildTargetGroupBindingSpec(ctx context.Context,
 	if err != nil {
 		return elbv2modelk8s.TargetGroupBindingResourceSpec{}, err
 	}
+	targetZonePolicy, err := t.buildTargetGroupBindingTargetZonePolicy(baseSvcAnnotations)
+	if err != nil {
+		return elbv2modelk8s.TargetGroupBindingResourceSpec{}, err
+	}
 
 	return elbv2modelk8s.TargetGroupBindingResourceSpec{
 		Template: elbv2modelk8s.TargetGroupBindingTemplate{
@@ -605,6 +719,7 @@ func (t *defaultModelBuildTask) buildTargetGroupBindingSpec(ctx context.Context,
 				VpcID:                   t.vpcID,
 				MultiClusterTargetGroup: multiTg,
 				TargetGroupProtocol:     &targetGroup.Spec.Protocol,
+				TargetZonePolicy:        targetZonePolicy,
 			},
 		},
 	}, nil
@@ -900,3 +1015,19 @@ func (t *defaultModelBuildTask) buildTargetGroupBindingMultiClusterFlag(baseSvcA
 	}
 	return false, nil
 }
+
+// buildTargetGroupBindingTargetZonePolicy builds the policy for targets in availability zones not enabled on the load balancer.
+// The policy only affects target registration, the subnets of the load balancer are never extended to cover new zones.
+func (t *defaultModelBuildTask) buildTargetGroupBindingTargetZonePolicy(baseSvcAnnotations map[string]string) (*elbv2api.TargetZonePolicy, error) {
+	var rawPolicy string
+	if exists := t.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixTargetZonePolicy, &rawPolicy, baseSvcAnnotations); !exists {
+		return nil, nil
+	}
+	policy := elbv2api.TargetZonePolicy(rawPolicy)
+	switch policy {
+	case elbv2api.TargetZonePolicyRegister, elbv2api.TargetZonePolicySkip, elbv2api.TargetZonePolicyDefer:
+		return &policy, nil
+	default:
+		return nil, errors.Errorf("unknown target zone policy: %v", rawPolicy)
+	}
+}
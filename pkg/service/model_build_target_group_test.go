@@ -2431,6 +2431,58 @@ func Test_defaultModelBuildTask_buildTargetGroupBindingMultiClusterFlag(t *testi
 	}
 }
 
+func Test_defaultModelBuildTask_buildTargetGroupBindingTargetZonePolicy(t *testing.T) {
+	deferPolicy := elbv2api.TargetZonePolicyDefer
+	tests := []struct {
+		name    string
+		svc     *corev1.Service
+		want    *elbv2api.TargetZonePolicy
+		wantErr bool
+	}{
+		{
+			name: "no annotation",
+			svc:  &corev1.Service{},
+			want: nil,
+		},
+		{
+			name: "valid annotation",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-target-zone-policy": "Defer",
+					},
+				},
+			},
+			want: &deferPolicy,
+		},
+		{
+			name: "unknown policy",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-target-zone-policy": "Ignore",
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				annotationParser: annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io"),
+			}
+			got, err := task.buildTargetGroupBindingTargetZonePolicy(tt.svc.Annotations)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
 func Test_defaultModelBuildTask_buildTargetGroupTags(t *testing.T) {
 	tests := []struct {
 		name                string
@@ -2561,3 +2613,136 @@ func Test_defaultModelBuildTask_buildTargetGroupTags(t *testing.T) {
 		})
 	}
 }
+
+func Test_defaultModelBuildTask_applyClassSettingsHealthCheck(t *testing.T) {
+	httpProtocol := elbv2api.ServiceHealthCheckProtocolHTTP
+	tcpProtocol := elbv2api.ServiceHealthCheckProtocolTCP
+	hcPort := intstr.FromInt32(8080)
+	newHealthCheckConfig := func() *elbv2.TargetGroupHealthCheckConfig {
+		trafficPort := intstr.FromString(shared_constants.HealthCheckPortTrafficPort)
+		return &elbv2.TargetGroupHealthCheckConfig{
+			Port:                    &trafficPort,
+			Protocol:                elbv2.ProtocolTCP,
+			IntervalSeconds:         aws.Int32(10),
+			TimeoutSeconds:          aws.Int32(10),
+			HealthyThresholdCount:   aws.Int32(3),
+			UnhealthyThresholdCount: aws.Int32(3),
+		}
+	}
+	tests := []struct {
+		name          string
+		annotations   map[string]string
+		classParams   *elbv2api.ServiceClassParams
+		applyDefaults bool
+		want          *elbv2.TargetGroupHealthCheckConfig
+	}{
+		{
+			name: "no ServiceClassParams",
+			want: newHealthCheckConfig(),
+		},
+		{
+			name: "enforced health check overrides annotations",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-healthcheck-protocol": "TCP",
+				"service.beta.kubernetes.io/aws-load-balancer-healthcheck-interval": "10",
+			},
+			classParams: &elbv2api.ServiceClassParams{
+				Spec: elbv2api.ServiceClassParamsSpec{
+					Enforced: &elbv2api.ServiceLoadBalancerSettings{
+						HealthCheck: &elbv2api.ServiceHealthCheckConfig{
+							Protocol:        &httpProtocol,
+							Path:            aws.String("/healthz"),
+							IntervalSeconds: aws.Int32(30),
+						},
+					},
+				},
+			},
+			applyDefaults: true,
+			want: &elbv2.TargetGroupHealthCheckConfig{
+				Port:                    &intstr.IntOrString{Type: intstr.String, StrVal: shared_constants.HealthCheckPortTrafficPort},
+				Protocol:                elbv2.ProtocolHTTP,
+				Path:                    aws.String("/healthz"),
+				Matcher:                 &elbv2.HealthCheckMatcher{HTTPCode: aws.String("200-399")},
+				IntervalSeconds:         aws.Int32(30),
+				TimeoutSeconds:          aws.Int32(10),
+				HealthyThresholdCount:   aws.Int32(3),
+				UnhealthyThresholdCount: aws.Int32(3),
+			},
+		},
+		{
+			name: "default health check only applies to fields without annotations",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-healthcheck-protocol": "TCP",
+			},
+			classParams: &elbv2api.ServiceClassParams{
+				Spec: elbv2api.ServiceClassParamsSpec{
+					Defaults: &elbv2api.ServiceLoadBalancerSettings{
+						HealthCheck: &elbv2api.ServiceHealthCheckConfig{
+							Port:                  &hcPort,
+							Protocol:              &httpProtocol,
+							Path:                  aws.String("/healthz"),
+							HealthyThresholdCount: aws.Int32(5),
+						},
+					},
+				},
+			},
+			applyDefaults: true,
+			want: &elbv2.TargetGroupHealthCheckConfig{
+				Port:                    &hcPort,
+				Protocol:                elbv2.ProtocolTCP,
+				IntervalSeconds:         aws.Int32(10),
+				TimeoutSeconds:          aws.Int32(10),
+				HealthyThresholdCount:   aws.Int32(5),
+				UnhealthyThresholdCount: aws.Int32(3),
+			},
+		},
+		{
+			name: "default health check not applied for instance mode local",
+			classParams: &elbv2api.ServiceClassParams{
+				Spec: elbv2api.ServiceClassParamsSpec{
+					Defaults: &elbv2api.ServiceLoadBalancerSettings{
+						HealthCheck: &elbv2api.ServiceHealthCheckConfig{
+							Protocol: &httpProtocol,
+						},
+					},
+					Enforced: &elbv2api.ServiceLoadBalancerSettings{
+						HealthCheck: &elbv2api.ServiceHealthCheckConfig{
+							Protocol:                &tcpProtocol,
+							UnhealthyThresholdCount: aws.Int32(2),
+						},
+					},
+				},
+			},
+			applyDefaults: false,
+			want: &elbv2.TargetGroupHealthCheckConfig{
+				Port:                    &intstr.IntOrString{Type: intstr.String, StrVal: shared_constants.HealthCheckPortTrafficPort},
+				Protocol:                elbv2.ProtocolTCP,
+				IntervalSeconds:         aws.Int32(10),
+				TimeoutSeconds:          aws.Int32(10),
+				HealthyThresholdCount:   aws.Int32(3),
+				UnhealthyThresholdCount: aws.Int32(2),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Annotations: tt.annotations},
+				Spec: corev1.ServiceSpec{
+					Ports: []corev1.ServicePort{{Name: "http", Port: 80, TargetPort: intstr.FromInt32(8080)}},
+				},
+			}
+			builder := &defaultModelBuildTask{
+				service:                           svc,
+				classParams:                       tt.classParams,
+				annotationParser:                  annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io"),
+				featureGates:                      config.NewFeatureGates(),
+				defaultHealthCheckMatcherHTTPCode: "200-399",
+			}
+			got := newHealthCheckConfig()
+			err := builder.applyClassSettingsHealthCheck(svc, tt.annotations, elbv2.TargetTypeIP, "/", tt.applyDefaults, got)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
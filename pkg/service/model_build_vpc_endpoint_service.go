@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/config"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/ec2"
+)
+
+const (
+	resourceIDVPCEndpointService = "VPCEndpointService"
+)
+
+func (t *defaultModelBuildTask) buildVPCEndpointService(ctx context.Context) error {
+	var enabled bool
+	if _, err := t.annotationParser.ParseBoolAnnotation(annotations.SvcLBSuffixEndpointServiceEnabled, &enabled, t.service.Annotations); err != nil {
+		return err
+	}
+	if !enabled {
+		return nil
+	}
+	if !t.featureGates.Enabled(config.VPCEndpointServiceManagement) {
+		return errors.Errorf("VPC endpoint services cannot be managed unless the %v feature gate is enabled", config.VPCEndpointServiceManagement)
+	}
+	esSpec, err := t.buildVPCEndpointServiceSpec(ctx)
+	if err != nil {
+		return err
+	}
+	ec2model.NewVPCEndpointService(t.stack, resourceIDVPCEndpointService, esSpec)
+	return nil
+}
+
+func (t *defaultModelBuildTask) buildVPCEndpointServiceSpec(ctx context.Context) (ec2model.VPCEndpointServiceSpec, error) {
+	var acceptanceRequired bool
+	if _, err := t.annotationParser.ParseBoolAnnotation(annotations.SvcLBSuffixEndpointServiceAcceptanceRequired, &acceptanceRequired, t.service.Annotations); err != nil {
+		return ec2model.VPCEndpointServiceSpec{}, err
+	}
+	var allowedPrincipals []string
+	t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixEndpointServiceAllowedPrincipals, &allowedPrincipals, t.service.Annotations)
+	var privateDNSName *string
+	var rawPrivateDNSName string
+	if exists := t.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixEndpointServicePrivateDNSName, &rawPrivateDNSName, t.service.Annotations); exists && rawPrivateDNSName != "" {
+		privateDNSName = &rawPrivateDNSName
+	}
+	tags, err := t.buildAdditionalResourceTags(ctx)
+	if err != nil {
+		return ec2model.VPCEndpointServiceSpec{}, err
+	}
+	return ec2model.VPCEndpointServiceSpec{
+		NetworkLoadBalancerARN: t.loadBalancer.LoadBalancerARN(),
+		AcceptanceRequired:     acceptanceRequired,
+		PrivateDNSName:         privateDNSName,
+		AllowedPrincipals:      allowedPrincipals,
+		Tags:                   tags,
+	}, nil
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/ec2"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2"
+)
+
+func Test_defaultModelBuildTask_buildVPCEndpointService(t *testing.T) {
+	tests := []struct {
+		name               string
+		featureGateEnabled bool
+		annotations        map[string]string
+		wantSpec           *ec2model.VPCEndpointServiceSpec
+		wantErr            string
+	}{
+		{
+			name:               "endpoint service not enabled",
+			featureGateEnabled: true,
+		},
+		{
+			name:               "endpoint service disabled",
+			featureGateEnabled: true,
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-endpoint-service-enabled": "false",
+			},
+		},
+		{
+			name:               "endpoint service enabled with defaults",
+			featureGateEnabled: true,
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-endpoint-service-enabled": "true",
+			},
+			wantSpec: &ec2model.VPCEndpointServiceSpec{
+				Tags: map[string]string{},
+			},
+		},
+		{
+			name:               "endpoint service enabled with all settings",
+			featureGateEnabled: true,
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-endpoint-service-enabled":             "true",
+				"service.beta.kubernetes.io/aws-load-balancer-endpoint-service-allowed-principals":  "arn:aws:iam::111122223333:root, arn:aws:iam::444455556666:role/consumer",
+				"service.beta.kubernetes.io/aws-load-balancer-endpoint-service-acceptance-required": "true",
+				"service.beta.kubernetes.io/aws-load-balancer-endpoint-service-private-dns-name":    "api.example.com",
+				"service.beta.kubernetes.io/aws-load-balancer-additional-resource-tags":             "team=backend",
+			},
+			wantSpec: &ec2model.VPCEndpointServiceSpec{
+				AcceptanceRequired: true,
+				PrivateDNSName:     awssdk.String("api.example.com"),
+				AllowedPrincipals:  []string{"arn:aws:iam::111122223333:root", "arn:aws:iam::444455556666:role/consumer"},
+				Tags:               map[string]string{"team": "backend"},
+			},
+		},
+		{
+			name:               "invalid acceptance required value",
+			featureGateEnabled: true,
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-endpoint-service-enabled":             "true",
+				"service.beta.kubernetes.io/aws-load-balancer-endpoint-service-acceptance-required": "maybe",
+			},
+			wantErr: "failed to parse bool annotation, service.beta.kubernetes.io/aws-load-balancer-endpoint-service-acceptance-required: maybe: strconv.ParseBool: parsing \"maybe\": invalid syntax",
+		},
+		{
+			name: "feature gate disabled",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-endpoint-service-enabled": "true",
+			},
+			wantErr: "VPC endpoint services cannot be managed unless the VPCEndpointServiceManagement feature gate is enabled",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			featureGates := config.NewFeatureGates()
+			if tt.featureGateEnabled {
+				featureGates.Enable(config.VPCEndpointServiceManagement)
+			}
+			stack := core.NewDefaultStack(core.StackID{Namespace: "ns", Name: "svc"})
+			lb := elbv2model.NewLoadBalancer(stack, "LoadBalancer", elbv2model.LoadBalancerSpec{})
+			task := &defaultModelBuildTask{
+				annotationParser: annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io"),
+				featureGates:     featureGates,
+				service: &corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   "ns",
+						Name:        "svc",
+						Annotations: tt.annotations,
+					},
+				},
+				stack:               stack,
+				loadBalancer:        lb,
+				externalManagedTags: sets.NewString(),
+			}
+			err := task.buildVPCEndpointService(context.Background())
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			var resESs []*ec2model.VPCEndpointService
+			stack.ListResources(&resESs)
+			if tt.wantSpec == nil {
+				assert.Empty(t, resESs)
+				return
+			}
+			assert.Len(t, resESs, 1)
+			resES := resESs[0]
+			assert.Equal(t, "VPCEndpointService", resES.ID())
+			assert.Equal(t, []core.Resource{lb}, resES.Spec.NetworkLoadBalancerARN.Dependencies())
+			assert.Equal(t, tt.wantSpec.AcceptanceRequired, resES.Spec.AcceptanceRequired)
+			assert.Equal(t, tt.wantSpec.PrivateDNSName, resES.Spec.PrivateDNSName)
+			assert.Equal(t, tt.wantSpec.AllowedPrincipals, resES.Spec.AllowedPrincipals)
+			assert.Equal(t, tt.wantSpec.Tags, resES.Spec.Tags)
+		})
+	}
+}
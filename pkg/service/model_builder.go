@@ -13,6 +13,7 @@ import (
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/v3/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/config"
@@ -46,7 +47,8 @@ func NewDefaultModelBuilder(annotationParser annotations.Parser, subnetsResolver
 	elbv2TaggingManager elbv2deploy.TaggingManager, ec2Client services.EC2, featureGates config.FeatureGates, clusterName string, defaultTags map[string]string,
 	externalManagedTags []string, defaultSSLPolicy string, defaultTargetType string, defaultLoadBalancerScheme string, enableIPTargetType bool, serviceUtils ServiceUtils,
 	backendSGProvider networking.BackendSGProvider, sgResolver networking.SecurityGroupResolver, enableBackendSG bool, defaultEnableManageBackendSGRules bool,
-	disableRestrictedSGRules bool, logger logr.Logger, metricsCollector lbcmetrics.MetricCollector, tcpUdpEnabled bool, enhancedBackendBuilder EnhancedBackendBuilder) *defaultModelBuilder {
+	disableRestrictedSGRules bool, restrictSGEgress bool, logger logr.Logger, metricsCollector lbcmetrics.MetricCollector, tcpUdpEnabled bool, enhancedBackendBuilder EnhancedBackendBuilder,
+	classParamsLoader ClassParamsLoader) *defaultModelBuilder {
 	return &defaultModelBuilder{
 		annotationParser:           annotationParser,
 		subnetsResolver:            subnetsResolver,
@@ -69,10 +71,12 @@ func NewDefaultModelBuilder(annotationParser annotations.Parser, subnetsResolver
 		enableBackendSG:            enableBackendSG,
 		enableManageBackendSGRules: defaultEnableManageBackendSGRules,
 		disableRestrictedSGRules:   disableRestrictedSGRules,
+		restrictSGEgress:           restrictSGEgress,
 		logger:                     logger,
 		metricsCollector:           metricsCollector,
 		enableTCPUDPSupport:        tcpUdpEnabled,
 		enhancedBackendBuilder:     enhancedBackendBuilder,
+		classParamsLoader:          classParamsLoader,
 	}
 }
 
@@ -92,6 +96,7 @@ type defaultModelBuilder struct {
 	enableBackendSG            bool
 	enableManageBackendSGRules bool
 	disableRestrictedSGRules   bool
+	restrictSGEgress           bool
 
 	clusterName               string
 	vpcID                     string
@@ -105,6 +110,7 @@ type defaultModelBuilder struct {
 	metricsCollector          lbcmetrics.MetricCollector
 	enableTCPUDPSupport       bool
 	enhancedBackendBuilder    EnhancedBackendBuilder
+	classParamsLoader         ClassParamsLoader
 }
 
 func (b *defaultModelBuilder) Build(ctx context.Context, service *corev1.Service, metricsCollector lbcmetrics.MetricCollector) (core.Stack, *elbv2model.LoadBalancer, bool, error) {
@@ -126,6 +132,7 @@ func (b *defaultModelBuilder) Build(ctx context.Context, service *corev1.Service
 		enableBackendSG:            b.enableBackendSG,
 		enableManageBackendSGRules: b.enableManageBackendSGRules,
 		disableRestrictedSGRules:   b.disableRestrictedSGRules,
+		restrictSGEgress:           b.restrictSGEgress,
 		logger:                     b.logger,
 		metricsCollector:           b.metricsCollector,
 
@@ -164,6 +171,7 @@ func (b *defaultModelBuilder) Build(ctx context.Context, service *corev1.Service
 		defaultHealthCheckUnhealthyThresholdForInstanceModeLocal: 2,
 		enableTCPUDPSupport:                                      b.enableTCPUDPSupport,
 		enhancedBackendBuilder:                                   b.enhancedBackendBuilder,
+		classParamsLoader:                                        b.classParamsLoader,
 		backendServices:                                          make(map[types.NamespacedName]*corev1.Service),
 	}
 
@@ -192,6 +200,8 @@ type defaultModelBuildTask struct {
 	metricsCollector           lbcmetrics.MetricCollector
 
 	service *corev1.Service
+	// classParams is the ServiceClassParams for the loadBalancerClass of the service, if any.
+	classParams *elbv2api.ServiceClassParams
 
 	stack                    core.Stack
 	loadBalancer             *elbv2model.LoadBalancer
@@ -199,6 +209,7 @@ type defaultModelBuildTask struct {
 	ec2Subnets               []ec2types.Subnet
 	enableBackendSG          bool
 	disableRestrictedSGRules bool
+	restrictSGEgress         bool
 	backendSGIDToken         core.StringToken
 	backendSGAllocated       bool
 	preserveClientIP         bool
@@ -240,6 +251,7 @@ type defaultModelBuildTask struct {
 
 	enableTCPUDPSupport    bool
 	enhancedBackendBuilder EnhancedBackendBuilder
+	classParamsLoader      ClassParamsLoader
 	backendServices        map[types.NamespacedName]*corev1.Service
 }
 
@@ -261,6 +273,11 @@ func (t *defaultModelBuildTask) run(ctx context.Context) error {
 }
 
 func (t *defaultModelBuildTask) buildModel(ctx context.Context) error {
+	classParams, err := t.classParamsLoader.Load(ctx, t.service)
+	if err != nil {
+		return ctrlerrors.NewErrorWithMetrics(controllerName, "load_service_class_params_error", err, t.metricsCollector)
+	}
+	t.classParams = classParams
 	scheme, err := t.buildLoadBalancerScheme(ctx)
 	if err != nil {
 		return ctrlerrors.NewErrorWithMetrics(controllerName, "build_load_balancer_scheme_error", err, t.metricsCollector)
@@ -281,6 +298,14 @@ func (t *defaultModelBuildTask) buildModel(ctx context.Context) error {
 	if err != nil {
 		return ctrlerrors.NewErrorWithMetrics(controllerName, "build_listeners_error", err, t.metricsCollector)
 	}
+	err = t.buildVPCEndpointService(ctx)
+	if err != nil {
+		return ctrlerrors.NewErrorWithMetrics(controllerName, "build_vpc_endpoint_service_error", err, t.metricsCollector)
+	}
+	err = t.buildMetricAlarms(ctx)
+	if err != nil {
+		return ctrlerrors.NewErrorWithMetrics(controllerName, "build_metric_alarms_error", err, t.metricsCollector)
+	}
 	return nil
 }
 
@@ -299,3 +324,19 @@ func (t *defaultModelBuildTask) getDeletionProtectionViaAnnotation(svc corev1.Se
 	}
 	return false, nil
 }
+
+// enforcedClassSettings returns the settings that the ServiceClassParams enforces on the service, if any.
+func (t *defaultModelBuildTask) enforcedClassSettings() *elbv2api.ServiceLoadBalancerSettings {
+	if t.classParams == nil {
+		return nil
+	}
+	return t.classParams.Spec.Enforced
+}
+
+// defaultClassSettings returns the settings that the ServiceClassParams defaults for the service, if any.
+func (t *defaultModelBuildTask) defaultClassSettings() *elbv2api.ServiceLoadBalancerSettings {
+	if t.classParams == nil {
+		return nil
+	}
+	return t.classParams.Spec.Defaults
+}
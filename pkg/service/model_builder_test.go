@@ -21,6 +21,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/v3/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/config"
@@ -7141,11 +7142,14 @@ func Test_defaultModelBuilderTask_Build(t *testing.T) {
 				mockMetricsCollector := lbcmetrics.NewMockCollector()
 				k8sSchema := runtime.NewScheme()
 				clientgoscheme.AddToScheme(k8sSchema)
+				elbv2api.AddToScheme(k8sSchema)
 				k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
 				enhancedBackendBuilder := NewDefaultEnhancedBackendBuilder(k8sClient, annotationParser, logr.Logger{})
+				classParamsLoader := NewDefaultClassParamsLoader(k8sClient, "service.k8s.aws/nlb")
 				builder := NewDefaultModelBuilder(annotationParser, subnetsResolver, vpcInfoProvider, "vpc-xxx", trackingProvider, elbv2TaggingManager, ec2Client, featureGates,
 					"my-cluster", nil, nil, "ELBSecurityPolicy-2016-08", defaultTargetType, defaultLoadBalancerScheme, enableIPTargetType, serviceUtils,
-					backendSGProvider, sgResolver, tt.enableBackendSG, tt.enableManageBackendSGRules, tt.disableRestrictedSGRules, logr.New(&log.NullLogSink{}), mockMetricsCollector, tcpUdpEnabled, enhancedBackendBuilder)
+					backendSGProvider, sgResolver, tt.enableBackendSG, tt.enableManageBackendSGRules, tt.disableRestrictedSGRules, false, logr.New(&log.NullLogSink{}), mockMetricsCollector, tcpUdpEnabled, enhancedBackendBuilder,
+					classParamsLoader)
 				ctx := context.Background()
 				stack, _, _, err := builder.Build(ctx, tt.svc, mockMetricsCollector)
 				if tt.wantError {
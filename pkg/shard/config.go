@@ -0,0 +1,52 @@
+package shard
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagEnableSharding          = "enable-sharding"
+	flagShardLeaseDuration      = "shard-lease-duration"
+	flagShardLeaseRenewInterval = "shard-lease-renew-interval"
+
+	defaultShardLeaseDuration      = 30 * time.Second
+	defaultShardLeaseRenewInterval = 10 * time.Second
+)
+
+// Config contains the configurations for sharded controller replicas.
+type Config struct {
+	// EnableSharding specifies whether TargetGroupBindings, Services and Ingress groups are sharded across controller replicas.
+	EnableSharding bool
+	// LeaseDuration is the duration after which a replica that stopped renewing its shard lease is removed from the shard membership.
+	LeaseDuration time.Duration
+	// LeaseRenewInterval is the interval at which a replica renews its shard lease and refreshes the shard membership.
+	LeaseRenewInterval time.Duration
+}
+
+// BindFlags binds the command line flags to the fields in the config object
+func (cfg *Config) BindFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&cfg.EnableSharding, flagEnableSharding, false,
+		"If enabled, TargetGroupBindings, Services and Ingress groups are distributed across controller replicas, "+
+			"while cluster-global work stays on the elected leader")
+	fs.DurationVar(&cfg.LeaseDuration, flagShardLeaseDuration, defaultShardLeaseDuration,
+		"Duration after which a controller replica that stopped renewing its shard lease is removed from the shard membership")
+	fs.DurationVar(&cfg.LeaseRenewInterval, flagShardLeaseRenewInterval, defaultShardLeaseRenewInterval,
+		"Interval at which a controller replica renews its shard lease and refreshes the shard membership")
+}
+
+// Validate the sharding configuration
+func (cfg *Config) Validate() error {
+	if !cfg.EnableSharding {
+		return nil
+	}
+	if cfg.LeaseRenewInterval <= 0 {
+		return errors.Errorf("%v must be positive", flagShardLeaseRenewInterval)
+	}
+	if cfg.LeaseDuration <= cfg.LeaseRenewInterval {
+		return errors.Errorf("%v must be greater than %v", flagShardLeaseDuration, flagShardLeaseRenewInterval)
+	}
+	return nil
+}
@@ -0,0 +1,227 @@
+package shard
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrlerrors "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/error"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// LabelShardKeyGroup is the label on key leases that identifies the group of replicas sharing the work.
+	LabelShardKeyGroup = "elbv2.k8s.aws/shard-key-group"
+	// annotationShardKey records the object fenced by a key lease.
+	annotationShardKey = "elbv2.k8s.aws/shard-key"
+
+	// keyLeaseGCInterval is the interval at which the global shard deletes expired key leases.
+	keyLeaseGCInterval = 10 * time.Minute
+)
+
+// heldKey is a key lease held by this replica.
+type heldKey struct {
+	key types.NamespacedName
+	// resourceVersion is the resourceVersion of the key lease as last written by this replica,
+	// it guards the release against deleting the lease once another replica took it over.
+	resourceVersion string
+	expiry          time.Time
+	// inFlight is the number of reconciliations of the key in progress on this replica.
+	inFlight int
+}
+
+// Acquire fences the reconciliation of key with a key lease, so that two replicas never reconcile the same object
+// while the shard membership is eventually consistent across replicas.
+// The key lease is only written when it's about to expire, and it's released once the key moved to another replica.
+func (m *defaultManager) Acquire(ctx context.Context, kind string, key types.NamespacedName) (func(), bool, error) {
+	if !m.Owns(key) {
+		return nil, false, nil
+	}
+	leaseName := m.keyLeaseName(kind, key)
+	now := m.clock()
+
+	m.keyMutex.Lock()
+	held, exists := m.heldKeys[leaseName]
+	if !exists {
+		held = &heldKey{key: key}
+		m.heldKeys[leaseName] = held
+	}
+	held.inFlight++
+	if held.expiry.Sub(now) > m.renewInterval {
+		m.keyMutex.Unlock()
+		return m.keyReleaseFunc(leaseName), true, nil
+	}
+	m.keyMutex.Unlock()
+
+	resourceVersion, err := m.acquireKeyLease(ctx, leaseName, kind, key, now)
+
+	m.keyMutex.Lock()
+	defer m.keyMutex.Unlock()
+	if err != nil {
+		held.inFlight--
+		if held.inFlight == 0 && len(held.resourceVersion) == 0 {
+			delete(m.heldKeys, leaseName)
+		}
+		return nil, true, err
+	}
+	held.resourceVersion = resourceVersion
+	held.expiry = now.Add(m.leaseDuration)
+	return m.keyReleaseFunc(leaseName), true, nil
+}
+
+func (m *defaultManager) keyReleaseFunc(leaseName string) func() {
+	return func() {
+		m.keyMutex.Lock()
+		defer m.keyMutex.Unlock()
+		if held, exists := m.heldKeys[leaseName]; exists {
+			held.inFlight--
+		}
+	}
+}
+
+// acquireKeyLease creates or renews the key lease for this replica, and returns its resourceVersion.
+func (m *defaultManager) acquireKeyLease(ctx context.Context, leaseName string, kind string, key types.NamespacedName, now time.Time) (string, error) {
+	microNow := metav1.NewMicroTime(now)
+	leaseDurationSeconds := int32(m.leaseDuration.Seconds())
+	lease := &coordinationv1.Lease{}
+	if err := m.apiReader.Get(ctx, types.NamespacedName{Namespace: m.namespace, Name: leaseName}, lease); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return "", err
+		}
+		lease = &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: m.namespace,
+				Name:      leaseName,
+				Labels: map[string]string{
+					LabelShardKeyGroup: m.shardGroup,
+				},
+				Annotations: map[string]string{
+					annotationShardKey: fmt.Sprintf("%v/%v", kind, key),
+				},
+			},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       ptr.To(m.identity),
+				LeaseDurationSeconds: ptr.To(leaseDurationSeconds),
+				AcquireTime:          &microNow,
+				RenewTime:            &microNow,
+			},
+		}
+		if err := m.k8sClient.Create(ctx, lease); err != nil {
+			if apierrors.IsAlreadyExists(err) {
+				return "", ctrlerrors.NewRequeueNeededAfter(fmt.Sprintf("%v %v is being acquired by another shard", kind, key), m.renewInterval)
+			}
+			return "", err
+		}
+		return lease.ResourceVersion, nil
+	}
+
+	holder := ptr.Deref(lease.Spec.HolderIdentity, "")
+	if holder != m.identity {
+		if expiry, ok := leaseExpiry(lease); ok && now.Before(expiry) {
+			return "", ctrlerrors.NewRequeueNeededAfter(fmt.Sprintf("%v %v is held by shard %v", kind, key, holder), expiry.Sub(now))
+		}
+	}
+	oldLease := lease.DeepCopy()
+	if holder != m.identity {
+		lease.Spec.AcquireTime = &microNow
+	}
+	lease.Spec.HolderIdentity = ptr.To(m.identity)
+	lease.Spec.LeaseDurationSeconds = ptr.To(leaseDurationSeconds)
+	lease.Spec.RenewTime = &microNow
+	if err := m.k8sClient.Patch(ctx, lease, client.MergeFromWithOptions(oldLease, client.MergeFromWithOptimisticLock{})); err != nil {
+		if apierrors.IsConflict(err) {
+			return "", ctrlerrors.NewRequeueNeededAfter(fmt.Sprintf("%v %v is being acquired by another shard", kind, key), m.renewInterval)
+		}
+		return "", err
+	}
+	return lease.ResourceVersion, nil
+}
+
+// releaseKeyLeases deletes the key leases selected by shouldRelease that aren't being reconciled on this replica.
+// key leases that expired are forgotten, they're deleted by the global shard.
+func (m *defaultManager) releaseKeyLeases(ctx context.Context, shouldRelease func(held *heldKey) bool) {
+	now := m.clock()
+	resourceVersionByLeaseName := make(map[string]string)
+	m.keyMutex.Lock()
+	for leaseName, held := range m.heldKeys {
+		if held.inFlight > 0 {
+			continue
+		}
+		if !now.Before(held.expiry) {
+			delete(m.heldKeys, leaseName)
+			continue
+		}
+		if shouldRelease(held) {
+			delete(m.heldKeys, leaseName)
+			resourceVersionByLeaseName[leaseName] = held.resourceVersion
+		}
+	}
+	m.keyMutex.Unlock()
+
+	for leaseName, resourceVersion := range resourceVersionByLeaseName {
+		if err := m.deleteKeyLease(ctx, leaseName, resourceVersion); err != nil {
+			m.logger.Error(err, "failed to release key lease", "lease", leaseName)
+		}
+	}
+}
+
+// gcKeyLeases deletes the key leases that expired at least one lease duration ago,
+// they're left behind by deleted objects and by replicas that terminated without releasing them.
+func (m *defaultManager) gcKeyLeases(ctx context.Context) error {
+	now := m.clock()
+	if now.Sub(m.lastKeyLeaseGC) < keyLeaseGCInterval {
+		return nil
+	}
+	leaseList := &coordinationv1.LeaseList{}
+	if err := m.apiReader.List(ctx, leaseList, client.InNamespace(m.namespace),
+		client.MatchingLabels{LabelShardKeyGroup: m.shardGroup}); err != nil {
+		return err
+	}
+	for _, lease := range leaseList.Items {
+		expiry, ok := leaseExpiry(&lease)
+		if ok && now.Before(expiry.Add(m.leaseDuration)) {
+			continue
+		}
+		if err := m.deleteKeyLease(ctx, lease.Name, lease.ResourceVersion); err != nil {
+			return err
+		}
+	}
+	m.lastKeyLeaseGC = now
+	return nil
+}
+
+func (m *defaultManager) deleteKeyLease(ctx context.Context, leaseName string, resourceVersion string) error {
+	lease := &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: m.namespace,
+			Name:      leaseName,
+		},
+	}
+	err := m.k8sClient.Delete(ctx, lease, client.Preconditions{ResourceVersion: ptr.To(resourceVersion)})
+	if apierrors.IsNotFound(err) || apierrors.IsConflict(err) {
+		return nil
+	}
+	return err
+}
+
+func (m *defaultManager) keyLeaseName(kind string, key types.NamespacedName) string {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(kind))
+	_, _ = h.Write([]byte("/"))
+	_, _ = h.Write([]byte(key.String()))
+	return fmt.Sprintf("%v-key-%016x", m.shardGroup, h.Sum64())
+}
+
+// leaseExpiry returns when the lease expires, or false if the lease was never renewed.
+func leaseExpiry(lease *coordinationv1.Lease) (time.Time, bool) {
+	if lease.Spec.HolderIdentity == nil || lease.Spec.RenewTime == nil || lease.Spec.LeaseDurationSeconds == nil {
+		return time.Time{}, false
+	}
+	return lease.Spec.RenewTime.Add(time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second), true
+}
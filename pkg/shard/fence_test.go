@@ -0,0 +1,105 @@
+package shard
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrlerrors "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/error"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_defaultManager_Acquire(t *testing.T) {
+	ctx := context.Background()
+	k8sSchema := runtime.NewScheme()
+	clientgoscheme.AddToScheme(k8sSchema)
+	k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+
+	now := time.Now().Truncate(time.Second)
+	cfg := Config{EnableSharding: true, LeaseDuration: 30 * time.Second, LeaseRenewInterval: 10 * time.Second}
+	newManager := func(identity string, members []string) *defaultManager {
+		m := NewDefaultManager(k8sClient, k8sClient, cfg, "lbc", "kube-system", identity, nil, logr.New(&log.NullLogSink{}))
+		m.clock = func() time.Time { return now }
+		m.updateMembers(members)
+		return m
+	}
+	key := types.NamespacedName{Namespace: "default", Name: "tgb"}
+	// replica-a still believes it's the only member, while replica-b already observed that replica-a is gone.
+	replicaA := newManager("replica-a", []string{"replica-a"})
+	replicaB := newManager("replica-b", []string{"replica-b"})
+
+	releaseA, owned, err := replicaA.Acquire(ctx, "targetGroupBinding", key)
+	assert.NoError(t, err)
+	assert.True(t, owned)
+
+	_, owned, err = replicaB.Acquire(ctx, "targetGroupBinding", key)
+	assert.True(t, owned)
+	var requeueNeededAfter *ctrlerrors.RequeueNeededAfter
+	assert.ErrorAs(t, err, &requeueNeededAfter)
+	assert.Equal(t, cfg.LeaseDuration, requeueNeededAfter.Duration())
+
+	// the same key of another kind is fenced independently.
+	releaseB, owned, err := replicaB.Acquire(ctx, "service", key)
+	assert.NoError(t, err)
+	assert.True(t, owned)
+	releaseB()
+
+	// the key lease isn't released while the key is being reconciled.
+	releaseA()
+	leaseList := &coordinationv1.LeaseList{}
+	assert.NoError(t, k8sClient.List(ctx, leaseList, client.MatchingLabels{LabelShardKeyGroup: "lbc"}))
+	assert.Len(t, leaseList.Items, 2)
+
+	// once replica-a observes the membership change, it hands the key over.
+	replicaA.updateMembers([]string{"replica-b"})
+	replicaA.releaseKeyLeases(ctx, func(held *heldKey) bool { return !replicaA.Owns(held.key) })
+	assert.Empty(t, replicaA.heldKeys)
+
+	releaseB, owned, err = replicaB.Acquire(ctx, "targetGroupBinding", key)
+	assert.NoError(t, err)
+	assert.True(t, owned)
+	releaseB()
+
+	_, owned, err = replicaA.Acquire(ctx, "targetGroupBinding", key)
+	assert.NoError(t, err)
+	assert.False(t, owned)
+}
+
+func Test_defaultManager_gcKeyLeases(t *testing.T) {
+	ctx := context.Background()
+	k8sSchema := runtime.NewScheme()
+	clientgoscheme.AddToScheme(k8sSchema)
+	k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+
+	now := time.Now().Truncate(time.Second)
+	cfg := Config{EnableSharding: true, LeaseDuration: 30 * time.Second, LeaseRenewInterval: 10 * time.Second}
+	m := NewDefaultManager(k8sClient, k8sClient, cfg, "lbc", "kube-system", "replica-a", nil, logr.New(&log.NullLogSink{}))
+	m.clock = func() time.Time { return now }
+	m.updateMembers([]string{"replica-a"})
+
+	staleKey := types.NamespacedName{Namespace: "default", Name: "deleted"}
+	liveKey := types.NamespacedName{Namespace: "default", Name: "live"}
+	_, _, err := m.Acquire(ctx, "service", staleKey)
+	assert.NoError(t, err)
+	now = now.Add(time.Minute)
+	_, _, err = m.Acquire(ctx, "service", liveKey)
+	assert.NoError(t, err)
+
+	// the stale key lease expired 40 seconds ago, the live one expires in 20 seconds.
+	now = now.Add(10 * time.Second)
+	assert.NoError(t, m.gcKeyLeases(ctx))
+
+	leaseList := &coordinationv1.LeaseList{}
+	assert.NoError(t, k8sClient.List(ctx, leaseList, client.MatchingLabels{LabelShardKeyGroup: "lbc"}))
+	if assert.Len(t, leaseList.Items, 1) {
+		assert.Equal(t, m.keyLeaseName("service", liveKey), leaseList.Items[0].Name)
+	}
+}
@@ -0,0 +1,38 @@
+package shard
+
+import (
+	"hash/fnv"
+)
+
+// ownerOf returns the member that owns key, or empty string if there are no members.
+// It uses rendezvous hashing, so that on membership change only the keys owned by the joined or departed members move.
+func ownerOf(members []string, key string) string {
+	var owner string
+	var ownerScore uint64
+	for _, member := range members {
+		score := rendezvousScore(member, key)
+		if owner == "" || score > ownerScore || (score == ownerScore && member < owner) {
+			owner = member
+			ownerScore = score
+		}
+	}
+	return owner
+}
+
+func rendezvousScore(member string, key string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(member))
+	_, _ = h.Write([]byte("/"))
+	_, _ = h.Write([]byte(key))
+	return mix64(h.Sum64())
+}
+
+// mix64 is the splitmix64 finalizer, it improves the avalanche of fnv for keys that only differ in their last bytes.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
@@ -0,0 +1,60 @@
+package shard
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ownerOf(t *testing.T) {
+	tests := []struct {
+		name    string
+		members []string
+		key     string
+		want    string
+	}{
+		{
+			name:    "no members",
+			members: nil,
+			key:     "default/tgb",
+			want:    "",
+		},
+		{
+			name:    "single member owns all keys",
+			members: []string{"replica-a"},
+			key:     "default/tgb",
+			want:    "replica-a",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ownerOf(tt.members, tt.key)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_ownerOf_distribution(t *testing.T) {
+	members := []string{"replica-a", "replica-b", "replica-c"}
+	ownedCount := make(map[string]int)
+	for i := 0; i < 3000; i++ {
+		ownedCount[ownerOf(members, fmt.Sprintf("default/tgb-%d", i))]++
+	}
+	for _, member := range members {
+		assert.InDelta(t, 1000, ownedCount[member], 150, "member %v", member)
+	}
+}
+
+func Test_ownerOf_membershipChange(t *testing.T) {
+	members := []string{"replica-a", "replica-b", "replica-c"}
+	membersAfterLeave := []string{"replica-a", "replica-c"}
+	for i := 0; i < 1000; i++ {
+		key := fmt.Sprintf("default/tgb-%d", i)
+		ownerBefore := ownerOf(members, key)
+		ownerAfter := ownerOf(membersAfterLeave, key)
+		if ownerBefore != "replica-b" {
+			assert.Equal(t, ownerBefore, ownerAfter, "key %v moved between remaining members", key)
+		}
+	}
+}
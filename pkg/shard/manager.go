@@ -0,0 +1,320 @@
+package shard
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+const (
+	// LabelShardGroup is the label on shard leases that identifies the group of replicas sharing the work.
+	LabelShardGroup = "elbv2.k8s.aws/shard-group"
+
+	inClusterNamespacePath = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+	releaseLeaseTimeout    = 5 * time.Second
+)
+
+// Manager decides which controller replica owns an object when sharding is enabled.
+type Manager interface {
+	// Enabled returns whether sharding is enabled.
+	// When enabled, sharded reconcilers run on every replica instead of only the elected leader.
+	Enabled() bool
+
+	// Owns returns whether the object identified by key is owned by this replica.
+	Owns(key types.NamespacedName) bool
+
+	// Acquire fences the reconciliation of the object of kind identified by key to this replica.
+	// It returns owned as false if the object is owned by another replica, and a RequeueNeededAfter error while
+	// another replica still holds the key, e.g. until it has observed a shard membership change.
+	// release must be invoked once the reconciliation is done.
+	Acquire(ctx context.Context, kind string, key types.NamespacedName) (release func(), owned bool, err error)
+
+	// IsGlobalShard returns whether this replica is responsible for cluster-global work.
+	IsGlobalShard() bool
+
+	// Subscribe returns a channel that gets notified whenever the shard membership changes or this replica becomes the global shard.
+	Subscribe() <-chan struct{}
+}
+
+// NewNoopManager constructs a Manager for unsharded deployments, where the elected leader owns everything.
+func NewNoopManager() Manager {
+	return &noopManager{}
+}
+
+var _ Manager = &noopManager{}
+
+type noopManager struct{}
+
+func (m *noopManager) Enabled() bool {
+	return false
+}
+
+func (m *noopManager) Owns(_ types.NamespacedName) bool {
+	return true
+}
+
+func (m *noopManager) Acquire(_ context.Context, _ string, _ types.NamespacedName) (func(), bool, error) {
+	return func() {}, true, nil
+}
+
+func (m *noopManager) IsGlobalShard() bool {
+	return true
+}
+
+func (m *noopManager) Subscribe() <-chan struct{} {
+	return nil
+}
+
+// NewDefaultManager constructs a lease based Manager.
+// Each replica maintains its own Lease labeled with shardGroup, live leases form the shard membership,
+// and the replica that wins the leader election through elected is the global shard.
+func NewDefaultManager(k8sClient client.Client, apiReader client.Reader, cfg Config, shardGroup string, namespace string, identity string,
+	elected <-chan struct{}, logger logr.Logger) *defaultManager {
+	return &defaultManager{
+		k8sClient:     k8sClient,
+		apiReader:     apiReader,
+		shardGroup:    shardGroup,
+		namespace:     namespace,
+		identity:      identity,
+		leaseDuration: cfg.LeaseDuration,
+		renewInterval: cfg.LeaseRenewInterval,
+		elected:       elected,
+		logger:        logger,
+		clock:         time.Now,
+		heldKeys:      make(map[string]*heldKey),
+	}
+}
+
+var _ Manager = &defaultManager{}
+var _ manager.Runnable = &defaultManager{}
+var _ manager.LeaderElectionRunnable = &defaultManager{}
+
+type defaultManager struct {
+	k8sClient client.Client
+	// apiReader reads leases directly from the API server, so that no cluster-wide lease informer is needed.
+	apiReader     client.Reader
+	shardGroup    string
+	namespace     string
+	identity      string
+	leaseDuration time.Duration
+	renewInterval time.Duration
+	elected       <-chan struct{}
+	logger        logr.Logger
+	clock         func() time.Time
+
+	isGlobalShard atomic.Bool
+
+	// mutex protects members and subscribers
+	mutex       sync.RWMutex
+	members     []string
+	subscribers []chan struct{}
+
+	// keyMutex protects heldKeys
+	keyMutex sync.Mutex
+	// heldKeys are the key leases held by this replica, indexed by lease name.
+	heldKeys map[string]*heldKey
+	// lastKeyLeaseGC is when the global shard last garbage collected expired key leases.
+	lastKeyLeaseGC time.Time
+}
+
+func (m *defaultManager) Enabled() bool {
+	return true
+}
+
+// Owns returns false until the shard membership has been observed, the subscribers are notified once it's observed.
+func (m *defaultManager) Owns(key types.NamespacedName) bool {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	return ownerOf(m.members, key.String()) == m.identity
+}
+
+func (m *defaultManager) IsGlobalShard() bool {
+	return m.isGlobalShard.Load()
+}
+
+func (m *defaultManager) Subscribe() <-chan struct{} {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	ch := make(chan struct{}, 1)
+	m.subscribers = append(m.subscribers, ch)
+	return ch
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable, every replica participates in the shard membership.
+func (m *defaultManager) NeedLeaderElection() bool {
+	return false
+}
+
+// Start maintains the shard lease of this replica until ctx is done.
+func (m *defaultManager) Start(ctx context.Context) error {
+	go func() {
+		select {
+		case <-m.elected:
+			m.logger.Info("elected as global shard", "identity", m.identity)
+			m.isGlobalShard.Store(true)
+			m.notifySubscribers()
+		case <-ctx.Done():
+		}
+	}()
+
+	wait.UntilWithContext(ctx, m.syncMembership, m.renewInterval)
+
+	releaseCtx, cancel := context.WithTimeout(context.Background(), releaseLeaseTimeout)
+	defer cancel()
+	m.releaseKeyLeases(releaseCtx, func(_ *heldKey) bool { return true })
+	if err := m.releaseLease(releaseCtx); err != nil {
+		m.logger.Error(err, "failed to release shard lease")
+	}
+	return nil
+}
+
+func (m *defaultManager) syncMembership(ctx context.Context) {
+	if err := m.renewLease(ctx); err != nil {
+		m.logger.Error(err, "failed to renew shard lease")
+	}
+	members, err := m.listMembers(ctx)
+	if err != nil {
+		m.logger.Error(err, "failed to list shard members")
+		return
+	}
+	m.updateMembers(members)
+	// hand over the keys moved to other replicas without waiting for their key leases to expire.
+	m.releaseKeyLeases(ctx, func(held *heldKey) bool { return !m.Owns(held.key) })
+	if m.IsGlobalShard() {
+		if err := m.gcKeyLeases(ctx); err != nil {
+			m.logger.Error(err, "failed to garbage collect key leases")
+		}
+	}
+}
+
+func (m *defaultManager) renewLease(ctx context.Context) error {
+	now := metav1.NewMicroTime(m.clock())
+	leaseDurationSeconds := int32(m.leaseDuration.Seconds())
+	lease := &coordinationv1.Lease{}
+	if err := m.apiReader.Get(ctx, types.NamespacedName{Namespace: m.namespace, Name: m.leaseName()}, lease); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		lease = &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: m.namespace,
+				Name:      m.leaseName(),
+				Labels: map[string]string{
+					LabelShardGroup: m.shardGroup,
+				},
+			},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       ptr.To(m.identity),
+				LeaseDurationSeconds: ptr.To(leaseDurationSeconds),
+				AcquireTime:          &now,
+				RenewTime:            &now,
+			},
+		}
+		return m.k8sClient.Create(ctx, lease)
+	}
+	oldLease := lease.DeepCopy()
+	lease.Spec.HolderIdentity = ptr.To(m.identity)
+	lease.Spec.LeaseDurationSeconds = ptr.To(leaseDurationSeconds)
+	lease.Spec.RenewTime = &now
+	return m.k8sClient.Patch(ctx, lease, client.MergeFromWithOptions(oldLease, client.MergeFromWithOptimisticLock{}))
+}
+
+func (m *defaultManager) releaseLease(ctx context.Context) error {
+	lease := &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: m.namespace,
+			Name:      m.leaseName(),
+		},
+	}
+	return client.IgnoreNotFound(m.k8sClient.Delete(ctx, lease))
+}
+
+// listMembers returns the sorted identities of replicas holding an unexpired shard lease.
+// this replica is always a member, so that it keeps working on its slice if renewing its own lease fails transiently.
+func (m *defaultManager) listMembers(ctx context.Context) ([]string, error) {
+	leaseList := &coordinationv1.LeaseList{}
+	if err := m.apiReader.List(ctx, leaseList, client.InNamespace(m.namespace),
+		client.MatchingLabels{LabelShardGroup: m.shardGroup}); err != nil {
+		return nil, err
+	}
+	now := m.clock()
+	members := []string{m.identity}
+	for _, lease := range leaseList.Items {
+		expiry, ok := leaseExpiry(&lease)
+		if !ok || !now.Before(expiry) {
+			continue
+		}
+		if identity := *lease.Spec.HolderIdentity; !slices.Contains(members, identity) {
+			members = append(members, identity)
+		}
+	}
+	slices.Sort(members)
+	return members, nil
+}
+
+func (m *defaultManager) updateMembers(members []string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if slices.Equal(m.members, members) {
+		return
+	}
+	m.logger.Info("shard membership changed", "members", members)
+	m.members = members
+	m.notifySubscribersLocked()
+}
+
+func (m *defaultManager) notifySubscribers() {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	m.notifySubscribersLocked()
+}
+
+func (m *defaultManager) notifySubscribersLocked() {
+	for _, ch := range m.subscribers {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (m *defaultManager) leaseName() string {
+	return fmt.Sprintf("%v-shard-%v", m.shardGroup, strings.ToLower(m.identity))
+}
+
+// ResolveIdentity returns the identity of this replica, which is its pod name when running in cluster.
+func ResolveIdentity() (string, error) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to resolve shard identity")
+	}
+	return hostname, nil
+}
+
+// ResolveLeaseNamespace returns the namespace for shard leases, it defaults to the namespace this replica runs in.
+func ResolveLeaseNamespace(namespace string) (string, error) {
+	if len(namespace) != 0 {
+		return namespace, nil
+	}
+	data, err := os.ReadFile(inClusterNamespacePath)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to determine namespace for shard leases, specify it with the leader election namespace")
+	}
+	return strings.TrimSpace(string(data)), nil
+}
@@ -0,0 +1,158 @@
+package shard
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_defaultManager_syncMembership(t *testing.T) {
+	now := time.Now()
+	buildLease := func(name string, shardGroup string, identity string, renewTime time.Time) *coordinationv1.Lease {
+		return &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "kube-system",
+				Name:      name,
+				Labels: map[string]string{
+					LabelShardGroup: shardGroup,
+				},
+			},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       ptr.To(identity),
+				LeaseDurationSeconds: ptr.To(int32(30)),
+				RenewTime:            ptr.To(metav1.NewMicroTime(renewTime)),
+			},
+		}
+	}
+	tests := []struct {
+		name           string
+		existingLeases []*coordinationv1.Lease
+		wantMembers    []string
+	}{
+		{
+			name:        "only this replica",
+			wantMembers: []string{"replica-a"},
+		},
+		{
+			name: "live replicas of the same group are members",
+			existingLeases: []*coordinationv1.Lease{
+				buildLease("lbc-shard-replica-b", "lbc", "replica-b", now.Add(-10*time.Second)),
+				buildLease("lbc-shard-replica-c", "lbc", "replica-c", now),
+			},
+			wantMembers: []string{"replica-a", "replica-b", "replica-c"},
+		},
+		{
+			name: "expired leases and leases of other groups are ignored",
+			existingLeases: []*coordinationv1.Lease{
+				buildLease("lbc-shard-replica-b", "lbc", "replica-b", now.Add(-time.Minute)),
+				buildLease("other-shard-replica-c", "other", "replica-c", now),
+			},
+			wantMembers: []string{"replica-a"},
+		},
+		{
+			name: "stale lease of this replica is renewed",
+			existingLeases: []*coordinationv1.Lease{
+				buildLease("lbc-shard-replica-a", "lbc", "replica-a", now.Add(-time.Hour)),
+			},
+			wantMembers: []string{"replica-a"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			clientBuilder := testclient.NewClientBuilder().WithScheme(k8sSchema)
+			for _, lease := range tt.existingLeases {
+				clientBuilder = clientBuilder.WithObjects(lease)
+			}
+			k8sClient := clientBuilder.Build()
+
+			cfg := Config{EnableSharding: true, LeaseDuration: 30 * time.Second, LeaseRenewInterval: 10 * time.Second}
+			m := NewDefaultManager(k8sClient, k8sClient, cfg, "lbc", "kube-system", "replica-a", nil, logr.New(&log.NullLogSink{}))
+			m.clock = func() time.Time { return now }
+			membershipChanged := m.Subscribe()
+			assert.False(t, m.Owns(types.NamespacedName{Namespace: "default", Name: "tgb"}))
+
+			m.syncMembership(ctx)
+			assert.Equal(t, tt.wantMembers, m.members)
+			assert.Len(t, membershipChanged, 1)
+
+			ownLease := &coordinationv1.Lease{}
+			assert.NoError(t, k8sClient.Get(ctx, types.NamespacedName{Namespace: "kube-system", Name: "lbc-shard-replica-a"}, ownLease))
+			assert.Equal(t, "replica-a", *ownLease.Spec.HolderIdentity)
+			assert.Equal(t, now.Unix(), ownLease.Spec.RenewTime.Unix())
+			assert.Equal(t, "lbc", ownLease.Labels[LabelShardGroup])
+
+			m.syncMembership(ctx)
+			assert.Len(t, membershipChanged, 1, "unchanged membership shouldn't notify again")
+
+			assert.NoError(t, m.releaseLease(ctx))
+			err := k8sClient.Get(ctx, types.NamespacedName{Namespace: "kube-system", Name: "lbc-shard-replica-a"}, ownLease)
+			assert.True(t, err != nil)
+		})
+	}
+}
+
+func Test_defaultManager_Owns(t *testing.T) {
+	members := []string{"replica-a", "replica-b"}
+	managers := map[string]*defaultManager{}
+	for _, identity := range members {
+		m := NewDefaultManager(nil, nil, Config{}, "lbc", "kube-system", identity, nil, logr.New(&log.NullLogSink{}))
+		m.updateMembers(members)
+		managers[identity] = m
+	}
+	for i := 0; i < 100; i++ {
+		key := types.NamespacedName{Namespace: "default", Name: string(rune('a'+i%26)) + "-tgb"}
+		ownersCount := 0
+		for _, m := range managers {
+			if m.Owns(key) {
+				ownersCount++
+			}
+		}
+		assert.Equal(t, 1, ownersCount, "key %v", key)
+	}
+}
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantErr string
+	}{
+		{
+			name: "sharding disabled",
+			cfg:  Config{},
+		},
+		{
+			name: "valid",
+			cfg:  Config{EnableSharding: true, LeaseDuration: 30 * time.Second, LeaseRenewInterval: 10 * time.Second},
+		},
+		{
+			name:    "lease duration not greater than renew interval",
+			cfg:     Config{EnableSharding: true, LeaseDuration: 10 * time.Second, LeaseRenewInterval: 10 * time.Second},
+			wantErr: "shard-lease-duration must be greater than shard-lease-renew-interval",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
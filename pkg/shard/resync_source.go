@@ -0,0 +1,53 @@
+package shard
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// ListRequestsFunc lists the reconcile requests of all objects handled by a controller.
+type ListRequestsFunc func(ctx context.Context) ([]reconcile.Request, error)
+
+// HandlesFunc returns whether this replica reconciles the object identified by key.
+type HandlesFunc func(key types.NamespacedName) bool
+
+// NewResyncSource constructs a source that enqueues the requests handled by this replica whenever the shard membership changes,
+// so that objects moved to this replica get reconciled without waiting for the next event.
+func NewResyncSource(shardManager Manager, listRequests ListRequestsFunc, handles HandlesFunc, logger logr.Logger) source.Source {
+	membershipChanged := shardManager.Subscribe()
+	return source.Func(func(ctx context.Context, queue workqueue.TypedRateLimitingInterface[reconcile.Request]) error {
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-membershipChanged:
+					enqueueHandledRequests(ctx, listRequests, handles, queue, logger)
+				}
+			}
+		}()
+		return nil
+	})
+}
+
+func enqueueHandledRequests(ctx context.Context, listRequests ListRequestsFunc, handles HandlesFunc,
+	queue workqueue.TypedRateLimitingInterface[reconcile.Request], logger logr.Logger) {
+	requests, err := listRequests(ctx)
+	if err != nil {
+		logger.Error(err, "failed to list requests for shard resync")
+		return
+	}
+	handledCount := 0
+	for _, req := range requests {
+		if handles(req.NamespacedName) {
+			queue.Add(req)
+			handledCount++
+		}
+	}
+	logger.V(1).Info("enqueued handled requests for shard resync", "handled", handledCount, "total", len(requests))
+}
@@ -0,0 +1,31 @@
+package shared_constants
+
+const (
+	// VPCEndpointServiceConditionType is the condition type reporting the VPC endpoint service(AWS PrivateLink) of a LoadBalancer.
+	VPCEndpointServiceConditionType = "elbv2.k8s.aws/VPCEndpointService"
+
+	// VPCEndpointServiceConditionReasonAvailable is the condition reason when the VPC endpoint service is available,
+	// the condition message carries the service name that consumers use to create endpoints.
+	VPCEndpointServiceConditionReasonAvailable = "Available"
+)
+
+const (
+	// LogDeliveryConditionType is the condition type reporting whether LoadBalancer logs can be delivered to their S3 buckets.
+	LogDeliveryConditionType = "elbv2.k8s.aws/LogDelivery"
+
+	// LogDeliveryConditionReasonReady is the condition reason when all log buckets exist and grant log delivery.
+	LogDeliveryConditionReasonReady = "Ready"
+
+	// LogDeliveryConditionReasonBucketNotFound is the condition reason when a log bucket doesn't exist and isn't created by the controller.
+	LogDeliveryConditionReasonBucketNotFound = "BucketNotFound"
+
+	// LogDeliveryConditionReasonBucketAccessDenied is the condition reason when the controller isn't allowed to access a log bucket.
+	LogDeliveryConditionReasonBucketAccessDenied = "BucketAccessDenied"
+
+	// LogDeliveryConditionReasonBucketRegionMismatch is the condition reason when a log bucket isn't in the LoadBalancer's region.
+	LogDeliveryConditionReasonBucketRegionMismatch = "BucketRegionMismatch"
+
+	// LogDeliveryConditionReasonBucketPolicyMissingGrant is the condition reason when a log bucket policy doesn't grant log delivery,
+	// and the controller isn't allowed to patch it.
+	LogDeliveryConditionReasonBucketPolicyMissingGrant = "BucketPolicyMissingGrant"
+)
@@ -6,4 +6,10 @@ const (
 
 	// TagKeyResource AWS TagKey to denote what resource is being represented.
 	TagKeyResource = "elbv2.k8s.aws/resource"
+
+	// TagKeyAGAFederationGroup AWS TagKey that publishes a load balancer to the Federated endpoints of a GlobalAccelerator federation group.
+	TagKeyAGAFederationGroup = "aga.k8s.aws/federation-group"
+
+	// AnnotationAGAFederationGroup is the annotation on a Service, Ingress or Gateway that publishes its load balancer to a GlobalAccelerator federation group.
+	AnnotationAGAFederationGroup = "aga.k8s.aws/federation-group"
 )
@@ -0,0 +1,267 @@
+package shared_utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/pkg/errors"
+	cloudwatchmodel "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/cloudwatch"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2"
+)
+
+const (
+	// MetricAlarmUnHealthyHostCount alarms on the number of unhealthy targets of a TargetGroup.
+	MetricAlarmUnHealthyHostCount = "UnHealthyHostCount"
+	// MetricAlarmHTTPCodeELB5XXCount alarms on the number of HTTP 5XX responses generated by an Application LoadBalancer.
+	MetricAlarmHTTPCodeELB5XXCount = "HTTPCode_ELB_5XX_Count"
+	// MetricAlarmTargetResponseTime alarms on the average response time, in seconds, of the targets of a TargetGroup.
+	MetricAlarmTargetResponseTime = "TargetResponseTime"
+
+	metricAlarmNamespaceApplicationELB = "AWS/ApplicationELB"
+	metricAlarmNamespaceNetworkELB     = "AWS/NetworkELB"
+	metricAlarmDefaultPeriod           = 60
+	metricAlarmDefaultEvaluationPeriod = 3
+	metricAlarmTreatMissingData        = "notBreaching"
+)
+
+// metricAlarmDefinition describes how an alarm is built for a supported metric.
+type metricAlarmDefinition struct {
+	// whether the metric is reported per TargetGroup, or per LoadBalancer.
+	perTargetGroup     bool
+	statistic          string
+	comparisonOperator string
+	// LoadBalancer types the metric is reported for.
+	lbTypes []elbv2model.LoadBalancerType
+}
+
+var invalidMetricAlarmNamePattern = regexp.MustCompile("[[:^alnum:]]")
+
+var metricAlarmDefinitions = map[string]metricAlarmDefinition{
+	MetricAlarmUnHealthyHostCount: {
+		perTargetGroup:     true,
+		statistic:          "Maximum",
+		comparisonOperator: "GreaterThanOrEqualToThreshold",
+		lbTypes:            []elbv2model.LoadBalancerType{elbv2model.LoadBalancerTypeApplication, elbv2model.LoadBalancerTypeNetwork},
+	},
+	MetricAlarmHTTPCodeELB5XXCount: {
+		perTargetGroup:     false,
+		statistic:          "Sum",
+		comparisonOperator: "GreaterThanOrEqualToThreshold",
+		lbTypes:            []elbv2model.LoadBalancerType{elbv2model.LoadBalancerTypeApplication},
+	},
+	MetricAlarmTargetResponseTime: {
+		perTargetGroup:     true,
+		statistic:          "Average",
+		comparisonOperator: "GreaterThanThreshold",
+		lbTypes:            []elbv2model.LoadBalancerType{elbv2model.LoadBalancerTypeApplication},
+	},
+}
+
+// MetricAlarmsConfig configures the CloudWatch alarms provisioned for a LoadBalancer and its TargetGroups.
+type MetricAlarmsConfig struct {
+	// Thresholds by metric name, an alarm is only provisioned for metrics with a threshold.
+	Thresholds map[string]float64
+	// ARNs of the actions(e.g. SNS topics) to execute when an alarm transitions into ALARM state.
+	AlarmActions []string
+}
+
+// MetricAlarmTargetGroup is a TargetGroup that alarms are provisioned for.
+type MetricAlarmTargetGroup struct {
+	// ResID is the resource id of the TargetGroup within the stack.
+	ResID string
+	// TargetGroupARN is the ARN of the TargetGroup.
+	TargetGroupARN core.StringToken
+	// Thresholds by metric name that overrides the LoadBalancer level thresholds for this TargetGroup.
+	Thresholds map[string]float64
+}
+
+// IsEmpty returns whether no alarms are configured.
+func (cfg MetricAlarmsConfig) IsEmpty() bool {
+	return len(cfg.Thresholds) == 0 && len(cfg.AlarmActions) == 0
+}
+
+// ParseMetricAlarmThresholds parses thresholds in metric=value format, and validates the metric names.
+func ParseMetricAlarmThresholds(rawThresholds map[string]string) (map[string]float64, error) {
+	if len(rawThresholds) == 0 {
+		return nil, nil
+	}
+	thresholds := make(map[string]float64, len(rawThresholds))
+	for metric, rawThreshold := range rawThresholds {
+		if _, ok := metricAlarmDefinitions[metric]; !ok {
+			return nil, errors.Errorf("unsupported CloudWatch alarm metric %v, supported metrics: %v", metric, supportedMetricAlarmNames())
+		}
+		threshold, err := strconv.ParseFloat(rawThreshold, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse CloudWatch alarm threshold for metric %v", metric)
+		}
+		thresholds[metric] = threshold
+	}
+	return thresholds, nil
+}
+
+// MergeMetricAlarmsConfig merges the alarms configured by multiple sources, conflicting thresholds for the same metric are an error.
+func MergeMetricAlarmsConfig(cfgs ...MetricAlarmsConfig) (MetricAlarmsConfig, error) {
+	merged := MetricAlarmsConfig{}
+	for _, cfg := range cfgs {
+		for metric, threshold := range cfg.Thresholds {
+			if existing, ok := merged.Thresholds[metric]; ok && existing != threshold {
+				return MetricAlarmsConfig{}, errors.Errorf("conflicting CloudWatch alarm thresholds for metric %v: %v | %v", metric, existing, threshold)
+			}
+			if merged.Thresholds == nil {
+				merged.Thresholds = make(map[string]float64)
+			}
+			merged.Thresholds[metric] = threshold
+		}
+		for _, action := range cfg.AlarmActions {
+			if !containsString(merged.AlarmActions, action) {
+				merged.AlarmActions = append(merged.AlarmActions, action)
+			}
+		}
+	}
+	return merged, nil
+}
+
+// BuildMetricAlarms adds the CloudWatch alarms configured by cfg for the LoadBalancer and its TargetGroups to stack.
+func BuildMetricAlarms(stack core.Stack, clusterName string, lbType elbv2model.LoadBalancerType, lbARN core.StringToken,
+	cfg MetricAlarmsConfig, tgs []MetricAlarmTargetGroup, tags map[string]string) ([]*cloudwatchmodel.MetricAlarm, error) {
+	namespace := metricAlarmNamespaceApplicationELB
+	if lbType == elbv2model.LoadBalancerTypeNetwork {
+		namespace = metricAlarmNamespaceNetworkELB
+	}
+	if err := validateMetricAlarmThresholds(lbType, cfg.Thresholds); err != nil {
+		return nil, err
+	}
+	for _, tg := range tgs {
+		if err := validateMetricAlarmThresholds(lbType, tg.Thresholds); err != nil {
+			return nil, err
+		}
+	}
+
+	var alarms []*cloudwatchmodel.MetricAlarm
+	for _, metric := range sortedMetricNames(cfg.Thresholds) {
+		def := metricAlarmDefinitions[metric]
+		if def.perTargetGroup {
+			continue
+		}
+		alarmResID := metric
+		spec := buildMetricAlarmSpec(stack, clusterName, alarmResID, namespace, metric, def, cfg.Thresholds[metric], lbARN, nil, cfg.AlarmActions, tags)
+		alarms = append(alarms, cloudwatchmodel.NewMetricAlarm(stack, alarmResID, spec))
+	}
+
+	sortedTGs := append([]MetricAlarmTargetGroup(nil), tgs...)
+	sort.Slice(sortedTGs, func(i, j int) bool {
+		return sortedTGs[i].ResID < sortedTGs[j].ResID
+	})
+	for _, tg := range sortedTGs {
+		thresholds := make(map[string]float64, len(cfg.Thresholds)+len(tg.Thresholds))
+		for metric, threshold := range cfg.Thresholds {
+			thresholds[metric] = threshold
+		}
+		for metric, threshold := range tg.Thresholds {
+			thresholds[metric] = threshold
+		}
+		for _, metric := range sortedMetricNames(thresholds) {
+			def := metricAlarmDefinitions[metric]
+			if !def.perTargetGroup {
+				continue
+			}
+			alarmResID := fmt.Sprintf("%v/%v", tg.ResID, metric)
+			spec := buildMetricAlarmSpec(stack, clusterName, alarmResID, namespace, metric, def, thresholds[metric], lbARN, tg.TargetGroupARN, cfg.AlarmActions, tags)
+			alarms = append(alarms, cloudwatchmodel.NewMetricAlarm(stack, alarmResID, spec))
+		}
+	}
+	return alarms, nil
+}
+
+func buildMetricAlarmSpec(stack core.Stack, clusterName string, alarmResID string, namespace string, metric string, def metricAlarmDefinition,
+	threshold float64, lbARN core.StringToken, tgARN core.StringToken, alarmActions []string, tags map[string]string) cloudwatchmodel.MetricAlarmSpec {
+	description := fmt.Sprintf("%v alarm for %v", metric, stack.StackID().String())
+	return cloudwatchmodel.MetricAlarmSpec{
+		AlarmName:          buildMetricAlarmName(clusterName, stack.StackID(), alarmResID, metric),
+		AlarmDescription:   awssdk.String(description),
+		Namespace:          namespace,
+		MetricName:         metric,
+		Statistic:          def.statistic,
+		ComparisonOperator: def.comparisonOperator,
+		Threshold:          threshold,
+		Period:             metricAlarmDefaultPeriod,
+		EvaluationPeriods:  metricAlarmDefaultEvaluationPeriod,
+		TreatMissingData:   metricAlarmTreatMissingData,
+		LoadBalancerARN:    lbARN,
+		TargetGroupARN:     tgARN,
+		AlarmActions:       alarmActions,
+		Tags:               tags,
+	}
+}
+
+// buildMetricAlarmName generates a deterministic alarm name, which is unique per cluster, stack and alarm.
+func buildMetricAlarmName(clusterName string, stackID core.StackID, alarmResID string, metric string) string {
+	uuidHash := sha256.New()
+	_, _ = uuidHash.Write([]byte(clusterName))
+	_, _ = uuidHash.Write([]byte(stackID.String()))
+	_, _ = uuidHash.Write([]byte(alarmResID))
+	uuid := hex.EncodeToString(uuidHash.Sum(nil))
+
+	sanitizedNamespace := invalidMetricAlarmNamePattern.ReplaceAllString(stackID.Namespace, "")
+	sanitizedName := invalidMetricAlarmNamePattern.ReplaceAllString(stackID.Name, "")
+	if len(sanitizedNamespace) == 0 {
+		return fmt.Sprintf("k8s-%.32s-%v-%.10s", sanitizedName, metric, uuid)
+	}
+	return fmt.Sprintf("k8s-%.32s-%.32s-%v-%.10s", sanitizedNamespace, sanitizedName, metric, uuid)
+}
+
+func validateMetricAlarmThresholds(lbType elbv2model.LoadBalancerType, thresholds map[string]float64) error {
+	for metric := range thresholds {
+		def, ok := metricAlarmDefinitions[metric]
+		if !ok {
+			return errors.Errorf("unsupported CloudWatch alarm metric %v, supported metrics: %v", metric, supportedMetricAlarmNames())
+		}
+		if !containsLoadBalancerType(def.lbTypes, lbType) {
+			return errors.Errorf("CloudWatch alarm metric %v is not supported for %v LoadBalancers", metric, lbType)
+		}
+	}
+	return nil
+}
+
+func supportedMetricAlarmNames() string {
+	names := make([]string, 0, len(metricAlarmDefinitions))
+	for metric := range metricAlarmDefinitions {
+		names = append(names, metric)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+func sortedMetricNames(thresholds map[string]float64) []string {
+	names := make([]string, 0, len(thresholds))
+	for metric := range thresholds {
+		names = append(names, metric)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func containsLoadBalancerType(lbTypes []elbv2model.LoadBalancerType, lbType elbv2model.LoadBalancerType) bool {
+	for _, t := range lbTypes {
+		if t == lbType {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
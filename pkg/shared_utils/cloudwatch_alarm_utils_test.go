@@ -0,0 +1,247 @@
+package shared_utils
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	cloudwatchmodel "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/cloudwatch"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2"
+)
+
+func Test_ParseMetricAlarmThresholds(t *testing.T) {
+	tests := []struct {
+		name          string
+		rawThresholds map[string]string
+		want          map[string]float64
+		wantErr       error
+	}{
+		{
+			name: "no thresholds",
+		},
+		{
+			name: "valid thresholds",
+			rawThresholds: map[string]string{
+				"UnHealthyHostCount": "1",
+				"TargetResponseTime": "0.5",
+			},
+			want: map[string]float64{
+				"UnHealthyHostCount": 1,
+				"TargetResponseTime": 0.5,
+			},
+		},
+		{
+			name: "unsupported metric",
+			rawThresholds: map[string]string{
+				"RequestCount": "100",
+			},
+			wantErr: errors.New("unsupported CloudWatch alarm metric RequestCount, supported metrics: HTTPCode_ELB_5XX_Count, TargetResponseTime, UnHealthyHostCount"),
+		},
+		{
+			name: "invalid threshold",
+			rawThresholds: map[string]string{
+				"UnHealthyHostCount": "one",
+			},
+			wantErr: errors.New("failed to parse CloudWatch alarm threshold for metric UnHealthyHostCount: strconv.ParseFloat: parsing \"one\": invalid syntax"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseMetricAlarmThresholds(tt.rawThresholds)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_MergeMetricAlarmsConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfgs    []MetricAlarmsConfig
+		want    MetricAlarmsConfig
+		wantErr error
+	}{
+		{
+			name: "no configs",
+			want: MetricAlarmsConfig{},
+		},
+		{
+			name: "thresholds and actions are merged",
+			cfgs: []MetricAlarmsConfig{
+				{
+					Thresholds:   map[string]float64{"UnHealthyHostCount": 1},
+					AlarmActions: []string{"arn:aws:sns:us-west-2:123456789012:topic-1"},
+				},
+				{
+					Thresholds:   map[string]float64{"UnHealthyHostCount": 1, "HTTPCode_ELB_5XX_Count": 10},
+					AlarmActions: []string{"arn:aws:sns:us-west-2:123456789012:topic-1", "arn:aws:sns:us-west-2:123456789012:topic-2"},
+				},
+			},
+			want: MetricAlarmsConfig{
+				Thresholds:   map[string]float64{"UnHealthyHostCount": 1, "HTTPCode_ELB_5XX_Count": 10},
+				AlarmActions: []string{"arn:aws:sns:us-west-2:123456789012:topic-1", "arn:aws:sns:us-west-2:123456789012:topic-2"},
+			},
+		},
+		{
+			name: "conflicting thresholds",
+			cfgs: []MetricAlarmsConfig{
+				{
+					Thresholds: map[string]float64{"UnHealthyHostCount": 1},
+				},
+				{
+					Thresholds: map[string]float64{"UnHealthyHostCount": 2},
+				},
+			},
+			wantErr: errors.New("conflicting CloudWatch alarm thresholds for metric UnHealthyHostCount: 1 | 2"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MergeMetricAlarmsConfig(tt.cfgs...)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_BuildMetricAlarms(t *testing.T) {
+	type wantAlarm struct {
+		resID      string
+		metricName string
+		namespace  string
+		threshold  float64
+		perTG      bool
+	}
+	tests := []struct {
+		name       string
+		lbType     elbv2model.LoadBalancerType
+		cfg        MetricAlarmsConfig
+		tgs        []MetricAlarmTargetGroup
+		wantAlarms []wantAlarm
+		wantErr    error
+	}{
+		{
+			name:   "application LoadBalancer with LoadBalancer and TargetGroup alarms",
+			lbType: elbv2model.LoadBalancerTypeApplication,
+			cfg: MetricAlarmsConfig{
+				Thresholds: map[string]float64{
+					"UnHealthyHostCount":     1,
+					"HTTPCode_ELB_5XX_Count": 10,
+				},
+				AlarmActions: []string{"arn:aws:sns:us-west-2:123456789012:topic-1"},
+			},
+			tgs: []MetricAlarmTargetGroup{
+				{ResID: "ns/ing-svc-b:80", TargetGroupARN: core.LiteralStringToken("tg-b")},
+				{ResID: "ns/ing-svc-a:80", TargetGroupARN: core.LiteralStringToken("tg-a"), Thresholds: map[string]float64{"UnHealthyHostCount": 2, "TargetResponseTime": 0.5}},
+			},
+			wantAlarms: []wantAlarm{
+				{resID: "HTTPCode_ELB_5XX_Count", metricName: "HTTPCode_ELB_5XX_Count", namespace: "AWS/ApplicationELB", threshold: 10},
+				{resID: "ns/ing-svc-a:80/TargetResponseTime", metricName: "TargetResponseTime", namespace: "AWS/ApplicationELB", threshold: 0.5, perTG: true},
+				{resID: "ns/ing-svc-a:80/UnHealthyHostCount", metricName: "UnHealthyHostCount", namespace: "AWS/ApplicationELB", threshold: 2, perTG: true},
+				{resID: "ns/ing-svc-b:80/UnHealthyHostCount", metricName: "UnHealthyHostCount", namespace: "AWS/ApplicationELB", threshold: 1, perTG: true},
+			},
+		},
+		{
+			name:   "network LoadBalancer with TargetGroup alarms",
+			lbType: elbv2model.LoadBalancerTypeNetwork,
+			cfg: MetricAlarmsConfig{
+				Thresholds: map[string]float64{"UnHealthyHostCount": 1},
+			},
+			tgs: []MetricAlarmTargetGroup{
+				{ResID: "ns/svc:80", TargetGroupARN: core.LiteralStringToken("tg")},
+			},
+			wantAlarms: []wantAlarm{
+				{resID: "ns/svc:80/UnHealthyHostCount", metricName: "UnHealthyHostCount", namespace: "AWS/NetworkELB", threshold: 1, perTG: true},
+			},
+		},
+		{
+			name:   "network LoadBalancer with application LoadBalancer metric",
+			lbType: elbv2model.LoadBalancerTypeNetwork,
+			cfg: MetricAlarmsConfig{
+				Thresholds: map[string]float64{"HTTPCode_ELB_5XX_Count": 10},
+			},
+			wantErr: errors.New("CloudWatch alarm metric HTTPCode_ELB_5XX_Count is not supported for network LoadBalancers"),
+		},
+		{
+			name:   "network LoadBalancer with application LoadBalancer metric on TargetGroup",
+			lbType: elbv2model.LoadBalancerTypeNetwork,
+			tgs: []MetricAlarmTargetGroup{
+				{ResID: "ns/svc:80", TargetGroupARN: core.LiteralStringToken("tg"), Thresholds: map[string]float64{"TargetResponseTime": 1}},
+			},
+			wantErr: errors.New("CloudWatch alarm metric TargetResponseTime is not supported for network LoadBalancers"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stack := core.NewDefaultStack(core.StackID{Namespace: "ns", Name: "name"})
+			lbARN := core.LiteralStringToken("lb-arn")
+			got, err := BuildMetricAlarms(stack, "cluster", tt.lbType, lbARN, tt.cfg, tt.tgs, map[string]string{"k": "v"})
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+			var resAlarms []*cloudwatchmodel.MetricAlarm
+			assert.NoError(t, stack.ListResources(&resAlarms))
+			assert.Len(t, resAlarms, len(tt.wantAlarms))
+			assert.Len(t, got, len(tt.wantAlarms))
+			alarmNames := make(map[string]struct{})
+			for i, want := range tt.wantAlarms {
+				alarm := got[i]
+				assert.Equal(t, want.resID, alarm.ID())
+				assert.Equal(t, want.metricName, alarm.Spec.MetricName)
+				assert.Equal(t, want.namespace, alarm.Spec.Namespace)
+				assert.Equal(t, want.threshold, alarm.Spec.Threshold)
+				assert.Equal(t, want.perTG, alarm.Spec.TargetGroupARN != nil)
+				assert.Equal(t, tt.cfg.AlarmActions, alarm.Spec.AlarmActions)
+				assert.Equal(t, map[string]string{"k": "v"}, alarm.Spec.Tags)
+				assert.LessOrEqual(t, len(alarm.Spec.AlarmName), 255)
+				alarmNames[alarm.Spec.AlarmName] = struct{}{}
+			}
+			assert.Len(t, alarmNames, len(tt.wantAlarms))
+		})
+	}
+}
+
+func Test_buildMetricAlarmName(t *testing.T) {
+	tests := []struct {
+		name       string
+		stackID    core.StackID
+		alarmResID string
+		metric     string
+		want       string
+	}{
+		{
+			name:       "namespaced stack",
+			stackID:    core.StackID{Namespace: "my-ns", Name: "my-ingress"},
+			alarmResID: "HTTPCode_ELB_5XX_Count",
+			metric:     "HTTPCode_ELB_5XX_Count",
+			want:       "k8s-myns-myingress-HTTPCode_ELB_5XX_Count-",
+		},
+		{
+			name:       "explicit IngressGroup stack",
+			stackID:    core.StackID{Name: "my-group"},
+			alarmResID: "HTTPCode_ELB_5XX_Count",
+			metric:     "HTTPCode_ELB_5XX_Count",
+			want:       "k8s-mygroup-HTTPCode_ELB_5XX_Count-",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildMetricAlarmName("cluster", tt.stackID, tt.alarmResID, tt.metric)
+			assert.True(t, len(got) == len(tt.want)+10)
+			assert.Equal(t, tt.want, got[:len(tt.want)])
+			assert.Equal(t, got, buildMetricAlarmName("cluster", tt.stackID, tt.alarmResID, tt.metric))
+			assert.NotEqual(t, got, buildMetricAlarmName("other-cluster", tt.stackID, tt.alarmResID, tt.metric))
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package shared_utils
+
+import (
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/shared_constants"
+)
+
+// BuildFederationGroupTags builds the AWS Tags that publish a load balancer to the GlobalAccelerator federation group
+// declared by the aga.k8s.aws/federation-group annotation of the objects owning the load balancer.
+// It returns nil if no object declares a federation group, and an error if the objects declare different ones.
+func BuildFederationGroupTags(objs ...metav1.Object) (map[string]string, error) {
+	federationGroup := ""
+	for _, obj := range objs {
+		group, exists := obj.GetAnnotations()[shared_constants.AnnotationAGAFederationGroup]
+		if !exists {
+			continue
+		}
+		if group == "" {
+			return nil, errors.Errorf("annotation %v must not be empty", shared_constants.AnnotationAGAFederationGroup)
+		}
+		if federationGroup != "" && federationGroup != group {
+			return nil, errors.Errorf("conflicting federation group %v: %v | %v", shared_constants.AnnotationAGAFederationGroup, federationGroup, group)
+		}
+		federationGroup = group
+	}
+	if federationGroup == "" {
+		return nil, nil
+	}
+	return map[string]string{shared_constants.TagKeyAGAFederationGroup: federationGroup}, nil
+}
@@ -0,0 +1,64 @@
+package shared_utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestBuildFederationGroupTags(t *testing.T) {
+	newObj := func(annotations map[string]string) metav1.Object {
+		return &corev1.Service{ObjectMeta: metav1.ObjectMeta{Annotations: annotations}}
+	}
+	tests := []struct {
+		name    string
+		objs    []metav1.Object
+		want    map[string]string
+		wantErr string
+	}{
+		{
+			name: "no federation group annotation",
+			objs: []metav1.Object{newObj(nil), newObj(map[string]string{"foo": "bar"})},
+			want: nil,
+		},
+		{
+			name: "federation group annotation on one object",
+			objs: []metav1.Object{newObj(nil), newObj(map[string]string{"aga.k8s.aws/federation-group": "web"})},
+			want: map[string]string{"aga.k8s.aws/federation-group": "web"},
+		},
+		{
+			name: "same federation group annotation on multiple objects",
+			objs: []metav1.Object{
+				newObj(map[string]string{"aga.k8s.aws/federation-group": "web"}),
+				newObj(map[string]string{"aga.k8s.aws/federation-group": "web"}),
+			},
+			want: map[string]string{"aga.k8s.aws/federation-group": "web"},
+		},
+		{
+			name: "conflicting federation group annotations",
+			objs: []metav1.Object{
+				newObj(map[string]string{"aga.k8s.aws/federation-group": "web"}),
+				newObj(map[string]string{"aga.k8s.aws/federation-group": "api"}),
+			},
+			wantErr: "conflicting federation group aga.k8s.aws/federation-group: web | api",
+		},
+		{
+			name:    "empty federation group annotation",
+			objs:    []metav1.Object{newObj(map[string]string{"aga.k8s.aws/federation-group": ""})},
+			wantErr: "annotation aga.k8s.aws/federation-group must not be empty",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BuildFederationGroupTags(tt.objs...)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
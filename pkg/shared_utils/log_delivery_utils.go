@@ -0,0 +1,136 @@
+package shared_utils
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2"
+	s3model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/s3"
+)
+
+const (
+	lbAttributeAccessLogsPrefix      = "access_logs.s3"
+	lbAttributeConnectionLogsPrefix  = "connection_logs.s3"
+	lbAttributeHealthCheckLogsPrefix = "health_check_logs.s3"
+)
+
+// LogDeliveryConfig is the delivery configuration for one type of LoadBalancer logs.
+type LogDeliveryConfig struct {
+	// the name of the bucket that receives the logs.
+	Bucket string
+	// the prefix that logs are delivered under.
+	Prefix string
+	// whether the bucket policy is only validated instead of patched to grant log delivery.
+	ValidateBucketPolicyOnly bool
+	// whether the bucket is created if it doesn't exist.
+	CreateBucket bool
+	// the number of days after which log objects expire in a bucket created by the controller.
+	ExpirationDays *int32
+}
+
+// LoadBalancerLogsConfig is the delivery configuration for all types of LoadBalancer logs.
+type LoadBalancerLogsConfig struct {
+	AccessLogs      *LogDeliveryConfig
+	ConnectionLogs  *LogDeliveryConfig
+	HealthCheckLogs *LogDeliveryConfig
+}
+
+// BuildLoadBalancerLogs adds the LogBucket resources for cfg into stack,
+// and returns the LoadBalancer attributes that enable the configured logs.
+func BuildLoadBalancerLogs(stack core.Stack, lbType elbv2model.LoadBalancerType, cfg LoadBalancerLogsConfig) (map[string]string, error) {
+	if lbType != elbv2model.LoadBalancerTypeApplication {
+		if cfg.ConnectionLogs != nil {
+			return nil, errors.New("connection logs are only supported by application load balancers")
+		}
+		if cfg.HealthCheckLogs != nil {
+			return nil, errors.New("health check logs are only supported by application load balancers")
+		}
+	}
+
+	attributes := make(map[string]string)
+	bucketSpecByName := make(map[string]*s3model.LogBucketSpec)
+	for _, logs := range []struct {
+		attributePrefix string
+		cfg             *LogDeliveryConfig
+	}{
+		{attributePrefix: lbAttributeAccessLogsPrefix, cfg: cfg.AccessLogs},
+		{attributePrefix: lbAttributeConnectionLogsPrefix, cfg: cfg.ConnectionLogs},
+		{attributePrefix: lbAttributeHealthCheckLogsPrefix, cfg: cfg.HealthCheckLogs},
+	} {
+		if logs.cfg == nil {
+			continue
+		}
+		if logs.cfg.Bucket == "" {
+			return nil, errors.Errorf("bucket must be specified for %v logs", logs.attributePrefix)
+		}
+		if logs.cfg.ExpirationDays != nil && !logs.cfg.CreateBucket {
+			return nil, errors.Errorf("expiration days can only be specified for log bucket %v when it's created by the controller", logs.cfg.Bucket)
+		}
+		prefix := strings.Trim(logs.cfg.Prefix, "/")
+		attributes[logs.attributePrefix+".enabled"] = strconv.FormatBool(true)
+		attributes[logs.attributePrefix+".bucket"] = logs.cfg.Bucket
+		attributes[logs.attributePrefix+".prefix"] = prefix
+
+		desiredSpec := s3model.LogBucketSpec{
+			BucketName:         logs.cfg.Bucket,
+			Prefixes:           []string{prefix},
+			ManageBucketPolicy: !logs.cfg.ValidateBucketPolicyOnly,
+			CreateBucket:       logs.cfg.CreateBucket,
+			ExpirationDays:     logs.cfg.ExpirationDays,
+		}
+		existingSpec, exists := bucketSpecByName[logs.cfg.Bucket]
+		if !exists {
+			bucketSpecByName[logs.cfg.Bucket] = &desiredSpec
+			continue
+		}
+		if existingSpec.ManageBucketPolicy != desiredSpec.ManageBucketPolicy ||
+			existingSpec.CreateBucket != desiredSpec.CreateBucket ||
+			awssdk.ToInt32(existingSpec.ExpirationDays) != awssdk.ToInt32(desiredSpec.ExpirationDays) {
+			return nil, errors.Errorf("conflicting settings for log bucket %v", logs.cfg.Bucket)
+		}
+		existingSpec.Prefixes = append(existingSpec.Prefixes, prefix)
+	}
+
+	bucketNames := make([]string, 0, len(bucketSpecByName))
+	for bucketName := range bucketSpecByName {
+		bucketNames = append(bucketNames, bucketName)
+	}
+	sort.Strings(bucketNames)
+	for _, bucketName := range bucketNames {
+		spec := bucketSpecByName[bucketName]
+		spec.Prefixes = dedupeSortedStrings(spec.Prefixes)
+		s3model.NewLogBucket(stack, bucketName, *spec)
+	}
+	return attributes, nil
+}
+
+// MergeLoadBalancerLogAttributes merges the attributes enabling logs into the LoadBalancer attributes,
+// an explicit attribute conflicting with the log configuration is an error.
+func MergeLoadBalancerLogAttributes(attributes map[string]string, logAttributes map[string]string) (map[string]string, error) {
+	merged := make(map[string]string, len(attributes)+len(logAttributes))
+	for key, value := range attributes {
+		merged[key] = value
+	}
+	for key, value := range logAttributes {
+		if existingValue, exists := merged[key]; exists && existingValue != value {
+			return nil, errors.Errorf("conflicting load balancer attributes %v: %v | %v", key, existingValue, value)
+		}
+		merged[key] = value
+	}
+	return merged, nil
+}
+
+func dedupeSortedStrings(values []string) []string {
+	sort.Strings(values)
+	deduped := values[:0]
+	for i, value := range values {
+		if i == 0 || value != values[i-1] {
+			deduped = append(deduped, value)
+		}
+	}
+	return deduped
+}
@@ -0,0 +1,154 @@
+package shared_utils
+
+import (
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2"
+	s3model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/s3"
+)
+
+func Test_BuildLoadBalancerLogs(t *testing.T) {
+	tests := []struct {
+		name           string
+		lbType         elbv2model.LoadBalancerType
+		cfg            LoadBalancerLogsConfig
+		wantAttributes map[string]string
+		wantBuckets    []s3model.LogBucketSpec
+		wantErr        error
+	}{
+		{
+			name:           "no logs configured",
+			lbType:         elbv2model.LoadBalancerTypeApplication,
+			wantAttributes: map[string]string{},
+		},
+		{
+			name:   "all logs into one bucket",
+			lbType: elbv2model.LoadBalancerTypeApplication,
+			cfg: LoadBalancerLogsConfig{
+				AccessLogs:      &LogDeliveryConfig{Bucket: "my-logs", Prefix: "/access/"},
+				ConnectionLogs:  &LogDeliveryConfig{Bucket: "my-logs", Prefix: "connection"},
+				HealthCheckLogs: &LogDeliveryConfig{Bucket: "my-logs", Prefix: "access"},
+			},
+			wantAttributes: map[string]string{
+				"access_logs.s3.enabled":       "true",
+				"access_logs.s3.bucket":        "my-logs",
+				"access_logs.s3.prefix":        "access",
+				"connection_logs.s3.enabled":   "true",
+				"connection_logs.s3.bucket":    "my-logs",
+				"connection_logs.s3.prefix":    "connection",
+				"health_check_logs.s3.enabled": "true",
+				"health_check_logs.s3.bucket":  "my-logs",
+				"health_check_logs.s3.prefix":  "access",
+			},
+			wantBuckets: []s3model.LogBucketSpec{
+				{
+					BucketName:         "my-logs",
+					Prefixes:           []string{"access", "connection"},
+					ManageBucketPolicy: true,
+				},
+			},
+		},
+		{
+			name:   "access logs for network load balancer into created bucket",
+			lbType: elbv2model.LoadBalancerTypeNetwork,
+			cfg: LoadBalancerLogsConfig{
+				AccessLogs: &LogDeliveryConfig{Bucket: "nlb-logs", ValidateBucketPolicyOnly: true, CreateBucket: true, ExpirationDays: awssdk.Int32(7)},
+			},
+			wantAttributes: map[string]string{
+				"access_logs.s3.enabled": "true",
+				"access_logs.s3.bucket":  "nlb-logs",
+				"access_logs.s3.prefix":  "",
+			},
+			wantBuckets: []s3model.LogBucketSpec{
+				{
+					BucketName:     "nlb-logs",
+					Prefixes:       []string{""},
+					CreateBucket:   true,
+					ExpirationDays: awssdk.Int32(7),
+				},
+			},
+		},
+		{
+			name:   "connection logs for network load balancer",
+			lbType: elbv2model.LoadBalancerTypeNetwork,
+			cfg: LoadBalancerLogsConfig{
+				ConnectionLogs: &LogDeliveryConfig{Bucket: "my-logs"},
+			},
+			wantErr: errors.New("connection logs are only supported by application load balancers"),
+		},
+		{
+			name:   "expiration without bucket creation",
+			lbType: elbv2model.LoadBalancerTypeApplication,
+			cfg: LoadBalancerLogsConfig{
+				AccessLogs: &LogDeliveryConfig{Bucket: "my-logs", ExpirationDays: awssdk.Int32(7)},
+			},
+			wantErr: errors.New("expiration days can only be specified for log bucket my-logs when it's created by the controller"),
+		},
+		{
+			name:   "conflicting settings for one bucket",
+			lbType: elbv2model.LoadBalancerTypeApplication,
+			cfg: LoadBalancerLogsConfig{
+				AccessLogs:     &LogDeliveryConfig{Bucket: "my-logs"},
+				ConnectionLogs: &LogDeliveryConfig{Bucket: "my-logs", ValidateBucketPolicyOnly: true},
+			},
+			wantErr: errors.New("conflicting settings for log bucket my-logs"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stack := core.NewDefaultStack(core.StackID{Namespace: "ns", Name: "name"})
+			got, err := BuildLoadBalancerLogs(stack, tt.lbType, tt.cfg)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantAttributes, got)
+			var resBuckets []*s3model.LogBucket
+			assert.NoError(t, stack.ListResources(&resBuckets))
+			var gotBuckets []s3model.LogBucketSpec
+			for _, resBucket := range resBuckets {
+				gotBuckets = append(gotBuckets, resBucket.Spec)
+			}
+			assert.Equal(t, tt.wantBuckets, gotBuckets)
+		})
+	}
+}
+
+func Test_MergeLoadBalancerLogAttributes(t *testing.T) {
+	tests := []struct {
+		name          string
+		attributes    map[string]string
+		logAttributes map[string]string
+		want          map[string]string
+		wantErr       error
+	}{
+		{
+			name:          "merged with matching attributes",
+			attributes:    map[string]string{"idle_timeout.timeout_seconds": "60", "access_logs.s3.enabled": "true"},
+			logAttributes: map[string]string{"access_logs.s3.enabled": "true", "access_logs.s3.bucket": "my-logs"},
+			want:          map[string]string{"idle_timeout.timeout_seconds": "60", "access_logs.s3.enabled": "true", "access_logs.s3.bucket": "my-logs"},
+		},
+		{
+			name:          "conflicting attributes",
+			attributes:    map[string]string{"access_logs.s3.bucket": "other-logs"},
+			logAttributes: map[string]string{"access_logs.s3.bucket": "my-logs"},
+			wantErr:       errors.New("conflicting load balancer attributes access_logs.s3.bucket: other-logs | my-logs"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MergeLoadBalancerLogAttributes(tt.attributes, tt.logAttributes)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package shared_utils
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// TrustStoreCABundleKindSecret indicates the CA bundle is stored in a Secret.
+	TrustStoreCABundleKindSecret = "Secret"
+	// TrustStoreCABundleKindConfigMap indicates the CA bundle is stored in a ConfigMap.
+	TrustStoreCABundleKindConfigMap = "ConfigMap"
+	// DefaultTrustStoreCABundleKey is the default key of the CA bundle within the Secret or ConfigMap.
+	DefaultTrustStoreCABundleKey = "ca.crt"
+)
+
+var invalidTrustStoreNamePattern = regexp.MustCompile("[[:^alnum:]]")
+
+// TrustStoreCABundleRef references the CA bundle and certificate revocation lists of a trust store managed by the controller.
+type TrustStoreCABundleRef struct {
+	// Kind of the object holding the CA bundle, either Secret or ConfigMap. Defaults to Secret.
+	Kind string
+	// Namespace of the object holding the CA bundle.
+	Namespace string
+	// Name of the object holding the CA bundle.
+	Name string
+	// Key of the PEM encoded CA bundle within the object. Defaults to ca.crt.
+	Key string
+	// Keys of certificate revocation lists within the object.
+	RevocationListKeys []string
+}
+
+// BuildManagedTrustStore loads the CA bundle referenced by ref and adds a TrustStore for it to stack,
+// listeners referencing the same CA bundle share the TrustStore.
+// when the CA bundle is stored in a Secret, the Secret is returned so that it can be monitored for rotation.
+func BuildManagedTrustStore(ctx context.Context, stack core.Stack, k8sClient client.Client, secretsManager k8s.SecretsManager,
+	clusterName string, tags map[string]string, ref TrustStoreCABundleRef) (*elbv2model.TrustStore, *types.NamespacedName, error) {
+	ts, secretKeys, err := BuildManagedTrustStoreFromCABundles(ctx, stack, k8sClient, secretsManager, clusterName, tags, []TrustStoreCABundleRef{ref})
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(secretKeys) == 0 {
+		return ts, nil, nil
+	}
+	return ts, &secretKeys[0], nil
+}
+
+// BuildManagedTrustStoreFromCABundles loads the CA bundles referenced by refs and adds a TrustStore for their concatenation to stack,
+// listeners referencing the same CA bundles share the TrustStore.
+// the Secrets holding CA bundles are returned so that they can be monitored for rotation.
+func BuildManagedTrustStoreFromCABundles(ctx context.Context, stack core.Stack, k8sClient client.Client, secretsManager k8s.SecretsManager,
+	clusterName string, tags map[string]string, refs []TrustStoreCABundleRef) (*elbv2model.TrustStore, []types.NamespacedName, error) {
+	if len(refs) == 0 {
+		return nil, nil, errors.New("no CA bundle referenced")
+	}
+	var caBundle []byte
+	var revocationLists [][]byte
+	var secretKeys []types.NamespacedName
+	tsResIDs := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		if len(ref.Kind) == 0 {
+			ref.Kind = TrustStoreCABundleKindSecret
+		}
+		if len(ref.Key) == 0 {
+			ref.Key = DefaultTrustStoreCABundleKey
+		}
+		refCABundle, refRevocationLists, secretKey, err := loadTrustStoreCABundle(ctx, k8sClient, secretsManager, ref)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(caBundle) != 0 && caBundle[len(caBundle)-1] != '\n' {
+			caBundle = append(caBundle, '\n')
+		}
+		caBundle = append(caBundle, refCABundle...)
+		revocationLists = append(revocationLists, refRevocationLists...)
+		if secretKey != nil {
+			secretKeys = append(secretKeys, *secretKey)
+		}
+		tsResIDs = append(tsResIDs, fmt.Sprintf("%v/%v/%v/%v", ref.Kind, ref.Namespace, ref.Name, ref.Key))
+	}
+
+	tsResID := strings.Join(tsResIDs, ",")
+	var existingTSs []*elbv2model.TrustStore
+	_ = stack.ListResources(&existingTSs)
+	for _, ts := range existingTSs {
+		if ts.ID() == tsResID {
+			return ts, secretKeys, nil
+		}
+	}
+
+	objKey := types.NamespacedName{Namespace: refs[0].Namespace, Name: refs[0].Name}
+	ts := elbv2model.NewTrustStore(stack, tsResID, elbv2model.TrustStoreSpec{
+		Name:                 buildManagedTrustStoreName(clusterName, stack.StackID(), tsResID, objKey),
+		CACertificatesBundle: caBundle,
+		RevocationLists:      revocationLists,
+		Digest:               computeTrustStoreDigest(caBundle, revocationLists),
+		Tags:                 tags,
+	})
+	return ts, secretKeys, nil
+}
+
+// loadTrustStoreCABundle loads the CA bundle and certificate revocation lists referenced by ref.
+func loadTrustStoreCABundle(ctx context.Context, k8sClient client.Client, secretsManager k8s.SecretsManager,
+	ref TrustStoreCABundleRef) ([]byte, [][]byte, *types.NamespacedName, error) {
+	objKey := types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}
+	data, secretKey, err := loadTrustStoreCABundleData(ctx, k8sClient, secretsManager, ref.Kind, objKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	caBundle, ok := data[ref.Key]
+	if !ok {
+		return nil, nil, nil, errors.Errorf("missing CA bundle key %v in %v %v", ref.Key, ref.Kind, objKey)
+	}
+	if !ContainsPEMCertificate(caBundle) {
+		return nil, nil, nil, errors.Errorf("no PEM encoded certificate found in CA bundle key %v of %v %v", ref.Key, ref.Kind, objKey)
+	}
+	revocationLists := make([][]byte, 0, len(ref.RevocationListKeys))
+	for _, crlKey := range ref.RevocationListKeys {
+		crl, ok := data[crlKey]
+		if !ok {
+			return nil, nil, nil, errors.Errorf("missing certificate revocation list key %v in %v %v", crlKey, ref.Kind, objKey)
+		}
+		revocationLists = append(revocationLists, crl)
+	}
+	return caBundle, revocationLists, secretKey, nil
+}
+
+func loadTrustStoreCABundleData(ctx context.Context, k8sClient client.Client, secretsManager k8s.SecretsManager,
+	kind string, objKey types.NamespacedName) (map[string][]byte, *types.NamespacedName, error) {
+	switch kind {
+	case TrustStoreCABundleKindSecret:
+		secret, err := secretsManager.GetSecret(ctx, k8sClient, objKey)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to load CA bundle from Secret %v", objKey)
+		}
+		return secret.Data, &objKey, nil
+	case TrustStoreCABundleKindConfigMap:
+		cm := &corev1.ConfigMap{}
+		if err := k8sClient.Get(ctx, objKey, cm); err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to load CA bundle from ConfigMap %v", objKey)
+		}
+		data := make(map[string][]byte, len(cm.Data)+len(cm.BinaryData))
+		for key, value := range cm.Data {
+			data[key] = []byte(value)
+		}
+		for key, value := range cm.BinaryData {
+			data[key] = value
+		}
+		return data, nil, nil
+	default:
+		return nil, nil, errors.Errorf("unsupported CA bundle kind: %v, must be %v or %v", kind, TrustStoreCABundleKindSecret, TrustStoreCABundleKindConfigMap)
+	}
+}
+
+// ContainsPEMCertificate returns whether data holds at least one PEM encoded certificate.
+func ContainsPEMCertificate(data []byte) bool {
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type == "CERTIFICATE" {
+			return true
+		}
+	}
+	return false
+}
+
+// buildManagedTrustStoreName computes a name unique to the cluster, stack and CA bundle, that fits the 32 characters limit of trust store names.
+func buildManagedTrustStoreName(clusterName string, stackID core.StackID, tsResID string, objKey types.NamespacedName) string {
+	uuidHash := sha256.New()
+	_, _ = uuidHash.Write([]byte(clusterName))
+	_, _ = uuidHash.Write([]byte(stackID.String()))
+	_, _ = uuidHash.Write([]byte(tsResID))
+	uuid := hex.EncodeToString(uuidHash.Sum(nil))
+
+	sanitizedNamespace := invalidTrustStoreNamePattern.ReplaceAllString(objKey.Namespace, "")
+	sanitizedName := invalidTrustStoreNamePattern.ReplaceAllString(objKey.Name, "")
+	return fmt.Sprintf("k8s-%.8s-%.8s-%.10s", sanitizedNamespace, sanitizedName, uuid)
+}
+
+func computeTrustStoreDigest(caBundle []byte, revocationLists [][]byte) string {
+	digestHash := sha256.New()
+	_, _ = digestHash.Write(caBundle)
+	for _, crl := range revocationLists {
+		_, _ = digestHash.Write([]byte{0})
+		_, _ = digestHash.Write(crl)
+	}
+	return hex.EncodeToString(digestHash.Sum(nil))
+}
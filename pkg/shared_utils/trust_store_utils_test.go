@@ -0,0 +1,178 @@
+package shared_utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/testutils"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const testCABundle = `-----BEGIN CERTIFICATE-----
+MIIBszCCAVmgAwIBAgIUEXAMPLE=
+-----END CERTIFICATE-----
+`
+
+func Test_BuildManagedTrustStore(t *testing.T) {
+	tests := []struct {
+		name           string
+		objects        []*corev1.Secret
+		configMaps     []*corev1.ConfigMap
+		ref            TrustStoreCABundleRef
+		wantResID      string
+		wantSecretKey  *types.NamespacedName
+		wantRevocation int
+		wantErr        string
+	}{
+		{
+			name: "CA bundle from Secret with default key",
+			objects: []*corev1.Secret{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "client-ca"},
+					Data:       map[string][]byte{"ca.crt": []byte(testCABundle)},
+				},
+			},
+			ref:           TrustStoreCABundleRef{Namespace: "ns", Name: "client-ca"},
+			wantResID:     "Secret/ns/client-ca/ca.crt",
+			wantSecretKey: &types.NamespacedName{Namespace: "ns", Name: "client-ca"},
+		},
+		{
+			name: "CA bundle from ConfigMap with revocation lists",
+			configMaps: []*corev1.ConfigMap{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "client-ca"},
+					Data:       map[string]string{"bundle.pem": testCABundle, "crl.pem": "crl"},
+				},
+			},
+			ref: TrustStoreCABundleRef{
+				Kind:               TrustStoreCABundleKindConfigMap,
+				Namespace:          "ns",
+				Name:               "client-ca",
+				Key:                "bundle.pem",
+				RevocationListKeys: []string{"crl.pem"},
+			},
+			wantResID:      "ConfigMap/ns/client-ca/bundle.pem",
+			wantRevocation: 1,
+		},
+		{
+			name: "CA bundle without PEM certificate",
+			objects: []*corev1.Secret{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "client-ca"},
+					Data:       map[string][]byte{"ca.crt": []byte("not a certificate")},
+				},
+			},
+			ref:     TrustStoreCABundleRef{Namespace: "ns", Name: "client-ca"},
+			wantErr: "no PEM encoded certificate found in CA bundle key ca.crt of Secret ns/client-ca",
+		},
+		{
+			name: "missing revocation list key",
+			objects: []*corev1.Secret{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "client-ca"},
+					Data:       map[string][]byte{"ca.crt": []byte(testCABundle)},
+				},
+			},
+			ref:     TrustStoreCABundleRef{Namespace: "ns", Name: "client-ca", RevocationListKeys: []string{"crl.pem"}},
+			wantErr: "missing certificate revocation list key crl.pem in Secret ns/client-ca",
+		},
+		{
+			name:    "unsupported kind",
+			ref:     TrustStoreCABundleRef{Kind: "Pod", Namespace: "ns", Name: "client-ca"},
+			wantErr: "unsupported CA bundle kind: Pod, must be Secret or ConfigMap",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sClient := testutils.GenerateTestClient()
+			for _, secret := range tt.objects {
+				assert.NoError(t, k8sClient.Create(ctx, secret.DeepCopy()))
+			}
+			for _, cm := range tt.configMaps {
+				assert.NoError(t, k8sClient.Create(ctx, cm.DeepCopy()))
+			}
+			secretsManager := k8s.NewSecretsManager(fake.NewSimpleClientset(), make(chan event.TypedGenericEvent[*corev1.Secret], 1),
+				logr.New(&log.NullLogSink{}), "", "")
+			stack := core.NewDefaultStack(core.StackID{Namespace: "ns", Name: "ing"})
+
+			ts, secretKey, err := BuildManagedTrustStore(ctx, stack, k8sClient, secretsManager, "cluster", nil, tt.ref)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantResID, ts.ID())
+			assert.Equal(t, tt.wantSecretKey, secretKey)
+			assert.Len(t, ts.Spec.RevocationLists, tt.wantRevocation)
+			assert.LessOrEqual(t, len(ts.Spec.Name), 32)
+
+			// listeners referencing the same CA bundle share the TrustStore
+			sharedTS, _, err := BuildManagedTrustStore(ctx, stack, k8sClient, secretsManager, "cluster", nil, tt.ref)
+			assert.NoError(t, err)
+			assert.Same(t, ts, sharedTS)
+			var resTSs []*elbv2model.TrustStore
+			assert.NoError(t, stack.ListResources(&resTSs))
+			assert.Len(t, resTSs, 1)
+		})
+	}
+}
+
+func Test_BuildManagedTrustStoreFromCABundles(t *testing.T) {
+	ctx := context.Background()
+	k8sClient := testutils.GenerateTestClient()
+	assert.NoError(t, k8sClient.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "client-ca"},
+		Data:       map[string][]byte{"ca.crt": []byte(testCABundle)},
+	}))
+	assert.NoError(t, k8sClient.Create(ctx, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "partner-ca"},
+		Data:       map[string]string{"ca.crt": testCABundle},
+	}))
+	secretsManager := k8s.NewSecretsManager(fake.NewSimpleClientset(), make(chan event.TypedGenericEvent[*corev1.Secret], 1),
+		logr.New(&log.NullLogSink{}), "", "")
+	stack := core.NewDefaultStack(core.StackID{Namespace: "ns", Name: "gw"})
+	refs := []TrustStoreCABundleRef{
+		{Namespace: "ns", Name: "client-ca"},
+		{Kind: TrustStoreCABundleKindConfigMap, Namespace: "ns", Name: "partner-ca"},
+	}
+
+	ts, secretKeys, err := BuildManagedTrustStoreFromCABundles(ctx, stack, k8sClient, secretsManager, "cluster", nil, refs)
+	assert.NoError(t, err)
+	assert.Equal(t, "Secret/ns/client-ca/ca.crt,ConfigMap/ns/partner-ca/ca.crt", ts.ID())
+	assert.Equal(t, []types.NamespacedName{{Namespace: "ns", Name: "client-ca"}}, secretKeys)
+	assert.Equal(t, []byte(testCABundle+testCABundle), ts.Spec.CACertificatesBundle)
+
+	_, _, err = BuildManagedTrustStoreFromCABundles(ctx, stack, k8sClient, secretsManager, "cluster", nil, nil)
+	assert.EqualError(t, err, "no CA bundle referenced")
+}
+
+func Test_buildManagedTrustStoreName(t *testing.T) {
+	stackID := core.StackID{Namespace: "ns", Name: "ing"}
+	objKey := types.NamespacedName{Namespace: "a-very-long-namespace", Name: "a-very-long-secret-name"}
+	name := buildManagedTrustStoreName("cluster", stackID, "Secret/a-very-long-namespace/a-very-long-secret-name/ca.crt", objKey)
+	assert.Equal(t, 32, len(name))
+	assert.Regexp(t, "^k8s-averylon-averylon-[0-9a-f]{10}$", name)
+
+	otherName := buildManagedTrustStoreName("other-cluster", stackID, "Secret/a-very-long-namespace/a-very-long-secret-name/ca.crt", objKey)
+	assert.NotEqual(t, name, otherName)
+}
+
+func Test_computeTrustStoreDigest(t *testing.T) {
+	caBundle := []byte(testCABundle)
+	digest := computeTrustStoreDigest(caBundle, nil)
+	assert.Equal(t, digest, computeTrustStoreDigest(caBundle, nil))
+	assert.NotEqual(t, digest, computeTrustStoreDigest(caBundle, [][]byte{[]byte("crl")}))
+	assert.NotEqual(t, computeTrustStoreDigest(caBundle, [][]byte{[]byte("a"), []byte("b")}),
+		computeTrustStoreDigest(caBundle, [][]byte{[]byte("ab")}))
+}
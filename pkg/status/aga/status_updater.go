@@ -3,10 +3,12 @@ package aga
 import (
 	"context"
 	"reflect"
+	"sort"
 
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/apis/aga/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/k8s"
 	agamodel "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/aga"
@@ -120,6 +122,23 @@ func (u *defaultStatusUpdater) UpdateStatusSuccess(ctx context.Context, ga *v1be
 		}
 	}
 
+	// Update cross-account attachments, requeue until all endpoints are granted by their attachment
+	crossAccountAttachments, allAttached := u.buildCrossAccountAttachmentStatuses(accelerator)
+	if !reflect.DeepEqual(ga.Status.CrossAccountAttachments, crossAccountAttachments) {
+		ga.Status.CrossAccountAttachments = crossAccountAttachments
+		needPatch = true
+	}
+	if !allAttached {
+		requeueNeeded = true
+	}
+
+	// Update endpoint groups
+	endpointGroups := u.buildEndpointGroupStatuses(accelerator)
+	if !reflect.DeepEqual(ga.Status.EndpointGroups, endpointGroups) {
+		ga.Status.EndpointGroups = endpointGroups
+		needPatch = true
+	}
+
 	// Update status
 	if ga.Status.Status == nil || *ga.Status.Status != accelerator.Status.Status {
 		ga.Status.Status = &accelerator.Status.Status
@@ -281,6 +300,81 @@ func (u *defaultStatusUpdater) isAcceleratorDeployed(acceleratorStatus agamodel.
 	return acceleratorStatus.Status == StatusDeployed
 }
 
+// buildCrossAccountAttachmentStatuses collects the cross-account attachment states from the endpoint groups of the accelerator's stack
+// It also returns whether all endpoints are granted by their attachment
+func (u *defaultStatusUpdater) buildCrossAccountAttachmentStatuses(accelerator *agamodel.Accelerator) ([]v1beta1.CrossAccountAttachmentStatus, bool) {
+	if accelerator.Stack() == nil {
+		return nil, true
+	}
+	var resEndpointGroups []*agamodel.EndpointGroup
+	if err := accelerator.Stack().ListResources(&resEndpointGroups); err != nil {
+		return nil, true
+	}
+
+	var statuses []v1beta1.CrossAccountAttachmentStatus
+	allAttached := true
+	for _, resEndpointGroup := range resEndpointGroups {
+		if resEndpointGroup.Status == nil {
+			continue
+		}
+		for _, attachment := range resEndpointGroup.Status.CrossAccountAttachments {
+			state := v1beta1.CrossAccountAttachmentStateAttached
+			if !attachment.Attached {
+				state = v1beta1.CrossAccountAttachmentStateNotAttached
+				allAttached = false
+			}
+			statuses = append(statuses, v1beta1.CrossAccountAttachmentStatus{
+				EndpointID:    attachment.EndpointID,
+				AttachmentARN: attachment.AttachmentARN,
+				State:         state,
+				Message:       attachment.Message,
+			})
+		}
+	}
+	return statuses, allAttached
+}
+
+// buildEndpointGroupStatuses collects the deployed endpoint groups of the accelerator's stack and the endpoints added to them
+// Endpoints excluded because their cross-account attachment doesn't grant them are not included
+func (u *defaultStatusUpdater) buildEndpointGroupStatuses(accelerator *agamodel.Accelerator) []v1beta1.GlobalAcceleratorEndpointGroupStatus {
+	if accelerator.Stack() == nil {
+		return nil
+	}
+	var resEndpointGroups []*agamodel.EndpointGroup
+	if err := accelerator.Stack().ListResources(&resEndpointGroups); err != nil {
+		return nil
+	}
+
+	var statuses []v1beta1.GlobalAcceleratorEndpointGroupStatus
+	for _, resEndpointGroup := range resEndpointGroups {
+		if resEndpointGroup.Status == nil || resEndpointGroup.Status.EndpointGroupARN == "" {
+			continue
+		}
+		notAttached := sets.New[string]()
+		for _, attachment := range resEndpointGroup.Status.CrossAccountAttachments {
+			if !attachment.Attached {
+				notAttached.Insert(attachment.EndpointID)
+			}
+		}
+		var endpointIDs []string
+		for _, endpointConfig := range resEndpointGroup.Spec.EndpointConfigurations {
+			if !notAttached.Has(endpointConfig.EndpointID) {
+				endpointIDs = append(endpointIDs, endpointConfig.EndpointID)
+			}
+		}
+		sort.Strings(endpointIDs)
+		statuses = append(statuses, v1beta1.GlobalAcceleratorEndpointGroupStatus{
+			EndpointGroupARN: resEndpointGroup.Status.EndpointGroupARN,
+			Region:           resEndpointGroup.Spec.Region,
+			EndpointIDs:      endpointIDs,
+		})
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].EndpointGroupARN < statuses[j].EndpointGroupARN
+	})
+	return statuses
+}
+
 // updateCondition updates or adds a condition to the conditions slice
 func (u *defaultStatusUpdater) updateCondition(conditions *[]metav1.Condition, newCondition metav1.Condition) bool {
 	if conditions == nil {
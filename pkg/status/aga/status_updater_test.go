@@ -2,6 +2,7 @@ package aga
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/go-logr/logr"
@@ -9,6 +10,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/apis/aga/v1beta1"
 	agamodel "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/aga"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/model/core"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/testutils"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
@@ -268,6 +270,158 @@ func Test_defaultStatusUpdater_UpdateStatusSuccess(t *testing.T) {
 	}
 }
 
+func Test_defaultStatusUpdater_buildCrossAccountAttachmentStatuses(t *testing.T) {
+	endpointID := "arn:aws:elasticloadbalancing:us-west-2:210987654321:loadbalancer/app/my-alb/1234567890abcdef"
+	attachmentARN := "arn:aws:globalaccelerator::210987654321:attachment/1234abcd-abcd-1234-abcd-1234abcdefgh"
+	tests := []struct {
+		name            string
+		endpointGroups  []agamodel.EndpointGroupStatus
+		wantStatuses    []v1beta1.CrossAccountAttachmentStatus
+		wantAllAttached bool
+	}{
+		{
+			name:            "no cross-account endpoints",
+			endpointGroups:  []agamodel.EndpointGroupStatus{{EndpointGroupARN: "eg-1"}},
+			wantStatuses:    nil,
+			wantAllAttached: true,
+		},
+		{
+			name: "attached endpoint",
+			endpointGroups: []agamodel.EndpointGroupStatus{
+				{
+					EndpointGroupARN: "eg-1",
+					CrossAccountAttachments: []agamodel.CrossAccountAttachmentStatus{
+						{EndpointID: endpointID, AttachmentARN: attachmentARN, Attached: true},
+					},
+				},
+			},
+			wantStatuses: []v1beta1.CrossAccountAttachmentStatus{
+				{EndpointID: endpointID, AttachmentARN: attachmentARN, State: v1beta1.CrossAccountAttachmentStateAttached},
+			},
+			wantAllAttached: true,
+		},
+		{
+			name: "endpoint not attached",
+			endpointGroups: []agamodel.EndpointGroupStatus{
+				{
+					EndpointGroupARN: "eg-1",
+					CrossAccountAttachments: []agamodel.CrossAccountAttachmentStatus{
+						{EndpointID: endpointID, AttachmentARN: attachmentARN, Attached: false, Message: "not granted"},
+					},
+				},
+			},
+			wantStatuses: []v1beta1.CrossAccountAttachmentStatus{
+				{EndpointID: endpointID, AttachmentARN: attachmentARN, State: v1beta1.CrossAccountAttachmentStateNotAttached, Message: "not granted"},
+			},
+			wantAllAttached: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stack := core.NewDefaultStack(core.StackID{Namespace: "default", Name: "test-ga"})
+			accelerator := agamodel.NewAccelerator(stack, "GlobalAccelerator", agamodel.AcceleratorSpec{}, &v1beta1.GlobalAccelerator{})
+			listener := agamodel.NewListener(stack, "Listener", agamodel.ListenerSpec{}, accelerator)
+			for i, egStatus := range tt.endpointGroups {
+				eg := agamodel.NewEndpointGroup(stack, fmt.Sprintf("EndpointGroup-%d", i), agamodel.EndpointGroupSpec{}, listener)
+				eg.SetStatus(egStatus)
+			}
+			updater := &defaultStatusUpdater{
+				logger: logr.New(&log.NullLogSink{}),
+			}
+			gotStatuses, gotAllAttached := updater.buildCrossAccountAttachmentStatuses(accelerator)
+			assert.Equal(t, tt.wantStatuses, gotStatuses)
+			assert.Equal(t, tt.wantAllAttached, gotAllAttached)
+		})
+	}
+}
+
+func Test_defaultStatusUpdater_buildEndpointGroupStatuses(t *testing.T) {
+	lbARN1 := "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/net/lb-1/1111111111111111"
+	lbARN2 := "arn:aws:elasticloadbalancing:us-west-2:210987654321:loadbalancer/net/lb-2/2222222222222222"
+	type endpointGroup struct {
+		spec   agamodel.EndpointGroupSpec
+		status *agamodel.EndpointGroupStatus
+	}
+	tests := []struct {
+		name           string
+		endpointGroups []endpointGroup
+		want           []v1beta1.GlobalAcceleratorEndpointGroupStatus
+	}{
+		{
+			name: "endpoint group not yet deployed",
+			endpointGroups: []endpointGroup{
+				{
+					spec: agamodel.EndpointGroupSpec{
+						Region:                 "us-west-2",
+						EndpointConfigurations: []agamodel.EndpointConfiguration{{EndpointID: lbARN1}},
+					},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "deployed endpoint groups sorted by ARN",
+			endpointGroups: []endpointGroup{
+				{
+					spec: agamodel.EndpointGroupSpec{
+						Region:                 "us-west-2",
+						EndpointConfigurations: []agamodel.EndpointConfiguration{{EndpointID: lbARN2}, {EndpointID: lbARN1}},
+					},
+					status: &agamodel.EndpointGroupStatus{EndpointGroupARN: "eg-2"},
+				},
+				{
+					spec: agamodel.EndpointGroupSpec{
+						Region: "eu-west-1",
+					},
+					status: &agamodel.EndpointGroupStatus{EndpointGroupARN: "eg-1"},
+				},
+			},
+			want: []v1beta1.GlobalAcceleratorEndpointGroupStatus{
+				{EndpointGroupARN: "eg-1", Region: "eu-west-1"},
+				{EndpointGroupARN: "eg-2", Region: "us-west-2", EndpointIDs: []string{lbARN1, lbARN2}},
+			},
+		},
+		{
+			name: "endpoint not attached is excluded",
+			endpointGroups: []endpointGroup{
+				{
+					spec: agamodel.EndpointGroupSpec{
+						Region:                 "us-west-2",
+						EndpointConfigurations: []agamodel.EndpointConfiguration{{EndpointID: lbARN1}, {EndpointID: lbARN2}},
+					},
+					status: &agamodel.EndpointGroupStatus{
+						EndpointGroupARN: "eg-1",
+						CrossAccountAttachments: []agamodel.CrossAccountAttachmentStatus{
+							{EndpointID: lbARN2, Attached: false},
+						},
+					},
+				},
+			},
+			want: []v1beta1.GlobalAcceleratorEndpointGroupStatus{
+				{EndpointGroupARN: "eg-1", Region: "us-west-2", EndpointIDs: []string{lbARN1}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stack := core.NewDefaultStack(core.StackID{Namespace: "default", Name: "test-ga"})
+			accelerator := agamodel.NewAccelerator(stack, "GlobalAccelerator", agamodel.AcceleratorSpec{}, &v1beta1.GlobalAccelerator{})
+			listener := agamodel.NewListener(stack, "Listener", agamodel.ListenerSpec{}, accelerator)
+			for i, egConfig := range tt.endpointGroups {
+				eg := agamodel.NewEndpointGroup(stack, fmt.Sprintf("EndpointGroup-%d", i), egConfig.spec, listener)
+				if egConfig.status != nil {
+					eg.SetStatus(*egConfig.status)
+				}
+			}
+			updater := &defaultStatusUpdater{
+				logger: logr.New(&log.NullLogSink{}),
+			}
+			got := updater.buildEndpointGroupStatuses(accelerator)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func Test_defaultStatusUpdater_UpdateStatusFailure(t *testing.T) {
 	// Setup test cases
 	tests := []struct {
@@ -0,0 +1,219 @@
+package targetgroupbinding
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	gasdk "github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+	gatypes "github.com/aws/aws-sdk-go-v2/service/globalaccelerator/types"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/util/cache"
+	"k8s.io/apimachinery/pkg/util/sets"
+	agaapi "sigs.k8s.io/aws-load-balancer-controller/v3/apis/aga/v1beta1"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/v3/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/services"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultTargetGroupLoadBalancersCacheTTL = 5 * time.Minute
+)
+
+// AGAEndpointHealthReason is the reason of the GlobalAccelerator endpoint health pod condition.
+// It is either a GlobalAccelerator health state or one of the AGAEndpointHealthReason constants.
+type AGAEndpointHealthReason string
+
+const (
+	// AGAEndpointHealthReasonNotAnEndpoint is the reason when no LoadBalancer of the TargetGroup is a GlobalAccelerator endpoint.
+	AGAEndpointHealthReasonNotAnEndpoint AGAEndpointHealthReason = "NotAGlobalAcceleratorEndpoint"
+)
+
+// AGAEndpointHealth is the health of the LoadBalancers of a TargetGroup as reported by GlobalAccelerator endpoint groups.
+type AGAEndpointHealth struct {
+	// Healthy is whether every endpoint group that contains a LoadBalancer of the TargetGroup reports it as healthy.
+	Healthy bool
+	// Reason is the GlobalAccelerator health state, or NotAGlobalAcceleratorEndpoint.
+	Reason AGAEndpointHealthReason
+	// Message describes the health.
+	Message string
+}
+
+// AGAEndpointHealthResolver resolves the GlobalAccelerator endpoint health of the LoadBalancers that route to a TargetGroup.
+type AGAEndpointHealthResolver interface {
+	// ResolveEndpointHealth returns the GlobalAccelerator endpoint health of the LoadBalancers of the TargetGroup.
+	// The endpoint groups containing the LoadBalancers are found from the status of GlobalAccelerator resources in the cluster.
+	// It reports healthy when none of the LoadBalancers is a GlobalAccelerator endpoint.
+	ResolveEndpointHealth(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (AGAEndpointHealth, error)
+}
+
+// NewDefaultAGAEndpointHealthResolver constructs new defaultAGAEndpointHealthResolver
+func NewDefaultAGAEndpointHealthResolver(k8sClient client.Client, elbv2Client services.ELBV2, gaClient services.GlobalAccelerator, logger logr.Logger) *defaultAGAEndpointHealthResolver {
+	return &defaultAGAEndpointHealthResolver{
+		k8sClient:      k8sClient,
+		elbv2Client:    elbv2Client,
+		gaClient:       gaClient,
+		lbARNsCache:    cache.NewExpiring(),
+		lbARNsCacheTTL: defaultTargetGroupLoadBalancersCacheTTL,
+		logger:         logger,
+	}
+}
+
+var _ AGAEndpointHealthResolver = &defaultAGAEndpointHealthResolver{}
+
+// default implementation for AGAEndpointHealthResolver.
+// The LoadBalancers of each TargetGroup will be refreshed per lbARNsCacheTTL, while endpoint health is always described.
+type defaultAGAEndpointHealthResolver struct {
+	k8sClient   client.Client
+	elbv2Client services.ELBV2
+	gaClient    services.GlobalAccelerator
+
+	// cache of LoadBalancer ARNs by targetGroupARN.
+	lbARNsCache *cache.Expiring
+	// TTL for each targetGroup's LoadBalancer ARNs.
+	lbARNsCacheTTL time.Duration
+	// lbARNsCacheMutex protects lbARNsCache
+	lbARNsCacheMutex sync.RWMutex
+
+	logger logr.Logger
+}
+
+func (r *defaultAGAEndpointHealthResolver) ResolveEndpointHealth(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (AGAEndpointHealth, error) {
+	lbARNs, err := r.resolveLoadBalancerARNs(ctx, tgb)
+	if err != nil {
+		return AGAEndpointHealth{}, err
+	}
+	endpointGroupARNsByLB, err := r.findEndpointGroups(ctx, lbARNs)
+	if err != nil {
+		return AGAEndpointHealth{}, err
+	}
+	if len(endpointGroupARNsByLB) == 0 {
+		return AGAEndpointHealth{
+			Healthy: true,
+			Reason:  AGAEndpointHealthReasonNotAnEndpoint,
+			Message: "LoadBalancer is not an endpoint of any GlobalAccelerator",
+		}, nil
+	}
+
+	endpointGroupCache := make(map[string]*gatypes.EndpointGroup)
+	var unhealthyMessages []string
+	reason := AGAEndpointHealthReason(gatypes.HealthStateHealthy)
+	for _, lbARN := range sets.List(sets.KeySet(endpointGroupARNsByLB)) {
+		for _, endpointGroupARN := range sets.List(endpointGroupARNsByLB[lbARN]) {
+			endpointGroup, ok := endpointGroupCache[endpointGroupARN]
+			if !ok {
+				resp, err := r.gaClient.DescribeEndpointGroupWithContext(ctx, &gasdk.DescribeEndpointGroupInput{
+					EndpointGroupArn: awssdk.String(endpointGroupARN),
+				})
+				if err != nil {
+					return AGAEndpointHealth{}, errors.Wrapf(err, "failed to describe endpoint group %s", endpointGroupARN)
+				}
+				endpointGroup = resp.EndpointGroup
+				endpointGroupCache[endpointGroupARN] = endpointGroup
+			}
+
+			state, healthReason := describeEndpointHealth(endpointGroup, lbARN)
+			if state != gatypes.HealthStateHealthy {
+				reason = AGAEndpointHealthReason(state)
+				unhealthyMessages = append(unhealthyMessages,
+					fmt.Sprintf("LoadBalancer %s is %s in endpoint group %s: %s", lbARN, state, endpointGroupARN, healthReason))
+			}
+		}
+	}
+
+	if len(unhealthyMessages) != 0 {
+		return AGAEndpointHealth{
+			Healthy: false,
+			Reason:  reason,
+			Message: strings.Join(unhealthyMessages, "; "),
+		}, nil
+	}
+	return AGAEndpointHealth{
+		Healthy: true,
+		Reason:  reason,
+		Message: "LoadBalancer is healthy in all GlobalAccelerator endpoint groups",
+	}, nil
+}
+
+// resolveLoadBalancerARNs returns the ARNs of the LoadBalancers that route to the TargetGroup.
+func (r *defaultAGAEndpointHealthResolver) resolveLoadBalancerARNs(ctx context.Context, tgb *elbv2api.TargetGroupBinding) ([]string, error) {
+	tgARN := tgb.Spec.TargetGroupARN
+	r.lbARNsCacheMutex.RLock()
+	rawCacheItem, exists := r.lbARNsCache.Get(tgARN)
+	r.lbARNsCacheMutex.RUnlock()
+	if exists {
+		return rawCacheItem.([]string), nil
+	}
+
+	clientToUse, err := r.elbv2Client.AssumeRole(ctx, tgb.Spec.IamRoleArnToAssume, tgb.Spec.AssumeRoleExternalId)
+	if err != nil {
+		return nil, err
+	}
+	tgList, err := clientToUse.DescribeTargetGroupsAsList(ctx, &elbv2sdk.DescribeTargetGroupsInput{
+		TargetGroupArns: []string{tgARN},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var lbARNs []string
+	for _, tg := range tgList {
+		lbARNs = append(lbARNs, tg.LoadBalancerArns...)
+	}
+	sort.Strings(lbARNs)
+
+	r.lbARNsCacheMutex.Lock()
+	r.lbARNsCache.Set(tgARN, lbARNs, r.lbARNsCacheTTL)
+	r.lbARNsCacheMutex.Unlock()
+	return lbARNs, nil
+}
+
+// findEndpointGroups returns the ARNs of the GlobalAccelerator endpoint groups that contain each LoadBalancer.
+func (r *defaultAGAEndpointHealthResolver) findEndpointGroups(ctx context.Context, lbARNs []string) (map[string]sets.Set[string], error) {
+	if len(lbARNs) == 0 {
+		return nil, nil
+	}
+	gaList := &agaapi.GlobalAcceleratorList{}
+	if err := r.k8sClient.List(ctx, gaList); err != nil {
+		// GlobalAccelerator CRDs are not installed, so no LoadBalancer can be an endpoint.
+		if meta.IsNoMatchError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "failed to list GlobalAccelerators")
+	}
+
+	lbARNSet := sets.New(lbARNs...)
+	endpointGroupARNsByLB := make(map[string]sets.Set[string])
+	for _, ga := range gaList.Items {
+		for _, endpointGroup := range ga.Status.EndpointGroups {
+			for _, endpointID := range endpointGroup.EndpointIDs {
+				if !lbARNSet.Has(endpointID) {
+					continue
+				}
+				if _, ok := endpointGroupARNsByLB[endpointID]; !ok {
+					endpointGroupARNsByLB[endpointID] = sets.New[string]()
+				}
+				endpointGroupARNsByLB[endpointID].Insert(endpointGroup.EndpointGroupARN)
+			}
+		}
+	}
+	return endpointGroupARNsByLB, nil
+}
+
+// describeEndpointHealth returns the health state and reason of an endpoint in an endpoint group.
+func describeEndpointHealth(endpointGroup *gatypes.EndpointGroup, endpointID string) (gatypes.HealthState, string) {
+	if endpointGroup != nil {
+		for _, endpoint := range endpointGroup.EndpointDescriptions {
+			if awssdk.ToString(endpoint.EndpointId) == endpointID {
+				return endpoint.HealthState, awssdk.ToString(endpoint.HealthReason)
+			}
+		}
+	}
+	return gatypes.HealthStateInitial, "endpoint is not yet added to the endpoint group"
+}
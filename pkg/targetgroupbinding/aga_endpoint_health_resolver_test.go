@@ -0,0 +1,174 @@
+package targetgroupbinding
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	gasdk "github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+	gatypes "github.com/aws/aws-sdk-go-v2/service/globalaccelerator/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	agaapi "sigs.k8s.io/aws-load-balancer-controller/v3/apis/aga/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/services"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_defaultAGAEndpointHealthResolver_ResolveEndpointHealth(t *testing.T) {
+	type describeEndpointGroupCall struct {
+		endpointGroupARN string
+		resp             *gatypes.EndpointGroup
+		err              error
+	}
+	tgARN := "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/my-tg/73e2d6bc24d8a067"
+	lbARN := "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/net/my-lb/1234567890abcdef"
+	egARN1 := "arn:aws:globalaccelerator::123456789012:accelerator/abcd/listener/0123/endpoint-group/eg1"
+	egARN2 := "arn:aws:globalaccelerator::123456789012:accelerator/efgh/listener/4567/endpoint-group/eg2"
+
+	newGA := func(name string, endpointGroups ...agaapi.GlobalAcceleratorEndpointGroupStatus) *agaapi.GlobalAccelerator {
+		return &agaapi.GlobalAccelerator{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+			Status:     agaapi.GlobalAcceleratorStatus{EndpointGroups: endpointGroups},
+		}
+	}
+	endpointGroup := func(state gatypes.HealthState, reason string) *gatypes.EndpointGroup {
+		return &gatypes.EndpointGroup{
+			EndpointDescriptions: []gatypes.EndpointDescription{
+				{EndpointId: awssdk.String("other-lb")},
+				{EndpointId: awssdk.String(lbARN), HealthState: state, HealthReason: awssdk.String(reason)},
+			},
+		}
+	}
+
+	tests := []struct {
+		name                       string
+		lbARNs                     []string
+		gas                        []*agaapi.GlobalAccelerator
+		describeEndpointGroupCalls []describeEndpointGroupCall
+		want                       AGAEndpointHealth
+		wantErr                    error
+	}{
+		{
+			name:   "target group not attached to load balancer",
+			lbARNs: nil,
+			want: AGAEndpointHealth{
+				Healthy: true,
+				Reason:  AGAEndpointHealthReasonNotAnEndpoint,
+				Message: "LoadBalancer is not an endpoint of any GlobalAccelerator",
+			},
+		},
+		{
+			name:   "load balancer not an endpoint of any GlobalAccelerator",
+			lbARNs: []string{lbARN},
+			gas: []*agaapi.GlobalAccelerator{
+				newGA("ga-1", agaapi.GlobalAcceleratorEndpointGroupStatus{EndpointGroupARN: egARN1, EndpointIDs: []string{"other-lb"}}),
+			},
+			want: AGAEndpointHealth{
+				Healthy: true,
+				Reason:  AGAEndpointHealthReasonNotAnEndpoint,
+				Message: "LoadBalancer is not an endpoint of any GlobalAccelerator",
+			},
+		},
+		{
+			name:   "load balancer healthy in all endpoint groups",
+			lbARNs: []string{lbARN},
+			gas: []*agaapi.GlobalAccelerator{
+				newGA("ga-1", agaapi.GlobalAcceleratorEndpointGroupStatus{EndpointGroupARN: egARN1, EndpointIDs: []string{lbARN}}),
+				newGA("ga-2", agaapi.GlobalAcceleratorEndpointGroupStatus{EndpointGroupARN: egARN2, EndpointIDs: []string{lbARN}}),
+			},
+			describeEndpointGroupCalls: []describeEndpointGroupCall{
+				{endpointGroupARN: egARN1, resp: endpointGroup(gatypes.HealthStateHealthy, "")},
+				{endpointGroupARN: egARN2, resp: endpointGroup(gatypes.HealthStateHealthy, "")},
+			},
+			want: AGAEndpointHealth{
+				Healthy: true,
+				Reason:  "HEALTHY",
+				Message: "LoadBalancer is healthy in all GlobalAccelerator endpoint groups",
+			},
+		},
+		{
+			name:   "load balancer unhealthy in one endpoint group",
+			lbARNs: []string{lbARN},
+			gas: []*agaapi.GlobalAccelerator{
+				newGA("ga-1", agaapi.GlobalAcceleratorEndpointGroupStatus{EndpointGroupARN: egARN1, EndpointIDs: []string{lbARN}}),
+				newGA("ga-2", agaapi.GlobalAcceleratorEndpointGroupStatus{EndpointGroupARN: egARN2, EndpointIDs: []string{lbARN}}),
+			},
+			describeEndpointGroupCalls: []describeEndpointGroupCall{
+				{endpointGroupARN: egARN1, resp: endpointGroup(gatypes.HealthStateHealthy, "")},
+				{endpointGroupARN: egARN2, resp: endpointGroup(gatypes.HealthStateUnhealthy, "Health checks failed")},
+			},
+			want: AGAEndpointHealth{
+				Healthy: false,
+				Reason:  "UNHEALTHY",
+				Message: "LoadBalancer " + lbARN + " is UNHEALTHY in endpoint group " + egARN2 + ": Health checks failed",
+			},
+		},
+		{
+			name:   "load balancer not yet added to endpoint group",
+			lbARNs: []string{lbARN},
+			gas: []*agaapi.GlobalAccelerator{
+				newGA("ga-1", agaapi.GlobalAcceleratorEndpointGroupStatus{EndpointGroupARN: egARN1, EndpointIDs: []string{lbARN}}),
+			},
+			describeEndpointGroupCalls: []describeEndpointGroupCall{
+				{endpointGroupARN: egARN1, resp: &gatypes.EndpointGroup{}},
+			},
+			want: AGAEndpointHealth{
+				Healthy: false,
+				Reason:  "INITIAL",
+				Message: "LoadBalancer " + lbARN + " is INITIAL in endpoint group " + egARN1 + ": endpoint is not yet added to the endpoint group",
+			},
+		},
+		{
+			name:   "describe endpoint group fails",
+			lbARNs: []string{lbARN},
+			gas: []*agaapi.GlobalAccelerator{
+				newGA("ga-1", agaapi.GlobalAcceleratorEndpointGroupStatus{EndpointGroupARN: egARN1, EndpointIDs: []string{lbARN}}),
+			},
+			describeEndpointGroupCalls: []describeEndpointGroupCall{
+				{endpointGroupARN: egARN1, err: errors.New("some error")},
+			},
+			wantErr: errors.New("failed to describe endpoint group " + egARN1 + ": some error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			elbv2Client := services.NewMockELBV2(ctrl)
+			elbv2Client.EXPECT().AssumeRole(ctx, gomock.Any(), gomock.Any()).Return(elbv2Client, nil)
+			elbv2Client.EXPECT().DescribeTargetGroupsAsList(ctx, &elbv2sdk.DescribeTargetGroupsInput{
+				TargetGroupArns: []string{tgARN},
+			}).Return([]elbv2types.TargetGroup{{TargetGroupArn: awssdk.String(tgARN), LoadBalancerArns: tt.lbARNs}}, nil)
+			gaClient := services.NewMockGlobalAccelerator(ctrl)
+			for _, call := range tt.describeEndpointGroupCalls {
+				gaClient.EXPECT().DescribeEndpointGroupWithContext(ctx, &gasdk.DescribeEndpointGroupInput{
+					EndpointGroupArn: awssdk.String(call.endpointGroupARN),
+				}).Return(&gasdk.DescribeEndpointGroupOutput{EndpointGroup: call.resp}, call.err)
+			}
+
+			k8sSchema := runtime.NewScheme()
+			assert.NoError(t, agaapi.AddToScheme(k8sSchema))
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			for _, ga := range tt.gas {
+				assert.NoError(t, k8sClient.Create(ctx, ga))
+			}
+
+			r := NewDefaultAGAEndpointHealthResolver(k8sClient, elbv2Client, gaClient, log.Log)
+			got, err := r.ResolveEndpointHealth(ctx, makeTargetGroupBinding(tgARN))
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
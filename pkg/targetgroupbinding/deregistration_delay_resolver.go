@@ -0,0 +1,92 @@
+package targetgroupbinding
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/cache"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/v3/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/services"
+)
+
+const (
+	tgAttrsDeregistrationDelayTimeoutSeconds = "deregistration_delay.timeout_seconds"
+	defaultDeregistrationDelay               = 300 * time.Second
+	defaultDeregistrationDelayCacheTTL       = 5 * time.Minute
+)
+
+// DeregistrationDelayResolver resolves the deregistration delay of the TargetGroup of a TargetGroupBinding.
+type DeregistrationDelayResolver interface {
+	// ResolveDeregistrationDelay returns the deregistration_delay.timeout_seconds configured on the TargetGroup.
+	ResolveDeregistrationDelay(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (time.Duration, error)
+}
+
+// NewCachedDeregistrationDelayResolver constructs new cachedDeregistrationDelayResolver
+func NewCachedDeregistrationDelayResolver(elbv2Client services.ELBV2, logger logr.Logger) *cachedDeregistrationDelayResolver {
+	return &cachedDeregistrationDelayResolver{
+		elbv2Client:              elbv2Client,
+		deregistrationDelayCache: cache.NewExpiring(),
+		deregistrationDelayTTL:   defaultDeregistrationDelayCacheTTL,
+		logger:                   logger,
+	}
+}
+
+var _ DeregistrationDelayResolver = &cachedDeregistrationDelayResolver{}
+
+// a cached implementation for DeregistrationDelayResolver.
+// The deregistration delay for each TargetGroup will be refreshed per deregistrationDelayTTL.
+type cachedDeregistrationDelayResolver struct {
+	elbv2Client services.ELBV2
+
+	// cache of deregistration delay by targetGroupARN.
+	deregistrationDelayCache *cache.Expiring
+	// TTL for each targetGroup's deregistration delay.
+	deregistrationDelayTTL time.Duration
+	// deregistrationDelayCacheMutex protects deregistrationDelayCache
+	deregistrationDelayCacheMutex sync.RWMutex
+
+	logger logr.Logger
+}
+
+func (r *cachedDeregistrationDelayResolver) ResolveDeregistrationDelay(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (time.Duration, error) {
+	tgARN := tgb.Spec.TargetGroupARN
+	r.deregistrationDelayCacheMutex.RLock()
+	rawCacheItem, exists := r.deregistrationDelayCache.Get(tgARN)
+	r.deregistrationDelayCacheMutex.RUnlock()
+	if exists {
+		return rawCacheItem.(time.Duration), nil
+	}
+
+	clientToUse, err := r.elbv2Client.AssumeRole(ctx, tgb.Spec.IamRoleArnToAssume, tgb.Spec.AssumeRoleExternalId)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := clientToUse.DescribeTargetGroupAttributesWithContext(ctx, &elbv2sdk.DescribeTargetGroupAttributesInput{
+		TargetGroupArn: awssdk.String(tgARN),
+	})
+	if err != nil {
+		return 0, err
+	}
+	deregistrationDelay := defaultDeregistrationDelay
+	for _, attr := range resp.Attributes {
+		if awssdk.ToString(attr.Key) != tgAttrsDeregistrationDelayTimeoutSeconds {
+			continue
+		}
+		seconds, err := strconv.ParseInt(awssdk.ToString(attr.Value), 10, 64)
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to parse attribute %v=%v", tgAttrsDeregistrationDelayTimeoutSeconds, awssdk.ToString(attr.Value))
+		}
+		deregistrationDelay = time.Duration(seconds) * time.Second
+	}
+
+	r.deregistrationDelayCacheMutex.Lock()
+	r.deregistrationDelayCache.Set(tgARN, deregistrationDelay, r.deregistrationDelayTTL)
+	r.deregistrationDelayCacheMutex.Unlock()
+	return deregistrationDelay, nil
+}
@@ -0,0 +1,110 @@
+package targetgroupbinding
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/cache"
+	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/v3/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/services"
+)
+
+const (
+	defaultLoadBalancerZonesCacheTTL = 5 * time.Minute
+)
+
+// LoadBalancerZonesResolver resolves the availability zones enabled on the LoadBalancers that route to a TargetGroup.
+type LoadBalancerZonesResolver interface {
+	// ResolveEnabledZones returns the availability zone names enabled on the LoadBalancers of the TargetGroup.
+	// It returns nil when the TargetGroup isn't attached to any LoadBalancer.
+	ResolveEnabledZones(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (sets.Set[string], error)
+
+	// InvalidateEnabledZones forgets the cached availability zones of the TargetGroup,
+	// so that changes to its LoadBalancers are picked up by the next ResolveEnabledZones.
+	InvalidateEnabledZones(tgb *elbv2api.TargetGroupBinding)
+}
+
+// NewCachedLoadBalancerZonesResolver constructs new cachedLoadBalancerZonesResolver
+func NewCachedLoadBalancerZonesResolver(elbv2Client services.ELBV2, logger logr.Logger) *cachedLoadBalancerZonesResolver {
+	return &cachedLoadBalancerZonesResolver{
+		elbv2Client:   elbv2Client,
+		zonesCache:    cache.NewExpiring(),
+		zonesCacheTTL: defaultLoadBalancerZonesCacheTTL,
+		logger:        logger,
+	}
+}
+
+var _ LoadBalancerZonesResolver = &cachedLoadBalancerZonesResolver{}
+
+// a cached implementation for LoadBalancerZonesResolver.
+// The enabled zones for each TargetGroup will be refreshed per zonesCacheTTL.
+type cachedLoadBalancerZonesResolver struct {
+	elbv2Client services.ELBV2
+
+	// cache of enabled zones by targetGroupARN.
+	zonesCache *cache.Expiring
+	// TTL for each targetGroup's enabled zones.
+	zonesCacheTTL time.Duration
+	// zonesCacheMutex protects zonesCache
+	zonesCacheMutex sync.RWMutex
+
+	logger logr.Logger
+}
+
+func (r *cachedLoadBalancerZonesResolver) ResolveEnabledZones(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (sets.Set[string], error) {
+	tgARN := tgb.Spec.TargetGroupARN
+	r.zonesCacheMutex.RLock()
+	rawCacheItem, exists := r.zonesCache.Get(tgARN)
+	r.zonesCacheMutex.RUnlock()
+	if exists {
+		return rawCacheItem.(sets.Set[string]), nil
+	}
+
+	clientToUse, err := r.elbv2Client.AssumeRole(ctx, tgb.Spec.IamRoleArnToAssume, tgb.Spec.AssumeRoleExternalId)
+	if err != nil {
+		return nil, err
+	}
+	tgList, err := clientToUse.DescribeTargetGroupsAsList(ctx, &elbv2sdk.DescribeTargetGroupsInput{
+		TargetGroupArns: []string{tgARN},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var lbARNs []string
+	for _, tg := range tgList {
+		lbARNs = append(lbARNs, tg.LoadBalancerArns...)
+	}
+
+	// the TargetGroup isn't attached to any LoadBalancer yet, don't cache so that the attachment is picked up right away.
+	if len(lbARNs) == 0 {
+		return nil, nil
+	}
+	lbList, err := clientToUse.DescribeLoadBalancersAsList(ctx, &elbv2sdk.DescribeLoadBalancersInput{
+		LoadBalancerArns: lbARNs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	enabledZones := sets.New[string]()
+	for _, lb := range lbList {
+		for _, az := range lb.AvailabilityZones {
+			enabledZones.Insert(awssdk.ToString(az.ZoneName))
+		}
+	}
+
+	r.zonesCacheMutex.Lock()
+	r.zonesCache.Set(tgARN, enabledZones, r.zonesCacheTTL)
+	r.zonesCacheMutex.Unlock()
+	return enabledZones, nil
+}
+
+func (r *cachedLoadBalancerZonesResolver) InvalidateEnabledZones(tgb *elbv2api.TargetGroupBinding) {
+	r.zonesCacheMutex.Lock()
+	defer r.zonesCacheMutex.Unlock()
+	r.zonesCache.Delete(tgb.Spec.TargetGroupARN)
+}
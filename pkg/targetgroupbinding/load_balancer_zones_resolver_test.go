@@ -0,0 +1,180 @@
+package targetgroupbinding
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/services"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_cachedLoadBalancerZonesResolver_ResolveEnabledZones(t *testing.T) {
+	type describeTargetGroupsCall struct {
+		resp []elbv2types.TargetGroup
+		err  error
+	}
+	type describeLoadBalancersCall struct {
+		req  *elbv2sdk.DescribeLoadBalancersInput
+		resp []elbv2types.LoadBalancer
+		err  error
+	}
+	tgARN := "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/my-tg/73e2d6bc24d8a067"
+	tests := []struct {
+		name                      string
+		describeTargetGroupsCall  describeTargetGroupsCall
+		describeLoadBalancersCall *describeLoadBalancersCall
+		want                      sets.Set[string]
+		wantCached                bool
+		wantErr                   error
+	}{
+		{
+			name: "target group attached to load balancer",
+			describeTargetGroupsCall: describeTargetGroupsCall{
+				resp: []elbv2types.TargetGroup{
+					{
+						TargetGroupArn:   awssdk.String(tgARN),
+						LoadBalancerArns: []string{"lb-1"},
+					},
+				},
+			},
+			describeLoadBalancersCall: &describeLoadBalancersCall{
+				req: &elbv2sdk.DescribeLoadBalancersInput{
+					LoadBalancerArns: []string{"lb-1"},
+				},
+				resp: []elbv2types.LoadBalancer{
+					{
+						AvailabilityZones: []elbv2types.AvailabilityZone{
+							{ZoneName: awssdk.String("us-west-2a")},
+							{ZoneName: awssdk.String("us-west-2b")},
+						},
+					},
+				},
+			},
+			want:       sets.New("us-west-2a", "us-west-2b"),
+			wantCached: true,
+		},
+		{
+			name: "target group not attached to load balancer",
+			describeTargetGroupsCall: describeTargetGroupsCall{
+				resp: []elbv2types.TargetGroup{
+					{
+						TargetGroupArn: awssdk.String(tgARN),
+					},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "describe target groups fails",
+			describeTargetGroupsCall: describeTargetGroupsCall{
+				err: errors.New("some error"),
+			},
+			wantErr: errors.New("some error"),
+		},
+		{
+			name: "describe load balancers fails",
+			describeTargetGroupsCall: describeTargetGroupsCall{
+				resp: []elbv2types.TargetGroup{
+					{
+						TargetGroupArn:   awssdk.String(tgARN),
+						LoadBalancerArns: []string{"lb-1"},
+					},
+				},
+			},
+			describeLoadBalancersCall: &describeLoadBalancersCall{
+				req: &elbv2sdk.DescribeLoadBalancersInput{
+					LoadBalancerArns: []string{"lb-1"},
+				},
+				err: errors.New("some error"),
+			},
+			wantErr: errors.New("some error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			elbv2Client := services.NewMockELBV2(ctrl)
+			describeCalls := 1
+			if tt.wantErr == nil && !tt.wantCached {
+				describeCalls = 2
+			}
+			elbv2Client.EXPECT().AssumeRole(ctx, gomock.Any(), gomock.Any()).Return(elbv2Client, nil).Times(describeCalls)
+			elbv2Client.EXPECT().DescribeTargetGroupsAsList(ctx, &elbv2sdk.DescribeTargetGroupsInput{
+				TargetGroupArns: []string{tgARN},
+			}).Return(tt.describeTargetGroupsCall.resp, tt.describeTargetGroupsCall.err).Times(describeCalls)
+			if tt.describeLoadBalancersCall != nil {
+				elbv2Client.EXPECT().DescribeLoadBalancersAsList(ctx, tt.describeLoadBalancersCall.req).
+					Return(tt.describeLoadBalancersCall.resp, tt.describeLoadBalancersCall.err)
+			}
+
+			r := NewCachedLoadBalancerZonesResolver(elbv2Client, log.Log)
+			tgb := makeTargetGroupBinding(tgARN)
+			got, err := r.ResolveEnabledZones(ctx, tgb)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+
+			// subsequent calls are served from cache unless the TargetGroup isn't attached yet.
+			got, err = r.ResolveEnabledZones(ctx, tgb)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_cachedLoadBalancerZonesResolver_InvalidateEnabledZones(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	tgARN := "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/my-tg/73e2d6bc24d8a067"
+	elbv2Client := services.NewMockELBV2(ctrl)
+	elbv2Client.EXPECT().AssumeRole(ctx, gomock.Any(), gomock.Any()).Return(elbv2Client, nil).Times(2)
+	elbv2Client.EXPECT().DescribeTargetGroupsAsList(ctx, gomock.Any()).Return([]elbv2types.TargetGroup{
+		{
+			TargetGroupArn:   awssdk.String(tgARN),
+			LoadBalancerArns: []string{"lb-1"},
+		},
+	}, nil).Times(2)
+	gomock.InOrder(
+		elbv2Client.EXPECT().DescribeLoadBalancersAsList(ctx, gomock.Any()).Return([]elbv2types.LoadBalancer{
+			{
+				AvailabilityZones: []elbv2types.AvailabilityZone{
+					{ZoneName: awssdk.String("us-west-2a")},
+				},
+			},
+		}, nil),
+		elbv2Client.EXPECT().DescribeLoadBalancersAsList(ctx, gomock.Any()).Return([]elbv2types.LoadBalancer{
+			{
+				AvailabilityZones: []elbv2types.AvailabilityZone{
+					{ZoneName: awssdk.String("us-west-2a")},
+					{ZoneName: awssdk.String("us-west-2b")},
+				},
+			},
+		}, nil),
+	)
+
+	r := NewCachedLoadBalancerZonesResolver(elbv2Client, log.Log)
+	tgb := makeTargetGroupBinding(tgARN)
+	got, err := r.ResolveEnabledZones(ctx, tgb)
+	assert.NoError(t, err)
+	assert.Equal(t, sets.New("us-west-2a"), got)
+
+	r.InvalidateEnabledZones(tgb)
+	got, err = r.ResolveEnabledZones(ctx, tgb)
+	assert.NoError(t, err)
+	assert.Equal(t, sets.New("us-west-2a", "us-west-2b"), got)
+}
@@ -3,7 +3,12 @@ package targetgroupbinding
 import (
 	"context"
 	"fmt"
+	"hash/fnv"
+	"strconv"
+	"sync"
+
 	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -11,12 +16,16 @@ import (
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/backend"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sync"
 )
 
 const (
 	trackedTargetsPrefix = "aws-lbc-targets-"
 	targetsKey           = "targets"
+	shardsKey            = "shards"
+
+	// maxTargetsPerShard bounds the number of targets persisted into a single config map, keeping
+	// each shard well below the 1 MiB object size limit even for IPv6 identifiers.
+	maxTargetsPerShard = 5000
 )
 
 // MultiClusterManager implements logic to support multiple LBCs managing the same Target Group.
@@ -32,6 +41,9 @@ type MultiClusterManager interface {
 
 	// CleanUp Removes any resources used to implement multicluster support.
 	CleanUp(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error
+
+	// GetOwnedTargetCount Returns the number of targets tracked as owned by this cluster, or nil when unknown.
+	GetOwnedTargetCount(tgb *elbv2api.TargetGroupBinding) *int32
 }
 
 type multiClusterManagerImpl struct {
@@ -39,7 +51,10 @@ type multiClusterManagerImpl struct {
 	apiReader  client.Reader
 	logger     logr.Logger
 
+	// configMapCache holds the union of tracked targets across all shards, shardCountCache holds the number of
+	// shards the targets are spread across. Both are keyed by getCacheKey and guarded by configMapCacheMutex.
 	configMapCache      map[string]sets.Set[string]
+	shardCountCache     map[string]int
 	configMapCacheMutex sync.RWMutex
 }
 
@@ -51,6 +66,7 @@ func NewMultiClusterManager(kubeClient client.Client, apiReader client.Reader, l
 		logger:              logger,
 		configMapCacheMutex: sync.RWMutex{},
 		configMapCache:      make(map[string]sets.Set[string]),
+		shardCountCache:     make(map[string]int),
 	}
 }
 
@@ -141,7 +157,9 @@ func (m *multiClusterManagerImpl) CleanUp(ctx context.Context, tgb *elbv2api.Tar
 
 	// Always delete from in memory cache, as it's basically "free" to do so.
 	m.configMapCacheMutex.Lock()
+	shardCount, cached := m.shardCountCache[getCacheKey(tgb)]
 	delete(m.configMapCache, getCacheKey(tgb))
+	delete(m.shardCountCache, getCacheKey(tgb))
 	m.configMapCacheMutex.Unlock()
 
 	// If not using multicluster support currently, just bail here.
@@ -149,17 +167,45 @@ func (m *multiClusterManagerImpl) CleanUp(ctx context.Context, tgb *elbv2api.Tar
 		return nil
 	}
 
-	cm := &corev1.ConfigMap{
-		ObjectMeta: metav1.ObjectMeta{
-			Namespace: tgb.Namespace,
-			Name:      getConfigMapName(tgb),
-		},
+	if !cached {
+		shardCount = 1
+		primaryCM := &corev1.ConfigMap{}
+		err := m.apiReader.Get(ctx, client.ObjectKey{Namespace: tgb.Namespace, Name: getConfigMapName(tgb)}, primaryCM)
+		if client.IgnoreNotFound(err) != nil {
+			return err
+		}
+		if err == nil {
+			if shardCount, err = getShardCount(primaryCM); err != nil {
+				return err
+			}
+		}
 	}
-	err := m.kubeClient.Delete(ctx, cm)
-	if err == nil {
+
+	// Delete the additional shards before the primary config map, so that a retry can still discover them.
+	for shard := shardCount - 1; shard >= 0; shard-- {
+		cm := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: tgb.Namespace,
+				Name:      getShardConfigMapName(tgb, shard),
+			},
+		}
+		if err := m.kubeClient.Delete(ctx, cm); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *multiClusterManagerImpl) GetOwnedTargetCount(tgb *elbv2api.TargetGroupBinding) *int32 {
+	if !tgb.Spec.MultiClusterTargetGroup {
 		return nil
 	}
-	return client.IgnoreNotFound(err)
+	cachedData := m.retrieveConfigMapFromCache(tgb)
+	if cachedData == nil {
+		return nil
+	}
+	count := int32(cachedData.Len())
+	return &count
 }
 
 func (m *multiClusterManagerImpl) getConfigMapContents(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (sets.Set[string], error) {
@@ -170,26 +216,45 @@ func (m *multiClusterManagerImpl) getConfigMapContents(ctx context.Context, tgb
 		return cachedData, nil
 	}
 
-	// If not available from in-memory cache, acquire write lock, look up data from kube api, store into cache.
-	cm := &corev1.ConfigMap{}
+	// If not available from in-memory cache, look up data from kube api, store into cache.
+	// The primary config map holds the first shard along with the total number of shards.
+	primaryCM := &corev1.ConfigMap{}
 
 	err := m.apiReader.Get(ctx, client.ObjectKey{
 		Namespace: tgb.Namespace,
 		Name:      getConfigMapName(tgb),
-	}, cm)
+	}, primaryCM)
 
-	if err == nil {
-		targetSet := algorithm.CSVToStringSet(cm.Data[targetsKey])
-		m.updateCache(tgb, targetSet)
-		return targetSet, nil
+	if err != nil {
+		// Detect not found error, if so first time running so need to populate the config map contents.
+		return nil, client.IgnoreNotFound(err)
 	}
 
-	// Detect not found error, if so first time running so need to populate the config map contents.
-	err = client.IgnoreNotFound(err)
+	shardCount, err := getShardCount(primaryCM)
 	if err != nil {
 		return nil, err
 	}
-	return nil, nil
+
+	targetSet := algorithm.CSVToStringSet(primaryCM.Data[targetsKey])
+	for shard := 1; shard < shardCount; shard++ {
+		shardCM := &corev1.ConfigMap{}
+		err := m.apiReader.Get(ctx, client.ObjectKey{
+			Namespace: tgb.Namespace,
+			Name:      getShardConfigMapName(tgb, shard),
+		}, shardCM)
+		if err != nil {
+			// A missing shard means the shard set was only partially written, treat it as empty.
+			// Missing entries can only cause targets to not be deregistered by this cluster, never the opposite.
+			if client.IgnoreNotFound(err) != nil {
+				return nil, err
+			}
+			continue
+		}
+		targetSet = targetSet.Union(algorithm.CSVToStringSet(shardCM.Data[targetsKey]))
+	}
+
+	m.updateCache(tgb, targetSet, shardCount)
+	return targetSet, nil
 }
 
 func (m *multiClusterManagerImpl) retrieveConfigMapFromCache(tgb *elbv2api.TargetGroupBinding) sets.Set[string] {
@@ -204,46 +269,109 @@ func (m *multiClusterManagerImpl) retrieveConfigMapFromCache(tgb *elbv2api.Targe
 	return nil
 }
 
+func (m *multiClusterManagerImpl) retrieveShardCountFromCache(tgb *elbv2api.TargetGroupBinding) int {
+	m.configMapCacheMutex.RLock()
+	defer m.configMapCacheMutex.RUnlock()
+
+	return m.shardCountCache[getCacheKey(tgb)]
+}
+
+// persistConfigMap persists the tracked targets into sharded config maps.
+// Targets are assigned to shards by hash, so only the shards whose contents changed are written.
 func (m *multiClusterManagerImpl) persistConfigMap(ctx context.Context, endpointMap sets.Set[string], tgb *elbv2api.TargetGroupBinding) error {
+	// Load the persisted state (e.g. after a controller restart), so that only the changed shards need to be written.
+	previousEndpoints, err := m.getConfigMapContents(ctx, tgb)
+	if err != nil {
+		return err
+	}
+	previousShardCount := m.retrieveShardCountFromCache(tgb)
+
+	// The shard count only grows, shrinking it would require re-distributing every target.
+	shardCount := max(computeShardCount(endpointMap.Len()), previousShardCount)
 
-	targetData := algorithm.StringSetToCSV(endpointMap)
+	dirtyShards := sets.New[int]()
+	if previousEndpoints == nil || shardCount != previousShardCount {
+		for shard := 0; shard < shardCount; shard++ {
+			dirtyShards.Insert(shard)
+		}
+	} else {
+		for ep := range endpointMap.SymmetricDifference(previousEndpoints) {
+			dirtyShards.Insert(getShardForTarget(ep, shardCount))
+		}
+	}
+
+	if dirtyShards.Len() == 0 {
+		m.updateCache(tgb, endpointMap, shardCount)
+		return nil
+	}
+
+	shardedEndpoints := make([]sets.Set[string], shardCount)
+	for shard := range shardedEndpoints {
+		shardedEndpoints[shard] = sets.New[string]()
+	}
+	for ep := range endpointMap {
+		shardedEndpoints[getShardForTarget(ep, shardCount)].Insert(ep)
+	}
+
+	// Write the additional shards before the primary config map, as the primary config map
+	// advertises the shard count that readers rely on after a controller restart.
+	for _, shard := range sets.List(dirtyShards) {
+		if shard == 0 {
+			continue
+		}
+		if err := m.writeShardConfigMap(ctx, tgb, shard, shardedEndpoints[shard], nil); err != nil {
+			return err
+		}
+	}
+	if dirtyShards.Has(0) || shardCount != previousShardCount {
+		primaryData := map[string]string{
+			shardsKey: strconv.Itoa(shardCount),
+		}
+		if err := m.writeShardConfigMap(ctx, tgb, 0, shardedEndpoints[0], primaryData); err != nil {
+			return err
+		}
+	}
+
+	m.updateCache(tgb, endpointMap, shardCount)
+	return nil
+}
+
+// writeShardConfigMap writes the targets of a single shard, creating the config map if needed.
+func (m *multiClusterManagerImpl) writeShardConfigMap(ctx context.Context, tgb *elbv2api.TargetGroupBinding, shard int, endpoints sets.Set[string], extraData map[string]string) error {
+	data := map[string]string{
+		targetsKey: algorithm.StringSetToCSV(endpoints),
+	}
+	for k, v := range extraData {
+		data[k] = v
+	}
 
 	// Update the cm in kube api, to ensure things work across controller restarts.
 	cm := &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: tgb.Namespace,
-			Name:      getConfigMapName(tgb),
-		},
-		Data: map[string]string{
-			targetsKey: targetData,
+			Name:      getShardConfigMapName(tgb, shard),
 		},
+		Data: data,
 	}
 
 	err := m.kubeClient.Update(ctx, cm)
-
 	if err == nil {
-		m.updateCache(tgb, endpointMap)
 		return nil
 	}
 
 	// Check for initial case and create config map.
-	err = client.IgnoreNotFound(err)
-	if err == nil {
-		err = m.kubeClient.Create(ctx, cm)
-		if err == nil {
-			m.updateCache(tgb, endpointMap)
-		}
-		return err
+	if client.IgnoreNotFound(err) == nil {
+		return m.kubeClient.Create(ctx, cm)
 	}
-
 	return err
 }
 
-func (m *multiClusterManagerImpl) updateCache(tgb *elbv2api.TargetGroupBinding, endpointMap sets.Set[string]) {
+func (m *multiClusterManagerImpl) updateCache(tgb *elbv2api.TargetGroupBinding, endpointMap sets.Set[string], shardCount int) {
 	m.configMapCacheMutex.Lock()
 	defer m.configMapCacheMutex.Unlock()
 	cacheKey := getCacheKey(tgb)
 	m.configMapCache[cacheKey] = endpointMap
+	m.shardCountCache[cacheKey] = shardCount
 }
 
 // getCacheKey generates a key to use with the in-memory config map cache.
@@ -258,3 +386,37 @@ func getCacheKey(tgb *elbv2api.TargetGroupBinding) string {
 func getConfigMapName(tgb *elbv2api.TargetGroupBinding) string {
 	return fmt.Sprintf("%s%s", trackedTargetsPrefix, tgb.Name)
 }
+
+// getShardConfigMapName generates the config map name of a shard, the first shard uses the primary config map name.
+func getShardConfigMapName(tgb *elbv2api.TargetGroupBinding, shard int) string {
+	if shard == 0 {
+		return getConfigMapName(tgb)
+	}
+	return fmt.Sprintf("%s-%d", getConfigMapName(tgb), shard)
+}
+
+// getShardCount reads the shard count from the primary config map.
+// Config maps written before sharding was introduced don't carry the shard count and hold a single shard.
+func getShardCount(primaryCM *corev1.ConfigMap) (int, error) {
+	rawShardCount, ok := primaryCM.Data[shardsKey]
+	if !ok {
+		return 1, nil
+	}
+	shardCount, err := strconv.Atoi(rawShardCount)
+	if err != nil || shardCount < 1 {
+		return 0, errors.Errorf("invalid shard count %q in configmap %s/%s", rawShardCount, primaryCM.Namespace, primaryCM.Name)
+	}
+	return shardCount, nil
+}
+
+// computeShardCount computes the number of shards needed to hold the given number of targets.
+func computeShardCount(targetCount int) int {
+	return max(1, (targetCount+maxTargetsPerShard-1)/maxTargetsPerShard)
+}
+
+// getShardForTarget computes the shard a target is persisted into.
+func getShardForTarget(target string, shardCount int) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(target))
+	return int(h.Sum32() % uint32(shardCount))
+}
@@ -676,6 +676,138 @@ func TestCleanUp(t *testing.T) {
 	}
 }
 
+func TestUpdateTrackedTargetsSharded(t *testing.T) {
+	k8sClient := testclient.NewClientBuilder().Build()
+	mc := NewMultiClusterManager(k8sClient, k8sClient, logr.New(&log.NullLogSink{})).(*multiClusterManagerImpl)
+
+	tgb := &elbv2api.TargetGroupBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      testTGBName,
+		},
+		Spec: elbv2api.TargetGroupBindingSpec{
+			MultiClusterTargetGroup: true,
+		},
+	}
+
+	endpoints := make([]string, 0, maxTargetsPerShard*2+1)
+	for i := 0; i < maxTargetsPerShard*2+1; i++ {
+		endpoints = append(endpoints, fmt.Sprintf("10.%d.%d.%d:80", i/65536, (i/256)%256, i%256))
+	}
+	err := mc.updateTrackedTargets(context.Background(), true, func() []string {
+		return endpoints
+	}, tgb)
+	assert.Nil(t, err)
+	assert.Equal(t, awssdk.Int32(int32(len(endpoints))), mc.GetOwnedTargetCount(tgb))
+
+	readShards := func() ([]*corev1.ConfigMap, sets.Set[string]) {
+		shards := make([]*corev1.ConfigMap, 0, 3)
+		persisted := sets.New[string]()
+		for shard := 0; shard < 3; shard++ {
+			cm := &corev1.ConfigMap{}
+			assert.Nil(t, k8sClient.Get(context.Background(), client.ObjectKey{
+				Namespace: tgb.Namespace,
+				Name:      getShardConfigMapName(tgb, shard),
+			}, cm))
+			shards = append(shards, cm)
+			persisted = persisted.Union(algorithm.CSVToStringSet(cm.Data[targetsKey]))
+		}
+		return shards, persisted
+	}
+
+	shards, persisted := readShards()
+	assert.Equal(t, "3", shards[0].Data[shardsKey])
+	assert.Equal(t, sets.New(endpoints...), persisted)
+
+	// Removing a single target only rewrites the shard it belongs to.
+	removed := endpoints[0]
+	removedShard := getShardForTarget(removed, 3)
+	err = mc.updateTrackedTargets(context.Background(), true, func() []string {
+		return endpoints[1:]
+	}, tgb)
+	assert.Nil(t, err)
+
+	updatedShards, persisted := readShards()
+	assert.Equal(t, sets.New(endpoints[1:]...), persisted)
+	for shard := range updatedShards {
+		if shard == removedShard {
+			assert.NotEqual(t, shards[shard].ResourceVersion, updatedShards[shard].ResourceVersion)
+		} else {
+			assert.Equal(t, shards[shard].ResourceVersion, updatedShards[shard].ResourceVersion)
+		}
+	}
+
+	// A restarted controller restores the tracked targets from all shards.
+	restarted := NewMultiClusterManager(k8sClient, k8sClient, logr.New(&log.NullLogSink{})).(*multiClusterManagerImpl)
+	restored, err := restarted.getConfigMapContents(context.Background(), tgb)
+	assert.Nil(t, err)
+	assert.Equal(t, sets.New(endpoints[1:]...), restored)
+
+	// Clean up removes every shard.
+	err = restarted.CleanUp(context.Background(), tgb)
+	assert.Nil(t, err)
+	cmList := &corev1.ConfigMapList{}
+	assert.Nil(t, k8sClient.List(context.Background(), cmList))
+	assert.Empty(t, cmList.Items)
+}
+
+func TestGetShardCount(t *testing.T) {
+	testCases := []struct {
+		name     string
+		data     map[string]string
+		expected int
+		wantErr  bool
+	}{
+		{
+			name:     "legacy config map without shard count",
+			data:     map[string]string{targetsKey: "127.0.0.1:80"},
+			expected: 1,
+		},
+		{
+			name:     "shard count set",
+			data:     map[string]string{shardsKey: "4"},
+			expected: 4,
+		},
+		{
+			name:    "invalid shard count",
+			data:    map[string]string{shardsKey: "0"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := getShardCount(&corev1.ConfigMap{Data: tc.data})
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expected, res)
+		})
+	}
+}
+
+func TestGetOwnedTargetCount(t *testing.T) {
+	k8sClient := testclient.NewClientBuilder().Build()
+	mc := NewMultiClusterManager(k8sClient, k8sClient, logr.New(&log.NullLogSink{})).(*multiClusterManagerImpl)
+
+	tgb := &elbv2api.TargetGroupBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      testTGBName,
+		},
+	}
+	setCachedValue(mc, sets.New("127.0.0.1:80", "127.0.0.2:80"), testNamespace, testTGBName)
+	assert.Nil(t, mc.GetOwnedTargetCount(tgb))
+
+	tgb.Spec.MultiClusterTargetGroup = true
+	assert.Equal(t, awssdk.Int32(2), mc.GetOwnedTargetCount(tgb))
+
+	tgb.Name = "other"
+	assert.Nil(t, mc.GetOwnedTargetCount(tgb))
+}
+
 func TestGetCacheKey(t *testing.T) {
 	testCases := []struct {
 		name        string
@@ -0,0 +1,225 @@
+package targetgroupbinding
+
+import (
+	"context"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/v3/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/backend"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/k8s"
+	lbcmetrics "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/metrics/lbc"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// newPodDrainer constructs new podDrainer
+func newPodDrainer(k8sClient client.Client, elbv2Client services.ELBV2, podInfoRepo k8s.PodInfoRepo,
+	deregistrationDelayResolver DeregistrationDelayResolver, metricsCollector lbcmetrics.MetricCollector, logger logr.Logger) *podDrainer {
+	return &podDrainer{
+		k8sClient:                   k8sClient,
+		elbv2Client:                 elbv2Client,
+		podInfoRepo:                 podInfoRepo,
+		deregistrationDelayResolver: deregistrationDelayResolver,
+		metricsCollector:            metricsCollector,
+		logger:                      logger,
+		clock:                       time.Now,
+	}
+}
+
+// podDrainer coordinates the drain of terminating pods that are held by the pod drain finalizer of a TargetGroupBinding.
+// A pod is released once none of its targets are draining, or the deregistration delay of the TargetGroup has elapsed since pod termination.
+type podDrainer struct {
+	k8sClient                   client.Client
+	elbv2Client                 services.ELBV2
+	podInfoRepo                 k8s.PodInfoRepo
+	deregistrationDelayResolver DeregistrationDelayResolver
+	metricsCollector            lbcmetrics.MetricCollector
+	logger                      logr.Logger
+
+	clock func() time.Time
+}
+
+// listTerminatingPods returns the terminating pods that are held by the pod drain finalizer of tgb.
+func (d *podDrainer) listTerminatingPods(ctx context.Context, tgb *elbv2api.TargetGroupBinding) ([]k8s.PodInfo, error) {
+	return d.listPodsWithDrainFinalizer(ctx, tgb, true)
+}
+
+// excludeTerminatingPodEndpoints removes the endpoints of terminatingPods, so that their targets get deregistered immediately.
+func excludeTerminatingPodEndpoints(endpoints []backend.PodEndpoint, terminatingPods []k8s.PodInfo) []backend.PodEndpoint {
+	if len(terminatingPods) == 0 {
+		return endpoints
+	}
+	terminatingPodUIDs := make(map[string]struct{}, len(terminatingPods))
+	for _, pod := range terminatingPods {
+		terminatingPodUIDs[string(pod.UID)] = struct{}{}
+	}
+	filteredEndpoints := make([]backend.PodEndpoint, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		if _, ok := terminatingPodUIDs[string(endpoint.Pod.UID)]; ok {
+			continue
+		}
+		filteredEndpoints = append(filteredEndpoints, endpoint)
+	}
+	return filteredEndpoints
+}
+
+// releaseDrainedPods removes the pod drain finalizer from terminatingPods whose targets are drained.
+// endpoints are the endpoints of the TargetGroupBinding excluding terminatingPods.
+// returns whether some pods are still draining and need further probe.
+func (d *podDrainer) releaseDrainedPods(ctx context.Context, tgb *elbv2api.TargetGroupBinding, terminatingPods []k8s.PodInfo, endpoints []backend.PodEndpoint) (bool, error) {
+	if len(terminatingPods) == 0 {
+		return false, nil
+	}
+	drainingPodUIDs, err := d.listDrainingPodUIDs(ctx, tgb, terminatingPods, endpoints)
+	if err != nil {
+		return false, err
+	}
+	deregistrationDelay, err := d.deregistrationDelayResolver.ResolveDeregistrationDelay(ctx, tgb)
+	if err != nil {
+		return false, err
+	}
+
+	finalizer := BuildPodDrainFinalizer(tgb)
+	now := d.clock()
+	anyPodDraining := false
+	for _, pod := range terminatingPods {
+		drainDuration := now.Sub(pod.DeletionTimestamp.Time)
+		if _, draining := drainingPodUIDs[pod.UID]; draining && drainDuration < deregistrationDelay {
+			anyPodDraining = true
+			continue
+		}
+		if err := d.removePodDrainFinalizer(ctx, pod, finalizer); err != nil {
+			return false, err
+		}
+		d.logger.V(1).Info("released drained pod", "tgb", k8s.NamespacedName(tgb), "pod", pod.Key, "drainDuration", drainDuration)
+		d.metricsCollector.ObservePodDrainDuration(tgb.Namespace, tgb.Name, drainDuration)
+	}
+	return anyPodDraining, nil
+}
+
+// releaseAllPods removes the pod drain finalizer of tgb from all pods, it's invoked when tgb is deleted.
+func (d *podDrainer) releaseAllPods(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
+	pods, err := d.listPodsWithDrainFinalizer(ctx, tgb, false)
+	if err != nil {
+		return err
+	}
+	finalizer := BuildPodDrainFinalizer(tgb)
+	for _, pod := range pods {
+		if err := d.removePodDrainFinalizer(ctx, pod, finalizer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *podDrainer) listPodsWithDrainFinalizer(ctx context.Context, tgb *elbv2api.TargetGroupBinding, terminatingOnly bool) ([]k8s.PodInfo, error) {
+	finalizer := BuildPodDrainFinalizer(tgb)
+	var pods []k8s.PodInfo
+	for _, podKey := range d.podInfoRepo.ListKeys(ctx) {
+		// check the pod is in the same namespace with the tgb
+		if podKey.Namespace != tgb.Namespace {
+			continue
+		}
+		pod, exists, err := d.podInfoRepo.Get(ctx, podKey)
+		if err != nil {
+			return nil, err
+		}
+		if !exists || !pod.HasFinalizer(finalizer) {
+			continue
+		}
+		if terminatingOnly && !pod.IsTerminating() {
+			continue
+		}
+		pods = append(pods, pod)
+	}
+	return pods, nil
+}
+
+// listDrainingPodUIDs returns the UIDs of terminatingPods whose targets are in draining state.
+// pod IPs are reused once pods are gone, so a draining target is attributed to the most recently terminated pod with its IP,
+// and to none of them when its IP is used by one of the endpoints, whose target replaced the draining one.
+func (d *podDrainer) listDrainingPodUIDs(ctx context.Context, tgb *elbv2api.TargetGroupBinding, terminatingPods []k8s.PodInfo, endpoints []backend.PodEndpoint) (map[types.UID]struct{}, error) {
+	drainingTargetIPs, err := d.listDrainingTargetIPs(ctx, tgb)
+	if err != nil {
+		return nil, err
+	}
+	endpointIPs := sets.New[string]()
+	for _, endpoint := range endpoints {
+		endpointIPs.Insert(endpoint.IP)
+	}
+	drainingPodByIP := make(map[string]k8s.PodInfo)
+	for _, pod := range terminatingPods {
+		if _, draining := drainingTargetIPs[pod.PodIP]; !draining || endpointIPs.Has(pod.PodIP) {
+			continue
+		}
+		if existingPod, ok := drainingPodByIP[pod.PodIP]; ok && !existingPod.DeletionTimestamp.Before(pod.DeletionTimestamp) {
+			continue
+		}
+		drainingPodByIP[pod.PodIP] = pod
+	}
+	drainingPodUIDs := make(map[types.UID]struct{}, len(drainingPodByIP))
+	for _, pod := range drainingPodByIP {
+		drainingPodUIDs[pod.UID] = struct{}{}
+	}
+	return drainingPodUIDs, nil
+}
+
+// listDrainingTargetIPs returns the IP addresses of targets in draining state.
+func (d *podDrainer) listDrainingTargetIPs(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (map[string]struct{}, error) {
+	clientToUse, err := d.elbv2Client.AssumeRole(ctx, tgb.Spec.IamRoleArnToAssume, tgb.Spec.AssumeRoleExternalId)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := clientToUse.DescribeTargetHealthWithContext(ctx, &elbv2sdk.DescribeTargetHealthInput{
+		TargetGroupArn: awssdk.String(tgb.Spec.TargetGroupARN),
+	})
+	if err != nil {
+		return nil, err
+	}
+	drainingTargetIPs := make(map[string]struct{})
+	for _, elem := range resp.TargetHealthDescriptions {
+		target := TargetInfo{
+			Target:       *elem.Target,
+			TargetHealth: elem.TargetHealth,
+		}
+		if target.IsDraining() {
+			drainingTargetIPs[awssdk.ToString(target.Target.Id)] = struct{}{}
+		}
+	}
+	return drainingTargetIPs, nil
+}
+
+func (d *podDrainer) removePodDrainFinalizer(ctx context.Context, pod k8s.PodInfo, finalizer string) error {
+	podPatchSource := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  pod.Key.Namespace,
+			Name:       pod.Key.Name,
+			Finalizers: pod.Finalizers,
+		},
+	}
+	podPatchTarget := podPatchSource.DeepCopy()
+	podPatchTarget.UID = pod.UID // only put the uid in the new object to ensure it appears in the patch as a precondition
+	podPatchTarget.Finalizers = nil
+	for _, f := range pod.Finalizers {
+		if f != finalizer {
+			podPatchTarget.Finalizers = append(podPatchTarget.Finalizers, f)
+		}
+	}
+
+	if err := d.k8sClient.Patch(ctx, podPatchTarget, client.StrategicMergeFrom(podPatchSource)); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return errors.Wrapf(err, "failed to remove pod drain finalizer from pod: %v", pod.Key)
+	}
+	return nil
+}
@@ -0,0 +1,261 @@
+package targetgroupbinding
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/v3/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/backend"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/k8s"
+	lbcmetrics "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/metrics/lbc"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_podDrainer_releaseDrainedPods(t *testing.T) {
+	tgARN := "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/my-tg/73e2d6bc24d8a067"
+	now := time.Now()
+	deletedAt := metav1.NewTime(now.Add(-30 * time.Second))
+	tgb := &elbv2api.TargetGroupBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "tgb",
+		},
+		Spec: elbv2api.TargetGroupBindingSpec{
+			TargetGroupARN: tgARN,
+		},
+	}
+	terminatingPod := k8s.PodInfo{
+		Key:               types.NamespacedName{Namespace: "default", Name: "pod-1"},
+		UID:               "pod-1-uid",
+		PodIP:             "10.0.0.1",
+		DeletionTimestamp: &deletedAt,
+		Finalizers:        []string{"pod-drain.elbv2.k8s.aws/tgb", "other-finalizer"},
+	}
+	drainingTarget := elbv2types.TargetHealthDescription{
+		Target: &elbv2types.TargetDescription{
+			Id:   awssdk.String("10.0.0.1"),
+			Port: awssdk.Int32(8080),
+		},
+		TargetHealth: &elbv2types.TargetHealth{
+			State: elbv2types.TargetHealthStateEnumDraining,
+		},
+	}
+	healthyTarget := elbv2types.TargetHealthDescription{
+		Target: &elbv2types.TargetDescription{
+			Id:   awssdk.String("10.0.0.2"),
+			Port: awssdk.Int32(8080),
+		},
+		TargetHealth: &elbv2types.TargetHealth{
+			State: elbv2types.TargetHealthStateEnumHealthy,
+		},
+	}
+	tests := []struct {
+		name                  string
+		targets               []elbv2types.TargetHealthDescription
+		endpoints             []backend.PodEndpoint
+		deregistrationDelay   string
+		wantPodDraining       bool
+		wantFinalizers        []string
+		wantDrainObservations int
+	}{
+		{
+			name:                  "target still draining",
+			targets:               []elbv2types.TargetHealthDescription{drainingTarget, healthyTarget},
+			deregistrationDelay:   "300",
+			wantPodDraining:       true,
+			wantFinalizers:        []string{"pod-drain.elbv2.k8s.aws/tgb", "other-finalizer"},
+			wantDrainObservations: 0,
+		},
+		{
+			name:                  "target drained",
+			targets:               []elbv2types.TargetHealthDescription{healthyTarget},
+			deregistrationDelay:   "300",
+			wantPodDraining:       false,
+			wantFinalizers:        []string{"other-finalizer"},
+			wantDrainObservations: 1,
+		},
+		{
+			name:    "target IP reused by another pod",
+			targets: []elbv2types.TargetHealthDescription{drainingTarget, healthyTarget},
+			endpoints: []backend.PodEndpoint{
+				{IP: "10.0.0.1", Port: 8080, Pod: k8s.PodInfo{UID: "pod-2-uid"}},
+			},
+			deregistrationDelay:   "300",
+			wantPodDraining:       false,
+			wantFinalizers:        []string{"other-finalizer"},
+			wantDrainObservations: 1,
+		},
+		{
+			name:                  "deregistration delay elapsed",
+			targets:               []elbv2types.TargetHealthDescription{drainingTarget, healthyTarget},
+			deregistrationDelay:   "20",
+			wantPodDraining:       false,
+			wantFinalizers:        []string{"other-finalizer"},
+			wantDrainObservations: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			elbv2Client := services.NewMockELBV2(ctrl)
+			elbv2Client.EXPECT().AssumeRole(ctx, gomock.Any(), gomock.Any()).Return(elbv2Client, nil).AnyTimes()
+			elbv2Client.EXPECT().DescribeTargetHealthWithContext(ctx, &elbv2sdk.DescribeTargetHealthInput{
+				TargetGroupArn: awssdk.String(tgARN),
+			}).Return(&elbv2sdk.DescribeTargetHealthOutput{TargetHealthDescriptions: tt.targets}, nil)
+			elbv2Client.EXPECT().DescribeTargetGroupAttributesWithContext(ctx, &elbv2sdk.DescribeTargetGroupAttributesInput{
+				TargetGroupArn: awssdk.String(tgARN),
+			}).Return(&elbv2sdk.DescribeTargetGroupAttributesOutput{
+				Attributes: []elbv2types.TargetGroupAttribute{
+					{
+						Key:   awssdk.String("deregistration_delay.timeout_seconds"),
+						Value: awssdk.String(tt.deregistrationDelay),
+					},
+				},
+			}, nil)
+
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  terminatingPod.Key.Namespace,
+					Name:       terminatingPod.Key.Name,
+					UID:        terminatingPod.UID,
+					Finalizers: terminatingPod.Finalizers,
+				},
+			}
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).WithObjects(pod).Build()
+			metricsCollector := lbcmetrics.NewMockCollector()
+
+			d := newPodDrainer(k8sClient, elbv2Client, nil, NewCachedDeregistrationDelayResolver(elbv2Client, log.Log), metricsCollector, log.Log)
+			d.clock = func() time.Time { return now }
+			gotPodDraining, err := d.releaseDrainedPods(ctx, tgb, []k8s.PodInfo{terminatingPod}, tt.endpoints)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantPodDraining, gotPodDraining)
+
+			updatedPod := &corev1.Pod{}
+			assert.NoError(t, k8sClient.Get(ctx, terminatingPod.Key, updatedPod))
+			assert.Equal(t, tt.wantFinalizers, updatedPod.Finalizers)
+			mockCollector := metricsCollector.(*lbcmetrics.MockCollector)
+			assert.Len(t, mockCollector.Invocations[lbcmetrics.MetricPodDrainDuration], tt.wantDrainObservations)
+		})
+	}
+}
+
+func Test_podDrainer_listDrainingPodUIDs(t *testing.T) {
+	tgARN := "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/my-tg/73e2d6bc24d8a067"
+	now := time.Now()
+	deletedEarlier := metav1.NewTime(now.Add(-60 * time.Second))
+	deletedLater := metav1.NewTime(now.Add(-30 * time.Second))
+	tgb := &elbv2api.TargetGroupBinding{
+		Spec: elbv2api.TargetGroupBindingSpec{
+			TargetGroupARN: tgARN,
+		},
+	}
+	targets := []elbv2types.TargetHealthDescription{
+		{
+			Target:       &elbv2types.TargetDescription{Id: awssdk.String("10.0.0.1"), Port: awssdk.Int32(8080)},
+			TargetHealth: &elbv2types.TargetHealth{State: elbv2types.TargetHealthStateEnumDraining},
+		},
+		{
+			Target:       &elbv2types.TargetDescription{Id: awssdk.String("10.0.0.2"), Port: awssdk.Int32(8080)},
+			TargetHealth: &elbv2types.TargetHealth{State: elbv2types.TargetHealthStateEnumDraining},
+		},
+	}
+	tests := []struct {
+		name            string
+		terminatingPods []k8s.PodInfo
+		endpoints       []backend.PodEndpoint
+		want            map[types.UID]struct{}
+	}{
+		{
+			name: "draining targets are attributed by pod UID",
+			terminatingPods: []k8s.PodInfo{
+				{UID: "pod-1", PodIP: "10.0.0.1", DeletionTimestamp: &deletedLater},
+				{UID: "pod-3", PodIP: "10.0.0.3", DeletionTimestamp: &deletedLater},
+			},
+			want: map[types.UID]struct{}{"pod-1": {}},
+		},
+		{
+			name: "draining target is attributed to the most recently terminated pod with its IP",
+			terminatingPods: []k8s.PodInfo{
+				{UID: "pod-1", PodIP: "10.0.0.1", DeletionTimestamp: &deletedLater},
+				{UID: "pod-1-previous", PodIP: "10.0.0.1", DeletionTimestamp: &deletedEarlier},
+			},
+			want: map[types.UID]struct{}{"pod-1": {}},
+		},
+		{
+			name: "draining target isn't attributed when its IP is used by an endpoint",
+			terminatingPods: []k8s.PodInfo{
+				{UID: "pod-1", PodIP: "10.0.0.1", DeletionTimestamp: &deletedLater},
+				{UID: "pod-2", PodIP: "10.0.0.2", DeletionTimestamp: &deletedLater},
+			},
+			endpoints: []backend.PodEndpoint{
+				{IP: "10.0.0.2", Port: 8080, Pod: k8s.PodInfo{UID: "pod-4"}},
+			},
+			want: map[types.UID]struct{}{"pod-1": {}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			elbv2Client := services.NewMockELBV2(ctrl)
+			elbv2Client.EXPECT().AssumeRole(ctx, gomock.Any(), gomock.Any()).Return(elbv2Client, nil).AnyTimes()
+			elbv2Client.EXPECT().DescribeTargetHealthWithContext(ctx, &elbv2sdk.DescribeTargetHealthInput{
+				TargetGroupArn: awssdk.String(tgARN),
+			}).Return(&elbv2sdk.DescribeTargetHealthOutput{TargetHealthDescriptions: targets}, nil)
+
+			d := newPodDrainer(nil, elbv2Client, nil, nil, lbcmetrics.NewMockCollector(), log.Log)
+			got, err := d.listDrainingPodUIDs(ctx, tgb, tt.terminatingPods, tt.endpoints)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_excludeTerminatingPodEndpoints(t *testing.T) {
+	endpoints := []backend.PodEndpoint{
+		{IP: "10.0.0.1", Port: 80, Pod: k8s.PodInfo{UID: "pod-1"}},
+		{IP: "10.0.0.2", Port: 80, Pod: k8s.PodInfo{UID: "pod-2"}},
+	}
+	tests := []struct {
+		name            string
+		terminatingPods []k8s.PodInfo
+		want            []backend.PodEndpoint
+	}{
+		{
+			name:            "no terminating pods",
+			terminatingPods: nil,
+			want:            endpoints,
+		},
+		{
+			name:            "terminating pods are excluded",
+			terminatingPods: []k8s.PodInfo{{UID: "pod-2"}},
+			want:            []backend.PodEndpoint{{IP: "10.0.0.1", Port: 80, Pod: k8s.PodInfo{UID: "pod-1"}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := excludeTerminatingPodEndpoints(endpoints, tt.terminatingPods)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -40,30 +40,44 @@ const (
 type ResourceManager interface {
 	Reconcile(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (bool, error)
 	Cleanup(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error
+
+	// ReconcileNetworking reconciles only the networking of a TargetGroupBinding whose targets are reconciled by another shard.
+	ReconcileNetworking(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error
+	// CleanupNetworking cleans up only the networking of a TargetGroupBinding whose targets are reconciled by another shard.
+	CleanupNetworking(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error
+
+	// ReleasePods releases the pods held by the pod drain finalizer of a TargetGroupBinding that no longer exists.
+	ReleasePods(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error
 }
 
 // NewDefaultResourceManager constructs new defaultResourceManager.
-func NewDefaultResourceManager(k8sClient client.Client, elbv2Client services.ELBV2,
+func NewDefaultResourceManager(k8sClient client.Client, elbv2Client services.ELBV2, gaClient services.GlobalAccelerator,
 	podInfoRepo k8s.PodInfoRepo, networkingManager networking.NetworkingManager,
 	vpcInfoProvider networking.VPCInfoProvider, multiClusterManager MultiClusterManager, metricsCollector lbcmetrics.MetricCollector,
 	vpcID string, failOpenEnabled bool, endpointSliceEnabled bool,
 	eventRecorder record.EventRecorder, logger logr.Logger, maxTargetsPerTargetGroup int, requeueDuration time.Duration) *defaultResourceManager {
 
 	targetsManager := NewCachedTargetsManager(elbv2Client, logger)
+	lbZonesResolver := NewCachedLoadBalancerZonesResolver(elbv2Client, logger)
+	agaEndpointHealthResolver := NewDefaultAGAEndpointHealthResolver(k8sClient, elbv2Client, gaClient, logger)
+	podDrainer := newPodDrainer(k8sClient, elbv2Client, podInfoRepo, NewCachedDeregistrationDelayResolver(elbv2Client, logger), metricsCollector, logger)
 	endpointResolver := backend.NewDefaultEndpointResolver(k8sClient, podInfoRepo, failOpenEnabled, endpointSliceEnabled, logger)
 	return &defaultResourceManager{
-		k8sClient:                k8sClient,
-		targetsManager:           targetsManager,
-		endpointResolver:         endpointResolver,
-		networkingManager:        networkingManager,
-		eventRecorder:            eventRecorder,
-		logger:                   logger,
-		vpcID:                    vpcID,
-		vpcInfoProvider:          vpcInfoProvider,
-		podInfoRepo:              podInfoRepo,
-		maxTargetsPerTargetGroup: maxTargetsPerTargetGroup,
-		multiClusterManager:      multiClusterManager,
-		metricsCollector:         metricsCollector,
+		k8sClient:                 k8sClient,
+		targetsManager:            targetsManager,
+		lbZonesResolver:           lbZonesResolver,
+		agaEndpointHealthResolver: agaEndpointHealthResolver,
+		podDrainer:                podDrainer,
+		endpointResolver:          endpointResolver,
+		networkingManager:         networkingManager,
+		eventRecorder:             eventRecorder,
+		logger:                    logger,
+		vpcID:                     vpcID,
+		vpcInfoProvider:           vpcInfoProvider,
+		podInfoRepo:               podInfoRepo,
+		maxTargetsPerTargetGroup:  maxTargetsPerTargetGroup,
+		multiClusterManager:       multiClusterManager,
+		metricsCollector:          metricsCollector,
 
 		invalidVpcCache:    cache.NewExpiring(),
 		invalidVpcCacheTTL: defaultTargetsCacheTTL,
@@ -82,18 +96,21 @@ var _ ResourceManager = &defaultResourceManager{}
 
 // default implementation for ResourceManager.
 type defaultResourceManager struct {
-	k8sClient                client.Client
-	targetsManager           TargetsManager
-	endpointResolver         backend.EndpointResolver
-	networkingManager        networking.NetworkingManager
-	eventRecorder            record.EventRecorder
-	logger                   logr.Logger
-	vpcInfoProvider          networking.VPCInfoProvider
-	podInfoRepo              k8s.PodInfoRepo
-	maxTargetsPerTargetGroup int
-	multiClusterManager      MultiClusterManager
-	metricsCollector         lbcmetrics.MetricCollector
-	vpcID                    string
+	k8sClient                 client.Client
+	targetsManager            TargetsManager
+	lbZonesResolver           LoadBalancerZonesResolver
+	agaEndpointHealthResolver AGAEndpointHealthResolver
+	podDrainer                *podDrainer
+	endpointResolver          backend.EndpointResolver
+	networkingManager         networking.NetworkingManager
+	eventRecorder             record.EventRecorder
+	logger                    logr.Logger
+	vpcInfoProvider           networking.VPCInfoProvider
+	podInfoRepo               k8s.PodInfoRepo
+	maxTargetsPerTargetGroup  int
+	multiClusterManager       MultiClusterManager
+	metricsCollector          lbcmetrics.MetricCollector
+	vpcID                     string
 
 	invalidVpcCache      *cache.Expiring
 	invalidVpcCacheTTL   time.Duration
@@ -114,12 +131,16 @@ type defaultResourceManager struct {
 	nodeAZCacheMutex sync.RWMutex
 
 	requeueDuration time.Duration
+
+	// networkingCheckPoints tracks the checkpoint of TargetGroupBindings whose networking was last reconciled by ReconcileNetworking.
+	networkingCheckPoints sync.Map
 }
 
 func (m *defaultResourceManager) Reconcile(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (bool, error) {
 	if tgb.Spec.TargetType == nil {
 		return false, errors.Errorf("targetType is not specified: %v", k8s.NamespacedName(tgb).String())
 	}
+	m.networkingCheckPoints.Delete(k8s.NamespacedName(tgb))
 
 	var newCheckPoint string
 	var oldCheckPoint string
@@ -158,10 +179,79 @@ func (m *defaultResourceManager) Cleanup(ctx context.Context, tgb *elbv2api.Targ
 	if err := m.updatePodAsHealthyForDeletedTGB(ctx, tgb); err != nil {
 		return err
 	}
+	if err := m.podDrainer.releaseAllPods(ctx, tgb); err != nil {
+		return err
+	}
+	m.cleanupZoneCoverage(tgb)
 
 	return nil
 }
 
+func (m *defaultResourceManager) ReconcileNetworking(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
+	if tgb.Spec.TargetType == nil {
+		return errors.Errorf("targetType is not specified: %v", k8s.NamespacedName(tgb).String())
+	}
+	svcKey := buildServiceReferenceKey(tgb, tgb.Spec.ServiceRef)
+	if *tgb.Spec.TargetType == elbv2api.TargetTypeIP {
+		endpoints, err := m.endpointResolver.ResolvePodEndpoints(ctx, svcKey, tgb.Spec.ServiceRef.Port, endpointSliceAddressType(tgb))
+		if err != nil {
+			if errors.Is(err, backend.ErrNotFound) {
+				return m.CleanupNetworking(ctx, tgb)
+			}
+			return err
+		}
+		checkPoint, err := calculateTGBReconcileCheckpoint(endpoints, tgb)
+		if err != nil {
+			return err
+		}
+		return m.reconcileNetworkingWithCheckPoint(tgb, checkPoint, func() error {
+			return m.networkingManager.ReconcileForPodEndpoints(ctx, tgb, endpoints)
+		})
+	}
+
+	nodeSelector, err := backend.GetTrafficProxyNodeSelector(tgb)
+	if err != nil {
+		return err
+	}
+	endpoints, err := m.endpointResolver.ResolveNodePortEndpoints(ctx, svcKey, tgb.Spec.ServiceRef.Port, backend.WithNodeSelector(nodeSelector))
+	if err != nil {
+		if errors.Is(err, backend.ErrNotFound) {
+			return m.CleanupNetworking(ctx, tgb)
+		}
+		return err
+	}
+	checkPoint, err := calculateTGBReconcileCheckpoint(endpoints, tgb)
+	if err != nil {
+		return err
+	}
+	return m.reconcileNetworkingWithCheckPoint(tgb, checkPoint, func() error {
+		return m.networkingManager.ReconcileForNodePortEndpoints(ctx, tgb, endpoints)
+	})
+}
+
+func (m *defaultResourceManager) CleanupNetworking(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
+	m.networkingCheckPoints.Delete(k8s.NamespacedName(tgb))
+	return m.networkingManager.Cleanup(ctx, tgb)
+}
+
+func (m *defaultResourceManager) ReleasePods(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
+	return m.podDrainer.releaseAllPods(ctx, tgb)
+}
+
+// reconcileNetworkingWithCheckPoint invokes reconcileFn unless the networking was already reconciled for the same checkpoint.
+func (m *defaultResourceManager) reconcileNetworkingWithCheckPoint(tgb *elbv2api.TargetGroupBinding, checkPoint string, reconcileFn func() error) error {
+	tgbKey := k8s.NamespacedName(tgb)
+	if previousCheckPoint, ok := m.networkingCheckPoints.Load(tgbKey); ok && previousCheckPoint.(string) == checkPoint {
+		return nil
+	}
+	if err := reconcileFn(); err != nil {
+		m.networkingCheckPoints.Delete(tgbKey)
+		return err
+	}
+	m.networkingCheckPoints.Store(tgbKey, checkPoint)
+	return nil
+}
+
 func (m *defaultResourceManager) reconcileWithIPTargetType(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (string, string, bool, error) {
 	tgbScopedLogger := m.logger.WithValues("tgb", k8s.NamespacedName(tgb))
 	svcKey := buildServiceReferenceKey(tgb, tgb.Spec.ServiceRef)
@@ -184,6 +274,13 @@ func (m *defaultResourceManager) reconcileWithIPTargetType(ctx context.Context,
 		return "", "", false, ctrlerrors.NewErrorWithMetrics(controllerName, "resolve_pod_endpoints_error", err, m.metricsCollector)
 	}
 
+	// terminating pods held by the pod drain finalizer are deregistered immediately, and released once drained.
+	terminatingPods, err := m.podDrainer.listTerminatingPods(ctx, tgb)
+	if err != nil {
+		return "", "", false, ctrlerrors.NewErrorWithMetrics(controllerName, "list_terminating_pods_error", err, m.metricsCollector)
+	}
+	endpoints = excludeTerminatingPodEndpoints(endpoints, terminatingPods)
+
 	newCheckPoint, err := calculateTGBReconcileCheckpoint(endpoints, tgb)
 
 	if err != nil {
@@ -192,8 +289,8 @@ func (m *defaultResourceManager) reconcileWithIPTargetType(ctx context.Context,
 
 	// Block the checkpoint early-exit if any pod has a pending readiness gate condition in cache.
 	// Only compute when checkpoints match — if they differ the early-exit won't fire anyway.
-	if oldCheckPoint == newCheckPoint {
-		if !needReadinessGateFlip(endpoints, targetHealthCondType) {
+	if oldCheckPoint == newCheckPoint && len(terminatingPods) == 0 {
+		if !needReadinessGateFlip(endpoints, targetHealthCondType) && !needReadinessGateFlip(endpoints, BuildAGAEndpointHealthPodConditionType(tgb)) {
 			tgbScopedLogger.Info("Skipping targetgroupbinding reconcile", "calculated hash", newCheckPoint)
 			return newCheckPoint, oldCheckPoint, true, nil
 		}
@@ -207,6 +304,15 @@ func (m *defaultResourceManager) reconcileWithIPTargetType(ctx context.Context,
 	notDrainingTargets, _ := partitionTargetsByDrainingStatus(targets)
 	matchedEndpointAndTargets, unmatchedEndpoints, unmatchedTargets := matchPodEndpointWithTargets(tgb, endpoints, notDrainingTargets)
 
+	zoneCoverage, err := m.checkPodEndpointsZoneCoverage(ctx, tgb, endpoints)
+	if err != nil {
+		return "", "", false, ctrlerrors.NewErrorWithMetrics(controllerName, "check_zone_coverage_error", err, m.metricsCollector)
+	}
+	if err := m.updateZoneCoverageStatus(ctx, tgb, zoneCoverage); err != nil {
+		return "", "", false, ctrlerrors.NewErrorWithMetrics(controllerName, "update_zone_coverage_status_error", err, m.metricsCollector)
+	}
+	unmatchedEndpoints, needZoneRequeue := applyTargetZonePolicy(tgb, unmatchedEndpoints, zoneCoverage)
+
 	needNetworkingRequeue := false
 	if err := m.networkingManager.ReconcileForPodEndpoints(ctx, tgb, endpoints); err != nil {
 		tgbScopedLogger.Error(err, "Requesting network requeue due to error from ReconcileForPodEndpoints")
@@ -282,16 +388,41 @@ func (m *defaultResourceManager) reconcileWithIPTargetType(ctx context.Context,
 		return "", "", false, ctrlerrors.NewErrorWithMetrics(controllerName, "update_target_health_pod_condition_error", err, m.metricsCollector)
 	}
 
+	anyPodNeedAGAEndpointHealthProbe, err := m.updateAGAEndpointHealthPodCondition(ctx, endpoints, tgb)
+	if err != nil {
+		return "", "", false, ctrlerrors.NewErrorWithMetrics(controllerName, "update_aga_endpoint_health_pod_condition_error", err, m.metricsCollector)
+	}
+
+	anyPodDraining, err := m.podDrainer.releaseDrainedPods(ctx, tgb, terminatingPods, endpoints)
+	if err != nil {
+		return "", "", false, ctrlerrors.NewErrorWithMetrics(controllerName, "release_drained_pods_error", err, m.metricsCollector)
+	}
+
 	if anyPodNeedFurtherProbe {
 		tgbScopedLogger.Info("Requeue for target monitor target health")
 		return "", "", false, ctrlerrors.NewRequeueNeededAfter("monitor targetHealth", m.requeueDuration)
 	}
 
+	if anyPodNeedAGAEndpointHealthProbe {
+		tgbScopedLogger.Info("Requeue for monitor GlobalAccelerator endpoint health")
+		return "", "", false, ctrlerrors.NewRequeueNeededAfter("monitor GlobalAccelerator endpoint health", m.requeueDuration)
+	}
+
+	if anyPodDraining {
+		tgbScopedLogger.Info("Requeue for monitor draining pods")
+		return "", "", false, ctrlerrors.NewRequeueNeededAfter("monitor draining pods", m.requeueDuration)
+	}
+
 	if needNetworkingRequeue {
 		tgbScopedLogger.Info("Requeue for networking requeue")
 		return "", "", false, ctrlerrors.NewRequeueNeededAfter("networking reconciliation", m.requeueDuration)
 	}
 
+	if needZoneRequeue {
+		tgbScopedLogger.Info("Requeue for targets in uncovered availability zones")
+		return "", "", false, ctrlerrors.NewRequeueNeededAfter("targets in uncovered availability zones", m.requeueDuration)
+	}
+
 	tgbScopedLogger.Info("Successful reconcile", "checkpoint", newCheckPoint)
 	return newCheckPoint, oldCheckPoint, false, nil
 }
@@ -335,6 +466,15 @@ func (m *defaultResourceManager) reconcileWithInstanceTargetType(ctx context.Con
 
 	_, unmatchedEndpoints, unmatchedTargets := matchNodePortEndpointWithTargets(endpoints, notDrainingTargets)
 
+	zoneCoverage, err := m.checkNodePortEndpointsZoneCoverage(ctx, tgb, endpoints)
+	if err != nil {
+		return "", "", false, ctrlerrors.NewErrorWithMetrics(controllerName, "check_zone_coverage_error", err, m.metricsCollector)
+	}
+	if err := m.updateZoneCoverageStatus(ctx, tgb, zoneCoverage); err != nil {
+		return "", "", false, ctrlerrors.NewErrorWithMetrics(controllerName, "update_zone_coverage_status_error", err, m.metricsCollector)
+	}
+	unmatchedEndpoints, needZoneRequeue := applyTargetZonePolicy(tgb, unmatchedEndpoints, zoneCoverage)
+
 	if err := m.networkingManager.ReconcileForNodePortEndpoints(ctx, tgb, endpoints); err != nil {
 		tgbScopedLogger.Error(err, "Requesting network requeue due to error from ReconcileForNodePortEndpoints")
 		return "", "", false, ctrlerrors.NewErrorWithMetrics(controllerName, "reconcile_nodeport_endpoints_error", err, m.metricsCollector)
@@ -378,6 +518,11 @@ func (m *defaultResourceManager) reconcileWithInstanceTargetType(ctx context.Con
 		return "", "", false, ctrlerrors.NewErrorWithMetrics(controllerName, "update_tracked_instance_targets_error", err, m.metricsCollector)
 	}
 
+	if needZoneRequeue {
+		tgbScopedLogger.Info("Requeue for targets in uncovered availability zones")
+		return "", "", false, ctrlerrors.NewRequeueNeededAfter("targets in uncovered availability zones", m.requeueDuration)
+	}
+
 	tgbScopedLogger.Info("Successful reconcile", "checkpoint", newCheckPoint)
 	return newCheckPoint, oldCheckPoint, false, nil
 }
@@ -457,14 +602,22 @@ func (m *defaultResourceManager) updateTargetHealthPodConditionForPod(ctx contex
 	}
 
 	targetHealthCondStatus, needFurtherProbe := m.calculateReadinessGateTransition(pod, targetHealthCondType, targetHealth)
+	if err := m.patchPodCondition(ctx, pod, targetHealthCondType, targetHealthCondStatus, reason, message, tgb); err != nil {
+		return false, err
+	}
+	return needFurtherProbe, nil
+}
 
+// patchPodCondition sets the targetHealthCondType condition of pod, unless it already has the given status, reason and message.
+func (m *defaultResourceManager) patchPodCondition(ctx context.Context, pod k8s.PodInfo, targetHealthCondType corev1.PodConditionType,
+	targetHealthCondStatus corev1.ConditionStatus, reason string, message string, tgb *elbv2api.TargetGroupBinding) error {
 	existingTargetHealthCond, hasExistingTargetHealthCond := pod.GetPodCondition(targetHealthCondType)
 	// we skip patch pod if it matches current computed status/reason/message.
 	if hasExistingTargetHealthCond &&
 		existingTargetHealthCond.Status == targetHealthCondStatus &&
 		existingTargetHealthCond.Reason == reason &&
 		existingTargetHealthCond.Message == message {
-		return needFurtherProbe, nil
+		return nil
 	}
 
 	newTargetHealthCond := corev1.PodCondition{
@@ -498,9 +651,9 @@ func (m *defaultResourceManager) updateTargetHealthPodConditionForPod(ctx contex
 
 	if err := m.k8sClient.Status().Patch(ctx, podPatchTarget, client.StrategicMergeFrom(podPatchSource)); err != nil {
 		if apierrors.IsNotFound(err) {
-			return false, nil
+			return nil
 		}
-		return false, err
+		return err
 	}
 
 	// Only update duration on unhealthy -> healthy flips.
@@ -509,7 +662,43 @@ func (m *defaultResourceManager) updateTargetHealthPodConditionForPod(ctx contex
 		m.metricsCollector.ObservePodReadinessGateReady(tgb.Namespace, tgb.Name, delta)
 	}
 
-	return needFurtherProbe, nil
+	return nil
+}
+
+// updateAGAEndpointHealthPodCondition updates the GlobalAccelerator endpoint health condition of pods that have the readiness gate
+// and are not yet ready, so that new pods only become ready once the LoadBalancer is healthy in its GlobalAccelerator endpoint groups.
+// Pods that already passed the gate are left alone, since making them unready would in turn keep the LoadBalancer unhealthy.
+// returns whether further probe is needed or not
+func (m *defaultResourceManager) updateAGAEndpointHealthPodCondition(ctx context.Context, endpoints []backend.PodEndpoint, tgb *elbv2api.TargetGroupBinding) (bool, error) {
+	agaEndpointHealthCondType := BuildAGAEndpointHealthPodConditionType(tgb)
+	var pendingPods []k8s.PodInfo
+	for _, endpoint := range endpoints {
+		if !endpoint.Pod.HasAnyOfReadinessGates([]corev1.PodConditionType{agaEndpointHealthCondType}) {
+			continue
+		}
+		if cond, exists := endpoint.Pod.GetPodCondition(agaEndpointHealthCondType); exists && cond.Status == corev1.ConditionTrue {
+			continue
+		}
+		pendingPods = append(pendingPods, endpoint.Pod)
+	}
+	if len(pendingPods) == 0 {
+		return false, nil
+	}
+
+	health, err := m.agaEndpointHealthResolver.ResolveEndpointHealth(ctx, tgb)
+	if err != nil {
+		return false, err
+	}
+	agaEndpointHealthCondStatus := corev1.ConditionFalse
+	if health.Healthy {
+		agaEndpointHealthCondStatus = corev1.ConditionTrue
+	}
+	for _, pod := range pendingPods {
+		if err := m.patchPodCondition(ctx, pod, agaEndpointHealthCondType, agaEndpointHealthCondStatus, string(health.Reason), health.Message, tgb); err != nil {
+			return false, err
+		}
+	}
+	return !health.Healthy, nil
 }
 
 func (m *defaultResourceManager) calculateReadinessGateTransition(pod k8s.PodInfo, targetHealthCondType corev1.PodConditionType, targetHealth *elbv2types.TargetHealth) (corev1.ConditionStatus, bool) {
@@ -531,6 +720,7 @@ func (m *defaultResourceManager) calculateReadinessGateTransition(pod k8s.PodInf
 // if the pod has readiness Gate.
 func (m *defaultResourceManager) updatePodAsHealthyForDeletedTGB(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
 	targetHealthCondType := BuildTargetHealthPodConditionType(tgb)
+	agaEndpointHealthCondType := BuildAGAEndpointHealthPodConditionType(tgb)
 
 	allPodKeys := m.podInfoRepo.ListKeys(ctx)
 	for _, podKey := range allPodKeys {
@@ -555,6 +745,11 @@ func (m *defaultResourceManager) updatePodAsHealthyForDeletedTGB(ctx context.Con
 				return err
 			}
 		}
+		if pod.HasAnyOfReadinessGates([]corev1.PodConditionType{agaEndpointHealthCondType}) {
+			if err := m.patchPodCondition(ctx, pod, agaEndpointHealthCondType, corev1.ConditionTrue, "", "Target Group Binding is deleted", tgb); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
@@ -604,6 +799,8 @@ func (m *defaultResourceManager) registerPodEndpoints(ctx context.Context, tgb *
 		m.needsPodAZCacheMutex.Lock()
 		m.needsPodAZCache.Set(tgbKey, true, m.needsPodAZCacheTTL)
 		m.needsPodAZCacheMutex.Unlock()
+		// the availability zones enabled on the LoadBalancer have changed.
+		m.lbZonesResolver.InvalidateEnabledZones(tgb)
 
 		sdkTargets, err = m.prepareRegistrationCall(ctx, endpoints, tgb, overrideAzFn, true)
 		if err != nil {
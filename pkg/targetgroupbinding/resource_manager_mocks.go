@@ -49,6 +49,20 @@ func (mr *MockResourceManagerMockRecorder) Cleanup(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cleanup", reflect.TypeOf((*MockResourceManager)(nil).Cleanup), arg0, arg1)
 }
 
+// CleanupNetworking mocks base method.
+func (m *MockResourceManager) CleanupNetworking(arg0 context.Context, arg1 *v1beta1.TargetGroupBinding) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CleanupNetworking", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CleanupNetworking indicates an expected call of CleanupNetworking.
+func (mr *MockResourceManagerMockRecorder) CleanupNetworking(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CleanupNetworking", reflect.TypeOf((*MockResourceManager)(nil).CleanupNetworking), arg0, arg1)
+}
+
 // Reconcile mocks base method.
 func (m *MockResourceManager) Reconcile(arg0 context.Context, arg1 *v1beta1.TargetGroupBinding) (bool, error) {
 	m.ctrl.T.Helper()
@@ -63,3 +77,31 @@ func (mr *MockResourceManagerMockRecorder) Reconcile(arg0, arg1 interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconcile", reflect.TypeOf((*MockResourceManager)(nil).Reconcile), arg0, arg1)
 }
+
+// ReconcileNetworking mocks base method.
+func (m *MockResourceManager) ReconcileNetworking(arg0 context.Context, arg1 *v1beta1.TargetGroupBinding) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileNetworking", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReconcileNetworking indicates an expected call of ReconcileNetworking.
+func (mr *MockResourceManagerMockRecorder) ReconcileNetworking(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileNetworking", reflect.TypeOf((*MockResourceManager)(nil).ReconcileNetworking), arg0, arg1)
+}
+
+// ReleasePods mocks base method.
+func (m *MockResourceManager) ReleasePods(arg0 context.Context, arg1 *v1beta1.TargetGroupBinding) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleasePods", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleasePods indicates an expected call of ReleasePods.
+func (mr *MockResourceManagerMockRecorder) ReleasePods(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleasePods", reflect.TypeOf((*MockResourceManager)(nil).ReleasePods), arg0, arg1)
+}
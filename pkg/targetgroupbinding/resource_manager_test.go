@@ -983,6 +983,7 @@ func Test_defaultResourceManager_registerPodEndpoints_RetryWithCache(t *testing.
 	m := &defaultResourceManager{
 		k8sClient:            k8sClient,
 		targetsManager:       mockTargetsManager,
+		lbZonesResolver:      &staticLoadBalancerZonesResolver{},
 		logger:               logr.New(&log.NullLogSink{}),
 		vpcID:                "vpc-123",
 		vpcInfoProvider:      mockVPCInfoProvider,
@@ -1099,6 +1100,7 @@ func Test_defaultResourceManager_registerPodEndpoints_RetryFails(t *testing.T) {
 	m := &defaultResourceManager{
 		k8sClient:            k8sClient,
 		targetsManager:       mockTargetsManager,
+		lbZonesResolver:      &staticLoadBalancerZonesResolver{},
 		logger:               logr.New(&log.NullLogSink{}),
 		vpcID:                "vpc-123",
 		vpcInfoProvider:      mockVPCInfoProvider,
@@ -23,6 +23,14 @@ const (
 	TargetHealthPodConditionTypePrefix = "target-health.elbv2.k8s.aws"
 	// Legacy Prefix for TargetHealth pod condition type(used by AWS ALB Ingress Controller)
 	TargetHealthPodConditionTypePrefixLegacy = "target-health.alb.ingress.k8s.aws"
+	// Prefix for coordinated pod drain finalizer.
+	PodDrainFinalizerPrefix = "pod-drain.elbv2.k8s.aws"
+	// Label on namespace to enable coordinated pod drain for all TargetGroupBindings in the namespace.
+	LabelCoordinatedPodDrain = "elbv2.k8s.aws/coordinated-pod-drain"
+	// Prefix for GlobalAccelerator endpoint health pod condition type.
+	AGAEndpointHealthPodConditionTypePrefix = "aga-endpoint-health.aga.k8s.aws"
+	// Label on namespace to inject GlobalAccelerator endpoint health readiness gates for all TargetGroupBindings in the namespace.
+	LabelAGAEndpointHealthReadinessGateInject = "aga.k8s.aws/pod-readiness-gate-inject"
 
 	// Index Key for "ServiceReference" index.
 	IndexKeyServiceRefName = "spec.serviceRef.name"
@@ -33,6 +41,16 @@ func BuildTargetHealthPodConditionType(tgb *elbv2api.TargetGroupBinding) corev1.
 	return corev1.PodConditionType(fmt.Sprintf("%s/%s", TargetHealthPodConditionTypePrefix, tgb.Name))
 }
 
+// BuildAGAEndpointHealthPodConditionType constructs the condition type for GlobalAccelerator endpoint health pod condition.
+func BuildAGAEndpointHealthPodConditionType(tgb *elbv2api.TargetGroupBinding) corev1.PodConditionType {
+	return corev1.PodConditionType(fmt.Sprintf("%s/%s", AGAEndpointHealthPodConditionTypePrefix, tgb.Name))
+}
+
+// BuildPodDrainFinalizer constructs the finalizer that holds terminating pods until their targets are drained.
+func BuildPodDrainFinalizer(tgb *elbv2api.TargetGroupBinding) string {
+	return fmt.Sprintf("%s/%s", PodDrainFinalizerPrefix, tgb.Name)
+}
+
 // IndexFuncServiceRefName is IndexFunc for "ServiceReference" index.
 func IndexFuncServiceRefName(obj client.Object) []string {
 	tgb := obj.(*elbv2api.TargetGroupBinding)
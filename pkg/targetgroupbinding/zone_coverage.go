@@ -0,0 +1,191 @@
+package targetgroupbinding
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/v3/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/backend"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// TargetGroupBindingConditionTargetZonesCovered indicates whether all targets are located in availability zones enabled on the LoadBalancer.
+	TargetGroupBindingConditionTargetZonesCovered = "TargetZonesCovered"
+
+	zoneCoverageReasonAllZonesCovered = "AllZonesCovered"
+	zoneCoverageReasonUncoveredZones  = "UncoveredZones"
+)
+
+// zoneCoverage describes the targets located in availability zones that are not enabled on the LoadBalancer.
+type zoneCoverage struct {
+	// uncoveredZones are the availability zones of targets that are not enabled on the LoadBalancer.
+	uncoveredZones sets.Set[string]
+	// uncoveredTargets are the identifiers of targets located in uncoveredZones.
+	uncoveredTargets sets.Set[string]
+}
+
+// zoneCoverageCheckEnabled returns whether the availability zone of targets should be checked for tgb.
+// Availability zone names are mapped per AWS account, so the check is skipped for cross-account TargetGroups.
+func zoneCoverageCheckEnabled(tgb *elbv2api.TargetGroupBinding) bool {
+	return tgb.Spec.TargetZonePolicy != nil && tgb.Spec.IamRoleArnToAssume == ""
+}
+
+// checkPodEndpointsZoneCoverage detects pod endpoints located in availability zones not enabled on the LoadBalancer.
+// It returns nil when the check is disabled or the TargetGroup isn't attached to any LoadBalancer yet.
+func (m *defaultResourceManager) checkPodEndpointsZoneCoverage(ctx context.Context, tgb *elbv2api.TargetGroupBinding, endpoints []backend.PodEndpoint) (*zoneCoverage, error) {
+	if !zoneCoverageCheckEnabled(tgb) {
+		return nil, nil
+	}
+	enabledZones, err := m.lbZonesResolver.ResolveEnabledZones(ctx, tgb)
+	if err != nil || enabledZones == nil {
+		return nil, err
+	}
+
+	vpcID := m.vpcID
+	if tgb.Spec.VpcID != "" {
+		vpcID = tgb.Spec.VpcID
+	}
+	overrideAzFn, err := m.generateOverrideAzFn(ctx, vpcID, tgb.Spec.IamRoleArnToAssume)
+	if err != nil {
+		return nil, err
+	}
+
+	coverage := newZoneCoverage()
+	for _, endpoint := range endpoints {
+		podIP, err := netip.ParseAddr(endpoint.IP)
+		if err != nil {
+			return nil, err
+		}
+		// targets outside the VPC are registered into the "all" availability zone.
+		if overrideAzFn(podIP) {
+			continue
+		}
+		az, err := m.getPodAvailabilityZone(ctx, endpoint.Pod)
+		if err != nil {
+			return nil, err
+		}
+		if az != nil && !enabledZones.Has(*az) {
+			coverage.uncoveredZones.Insert(*az)
+			coverage.uncoveredTargets.Insert(endpoint.GetIdentifier(false, tgbProtocolSupportsQuic(tgb)))
+		}
+	}
+	return coverage, nil
+}
+
+// checkNodePortEndpointsZoneCoverage detects nodePort endpoints located in availability zones not enabled on the LoadBalancer.
+// It returns nil when the check is disabled or the TargetGroup isn't attached to any LoadBalancer yet.
+func (m *defaultResourceManager) checkNodePortEndpointsZoneCoverage(ctx context.Context, tgb *elbv2api.TargetGroupBinding, endpoints []backend.NodePortEndpoint) (*zoneCoverage, error) {
+	if !zoneCoverageCheckEnabled(tgb) {
+		return nil, nil
+	}
+	enabledZones, err := m.lbZonesResolver.ResolveEnabledZones(ctx, tgb)
+	if err != nil || enabledZones == nil {
+		return nil, err
+	}
+
+	coverage := newZoneCoverage()
+	for _, endpoint := range endpoints {
+		if endpoint.Node == nil {
+			continue
+		}
+		az, ok := endpoint.Node.Labels[corev1.LabelTopologyZone]
+		if ok && !enabledZones.Has(az) {
+			coverage.uncoveredZones.Insert(az)
+			coverage.uncoveredTargets.Insert(endpoint.GetIdentifier(false, false))
+		}
+	}
+	return coverage, nil
+}
+
+// updateZoneCoverageStatus reports the zone coverage of targets via TargetGroupBinding condition and metrics.
+func (m *defaultResourceManager) updateZoneCoverageStatus(ctx context.Context, tgb *elbv2api.TargetGroupBinding, coverage *zoneCoverage) error {
+	tgbOld := tgb.DeepCopy()
+	var changed bool
+	if coverage == nil {
+		if zoneCoverageCheckEnabled(tgb) {
+			// the enabled zones are not known yet, keep the last reported status.
+			return nil
+		}
+		m.metricsCollector.ResetTargetsInUncoveredZones(tgb.Namespace, tgb.Name)
+		changed = meta.RemoveStatusCondition(&tgb.Status.Conditions, TargetGroupBindingConditionTargetZonesCovered)
+	} else {
+		if coverage.uncoveredTargets.Len() != 0 {
+			// the uncovered zones are likely to be enabled on the LoadBalancer soon, re-resolve them on the next reconcile.
+			m.lbZonesResolver.InvalidateEnabledZones(tgb)
+		}
+		m.metricsCollector.ObserveTargetsInUncoveredZones(tgb.Namespace, tgb.Name, coverage.uncoveredTargets.Len())
+		changed = meta.SetStatusCondition(&tgb.Status.Conditions, buildZoneCoverageCondition(tgb, coverage))
+	}
+	if !changed {
+		return nil
+	}
+	if err := m.k8sClient.Status().Patch(ctx, tgb, client.MergeFrom(tgbOld)); err != nil {
+		return errors.Wrapf(err, "failed to update targetGroupBinding status: %v", k8s.NamespacedName(tgb))
+	}
+	return nil
+}
+
+// cleanupZoneCoverage forgets the zone coverage state of a deleted TargetGroupBinding.
+func (m *defaultResourceManager) cleanupZoneCoverage(tgb *elbv2api.TargetGroupBinding) {
+	m.lbZonesResolver.InvalidateEnabledZones(tgb)
+	m.metricsCollector.ResetTargetsInUncoveredZones(tgb.Namespace, tgb.Name)
+}
+
+func buildZoneCoverageCondition(tgb *elbv2api.TargetGroupBinding, coverage *zoneCoverage) metav1.Condition {
+	if coverage.uncoveredTargets.Len() == 0 {
+		return metav1.Condition{
+			Type:               TargetGroupBindingConditionTargetZonesCovered,
+			Status:             metav1.ConditionTrue,
+			Reason:             zoneCoverageReasonAllZonesCovered,
+			Message:            "All targets are located in availability zones enabled on the load balancer",
+			ObservedGeneration: tgb.Generation,
+		}
+	}
+	return metav1.Condition{
+		Type:   TargetGroupBindingConditionTargetZonesCovered,
+		Status: metav1.ConditionFalse,
+		Reason: zoneCoverageReasonUncoveredZones,
+		Message: fmt.Sprintf("%d targets are located in availability zones not enabled on the load balancer: %s",
+			coverage.uncoveredTargets.Len(), strings.Join(sets.List(coverage.uncoveredZones), ",")),
+		ObservedGeneration: tgb.Generation,
+	}
+}
+
+// applyTargetZonePolicy filters out endpoints in uncovered availability zones per the TargetZonePolicy of tgb.
+// It returns the endpoints to register and whether registration of some endpoints is deferred.
+func applyTargetZonePolicy[V backend.Endpoint](tgb *elbv2api.TargetGroupBinding, endpoints []V, coverage *zoneCoverage) ([]V, bool) {
+	if coverage == nil || coverage.uncoveredTargets.Len() == 0 {
+		return endpoints, false
+	}
+	policy := *tgb.Spec.TargetZonePolicy
+	if policy == elbv2api.TargetZonePolicyRegister {
+		return endpoints, false
+	}
+
+	filteredEndpoints := make([]V, 0, len(endpoints))
+	excludedAny := false
+	for _, endpoint := range endpoints {
+		if coverage.uncoveredTargets.Has(endpoint.GetIdentifier(false, tgbProtocolSupportsQuic(tgb))) {
+			excludedAny = true
+			continue
+		}
+		filteredEndpoints = append(filteredEndpoints, endpoint)
+	}
+	return filteredEndpoints, excludedAny && policy == elbv2api.TargetZonePolicyDefer
+}
+
+func newZoneCoverage() *zoneCoverage {
+	return &zoneCoverage{
+		uncoveredZones:   sets.New[string](),
+		uncoveredTargets: sets.New[string](),
+	}
+}
@@ -0,0 +1,312 @@
+package targetgroupbinding
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/v3/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/v3/pkg/backend"
+	lbcmetrics "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/metrics/lbc"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+type staticLoadBalancerZonesResolver struct {
+	zones       sets.Set[string]
+	invalidated bool
+}
+
+func (r *staticLoadBalancerZonesResolver) ResolveEnabledZones(_ context.Context, _ *elbv2api.TargetGroupBinding) (sets.Set[string], error) {
+	return r.zones, nil
+}
+
+func (r *staticLoadBalancerZonesResolver) InvalidateEnabledZones(_ *elbv2api.TargetGroupBinding) {
+	r.invalidated = true
+}
+
+func Test_defaultResourceManager_checkNodePortEndpointsZoneCoverage(t *testing.T) {
+	registerPolicy := elbv2api.TargetZonePolicyRegister
+	endpoints := []backend.NodePortEndpoint{
+		{
+			InstanceID: "i-1",
+			Port:       32000,
+			Node: &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{corev1.LabelTopologyZone: "us-west-2a"},
+				},
+			},
+		},
+		{
+			InstanceID: "i-2",
+			Port:       32000,
+			Node: &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{corev1.LabelTopologyZone: "us-west-2c"},
+				},
+			},
+		},
+		{
+			InstanceID: "i-3",
+			Port:       32000,
+			Node:       &corev1.Node{},
+		},
+	}
+	tests := []struct {
+		name         string
+		tgbSpec      elbv2api.TargetGroupBindingSpec
+		enabledZones sets.Set[string]
+		want         *zoneCoverage
+	}{
+		{
+			name:         "target zone policy not specified",
+			tgbSpec:      elbv2api.TargetGroupBindingSpec{},
+			enabledZones: sets.New("us-west-2a"),
+			want:         nil,
+		},
+		{
+			name: "cross-account target group",
+			tgbSpec: elbv2api.TargetGroupBindingSpec{
+				TargetZonePolicy:   &registerPolicy,
+				IamRoleArnToAssume: "arn:aws:iam::123456789012:role/role",
+			},
+			enabledZones: sets.New("us-west-2a"),
+			want:         nil,
+		},
+		{
+			name: "target group not attached to load balancer",
+			tgbSpec: elbv2api.TargetGroupBindingSpec{
+				TargetZonePolicy: &registerPolicy,
+			},
+			enabledZones: nil,
+			want:         nil,
+		},
+		{
+			name: "all zones covered",
+			tgbSpec: elbv2api.TargetGroupBindingSpec{
+				TargetZonePolicy: &registerPolicy,
+			},
+			enabledZones: sets.New("us-west-2a", "us-west-2c"),
+			want: &zoneCoverage{
+				uncoveredZones:   sets.New[string](),
+				uncoveredTargets: sets.New[string](),
+			},
+		},
+		{
+			name: "some zones not covered",
+			tgbSpec: elbv2api.TargetGroupBindingSpec{
+				TargetZonePolicy: &registerPolicy,
+			},
+			enabledZones: sets.New("us-west-2a", "us-west-2b"),
+			want: &zoneCoverage{
+				uncoveredZones:   sets.New("us-west-2c"),
+				uncoveredTargets: sets.New("i-2:32000"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &defaultResourceManager{
+				lbZonesResolver: &staticLoadBalancerZonesResolver{zones: tt.enabledZones},
+				logger:          logr.New(&log.NullLogSink{}),
+			}
+			tgb := &elbv2api.TargetGroupBinding{Spec: tt.tgbSpec}
+			got, err := m.checkNodePortEndpointsZoneCoverage(context.Background(), tgb, endpoints)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_defaultResourceManager_updateZoneCoverageStatus(t *testing.T) {
+	registerPolicy := elbv2api.TargetZonePolicyRegister
+	tests := []struct {
+		name           string
+		policy         *elbv2api.TargetZonePolicy
+		existingConds  []metav1.Condition
+		coverage       *zoneCoverage
+		wantConditions []metav1.Condition
+		// wantGauges is the number of recorded gauge observations, starting from one previous observation.
+		wantGauges      int
+		wantInvalidated bool
+	}{
+		{
+			name:     "uncovered zones",
+			policy:   &registerPolicy,
+			coverage: &zoneCoverage{uncoveredZones: sets.New("us-west-2c", "us-west-2b"), uncoveredTargets: sets.New("10.0.0.1:80", "10.0.0.2:80")},
+			wantConditions: []metav1.Condition{
+				{
+					Type:    TargetGroupBindingConditionTargetZonesCovered,
+					Status:  metav1.ConditionFalse,
+					Reason:  zoneCoverageReasonUncoveredZones,
+					Message: "2 targets are located in availability zones not enabled on the load balancer: us-west-2b,us-west-2c",
+				},
+			},
+			wantGauges:      2,
+			wantInvalidated: true,
+		},
+		{
+			name:     "all zones covered",
+			policy:   &registerPolicy,
+			coverage: newZoneCoverage(),
+			wantConditions: []metav1.Condition{
+				{
+					Type:    TargetGroupBindingConditionTargetZonesCovered,
+					Status:  metav1.ConditionTrue,
+					Reason:  zoneCoverageReasonAllZonesCovered,
+					Message: "All targets are located in availability zones enabled on the load balancer",
+				},
+			},
+			wantGauges: 2,
+		},
+		{
+			name:   "condition removed when policy is unset",
+			policy: nil,
+			existingConds: []metav1.Condition{
+				{
+					Type:               TargetGroupBindingConditionTargetZonesCovered,
+					Status:             metav1.ConditionTrue,
+					Reason:             zoneCoverageReasonAllZonesCovered,
+					LastTransitionTime: metav1.Now(),
+				},
+			},
+			coverage:       nil,
+			wantConditions: nil,
+			wantGauges:     0,
+		},
+		{
+			name:   "condition kept when enabled zones are unknown",
+			policy: &registerPolicy,
+			existingConds: []metav1.Condition{
+				{
+					Type:               TargetGroupBindingConditionTargetZonesCovered,
+					Status:             metav1.ConditionTrue,
+					Reason:             zoneCoverageReasonAllZonesCovered,
+					LastTransitionTime: metav1.Now(),
+				},
+			},
+			coverage: nil,
+			wantConditions: []metav1.Condition{
+				{
+					Type:   TargetGroupBindingConditionTargetZonesCovered,
+					Status: metav1.ConditionTrue,
+					Reason: zoneCoverageReasonAllZonesCovered,
+				},
+			},
+			wantGauges: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			clientgoscheme.AddToScheme(scheme)
+			elbv2api.AddToScheme(scheme)
+			tgb := &elbv2api.TargetGroupBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "tgb",
+				},
+				Spec: elbv2api.TargetGroupBindingSpec{
+					TargetZonePolicy: tt.policy,
+				},
+				Status: elbv2api.TargetGroupBindingStatus{
+					Conditions: tt.existingConds,
+				},
+			}
+			k8sClient := testclient.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(tgb).WithObjects(tgb.DeepCopy()).Build()
+			assert.NoError(t, k8sClient.Get(context.Background(), client.ObjectKeyFromObject(tgb), tgb))
+
+			metricsCollector := lbcmetrics.NewMockCollector().(*lbcmetrics.MockCollector)
+			metricsCollector.ObserveTargetsInUncoveredZones(tgb.Namespace, tgb.Name, 1)
+			lbZonesResolver := &staticLoadBalancerZonesResolver{}
+			m := &defaultResourceManager{
+				k8sClient:        k8sClient,
+				lbZonesResolver:  lbZonesResolver,
+				logger:           logr.New(&log.NullLogSink{}),
+				metricsCollector: metricsCollector,
+			}
+			err := m.updateZoneCoverageStatus(context.Background(), tgb, tt.coverage)
+			assert.NoError(t, err)
+
+			updatedTGB := &elbv2api.TargetGroupBinding{}
+			assert.NoError(t, k8sClient.Get(context.Background(), client.ObjectKeyFromObject(tgb), updatedTGB))
+			for i := range updatedTGB.Status.Conditions {
+				updatedTGB.Status.Conditions[i].LastTransitionTime = metav1.Time{}
+			}
+			assert.Equal(t, tt.wantConditions, updatedTGB.Status.Conditions)
+			assert.Len(t, metricsCollector.Invocations[lbcmetrics.MetricTargetsInUncoveredZones], tt.wantGauges)
+			assert.Equal(t, tt.wantInvalidated, lbZonesResolver.invalidated)
+		})
+	}
+}
+
+func Test_applyTargetZonePolicy(t *testing.T) {
+	registerPolicy := elbv2api.TargetZonePolicyRegister
+	skipPolicy := elbv2api.TargetZonePolicySkip
+	deferPolicy := elbv2api.TargetZonePolicyDefer
+	endpoints := []backend.PodEndpoint{
+		{IP: "10.0.0.1", Port: 80},
+		{IP: "10.0.0.2", Port: 80},
+	}
+	uncovered := &zoneCoverage{
+		uncoveredZones:   sets.New("us-west-2c"),
+		uncoveredTargets: sets.New("10.0.0.2:80"),
+	}
+	tests := []struct {
+		name          string
+		policy        *elbv2api.TargetZonePolicy
+		coverage      *zoneCoverage
+		wantEndpoints []backend.PodEndpoint
+		wantDeferred  bool
+	}{
+		{
+			name:          "zone coverage not checked",
+			coverage:      nil,
+			wantEndpoints: endpoints,
+		},
+		{
+			name:          "Register policy",
+			policy:        &registerPolicy,
+			coverage:      uncovered,
+			wantEndpoints: endpoints,
+		},
+		{
+			name:          "Skip policy",
+			policy:        &skipPolicy,
+			coverage:      uncovered,
+			wantEndpoints: []backend.PodEndpoint{{IP: "10.0.0.1", Port: 80}},
+		},
+		{
+			name:          "Defer policy",
+			policy:        &deferPolicy,
+			coverage:      uncovered,
+			wantEndpoints: []backend.PodEndpoint{{IP: "10.0.0.1", Port: 80}},
+			wantDeferred:  true,
+		},
+		{
+			name:          "Defer policy with all zones covered",
+			policy:        &deferPolicy,
+			coverage:      newZoneCoverage(),
+			wantEndpoints: endpoints,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tgb := &elbv2api.TargetGroupBinding{
+				Spec: elbv2api.TargetGroupBindingSpec{
+					TargetZonePolicy: tt.policy,
+				},
+			}
+			gotEndpoints, gotDeferred := applyTargetZonePolicy(tgb, endpoints, tt.coverage)
+			assert.Equal(t, tt.wantEndpoints, gotEndpoints)
+			assert.Equal(t, tt.wantDeferred, gotDeferred)
+		})
+	}
+}
@@ -14,6 +14,9 @@ $MOCKGEN -package=services -destination=./pkg/aws/services/wafregional_mocks.go
 $MOCKGEN -package=services -destination=./pkg/aws/services/wafv2_mocks.go sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/services WAFv2
 $MOCKGEN -package=services -destination=./pkg/aws/services/globalaccelerator_mocks.go sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/services GlobalAccelerator
 $MOCKGEN -package=services -destination=./pkg/aws/services/route53_mocks.go sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/services Route53
+$MOCKGEN -package=services -destination=./pkg/aws/services/s3_mocks.go sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/services S3
+$MOCKGEN -package=services -destination=./pkg/aws/services/sts_mocks.go sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/services STS
+$MOCKGEN -package=services -destination=./pkg/aws/services/cloudwatch_mocks.go sigs.k8s.io/aws-load-balancer-controller/v3/pkg/aws/services CloudWatch
 $MOCKGEN -package=webhook -destination=./pkg/webhook/mutator_mocks.go sigs.k8s.io/aws-load-balancer-controller/v3/pkg/webhook Mutator
 $MOCKGEN -package=webhook -destination=./pkg/webhook/validator_mocks.go sigs.k8s.io/aws-load-balancer-controller/v3/pkg/webhook Validator
 $MOCKGEN -package=k8s -destination=./pkg/k8s/finalizer_mocks.go sigs.k8s.io/aws-load-balancer-controller/v3/pkg/k8s FinalizerManager
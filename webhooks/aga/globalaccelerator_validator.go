@@ -2,9 +2,14 @@ package aga
 
 import (
 	"context"
+	"net/netip"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	agaapi "sigs.k8s.io/aws-load-balancer-controller/v3/apis/aga/v1beta1"
 	lbcmetrics "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/metrics/lbc"
@@ -49,6 +54,31 @@ func (v *globalAcceleratorValidator) ValidateCreate(_ context.Context, obj runti
 		return err
 	}
 
+	if err := v.checkByoipCIDRs(ga); err != nil {
+		v.metricsCollector.ObserveWebhookValidationError(apiPathValidateAGAGlobalAccelerator, "checkByoipCIDRs")
+		return err
+	}
+
+	if err := v.checkFlowLogs(ga); err != nil {
+		v.metricsCollector.ObserveWebhookValidationError(apiPathValidateAGAGlobalAccelerator, "checkFlowLogs")
+		return err
+	}
+
+	if err := v.checkCrossAccountAttachments(ga); err != nil {
+		v.metricsCollector.ObserveWebhookValidationError(apiPathValidateAGAGlobalAccelerator, "checkCrossAccountAttachments")
+		return err
+	}
+
+	if err := v.checkEndpointSelectors(ga); err != nil {
+		v.metricsCollector.ObserveWebhookValidationError(apiPathValidateAGAGlobalAccelerator, "checkEndpointSelectors")
+		return err
+	}
+
+	if err := v.checkFederatedEndpoints(ga); err != nil {
+		v.metricsCollector.ObserveWebhookValidationError(apiPathValidateAGAGlobalAccelerator, "checkFederatedEndpoints")
+		return err
+	}
+
 	return nil
 }
 
@@ -65,6 +95,31 @@ func (v *globalAcceleratorValidator) ValidateUpdate(_ context.Context, obj runti
 		return err
 	}
 
+	if err := v.checkByoipCIDRs(ga); err != nil {
+		v.metricsCollector.ObserveWebhookValidationError(apiPathValidateAGAGlobalAccelerator, "checkByoipCIDRs")
+		return err
+	}
+
+	if err := v.checkFlowLogs(ga); err != nil {
+		v.metricsCollector.ObserveWebhookValidationError(apiPathValidateAGAGlobalAccelerator, "checkFlowLogs")
+		return err
+	}
+
+	if err := v.checkCrossAccountAttachments(ga); err != nil {
+		v.metricsCollector.ObserveWebhookValidationError(apiPathValidateAGAGlobalAccelerator, "checkCrossAccountAttachments")
+		return err
+	}
+
+	if err := v.checkEndpointSelectors(ga); err != nil {
+		v.metricsCollector.ObserveWebhookValidationError(apiPathValidateAGAGlobalAccelerator, "checkEndpointSelectors")
+		return err
+	}
+
+	if err := v.checkFederatedEndpoints(ga); err != nil {
+		v.metricsCollector.ObserveWebhookValidationError(apiPathValidateAGAGlobalAccelerator, "checkFederatedEndpoints")
+		return err
+	}
+
 	return nil
 }
 
@@ -177,9 +232,180 @@ func getEndpointKey(endpoint agaapi.GlobalAcceleratorEndpoint, defaultNamespace
 		}
 		return string(endpoint.Type) + "/" + endpointID
 	}
+	if endpoint.Type == agaapi.GlobalAcceleratorEndpointTypeFederated {
+		federationGroup := ""
+		if endpoint.FederationGroup != nil {
+			federationGroup = *endpoint.FederationGroup
+		}
+		return string(endpoint.Type) + "/" + federationGroup
+	}
+	if endpoint.Selector != nil {
+		namespaces := []string{defaultNamespace}
+		if len(endpoint.Selector.Namespaces) > 0 {
+			namespaces = endpoint.Selector.Namespaces
+		}
+		return string(endpoint.Type) + "/selector/" + strings.Join(namespaces, ",") + "/" + metav1.FormatLabelSelector(&endpoint.Selector.LabelSelector)
+	}
 	return string(endpoint.Type) + "/" + namespace + "/" + name
 }
 
+// checkByoipCIDRs validates that the BYOIP address ranges are well-formed and match their IP address family
+func (v *globalAcceleratorValidator) checkByoipCIDRs(ga *agaapi.GlobalAccelerator) error {
+	if ga.Spec.ByoipCIDRs == nil {
+		return nil
+	}
+	if ga.Spec.IpAddresses != nil && len(*ga.Spec.IpAddresses) > 0 {
+		return errors.New("ipAddresses and byoipCIDRs are mutually exclusive")
+	}
+
+	seen := make(map[netip.Prefix]bool)
+	countByFamily := make(map[agaapi.IPAddressFamily]int)
+	for idx, byoipCIDR := range *ga.Spec.ByoipCIDRs {
+		prefix, err := netip.ParsePrefix(byoipCIDR.Cidr)
+		if err != nil {
+			return errors.Errorf("byoipCIDRs[%d]: invalid CIDR %s", idx, byoipCIDR.Cidr)
+		}
+		if prefix.Addr().Is4() != (byoipCIDR.IPAddressFamily == agaapi.IPAddressFamilyIPv4) {
+			return errors.Errorf("byoipCIDRs[%d]: CIDR %s doesn't belong to IP address family %s", idx, byoipCIDR.Cidr, byoipCIDR.IPAddressFamily)
+		}
+		if byoipCIDR.IPAddressFamily == agaapi.IPAddressFamilyIPv6 && ga.Spec.IPAddressType != agaapi.IPAddressTypeDualStack {
+			return errors.Errorf("byoipCIDRs[%d]: IPv6 address ranges require ipAddressType %s", idx, agaapi.IPAddressTypeDualStack)
+		}
+		prefix = prefix.Masked()
+		if seen[prefix] {
+			return errors.Errorf("duplicate CIDR detected in byoipCIDRs: %s", byoipCIDR.Cidr)
+		}
+		seen[prefix] = true
+		countByFamily[byoipCIDR.IPAddressFamily]++
+		if countByFamily[byoipCIDR.IPAddressFamily] > 2 {
+			return errors.Errorf("at most 2 byoipCIDRs are allowed for IP address family %s", byoipCIDR.IPAddressFamily)
+		}
+	}
+	return nil
+}
+
+// checkFlowLogs validates that enabled flow logs have a destination bucket
+func (v *globalAcceleratorValidator) checkFlowLogs(ga *agaapi.GlobalAccelerator) error {
+	if ga.Spec.FlowLogs == nil || !ga.Spec.FlowLogs.Enabled {
+		return nil
+	}
+	if ga.Spec.FlowLogs.S3Bucket == nil || *ga.Spec.FlowLogs.S3Bucket == "" {
+		return errors.New("flowLogs.s3Bucket is required when flow logs are enabled")
+	}
+	return nil
+}
+
+// checkCrossAccountAttachments validates that cross-account attachments are only set on endpoints referenced by ID
+// and are Global Accelerator attachment ARNs
+func (v *globalAcceleratorValidator) checkCrossAccountAttachments(ga *agaapi.GlobalAccelerator) error {
+	if ga.Spec.Listeners == nil {
+		return nil
+	}
+
+	for listenerIdx, listener := range *ga.Spec.Listeners {
+		if listener.EndpointGroups == nil {
+			continue
+		}
+
+		for groupIdx, group := range *listener.EndpointGroups {
+			if group.Endpoints == nil {
+				continue
+			}
+
+			for endpointIdx, endpoint := range *group.Endpoints {
+				if endpoint.CrossAccountAttachmentARN == nil {
+					continue
+				}
+				if endpoint.Type != agaapi.GlobalAcceleratorEndpointTypeEndpointID {
+					return errors.Errorf(
+						"listener[%d].endpointGroups[%d].endpoints[%d]: crossAccountAttachmentARN is only supported for endpoints of type %s",
+						listenerIdx, groupIdx, endpointIdx, agaapi.GlobalAcceleratorEndpointTypeEndpointID)
+				}
+				attachmentARN, err := arn.Parse(*endpoint.CrossAccountAttachmentARN)
+				if err != nil || attachmentARN.Service != "globalaccelerator" || !strings.HasPrefix(attachmentARN.Resource, "attachment/") {
+					return errors.Errorf(
+						"listener[%d].endpointGroups[%d].endpoints[%d]: invalid crossAccountAttachmentARN %s",
+						listenerIdx, groupIdx, endpointIdx, *endpoint.CrossAccountAttachmentARN)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkEndpointSelectors validates that the label selectors of label-selector endpoints are well-formed
+// and do not select every resource in a namespace
+func (v *globalAcceleratorValidator) checkEndpointSelectors(ga *agaapi.GlobalAccelerator) error {
+	if ga.Spec.Listeners == nil {
+		return nil
+	}
+
+	for listenerIdx, listener := range *ga.Spec.Listeners {
+		if listener.EndpointGroups == nil {
+			continue
+		}
+
+		for groupIdx, group := range *listener.EndpointGroups {
+			if group.Endpoints == nil {
+				continue
+			}
+
+			for endpointIdx, endpoint := range *group.Endpoints {
+				if endpoint.Selector == nil {
+					continue
+				}
+				selector, err := metav1.LabelSelectorAsSelector(&endpoint.Selector.LabelSelector)
+				if err != nil {
+					return errors.Errorf(
+						"listener[%d].endpointGroups[%d].endpoints[%d]: invalid selector: %v",
+						listenerIdx, groupIdx, endpointIdx, err)
+				}
+				if selector.Empty() {
+					return errors.Errorf(
+						"listener[%d].endpointGroups[%d].endpoints[%d]: selector must specify at least one label requirement",
+						listenerIdx, groupIdx, endpointIdx)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkFederatedEndpoints validates that listeners with Federated endpoints specify their protocol and port ranges,
+// since published load balancers may not exist yet or live in other regions and cannot be used for auto-discovery
+func (v *globalAcceleratorValidator) checkFederatedEndpoints(ga *agaapi.GlobalAccelerator) error {
+	if ga.Spec.Listeners == nil {
+		return nil
+	}
+
+	for listenerIdx, listener := range *ga.Spec.Listeners {
+		if listener.EndpointGroups == nil {
+			continue
+		}
+
+		for _, group := range *listener.EndpointGroups {
+			if group.Endpoints == nil {
+				continue
+			}
+
+			for _, endpoint := range *group.Endpoints {
+				if endpoint.Type != agaapi.GlobalAcceleratorEndpointTypeFederated {
+					continue
+				}
+				if listener.Protocol == nil || listener.PortRanges == nil || len(*listener.PortRanges) == 0 {
+					return errors.Errorf(
+						"listener[%d]: protocol and portRanges must be specified for listeners with endpoints of type %s",
+						listenerIdx, agaapi.GlobalAcceleratorEndpointTypeFederated)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
 // +kubebuilder:webhook:path=/validate-aga-k8s-aws-v1beta1-globalaccelerator,mutating=false,failurePolicy=fail,groups=aga.k8s.aws,resources=globalaccelerators,verbs=create;update,versions=v1beta1,name=vglobalaccelerator.aga.k8s.aws,sideEffects=None,matchPolicy=Equivalent,webhookVersions=v1,admissionReviewVersions=v1
 
 func (v *globalAcceleratorValidator) SetupWithManager(mgr ctrl.Manager) {
@@ -1028,3 +1028,430 @@ func Test_globalAcceleratorValidator_checkForDuplicateEndpoints(t *testing.T) {
 		})
 	}
 }
+
+func Test_globalAcceleratorValidator_checkByoipCIDRs(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      agaapi.GlobalAcceleratorSpec
+		wantError bool
+		errMsg    string
+	}{
+		{
+			name:      "valid - no byoipCIDRs",
+			spec:      agaapi.GlobalAcceleratorSpec{},
+			wantError: false,
+		},
+		{
+			name: "valid - IPv4 and IPv6 ranges with dual stack",
+			spec: agaapi.GlobalAcceleratorSpec{
+				IPAddressType: agaapi.IPAddressTypeDualStack,
+				ByoipCIDRs: &[]agaapi.ByoipCIDR{
+					{IPAddressFamily: agaapi.IPAddressFamilyIPv4, Cidr: "198.51.100.0/24"},
+					{IPAddressFamily: agaapi.IPAddressFamilyIPv6, Cidr: "2001:db8::/48"},
+				},
+			},
+			wantError: false,
+		},
+		{
+			name: "invalid - combined with ipAddresses",
+			spec: agaapi.GlobalAcceleratorSpec{
+				IpAddresses: &[]string{"198.51.100.10"},
+				ByoipCIDRs: &[]agaapi.ByoipCIDR{
+					{IPAddressFamily: agaapi.IPAddressFamilyIPv4, Cidr: "198.51.100.0/24"},
+				},
+			},
+			wantError: true,
+			errMsg:    "mutually exclusive",
+		},
+		{
+			name: "invalid - malformed CIDR",
+			spec: agaapi.GlobalAcceleratorSpec{
+				ByoipCIDRs: &[]agaapi.ByoipCIDR{
+					{IPAddressFamily: agaapi.IPAddressFamilyIPv4, Cidr: "198.51.100.0"},
+				},
+			},
+			wantError: true,
+			errMsg:    "invalid CIDR",
+		},
+		{
+			name: "invalid - family mismatch",
+			spec: agaapi.GlobalAcceleratorSpec{
+				IPAddressType: agaapi.IPAddressTypeDualStack,
+				ByoipCIDRs: &[]agaapi.ByoipCIDR{
+					{IPAddressFamily: agaapi.IPAddressFamilyIPv6, Cidr: "198.51.100.0/24"},
+				},
+			},
+			wantError: true,
+			errMsg:    "doesn't belong to IP address family",
+		},
+		{
+			name: "invalid - IPv6 range without dual stack",
+			spec: agaapi.GlobalAcceleratorSpec{
+				IPAddressType: agaapi.IPAddressTypeIPV4,
+				ByoipCIDRs: &[]agaapi.ByoipCIDR{
+					{IPAddressFamily: agaapi.IPAddressFamilyIPv6, Cidr: "2001:db8::/48"},
+				},
+			},
+			wantError: true,
+			errMsg:    "require ipAddressType DUAL_STACK",
+		},
+		{
+			name: "invalid - duplicate CIDR",
+			spec: agaapi.GlobalAcceleratorSpec{
+				ByoipCIDRs: &[]agaapi.ByoipCIDR{
+					{IPAddressFamily: agaapi.IPAddressFamilyIPv4, Cidr: "198.51.100.0/24"},
+					{IPAddressFamily: agaapi.IPAddressFamilyIPv4, Cidr: "198.51.100.0/24"},
+				},
+			},
+			wantError: true,
+			errMsg:    "duplicate CIDR",
+		},
+		{
+			name: "invalid - too many ranges of one family",
+			spec: agaapi.GlobalAcceleratorSpec{
+				ByoipCIDRs: &[]agaapi.ByoipCIDR{
+					{IPAddressFamily: agaapi.IPAddressFamilyIPv4, Cidr: "198.51.100.0/24"},
+					{IPAddressFamily: agaapi.IPAddressFamilyIPv4, Cidr: "203.0.113.0/24"},
+					{IPAddressFamily: agaapi.IPAddressFamilyIPv4, Cidr: "192.0.2.0/24"},
+				},
+			},
+			wantError: true,
+			errMsg:    "at most 2 byoipCIDRs",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &globalAcceleratorValidator{
+				logger:           logr.New(&log.NullLogSink{}),
+				metricsCollector: lbcmetrics.NewMockCollector(),
+			}
+			err := v.checkByoipCIDRs(&agaapi.GlobalAccelerator{Spec: tt.spec})
+			if tt.wantError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_globalAcceleratorValidator_checkFlowLogs(t *testing.T) {
+	bucket := "my-flow-logs"
+	emptyBucket := ""
+	tests := []struct {
+		name      string
+		flowLogs  *agaapi.FlowLogsConfig
+		wantError bool
+	}{
+		{
+			name:      "valid - flow logs not specified",
+			flowLogs:  nil,
+			wantError: false,
+		},
+		{
+			name:      "valid - flow logs disabled without bucket",
+			flowLogs:  &agaapi.FlowLogsConfig{Enabled: false},
+			wantError: false,
+		},
+		{
+			name:      "valid - flow logs enabled with bucket",
+			flowLogs:  &agaapi.FlowLogsConfig{Enabled: true, S3Bucket: &bucket},
+			wantError: false,
+		},
+		{
+			name:      "invalid - flow logs enabled without bucket",
+			flowLogs:  &agaapi.FlowLogsConfig{Enabled: true},
+			wantError: true,
+		},
+		{
+			name:      "invalid - flow logs enabled with empty bucket",
+			flowLogs:  &agaapi.FlowLogsConfig{Enabled: true, S3Bucket: &emptyBucket},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &globalAcceleratorValidator{
+				logger:           logr.New(&log.NullLogSink{}),
+				metricsCollector: lbcmetrics.NewMockCollector(),
+			}
+			err := v.checkFlowLogs(&agaapi.GlobalAccelerator{Spec: agaapi.GlobalAcceleratorSpec{FlowLogs: tt.flowLogs}})
+			if tt.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_globalAcceleratorValidator_checkCrossAccountAttachments(t *testing.T) {
+	endpointID := "arn:aws:elasticloadbalancing:us-west-2:210987654321:loadbalancer/app/my-alb/1234567890abcdef"
+	endpointName := "test-endpoint"
+	attachmentARN := "arn:aws:globalaccelerator::210987654321:attachment/1234abcd-abcd-1234-abcd-1234abcdefgh"
+	acceleratorARN := "arn:aws:globalaccelerator::210987654321:accelerator/1234abcd-abcd-1234-abcd-1234abcdefgh"
+	invalidARN := "not-an-arn"
+
+	newGA := func(endpoint agaapi.GlobalAcceleratorEndpoint) *agaapi.GlobalAccelerator {
+		return &agaapi.GlobalAccelerator{
+			Spec: agaapi.GlobalAcceleratorSpec{
+				Listeners: &[]agaapi.GlobalAcceleratorListener{{
+					EndpointGroups: &[]agaapi.GlobalAcceleratorEndpointGroup{{
+						Endpoints: &[]agaapi.GlobalAcceleratorEndpoint{endpoint},
+					}},
+				}},
+			},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		ga        *agaapi.GlobalAccelerator
+		wantError bool
+		errMsg    string
+	}{
+		{
+			name:      "valid - endpoint without attachment",
+			ga:        newGA(agaapi.GlobalAcceleratorEndpoint{Type: agaapi.GlobalAcceleratorEndpointTypeEndpointID, EndpointID: &endpointID}),
+			wantError: false,
+		},
+		{
+			name: "valid - endpoint ID with attachment",
+			ga: newGA(agaapi.GlobalAcceleratorEndpoint{
+				Type:                      agaapi.GlobalAcceleratorEndpointTypeEndpointID,
+				EndpointID:                &endpointID,
+				CrossAccountAttachmentARN: &attachmentARN,
+			}),
+			wantError: false,
+		},
+		{
+			name: "invalid - attachment on non endpoint ID endpoint",
+			ga: newGA(agaapi.GlobalAcceleratorEndpoint{
+				Type:                      agaapi.GlobalAcceleratorEndpointTypeIngress,
+				Name:                      &endpointName,
+				CrossAccountAttachmentARN: &attachmentARN,
+			}),
+			wantError: true,
+			errMsg:    "only supported for endpoints of type EndpointID",
+		},
+		{
+			name: "invalid - malformed attachment ARN",
+			ga: newGA(agaapi.GlobalAcceleratorEndpoint{
+				Type:                      agaapi.GlobalAcceleratorEndpointTypeEndpointID,
+				EndpointID:                &endpointID,
+				CrossAccountAttachmentARN: &invalidARN,
+			}),
+			wantError: true,
+			errMsg:    "invalid crossAccountAttachmentARN",
+		},
+		{
+			name: "invalid - ARN of a different resource",
+			ga: newGA(agaapi.GlobalAcceleratorEndpoint{
+				Type:                      agaapi.GlobalAcceleratorEndpointTypeEndpointID,
+				EndpointID:                &endpointID,
+				CrossAccountAttachmentARN: &acceleratorARN,
+			}),
+			wantError: true,
+			errMsg:    "invalid crossAccountAttachmentARN",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &globalAcceleratorValidator{
+				logger:           logr.New(&log.NullLogSink{}),
+				metricsCollector: lbcmetrics.NewMockCollector(),
+			}
+			err := v.checkCrossAccountAttachments(tt.ga)
+			if tt.wantError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_globalAcceleratorValidator_checkEndpointSelectors(t *testing.T) {
+	endpointName := "test-endpoint"
+
+	newGA := func(endpoints ...agaapi.GlobalAcceleratorEndpoint) *agaapi.GlobalAccelerator {
+		return &agaapi.GlobalAccelerator{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+			Spec: agaapi.GlobalAcceleratorSpec{
+				Listeners: &[]agaapi.GlobalAcceleratorListener{{
+					EndpointGroups: &[]agaapi.GlobalAcceleratorEndpointGroup{{
+						Endpoints: &endpoints,
+					}},
+				}},
+			},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		ga        *agaapi.GlobalAccelerator
+		wantError bool
+		errMsg    string
+	}{
+		{
+			name:      "valid - endpoint without selector",
+			ga:        newGA(agaapi.GlobalAcceleratorEndpoint{Type: agaapi.GlobalAcceleratorEndpointTypeService, Name: &endpointName}),
+			wantError: false,
+		},
+		{
+			name: "valid - match labels selector",
+			ga: newGA(agaapi.GlobalAcceleratorEndpoint{
+				Type: agaapi.GlobalAcceleratorEndpointTypeService,
+				Selector: &agaapi.GlobalAcceleratorEndpointSelector{
+					LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+				},
+			}),
+			wantError: false,
+		},
+		{
+			name: "valid - match expressions selector",
+			ga: newGA(agaapi.GlobalAcceleratorEndpoint{
+				Type: agaapi.GlobalAcceleratorEndpointTypeIngress,
+				Selector: &agaapi.GlobalAcceleratorEndpointSelector{
+					LabelSelector: metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: "region", Operator: metav1.LabelSelectorOpIn, Values: []string{"us-west-2", "us-east-1"}},
+					}},
+					Namespaces: []string{"team-a", "team-b"},
+				},
+			}),
+			wantError: false,
+		},
+		{
+			name: "invalid - empty selector",
+			ga: newGA(agaapi.GlobalAcceleratorEndpoint{
+				Type:     agaapi.GlobalAcceleratorEndpointTypeService,
+				Selector: &agaapi.GlobalAcceleratorEndpointSelector{},
+			}),
+			wantError: true,
+			errMsg:    "selector must specify at least one label requirement",
+		},
+		{
+			name: "invalid - malformed match expression",
+			ga: newGA(agaapi.GlobalAcceleratorEndpoint{
+				Type: agaapi.GlobalAcceleratorEndpointTypeService,
+				Selector: &agaapi.GlobalAcceleratorEndpointSelector{
+					LabelSelector: metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: "app", Operator: metav1.LabelSelectorOpIn},
+					}},
+				},
+			}),
+			wantError: true,
+			errMsg:    "invalid selector",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &globalAcceleratorValidator{
+				logger:           logr.New(&log.NullLogSink{}),
+				metricsCollector: lbcmetrics.NewMockCollector(),
+			}
+			err := v.checkEndpointSelectors(tt.ga)
+			if tt.wantError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_getEndpointKey_selector(t *testing.T) {
+	selectorEndpoint := func(namespaces ...string) agaapi.GlobalAcceleratorEndpoint {
+		return agaapi.GlobalAcceleratorEndpoint{
+			Type: agaapi.GlobalAcceleratorEndpointTypeService,
+			Selector: &agaapi.GlobalAcceleratorEndpointSelector{
+				LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+				Namespaces:    namespaces,
+			},
+		}
+	}
+
+	assert.Equal(t, "Service/selector/default/app=web", getEndpointKey(selectorEndpoint(), "default"))
+	assert.Equal(t, "Service/selector/team-a,team-b/app=web", getEndpointKey(selectorEndpoint("team-a", "team-b"), "default"))
+	assert.Equal(t, getEndpointKey(selectorEndpoint(), "default"), getEndpointKey(selectorEndpoint("default"), "default"))
+}
+
+func Test_globalAcceleratorValidator_checkFederatedEndpoints(t *testing.T) {
+	protocol := agaapi.GlobalAcceleratorProtocolTCP
+	federated := agaapi.GlobalAcceleratorEndpoint{
+		Type:            agaapi.GlobalAcceleratorEndpointTypeFederated,
+		FederationGroup: func() *string { s := "web"; return &s }(),
+	}
+
+	newGA := func(listener agaapi.GlobalAcceleratorListener) *agaapi.GlobalAccelerator {
+		return &agaapi.GlobalAccelerator{
+			Spec: agaapi.GlobalAcceleratorSpec{
+				Listeners: &[]agaapi.GlobalAcceleratorListener{listener},
+			},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		ga        *agaapi.GlobalAccelerator
+		wantError bool
+	}{
+		{
+			name: "valid - federated endpoint with explicit protocol and port ranges",
+			ga: newGA(agaapi.GlobalAcceleratorListener{
+				Protocol:       &protocol,
+				PortRanges:     &[]agaapi.PortRange{{FromPort: 443, ToPort: 443}},
+				EndpointGroups: &[]agaapi.GlobalAcceleratorEndpointGroup{{Endpoints: &[]agaapi.GlobalAcceleratorEndpoint{federated}}},
+			}),
+			wantError: false,
+		},
+		{
+			name: "valid - auto-discovered listener without federated endpoints",
+			ga: newGA(agaapi.GlobalAcceleratorListener{
+				EndpointGroups: &[]agaapi.GlobalAcceleratorEndpointGroup{{Endpoints: &[]agaapi.GlobalAcceleratorEndpoint{{
+					Type: agaapi.GlobalAcceleratorEndpointTypeService,
+					Name: func() *string { s := "svc"; return &s }(),
+				}}}},
+			}),
+			wantError: false,
+		},
+		{
+			name: "invalid - federated endpoint without protocol",
+			ga: newGA(agaapi.GlobalAcceleratorListener{
+				PortRanges:     &[]agaapi.PortRange{{FromPort: 443, ToPort: 443}},
+				EndpointGroups: &[]agaapi.GlobalAcceleratorEndpointGroup{{Endpoints: &[]agaapi.GlobalAcceleratorEndpoint{federated}}},
+			}),
+			wantError: true,
+		},
+		{
+			name: "invalid - federated endpoint without port ranges",
+			ga: newGA(agaapi.GlobalAcceleratorListener{
+				Protocol:       &protocol,
+				EndpointGroups: &[]agaapi.GlobalAcceleratorEndpointGroup{{Endpoints: &[]agaapi.GlobalAcceleratorEndpoint{federated}}},
+			}),
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &globalAcceleratorValidator{
+				logger:           logr.New(&log.NullLogSink{}),
+				metricsCollector: lbcmetrics.NewMockCollector(),
+			}
+			err := v.checkFederatedEndpoints(tt.ga)
+			if tt.wantError {
+				assert.ErrorContains(t, err, "protocol and portRanges must be specified")
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
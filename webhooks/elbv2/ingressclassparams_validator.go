@@ -45,6 +45,14 @@ func (v *ingressClassParamsValidator) ValidateCreate(ctx context.Context, obj ru
 		v.metricsCollector.ObserveWebhookValidationError(apiPathValidateELBv2IngressClassParams, "checkSubnetSelectors")
 		allErrs = append(allErrs, errs...)
 	}
+	if errs := v.checkTargetGroupHealthCheck(icp); len(errs) > 0 {
+		v.metricsCollector.ObserveWebhookValidationError(apiPathValidateELBv2IngressClassParams, "checkTargetGroupHealthCheck")
+		allErrs = append(allErrs, errs...)
+	}
+	if errs := v.checkAuthentication(icp); len(errs) > 0 {
+		v.metricsCollector.ObserveWebhookValidationError(apiPathValidateELBv2IngressClassParams, "checkAuthentication")
+		allErrs = append(allErrs, errs...)
+	}
 	return allErrs.ToAggregate()
 }
 
@@ -59,6 +67,14 @@ func (v *ingressClassParamsValidator) ValidateUpdate(ctx context.Context, obj ru
 		v.metricsCollector.ObserveWebhookValidationError(apiPathValidateELBv2IngressClassParams, "checkSubnetSelectors")
 		allErrs = append(allErrs, errs...)
 	}
+	if errs := v.checkTargetGroupHealthCheck(icp); len(errs) > 0 {
+		v.metricsCollector.ObserveWebhookValidationError(apiPathValidateELBv2IngressClassParams, "checkTargetGroupHealthCheck")
+		allErrs = append(allErrs, errs...)
+	}
+	if errs := v.checkAuthentication(icp); len(errs) > 0 {
+		v.metricsCollector.ObserveWebhookValidationError(apiPathValidateELBv2IngressClassParams, "checkAuthentication")
+		allErrs = append(allErrs, errs...)
+	}
 	return allErrs.ToAggregate()
 }
 
@@ -146,6 +162,27 @@ func (v *ingressClassParamsValidator) checkSubnetSelectors(icp *elbv2api.Ingress
 	return allErrs
 }
 
+// checkTargetGroupHealthCheck will check for valid target group healthCheck settings
+func (v *ingressClassParamsValidator) checkTargetGroupHealthCheck(icp *elbv2api.IngressClassParams) (allErrs field.ErrorList) {
+	healthCheck := icp.Spec.TargetGroupHealthCheck
+	if healthCheck == nil {
+		return allErrs
+	}
+	fieldPath := field.NewPath("spec", "targetGroupHealthCheck")
+	if healthCheck.IntervalSeconds != nil && healthCheck.TimeoutSeconds != nil && *healthCheck.TimeoutSeconds >= *healthCheck.IntervalSeconds {
+		allErrs = append(allErrs, field.Invalid(fieldPath.Child("timeoutSeconds"), *healthCheck.TimeoutSeconds, "must be smaller than intervalSeconds"))
+	}
+	return allErrs
+}
+
+// checkAuthentication will check that authentication and jwtValidation aren't both specified
+func (v *ingressClassParamsValidator) checkAuthentication(icp *elbv2api.IngressClassParams) (allErrs field.ErrorList) {
+	if icp.Spec.Authentication != nil && icp.Spec.JwtValidation != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "jwtValidation"), "may not be specified together with authentication"))
+	}
+	return allErrs
+}
+
 // +kubebuilder:webhook:path=/validate-elbv2-k8s-aws-v1beta1-ingressclassparams,mutating=false,failurePolicy=fail,groups=elbv2.k8s.aws,resources=ingressclassparams,verbs=create;update,versions=v1beta1,name=vingressclassparams.elbv2.k8s.aws,sideEffects=None,webhookVersions=v1,admissionReviewVersions=v1
 
 func (v *ingressClassParamsValidator) SetupWithManager(mgr ctrl.Manager) {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/stretchr/testify/assert"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/v3/apis/elbv2/v1beta1"
 	lbcmetrics "sigs.k8s.io/aws-load-balancer-controller/v3/pkg/metrics/lbc"
@@ -177,6 +178,67 @@ func Test_ingressClassParamsValidator_ValidateCreate(t *testing.T) {
 			wantErr:    "spec.subnets.tags: Required value: must have at least one tag key",
 			wantMetric: true,
 		},
+		{
+			name: "targetGroupHealthCheck is valid",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					TargetGroupHealthCheck: &elbv2api.TargetGroupHealthCheckConfig{
+						IntervalSeconds: awssdk.Int32(15),
+						TimeoutSeconds:  awssdk.Int32(5),
+					},
+				},
+			},
+		},
+		{
+			name: "targetGroupHealthCheck timeout not smaller than interval",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					TargetGroupHealthCheck: &elbv2api.TargetGroupHealthCheckConfig{
+						IntervalSeconds: awssdk.Int32(10),
+						TimeoutSeconds:  awssdk.Int32(10),
+					},
+				},
+			},
+			wantErr:    "spec.targetGroupHealthCheck.timeoutSeconds: Invalid value: 10: must be smaller than intervalSeconds",
+			wantMetric: true,
+		},
+		{
+			name: "authentication without jwtValidation",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					Authentication: &elbv2api.AuthenticationConfig{
+						Type: elbv2api.AuthTypeNone,
+					},
+				},
+			},
+		},
+		{
+			name: "jwtValidation without authentication",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					JwtValidation: &elbv2api.JwtValidationConfig{
+						JwksEndpoint: "https://issuer.example.com/.well-known/jwks.json",
+						Issuer:       "https://issuer.example.com",
+					},
+				},
+			},
+		},
+		{
+			name: "authentication and jwtValidation",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					Authentication: &elbv2api.AuthenticationConfig{
+						Type: elbv2api.AuthTypeNone,
+					},
+					JwtValidation: &elbv2api.JwtValidationConfig{
+						JwksEndpoint: "https://issuer.example.com/.well-known/jwks.json",
+						Issuer:       "https://issuer.example.com",
+					},
+				},
+			},
+			wantErr:    "spec.jwtValidation: Forbidden: may not be specified together with authentication",
+			wantMetric: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {